@@ -19,184 +19,208 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9iXLbOLbor+BxblWSaUneErfjqqm5iu0k6o5sx0ty0628CURCEmISYADQstLlqvcP",
-	"7w/fl7zCRoIkqM1Kp2cqPVUTmdgODg4ODs6GP4KQJikliAgeHP4R8HCCEqh+hpShf93uDJGAO/+iKSIw",
-	"xf/qDjmNM4HOoZjIShHiIcOpwJQEh8EFShnisi8ACYCmLhjhGIEUikknaAUpoyliAiM1SOrt52qCitay",
-	"ChAUQN0PJUBMEOAzLlDSAadUICAmUABIZgDdYS4wGeuqUxzHYIgAvUVsyrAQiEgI0B1M0hgFh8HWLWRb",
-	"MR1vwTTtxHQctAIxS2UJFwyTcXB/n3+hw88oFMF9qwExKX6HGFfwV6fTPe+ZMhChESaIqync6m8oAhrr",
-	"gI6AmGAOmEUjlB3Iz5AAPX4HXCImGwI+oVkcgZCSW8QEYCikY4K/5r1xiTM5TAwF4gJgIhAjMAa3MM5Q",
-	"C0ASgQTOAEOyX5ARpwdVhXdAnzIEMBnRQzARIuWHW1tjLDo3B7yD6VZIkyQjWMy2QkoEw8NMUMa3InSL",
-	"4i2Ox23IwgkWKBQZQ1swxW0FLJGT4p0k+htDnGYsRFytCsmS4PD3wCA2aAWjGI8nIhSxHKz4HHysrlIr",
-	"uGvL5u1byAhMJGX9HhQL8i5vWnx7afvuUV/xSZKKmRzorj2m7QpNNFJAeqUq+qhZdqHXFwGYpjEO1dq6",
-	"E1cbkaOgFXzJYBQjEciBiICYIBa0ggmKk6XnrkA5yns0H97mHec1iv7Np9dqmDmTtLDLtogIOV8Yx2ej",
-	"4PD3P4L/YmgUHAZ/2yrYypahxy1vhy9xjGxP9601OrhAMRT4VnMk2QNDXzLMUCQRodjLx9oeXmZ6J+T2",
-	"HWSaSZVYFioKYBRhWRfG56UqNYIpE8QJucWMkgQRAW4hw3AYI3CDZm2170AKMeMtgIkEFkUgymQ3gGVE",
-	"4AR1gKSnGzRTO1i3QDCcgCTjQnK7IRJThAjYURV2n+2BcAIZDAViaqNVcLECh8txc06ZqNO5/AoSmKYS",
-	"WkzAiLIECjAIJpQLWXiYk7P8axCAx6gz7rTAIDjYPtg+PNgeBE/KDNp8l8cGFJJ/BYfB/x4Mop8O5f/9",
-	"V51fLwE7o7c4QuwF5J69ekSThBJQrLicBYBx7O5atZt5/TCDBQdYmYwt97hvBXIbN5yJDgyyVr7mO//v",
-	"//zf8kqDmJJxC3ABmQBTLCYAghhJHALKAMmSIWL6EDCLAggFU8mueQpD1PEehe7WspP9uA79mDW4TFGo",
-	"ZorlTBNMoKBMfjBUpMUQzcQakGl4nNN5iW02tjIVyu0Ui21oIvliubZl0w0NDLN129znFDM7VYucY/G+",
-	"FVCC1mWiHiSsxUu9IK8Fjwe9a/VUxfpSrPvCyBVvcIK1WFsVU3U5iFUFtcU9B3N5c4dp5mEX59e6E8nv",
-	"JFi8A15qtseQ3DiKgQ8hR5EUXKs8pMzstjs/P/NxtAQllM3qg/fVdzO+2uI01acRkGLZAyDZfbafPEgW",
-	"ri3FvFUIKeGCQUyWXYo4X9d12GwDlaw1vUsBRcb9Yp8uU9I74JiM4zL/NveYCN1izW6tHHjOUAqNbHcp",
-	"2bf+eZERon+dMEalwHZNbgidSk4k93+MBIqWlw/LM3DHrBU6QNTKCqhqRRbMWkEBd63ImcgS2L/miNXl",
-	"M5aRLvefnxlHCglWEteXSPVZ37zcBTIXrCGSkhfISISYlL0wB5gDQoXuQfYG9X1OdSP3ISbqMpqfQtwj",
-	"+YPHeGT/HsboSQccoxHMYpHf2wxUUA8Ex1JaZBnhcrjHY0QQg3E8A4xS8QTgkQKJpyjEI4yi+vldvstc",
-	"G0y4n9v8Bqdty0PaKVVXxuBQsAyttTve0TjTokxxQSgvyrG5DkMlzkTgVrWQU4/AcKaUCPMYgV9Suib4",
-	"S4aAu9Buv2aFPFymxnkZCmOIk3Ma43D2UH6jsXFR6rIqVKkJeSSqPx4iHfQSOEZ69JLgtdaB3KcZEZvq",
-	"TEHW2OPHpQ57T8saS9DL72EKbzAXikM7G9NUlgSBBUr4ZtY9KDYQZAzOggfsqIsqWUaadahDVbKcoNWw",
-	"zyZ06nCTCSRRrHaf2R/TCdIbg04lVy+hRemlEnqreYs9rMx4H9e4iOm5aL4///TcCFc4rbGDhi0/QgyR",
-	"EPkEFlNkOXSE0pjOUATOjnptSRkxhkQALKla3rLksTmCoQBDGN5IfM4d28cKXHjWuWjxyyxJIJstKaeU",
-	"r7m8WUZ5jWAsJrOgFRyjMYMRirxyySl1YVldOCmDXwzaWMWBprGORy4pV/DKJ+Uq1Yk1LcWRpMqRrIcu",
-	"8VjuqQv0JUPcoz1prFqog+VOZeajUksAjscERSAs2oIRo4lataOuT0PhaqnX4Gp58/tWcINJVJ/Hr5hE",
-	"kllAoBfZqKjySdh9cHFyeQWsBlhLX5qunfkW2u4EzgAmIyun5ZNEJFJyivojjLFkazwbqmudQZUUqDrg",
-	"CBIpIA0RyNIIChR1QI+AI5ig+Ahy9M113Upl1JYo4x3/JU/ACAq41rqcKcT1kYCyK27OwNWv7U0kaE74",
-	"gOfMeoN96z7r6qWc1AylOTgyc1yaI86fWI2E3zOYpkjyb5qRCEAlirdDhiTdgKPLixZIaIRifZW+yYaI",
-	"ESQQB5gqeoEp7jhbkndudzpzQahvVHSXYqYvRiikJPLen1V7rSDOLUW3MMYRFrP8Ju0AIofRStngMMBE",
-	"7O0WlChl/TFiEoXoTjA4T72di0U1Ki5LODW9t+wYQKH3D8qvRcVNx+JY8S+J55SmWaw+DWfqa/e8B7hi",
-	"ChL3qr6cOZRMJ0kyIS9THi23Ji4v45UXwyHkaP9pG5GQRigC5yf94vevR5d/29mW4HRAH4pwYsx3kgQ7",
-	"OTvGKI6AvPi59DCPp2vGV1qS4UwgH29QXJ6desWaHok0kSmYWE4Tuo3W8Cpu/CWDsboaqouRHDfB5A0i",
-	"YzEJDnc8o2bYw92ve8d/wqo5QHA49gnu1+p7ft9Vxw1SovwNmgHdysGGue9hzrPycVmS8heSs9UfzJcu",
-	"/wTEVJilpe0SqWyAOzbI5gXNwTRl9BbGWxEiGMZbI4jjjCHAc5kyn7pjauANiwHwqLDie+wrTlX/NjZd",
-	"1qWiVoFNQKUEny/EUhtQcmCsRT2PxciWadlZLjd1N2MH/CrFSRA6FRkCXYU6FLXAMSJY/isx9BLiWAO1",
-	"/t0zh8h753TpxpnX8tRStzU8zALcZJtbS6PgMeA+tJ8GZcdDFTJN5rD1FCkkxqS5y4/3KyyvJZ6HrWre",
-	"T76WqcdE/pCOtd2sQtHCb5L82GratQVPipCAONYmCEoQgPJwEZaHhRlj6nIhJKOynjqSfV/kR/lCnPpt",
-	"zvJrwR3ktSlT1wgwonFMp/LC9GshU8gh3bsFuObIGHjlailPqUgKreZsDLXvibxmeywpkIsrBgnXGMVN",
-	"hmdZDwicII2LHFaRt0WRvpRJzBnuLyEhVEwQKzFZeftqy7781yAuz+46FK+zBBLAEIwUEzf1ANZHkcSR",
-	"XT84pJkwEOfgeYULOlRHb/RKKdOF13dLzr5jLx6dcV6z0MwX2JhCrqQQbWXLUj2sK3HvP/VK3AxB7nUc",
-	"A4+HDKPRE6BrFEK9HfMRX2qmD7m42aEaLmqm65aPlvKZFQu7OiNarCEsYaSlSJCOwBXLUAu8hDFHLWB0",
-	"Oq4OS5YHrUBVcLRWyympKtCZvipfbdeVz/lIC6fe4E9mfMkKwsOuJsOZopUxglZwdd5/h5i6AGjFli3Q",
-	"0odChBQ96lXDEHGOhzGq/mEZ3zlkXFW9nJFQ/XgnL6GyhmRgmejJ42jMEJdkcp1G0FgT5eFkq/azWOA0",
-	"RmdTghhXcN3iEB2jkCYJ5hxTZWdcbnVOCKNxnCAijCTrzLdWVp5uozDsdNFYJ8dlY40cyY01yuBcoJRy",
-	"LCibeVEvMd5YUFsftzBfq5cxQsKugvrDt2p6NZy10x/cFdRfll3HObQ/wuOqOecBwtYrLDx9riVlFafw",
-	"JQoZEpuS2zYF32shUl9f85Bd99P5z5bmlc/AN7oSlGUrZQZawoqkzUX6ZMe88BrwHuQpZT6PJtf1cnOW",
-	"S+Xm6VGDMNepZxMuOHWpQiPPK8gvKT6kme29T4nkoJaXFMRdXq9EV1vsw10YLSgwjRaraNzevQb+NRyf",
-	"69Obs9UZJSd3qWTnXjlXlgOUV7DOu5IsJRRRFivDDU4Q7wyIRIepgTn49Hdg/vfpELRBH5NMIH4IPv39",
-	"E0iMxnS7/ex5B7TBa5qxWtHuniw6hjOJ3j4lYlKusdPe25E1vEU7u07j9wjdVHvf7wzIZZbKrYMiIJcc",
-	"CiqBaMuKh7lSF5KZMVYZv2TZDSZgIkHO+0O3iM3Utydy3E/tT4fgApJx0Wq7ffBJIW5nF3T7kkoOQLev",
-	"a7c+HQLldGAr77R2dk1tLpQKaGdXTECicKjbbH06BJcCpQVYW7aNBqba4lJ7m5XnclCgRHKdA6fJgJxo",
-	"1z+JObDdPmjt7Ld398ySdpb2sj7KuKCJPvB7ZETn2RCqFx1lYtHRMREIVUfWC9usiheOqlbY6QQTTaFK",
-	"n6ruhGVb9nJ8RM+meSYjdb+ouVvIRmXDbTqZcRzC2LWmW4fLsmG2KfBF21tN50HZZBjDIYoVYkdShJPX",
-	"bOMTkjuUB6P93Wg0fDp6Fu2G0XD4fG/v+d7+7vDZaOdgtBui3f2D6Odn+0+fD6PwYHt7e2+0jbaf7j7f",
-	"hT+j0UG4p1jaD3PyD3Pyst0UV4QHqiBMR2sYij+uts1r/qh1V7INxXigZIiiCHkI/v0EiQmqeUHLPWAb",
-	"WXPWkFIRavnRIYIhpTGCpDmSpKJKc/0kFztEwmjml450SInRS1i/1+kEhxNl7lAtwdJ+lypuxXNQnOaj",
-	"2DrAavGafMc96rYNeQhjDlimHOWMd3BvBIYxJDct3+qxjFhPYeU1rPo0H7Q/XtWrd+NOvA/ahX639/tW",
-	"s3dloaEzVXIXvioqN+xsaVnFAvNX7mInidqhulah1Mz3aWv1gKcaTyn7kPmkIK4rWOqbKI+3ioOqxy2v",
-	"cvE0otfcXe9KR1pzbk9SpU8uxYd8M93yfE/FBk3zivjXCpEmlB85Zp1CmawxKxnZCI/rCGZIbncUNQZ8",
-	"X5gKNsS7sd9FZv3yOKvPnNPYcwCUil0p1SjS1eeQEoJCo17OaaWODK4vjL1jP0M1xaB37BovKiP46Uq3",
-	"7DuSTGW75AJ5Poo9+O1BI+E2TiH/KAXXhpAo4Y1rcz0mWGAY46/awJVHayOWYALjVg6zoLZZCyARNq0h",
-	"jM5IPNNct0LElVm1HASuuL6uTtUXHWZQoe8+0BJfVNbE5l4KtYUVkI2ReIDA5sJ3pTrzm2z1OA+YvNN5",
-	"/QzKPYT0BuRy2BoSEiQmNCpvU9eQck2QMhsoM0koKJtdIF4Cep45Yh7ETs/zqpVHnY+anpR0GBazowkK",
-	"b5o4X3PdKkco80ZsW4BQNgEpYnKXaV/INY+ltvdYKi7t1TE1RJs+jZoxssHjqLH7BfbNFdBeEK31Y78m",
-	"3Oq/XENfbmdahYx9EyhGmlfHhaG5Xg5dc5UC7iVx3WhCNuJWE4XX+EFa0WGpUSJ5LxezDdOcpKOVJbli",
-	"yygprpjJAhlO1s6xWj/HcSJF4yS1CKl0fqtaFpL8cq4em9upJkxVL6a9lYg02fiKbJYD1MFemgc0HlSO",
-	"6TjfSH4+sNaer+y/lr+8aQsvYBZ1PrFgf7/BIxTOwhitJd3HtvUGblBV/XLR+Tc9qyoI2OAx5eu5iTrd",
-	"3EY+3NbPI+2DYUik7BhQ/rIinVagrlJapbgEhafcB9qCaotp9oz7Y07cUqCLhkZo1QIyOLvMb1CNIpbf",
-	"5HxV6kTbm7WSi4HrizeLL6JN1thFM11nW55dLj2vd+XbtZ2bd6+pkmM8bgwBiVRZtS9tPgN8Anef7R/C",
-	"7U6n82RZfJUHXRF7ua/OSjjMLfOLRBeTzWRNvlMGzp64EeY3G++0SH6ywW6rbvBpFuQjmXk8aLnm+xDw",
-	"khOBXsByDrwi0vU9ZIb3HDEscAhjT6DtKiyyDKgbx1svLQb3lToA+YotkL6yhR6QjumogVNW+CRspHRX",
-	"c7pcAoDU+PVs0JGm7ClUc6jRCsFm6HT5pgDzupv5YOI0Rg12ktjiLRT4tlDpGV3WAwEsqy+9YYXlk3kz",
-	"OirlEv4QiM3ZXvWQqmgt5SRKHMC4BplVNrGcD0RhxTnIh0Rt2opWdG24yo1ikQpcw/KwKTtCVdyqoAgn",
-	"5zp7n9fmYUlJVQQmz195+jUziXZwsXBkBAsl0LRMGiydhkeK5DwbjfBdC+ho+QmK4zYXsxiBcUyHdjAF",
-	"vxodjiEmXNhoj3gGYgojpIdQMCXwzgZN7j7bL+Ul/H27/Ry2v3bbvx0OBu1/dQbqv98Hg4//azBoDwZ/",
-	"Hwz++fGnx/+9XL0n/3w8GHR+1xV9xd7sh4tTjGh/hQektHFcgU03murvVzsxC8FmZceauprSfxfjTg4R",
-	"czwA0zaBSieCY21+DUUG4yK256GniRFlS9mknGP+oZyx7qbg2d6wblvbzJAVK+by4ZH5eukUM8psby2a",
-	"EufeMCt3Kb5JSKR7+q5/UhUmRqW2MxqNzSm3rJLuEiGyTIiWoUAdkYSIDTc2HBs8Pj27OjnUZvjcy86k",
-	"NWNIZIyUQpSfLKnVM34InzklbTwmlKHc8SDXCGxO3bGJ8zrv6GE+zd47mzxjH7zxaptNH3rWvXLdXotO",
-	"ypKAn5+VDtrNcDINQXRNsGjmYca37sHHVNSgwHUYVwmxZe4Z+JmpSzPuns8ZiqLOYhIFNbi7YcV75voe",
-	"JA5XmEAWTSFDyt1Y+0NjMra+TqWs7Jv3LDEw2BjRb+Zb4sHXBvWjK+XV8qvqz1Tsjz+F1gUaUmoirc7p",
-	"FDEUnY1GJV1+dwqxUGFjxo9CBxqOYhyKc5jxFVWnpQk5oNXKHGg9peVbf6nInZOnuDRNT3lVmVsq9CHD",
-	"U62KnwVrXOK0yzmyn9kMvGYzOclu0F1KeXGsKpe/zoCcwHCikpaElDHEU0oiHTpd3Af1rjLPN4QwhUMc",
-	"YzHrDMhil3g9idKmDGkc68zyud9zo7grgWx0c5JiR1d5Lho/J+8edl2ZG/pwakgpUQdqGDzVHPaLniU9",
-	"+fyOXlAqQO94la50xMHap2ot8kGKJpax6iXwT/0s576XlvsuCXPVDdrFco6aOhSt8pquyPZqt74F/jap",
-	"qqnsFgkkcKzj9NUBoI9F9c5BGGeRLFHpJ813J/1uRKfEXMPlgWWyrHjM8abepY5XWk/S1DPMu8iFkI12",
-	"er8O1qO1zCka+o2aON1jXHf/zY/xEgY2eIzX+13ByFmgNrdwplf0GKocQmeZOBuZ307Y9Doq+xKQzhCe",
-	"UndUb+NK/Ha5dKFWHvObhUGVm4ljbP3FojO9TM6odhR30x0o/ob5jc5AtspzVxFmSLn45e9dWbWw7L7c",
-	"5/y5rPrmzXE2L/dJAu9wkiVFjkEYx3TqRltoH2FBQWiSt+sXavIGBSPPs5MDqCLiqGQZt8avBTGT78Yk",
-	"+dW6DP2QQRG9mX9USbQOwSeuAyG5zpLYAp8S/UHHNsoPE/1BRXF2yi/JPP7n4e877ecfB4Po70/+ORhE",
-	"v/Nk8nH5Z2VOSEjlybWM6ykydTWhKsdjtbJQwMqDXy5HSWOdzlknKFw6/YUe6tw0tn+/MJ00T6eSGqM+",
-	"p1qVOdlpTW44SRrax7WkzFsv+LEOYjnWzYQ67uxF+3u70cH+3s97IYQogvtPI/h0+9nu6Pmzn0cQ/vx0",
-	"dxT+vP1se3t3/+enB8Pw5+fb+8/Cg4Od59HOcNuNews5Cw6Dtvzvxcmr3ik4Orm46r3sHXWvTsDFydvr",
-	"k8srVTog/V7vxYvPRy/Y296L7vGLN/3rm+nF9MPxu7dvj0+2u3f93be7/a+/3Jwdf/h6+vX084f3L+Pf",
-	"Xp3snr66mJwed3cGpJ98eHZ6FSUf3p/snR7/knz4Gk5Pr7rT/ucPe6fHE/zha/isf/xh58PX8dP+VXzT",
-	"f9+b9l/eTE+mH17/Sn/rDcjXz9tH3bcfevKvr5+3j7tvw+O34+7J6xf9o73t04tfrn7ZO31/FiP8/MP7",
-	"mxf9rf5Xenr8ata/+DX7erK9NSDhrzez/3n3C7p7/WX7rkd2dz8cnZ7u/XZ8enc3fb//Jn473sOfX5Hb",
-	"S/H2bLjf7fa79NXR0ZdXl/2nz190+0cD0t0ed/sn10e9t8eX7A7v37Do6NfwzdEk6r/Ym/7c+5Icx79N",
-	"Lk5eDV/3j04u35F9zs+7vfFvb356y34R0wE5uPiJPU0x/HD7241g/GZvdtTLvu5Nej/H9EPyP+d70cE/",
-	"BkSh/eT0eM6S/Iha/RG1ulI3NQ6zgQDWep9/QtLjhiRIMF6Cq9uqRXY8/1Ug5/GOpcYwe/W6XWNwCbTZ",
-	"lOYkF506kbD2YJlADoYIEWA78Ae+FuHwaz7R17XpZHVPSiGTpvGsSP3fkEag/kqanufDl80xmK4vbDcT",
-	"RV3KXQDJItpwrK8PpZJug8+ekgahMGHGLp1MIS+RyHIu6bbFi6YY53KotKzLlnopz/bacqe0RJrKRUuw",
-	"hgncg/h8gTrLU6Vf++Otpg9Cp6IGp1b3Ebdeqsr663Fa5My/Lr583W5eYa4zrLmE5tn/Za+EB2YpaAXq",
-	"Mn2xKFT0ShHt3HBRdc6byLXOZ04JeGzju580RHNsmvvZXJfWfqxeVnYYIua5xXmCCJCCh0NmmPvYdQPH",
-	"lIu8PrNs0pE1VFxtA9U6aeJY+ozdHHN2XC0WpcB2t0I9D3Zn5ezW9bS3yI+Hv2q+aveBYS/GQl2ol3iE",
-	"ffEg4bz26ooOBLoT4PH11cv2wRNAWfVFAWcQFZeM48a1kPXsjX1NMnK0EsvrmiWimoOtZWkeXl3H0JjR",
-	"LPXjR871EQeqRsvRASGspDtoX38z79IihkPQOy6/hzcIGKViEMzNiLEg9UVCIzQXwhQx4wmqnvfogA80",
-	"U3cuDbO2xyTyhjSCCY4xZICGAsbF87pQKXi+IkZt2q3t/adPFT1AfQaGODENdFC2r83T3e0n8tInMhxt",
-	"cSTG8h+Bw5sZGBrFF8hjpFTSkdLTfzr5SGUySiMj5ykZdYFXCZ4/R0pm3lZsxBadqvclvuF6ftNnCiU9",
-	"b0B5/eDny0u7br28lENO40zMeQA9ZyurqIX9eVNr+ZDGWFxIgP1yjZPqEoJXWJR9nM3LF6uoya1y3OSR",
-	"GOGxTfVQZMptSClkixeL9kVXpXda6s62Slq9QLd4nmynSyXQGXee55oLby0RSA58bdRWk8J/3kPi7mwr",
-	"wQVLv0xnVn7pg7j6lPO3yzO7Xh7WcAKZKPKwTlCcLExUpVTuKQznB93ltXwpqswrglKaKj/CnMzaME3b",
-	"xRCe8ZVacY6kr/OP1DxynS2oe/DmzrJmF3niDLFgkOF4Boh5X8i+UsAryvwc3e6OC8gYkztFvOPgMNjp",
-	"7O5oR1mdX/rwjwAROIwlfWmQJ5QLrihD/goO7QidkCaG5HWxZhXBlvmoLTHBOUMjfGefEGVITeqIZlKG",
-	"22sF5hqjWA1lIjg82M6RexRnXCDWO/eLThpfkmvP8f+2SFXnba46ijJtHyzWG6h+TH60GCpjmJqa6+ig",
-	"XpXSQSMsQgwM0UjKICphptm0kRmxtBS/G1hlpSjTeXZmMIkDO4k2vUWM4QjxziyJ5UZe9iWkTSfrbUhc",
-	"XTtsJkKkS542BLy+ujpf8ryRC3XuPXPkV3vC6BvuI72u1sFLUEf7ZKX8qgu1AoWjW8Qca+iUYSEQefBp",
-	"xeqnlT1sbLq5GQnBnHNMB8n4Js/yG8v1xRvzphVNJMmOhNHMyouOiiIGPaHSKml/HgS+ZEhZsxlMkECM",
-	"A56FEwD5IRgEW5LKtwTdsqaLf6ra/1C1fWLh3BMxX74//xC0FLk0qc99+2dOduplT7Gzo57nAVrfuZPC",
-	"8GYph4IHb++5zzzXo7r1a79KUCpmk8jmKALQuekoXjknWvwhL2Vfqu2ijFL62FijO92TmnhjiLjufTVU",
-	"nmdx7Hv8uTc6peJc67NqT0Bbz9TyReyR2+ZRB7yfIKKUf7KsG0/hjD9qOWnqMQdpFsfyuqdSTwucoEqr",
-	"U1lSapRkXAAY6xSg6nG35qRFesygVZ2M6nVJ/weJn7wf+UelL/nJ9DcXz35qJVRo8cZ1H1KLeP9NKfFh",
-	"ORnqHXoCq92sE+YkoSN5li544NpzgyuR6HrTd8h8hYe5F0Orn+lkaIy5YLMOuJLifgJn8lCGRfZap6F+",
-	"xSp3MZNcyXbWkodsTMlY/msuuJQlvM6Pues0tswJt/LD3/NfgZt3uGh//znBue7BYaScjUXHF/qTBWKm",
-	"hvIhB1HTcyuHq2MkF+71I591jrY+bnLdlCck6tuKM40obkDo3BdxakjdFOzyAidHW1bx5Lyepxv+m+qv",
-	"MyLWvKWUbOQaB85NxMhWjRqOxWtWoHVVFUmhG1nc1b+vTtqz1Vy9TrG0HxeZR03rYgMsvVf7Kr/Nj0dx",
-	"XJQseyc5hQmKTBZzvV3KmgU5VY9caGS0mlz4ba4UK14lHH+5ehhbXiYl/jyrslIAwDgGKWIcKz1gkXFK",
-	"SfkTeItahtcYRQBXLTQwKgM8M3X1wemxoRNCRZFiYU0fhqKyfpOzFADvf//FvL6dp7uc43SkMxgoT80p",
-	"5Pah7+U9jSIUo3XGwnGsQqJk81XGG8954rQLOPqSqePSPOJQ8lN1XpJ1nj/NXVR0QmXtsQPOq++b6/On",
-	"Ay4QjNqUxLMlX0Rd7MLiJGLZ31sYBtmHKnmp8ca9QTOtYjWKWq3YKqcEp2wMCf6qg6RDKNCYMvnnYx7S",
-	"VH/lKEaheGJp20tUy52fun4tv4xvXuqM8y2i40YMhTwKuTZk2O8tKSEMlFvplhx7EJgnvJqe4FCtGh2X",
-	"ugTQFH7JkEWqGharZLh50INWuD7iRfiq4+IEiTPv+kGxHBc7hyKcOOEKudQ9N6tMmeHQht1nYie18c4k",
-	"XHZ1FTCKAm1d0Mc4Qwm9VduvnPDPeb7PK751wS+XZ6fgXEsNuVbLb+3xg6r9y83bz1TtYAlUp3ZI0HSe",
-	"xbCeGsWD8rcZjGIkfrwZ+eMZ+UYauTDmLP995aIcM2QsX+rC4lfpNu2belt7D7cHzykVhiNCYpSPklmp",
-	"+vY0pbeIOdaYwgTLWbiFSYTuOp/5A3iUFUq7MWLiwgQRp81ZBOrznJSz5Fd8aZVmX/btd2xtjO+zkX9S",
-	"xhNW2pDIcC6L8BYxOEY6+BBg57klY4dUA2My7oCX6jQ5nB/X94g/KgfsPUoelQP2Hk0eNQbsDQbRT80x",
-	"eiliISKiMQduUS6xpmekXVsZHo+lXOrDpJaJtNbhFq2d1qlEBJemJ38osx3GWbvS5MrCzce1yLAEQT10",
-	"0ZTWqMsefd68pCrzwXIq+kZYio4bqzgjNtbRoCzChM2OKOeP5fwTTKD5YF7TlT+Pzq+blrrhmdlWcKyS",
-	"4fobNcVRt4K+yXbrb9d8gy9umLNTHQHpXq2l1Lj+MdMwxbUOmHkzWMflvgGR8pxq1DMsSRRz01v4I79h",
-	"yV2scqm1vH9eSlBVCTBZqwPOSDwDlJjNJ5kXsJxBedRrnrqZNKHFyeRLFCqPQ0zGPSmdeuPH8oNkiMQU",
-	"IZLHrqumcjJ/wtmQR3I3HRBzlE8td308M16axza+NKLDCLAWeETGjOeVmk0IYxtkEVHyyPqPAG11cm6X",
-	"3zCONfR6R19m47H291IuLAau0DoUq/uljpBogW2AjSeyVt+6t/y9Xe8t/0fw7EaDZzn3ij7LiI9u7hiJ",
-	"R3vzbXoFlPvl1ASGE0xQ41DTyawygFxoY7kYqCdLMoYGgX01A/QMQJoE1BuoqVCqJYZ0wspy3M4txLEc",
-	"uAO64EKBCcIYMq2RsE5h3MYvRggMM7npkE59aR3iABYL8tjMS/VWIA+cESlqHoJBcJmFIeJ8EMjbuTPT",
-	"b042PEVhG5Ko3fgQyRJByPl7mIpN5BRQEN3yDFLnfeyqvOESb6jZtjDB40k7ljPVmSdVsvEinW7Jb0Qn",
-	"IlegxVTFVChzZv55ZN+ksZ2oChEq/ZlAyZwIJEadM2KIT3RRtlLinvosuxaQetGFA3G9tFfMoV6Yv7TT",
-	"MKCdWL34uDzzeoV+CRc+qB3s1IsXJhMyTU6U3+8CQtDOweUgNEURNo7KUoH1Im7ZX22WEaMTjzG5UUX6",
-	"h1MCYwy5Wn6ua+gfTg05Mg712xB2BEx0zp4g166rzzoVlnY0GsLIIZ1WsBr1OKg5yefVWHaRA1uv8sZO",
-	"valoXuOuwU69pG/x1VQ0r9tLi9J60XGB5Hphr0B7vfCVsxAeAnOWpl76AvpbFYknPbiXp9FCGn9DYbSA",
-	"wiUHWIK+uciGkoKpycBJqGiPaKZ49BBGbY6E2dDIJOJMEBs7NL0uJ8uncKkhqH5+YyGqFpxS8dIAWC16",
-	"AaPLHN5qoU0kWv3et/OpFVSIMS9YlhM5qYjrr74XjO0h2Y6rp17VTOU9BJtlN+vVq5JklRSXZykil5ev",
-	"jf0FRBAl+rh2rEvbTw88Ig4qiPshM62y9XtNtA/ut7yVlO/6MO/Ut62mWoBoKSQZXw1rziyEiBxxLCPE",
-	"ygKFffFp+aIJ21+328/bH3/yaiHlQA2Py9t3Kmyk5yDgfBJ1jFF6EDypqJ+dwoVimxq2TE/l1XRXoFWi",
-	"aAeLS8txVzhBv1HrImc9kN9QrZervx8JvlKCCvMg4+bmrwi41z3tGmMk6F6cdLfenB11r3pnpy0wVZK+",
-	"/FjONCalYUxUSDUDNESQtJRN1rbMnYBUpjnIBA6zGDLAsUAq/lZdOqAAkCHYUlvJIB50lX8Q3DpF0399",
-	"oOymBU4yyQ22ziHDVjGTEZgM8TijGQd77XACGQxVSISdayVOFzweBK/6V4NArvr11ZFZ7OXyzF3XUpBW",
-	"PXdHmFiTq6mlc7BlgsqrUZgnUVV6KhL50q8KnNhS68mkpkMzX6KD9fxXjhglJ3dyHa3iQb25/4rBELmJ",
-	"CFdXBdrG961AOLS5ckc5YdfuRSLwQrv0lnF9d+rPddedivQKGq+jZW1iup/UcQgsHJpVnLqhSNW5XuZy",
-	"2FiwhUS4peL15JVz1IkOGV036eS984itgiNUU0cJxHFwGAgEk/92E/4F1mFC8cuXqgQcyYsvjcEVgomU",
-	"X5hsaq/KpdY1t4/fy118fOxr9sQom0xuE5WVEYUxZNq0qBIXo8RkbxjFCAl11UfR2HpbamcSMUGYgSll",
-	"N3KjcZ2eO8YhIhw5uQm7KQwnCOx2tmuTmU6nHaiKO5SNt0xbvvWmd3RyennS3u1sdyYiiTWRC7VcFSR1",
-	"z3tBK7jN0ynaDIo6IbWkw+Aw2Otsd3aKMMo/gi0nR4hJlmN1XzpI0pcL8kg7PkNwVDS+1I2L5JCFPjxX",
-	"i/SivHFjy0CTF+LiBY1mlRwcjjP61mejidJ7ej2e1AjEfZnMjSOuztLO9S7c3d75rtD5liSSq/10e/vb",
-	"ApbnsKtB8QJGIAdSQrLzvSC5JjATE+W4ZZCy971AeUnZEEcRIhqO598LjvJLCAqY3e8GzBWloA/JzJKL",
-	"ynH07Pst0qU+A65JrkjWbh5wrC7DjVwy+CirzeGiW39I7n+vQg2Q8HnEwEiLb7nveuPGrzPTV0jM46RF",
-	"DK6yAM93RVzMzIGgYKxtPFj2YHKMmOPNOKiXuWbLWa6q0iEj+EuGetqGqR3mP9aY7PZfiMme/fqDqzVw",
-	"taffC45czfSDn22Qnxnp1jCvLZunsJGLvULCpCQwCQ1NUsBmMfAVEjZFos6fuCq70q0MSyoPzqu+Gpvh",
-	"WPf1TKn5+3tK05hD8K54FUcNq3IcFON6E0TOG/dPZ4tmSRp54K7e8NWtCJyIzr8Um/ye7AkU/On7CX9/",
-	"XbHP4UqaafhZUGGBT6EI/aGQNszRydx5vIgPqWalDK7r8SFXRlIQbornfFzlQtxWQ/+0gTUshVcsdR3+",
-	"zizpx7X3h4C4mAP/kBArEiJoEhFzZtwK0swj8l2bB8BWZbgXOkBpwyy3eJLrT+e5G+RrP1jsvyOL/cHa",
-	"lpfqiuTwy5sZCPA9PjXfvuB7LupP29L1wf8K9oQGqH7YEX7YEf5DrpJ/aXmqxvkaOeIik8ErJFZliq+Q",
-	"8HHElaSu5vE2ahf4DtqupTjjD+X/j7vdfzQvutfpsS0z0A4qWzDFW7c7OtWebl1/+d5wGg4oqd7NlIuR",
-	"YQRGEKxr0cs9NPMZt7P6FO4/3v//AAAA///9KDrr1eIAAA==",
+	"H4sIAAAAAAAC/+x9i3Lbtrbor+DynJkku5L8StzEM2f2UWQnURvZjh/pSavcBiIhCTUJMABoW+l45v7D",
+	"/cP7JXfwIkES1Dtp9550z+zIxGthYWFhYb3wZxDSJKUEEcGDoz8DHk5RAtXPkDL0++3eCAm49ztNEYEp",
+	"/r074jTOBDqHYiorRYiHDKcCUxIcBRcoZYjLvgAkAJq6YIxjBFIopp2gFaSMpogJjNQgqbefqykqWssq",
+	"QFAAdT+UADFFgM+4QEkHnFKBgJhCASCZAXSPucBkoqve4TgGIwToLWJ3DAuBiIQA3cMkjVFwFOzcQrYT",
+	"08kOTNNOTCdBKxCzVJZwwTCZBA8P+Rc6+gOFInhoNSAmxe8R4wr+6nS6531TBiI0xgRxNYVb/Q1FQGMd",
+	"0DEQU8wBs2iEsgP5GRKgx++AS8RkQ8CnNIsjEFJyi5gADIV0QvCXvDcucSaHiaFAXABMBGIExuAWxhlq",
+	"AUgikMAZYEj2CzLi9KCq8A4YUIYAJmN6BKZCpPxoZ2eCRefmOe9guhPSJMkIFrOdkBLB8CgTlPGdCN2i",
+	"eIfjSRuycIoFCkXG0A5McVsBS+SkeCeJ/oMhTjMWIq5WhWRJcPRbYBAbtIJxjCdTEYpYDlZ8Dj5WV6kV",
+	"3Ldl8/YtZAQmkrJ+C4oFeZ83Lb69sn33qa/4JEnFTA50357QdoUmGikgvVIVfdQsu9DriwBM0xiHam3d",
+	"iauNyFHQCj5nMIqRCORAREBMEAtawRTFydJzV6D08h7Nh3d5x3mNon/z6Y0aZs4kLeyyLSJCzhfG8dk4",
+	"OPrtz+A/GRoHR8F/7BRsZcfQ4463w1c4Rranh9YaHVygGAp8qzmS7IGhzxlmKJKIUOzlY20PLzO9E3L7",
+	"HjLNpEosCxUFMIqwrAvj81KVGsGUCeKE3GJGSYKIALeQYTiKEbhBs7badyCFmPEWwEQCiyIQZbIbwDIi",
+	"cII6QNLTDZqpHaxbIBhOQZJxIbndCIk7hAjYUxX2nx2AcAoZDAViaqNVcLECh8txc06ZqNO5/AoSmKYS",
+	"WkzAmLIECjAMppQLWXiUk7P8axiAx6gz6bTAMHi++3z36PnuMHhSZtDmuzw2oBCIyWH+93AY/XAk/+8/",
+	"6/x6CdgZvcURYi8h9+zVHk0SSkCx4nIWAMaxu2vVbub1wwwWHGBlMrbc46EVyG3ccCY6MMha+Zrv/b//",
+	"83/LKw1iSiYtwAVkAtxhMQUQxEjiEFAGSJaMENOHgFkUQCi4k+yapzBEHe9R6G4tO9mP69CPWYPLFIVq",
+	"pljONMEECsrkB0NFWgzRTKwBmYbHOZ2X2GZjK1Oh3E6x2IYmki+Wa1s23dDAMFu3zUNOMbNTtcg5Fh9a",
+	"ASVoXSbqQcJavNQL8lrweNC7Vk9VrC/Fui+MXPEWJ1jw+lay5SBWFdQW9xzM5c0dppmHXZxf604kv5Ng",
+	"8Q54pdkeQ3LjKAY+ghxFUnCt8pAys9vt/PjMx9ESlFA2qw8+UN/N+GqL01SfRkCKZRtAsv/sMNlIFq4t",
+	"xbxVCCnhgkFMll2KOF/XddhsA5WsNb1LAUXG/WKfLlPSO+CYTOIy/zb3mAjdYs1urRx4zlAKjWx3Kdm3",
+	"/nmREaJ/nTBGpcB2TW4IvZOcSO7/GAkULS8flmfgjlkrdIColRVQ1YosmLWCAu5akTORJbB/zRGry2cs",
+	"I13uPz8zjhQSrCSuL5Hqs755uQtkLlgjJCUvkJEIMSl7YQ4wB4QK3YPsDer7nOpG7kNM1GU0P4W4R/IH",
+	"j/HY/j2K0ZMOOEZjmMUiv7cZqKAeCE4QERISLod7PEEEMRjHM8AoFU8AHiuQeIpCPMYo6gQ+QijuMtcG",
+	"E+7nNr/BadvykHZK1ZUxOBIsQ2vtjvc0zhJUviCUF+XYXIehEmcicKtayKlHYDRTSoR5jMAvKV0T/DlD",
+	"wF1ot1+zQh4uU+O8DIUxxMk5jXE425TfaGxclLqsClVqQh6J6s9NpIN+AidIj14SvNY6kAc0I2JbnSnI",
+	"Gnv8uNRh72lZYwl6+T1M4S3mQnFoZ2OaypIgsEAJ3866B8UGgozBWbDBjrqokmWkWYc6VAXEkrX699mU",
+	"3jncZApJFKvdZ/bH3RTpjUHvJFcvoUXppRJ6q3mLPazMeB/XuIjpuWi+P//03ApXOK2xg4YtP0YMkRD5",
+	"BBZTZDl0hNKYzlAEznr9tqSMGEMiAJZULW9Z8tgcw1CAEQxvJD7nju1jBS4861y0+GWWJJDNlpRTytdc",
+	"3iyjvEEwFtNZ0AqO0YTBCEVeueSUurCsLpyUwS8GbaziQNNYxyOXlCt45ZNylerEGpdCCMS1Grc3hXGM",
+	"yMSzAr5aQMkZPEOR1n3rNZC/9ZUeqt0oEIBFY8BoRiLPhqBeeu6CMUN8ClRxIWmYkZRqAZMwziIl4XzO",
+	"YIzHM0nGERRQXniw4OC8dwE+Z1Qo8tAan+AoGM0E8u2uNGQNohkmEbpHuaR03rvgfpDywXLmbEbBRKAJ",
+	"Yl5GW9pYChsGluU3VYHmC5R69V+1KiCEjGHFYHM0AY4nRPOxYmaPeGkZpXIPE8AQTynhatEhAT4qqa81",
+	"ukVEvKUTP5aveq/BiFIBVDUQ04lFuAakJQVJeAtxLIXSpZa0gbjkaKrIDhDmlD1C6mwh/A4xFC01SDPd",
+	"aFOFHUShOFLUs9n5fR6y97LnOjVJvQ8VDTO+Oh9c/t69ujq5vAJcsEyZPgBDImNm0a/OB/u/v1t6w0hq",
+	"gbKTxvGufr/svz7tXl1fnChDV4GHFkhghLRmQH6skNgS4zfsHI0AF7hVd1NPEuxY8k90iSdS1rhAnzPE",
+	"PbuqsWphJuOKG+qPSl1rN1lYtAVjRhOFhl7Xp7l1rXdrSHt584dWcINJVJ/Hz5hEkqlDoLFrVPf5JKx8",
+	"cCFpx1rG9K1UI9OZb2EFlBY8TMb2/ppPEpFI3d/UH2GM5W7n2UipuwyqOBC0A3qQEKp0yFkaQYGiDugT",
+	"0IMJinuQo69uA1Sq9LZEGe/4lV8CyvNmrXU5U4gbIAHVXjJ3g9XVmU0kaG4+Ac+F2C32rfusq91zUjOU",
+	"5uDIzHHzbZgr5ksk/AuDaYqkXCvlDACViqIdMiTpBvQuL1ogoRGKtYrxJhshRpBAHGCq6AWmuONsSd65",
+	"3evMBcFzvN2nmGmFEQopibx6RdVeG85yC/otjHGExSzXMDqAlDghJuJgP2h5pAp0LxicZ/arSiQFFVeP",
+	"kIo9UHYs2bPaP8VpVmiALI4V/5J4TmmaxVAUgkT3vA+4YgoS96q+nLk08OAkyYQ9z2uEwZoYrzxfRpCj",
+	"w6dtREIayXP1ZFD8/rl3+R97uxKcDhhAEU6NW4MkwU7OjjGKIynMQJce5vF0zfiWPhwRO/Ve9/ok0kSm",
+	"YGI5Teg22vKluLGWazGKlMJIjptg8haRiZgGR3ueUTPs4e7X/eNvsGoOEBxOfAqNa/U91wOq4wYpFYcU",
+	"KnUrBxtGD2YuGpUtsTw5W73q/Fv3N0BMhVla2i6Ryha4Y4POoqA5mKaM3sJ4J0IEw3hnDHEsJUGe37Xz",
+	"qTsmWN6wGEoot95NHruzU7XhcqW7rEtFrQKbQAnr+UIstQElB8b6CuyxpNsyrVPQF1lnM3bAz/KaDUKn",
+	"IkOgq1CHohY4RgSjSGPoFcQxikpUuY5VUg+08IrozGt5aqnbYDfzjGnyWXhobdaZdWzZtJ8GJfCmiuom",
+	"N4H1FMwkxqS5y48PKyyvJZ7NVjXvJ1/L1OM6tEnH2p+gQtHC76rxsdW0awueFCEBcaxNs5QgAOXhIvJr",
+	"fcaYulwIKFDuwSjZ90V+lC/Eqd8XR34tuINznx7TOKZ38sL0cyFTyCHduwW45ubuq1ZLKVEiKbSaszHU",
+	"PnlS/eixMEMurhgkXGMUNznkyHpA4ARpXOSwirwtivSlTGLOcH8JCaFiiliJyUZQoLbsy38N4vLsrkPx",
+	"JksgAQzBSDFxU0+q0xTFk0m+fnBEM2EgzsHzChd0pI7e6LUyMgqvT6ucfcdePDqTvGahtCuwcQe5kkK0",
+	"90GWUlKaOCbi8KlX4mYIcq9DLXg8YhiNnwBdoxDq7ZiP+FIz3eTiZodquKiZrls+WspnVizs6oxoseWk",
+	"hJGWIkE6BldMuv++gjFHLWB03a5uX5YHrUBVcLT5yynvK9CZvipfbdeVz/lIC6fe4GdrfGwLwsOuJsOZ",
+	"opUxglZwdT54j5i6AAQtt0BLHwoROPZVDUPEOR7FqPqHZXznkHFV9XJGQvXjvbyEyhqSgWWiL4+jCUNc",
+	"ksm1VL8Yr48UhbbqIIsFTmN0dkcQ4wquWxyiYyQ1L5hzTJX/xXKrc0IYjeMEEWEkWWe+tbLydBuFYaeL",
+	"xjo5Lhtr5EhurFEGRyrYORaUzbyolxhvLKitj1uYr9WrGCFhV0H94Vs1vRrO2ukP7grqL8uu4xzaH+NJ",
+	"1cy9gbD1GgtPn2tJWcUpfIlChsS25LZtwfdGiNTX1zxk1/0X/72leeVL9ZWuBGXZSpnHl7Cuq3rmZMe8",
+	"8KbyHuQpZT5PT9clfXseHbJXnxqEuc6O23BNrEsVGnleQX5J8SHNbO8DSiQHtbykIO7yeiW62uLYlsJo",
+	"QYFptFhF4/budXxaIyCkPr05W51RcnKfMsT9sVuyHKC8gg1qkGQpoYiyWBlusPQYGhKJDlMDc/DpH8D8",
+	"79MRaIMBJplA/Ah8+scnkBiN6W772YsOaIM3NGO1ov0DWXQMZxK9A0rEtFxjr32wJ2t4i/b2nca/IHRT",
+	"7f2wMySXWSq3DoqAXHIoqASiLSse5UpdqXrSxioTryG7wQRMJch5f+gWsZn69kSO+6n96QhcQDIpWu22",
+	"n39SiNvbB92BpJLnoDvQtVufjoByxrKV91p7+6Y2F0oFtLcvpiBRONRtdj4dgUuB0gKsHdtGA1Ntcam9",
+	"cMtzeV6gRHKd506TITnRLtESc2C3/by1d9jePzBL2lk6+qSXcUETfeD3yZjOsyFULzrKxKKjBiMQqo5s",
+	"dIpZFS8cVa2w0wkmmkKVPlXdCcs+PsvxET2b5pmM1f2i5oYmG5UNt+l0xnEIYweC3BG9bJhtCgjU9lbT",
+	"eVA2GcZwhGKF2HGMVKyG9ZXLA22C8eF+NB49HT+L9sNoNHpxcPDi4HB/9Gy893y8H6L9w+fRj88On74Y",
+	"ReHz3d3dg/Eu2n26/2If/ojGz8MDxdK+m5O/m5OX7aa4ImyogjAdrWEo/rjaNq/56dddbLcU+4aSEYoi",
+	"5CH4X6ZITFEtOkTuAdvImrNGlIpQy48OEYwojREkzRF2FVWa6z++2FEcRrMGJygVamf0EjYe4G6Kw6ky",
+	"d6iWYGl/dOX85zkoTvNRbB1gtXhNMTUedduWIicwl4EKkgOZqIn+GIxiSG5avtWT0RWQF9EUqk/IHT/l",
+	"arTD1oMbNtqF/nCgh1az13mhoTNVctfmKiq37IRuWcUiD0nreiyJ2qG6VqHUzPdpa/VA0BpPKfvW+qQg",
+	"ritY6psqT+CK477HXbly8TSi19xd70pHWnNuT1KlT3Zp9+vplud7cDdomlfEv1aINKG855h1CmWyxqxk",
+	"ZGM8qSOYIbndUdSYCOPCVLCpLxr7XWTWL4+z+sw5jT0HQKnYlVKNIl19DikhKDTq5ZxW6sjg+sLYP/Yz",
+	"VFMM+seu8aIygp+udMuBI8lUtksukOej2IPfHjQSbuMU8l+lpAMhJEp449pcjwkWGMb4izZw5VksEEsw",
+	"gXErh1lQ26wFkAib1hBGZySeaa5bIeLKrFoOAldcX1en6ouaNajQd5/csz4qa2JzL4XawgrIJkhsILC5",
+	"8F2pzvwmWz3OBpN3Oq+fQbmHkN6AXA5bQ0KCxJRG5W3qGlKuCVJmA2UmCaU6/gLxEtDzzBHzIHZ6nlet",
+	"POp81Oj4kd4UhTd8xYurbgpC1VabOFUwQgo5B3AsEAOQmCsPmEIORgiR3O/FbnBTrveK3DlYc0OeKVPF",
+	"OIuVqCSmaAYiqgI61QBy0+IEtVxeKXHDVWCR1ZemDN1imnFQYY91CjbeSFdThviUxtESgitHYSbwLQJj",
+	"ZagBSHINc+zq+UsQJgyGCKSIYRqZz1rSdWaPuTt1053U78gZqRD18KYcDHvg9c9MMMFJlrg+eo4oqyA5",
+	"V4D4Z5cb7Q0+MQcTfIuIXp2QJlbKmDnza1jgjnJPkvslZXSEuF28kGZEADiBmHB9dTaYB8Ki3iZ3qWJP",
+	"Z3nJHViLWOQ8wleKyBAoK5hcFzN34xthAuX0LHUygELTl3/UDleP+COtztQOtS3wKNEftBqMawesR1P9",
+	"USn9yuvzTDtQFAlaHv/z6Le99ouPw2H0jyf/HA6j33gy/ejN1qKk8FsYNwUF6dI8p424oyXSM4xJo/1f",
+	"CWd7u3x9pOnp+lGmy4DI8YQ6oBvH9nvBtSxTKgLLykzJUP9mNxDNN8/l2Er7gklf97W34Apiprji4deX",
+	"C8qwmCkm3yTbNtetynxl6RfbFvockDtVciXt7b7mxaPtvXgUatnqmBqibd83mjGyxQtHY/cLPFhWQHsh",
+	"ltgIzmvC7R52XTlyT4JVBBXfBIqR5tVxYWiul0PXXKWAe0lcNzoJmQt1E4XT8VyKdkLJtkxw2nihSiNE",
+	"BBazrzDAypqAYkMqLUCBpwU6AFk7X7M6v8aJxGKSWnRXOr9VLQtN0HKugtvjAyb9iyYVq9USabL1Fdku",
+	"f6mDvTSHabzoOK5H+Tb1c5m1OEpld7f85U0MYgErqnOhBdzjLR6jcBbGaC3tUGxbb0EDV7VPFp1/1ZOw",
+	"goAtHoK+npuo080Z6sNt/bTTPnyGRMqOZeUvK9JpBeoqpVWKS1B4yn2gLai2mGbPuD9m0S0FumhklB5a",
+	"wQLOLiuysIdu/S5LV6VOVCVjNWLg+uLtYkVmkzfPopmusy3PLpee1/uydtbOzbvXVMkxnjSGEEaqrNqX",
+	"dr8AfAr3nx0ewd1Op/NkWXyVB10Re7mv50o4zD27FglGJkvgmnynDJw9cSPMb7beaZFUcIvdVpZLIiMf",
+	"ycxjo+Wa74PGS05oegHLuaWLDDK/QGZ4T49hgUMYexLYrMIiy4C6+XHqpcXgvlIHIF+xBdJXttCD3nE9",
+	"aOCUFT4Jm68AbgKcpRJrpcYvdIuOmGVP05pDpjYoNUOny7cFmNdd2QcTp3GT+ia2eAuVhspUtnacDQEs",
+	"m7+8Yenlk3k7Ng7Z87Sie1+z25IKX0YqbdKZERmqjrsVY5rETYmxGI9VQzxG27jhylR8Vn1roz0uohUN",
+	"F1e5r0ak4qlxqJWDjn9uxdsXinB6rtWSXlO8pVBVERgFZnn61SYm+6mFIyNYKDmpZbLW6qyZUtLn2XiM",
+	"71tAJ3GZojhuczGLEZjEdGQHU/Cr0a1q3QQhxjMQUxghPYSCKYH3NpZ//9lhSeH62277BWx/6bZ/PRoO",
+	"2793huq/34bDj/9rOGwPh/8YDv/58YfH/71cvSf/fDwcdn7TFX3FXk3u4oyA2uSwQQZKJ0LFdGOp3qae",
+	"3ySV/blyLJUj5JnsjX1M+VsCgZI0huoVDwYTpDKTh+q2Z739IQd//gk6KmtE3kXntDs4AQ8PHSBD9nme",
+	"51xl8QBYaYbGWHJscE1ifIOAcvQ02aBaOSzmg/JrHCGVE13SVh5KGgGB7rV7MWRIGW2U6Vvr9mMUmghK",
+	"tW864H0+RVn7BqWiSJmYZ/LSE9f2OW1hJuguR0SrNFh+JVf58ksYk2hECUfxLdoobX/JSXAdd926atx/",
+	"Q+dOxkYjNADTVqJfMIhjVRGGIoNxETG8qYxh6M+pXBL+Nj0v686PHu4M6x472xmy4hu1fNKFfL0UzrUz",
+	"YGE1g/7gbXcpvkqiBVcmW19+KRyXjAlR6bm2p/K0qttLhMgygd+GAnWcMyI2iYk5cMHj07OrkyPt3Jf7",
+	"7psk0m5uOJP45MmSul7j3fgHp6SNJ4QylLsz5nqi7SnBtiFu5R1tFinlvclLEWnjjVfbbFpmsUEb6/Za",
+	"dFIW5Pz8rCQnbYeTaQiia4JFMw8zHvsbSxlRg1rfYVwlxJa5Z+Bnpi7NuHs+ZyiKOotJFNTg7oYVtQ/r",
+	"+6U6XGEKWXQnD3x58OsoKylUaASA0htY2/dXNTCYs/nreax68LVFrflKWYz9BpwzFVHsT1h8gUaUmvjt",
+	"c6qykp6NxyULT/cOYqGC0Y13pk5fMI5xKM6hlBlX0haVJuSAVitzoPWUlnVBpSJ3Tp7i0jQ95VUVf6nQ",
+	"hwxPtSp+FqxxidMuFx53Zt87MZvJSaGH7lPKi2NVBRLIgD4YTlUqtJAynV830glZiuu83lXmsbwQpnCE",
+	"YyxmnSFZHGinJ1HalKG0hajHV/JoqkZxVwLZ6DwtxY7uRL0ZVjj21eBxA6Qa+nBqSClR3z9K+Yi9PUt6",
+	"8nkzv6RUSDfmFbrScYxrn6q1eEopmljGqpfAP/UzWwlcWu67JMzV4CoXyzlq6lC0ymu6IturXdoXePGm",
+	"qqb2Q4METoq7q/Fc5C2T0VuWqJur+e44y0X0jhgtirqpGhfHGrHaepc6Cno9SVPPMO8iF0K22unDOliP",
+	"1jKyaei3avh2j3HrQPyVj/ESBrZ4jNf7XcH0XaA2t3unV/QYqsyEZ5k4G5vfTjKWdQw5JSCdITyl7qje",
+	"xpWsMOXShbYazG8WpmrYTnaE1t8s54OXyRnVjuJuugPF3zC/0XlNV3lcOMIMqcCB/HVh06Xqvtzn/Lms",
+	"+sLocTYvo1oC76VTe+HEDI1nch7DqSOPBAWheSrr23k9H4FP/FPZ7flT8qns9vxp+slxeV7bw9mLuhMS",
+	"UnlyLRPQgkxdTai5TlcF/pSfV3Y5Shrrx3N02uOlk2rpoc5NY/v3S9NJ83QqCbfqc6pVmZPz3mSclaSh",
+	"I2cA3DilQh3EcgS9SaCwdxAdHuxHzw8PfjwIIUQRPHwawae7z/bHL579OIbwx6f74/DH3We7u/uHPz59",
+	"Pgp/fLF7+Cx8/nzvRbQ32nWj6UPOgqOgLf97efK6fwp6JxdX/Vf9XvfqBFycvLs+ubxSpUMy6Pdfvvyj",
+	"95K967/sHr98O7i+ubu4+3D8/t2745Pd7v1g/93+4MtPN2fHH76cfjn948Mvr+JfX5/sn76+mJ4ed/eG",
+	"ZJB8eHZ6FSUffjk5OD3+KfnwJbw7vereDf74cHB6PMUfvoTPBscf9j58mTwdXMU3g1/6d4NXN3cndx/e",
+	"/Ex/7Q/Jlz92e913H/ryry9/7B5334XH7ybdkzcvB72D3dOLn65+Ojj95SxG+MWHX25eDnYGX+jp8evZ",
+	"4OLn7MvJ7s6QhD/fzP7n/U/o/s3n3fs+2d//0Ds9Pfj1+PT+/u6Xw7fxu8kB/uM1ub0U785Gh93uoEtf",
+	"93qfX18Onr542R30hqS7O+kOTq57/XfHl+weH96wqPdz+LY3jQYvD+5+7H9OjuNfpxcnr0dvBr2Ty/fk",
+	"kPPzbn/y69sf3rGfxN2QPL/4gT1NMfxw++uNYPzmYNbrZ18Opv0fY/oh+Z/zg+j5fw2JQvvJ6fGcJfme",
+	"C+N7LoyVuqlxmC2kxaj3+Q2eUmhIreiLbGqsWuTc9V8Fch7vWGoAyntrDlmFNkfjnJTld05+DXuwuIFu",
+	"zD79Vk+nUSTZWdOK3LVJ6nVPSiGTpvGseGitITlRbUXNPDdfNsdgur6w3UwUdSl3ASSLaMOxvm5KJV0x",
+	"J2QSCiek09KJNHy5JLJcoIJt8bIpc0o5AYusy5Z6l9z22nKntETy60VLsIYJ3IP4fIE6y1OlX/vjraYP",
+	"QqeiBqdW9xG3vssSap8rK2f+dfG9AuK+VsB13laX0Dz7v+yVsGHuo1agLtMXixJQXCminZuEQp3zJh6+",
+	"I22r4LHNGvOkIcZn29zPZtC29uM7HMcuQ8Q8tzhPkX6GzyEzzH3suoFjykVen1k26cgaKq62gWqdNHEs",
+	"GK9FQYtYv8TCooc13K1Qf12js/KbGfVk+siPh7/rKxivcIx6lAhEGg6RUBfqJR5jX5RQOK+9uqJrr63H",
+	"11ev2s+fAMqq7xQ5g6ioexw3roWsZ2/sa5KRo5V4eFgFUc0pXGRpnrSljqEJo1nqx4+c6yMOVI2WowNC",
+	"WEl30L61LbFGsgQxHIL+cTk4fRgwSsUwmJtna0FCrYRGaC6EKWLGP1g9GtYBH2im7lwaZm2PSShDYAwT",
+	"HGPIAA0FjM2bDiBGUCl4viBGbTLP3cOnTxU9QH0GhjgxDbQjnq/N0/3dJ/LSJzIc7XAkJvIfgcObGRjZ",
+	"cP/cTU/l5yg9tK6TclQmozQycp6SURd4leD5M69l5iX7RmzRO/Vq1Vdcz6/6KLyk5y0or13u8tBas4N8",
+	"163VQ3fEaZwJdA7FVPVQUwbnbGUVtbA/G3sty+IEiws09lMKcxNoQ/Aai7KLunlPaxU1uVWOm+xUMlbC",
+	"JJAq8u83JCq0xYtF+6Kr0utvtT61tHqBbvE82U6XSqAz7jz6ORfeWnqxHPjaqK0mhX9TUsnqbCshJ0u/",
+	"A25WfumD2M28MSe593JAq2wcrTwrmLaam3ugKjN5fPzrhhNEM7GGwQPayIEi5UsJon+pJDlrW0N89OC1",
+	"mm3wGIRDLfKxhDWfWSg6uQo37+PkHoUbd+I4Pm7cl/sYhHw6bImtV3k/otG7fSkFiK2syV7vuxRyjrh6",
+	"IDCOwR1lN9Ing3taKTJmGSH68e1IZ55dSp+SQ7kO91HLWJs7uvcpNPQFIUkg0XwmI67DCiQzANkkS9QF",
+	"MpO8AXABSQRZpIOFAJ8RAe/9CBJO5+hebmwlScobA9hdjAcF8ToIUBuqhoCp+VpHwPXFWzl5jtQTu69P",
+	"rnLFkTQqNM0sfyx+qlje/v29vUzLCfr9RG5wqo0d751EIx7L6g1OS7lILHVpgeIRB1dvLxvuvbmWuIJN",
+	"Nf11sOlu6BpSeblw/o5y3ayb8SpLlUpFBWQuJhMXhHXmJzlnbV4ibKAVGEUM6QyBU8rFkTqXzQMUykdA",
+	"uWfOJRwnn6nKQ4givniWEqAVZhcn3+YhnfUemgmnUGNNq9amKE4WZuJW1v8UhvOzQuS1fDm4QYTSmM4k",
+	"NytZ6INk1oZp2i6G8Iyv49qalY46wWotOMi5DegefIDlYpAUfUZYMMhwPAPEPKBsY+d4xa8gR7cr/Adk",
+	"gsm9nAGcSE+Bzv6ejtnRD2jJk4BI57fIgizJmCvKkL+CIztCJ6SJkb51sb61BDvmo3YKCc4ZGuN7ezwz",
+	"pCbVoxkRwdFBKzAaVbXDKBPB0fPdHLm9OOMCsf65X4uj8SUvkHNC0SxScYwcK5bJ8eisN1D96CMZxVAJ",
+	"p2pqrs+l3NVQhx+zCDEwQmPKdH70trk/RGbE0lL8ZmCVlaJMJxKewSQO7CTa9BYxhiPEO7MkDj466sTF",
+	"EapbfY2o4WUu72m55MWXgDdXV+dLXn3lQp17r7/yq73sFqGdOA9jlmMVhjCrcKxGcylQOLpFzLmd3DEs",
+	"BCIbX5xZ/eJs7702n/6MhGDOlVqHW/smz3LlqRJH1KPdNEFustcR5Kq0A/pCxfRqSQ2BzxliMzfgl2cy",
+	"VpsfgWGwI6l8R9Ad60XxT1X7v1Rtn4Zq7uU8X75vfx+3FLk0qc993HjODX3ZU+ys1zepdeTNWcpiMBTe",
+	"cyeF4c1Svo0bb28154Hkvp6X4epph1QdrbMpZpPI5vIm7yhdFa+ck85ovZen9fiXarsESoWdkfXyfOue",
+	"1MQbcxjp3ldD5XkWx0UqAPuS0VHQH59Sca5Na0GrIUimrI945LZ51AG/TBFRdkhZ1o3v4Iw/ajnv8GEO",
+	"0izWqZ/l9hY4QZVWp7Kk1EiF7cNYv3GiXq9vztmpxwxa1cmoXpd0xZT4yfuRf1T6kp9Mf3Px7KdWQhU1",
+	"lD2Z1SI+fFVK3CxpWL1DT+YfNy2aOUmkgY7IfdiWoMcYElHnLx5lcolE15u+Q+Zq7obTLWCCi6FVAhVg",
+	"aIK5YDN5JcJ5aghYPM/jNNTPdOfe7pIr2c5a8pBVCSUgB0bXTlnC6/yYu/7ry5xwdr7Lr/HcZ+7nHS6q",
+	"4bw0L+7BYaScraVvKkw5C8RMDeUmB1HTe7JHq2MkF+5DhpSZvsrR1sdNbibzRGd/XXGmEcWtYPUnf4/W",
+	"szIshr0VcDXasjawAkqgG/6LmtIzIta8pZTc9TQOnJuIka0aNRyL16xA66oqkrx4ia7+dc3jnq3m6nWK",
+	"pf24yFPLtC42wNJ7daASMH5/9ddFybJ3Epl+KjLPtOntUtYsyKl65EIjo9Xkwq9zpVjxKuG47tcj6vMy",
+	"KfHnz0YpBYC0MKWIcaz0gEVKVCXlT+EtahleYxQBXLXQwKgn7pipqw/OOi9W8RJFtqd1U5LlldXNpJyL",
+	"x//ArYQHU5LnY1/wZIxuqbye9VRWcHqOUIzWGUu6gMrobNl8lfH004l+w450PP2cqePSPPZTCpmBueQK",
+	"il4Kb1n9YpR2HgbnNM1i6ETS6/OnAy4QjNqUxLPqIz6HT72eUIu9aZ2UfocHCzMyDKDKrq+LZeYHrWI1",
+	"ilqt2Cq/eUbZBBL54pmsF0KBJpTJPx/zkKb6q04N98TStpeoljs/df1apkLfvNQZ51tEJ6IJCnkUcm3I",
+	"sN+lDRUMVYTLjhx7GBgTUdMbo6pVow91lwCaws8ZskhVw+Y5+XjZNJhn0nC8rSFx5l0/KJbjYudQhFMn",
+	"cjKXuucmuCszHNqw+0waB+1HZN4bcXUVMIoCbV3QxzhDCb2VP0Q5I3WBVb+PVRf8dHl2Cs611JBrtfzW",
+	"Hj+oqkiCCaNICjkGqE7tkKDpPOelepY2H8pD9t4PiS0BWC+/hoqOCxee896FFVQv33Tb+88OwQiSG09e",
+	"iTm5xPNuxVT3qOS7KbrPPX9t37qXjv/JqQjd+/tXRU7/PmfN2t1U9tayYC99CL/LYBQj8W3sopt0dkJu",
+	"30PGN+5HSs8bd+IR2Tb0fJqrll8L3PnKGGmZXJZGLoxp0H/3uyiHguuq+vLnV4838aB6W6vTsIf4KRXm",
+	"dIHEKHIl41f1rWRCbxFzLFuFOZuzcEftks4ffAN+bwX8boyYuDC5YdLm5FD1eU7LD25VQqTkfKHs2x+v",
+	"1Ji2wSZ0sKxPSW4SGc7FG94iJjWBKqcEwM7b3MamqwbGZNIBr9TJfDTfZ3GxN2LZE7HseTgcRj/MeVwO",
+	"sRAR0fjgRVEusaZnpEhDMDyZIMa9mNTypdbg3KK1s3WWiODS9OTPUGOHcdauNLmyoPhxLTIsQVD3mzKl",
+	"NeqyYoT3EQKV0Go5c0cjLEXHjVWcERvraFAWYcLmLFdnt5x/ggk0HxKYpiawpnd+3bTUvTTzXd9bwbF6",
+	"+cLfqCk9TisYmKct/O2atSHFbX12qhNbuGqKh9Ymx0zDFNc6YObNYJ1IygZEPnws76iSzmZJopibtcyf",
+	"0AeWogAqCgLL++cl6leVAJO1OkA+Nw0oMZtPMi9gOYPy6tM8dTvJ+4uTyZe+Xx6HmEz6jQ+e5geJfezU",
+	"IAWopoh/k7Mhd0lf0hvdJYqWuz6eGS/NYxsfLVTf1boZTxtzI5CzCWFsY2cjSh5ZXxygLXjOTf0rpicJ",
+	"vUFvl9lkon3nlDuQgSu0cWLqrq59dVtgF2ATYKZV4bVnj+sak+85UbaaE4Vzr+izjPjopgTEvNAiNOhZ",
+	"IPfLqQkMp5igxqHuprPKAHKhzeV6qN4nzJjU8mh4zJvimBsSwBygJBWyD8TUn4SWw7FvIY7lwB3Qlfo7",
+	"TgkIY8i0dsc62Dne5WCUyU2HdEZz61wIsFiQnnBeBt8CeeCMSFFTeo9d6ofSh4HUdDgz/epkw1MUtiGJ",
+	"2o2vDi6RW8ZM3LCJnAIKolueQWon867ySZd4Q812mimeTNuxnKn2ZVeO7MUrCSUfHFWmQZNhJFqExCT/",
+	"PLYPUNpOVIUIlf5MICYCEUiMamzMEJ/qomylfIz1WXYtIPWiCwfiemm/mEO9MH9Ws2FAO7F68TGC8ysM",
+	"SrjwQe1gp168MEekaXKifKgXEIJ2tC7nFlAUYcPjLRVYj+yW/dVmGTH2hRiTGxTlP5wSGGPI1fJzXUP/",
+	"cGrIkXGoH4KzI2CiUzEGuaVCfdYZTrXT1ghGDum0gtWox0HNST6vxrKLHNh6lbd26k1F8xp3DXbqJQOL",
+	"r6aied1eWpTWi44LJNcL+wXa64WvnYXwEJizNPXSl9Dfqsgn7sG9PI0W0vhbmWt4PoVLDrAEfXORjSQF",
+	"U5NYnVDRHtNM8egRjNocCbOhkcmvniA2cWh6XU6WT+FSQ1D9/NZCVC04peKVAbBa9BJGlzm81UKbH776",
+	"fWDnUyuoEGNesCwncl6YqMc7Foxtk0csqqde1eTnPQSbZTfrIa3CwEqKy7MUkcvLN8aWBSKIEkoqlrrd",
+	"p889Ig4qiHuTmVbZ+oMm2o37LW8lFQcwyjv1bas7LUC0FJKM34s1DRdCRI44E2VaRtXh0/JFE7a/7LZf",
+	"tD/+4NVCZo0hfPnrcTaBxzDgfBp1jIF/GDwpA+MWLhTb1LBleiqvprsCrRJFO1hcWo6TTgC/UutuaL25",
+	"31Ktl6s/Fg++UILc58/MzV8RcL972rWxf92Lk+7O27Ne96p/dirdMhBD6mM5gWxIicAEESHlaRoiSHQc",
+	"u22ZO1TJyilkAodZDBngWCAVPYuJMQswBFtqKxnEg67ytYI7p+ju9w+U3bTASSa5wc45ZNgqZjICkxGe",
+	"ZDTj4KAt4/BgqMJL7Fwr6VfA42HwenA1DOSqX1/1zGIvlz74upZZvuoFPcbEmq9NLTUlmAkqr0Zhnhvf",
+	"hlB7suoLnNhS6xUGTAYEj/JhPV+gHqPk5F6uo1U8cAGZeM1giNz80qurAm1jk7bB0ubKHeWEXbsXicAL",
+	"7dJbxvWDqi3hoO6gpVfQeHAtaxPT/aSOc2XhHK6Cxg1Fqs71MpdD8IIdJMIdFfsor5zjTnTE6Lq5xB8e",
+	"WvkDAgqOUE0dJRDHwVEgEEz+283jHFjnE8UvX6kSIH2tGY3BFYIyjjJjsqm9Kpda11xofit38fGxr9kT",
+	"o2wyKeskYiIktQbatKjeo0CJScqlXjpUV30UTaznqnbMEVOEWZHIQL+6EuMQEY6clNPdFIZTBPY7u7XJ",
+	"3N3ddaAq7lA22TFt+c7bfu/k9PKkvd/Z7UxFEmsiF2q5KkjqnvdlsGSeJdsQoXlnRNJhcBQcdHY7e0VI",
+	"6p/BjhMCb3IgWt2XLE6pz62hp53IIegVjS914yLnd6EPz9Ui/Shv3Ngy0OSFuHhJo1kltZrj2L/zh9FE",
+	"6T29Hk9qBOKhTObGqdnmK1Co2d/d+0uh8y1JJFf76e7u1wUsT01cg+IljEAOpIRk76+C5JrATEyVE5xB",
+	"ysFfBcorykY4ihDRcLz4q+AoP3ClgNn/y4C5ohQMIJlZclGpK5/9dYt0qc+Aa5IrkrWbB5yoy3Ajlww+",
+	"ympzuOjOn5L7P6iwDSR8HjEw0uJbHgfQuPHrzPQ1EvM4aRHPrCzA8906FzNzICiYaBsPlj2Y1HHmeFP/",
+	"VLlmy1muqtIhI/hzhvrahqmDDz7WmOzu34jJnv38nas1cLWnfxUcuZrpOz/bIj8z0q1hXjtQCMRFkRLM",
+	"lxnvUplOtdMQ4XeImfhWpy0IpzCOEZloj/QJEjYNVBYr427+RGrlpbLjPE6xzP4utOOwLu46QK7I+nQH",
+	"CmDVyXY43MPDx28ozDrTv1C5DpcTYr8yf214qrqZt36XXT1c/q/kruA7e21kr6CJv2r+uZCZ7uQMsfmy",
+	"bYUwCAglIWqPIEeRw0nHKoAioQKVWK2KY2jim/q2WGObvRyavxP//JZ3bi8yFl23vzOK74ziazAK++hH",
+	"493xdS5A6Yr2hY1m5dtrJOx7I3rPrr/TJ9XBedVDdltcoOU18EEugLLv5hC8L56YVsOqLF3FuN7XVlbj",
+	"Pt9EWGqWjvb1/q7uPODkJPnOjerc6K9Tuf19lW1LsqDC7zGFIvQn87CJOpxncI4X8SHVrPQc0np8yNVM",
+	"KQj/kptbWw39wxbWsBQg/Pe5v32/sH1Xy32XB7+pPNjya9muzWv6qzLckqZsayy3eN/+X01b5vK17yz2",
+	"u07s34O1NUl1xUuLyzt3EOB7yX2+V4fv7fVvtqXrg/8dvDgaoPruvfHde+Pf5Cr5t5anapyvkSMuctSQ",
+	"yrYVmeJrJHwccSWpq3m8rXpj/AXarqU443eXi+93u39rXvSgH3ixzEC7Bctw3p3bPZ0sGk58fOLMchou",
+	"Ex9V7mbKsdswAiMIPrTm99DMZ9zO6lN4+Pjw/wcAN+GUE5ADAQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -16,6 +16,9 @@ type GetRenderedDeviceParams struct {
 // CreateCertificateSigningRequestJSONRequestBody defines body for CreateCertificateSigningRequest for application/json ContentType.
 type CreateCertificateSigningRequestJSONRequestBody = externalRef0.CertificateSigningRequest
 
+// ReplaceDeviceAttestationJSONRequestBody defines body for ReplaceDeviceAttestation for application/json ContentType.
+type ReplaceDeviceAttestationJSONRequestBody = externalRef0.AttestationReport
+
 // PatchDeviceStatusApplicationJSONPatchPlusJSONRequestBody defines body for PatchDeviceStatus for application/json-patch+json ContentType.
 type PatchDeviceStatusApplicationJSONPatchPlusJSONRequestBody = externalRef0.PatchRequest
 
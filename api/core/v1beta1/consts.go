@@ -34,9 +34,17 @@ const (
 	DeviceAnnotationRenderedTemplateVersion = "fleet-controller/renderedTemplateVersion"
 	// This annotation stores the hash of the device spec that was last rendered
 	DeviceAnnotationRenderedSpecHash = "device-controller/renderedSpecHash"
+	// This annotation stores the OS image of the device spec that was last rendered
+	DeviceAnnotationRenderedOsImage = "device-controller/renderedOsImage"
 	// When this annotation is present, it means that the device has been selected for rollout in a batch
 	DeviceAnnotationSelectedForRollout = "fleet-controller/selectedForRollout"
 	DeviceAnnotationLastRolloutError   = "fleet-controller/lastRolloutError"
+	// When this annotation is present, the device failed remote attestation against a reference measurement
+	// that requires quarantine and no new specification is rendered for it
+	DeviceAnnotationQuarantined = "device-controller/quarantined"
+
+	// The common name of the enrollment certificate that an enrollment request was submitted with
+	EnrollmentRequestAnnotationEnrollmentCertificate = "enrollment-controller/enrollmentCertificate"
 
 	// TODO: make configurable
 	// DeviceDisconnectedTimeout is the duration after which a device is considered to be not reporting and set to unknown status.
@@ -66,6 +74,10 @@ const (
 	FleetAnnotationLastBatchCompletionReport = "fleet-controller/lastBatchCompletionReport"
 	// A frozen digest of device selection definition during rollout
 	FleetAnnotationDeviceSelectionConfigDigest = "fleet-controller/deviceSelectionConfigDigest"
+	// The time the last batch was completed.  Used to wait for the bake time before approving the next batch
+	FleetAnnotationLastBatchCompletionTime = "fleet-controller/lastBatchCompletionTime"
+	// The template version that was deployed before the current rollout.  Devices are reverted to it on rollback
+	FleetAnnotationPreviousTemplateVersion = "fleet-controller/previousTemplateVersion"
 	// The requestID related to an event
 	EventAnnotationRequestID = "event-controller/requestID"
 
@@ -83,10 +95,26 @@ const (
 	AuthConfigAPIVersion = "v1beta1"
 	AuthConfigKind       = "AuthConfig"
 
+	EnrollmentPolicyAPIVersion = "v1beta1"
+	EnrollmentPolicyKind       = "EnrollmentPolicy"
+	EnrollmentPolicyListKind   = "EnrollmentPolicyList"
+
+	ReferenceMeasurementAPIVersion = "v1beta1"
+	ReferenceMeasurementKind       = "ReferenceMeasurement"
+	ReferenceMeasurementListKind   = "ReferenceMeasurementList"
+
 	ResourceSyncAPIVersion = "v1beta1"
 	ResourceSyncKind       = "ResourceSync"
 	ResourceSyncListKind   = "ResourceSyncList"
 
+	SecretAPIVersion = "v1beta1"
+	SecretKind       = "Secret"
+	SecretListKind   = "SecretList"
+
+	TrustPolicyAPIVersion = "v1beta1"
+	TrustPolicyKind       = "TrustPolicy"
+	TrustPolicyListKind   = "TrustPolicyList"
+
 	TemplateVersionAPIVersion = "v1beta1"
 	TemplateVersionKind       = "TemplateVersion"
 	TemplateVersionListKind   = "TemplateVersionList"
@@ -129,15 +157,16 @@ var KnownExternalRoles = []string{ExternalRoleAdmin, ExternalRoleOrgAdmin, Exter
 type UpdateState = consts.UpdateState
 
 const (
-	UpdateStatePreparing      = consts.UpdateStatePreparing
-	UpdateStateReadyToUpdate  = consts.UpdateStateReadyToUpdate
-	UpdateStateApplyingUpdate = consts.UpdateStateApplyingUpdate
-	UpdateStateRebooting      = consts.UpdateStateRebooting
-	UpdateStateUpdated        = consts.UpdateStateUpdated
-	UpdateStateCanceled       = consts.UpdateStateCanceled
-	UpdateStateError          = consts.UpdateStateError
-	UpdateStateRollingBack    = consts.UpdateStateRollingBack
-	UpdateStateRetrying       = consts.UpdateStateRetrying
+	UpdateStatePreparing         = consts.UpdateStatePreparing
+	UpdateStateReadyToUpdate     = consts.UpdateStateReadyToUpdate
+	UpdateStateApplyingUpdate    = consts.UpdateStateApplyingUpdate
+	UpdateStateRebooting         = consts.UpdateStateRebooting
+	UpdateStateUpdated           = consts.UpdateStateUpdated
+	UpdateStateCanceled          = consts.UpdateStateCanceled
+	UpdateStateError             = consts.UpdateStateError
+	UpdateStateHealthCheckFailed = consts.UpdateStateHealthCheckFailed
+	UpdateStateRollingBack       = consts.UpdateStateRollingBack
+	UpdateStateRetrying          = consts.UpdateStateRetrying
 )
 
 type DecommissionState string
@@ -160,6 +189,8 @@ const (
 	RolloutSuspendedReason = "Suspended"
 	// Rollout is pending on user approval
 	RolloutWaitingReason = "Waiting"
+	// Rollout failed and the devices were rolled back to the previous template version
+	RolloutRolledBackReason = "RolledBack"
 
 	// The name of the preliminary batch
 	PreliminaryBatchName = "preliminary batch"
@@ -182,6 +213,8 @@ const (
 
 	// ResourceSync New Hash Detected Reason
 	ResourceSyncNewHashDetectedReason = "NewHashDetected"
+	// ResourceSyncDryRunReason indicates that the changes were previewed but not applied
+	ResourceSyncDryRunReason = "DryRun"
 )
 
 const (
@@ -44,6 +44,12 @@ func (d *Device) IsRebooting() bool {
 	return updatingCondition.Status == ConditionStatusTrue && updatingCondition.Reason == string(UpdateStateRebooting)
 }
 
+// IsUpdateFailed() is true if the reason of the device's Updating condition reports that the agent failed to
+// update to the desired spec and will not retry.
+func IsUpdateFailed(reason string) bool {
+	return reason == string(UpdateStateError) || reason == string(UpdateStateHealthCheckFailed)
+}
+
 func (d *Device) isRenderedVersionUpdated() bool {
 	if d == nil || d.Metadata.Annotations == nil {
 		// devices without a rendered version cannot be out-of-date
@@ -3,24 +3,25 @@ package v1beta1
 import "encoding/json"
 
 const (
-	redactedPlaceholder = "[REDACTED]"
+	// RedactedPlaceholder replaces sensitive values in output.
+	RedactedPlaceholder = "[REDACTED]"
 )
 
 type SecureString string
 
 // String implements fmt.Stringer interface, used by fmt.Println, fmt.Printf, etc.
 func (s SecureString) String() string {
-	return redactedPlaceholder
+	return RedactedPlaceholder
 }
 
 // GoString implements fmt.GoStringer interface (used by %#v)
 func (s SecureString) GoString() string {
-	return redactedPlaceholder
+	return RedactedPlaceholder
 }
 
 // MarshalJSON implements json.Marshaler interface
 func (s SecureString) MarshalJSON() ([]byte, error) {
-	return json.Marshal(redactedPlaceholder)
+	return json.Marshal(RedactedPlaceholder)
 }
 
 func (s SecureString) Value() string {
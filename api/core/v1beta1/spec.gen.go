@@ -18,415 +18,587 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+z9iXLctrY/Cr8KTp9TZXvv1ujEcVSVOn9Zkh0l1hBJTv7Zkb8ETaK7EbHJDgBKbqdc",
-	"9b3DfcP7JLewMBAkAZLdGmzHPKdqx2pixsIPC2v8exBls3mWklTwwc7fAx5NyQzDP3fx/JRl1zQm7HxO",
-	"IvlTTHjE6FzQLB3sVAsg9XVEOMIp2k05HSUE7eYim2FZA50mWIwzNkOPd3dPn6C5rouiLB3TSc6g1Ppg",
-	"OJizbE6YoATGgef0DUvq3V9MCaKpICzFCdrdPUW7p4fozdlr2YJYzMlgZ8AFo+lk8GE4wLmYZoy+hz6C",
-	"zZ3s5mK6jUqFEUnjeUZTEWw7SihJxWHc2KYqhA73G5o4JxEjokszHEp6m4opnyd4cYxnpN7S9/kMp2uM",
-	"4BjLzdFlUYpnBI0zhsSU2H3xtk5SWVFPdYzzRAx2BMvJsNLRL1MipkQ2SDlsjt1typFuxOlglGUJwans",
-	"wRS8gC++pZB1UDaGbSKpoJHaJ3fcJM1ng53fBhjPB2890+BRNlfUVW7+NeVCNq1XWxVDIkOM/JUTDitO",
-	"BZlB1Vqr+gfMGF7A39kVaSU2KNRGZB+GAzkCyuTS/1Zeo6E5IR4qd8bg0GmF3uxyFCuVjf4kkZBz2B3x",
-	"LMkFOcViWp/HGZkzwiV4yDOPdVk0pglBcyym9dM897Yj18PWlkXkmmPVTpYCWfIFF2S2jo4zQZCYYoFw",
-	"ukDkHeWCphNV9IYmCRoRlF0TdsOoEATwhLzDs3ki57VxjdlGkk028Hy+nmQT70rX12BOfyaMw1BrIHh6",
-	"qL+hmIxpKsllStC1+o3ESCGqJCo4C8ysmCJaScYpUl2to3PCZEXEp1mexBIYrwkTiJEom6T0vW0NSFJ2",
-	"k2BBuChg8BonORkinMZohheIEdkuylOnBSjC19FRxiR+jrMdNBViznc2NiZUrF895+s024iy2SxPqVhs",
-	"RFkqGB3lImN8IybXJNngdLKGWTSlgkQiZ2QDz+kaDDaVk+Lrs/i/GeFZziLC3eN4vTUiAm8NhoNxQidT",
-	"EYlEdlb8XD+sw8G7NVl97RozCVNctlNsyM+2avHbS9P2Yeb7fDCbi4Xs6N3aJFurHeLd+bwdeuTa4/k8",
-	"0djjzhHuUy6P5V85jhM4X3INMU0JGwwHU5LMOk8ThrJnW9Q//GQbtiWK9vVP30M3aj5mmLIYSeGCwUly",
-	"Mh7s/Pb34H8YGQ92Bv+9UTACG5rKNl7ShJhKH4bNZc9IggW9VkAhC5cAS/5Yh5fK+A7S658xUzBRAg1S",
-	"fMBxTGVZnJyWitTvwdLmHaTXlGXpTF6e15hRuP6uyGINjgOaY8r4ENFUjovEKM5lM4jlqaAzso7k3l+R",
-	"BRwsVYPgaIpmORcSb0ZE3BCSoi0osP31UxRNMcORIIw7aG6m7ccYuwynGfMwAfJXNMPzuRwYTeV1PcMC",
-	"XQ6mGRfy446lMvnX5QA9JuuT9SG6HDzffL6583zzcvCkjIb6d4nRWEgEGewM/n+Xl/G/d+T//I/v/neH",
-	"qS+hF5h7TsteNpupS1lvEvAXOEnccwPniftYPnsGm0jOHNUPw0HqZXcuysdU8Tlm07b+3////1PeKpRk",
-	"6WSIuMBMoBsqpgijhMiVQRlDaT4bEabAVS81SjN0I2GQz3FE2u9tM6+3LQRQZbupnNSMplhkTP6gyUD+",
-	"08BNYIk0djiNl+AoWEsXKNcD6ApUkXhTLm3gL1BBg5hb54OlA82+2gX7MBxkKemAWJ75tgGXdyBtvXjW",
-	"p61SdYWq6Hemb8zXdEYF9/Fa6jtKoIDl1yv3UPkkRfPcczZP36hGJI5EGZPswEsFJ4xI0gUMHGFOYsl9",
-	"VQ9sGUQ217/52ocUMzLL2KLe+RH8rvuHQ5bNFaAjyXDcYiTbXz+bdWXoaqvetOBRlnLBME27rnpit7AF",
-	"vgJ73zboc4FFzv1sivoGjCXiNJ0kZQjU3HRMrqlCLMO3nDIyx5oXOZcIqP55lqep+tcBY5lkMN6kV2l2",
-	"I0+4PGwJESTuzs+UZ+D2WfvoDKL2rRhV7ZMZZu1DMe7aJ2ci5YV+wwmrsyMsT3e5/7bJOYH5GiZRvVrg",
-	"Z8X/u3uh2fwRkYwGylP5eEUXshTlKM2EakG2htWrApqRZ4am8PqxQM49TCl6TMfm71FCnqyjffVit68H",
-	"PSqsOsITyRyxPOWyu8cTkhKGk2SBWJaJJ4iOYUh8TiI6pqXXu7PnBUf9Rq+E+/Mav6LzNXPe1+DFKxdY",
-	"sJy00fzPWZKrO77gXcvrv6/fXxju+RhdQw05yxiNFvBAbTq0fhbiTUr/ygly99RtV2+GBxFqgMhIlGA6",
-	"O80SGi2WwAY18bNS7SpjAWP3cBV/d7w2D2d4QlRHJeaj7U47yvJUrFAP+gtWflu9Gj2FaodS7UqDTMc9",
-	"GrpwSZyz1HbUxT2dyPesSgNWiDY4I/IoD4YBop5mN84pneI0ToDUNTHeTImiwuxGAmNpsiB1mGXX6swa",
-	"vNf9vW1m8tWwFUo23zV3ctqOa8cscJTGhJE0Ir5LW38yIBeTeZItSIxO9g7X5NYmFKcCUUmBkq2Xl8wY",
-	"RwKNcHQll66xb9+5c8fTwtnz83w2w2zR8QIvv5Z4+PL+nuBETBeD4WCfTBiOSey9sI8zdyzL39rl4Red",
-	"Bos4owmW8VzY5QLei7tcpDoxueq5mO6BZqGOFbgk0Gs++LYkyBTgtBogaqZfXbhJTF0j7IxNcKrlt/zA",
-	"lbX7hOul0ggzYiTr6q1d6rdZ2N4Em5IIrzFNQGQSmMwSSJqLqV0/H4iW38t29b0HKxfT/UWKZzQ6cZZi",
-	"l3M6AVGPR1zbVgVh+CcH5gg4pfIqF2+RXEwdHZaEdY8kQ8F9UOb9w/nJsZV3S6KB8oon08yd4vzcQSAa",
-	"yy0YU8KMjOe3y8GEZfmcXw6G6HKweTl4K7Htt8tBlHORzdTPGZtcDt4+WU6J4fYsyfuUkTF9V767arfW",
-	"BeiRZEH7YCrNANgpK5/K2GRNC6caT4Ts/jwfd+ueQ8Eu3a/Buvi7F62i4FLD2NKRi86xIjjPXVuhd6H0",
-	"OQXRtFD9WZaQjtReLorIO8FwJDhimeQjxiybeSka5RzYiYJSb0/jsssNIFdN7nUifgt/wdjsHwQns99x",
-	"FBGuqdx8XpKguXxzGklaQUQ7NSo6NwWBiDI22ZE9GsHrY4Pvj3YePVlHZ7CO+swaNsJ2BeDM5wmIXCqY",
-	"sgbat1jthGlIviuyXFRamCTZCCcggZR8wQK0ZElShqgV6Rjm9lD0uwxcB3A6dhhjhdVAxOqRXaJkuXJq",
-	"YiSuAbqcZ5N81cy94TprvoKGgzlhSo7QcCOqIsEmuMCieRDnUCLQQF2wKpaSqnbooL2B5mXq0kLzKn0I",
-	"EVtzNS/NNVZBESNYwOtLH8/K9SLhAvRDki7reNnlRpU15b201uVqhcJaMBM13XS21fu+bTuP6N7vXnP4",
-	"umFXkISCHL/7tVDuK3MIP69ctndCPJ/PMxB0olEmpujkcH8PEF7Zh3jtoVZ6vFzR1POW+JGmMaJAy7Au",
-	"Wr1pZ2KusrOD8wtklPoKZdUSOZMuDBhmeIFoOjZCT43MpDBzUbyusmXKR6DP0CY2kudeR3s4TTNQ0+Xz",
-	"GAsSr6PDFO3hGUn2MCf3br4Aisk1uWT++3RGBI6xwG1bcAJrdEQEBtZDS666PpCUOCz8KNKb6gxH99FG",
-	"x/Jx10zL8PwDukjMQ9C9VPnd0aXl3ALvz1q3d/DO7E/DRzkNck/VWViOptWOtxF1F3U5xvMgxVTsXYeD",
-	"q+c8VPjH57xSOJOEuh3EAQDzahUaB3k6eQ1Ui89Jyqd0HFSpn8xJei4LVGTxVeavZD7YmQmsjaiNZfPM",
-	"ubVKYAYtZ72yc23lq5snOcegeaWRJXZ5a1ce2e4TRb2zq0+RxofL3T1NKmPv/p6oVLy7d0St4c7vh2rN",
-	"ECo0vle8u9dUw4oF5XO7+bkJlqta867WucSntr8H/Dyvq4Es8b03UyKpyo7LGMEaOrs/3lpTUVexQG2e",
-	"zVvX5cD5ShZbZZafE2EkHNyITFpPXkXlL+v6F8zwRyBAAa0J9AGDKPW2pPH4bSQ2S+6Mmp1vO15gEXnk",
-	"evAzMEopIgmBZacpGsHPXLIuqVJQeexi/JOa4Xd0ls+0lR3KGJoTFpFUgJpurHVesLSKB0Ja7Q59yq66",
-	"QdCpbRVAZ0ZT2e1gZ8tOnqaCTCTT+BaEhQmJNPQ2cjZ4RJJzU1hWzEFSeTFlhE+zJG5rwB3Xh9BGnOuV",
-	"DWyI+VyyRDfkCeukFnBEEHlHolyQWK5ieL94sL/dcruqR2olap1YdEVbkn2k6aGqsOUR1wqGBZm0Wkyc",
-	"ZUmS5eLcFK+Sum3HR+Z7cs5j+VIn53SS0nRypvhvj/FcqGjp9W/4d6WJQ/rGj4q6xStgb7d/439hb/wg",
-	"DRmGnVuDi9WaUdXvSnIQ7McvRmgsXpYpBIs+mHihcQSdYCyMHb3Y4Z8qdmg+wHWDDYbnc9BEZXkaI6zk",
-	"40qNEKO987MhmmUxSZRlwVU+IiwlgnBEM1hMPKfrzt3B16+31huHUD8+5N2cKonzOYmyNPbaO0N95f5i",
-	"3dOucUJjKhZWtu8MRHaj1KGKb3q6PaizUcMBKJubnHe6c8UVrx7ZMMJCERexVrCFYatZY7ho5TrPs3me",
-	"wE+jBfy6e3qIOJwYufZQHl488kTOZrnAo4R4fHgUIXk5hAvgSjl59tUaSaMsJjE6PTgq/v3j3vl/b23K",
-	"4ayjI8OVTQmY1q5bvoGSBLgz7NJDE/OhUKG0JaOFIL6DA+wI8781D9NYEZnS1VuaUHWU+wtA1V85TsAS",
-	"GB49st8ZTV+TdCKmLiNX9JpTD/S9Odx/gF1zBsHxxPeSewO/W/NmpdyDG+KKLJCq5ayGfnxQzvMyX7fc",
-	"I8+Yizdbkj3AwlSA0dB2iVSWA8KAyWhBXngun9s42YhJSnGyMcY0yZmSmeT2KMMsHZcrHlh3RMeFQ7DP",
-	"EKsoGggloJqsc+rDYuFQJl9Xds07nTUJtuph5HOSM9+UnacSCjrnbh39mGY3KYqcgoygXVg6Eg/RPkmp",
-	"/K9coZeYaq/6bnyLabPVDM+ZgpcG6j5XnT1MQ/6ErQLButfoElUCFutL2MqHvPVaDd/ThKbh2m8/+BfY",
-	"7FTndbVV7GrOPe6yHdtQeoJu+vK3wxCNFyc4JgLTRPlSZSlBWKKuMCc+yhkDllSAYYX2m5e4dmbvOHdR",
-	"/P6n8tfi2Ei+Ogc+E42zJMluJEf9Y3GvytZd5hO94UR7gMrlBjFeLBk3a9MAQjiS5jOPlAtzccFwytXi",
-	"0ZBEV5ZDgs6ImrYdq7B1Say4drlIGhblSNJMTJWQ0qKPZM/XZFt+PpnL+ysQDgTZcCC6HKIKo+Uama3C",
-	"oywXesR2eH4TkhFcP/Er8B8S3qAJcvbrhtFen9iShTNSsRo3mMNNrAxv87nq1uU6n33l5ToZwdwbsQE9",
-	"HjFKxk+QKlEwtqbPR7zTTDs+0k2rgUe5bmXoIxs7iWIPG/Gh3U+jNM8hEFY2RhcsJ0P0EiecDJE2t3fF",
-	"yfL7YDiAAo5DQTf/gcrodFuVX03TlZ9tT+4sA+EZtFS8oBzqvlWd2ZjbczAcXJwe/UwYcLHKvcB8UPcq",
-	"zFleqvWiIN2lo4RU/zAgdYoZh6LnizSCf/wsX1KyhJJUHkrsnzDC5ea/kQ9s7Xw5J5EpepQngs4TcnKT",
-	"EsZhXNc0IvtEvq0ply9XWanbRhykLEuSGUmF5tGc+da+lacbZPOcJoJl7FoGS9hFDpYoD+eMzDNORcYW",
-	"3qWXKx78UNsf96Pdq5cJIcLsAvzh2zW1G87eqR/cHVS/dN1HReZjOqnaNHRjTV5R4aneqg6396AKCLQC",
-	"Q7NCr98LMfdV02tQ96//xHlKsDK8PQ9aZiXAS62Dk5vyZlMXGeWFX7D33ppnzBdfwA0wspJnJMQt8Txy",
-	"metiv6RDfP2+VEviZTx9F2ONjsqSp8oSlKOV2GUsuVMqh8IZyFrr4a0+u7WtL9o8NyWOslSirAGh4viV",
-	"Jz1TxdrDJhWi6wzpSu2yCLd1r4tzc1Si+kwUxLAsPXg3l0DuZVHld0RsAeP1IclCth3nCQjl6Yzw9ctU",
-	"TlKXoBz98S+k//+PHbSGjmiaC8J30B//+gPNtMBvc+3rb9fRGvo+y1nt0/ZT+WkfL+SiHWWpmJZLbK09",
-	"3ZIlvJ+2tp3KvxByVW392fpleq6sjkmM5EZikclBrMmCO1YmidOFVkRoc23ZDE3RVA7ZtkeuCVvAb09k",
-	"v3+s/bGDznA6KWptrj3/AxZuaxvtHsm9f452j1Tp4R87CFQxpvDWcGtbl+YCxBpb22KKZrCGqs7GHzvo",
-	"XJB5MawNU0cNplrjXBnjlOfyvFgSiaDPnSqX6YEKKiJXDm2uPR9uPVvbfqq31Iupe+Bmp271w3ScNUm7",
-	"q88RUAYolX2MlL+eiZukN8Af6LEiv3QaoakiRpD8wcut7DZcO/Nq4OFBj+FpUHNNl5XKau/5dMFphBPX",
-	"R9kEaCnr8EIh4JSGzIyorORJ8IgksIZjyZLJd692S7AhoAbjZ9vxePTV+Ot4O4pHo2+fPv326bPt0dfj",
-	"refj7YhsP3sef/P1s6++HcXR883NzafjTbL51fa32/gbMn4ePQX46ZXxX5AyvuDZuz/qdZ0V1Oxvg6ev",
-	"FpXG57e+bFw0MhuROG5yIq9GjaEcmUrWxCrLRKR4TL8beRoONltIl1wDxvZYKTheBOwgtYHY2I1+czOl",
-	"0RRE41ATdQ7JArHePKh8bHsxZZARbIVCQHkkUHcUJ4hyxHJwKtUxgg7HaJTg9Gro2z2WpyZeEMQOgjb1",
-	"Dyp6SDW2z52H8ul6jPwhrT4Mw8FcCkmWLmIDjlRXbfXYLuZYt2hFbOwPSaoOLQ0LkZ49fcPG0H+1818O",
-	"buHjGbgqYMhnCqE4KlFuPPFCKq9Lzag0HluXl1DSYHNDgYy0FJLtLuSlzdFSAtLT8KoqEUNoIfccXUMh",
-	"IFXrpf30PLbARJ5CEgdjAp/pAiYKcLDdNiVsuZ/GSfIs8UBw6bPLqWk5MPwcZWlKIi0ytZvtswaFp9Dh",
-	"vh/S9Gd0uO9K1Cs9+AlD1TxyrvgKvVum1PZiLlQD9RBYXmnrvyvFdI1wClwNV8pVsFLFCX2vtC42oC9h",
-	"M5riZGjHrOxkZbUhIiIKbReOT9JkYYKgl0izMquhs4DhrXRFgr7giXrWiqvHhqTisiDRDVde0fthNlEh",
-	"5tvZG3coF1DPrwhUTXabktNOHdut7YU6LFz2UJvajIhpFpePlCvdf5MSkGWD7D4SGVucEV4aX5OMvGnE",
-	"TstNxcq92lU4lHwBo2KxNyXRVQiQwmWrp7cMWdTUQJGsguaEyROhDMpWvAPWvHdA8Z6s9qlGdAvoD09+",
-	"NewPttSiIFtiMQuqMzGq3qTcyFZc9ZHVXixDh74JFD01lXHHEC5nRxcuUoy7vqxBdaNmTkIkWju784p8",
-	"BHqBIEdisTrRgPXvsixOQd7A3hSDbmFuZGm7VvX7kc4kezibm7lXGr+GmgXj2k2vv9Kp0sFV1RYZflvM",
-	"Z7dZ55UPZn0wnY9m8AJw9ISWvv3Hc6WjWDkWgSmFTlbLGa4f3+LYvcZcnBOShi4N8716UQCpcflBuFSI",
-	"g+cvCXZUt1rR/Icy0iCpsUKUL2VaMUZtIOUK/dgBhCnoNR2TaBEl5PssuzKEYyjgBRlnzFXL7o4FYc7f",
-	"qsAZGWWZW6L4YRnKKA2l1rWnTHU0wWbcAYbaccZcX5yVnj2JqX0HD8aq8Llo/K64hcpcV2MUfI2EgMhN",
-	"AeJbsTpHoGwrNBqUFf7lX5aEpMqoq6BS+Vwahee7b2gtxcrw5PUFKr6VHX/U7w8XRcTpr5NQSKsfeg+e",
-	"T82DZzjQoq9uO2h4i7tz/fEZ9OwTAXlj9pW1ZF1wrwRn7QpyVQ7kJ6XID2ies3nGy9mUmkbijesM+k6a",
-	"TsCeqeGwgHLNuLJPsVCK0gq71dXJobLuzkrUBtR1uc8Iz5LrhuU2oQ+guH/F1RxtLA7MUSYLo8dpniQq",
-	"2L36BaTj8kd5uRk5j0cZ+0AbbObu3eA5I9c0y/nRMhut99jUTRZaLx6vuOHKKCXJw6aa3+tY5lGWjhMa",
-	"CWAfmZ6YuwBKcQ+zgejV5l8wr30SyD3RSHKVsYVJ7oT7ffncr07KSxAmggwInZxbAWhQ6uK367ooNaKM",
-	"upSWiHVLDBgyjnImtQpLeHLeeQo/l0XeZhpe9Icv+3QS9KKL4Vu1LWXCgfgUb3/9bAdvrq+vP+m6NOVO",
-	"GxYKDtuUzvemOJ18HGSvjsF75FNy04ByKbnRuKbwzqKbTgjQDdwMNDR0ZIr4e0uzlHTpKnxwwztlzXeX",
-	"ImxrN9cmjNIJlNo5jfI4jGAlpvzqNvWLLEqrtVD15JrnA9uoHl3XpW2mcV6y71OLXSbqIl3AL5jpJ8Ye",
-	"o4JGOPFkK1jmJVQeqJsMof616Nz31RmQ77MZpO+b66tgv0POD+sn3GhchdOFtq4sy0LcwDJvq4kVwU3Y",
-	"+VxzvzL+wZDDVg7HRtKxkeyhC2Qi3SCcxhsZ0w7I5td1tCtQQrBE45QUhU0yPR05Ka4YfJVHvzMgRRrG",
-	"7+Ysi3NQCg4FJey7MYNMk/GgZoBVnqRPG26Go2YJecNKwW+c6EF6FZSgiup5Ko8vx2hCG3Ni7nqJlZeE",
-	"F1FsrSuTpMvvVGdbQy3hmE8xJ//13SlJY5oGg91WVupu56js5jrNsUwMzhyvyGJLaVa3hldksf1f6o9t",
-	"/4Q+NIEKHAo+z1K+rMmhqaaewjBN5aVmX/cO8alViPVDcLDz9ENdk18uEbYCsosrWeUbwgjSAZ7GOZjR",
-	"qIZ8ZkA1pX6pyzD4NnGf1XTrwdvLtQbplhlphUirQVfY2sMgsvlY/ANR31cYg9f/xNc9b4/jhiNBrwvb",
-	"Ba20X1Z0ZEwyvDEsypK2pZXx4GbZcRz6GVN1Faigixxa6QLXRvflWNXd16Bidu9NwwAWb/GSAHBhbeVi",
-	"o2TgFW+Cim+CfDKeqvS2vCmOmZJx6ES45ZnWoFgHd9TjyFOqpCVDnc+SFekbICz6EKkgYFOSJGtcLBKV",
-	"ycF0BuOH3vEE05QL4wKdLFCS4ZioLmBMM/zOhNjY/vpZKXHvb5tr3+K197tr/9m5vFz7ff0S/u+3y8u3",
-	"/3V5uXZ5+a/Ly/99++/H/6dbuSf/+/jycv03VdD3+X/CYSWbUqIpOWS3JHiOe52uYQNih0DTPgKWtmOv",
-	"W1n4FRncyXmmYRfpujMMamKaKFvLSOQ4KdzYb4vS+i1eSiDpsNlLYFPd0thzPnHdDm/p1it2jN2jYdhd",
-	"UOFOwfLW2DTKlfTGCcA+YdWKETDcu6oT2BdGhmB1oPW5K+nmjTnB3ehg0ePjk4uDHaVBsG4lOsEoIyJn",
-	"aSl6zJOOSlttC/wnz9I1OkkzRqzxr9WHraTCW/J2s3U6u8J55QbLKhZqlK1uA+P706GBonz5NvSf/tJl",
-	"s/S5V53Fb1Iqwideq4iWQeU4YAHiHPPSypRhZeBHGXcr3bNkzyTQRzHeYudc0mvgrFc2rnZO2xSz+AbC",
-	"eafGh06+RLQdv53A/Rhd6zGYkCB3YXbtWZrVdOlLZbj0W/CcgE+5P5mlaxNxmsmXWHwyHpdMfHZvMBUQ",
-	"OkDbHau4EqBqOMU5X1LNXpqQM7TaN2e0nq9l0VHpU93Oo/S5NE3P96riv/TRtxieYtX1KbazBGvdXBpP",
-	"TEJ3fRqcAH3k3TzjxX0D/ijrl+kBjqYQaC3KGIM3fqxC3RQPEHUsJLOLExThOR7RhIrF+mXa7hypJlE6",
-	"VVGWJKApLbTqQfZMDjJo7C/v411wq9HW/t5D6CrKA204JST/o7xz9TrVXDeLliXp+EzyX2SZQIf7yzSl",
-	"fE+7XGE1d9cPkJ5CgaBabf8sTyxSnhuk7Di8qv7eXVC7CvVRDMvbF8at2jOjxT59DiVBoTPDKZ4Uciht",
-	"a8GHiKZRksfyC+Rn1r87ed/j7CbVTzyT1E+nVq9omXS5c+V63spYqcnY0vZyX7X+h5Zli1dSK6ox3amZ",
-	"mXs96jjxd3g9lia72vVYb2IJQ7NiwayV2fwi28cQefAkFydj/W/HunAVfUppkE4Xnq9ur97KFTPH8tea",
-	"ysR9arawZUYkaxyAQOVoHzRw4MZE2UEUCTnAcqDxBd4mQvi7S+wxmy3v79pdtItGjOAreaIbZzJaoEt3",
-	"XJeDuslkQVy8ytN+AoPXY2oeuMgETgJqRfnJ8ff19dQxFpxGv09pdfTrpWl1qo5WsFRDD7FW978yYS8a",
-	"UX7VGuZl6cgqw08sNIz3Ao+KLJ26Abi7Kb9ScX49UX2CyY1jykBRtrAZjo3kXFt6OG02z2Xuz5f7Vu0V",
-	"y6HXF3ms3fcqIsxKiXIGEHJNEp3KPbshsRyWLq1gkqnQZogCnc51fLP6MkB25heLsJBCKQ+vyAKYd+02",
-	"haCaXGJrFVX0P4LhluQYjkj78W+7a//Ba+831759+9ua/ffvG+tv//Xkf52PHYTRIDt/k9pU9oE0NCof",
-	"jIM6Zo+cJPjmUMc5UI5ePh0BO5hOBr7utnRfyYIzRnla79fu41L9e3m4LLoibDdX5L2MzBwqamVGJdGp",
-	"3OaTvUPEyITK3fCaeedi2iWUxUlEd03RD8PBHHN+k7E4YISkvyLQkl8RNRQ9jEVlmKWbw7brjRgeitFd",
-	"CuTQ0lXLa8bM0enOma0XwPOm6KqGkGwkf0MzNniFcvgWGZKrnhBB1hEAms1Lax8pJiY6WMliBKEX6bX2",
-	"xSJMR9RVTzisxNJ5SsU6KoJM2R8hfvUO+oOreE1c5SIYoj9m6gcVgkn+MFU/QLApoB8HFv5357ettW/f",
-	"Xl7G/3ryv5eX8W98NvVjwEEaZfIB1sXjmOiy6k4Ch3EAcSxwoZCwG2oTDCeYpvIFChH/O4fiVF2d6srm",
-	"7xe6kQ9uRM49q4mopHSwJda0rL/tNBVtnusKVUL0tOkjvlq40Pra1oo05EfSkeAlNaoBlHQ5qwWQqg+x",
-	"7G6gw0VtPY2fPd2Onz97+s3TCGMS42dfxfirza+3x99+/c0Y42++2h5H32x+vbm5/eybr56Pom++3Xz2",
-	"dfT8+da38dZo040yFHE22Bmsyf97cfDq8BjtHZxdHL483Nu9OEBnBz+9OTi/gK+X6dHh4YsXf+69YD8d",
-	"vtjdf/H66M3VzdnNr/s///TT/sHm7ruj7Z+2j97/cHWy/+v74/fHf/76y8vkP68Oto9fnU2P93e3LtOj",
-	"2a9fH1/Es19/OXh6vP/D7Nf30c3xxe7N0Z+/Pj3en9Jf30dfH+3/uvXr+8lXRxfJ1dEvhzdHL69uDm5+",
-	"/f7H7D+Hl+n7Pzf3dn/69VD+9f7Pzf3dn6L9nya7B9+/ONp7unl89sPFD0+PfzlJCP3211+uXhxtHL3P",
-	"jvdfLY7OfszfH2xuXKbRj1eL//vzD+Td939tvjtMt7d/3Ts+fvqf/eN3725+efY6+WnylP75Kr0+Fz+d",
-	"jJ7t7h7tZq/29v56dX701bcvdo/2LtPdzcnu0cGbvcOf9s/ZO/rsisV7P0av96bx0YunN98c/jXbT/4z",
-	"PTt4Nfr+aO/g/Of0Geenu4eT/7z+90/sB3FzmT4/+zf7ak7xr9f/uRKMXz1d7B3m759OD79Jsl9n//f0",
-	"afz8u8sUlv3geL9hS/rIX19a5K8aRCwXBKxe/W7TbgXiNfuey8GiRdB9v7zMQq+jP0fFJRAOJIJN4OeG",
-	"DB83Togxg/dTzNGIkBSZBvwRxYpIf6GneovIf9ekb1EtgTJhPk8WJmJuMBhibfP0PJfaIUfS0OkxHd7q",
-	"+iu2pdO2HXfsV26797sBtw3g9rDQUdnc3b/BvLTx3eIcmBovFu2pcXXZDsIDp9WhO6UOOS3atmAFIyLP",
-	"wtsNWvfSmt/h1lus7HtbK/JgXrjenjsZMNS5ud4195+aXM9/LbdTOlhFU2UObQuqM1Yr+4gbRzswH/P4",
-	"/fCAp5MvlZubh4qrvAUuenquqrKxYvdQo8MBaEzO2kLQXQDoNoahA5LVUbbW/+RZih6bcI4NLgp3eieb",
-	"bC/GVA1SlzvXNOXWuG1KUiTPkAOTlPuYiMA9LvezG7EFNJmBgsthfSfoLZi8lVgGx9iyLeeZS8v1xGfr",
-	"S6czq2duIrfA/DtLUFYXezTsri7SxEZNsxstOJMQDKde5YpCL0EkgfYkJGeJS6xORJy6JLQQFS4twQHZ",
-	"7YehK7jJ6Zq5hfzb/ubstdmdN4fFKVTBYXOuLOrnzNxiP50hSSJgoZDQ9EpFWof+zN3ZYFSyqmgqJKGq",
-	"rFfRQXANOpGEkYG3kIUsVk5FqO/4igzcJRqVAnwF0lBNrzlHcs0fH3MPCjrJc/axwMUw3WMO0kyAfmyG",
-	"LttHY5ooGfbF63P/wVeDuSKLxkH8SBZLdX5FFm19Vw97YFXqQ+y08d0hoQMymECn8lhkK266My9JVBmj",
-	"IrjkRdldUzS8+i6XYltGpbzCoQPsc/tWnLC8hQE84pgRbi18WieOHhumdppxIV9wO/OMiQ6O/A0LZAfr",
-	"3XnJ/Xq2+Vo9uRzZtLYVIdfKAQELlEXgbWAjoivDRg+Y+703q49UCMkN6g+1FgqCGZ1MgF+Dd4TsXKlk",
-	"1HsFeCPwtCVj+k5pWwgF+Ypsbgc9BnUJGEnJH/gTpwf9Fecim0G2WOM75ef0Vn3+xUWUhEasl3MzERXA",
-	"XeIaQn8oCV43OZ9NgdQ//O784RfIFrmrqKsIC1t5ZlWj0sp1nOvMjnco2Q3ndeTTjIkhmuFoSlNSjFNv",
-	"P5yycsSWSgZIdegc5Z4xctlTeZedbHF7OrOyDfRoPryxXgnlX2oFTfyayi9um3XXz8DPlRp7p29qgQz2",
-	"Tt9UQx/snb45lhdYUegIIkPU6qqfq9XVr5UW9im/qtWXP1Zry98qdd0EbCVreedDzcje+VYN/LBPub6Q",
-	"3VCcHnP7ivV79Wcbc8n5UGl1D2IXiJqtpP69biVpK3jtI+1+LpWz0bwAK9QQiBDWHFurIZmh/OUwvda/",
-	"HWqb/AvMr2zH7o+nhM1wCu6szhkIJHA0Px+muPxBo31cFCkOWj1ZYzE8N3djcYrdX88FZvVf7VBLDWhT",
-	"iervL7CIpvuUz1XgrMpXvWokMeteq+q266aj3JMHXDg71inDZW3tik/epJfyR5pOagBVSohZ/dGWVnb2",
-	"Z4SLjAViFKmanbiCc1XUPvibTAYdNulE5bFVeDJEGmtcJLdQY3Dobbt+oFl+WWZaPKl6dQd2/kPNHgaZ",
-	"UyfIlIdHXbO5pzWbNSyyWsdFNBfNtS7m8LYoxZpS/vCQhFD+sxEdGqWRzdEPW4BliZargf5C0blanEQD",
-	"sbwaD2KgxXCNhlYdZOjabFHF3+5SA20ZYwWfOjRYruFvVQNEh9ZUSX8rBpw7NKOLFu14bqZgEttqSX8r",
-	"9ausQ4O1SkXbTdda0Ow6WMVtt3SHNFOKt3C9rdZxlYo5rzvjc36sDK6coG4fhh3zGgcb7+QjHjj+3Wo3",
-	"Q90qbVRBrT3Dcog4l6kZpMKuGVS95NFeuZVa25poOOLLVF1u0o3ouUzlAJgv3cStBuGH6w9vl4q2CDxI",
-	"wObAfKrYGVz7cyTfl3GB7a6bRQGIA3srgn+sFYHznPA+I+wolGCIQgjieZbCu6kuEqpI6U3ldmHvkv20",
-	"CL9tv745v6SJESyE5gwflTJ6TH05HqKm+mDsjgR5J9DjNxcv156DkFmZvhd6hqIT8HnT3fhUybKcsX1v",
-	"1xA6pvxebxY5/XASOfnVpo0LODcFYoXThDziyo9p6LhDaPE7eEWYMM1pPiOMRuhwfx3tK1dBUKdeDliW",
-	"ictBY67NlqSasywmjSOcE6YFgkiWXUe/ZjlgjBqz8rCfSUQY4xlNKGYoiwROjPo6IRgcIN4TlpnIj5vP",
-	"vvoKdhkry5qIznQFlYHOV+er7c0nEuRETuMNTsRE/kfQ6GqBRtoHBNkUN5DOVIKYXViV1rQyGTgpcp4c",
-	"xc66yuH5s6/mPBj7Xa0WhCq+1/1cJXdqiLBPjCjdzXQTWYmWDujshMXp5olSatoRkLk/n9m2Sz+bF8Fb",
-	"PcLl/EddrGplZtyD3VZ4dwQR3skpBsuIv+telhZ6Av6WL1Vy8aUc4l5q93NXjUjcuKx3xwf1DMpn4V0A",
-	"FLGcR4GqcrdeBNCmn2+3n8p8u8oa8WB8e9FdJ75dHc6eb/+n8u3tT9+aI+QI0oJ4r3r4BNxKOUxI4TL9",
-	"MKlcGiTNPo3JWEsXmxPgq1LVGBMw5Y5xMXQQ61PCIpKKYE4SXUyyZLqcYe5X6GycJ20TK0reZnKCzOYJ",
-	"FqTRSNp9qV2UKxjLSMo1GcmnmzZ6BOPezEs/gs5IfJKLtklCOWjoNnNcOXxK916a0ulU13ioD6OPtIY2",
-	"golDCZbWnYXrBAt1odo/AhccXZEPGD4KTa9CAG172I7q977ezRB8hytdoi254ibkAgQYuOWCty20X/j7",
-	"8Ktd0TJ6bz1Z/DgYasNdbLWk1nRde4lIqiYQhpWY4I7e9b273W3oGvJwpDFZdoOLVVh+s8tajoffZK0V",
-	"fdDzpLmg+z9JFe3Tw6+uUYb7lpeZIgwLMvE4yuo25DMISlgTkcJCBgLbvrj326d85dz6vqnOvMM2eh28",
-	"6mWW8+2qcRAVubpyjnrRxpNohq1IAaFgRSXvrSxYY2ynQv6wgiSrwZcS5tnqP6nXoVuih7NSYfA5KBIh",
-	"NT4/S1mTHApdIa2IqVpJ3liPN1iJcHdvAiQnD1AtzKhf2lMNGGgWI3gkVsqX4dRc4YR0zpYBpYeIgHwe",
-	"J8kC0eIR45y4Kb4moD8Apxd19UIErBRPSMnlhKYIo5tpSO21nF+jJYfbp5qIa6FPl0lsbBGwk+SsDIJL",
-	"OlK+osKTaal2EU6oOJPD8Dsla4sQ5SD1iopyjiGkPHiWicFoIi+alLJ0Yo5sYXTiZQKZ/dx+kxVNWSmh",
-	"n7EEVDwj17TJMVt9lYPOTTKz1vHWEonZwdd6HYaiSQ4HaSf2upKIq300WsWldz5AO9/no8NUsEyeaIhe",
-	"6vXrDxQsQlpCZD/qfkc5l3ekqol2Tw/R49OT8wu04aaX2PhbCWR/p/GHDWjkiZMR72Q3F9Ntl661/PZQ",
-	"heZWf5yTiBEVtOwF5jRC4A8qv785ew2EWCfcsCFzeQ5VfmxCxTQfefmwnGmZjw5FOzAiYjyn66reepTN",
-	"Br5rzlmkEebgDFzWbPrbgjmruvLPIRrlAkU4RSOCVNx4+l4HzNM9HKSCsDmjnGixeTsViZDx0StJV/Ns",
-	"BW5GAkxxVIyyV8dlNBEKOUozcIlEj+f5KKGRqvJkiL6/uDjdkP9zDt8hk9f5+ffwByS0zYRKJFhMQq7f",
-	"nklUwvlU//ttLbqYU7AFub8vSn5w22zL+mILNtrTO8sDT5TSo6RCkR21ys5+Sb79lazo0q2HKN1hyMMk",
-	"MhQlWarQsRQGcOAoRDR1buiPG7IRSbUqFqpJlbbVRnhyYMMw+X1PkpnjZ9Rdye3JjfgCc+IJlBxIp33m",
-	"XpeAzFPMhGZRKUdTkszclGDeOwm2ZY6j5ozjtlQRI9RJNRaTeZItZsY/zu7FbLGG5/O1ogtP/6Bya+Ay",
-	"VS7oWjIwhylQLfgG5pxhzEZUMMxoskAp4eDmahwkqrlB7XK7PMAgndD0HVynk8HOYGt9e0u5p0I8ZGV3",
-	"gUegmFFDnmZccCAC+a/BjulBg6+8D9RnxbwMNvSPSkYwOAVX3sGHt2B1wAhMai/LUzHYeVqKnCAnONh5",
-	"vmkXdy/JuSDs8NT/9lPr9ZJ6k10axatZVLCpsfHRdFBhZ78RtANGO4wkGGK/wtTcnBXAXKs0kiwmDI3I",
-	"OGPK03nNJAfWPZa24jc91rUiHfD6As/kcdQfsmvCGI0JX1/MEnk8LcPdnn6wS25874HPsqvdqH7WK2d2",
-	"3JjEDoQdJkHyjAhP7N0RQeQdiXKhQth0ekrIsTU+JwSdkSykf/qkAwOjR/xROS7wo9mjclxgSXKPpo9u",
-	"Hxv4gy9efDd3hII6zvLUHN/yj55gvdc/Y3ab6EkHRe5sdI0ZBdfmK7JYUxYNc0wZpJz5U4mfTXDwPJVr",
-	"7M29wPI0aBo7kwtdplA3nw1OFwizST6Dp79iv7nAaYxZrBKdIr5IBX4niYfa1NkGqmfaI8L0xNGczkFm",
-	"PoGQkUNJURTeIguVbtkMAuWphBcsmdcpWouUtek7v8ruJmNX+zRgBSg/qsD/JoS/mi4EflZx8fM0NfYh",
-	"eqAd3mW5X5JcPrY7y9CarXaKxfRk3moBV6pz8G7OiE4b3Doup3Ddvz5FxH52wI1I+oNEOxmS16LcOitH",
-	"8GOezgxAYu+u+aZcO09ZwFbXRhx4zJ8g2b+6uwTYKZMku1G2pSAykFPgWFCuLWPhVzv07hZJJetMDyCH",
-	"RRdY2ypaGYayykagGrBkaZcaRF2RcmO65TL7sk8M5ap6aaT0Ulni9VXm4uQg5VtKpWSSSOCRw+H1iHnu",
-	"LhUZHRkbc5ZlAu3teumnY5IAHRBFWQF4xtUpOQC/onP1uv2ZMPuw9ATLuaJzxMgsE0RLuNC1U8EfBFck",
-	"vNNiXLw+V0GcjGV7p6HL1q/IonvrV2TRvfHsKpS8tsjMcOvVXyI1Q1Nf7ZyBcwKaRZ/yadpR9pmqkXST",
-	"fkpUOPXCiPzVyDuVIPmR4ulN9kWROYF4jW9GNfEvDIUTSZcFf3fDqBAkvbXslNVlp0b0ibmOp5RGqEGq",
-	"qvKo+ybPrJ8JCA0kVEbZTEL+WOjQ04WY61CJrBQbQ9BfOYHEPQzPiCCMI55HU4T5DrocbEhE3BDZhjHp",
-	"/F8o/R2U9pn9N8pn7fY9vEjWUGQI11eUqwHBWHNXXsusZFOvlei7TtirCsHuQJwlu+4oz3IXSj7ev4eq",
-	"TRItWB8jx8JJ4pdgOfKCDZvcuFFwBc9iGqvsX4FTIbtVJ0Yxs1maqESVpqpk4JVFp5YqFWsGInHG0Qzi",
-	"t8kjas6WYuHhpQe3r56c4ZhHC0OiOvUxktQknwUwEsL1SwDimE1JMldoLFSAMz0jm3JZiLmlrnZSbxHf",
-	"HcqXv0cUV3dYWU0md7J3iKAsuEkxQcc4El4p2hxHV52ygS0jrIDpHWV5Kn7OknxGqtMrj16VUTqnYuAz",
-	"WV0ylY4bVkCfYVel0fVcFlJdFSFdZkq01VxTVYLpBFbFNBRci9M8SQrDg0JLcjg+zsSp0lfXdCMmaXFZ",
-	"GfLIrfNoHf0i34WcCPi2m9zgBX+k3NXUOlKO5jlYasi7dAHyjUqtY/mlVAl4e5wwguMFIu9APJcGcmOr",
-	"PgfD6mSg1Y5oJtfHtiP/qLQlf9LtmSX1U5ZH+6G35sNdUU3Hc1EaZsjrd78U+00zItlYsmIne4drIO+i",
-	"OBX1w+xRR5dorHVSDknCjEzwwmZwaR+Ysmkwecc0xM7wQsKyDQNKmFMxzVRODm2MJiHANAZSlySTtwNH",
-	"WjGfsRmv41xZjdaBFzLz9e4c5FRfBZ9VMnZPJEDjyuRir2Z9Oz/rnQEVfootImY1oI6wDYW7PCra52ll",
-	"+MohtA4fnQUZxmGtKsO4XyY1uHC+KD4Pa4DpiSLkU8kTxjJ2FAqdKXuHEkgHBzNxKI14cYxpkjP/4ydj",
-	"dEJTnNgAtp3CWjAi2GLP3Ljl4RyXnFAUHArMr4rsPLI2LQmOOrmDlFahOvK23Q2GqHn4ja4HerqHPZ+b",
-	"Tj6V3b/B3Gy80d8p49MZZldK4jgvFkYbXt+SRJyBdqGXH25EBxMiX6kO9kM//HLhvkXgffLDLz+e+4L2",
-	"x9R/fx+8myv9iymCogTTmVG2akHND79c+MIe5B2skUpo3przlnKe+8Ig2GGqAu4gbzFG1ZiXjP+8ueJv",
-	"Qo9lucjo8Q/nJ8foFzJCP5IFOifiSSFfgPenK1XQZjom1bDeNRg0ZLLAVukfWKLl7bH+vBHtQSN1xEcz",
-	"Wx8J//icN7/QKgWckMUY/ZiPCEuJIHzjZE7S8ykdC3vdtsla8JwGt4Bq9HN6ABuxN2ev/d5wlM8TvPB7",
-	"63xfiROtyiIrjAX0C/MIw8LOwnm++axEfrEZ5ihHPz7nxVJQjnQjftl6xiY4pe9hpXa5JJlZB3yVJH/i",
-	"r6lePNB5+8VUyRbhroUht6vn3O/oMcLRMfc3f/Zid69ix1NEUfGfBpYlZLn5n5Vr6DZCsijzrDYCKZGB",
-	"I/5cCSC0GYtsUo1bKV5TCNZK32vHB/0NRFNKbwP64zVGEoI5cWxVoD4jbrtcG4ibVSnCqKoOdcgam0x2",
-	"Dcczmq5d5pubTyNbC/4kHbITlGhgaI6cFwfsQVNWpc1vgLviv4cDDr11NdB24EBV/EwjJ+WpWFF/Ukpk",
-	"qNbA0ZFowVnQ7q59z4plXdZwrzjp7U19vtGQPM9F19qw2NpWfxhduzgAvmMJLkX+eCnFezumXNA0EjqT",
-	"2VDDDsHRFEnmTF4/SoMpFN98Obgii++Av7ocrF+mZRM4Upj2fFfYwQF3PKFZ+l3O1wjmYm0LvOQJ+26E",
-	"oyuSxstYw+mZnTueVbXZyQLI+F7poDDwm9KUZddgeaPjGhlVHlf2YYzwPIEPMyyi6WuV4g2nsfq7sCxR",
-	"ll67x/skXkcHs7lYbKR5klR656qa5D6nOpR3xe+q0mrb1XVULS9hoRjprdLazfBcTvzvK7IYwh5/UOZY",
-	"/rR0dZIzQVS8ppryi8MDGn8zbb6ySMWUCLBYN3oXayriGmxJylXbcY0ZzXJuPbNgGHwd7RbK2RleqFsT",
-	"NEeZCq7+d+HBNkRmYB/8AQRpmnsw60jJJSX9UCcRjvwbo4TOqJV7F1ErgLytulrZ/1GbYbiUQZAwkF9A",
-	"fDtYIXyNaSJ5QDePDmQlwX/lRNPmwmqwRKYeMFZGavKwavGnE9wHK6cycDWjXMEC+HvLx/O10pml5J0w",
-	"Z8WOpFjuPbVMoIuT9zanHDTz0JYclo4PNM9UJH+zZHqmZbMBOW9jFyTZI7kEYopThNGY3BjrSbWnc8y5",
-	"PHr60MKOG/9cpeOzxgHAjKm3MczTbG0lJRGNFS+bmJUqvSPHlEHyf4h5SYYoTxPCOVpkuRoPIxGhdim1",
-	"dQjkCEvL8pOAHcIM05Smk0NBZgGBRzW4zIjLjU2FJi49Tlh4ddNLgJLLrw0FdNons9FmKvA6tjUNsRiZ",
-	"e6wBDaIWgvGjQTZQ/VTp3M7DDIqjPIVcn0CnaiFlM2bREzIWKE/h8KQxymZUOGafnDAqOWhtI+8O1Ik/",
-	"gR7rS35EIpxzgih8BmfKaZ6CeWRWfIUl0Pm+Esx1oSfFfBjRS6cosDonNRFrDbrSTEwEriyJ4d2HU3S9",
-	"tb71NYozJakgwulDUblkKFK5jXIS1ialSjdyZv8iXNAZaMj/pU4bfa/dUaMsSZRkYB2pVHfcsIGyX0YA",
-	"KUNtK0U5oAGzZrVasdQlAE/tzqhcZ/UHg9e06wJ2TpLlFVm46KmvfGXMz0OhjZRxZSjDmTW9LJwJAEDg",
-	"lq2k4ziU3M1xJuC/B+/k5TQYDvYzwo8zAX97H7+FJ4lnXmW3BpGpjpeRl1X4RbmEzqTftm8Db2IaFUNT",
-	"2NB2j3lX3ewPYCRyqKpu1Tk9lZnJRG4/ylIqslZt2kwVaxdeuDZculL7u9ht/a3P9L5LDHp3JmB039nq",
-	"4RjPSIyule0DPBnqwjKPNlurm2va7FtbMoQtGJRYtSS+9khV6oUK+ba1sSzLM2vzbcoNo51PAzML+fIO",
-	"QUgaqOQV3Q8HbBx98+zZdnDr1ed6zXpmCbFcTolww80VQ5Nvq+ed/4cwCTQTtEf67siJUy2d7y4aVok0",
-	"1S0bFBLrRkuFS0L6hsyxh3FjmzpY5uF+QxNKTtalmQZByCcouK7uVZvsmlbBoTHuiAdPGhRDzlpq1ZDi",
-	"7seUMPQ4NwLYyjeb9VghTyCt6Ccvc89kme1QfKVby8l5lM2bHDL1uqti6j1Zz5jeKuaBHWg7wuqCaD26",
-	"8n1O03HW1pwp161FeZz2EkxnpWPy22AOsmdG4t+tYf/b6sEBJaEb8cNa+SsVpn7M2QGZxxrIMa2L6lg1",
-	"wclEaQ20EuC3S88YLgdv4Ytk6hPzB89Hl4O3T27BXFYVBVUAdjayvA8OoFaAMXjCauTrvXUO9/da7pxK",
-	"icqNc7i/1/m+abkTZFO3vhGcRj6z+6C0kq23QROSy5Y0Vr85e23o3Ib4iCLJh/L1SZZNlBn654rcNI4+",
-	"Hm7LVb4laj8QLv7wy4XG/k8cDzVV3xvYFdHY6jBnv8mzV5a34yRBc8JAWBv7Ze5KhKhFhxxqaKGvysuu",
-	"yipDTQ8jnqaZwDYQ2YoqiaIwyJy0k5cOKOH1BY90PusLOiNc4FlAoQv++rItHRz/BnM9lbgkyoqxIGuy",
-	"sD+SsspzvWxfWl4Yq8TZ3fubkNTJnVLLGg7C4MgKY0upAbA1dUZFK0aGGBMuqVeHQESn2TxPILu+WW9Q",
-	"IK+jM4LjtSxNFh2DeietGqkZfmdchJ49HbZRw5FST+kECGAzpRRBSlAGPnMqkpPRg+ijpXQkERZkInkT",
-	"gh4DysGvSmb4xCo0Bit7tqnysgFnWttf++YFSmrfJjqZG7BA2Q2E31CptuD3oQTAS0hEsKFATOtnA0qF",
-	"klrE6wuvlUhubnn7UuKOpuYRL2yrrrWaRfkcFPPu4ICqQOks7DiwW7XccMPUVUTDfaaMu8uU0Y3G7d7E",
-	"jdtekj6rpBnmuq9TREQlu+KhhDK7JPnUk71D4yRClVlkk/AvzqIrecwC8SfhK3Rdl8FJVm253K5ucw3T",
-	"XJpL9E/b8It6ij6O8SSiK/rEyu4KlxvjlFN3lqnc+OCJeWRzuxlvNXlr1LzUVNL3IiGaoi3V0Q66hEoK",
-	"2xAztw56PM+T5MlQf/6FUUHcMjfyB1UIkH2e8+kTd7H0SGxl77LdQeCGrKDoRomWLvZB2cfIqQdeP8X2",
-	"L9A040KepSF6+dP+MQTyOzxFOI6ZXFCw9TY2SGieMWF44L9yvFin2bBwsmIknmIBv80W9tcom+18vbm5",
-	"OURb326vbz17vr61vqV/+W1nZ+st/Nv/vIKZEU9Ix9r+g2svlIb9i7I0JZGC5qxEDDVH56Fu8e2DR7G4",
-	"vad2FtGOro3O4ZWIcSIr1r3RNNE0uAxb4+oWiYivWEUsYs20QVbWS+Q9TYGx7p68n7PkNMEpCc/Xrqau",
-	"BYDLsgTNZb3PyVzdY79/K1HPPQnt5yyThwKs5F7SRPj6Pxy7HiJw5+hq3Hj5U64ND8wrDkyeYsKMKVLF",
-	"+LCwsDVmRMCuo0dXZPFIgvcja1D5COxboFdZ8IoswA1bBx0cQqwyPRwzGqwtN9FjRiaYxWCRZGwHnhSG",
-	"Tdr+R/vVqr3hGvrW5PDRGJZEAvAYLGWEpEkdeAmngXAmdyv6mpOUSzoKyr++WNv8z0/l0iQU895Tjgys",
-	"bgPUZxj9R2cYdTffmyaiMQljGzn5beCrJcqpQ92vD5dBtNZrJ6Oq0tHp84n+U/OJ1g5JI0nX+XdXy1Cn",
-	"6Ha+Elm+EvhJPs1uTOIH5l8r8k6JC338+YH+hg73rfi0MsAOwsRTLKLpmaIfCDBhzkujsGPJAJxykpoR",
-	"ctkVHKt05fNE+e+oxOWQ2Slg9OkPn7mLQOV1qtyFbKwiv8mof6jq6IoM4RjM5vWg1mvEl82bUmpUgaMp",
-	"m2rxzRhSaxjR7G0JR5x0q6qUd4Kn1sPTt0hOFn0wEASlhg7LbLeKoyxtlBgXJcMo7GlV82+XgwkRlwP5",
-	"D3lRqH8prZH6t8Is9W/Ifqn+qRQ96t//0hIrUKfZHp4sx6eZCYbEERqt7bB1Yh41Akj4w+ujsUrAJ10C",
-	"4egBDN0l9RFVsav+e9iuuvUscTx91dgAYjyRi4py4WbdxoouHNVy52vWIc9WFbAzMt+a/JTjOCHizjMx",
-	"dKx3oEN4L1HlDQeNXufyHlPm7mHJG8PctQ2iOQjTh7de2bxVR8VF5qI3iv942NgtDQPxv4pXi14KgoMb",
-	"zC2TtVzuQqdX/2qq5Ap+P+WzItMaLvIwgKOyP05f6Nqs1zV+K0ZffZwJrUjFqQ5IB1eULG9EI9k1YU58",
-	"2CK0JWfRBk1j8m79T96NG3EFtt5526/mzjQ0UgldWUlVMzSC7+7i42rSmvLgvlfN1QXM6rcQQZVShzmb",
-	"WEl6A8HZUCmuaiVpift8GlihiOTfr7dGROAtwxq7fQ7KzLdSV5pW12T/7nPTVUY5Ch9X0zLQGhFH4R9R",
-	"aMMmE3ST6f0G8vdeLvEFySUK4jOeGgVpdKznT0/Y8ggMZMV0T6efmSp/L4s07DetQX4QiQardNqJ03LO",
-	"fC/O+KeKMypnq4GUa7Gjyi7j5XuzxReswRfK2kro67YherdTVF4ZYfW+LXhbJy93fK1ZU9wRthUuDbJl",
-	"nwL5pqsllsulW96+W+ayLTd224S2y+WUNb6duwlh4ixXnE71yeDMoM7QTivq23LCagjBLdv264XzkGHo",
-	"vjkohuekM8X1OtGA8DVheCIfxFqgk410WAgdPhE6pulkHb2E/dxpTpLVnv6qKfXV5WX871C2q+Fg3iCV",
-	"ulDRKI2wKRvrGSkHcUYnE4nqvpVUNrMqrNQ1YVS05/p29/tcV1ImY9Uk16ZFZ5tK8yir11uJq9RZ3bZF",
-	"f63RjHlS/IJZqh4Oe4xCuIvBcHCYjrPOb4vAWIqGg0WcHoNl1FCcSf/ovfHP7CUu7zhE3snHdIyuKYZp",
-	"754eupPek/QLpgLknE7kMI3YeDg4SFmWJDOSiuI3lZx6oNOLD4blh4jp+3yRykvgopbLfzcXUyN48D7c",
-	"K57gWgQfvLr2Tt8EAWye+9zKh4N9yq+CxoqUX/lrKZf7oAN/0CG/fsO5nvKdL7rAbNqusaZxtZhtBlZC",
-	"XoZBv//6BvqZmPNaOhHdjDLJD8upsblEfIEYjKsLFEJMllpHJybCkfp1DvGINBJQboTaS/Dg1dvMw4pz",
-	"+fam6QQiDl/jpOHyGRFxQ0hq5o+gqhz3A9wnNo9iQwrF0FYP3a3wzLgJrAEdgrglv5blKCW7dwhWpSMg",
-	"qajoOkJ+IcTLVLohMPbQWKhUI1Z/vKrMpYRuDVIX2b/7mDbpYa0qoSwsrIhr6knmBzNM014E04tgajgk",
-	"aXFZIYxT867FMEXTezoEVVhRoOKZtcYwV8W4CnYS55Fxv6IclSBDUcC61+FKbjQV32PuEZjLXw1PqIJe",
-	"QWH/a+J+dBueVQvHo29dMCjFwSA/l3C8/II16TicpRyWtrA0vDbqMGK6BxK2adRdpNHSF/25hvJe3PYP",
-	"FbdVcLSRL6mI3ISOrvuYP7Fch4ps2Ci+CaeLVcq6sTdLLE1rmdwOZUlbQmWcKipoC2vtsKTsp30MkbKZ",
-	"TjNJOqY2lSzoAY6mOlJyuSnlG2sakAN2ubIibmxJb1hiflxH0M2vnj90/smWDHxV9svXv/ENZrqUZ39K",
-	"0wcOrjzxr75qH4m+aroilVfOUspUWZlbg9mTh1FoPhwrSDnd+reUc+LVcL4x074R9+3BpRcKt2h5BjSV",
-	"vIQ1IpDjCEQONw2/avBct407jumetrtEl1xBXGupqeS0NtZSn/bggdzDccxwiicSWdzQ4tAkopU8JC6D",
-	"ZDqNsMBJNllSHGcmUgisyr/vmVadyX8kG5dS514OMCU3J34XecBEcqPCvKPH1OZGGyXKdSLNEzDyMp5L",
-	"HqcVck2znDd0YIrcohfNgLykJIkbeDaI7qpjF9wQZhmXAmYLNLfn3HrMwAxsnAX9YlH/WTceSOZvoYWU",
-	"3vVuVHyU+OLyvLwnKxSQsI6qgZIdUhydvdxDsq7ExTTGLAbHndakQyoOhOPyZ3NqF85JdXxeNdOOCQnp",
-	"W/Fgwl07M9/kl/O60Ul88kDijrMsSbJcKFG3iqfv1yBZRnCa3QADCGV1lgllpMlUW20q2BdYRNNzHagk",
-	"dFWVC9UFy1wwLMhk0V2qXGmxYTHcdKslUnU/G1WanjSaq181lwYw7rGwV3eBQr0LOiNZ3hrGyYhP1Zu8",
-	"tk2N3JJ/c5ULKcthXi/yeELaB1EtD3nMwb7qYsoIn2ZJ3MF41ii7/KZzarTnZme9J8Psu5KWZFRnBlIL",
-	"o4lSc6jlnXHPZJkUfCfznE+LRN5LRE3YK5klyJGdn39fTtBfeR8xeo0F+ZEsTjHn8ynDPKTTtN+VGJxP",
-	"T23dEm8kC95kLB48tHN8aUitwRP0zGGBrjpPwUc4IYZdM+lUydVFzlItooBoAzhJ9J0bZ+kjYUqobAlO",
-	"IKC7EdtENiRGaYT5ZEIg+haYSuohREVADGpSWwzRpuUbSS3S+tNtryiwl9vcqdwmkBuzi9FG8Q5U62j8",
-	"JQIRmzD3W4fMcDSlKQl2dTNdVDqQG63ZyMvBS5Wa83Kgx6NzKVBepBMhs7lY6PQHkD2h/LAtkpDsojMY",
-	"JooSzFScKGPxqycLZDzK5fkiKg9Ddk0YozFBAZEzbz7IxkfMLh46gWwuO+hycK4uo8sBypg703snG8mW",
-	"reE0XtNL2sqQ+cR3euIaJiwFFETn41bOwcI93o0EvSZyiUj4kTalk+laIieF5GwRlpXUnqqAb65TGzQI",
-	"o0gyHKtHJ03tzypV6mA4MI1AgZiU/pxhSFSBU+0XN5ZMgvqkU4F0fNrWZ7lrBlL/dOaMuP71sJhD/eNL",
-	"M6tAh2Zi9c/75ZnXCxyV1sI3amd16p/fmPUq9vwAgje07LmK8FA2joPNH9OktOEm4oeN/bHG8lTHH0xo",
-	"egWf1D+cLzihWIk3uSqh/uGUkD3TSL0GTA80VWLXgY1kCD8Dh0RVxMsRBGgyVDIcLEcoztIc2HkFv53Z",
-	"wdaLvDZTD31qqryrV6f+5cisV+hTU7PnZknrn/aLRa5/PCyWvf7xlbMRHgJztqb+9QX213pjt8+z9vKO",
-	"ccn5dYbjFmKW57oDKXORjySxZjiG6aSZWBtnOYDsCMdrnAh9TEGBBwjLJg75ropPdgrnagTVn1+bEVU/",
-	"HGfipR5g9dMLHJ/b8VY/HujxV38/MvOpfajQnf3gwZc3KRUFV10N8WaRqY0FDtxQ1Zie3gsrzFKZKEaS",
-	"AMp6B4hCdP69ebHEmMyytJMGhhTU2XFSVQj+oKhumSbKZA8v6pGt7zsCN+oKH8LUdb5JE2SmuMbtcrA8",
-	"Tc1tXIRY/apsF4XX3m+ufbv29t9eQ1vZkX80cPxAMWU9jDmfxus6Lu/l4EnFlc752MojQbdlKinvkbvY",
-	"wxJJOqvoY5qqZpr1uZULlK2z3JinyAhT7+6R2L/XPgt7pAqJLGeSVK18t1ZJldb9HmKeQmU3sUqBh3MV",
-	"83XcSbNZPda9Ecs/1YjFd/jaKLzmPVbCcS07DsO50sn6802DQvdmmvGiAROsewx+Jlm7REC132WyFmG6",
-	"xYnQgn9jAn9Lxyq1TndjbKCpelc0RMsv5Sq3i3uDubIUcMIWdImcv4xlQC0hhXcflrP+sBPQtLcO+0tn",
-	"5D9ZWjE8eJ0p75jKGOSavM9S4sTD5NpCHno73D3eNaF3ds8Odjden+ztXhyeHJuM3vLHMj+jcuDKnc4Y",
-	"yiKCU5WU2NS0Ngqy8BwzQaM8wQxxKneCiinVZhqYETwEzlxzfGgXkqvjjWNy8/uvGbsaooNc0t/GKWbU",
-	"+CrkKZ6N6CTPco6erkVTzHAkJGqauar82jyfzzMmSIweXw5eHV2ouDVvLvY0l1mDp4vsiqROTKhl4l67",
-	"AQ6Z9QXyZfz5ncahXHYG3IutajPFko/LTCFxTCYkXSPvBMNrAk8UBmVsNthxOv4QVCrsluLnWmVCKazu",
-	"7/DzhGEVl6HZkqHj0LKYDLOZxAb5vDfj+13pjXxWFqc/7h2o8ZkydzkW23FlUDDp3/3qfL15UKSuyVdi",
-	"ut+BNKpZrmBB/SKD9uE6Q1I4pYQ1v+eMBsdoCqE3Z4fosYG2xp1GdFzKAl0OtKxp/cld7YE7i8oWlFfS",
-	"Y2gHn036bAhm5lS4W7ItNV0ZJ0RSDe4AfL2rYUBjpe4rF5ZDI0MHBrxcg0I/lSvudvCn2/CH+Q/tn4nj",
-	"rcL7QyF/bEAQYYWqq3TpctHClX9vlCOVGnI+BQIVzikj/HfqkwmoaLeyhDZNlPcTTY0vmt8Rg8bBBTrc",
-	"30OH+3qVH//wy8WTdXSqrmU3Tz2U09H8SUrjguQ8OsPGI2VBwzlZ3nbgSwAd1TJUYfEFwczr4OpT1SvL",
-	"l/NoSmLtHV81Myry/nJdymBaJvmrCMXZTaq1PMCrKD6QDzW0wYONzsxXmwZBKGsbz1O21fhlj2VpOWE1",
-	"F5iJVwxHZN/xue9qxSMcrq/xUWvK1R5PYuAdgw8M3nDCDtNxtioeSBI0bYQBIXCUD5rPsD/dzMs8SUCy",
-	"2Jq9z/NykUMtRRG9uxi6nsx1dZbGlLEp6/yK7nzkMwZRAoUyz9jhUDmSmPKuXIeEnK+ocN/A/nxqgZeT",
-	"adRHbG6W8FqnR/VM5Tq2o0pl3tV5RLUD7iPasqHIUwNgqZ8L0Lg6/1X3DCKijXRC03cbcjjr8Q7L2sOX",
-	"+30HIFpElDMqFueQywRGPgJQNAlg1F8vzSv1h18uBkWeFP216B8izyhKD2W1ePPGHzK3lDHMMRxG6AjP",
-	"wQK9EgS4SAm4bohVXoCDv3ICHhTa1zdjE8lsFGdiTn8kmkmRT18tTxA4gn2HbIqDnYEgePZ/XD/jokU5",
-	"i5fwBelcGeiC4Jm2VNUZY3Y2Nkq1a8ngfis38faxr9oTLd9TBK7NBaMEMxU5UBnqz+ARPFYCHXiyk3hC",
-	"rHmrvErElFCGbjJ2Ja8fvn6Zglo8IhpV9cx25ziaErS9vlmbzM3NzTqGz+sZm2zounzj9eHewfH5wdr2",
-	"+ub6VMwSdUkIoNXKIu2eHg6GxcEeGMftDxCENcVzOtgZPF3fXN/S7i5AjhuS1d+IrEXjxCfPekVENUVD",
-	"La2Ltb2RlAkvTW0mCfFo4W6ADrc3Nw1N6EyluIjGufGnNm9SyNslj6nuBQiuckH9KOf+1dbzO+vPiuRr",
-	"fUHOmzQrQIzE0Pn2tw/Q+UWWoSOcLpCWayilgXpG/DYob5zKE6R2vRIiN7j14BPcGogXoskUfemLzk8a",
-	"r4g4dTq/RxKpBBj2rF5jiGHYxM2tB9jEN6l5dGvS+SLpdjj4+g43P9z1oclVqfQySNlMdDs2Jkt58MyU",
-	"OWMb5RSdsuydyZqpZSom1nSx/KFcOCqQh2CUXKvQ1K5k2X/KzBDu83zVHhE+0q6Mtj9U/aGqHqprnNBY",
-	"G7h4D9XPugCk8y4fESuzqB8BUwtYHoZnRBDGQTtWZ519rcpTZ4ZmWeApwTGw5Yavc6Wlhn/HnlD9KmrU",
-	"PZ3EJpKQM4FpqKP3EJ2+wLEhwYc77xfaKa6Ya3/gP9ED/7e52OQh+rBhpZPzzJuPqZRc7J2KuOK7Wl3l",
-	"HF/idn18unukMwc+qatKtK4syW6UHAH0U1qY4AeeC60KakSdYyd6Q8O1n/MCe0DWYJHHXcOBK5RQ6oYW",
-	"IIJFepHFizsjlZJ2Ve6129S7tZubmzXJBazlLNHOXiu3/aE63Q/3iK1lvUkQeKwQ9I5RtrX7Eth2OX6G",
-	"cMIPP3gWuYEqyxFNyhQvC7tleRvlQ2Z5k4bYFJS0DuIlG0EFoiNYGyllvKvyaCpjLH12oAXZwCznAs2w",
-	"0KYhpUKPlElDTh4pt3ojIrTe/PDENVsYkneZRhqv+WHdP8tm/FTx+ASjUflhrTz8bOJ0rqJVEcpUCs9K",
-	"uAhyTdhCTHWyJN9Aoda54+X/QKNVIQuGBh1pOtFuWRmTS3xF0KPvHg3Ro+/k/+I0Ro/+67tHhaXwFVls",
-	"qXynW8Mrstj+L/XHtrbn8M0UelxtppKSZvgdneUzlNrQYYbw7CQ1pZQJBMhQ25NDuhCuRSUhQitVR3Rc",
-	"pnLIP6IaNfU1/c4wrG2KRqSIDIIwdw4OBH/k+YiD57JQpyhIGXQGNtTFOtUcRvWaDHa2Njc3wTpF/bnp",
-	"iavy9p4FfAZTQvIbLeb75zK1tUfs5tMH6PVlxkY0jkn60TnZh5jtuVYBvEmtGLB2kc5txOYPwwCbugfZ",
-	"3uQT1Xtz1i9OVaEUD/p+OLNSF524p6177Nu3asaVELrfh5x4pYpufj5Yu7hepsx1WMXL/1jQHmXx4r83",
-	"jGZrA77LAb0iormzSaXAyj2dqeyLzb0xT6EVe/zQg+N9g+PmQ4DjXpaOE6qU6T0cV+H43VqR9HJQfuDU",
-	"njwbf4PIQaG3hBCfsZH8fQkc32/Dot/aojd6O5L8txpjQACw2sP/wSWQPY/2EDD01QN0eZwJpLySexzy",
-	"4FDYfKIzlLxq5DRWx5GJCg70qYNIG7PYQ0kPJV/GCxOLyGPjCEntl4ATKH8vgAIDvFNI6frsXYOu/72k",
-	"JZCs85H0Bz2ofZmg1r8MPz6M5h6OTDmbLIGiZ60CmdVxtMhA8uBAep/yw4dGz48hsexBuwftHrQfXJwX",
-	"Fek6uUrXaSx+ms0Zgmk+22wbghV7Q4fe0KE3dOgNHW6LnUGA6a0eequHj3YvB+/ZDiYQHS7bkDlEUzbu",
-	"+3jbhPt7YEOJloF0tJoItxIwoWha79XtKZYYxqSp9G0tLZYYB2ursfJYlMAh2PDuXDK4KkV2eUh5x4q9",
-	"dUhvHdI/J7tcW6W3ZcNLsvmh2cGIJNZGJO5NiPTxRQWi+AxJuiJQq9Cx/RLuTUx6LOv1wp8rmHllXYzg",
-	"WMmR7CM6agCUmvnJA6PPnRmmQFD6v3JyqGI6Abf+cV7tPUD1ANUDVLsVy0pCAqj7wBjV27r0oNiDYq9D",
-	"/WxhOPfyiSDuqrCKe51ZxbPlxGV3BMWfhbnMLUXKHxWNP7pEu78R+huhvxE+JzHoBnYUGN67RikqCIIQ",
-	"q+miifWvc/xvVlKC3OK+ERnC5QH3903P/fdY32P9PxnrCxSXoK8CXOMIgoFvMMJzFdbeb/ZxBt9tVOwR",
-	"5iRGWaps+gozO5zGG5m2nbO/+sztZWsqSRm/J6sP1brq6SOBZXkI4fBePU72xl73DiGl8z54C6gxwpFJ",
-	"7AxtqLe3iids8EQfeYMQH6p4U/1uoaXFWFsdjjbL7AIjejPs3gy7N8P+55the8hnlGUJwSkaJ3giSUin",
-	"skNZmixgoLMZZotytlK+jn6Rk4RVzBC820xqFLVisMg6gYxqCkBBN+ZGX1cpK2EvspuUsEeK0EpH4lGx",
-	"fNXUlZDX65FuWDb1CFEOIwotqVPWR4B6Pe7ZFkdBb2+g3vMsH5ln6WKNXuEmQqbnqti9vjge2qjc7bWD",
-	"BXmUzXTyE13RYzReK7OyXbQydwz35Hy9jS16qIOJ/XT71l9jLs6JCmIe6MUWuX1vRcLvQF+6wG16OiNp",
-	"TBiJG1avUuS2tvqhnljp8930ElpB5inUW9f31vW9qLF25/re+e4Df4lIi+0X9H74MmjV9FQa723ee4Tp",
-	"TUo/C4gJB1RsR4xXAe5uFbj4TKInhpn9Hit6rPiniwCabc1b8QIK3hli9CbjPWr1qNVbiHyCONkUErEd",
-	"Js8ahDGrAOVnYdC9jOz24YDxYeXEPRL3SNwj8UcQoG24KpegibUcWZwnxFFyK0GXU7cuVGvR5awmWisa",
-	"/Sxg3V2FnvftEbdH3C8Kccvw6oHfBHPBtWo3KJAEkyvMBZIlkaAzwgWezQM42SCtDGiJV5RaBsc1VobX",
-	"n4dE065JAyv8VX1fjjO0pwfRQ2kv/PzigM0ClwfUmDbdaAU1U1DzlF7karQDuQ1yVTo3Bqk6S/4dYpjX",
-	"Vhdw8yrNblI7kJ8JK/G1FctMKHxWLjv4VLVBPWb27GfPfn50lLZI7EFpbq3UGjFaFZN4uoxe3Gvd1mvH",
-	"e7DrGcQvTDu+NIY4uvI7Q5FeY94jWY9kPZLdRn+9NJCdtZr79zrtHrp66OpfnP+gF6d+Vcr3JklZliQz",
-	"koooS8d00vjULAqX/J99L8wDW3RPtbsEqOKOoSBV8IYxxJVBlPO8HOl8HR2Okc4cGA9tSAe5cMq3e0qi",
-	"K0TbgoFpF3Du7wRcvcHjnnIUYU6s9zk1Ekzt1V9dkXV0mCKcJCgTU8Kgrhqks8puR8q5H0Y+IojM5iLo",
-	"ch9x9tGEjrWN75G+Z1K/ENwtTm4RfqsMst0SlRZnqGOC0lqFPiJOHxGnj4jTJya9u9u8T0jax3v5FO/X",
-	"ttAvacNtGgoDU6txTxFh6v08cHCYwABa48TocM716rVwGjhU8pYxYzp0HQcK3iYmSoduJ75Sd9pnQ/CX",
-	"UNnbxkzpMG8WKnnnfbeEbrnjNeijuPRRXL6Qm7QkLPQ8Wv1v2SXCvCx3Ge93AvBW/Uy4yz4QTA9Sveak",
-	"x8U2XAxHoVkO0F61s4a3RLPPxBKv07ujR7VeS/AFSTEao9cshzNQ6Z6RprfW69GuR7ueh/ts8LUp6s1y",
-	"8HrWTdJ1S4D9LGwIV5RgfxRs/WiC8x7Xe1zvcf1TlFmukLjUc1XUb4jdblqvFW6Izy41aW0KNl3rx74p",
-	"zEB6uWovgeiRtBVJy+lBw5C6vMvy7YWoqznu9KLUHsh6IPvCRKm3wh6/YPU+0KcXr/YI2CNg/wz/J4hX",
-	"bwW5Z8sY9fUi1x5ve7ztOc5P7ensOlxfy5EEn8dnRDBKrglH2Pp6qSrrl6nf90812Obv98W4lJ1nTKCM",
-	"xYSBa7jO6Q0uXnJCJgBu2Z3vkWzjEXqckht5KYwp4yI4OGi8NKhYNQVOBzwaDAckzWeSXDD8BT++Ha7q",
-	"Dqf2X+2b3CLjz9bmKnnvmdf/0T6k9yq0kTvau9L1rnQf7x6TFOi5u9RlIi+qcUJIm6P6S1mmzTn9pWqo",
-	"d0jvHdJ7h/QvwSG9tqiHOiSOHNFshtnCnEAdkMisB0BOaJA41uHF+blqxLexoyxLCE7v+foGROuv7/76",
-	"/mjXN5yUDt7vlRs65PAOpe7JyV21/cCO7U6nrc7sys1Q1Qg4kZv1Wd2JO9D8xHy5ddsNTuHu95X7kXB3",
-	"QWbzBAuiExt4ekt8pap9KuJdwgM8sHjM/XpbL/PGRWT1Mr03ee9N3quEqrdR6TGpX5DFY3Ljb/jvhw2h",
-	"IeLaARLvKxM4ZFMaXReIUn9mtsCOVzWU3aSKwZfcZ62bgCJo7FyWKyaX6R+7/WO3f+z20ddaELkCaf2L",
-	"s39xfpp3fP1C73Dpd4gbE+u4MbW7ORArpnJgbs0C3B8HUDVM6dhzH5CmR6Te+uMTAEHva4URHCtW3fIp",
-	"rcD1iogetR4Staqr3cNXD189D9fGw3UO8deqcdgPStRbrXfLTffR+3q06dHms2WWIH5eK1q88ivIVoCK",
-	"O/Tn/DIMHHqs6rHqC7SnaIzD14pXUO6OEKv3Ae0Bqwes3u/zk4PIplB6rQh5FrbaWQEjPwuXzSVM4B4M",
-	"Eh/U2q6H4B6Cewh+QDurTqGYQF1ROOaXFRcGn/3P8dW87+/1Ud6/h3ts69/DD/serkT2WOJ1fFcA0r+R",
-	"exDrQawHsRVerNqpY0kO6KzNFaR/xPaY1WNWj1n3YaLhxBFSbhGd4gjFlAuaRsK6L6i6NjxOAXkFKC3m",
-	"JBRw6LXquQPqyVa0R4HFOqYHZgfBsllII3pF07gR+kyYHaU37RRiZxeNaaK9bapjydJkofxtbEgLJKbY",
-	"9amZ0GuSqvLWTeRefFDuYJTK/aJtlHfuP1KQmxrvx45btJpggLzDs3miaqiJHKhfAAW0gn5noH8sHFvk",
-	"oUrMCQEPFhU27JqyLJ2RVHw3Z1mcR0JZejIyoVn6Xc7XCOZibWswHAhK2HcjHF2RNB68leDQDXTgXPY+",
-	"Ir2PyEe7vIDu65eXPg7y1srYBKf0PQxruSB4pZrrCJ1IFFS4wssfFRhKoMk5YWiKOcJRRLhEIn+EopPS",
-	"qL7USHr3KUB1V7iHqB6iHhyiihsbApdllRNvEMz9vQ5k5VoSzxiZZ5yKjFHSEirtzJRctMVLO3Pb7KOm",
-	"9Y7kvSN570h+O7wswKe/fPvL96O9D+xtuegSusxzY4bilxVF7ymImdPBA0cyq/bcGs7MrIhasfNFGtXj",
-	"WUX1MrV1kxAp/+tsWofwVkPt3+UMOxBTrbRnqwc/a+poUvp82whhTT2xWpE+PlgfH6y3W/PifulNVXpB",
-	"VZ9Uy/iddrou9puhp1V36+mkd0PtsafXqH424NPgi9oJQV418BSrwsdnYgXbzIr2+NHjx5fwaG32D+2E",
-	"IdoK9I5RpDeF7ZGsR7LeYekTxs5Gx9FO0HnWImhZFTw/CxPcZaWQDwuYDy/17FG6R+kepR9aPKeNFxZp",
-	"1GryUOgX2o0eirK91UNv9dBbPfRWD7dnIgpM6e0eeruHj3jBFndmN8sHz8UZtn1o0uLf+UF6ePuHat8d",
-	"E7o1W0DE9TK3s0Jo6mxSKXB7S4Sm3pinUG+N0Fsj9M+dABpXHjzu+6b24lnOIqETjO+3QVEHmZano94u",
-	"oUehXq/4GcFQo2VCJyR51chnrA4jn419QjOr2CNJjyRfxvOyzUahE5poBf094ElvqdBjWo9pvRbsE0fR",
-	"FmuFTiB61iqMWR1GPxObhWVlhw8Nnh9DWtljdo/ZPWY/uCjPJOEMmS3I1zbXfTopLWuv7CJT571hV4f0",
-	"lL368MugckO1b6GuMhpQjEPOksHOYAPP6cb11kBe4bpOlbBPDAWrqH9yT0kq9ETWnRxkpQ+Dus2D01CW",
-	"ot1cTE9Zdk1jwsoWPk57c12gtbU9wgQdy77JOZ2kNJ3ovfA2HRWluSrN7D3X3I+KFuhtVOVra25BLqAq",
-	"h3CkwlFVG9C/t47kIGVZksxIKppmSmypTjOU49MxA2k6QeRakqHbnPyhdWjlKLVufRWicpkh6ECAOGIZ",
-	"5yim4zFhJPW3rmKILdO6G1vK22QpqE/bvENxenRbjq1ce0sh8zfblnN7dZhxRChM2HND6RYNUnx4++H/",
-	"CwAA//8y2heu9B4DAA==",
+	"H4sIAAAAAAAC/+y9i3Ibt5Yo+isYzkzZ3kNRkh07jm6l9pEl2VESWYokx5Md+WaD3SCJqNlgALRkJuWq",
+	"+w/3D++X3MLCo9Hd6Aeph+2459TZsdh4LiwsrPf6axCx+YKlJJVisPPXQEQzMsfwz128OOHsisaEny1I",
+	"pH6KiYg4XUjK0sFOuQHSX8dEIJyi3VTQcULQbibZHKse6CTBcsL4HD3c3T15hBamL4pYOqHTjEOr0WA4",
+	"WHC2IFxSAuvAC/qGJ9Xpz2cE0VQSnuIE7e6eoN2TQ/Tm9Ec1glwuyGBnICSn6XTwYTjAmZwxTv+EOWqH",
+	"O97N5OwxKjRGJI0XjKayduwooSSVh3HjmLoROtxvGOKMRJzILsMIaBkcKqZikeDlazwn1ZG+y+Y43eAE",
+	"x1gdjmmLUjwnaMI4kjPiziU4OklVR7PVCc4SOdiRPCPD0kRvZ0TOiBqQCjgcd9pUIDOIN8GYsYTgVM1g",
+	"G57DlxAoVB/EJnBMJJU00ufkr5uk2Xyw8+sA48XgXWAbImILIqrD/0iFVEMbaOtmSDLEyR8ZEQBxKskc",
+	"ulZGNT9gzvES/maXpBXZoFEbkn0YDtQKKFeg/7UIo6G9IQEs99bg4WkJ3xw4ckix8e8kkmoPu2PBkkyS",
+	"Eyxn1X2ckgUngqQS7jw2bdGEJgQtsJxVb/MiOI6Ch+utmiiYYz0OSwEtxVJIMh+h10wSJGdYIpwuEXlP",
+	"haTpVDe9pkmCxgSxK8KvOZWSAD0h7/F8kah9bV5hvpmw6SZeLEYJmwYhXYXBgv5MuIClVojgyaH5hmIy",
+	"oSkRsNor/RuJkaaoCqngLnALMY20Co1TpKcaoTPCVUckZixLYkUYrwiXiJOITVP6pxsNUFJNk2BJhMzJ",
+	"4BVOMjJEOI3RHC8RJ2pclKXeCNBEjNAR4wTRdMJ20EzKhdjZ3JxSObp8LkaUbUZsPs9SKpebEUslp+NM",
+	"Mi42Y3JFkk1BpxuYRzMqSSQzTjbxgm7AYlO1KTGax//JiWAZj4jwr+PV9phIvD0YDiYJnc5kJBM1Wf5z",
+	"9bIOB+83VPeNK8wVmRJqnPxAfnZd899e2rEPWejzwXwhl2qi9xtTtlG5xLuLRTvpUbDHi0ViaI+/R3hP",
+	"hbqWf2Q4TuB+KRhimhI+GA5mJJl33iYsZc+NaH74yQ3sWuTjm5++g2n0fuwyVTOSwgODk+R4Mtj59a/B",
+	"f3EyGewM/nMzZwQ2DZZtvqQJsZ0+DJvbnpIES3qlCYVqXCBY6scqeSmtb58sSBqTNFoG7hmK3Vdza7wD",
+	"QCxFOGXw5BR+1kcl1PsWkysakSpFilgaU2kud9MOgyvdc70/DAcpntcgjvoSQBxNE/wf9C4F0kjV/AzA",
+	"dEGi3bzS4AqFxDLTxMuD9DwTEnGCoxkakwnjpNBAFpYuJOZSjNCZ+i+JkVlqZUzJFI2GVjSdDtFplqaK",
+	"hDOOFKonRJJ4hL4jOJGzZT4KlaanbX9N5QzhJEHuegm11Hg5LA21rzkVoJpmWP/GmvUOhgPzsfv1bIBy",
+	"PmpzOzdn8dwO0qufMddPZgFdSf4Bx3oInJwUmlR5wsJpH6RXlLN0rs7vCnMKrOAlWW7A04AWmHIxRDRV",
+	"2ERiFGdqGMSzVNI5GSGFLJdkCY+M7gHoAZgyJmhM5DUhKdqGBo+fPkHRDHMcScLFaFBB1g/N6HvCeIAh",
+	"Vr+iOV4s1MJoqljXOZboYjBjQqqPOw4l1F8XA/SQjKajIboYPN96vrXzfOti8KjIGZjfFXXAUhKupvm/",
+	"Ly7i/9lR//NfIV7YX6ZhyF5gESAAe2w+1wyqOSS1YMDcAilYLvRrWRZ/3HvUQpygGZw2kJDjELeSzycs",
+	"OdJ0UbNU9hDhGuU3norgRQeubQryCPwCJ54tYizLlx72TLmQ0ERIthDVYd10ZF7gs1emySFevJ40+0tQ",
+	"rRwQtv+//+f/LeIvSpgiWLBbQ35QQqQkHDGO0mw+JlxzXwb/UMrQ9YxKIhZYvz3NFN0edgtRr8jlVG1q",
+	"TlMsGVc/mLuhnzfNPdQA0DAX3uAFfqW2l2lQ7Ae8TU0XxZAUW1v+qKaD4XL8Ph/c5TDyrQPYh+GApaQD",
+	"SxPYbxtnE1xI2ywB+LR1KkOozB6dGpb6RzqnUoSEMf0dJdDACfQlRrXE/SyyAME6eaMHQTRFEeNKXnip",
+	"aSwnCnXhYRhjQWLgvEpUrEhZt0ZfPw2RzzmZMx7g9I7gdzM/XDK20K8cUhLJDVby+OmzeVeJrwL1I5ZS",
+	"ydyV68ZAhzorNCiewVx/bRc7rFCFJEOmUztN8UcP0JXyfGqNdGKBqHDIDKDeWoVOCjlAwNTnlAk8hcV5",
+	"sBcjtJsQLtGC8IikEk+JQNgwjzM6nRFuXx81Wj5E8QS5ESmstKvaRniBIyqXpddLLUjhvL+o4Fvqbtdq",
+	"bHoOb3Q4QSmTSGg4kXhYfsn1Vk17EsPa1IAsJRqfHRSENHvXigHGJYnDB9osOFk0ayIKEUuF5JimXSlD",
+	"4shMxwe4RJ/aLtYZCBxhpNffACWQoOk0IWVpLz97n5U/4WSBDcduBYzBcGAkhsFwcMA5U1Lym/QyZdfq",
+	"FXJywupcv16lP2flo7eIyrd8VZVPdpmVD/m6K5+8jRQB/UYQXpUjeJbuivAtyATh/k3Qqjf4OcAMal2V",
+	"YhqzFGWp0sCic9WKCrgoTgDHmimEYdQ9oCmo8DzpLXDzHlJHWcYJeVQU5txwwLxKx47yTF1CgR5OSUo4",
+	"TpIl4ozJR4iW7u5oEDrzXC30xkDC/3lDXNLFhn2TNkBtS7hWg7fh/M8syeak+H4U4b9vlIgYaFGMrqCH",
+	"2mWMxssShaxe2jCb+yalf2RF6uaPaw4jQBEqjzYnUYLp/IQlNFquQBv0xk8LvZUePsULMWPyBbD+b0B0",
+	"qC7/cILAxuCdMKgfr6yyVe/FyA84NTKIYtzTqWnDrkFn4KOWosucCMmMdoFqyJjOVCDOkgS4i+hyhI7T",
+	"ZIlEttBU2jyMWSrN7CJkzeior/mrI+96OMdToiFZkADaGMsjtc41+sF8tZ3flV+hQKMK1THAqre8+Afk",
+	"QXZVYdBctIog2Ol+npaR3Jm6BqdE0arBsObWzti1h6QznMYJ3GWDodczktZhI7AAc3alFFZ6FnRJyKKA",
+	"4ZbLibHEQ7RPEiKJ6SWc3GzfQrdU3S5oB6tsXT8lzQ/yrZCk1xVaVENvJoSTNCIhzsZ8si9BTBYJW5IY",
+	"He8dbij0SChOJaJz4C45Ui/xBEcSLrTlZmvnDt1dfz0tIro4y+ZzzJcduZwyB1nL4Vh1oTrWKccxaBir",
+	"XM1r5q9lddamuPx80tom3mpq2wS4mmKDIHdTbFLemIK6lERoe9reDCcJSacBYIdaIeBSREZibW+0qjBm",
+	"VDwYLpckCOedEWdZGgfQnAWxdBdNOBEzBJ9zPsXMBKommkZJFgN/9EeGEzpZKuRUdxzRFO77yd4p+iNj",
+	"EjBBKzvVM7OUJHRnFhEXde4SMXlPHJ91sncqwktyk5WN3TSVZEp4kLAWrgtAw6wleFVyiJ6C5NN4YroJ",
+	"ijDnFAiqgwgSdJpqQpRv4oEonJjSVtMUcSIWLBVwvspDJYAQ1WMlVySVP7JpGKDne6/QmDGJoBlK2LQo",
+	"lw4VU4GvME0U99rp9GrwSM0Gn+wEkUPiMYG3JBXXhJO40yT1KKLNw3YSAHEMiNL5FT6J+M9qkJAWFsar",
+	"AeXJ0dlvu+fnB2fnSEiegWUZcSIzbs73/OTo8W8/db4GCjGwGqR2vvPfzg5fvd49f3N6AC4D+ZaHaI5j",
+	"T1ovYVOH+WvugwaAv7imO5LJ2R44R1UZKVzwSWjmilzLD0PLylgurflhNo2bPG0qYGd8ilPjgiIOfHeh",
+	"kH9QoTXoTYxzkDaRFOZt9hdq4imTJL+EdZtZgc3M5MzBr40QeudUd8T7yxTPaXTsgWJXKASZG2N9iSy2",
+	"dUEY/ilANAY5uQjlXFuayZnnhqd43oABSvPCtW47358dv3YuO0CYVHv9rBjR3khg3iIQjdURTCjh1jT3",
+	"68Vgylm2EBcDZafbuhi8Q4yrn6NMSDbXPzM+vRi8e7SaH5Y/s0LvE04m9H2RsR8MA3tbQEOnLivsAIRp",
+	"Z1ZkfLphbIqNN0JNf5ZNuk0vsknH6TcALuHpZatauTAwdnjks52xRriAEFHCd6ld0nKkacH6U5aQjthe",
+	"bIrIe8lxJEFMJwJNOJsHMRplAt7HHFNvjuNqyk1AV4PuVSR+B3/B2twfBCfz33AUEWGw3H5eEaEFWWBu",
+	"bX05Eu1UsOjMNgQkYny6o2a09vKHpit6sPPg0QidAhzNnbXykZsKiLNYJGAUKtGUDXAgjPVJ2IHU48ky",
+	"WRphmrAxTkCSVQIPeIMo+uwPJ9bEY9jbfeHvKuQ63BbFntZA02pAYq1iLWAy5nZjWlSpQKvJAmz33vCc",
+	"NT9Bw8GCcK1FbngRdZPaIYB9alzEGbSoGaBq+pUr2X07TNA+QDOYuozQDKUPdcjW3C2Ic41dUMQJeGlg",
+	"ez1Lz4siF+DWo/CySi+7vKiqp3qXNro8rdDYqOWjppfOjXrXr23nFd3522svXzfaVYtCtRy//zX3T9Ye",
+	"3WFeuRiyYbXi6skYMzlDx4f7e0DhtYt7MKRjLeHlkqYBWeIHmsaIAi4DXIxXmtuJfcpOlWiZm9AVldUg",
+	"8jad+2Ar/2maTqzJy1Bmknvqa15Xh2NkY/C4MFECAkk2Qns4TRk4EmmLQjxChynaw3OS7GFB7twDW2GB",
+	"2FAgC7+ncyKxUjK1HcExwOiISKx6CaPW7yogaVtBvVBkDtVbjpmjDY+VcNeMy6qFxovECoL+oypuDy8d",
+	"51Yjf1amvQU5s78NH+U2qDPVd2E1nNYn3obUXRz6MF7UYkwpZG84uHwu6hr/8FyUGjOFqI9r6QAQ83IX",
+	"GtfydOoZKDdfkFTM6KTW6e94QdIz1aBkqCwzf4UIqM5MYGVFbSxbYM+tXWp20HLX8WKl9uXD+/CuiI0F",
+	"+LwzWNZF1i62KYgoWs4uiyKNgsvtiSaltXeXJ0odb0+OqAzcWX4o96yjCo3ySvD0mno4taASt5vFTTCG",
+	"Gb8rDecCn9ouD7R71/k9lF2cE29dNo7P4tnd8dYGi7qqBSr7bD66Lhcu1DI/Kgt+QaTVcAirMmm9ecUz",
+	"gr5hgFn+SDWBU9JzwCIKs60Y/3oTjc2KJ6N3FzqOF1hGAb0e/AyMUopIQgDsNEVj+Fko1iWNSI1XZHhT",
+	"c/yezrO5iQNAjHvur2qz2iQIoLU+RtrKD3OOBl1J0IkbFYjOnKZq2sHO9rBipX0HysKERIb0NnI2eEyS",
+	"M9tYdcxAU3k+40TMWBIPdrqv60PdQZwZyNYciP1cCKa16Alw0gAcE0TekyiTJFZQrD8vUTvfbnFcPSN1",
+	"GrVOLLrGLcU+0vRQd9iu3gMhOZZk2uovd8qShGXyzDYvo7obJ4TmezjFIXd6/Tv4sgnEMqmJ+5SzayUJ",
+	"iBnmjiZPEkLkA+EQVQFWkoUYomtMdZQ14wijMb4kSNI5QXgiiVEUqZbWBW/B2ZxBB6MvT8l7iVgaOB81",
+	"1jmtezFgEslgfjMZ1jNF1tu1eU7VVgcqxRkvO6w6N1CEFaldMEHB3dxcHzRR53FtvJz0WnQEwplzA3Q/",
+	"asbogXgAMBIkYmkshujBXP8wp2kmidDBQQ9m+scZy7goerRua+NEHoL28J87v25vfPPu4iL+x6N/XlzE",
+	"v4r57F0wHg3OKgzHxmMGUx0VVjArH+pQ4QFNI06w0GGaxsUXXM+MqcCOpaax49AUQvhTnJhjKGz0v4fo",
+	"6X8P0eOn/w0w2d7a+u/uLgY+BfwU7h7hUsdMkFNyxaw7PBZ1cQYcviGMorwnusYCcXLFLiGmQBgSCHA8",
+	"fbmHnj5+vuU/kG9Sh7+D4eAHslQO4JzNKYSKn2ULwgXRHll7RAhY0/HkeEH0PejoE9awteICGhqW19bQ",
+	"tLTs2nbhHRWP4oxOFW6eajVEgDLWNS0oQa0aw9A+I/j45+aUIXu7varzC1N11uKQ1VsI51C73jC6+20p",
+	"UGvnCWtTG5sXVau1Te9Ny9q4gk7PSu0Ivfb1b6t9bb7AVb81jhcLMMgrL2CEtZlQW1NjtHd2OkRzFpNE",
+	"O1hdZmPCUyKJQJQBMPGCjry3Q4yutkeNS6heH/J+QfWDd6a5zJBrPPTXyRtcJpQrnNCYyqUzcXoLKXg0",
+	"0lQ+eTwYBnx+weemKfVEd+VAKSeFGhhhqZErd0DNo7ssjOGhVXBesEWWYJn7/qr8bwJujII9tLdhPnQ+",
+	"z6R1wa3gAK/jEM5BOBfk2VcbJI1YrFxhD47yf/+wd/af21tqOSN0ZIXTmRYsRo5voCQxzLCHD03Mh6YK",
+	"nZ1cCQ+r3A7TWCMZrIk7nNB9tCgCpEp7nVMSg+5HzTun6Y8kncqZz1Pns2Y0QPreHO7fw6l5ixB4GlJo",
+	"vYHfnXAHtFhrz5QfuO7lQcPoYEwYQOlKdEdnGzPZ7FB7D4ApEUaL2wVUWY0Q1oQE5eiFF0rriJPNmKQU",
+	"J5sTTJOMu5RCbJLv0suNIWrgDi7zNrVbyB81bxq+sWbIKqc+zAGHwJXewbzTXXOJokQoxYv9puN4tG3E",
+	"u3cj9IMKbUGR15ATtAugUwLfPkl1MHkao5eYmvyI3fgWPwdVozeyt4UgDlSTY3ROdVCXDefDsHM/m/No",
+	"hS41UY0rxFPWpVVpDY5ME5rW9373IQxgLwdXN7i6Lg6ai0Cyp45jaHNpN7ehd8M6HM9vcEwkpolOKMBS",
+	"grCiutKFqGScm+xAkrgMiIqunbo3zgdKOHuS+jW/Nl5siFbPKY76h/xdVaP7zCd6I4jLFHY8Ae1nrBg3",
+	"59qlto2U3iOg7MdCnnOcCg28WjWlamd0lTN/rdL1JbHm2hWQDFmUzKasK1AfxZ5vqLHCfLJQ71dNYlfk",
+	"EruadohqGq01dPqo8Jhl0qzYLS/sSTeG5yd+RVKrYQnufmQZ7dHUtczDynJoKP2WINLEH2QLlhY2TlP5",
+	"7Ksg18lrNGm76OGYUzJ5ZPVpjrG1cz4QnXbaUUj3UruFhHIzyjCENm4T+Rk20of2ONzCPoeAWGyCziFO",
+	"/iVOBBkiE07pKw3V98FwAA28gNGOusDi6sxYpV/t0KWf3Uz+Lmsy3hjjYI451JdVvd3Y13MwHJyfHP1M",
+	"uFVGeh/0uwp7pkmoKRi56Dgh5T8skTrBXEDTs2UawT9+VpKUaqGVxoeK9k85EerwIYmByUCyIJFtepQl",
+	"ki4ScnydEi5gXUpjvk+UbE2FoAxygXQ7iINUmXPmJJWGR/P2W/lW3G4tm+cNUdvGwbK2hQNybYviclRg",
+	"p6CS8WUQ9AritR8q5+N/dGf1MiFE2lOAP0Knpk/DOzv9g3+C+peu56jRfEKnZdeubqzJKyoD3Vu9gtw7",
+	"qFM7r9R5jS6aB1pjod9JuQh1M2Cr5k77xNlQ8M++Odta5D4gcUGHvAfQzrx9VOT5dIJP3YLxUO44P6Pm",
+	"Wgk31AAhuZj7qalWTCRVfWI1SIK8augtreBRUVlVAkExPacDYzk9b8KWc1DPVnObf3awrQJtkbUmvPuk",
+	"ktetnH0PSAxn6cH7BScinNRdfUfENbDxcmDZj2YkzhLQ49M5EaOLVG3StKAC/fsfyPy/f++gDXSkDf87",
+	"6N//+DeaGx3h1sbTb0ZoA33HMl759PiJ+rSPIcXdEUvlrNhie+PJtmoR/LT92Ov8lpDL8ujPRhdp7r7A",
+	"wHbK1CI2VMMdp8ZUGhhtuzCBLmoYmmqfBTceuSJ8Cb89UvP+e+PfO+gUp9O819bG838D4LYfo90jdfbP",
+	"0e6Rbj389w4C641tvD3cfmxam1Sx24/lzDhP6D6b/95BZ5Is8mVt2j56MeUeZ9qNsbiX5zlIFAV97nW5",
+	"SA90wkgFObS18Xy4/Wzj8RNzpEGaugcBypoROEwnrElBXpZgwH5gLf060tkmCjYHEJyyrPL0BqGpRkZQ",
+	"FoKwV8wkU7nzeuH1i56ANFHJeKQ6FS3li9lS0Agn3mQu+WbR7FeX/18b1eyKinahRPmnAQzBgUWJyiag",
+	"y6X3HUyePY4n468mT+PHUTwef/PkyTdPnj0eP51sP588jsjjZ8/jr58+++qbcRw939raejLZIltfPf7m",
+	"Mf6aTJ5HT4D89Pb7L8h+n7P53fUAps8alvl3tbevks0xlPFj1UTgZD4mcdyUfqOcbZEKZDs551TGZKR5",
+	"zHACjrS+0lCukKpJrFqT8wvHyxoPcuNaO/GzRl7PaDQDbTr0RJ1TGeqs5QGLjZvFtkFWF1aXOjWgtLql",
+	"/JpUIJ6Bj53JrXk4QeMEp5fD0OnxLLV5NiHnJoyJhZeUrpwT89ZTYHa9RuFUsB+G9TkCc+WXaeJy0JWh",
+	"tn7KQHut2/Jb2XRwClU9XBrmWkB3+4aNad0r97+Y7yzEMwjdwKLPDLKzlVMwV1PIlaRLw6g0Xlufl9AK",
+	"ZPtCgVrVR75bUbE2J9CrUbjWQ1WrGOoAueeZJ7JyOQQd4VwFG0kjvoQRfiABIuW7ByyycUIjsDo7bbii",
+	"Imp4M4xAgqTG61jPqP4j1aokMzlHbWJo8EKeAqsFw0VYnQkMg0QWzWzP/8sNAVYZoPPOo1sgThQZ0fQ9",
+	"SgjmkryXNQRSt6ytfXVqhzLVrmpB2GaiLs7TeJ6CJYHXpvDZZ0qNlhx+jliaksgolB1eh0IGQOo73K/x",
+	"pdaf0eG+b28ozRC+A7rnkcfNlK6247/dLJZ3sK+aWrfxZfi2UK9FocPYZOWUDEEoA07on9om5QpXET6n",
+	"KU6Gbs2S2W5DRGRUd1w4Vshoi/0VbmFpV0MPgPVH6StMQzUAzK61AOMyM8ZFNatflq94hhLzKZFt5Ka6",
+	"lHPoFzaT6iG7bckbZ6cmw76rkSLUDJWtzYmcsbh4pYru3wQ0/WDZiCTjy1MiCutrsiA0rdgbualZcVYH",
+	"BZ0rdG9Gokuxokypu6II+noFaRZYCBv94ZI7z7BAY0JS52/hEnfo7xqvFZZTTaRMONMkS4CHkjOyRDFD",
+	"KTMTqAtG52TowdtEzah8sVbZuuDkirJMoBLVqqKg8YIphE+1cLSCRBm8BRMwoyCibrjNjQf7V0uYchwR",
+	"tCCcMhusoVngYvZqb+tmOEh47ee09gMyngRdAJuCy4YDWMkJLKQpfieHJxVoSq9Iqk8nYnPLvSy9/dUc",
+	"8AjcYtTVWHA2JsIeXgRZuPEU01ToR9ZAHkkLelvDqgy9zyky6Ol8/cggtWx+hZuK6F7hxJXuktesgHqG",
+	"Bmmwf1bRVFtifaDp7YZBpr8h6eBEVMWTxP6eUy1LlPLMwkWiNMvL0XWSVzSJPFHTtIQ+VUvGjkkTr3yo",
+	"jolTuQTSXccz17ctc11FrpraHpq6q/unaI12k15TTNkIiim5yrM8p17RDaST+s2vJ57UjtTi9rECMHNu",
+	"wWbWfpO6Iga+U4Szya/CP4Q2kM/U1MZfQ307t7r6Jvm6q2CtdaIx8nMdirJJI0p6yYDXxxitxIevkApV",
+	"Lm821srifH5PQJTPd98iyKvWDuhV4kjnCjbzhQViafAr6Jkrabq5va11PU0BHn3WVrckF/ObwHntG15d",
+	"TOc7XisBeG407qKE7/lad7p0v2q2VHdFW4hBlQ7k9/dHLOQZIWnd62O/l18cQDWhPkgfC3HtRU5qJ6o6",
+	"deoxjA8jSa2TvtHndEXlEv64BdRj0I90QqJllJDvGLu0iGMxwCubYwpJTSTh3t+6wSlRSnyvRf7DKphR",
+	"WEpl6kCb8mpqh/EXWDeOt+YqcNZS8SW29y0oR8uG1nzw22I7Sntdj+MIDVJHiPxa5yGIVVkL7XpoqEHR",
+	"H674y4okqbTqMlEpfS6sIvA9tLSWZkXyFAyVzb8V42L17/eXa9Cbr5NAodv3Aa6fXIDrcGDMPN1O0PIW",
+	"txcZG/J33ScSioLv62CCqpFaG4nancF0OxCPC/nh0CLjCyY0AlsK07SSYFkr8O2h6RTcfRsuCziS2IRX",
+	"oG5UHUvsVtcYwBLcPUhUFtQV3MqBLblqALdNkAbNwxDXe7QNERaq+Jgqu5BmSaILIupfwBKsflSPm1X0",
+	"BxyP7umA7d6DB2y1sEerHLQ5Y9s3WerjJvGaB64dMJOsPpLhO1MOTlnCEhpJk+JFb8wHgHZSg91A8S77",
+	"L9iXrt8Wt6dCK6BcaW31KHcswqHu/lekP42NzUKbQtDxWUm/FWCkwj7M54VBoJFxEeHozemP7TbDOkdg",
+	"b1PrsITHZ5238HPR5mm3EaT+8GWfTmuDzGP4Vh5LuysiMcOPnz7bwVuj0ehRV9AUJ20AFFy2GV3sQbHM",
+	"j0LZy2sIXvmUXDdQuZRcG7qm6Z2jbqamYjfiZklDw0S2SXi2lKWky1T1F7f+pFx0y0qI7XzEW7VaxWiL",
+	"do6juB6rYDH15NftHlNxeZP+NGUxuckAeVX6dUdIibxm/Ea7kGQOjtmmeNl6w5RDvxfZwG3PALorsjXf",
+	"elHw7tfoV7zmef3It5gboWuPU6mchQPlK1eRDYsL9atjVr/mk4e+egsKfbaLDH3zgxvddygk6xKLNJrB",
+	"cbo0sRVF7ZCfkPPdh2HxM+QV8T5X4rXN7EgydTrZnDg3IFcBDKZANkOoMqdtMm4ylthfR2hXooRgIXX0",
+	"sm0MRq4xsRln45K7d3H1OwOSXlHOIM3rtwvO4gz8ZIaSEv7thLNUkjQeVNyvi5sM+cLZ5ehdSk4jWUga",
+	"6mVdNVDQqjtq9qlDxD2XSRPKgYUfVl4Eicirf7jYZ4WX3+rJtodG57OYYUH+49sTksY0rS0SUoLU7e4R",
+	"Bu+2xyIyeHu8JMtt7Wy0Pbwky8f/of94HN7QhyaiApdCl+Fc0TnEdtPKAdiml3iyhHzwWTEz8HGw86SC",
+	"WOUW9T7AhTSZ14QTz5ME3PNgoJATcMXPrTBlE/G1yQ5D2MD9a62yRcKxznGKpzqLsZ9fKlDetvr05/H1",
+	"HbOjVZJcVrcKP3fZokKKRNalyVTf9PNyxVzN5rq9Nir7/Zbh2bIUUmuRuDKqb8FXWIA5QSm71qtaIVHL",
+	"qW7vQbI9Y4u/7Hp4NolwJQEOd2EBO1ZoX6OoSW26lYp0HbnSp+GF6O9rrCEYsByaXrSnTMeRdd5Vja3b",
+	"5qr6V+vYGsyTVlRXr+zSqAaZlZzy2kcouPGpZCAd+xltQjk6tUTE1OYKXKOJ8ywWlhIrXKxCpGcIjjrI",
+	"Il7x1Tl34RmxtfWJUgBrKRxWaW5OtMORaEo6Dg2RcU0q7rTcxVZiMOvIUqqVlkOd2IbxvNYi1DAbIp2q",
+	"dkaSZEPIZaLLLtrJYP0wu3WaM4l6kiVKGI6JngLWNMfvbSK4x0+fFVypft3a+AZv/Lm78a+di4uN30YX",
+	"8H+/Xly8+4+Li42Li39cXPzz3f88/D/d2j3658OLi9GvumHo83/V14DwSGeFPEqeCXnCEhp1FOrOvQ4q",
+	"rxuYE1YZ4I3Xw96FK8ypUgeIptDQliDPE4jxVDMgN5x+jrQSQgmNCZYELTDHcyIJF+AUzl2+ACzQX3+h",
+	"EWQndEOMXu8eHaAPH0boNejBLVsP2SK9Or2Kh0wTekkMn6gLhQ/dWswPYMoZE5QwSBWep2uKkQo00IU/",
+	"4fmU2lddO/gpntNn59DPbouq9SVZSFUlJC0Wedcbz1PbY9ADWUAMC5M5TwNE0xLEFBjJXJDkiohAhGw9",
+	"Q5urYlaOnK06zYXNybk2x73byPRVkJZcKdtUQxzJDDKtm1xbN33mde/Ca++L9is8btXYxgB5xtXIn5VH",
+	"L0VOdU/Z504BIKlj/XLfVxxOZoZDJoM10/T5zE4nbiEPazLuveBVs5aHlHXquh1PGPTw9fH5wY6247pA",
+	"dirgDvrV/E2Ky0cdXWdM9OHvgqUbdJoyTly4ofNKWMuRYkXmxvXpnHwjqL1d1bxbwWzNDNhsAx0GyNsX",
+	"maHw7S/wGivfez1Z/Calsv7GG0P9Ko9qXOOH513zAmSKZGUQpjL+Ufp3yd1JwI98vfnJ+ajXIJqtHc7p",
+	"3bYZ5vE1lF5LbdYO9VzqveZK/rsJ8zRrME/RrQR6BkCznkdTdYgWx8qqH+UxZLEC1e6UYx2xa7W9vmfa",
+	"CVPan/h4Mik4Wu6a4jinxIT/6eR3YPA9wYrHWUmhXdiQt7TKN2+1ga9FdXXhU9XbrvC5sM3A97L7VeFj",
+	"CBiBZmX45MdZIGvdkqgcmzh0exu8LOLk/YKJ/L2B8FmV4QVHM4jLjRjnoFeMdT7OXP7U10ISrgaO8AKP",
+	"aULlcnSRtqdj0Zso3KqIJQn4q+S+TbXsmVpkbcyteo93VQsbdBu8hL67Us0YXotCiHEwWUw+skKdUGTs",
+	"C8akColdYSid7abLE1ZJsPNhOHBEUEM7vMtj2widWUrZcXllLyofoA4K1VUMi8dXT7fOi3JpdemCTlOw",
+	"ABZ85tECupSImfH6g8dBizZiQSLhi37ImzBXu4yXRpGgevpc3IQmiQ6JNOns7Yz+MCCQUWlCIUUjVqed",
+	"qkIGFxmqGKnVsOo11/qYFlTrkgrGmztYtDqty5xeI/a3xP2ag4RwNqXPzqVf48IohoimUZIp05EGtfnd",
+	"i7mL2XVqVDYg65pIyQr0bbsznb2slVPWm3GtHbe2bv8PLWCL1/LW0Wu6Ve9tn9+xgcS3x+8UNrsev1Md",
+	"YgX/7Rxgznl7cc72MeS7P87k8cT823PaX8coX1ikN0Xgqz9rsHMpeqD4tWJ393UHLXy2V+XQVcXLJVS4",
+	"cBOi3QvzarjgkNeoUmnTCf3VJeO1zWy281eFudhFY07wpbrRjTsZL9GFv66LQTUSIUcuURZSPoHFmzU1",
+	"L1wyWRfXDJ+8APvQTB0zkBvq9ylBx4ijTdAp0RYNqmEAWcvnX9pwkBpRcdmaKXTl5JzDTyy7aPABN5wY",
+	"vNx6AHi7qbjU1WWq5GGB5azO8ZODt8USqTbe4q0DpTdm815gjkBmXH1WPINZX2SxSYtS0kmXWhTL75Ir",
+	"koDG0wTwx661JpNcJ9RGFPB0YbJqV8Ew5SxbvFjWa520ZeGSLIGnM2HNCLrZ6rNwKfL5x7DcgmLKj/b/",
+	"dXfjX3jjz62Nb979uuH+/dvm6N0/Hv3T+9jBuAS2sDcpvsLUeHaGztPky/Cojj0j5Hq6S22yUhjwjVrT",
+	"bcxputsyfakE9QRlaXVed44rzR/k4Vh0SbgqY76qEQQ6GuOkKlxOUulfrOO9Q8TJlKrTCEZPZXLWJRvi",
+	"cUR3bVPlA4SFuGa8JluJ/YoUnrFLopdilrEsLbPwcrhxg3Wq6ipDFXIBtkzVIp7aPXrTebsNEvCsqaaH",
+	"RSSX58PijL2DWCfSksxVYr6/xCA76N/i38XMIP+e/7uYGeTfs397WUHWTgJykEZMCWBdMjkR01a/Sc64",
+	"CXmsnIVJHyg692RtQaRAv18TxNJiOjhJUomotMni8ppS+iIPTdoflia+MsMmhmNxgdNfJJimSnUB9ewG",
+	"w8Hv16RzuQm9sRMzhP37hR3K/vD92wPgxfMaFHvOrFVOo2dbbBgwtN3kfMwz06F8CQJjhhA/H6hO/VJu",
+	"YQqcEYHyKfLwQ5xJpuTICHJqWiP10rpY2OJzPEsqboorpESurLoYU2jzH+ucXBsJTcnGtq/5sDXa/PzJ",
+	"JJ6SjSmW5Bovdd0am2FZUOkPtw2whg0ZBo9d2zBY48t7RjjFCaDL2euNra2t7cdPBkP3768AL3Lw7RW8",
+	"8X4tL/xd2cBVVGgO8Dx+9pXJ55AnowB7YJ/C+QtL4Vy+GUHl3boFk8uD7/qo30w1XFNw+RIFcQ5b9Z+j",
+	"LM5h29wn43kjlyOUu/zanPV0UvSDsX0EXELE8lHeqKqcVMASQorB8u0N8QL26S/NonlM4d6y/LdCDmmM",
+	"zk+ONgJl04UuC+Q2J/ElUVshEYlBZ82uTEI4lnomCquTrthYVgvdNJt5k9G447YVKAUU5r/dtXzognYe",
+	"9W7BOtPSc/i9nunKFFhKHM3KXEQAGatoYl6MNvU9NNO6eXadFgwCSDuSqAem0PqBKSbxo/6Uh6JwUq6k",
+	"ac3QLE+nqye8JGQhPCcyKuvjLG7igbdr33KzD8lgicuSAUJtdQnoHMRm0zmvQFtnimryPysfezeSVE1P",
+	"UWpB7zFTRXjqTv4l5X316Sv+tvXZy0d9ZJnQZkyHZt7DC9w3wmmAg9cerwJLKiZLk6HRUMJYZ3XUnV3k",
+	"hyDSecnqbq5wL/Yj5VyboMXAZyRWwfWcA2lgqOsItaO9ecdisItXsdh68mqEVYRKeZlrQh5gSKjOblBs",
+	"DWWE9JTz1d5n0ckRpIVg/6xdkrWTNAT7lUou+EbA8qtehylElUsf6pTrWCD/ag1RyR/AY8Vem9LqVQuG",
+	"L8I0Fepw7N75yZEiLYwLHQQFniwzTFO9wBm+Ijpz7pUZt5Ad17q4kBgGmuM0U9JXxgkv4oL1CdDzemMv",
+	"sBAma0bECfCpONGRMBqU0QwnCUnDFUO6PGdhA3qoVYHPUdqQoIheYbfV3mbsOmjJc5zWStfS9vMF5VUG",
+	"0IStYqGAX5sJoxdlXAcv06RYrsli9YRxV8IdSWYgWPBvvqnO4tRVoQ8oLbafxM+ePI6fP3vy9ZMIYxLj",
+	"Z1/F+Kutp48n3zz9eoLx1189nkRfbz3d2nr87Ouvno+jr7/ZevY0ev58+5t4e7zlC4qR4IOdwYb6vxcH",
+	"rw5fo72D0/PDl4d7u+cH6PTgpzcHZ+fw9SI9Ojx88eL3vRf8p8MXu/svfjx6c3l9ev3L/s8//bR/sLX7",
+	"/ujxT4+P/vz+8nj/lz9f//n691/evkz+9erg8etXp7PX+7vbF+nR/Jenr8/j+S9vD5683v9+/suf0fXr",
+	"893ro99/efJ6f0Z/+TN6erT/y/Yvf06/OjpPLo/eHl4fvby8Prj+5bsf2L8OL9I/f9/a2/3pl0P115+/",
+	"b+3v/hTt/zTdPfjuxdHek63Xp9+ff//k9dvjhNBvfnl7+eJo8+hP9nr/1fLo9Ifsz4OtzYs0+uFy+b8/",
+	"f0/ef/fH1vvD9PHjX/Zev37yr/3X799fv332Y/LT9An9/VV6dSZ/Oh4/29092mWv9vb+eHV29NU3L3aP",
+	"9i7S3a3p7tHBm73Dn/bP+Hv67JLHez9EP+7N4qMXT66/Pvxjvp/8a3Z68Gr83dHewdnP6TMhTnYPp//6",
+	"8X9+4t/L64v0+en/8K8WFP9y9a9LycXlk+XeYfbnk9nh1wn7Zf6/J0/i599epAD2g9f7DUfS19/6cpU3",
+	"hkSsVoqr2n2Nqlyd1D+FKsnNgnipaV4tP+xy5EivF1MSeMXqnqoQ13Coy77Dm5jzD2agQmJ9zeUG63rd",
+	"l7jc6rfg9rnSCXnOGp38EeqPuuoI0DJp24l7MV03Pftd2VB4AUvDE/mnr5h0/+C7ZWC2PV4s27VApm0H",
+	"/wtv1KG/pWCB39WOYI3AuhDHbw9oFMS1Nq2L16xO7XJaf8J3rHXxZl5R6WJ69lqXL0Dr4j/L7ZiumumD",
+	"9hrqO1Zp+0DYFIDqKoYydoiaHGx+kbWTH/bO/nN7q8myUFNAtxjA273g53AATqenbdXRtJ6ksUIaoKwp",
+	"ADVSoY3ooS2q+OieVNjlwnTXNEn8Z5oKF/Cpvf0LRnAqQkxEzTuuzrMbstU4g9c0XI3WdyK9q+oAwryH",
+	"inHJ0bIdl6tGsnDcTFNQczlKWW1/fZrfELJcH4LZfMZnubtH3emaJk1s1IxdG98jRYLh1mt9I3oJKgm0",
+	"x1LJWeIjq5erv+pMlntbreyIAu5vBWXsRkY37CsUPvY3pz/a03lzmN9CXaI1EzqoZcHtK/bTKVIoAmqr",
+	"hKaXut45zGffzqbolzU9bOocbUrwyieohUEnlLBuhC1ooZrlqOG98cVlFZAGVFzroIYeesO7khvh0o17",
+	"CS1q4fexxPky/WuuBtCkH9ulq/FVzJV2Azz/8Sx88fViLsmycRE/kOVKkyv1ccvc5cteA5XqEjsdfHeS",
+	"0IEy2Bqc6VRHdK5z6N6+FFIxTmUtyPO2u7ZpPfS9kZEb2f9V1F7gUEJazQkjqq8BjmNOhDNxtG4cPbRM",
+	"7YwJqSS4nQXjskOK4QYAucUGT15xv4FjvtIil6ebNuE2BL5oh4IIMnCUnC4CxDycRbEspEJhbMYdLGAO",
+	"yel0CvyanJnJtb1LyyvAG0HGSzKh77XlnlDQr6jhdtBD8DiFODP1g3jkzWC+Gm9BkqeTCnN664p/cZ6/",
+	"uZHWq73ZXM+QQuQKkpJrDV43Pd+pjS7tBb9bF/yECCYd30WzYuW7kphVLrxHhbVI1kQvrqfZzRNGlpcn",
+	"ZozLIZrjaKY8Od06zfHDLSvmktdjOb9yfek8v2UbJ7THiUm/UfiFstSVoLIf3rhMHcVfKg1tZv3SL/6Y",
+	"1Xx6NT+XeuydvKkkFN47eVNOQbx38ua1esDyRkeQobnSV/9c7q5/LY2gQrMq/dWP5d7qt1Lfw5TFpNIZ",
+	"fi33hh9L3c/zxNWVQbxv5aG8T6UBX+tk2pXBzO/lgczPpUG8FFFv1L2qjFZuUB62/L1+/GLaDe9DJVuH",
+	"962ctXqfCsPFeO0PA3k7Smk0yj+7EhqV8mv1Vd1KaHzgl7vP75Wbp7TIPR20UAn5Nb9Xg31dh2CYr1tM",
+	"jaTd9A0npa3U1I9prrwy8BOs/qxS4hV+OUyvzG+HJlfIORaXbmL/xxPC5ziFLIseHYJgGMaXu5BRmKrA",
+	"Lv/nwxQXP5gXN86b+MTO/naSidkpiQjVO4CgZbt4+CNfN/x5qiPAchLr/3omMa/+6vbg/3gKhbde4Oiy",
+	"PLKJESp3eKHcG/apWGAoxFL6auBMEntSla7+uC7D1jKN9hRZlt4Z+x9LsM4/VKCdfzrBXJA48KMqPlN+",
+	"VtQ39f+DPwZbn3ByRcn1qfUJVZETwN+eEiEZrymJoft3YvXOdFOnxWkKpfV43+MUftH0bogMXfSfZ0cK",
+	"zbf2KjVtSukiJ+qYjZwrMhO4/Q8Nz18rcXg1TQKCx4aJT4tM6isx9JNWulT5RhRZLkBgLJQ20XlfFwuT",
+	"nreR3DSqmJuLbbVQqhVGLteVqisG05INr6Z0TOM9rRmxvkfDqB7h6Dps3iU87koLbVljiXx1GLDYIzyq",
+	"oR8dRtMtw6N4lLrDSHnr8Gj2iegwlGmajxN4OGuGqbYMj1J9aTsMWOmUj9306tYmN6jt4o8bfK9rhwy1",
+	"9kcrPH/NWBxsHB6r8jR1GLLcpzpyK/wKzTxFhU0p+lr7DnqVk1ROsJSskHmiMninFKA1RK9b72YCv84Y",
+	"ZVLeNkb9JVqlZ+1taRukEfHaO7feqvYhmm7RKquvw/G2MRqI4SpdVwN7MzFfpffKh9bhCV55iBstIvzI",
+	"fnhX5FJbSrIB51jj/mM/lVx+rkCJeW9+Pm66bs49qnnv0PP3dejxhMCg8OdWoXW0VCCdGBWE4ap2tmQw",
+	"s53b7S4rztNih3Lzhvb8kiZWv1S3Z/io/UKUBTS0s4b+kFJDlzV4+Ob85cZzsPfoBBu5yS+fRO3MThPy",
+	"6lDtbCqNdmO9l5jkw4ea7R95CFdcv/qKXPGycKqm8K7VDh4InZVp6CV3MZYwyPFia7mm2ZxwGqHDfZWj",
+	"FBKfqZuKLgacMXkxGNXleVc/bohLutiwDlEbQAIId2nf56bSY+0KF4Qb3TxSbUfoF5YBjdFr1lG3c8YJ",
+	"muA5TSjmiEUSJ9aTJCFYQRj9STizxdC2nn31FZwy1k5uEZ2bDrr+RKjPV4+3HikiJzMabwoip+o/kkaX",
+	"SzQ2GW3y6hQjdDjR9SosYIewztJm4KaofQoUe3BVyxuFM9gJwhuhBfVM7/Q8BzuDN3lyom7HXIfYx9aq",
+	"pZPJaF1U5PSXpuqrl7W9W6abwtCeOtT/+dSNXfjZSjTvzApXy4bn06pWZsa/2G2Nd8dQBpqcYHBSKufh",
+	"cfmGQs4FslzbAaIkjUejRr5rTqUkqX6rz0jEifSy0SvbOeWOBAKxjzGkwXbFXNyAhdJPui3WOaHSJUsJ",
+	"YiaQAaclfyfV7jhNlqVau37AYSVRnqO3NSnzXtqEByvkNHtpMoj6bgzEr894e8xfz5V9FtFNgBGrRTTp",
+	"LrcbxQRjhoUV96korMDP9yes5NN1ElageS+s/G2FlXZ5v5JPbhxOC6H4G/gELFox03Oe9TLgM38Hte7r",
+	"dxU07k2M6jooULn0nrpVOU0wbLljamNTzPaE8IikMuh1pKY0zdDCtbMSzRqTTbKkbWN5y5tszlZ4awzS",
+	"KBQgKHawntlUGDSiAlmnawguYEH8kXRO4uNMtm0S2sFAN9nj2hmwu8/yoVY5VoXx0FzGEGoNXRJqDxMc",
+	"rnuA60QWqprEvwVdyLcVJAwfBafXQYC2M2yn6ncO72YSfIuQLuCWgrjNmgs5Ym8I8DZAhzXe9w/t4jrC",
+	"r55q/rpTrRgv2ZwXUqewmihUFsQWXArC9/ZOt2FqyYx4u+IB51BY/bCLhqH7P2Q9//3eJ8MF3f1NUv8Z",
+	"g9NEUJAqtUKcRIzHJjrDpglG3H7Weme9du/ZKR5YrS/425lWzlggqMB+DrY9pEYP4jw37mTnNz+JPH/k",
+	"NeHEn7mWK7rppNczJkj50FVIXPvWS0hQfbLqINMFI4rW1Pu/cvkagtfu73ToK590C6ey7rGXLPf3f+Zm",
+	"AeEDt004lmQayNhhxkDCtHBujblXZ6rg9eLO2dAi73nz4yztvMMxBiPNq21WCzKviBIlq6KO0n7RJpwY",
+	"yS0vz6/5C3MBigDzJELuvVKreIVAn7DJxSky11CJNySFsCmAmxNBGDh2K8J/WmgMwZM6pXCrHktlRDiz",
+	"jT0MX3HPcL1N17xkTU3tqfJbf1ea6DxkoXKlatTGNS9m/ZVaqxi+13ONG9a5FD60HiKi9kqhTgPNtSF5",
+	"C53eMmVSR+9qHh6qoaR4Sgqxs5DZ/HpW5zSwWoIGhw43ryMfV8rgtaOFa52/HauQjvai3CGceUVNcZAT",
+	"zq5oTFz5sJIjAlVxpnXZVWx5U4j0fkVlyYioQ5FXqcdlq3DliVLtlc09B2s4a/u5/SXMh3LmhuCYmiqe",
+	"kivalGFGf1WLzgTJ7RCN6y0dlbf4yqzDuspiw441XQ0YF+aY21djHATMydfgznfZ+DCVnKkbrSYOJyiq",
+	"aZiXN4MqT9T/jjIV04N0T7R7cogenhyfnaNNv3b85l/asvMbjT9swiCPRuiNMCGtxyoTwGMfr40h6FCL",
+	"K/oPYy1XL8QLLGiEVC/4rpKDKKBXEbc+eKe4hzI/N6Vylo2DfFzGjfLYlCUcWFsTXtCR7jeK2HwQeuY8",
+	"ICmvJ7Xwol9IeCzYs+6r/hyicSahatGYIF0Umv5JYq8VOkgl4QtOBTH2tw4iXp3r5iuFVwu2BjejCEx+",
+	"VayrjKnRZatVCZQyyO2AHi6ycUIj3eXREH13fn6yqf7nDL4PEePo7Ow7+EPtJ2VAdv1NKPhpKjkYDoSY",
+	"mX+/q6RJ9Rq2UO7v8pYf/DFbup25ho0xZB54VKOiUFPCyI4+Od55Kb7/lero420AKf1lqMskGYoSlmrq",
+	"WMhnPPAsqwY7N83HTTWIwlpdF+9Hkk7lzK+Ml9+hazKeMdbKb+ck+a3uYCFaQl21tWE9Autw4RPOxqTo",
+	"YLROxe2FGmaoq5WBulLhsEkbCd+MnqXWzsUyuUYJOS/DB04y7OcvgFnvr5gceiAeFGvJPZg/KNaSUzT5",
+	"weyBV0+uQOSerl1frmOF8b86BrZ4iKFueatvmNf+PFqp+cF7Eq3SXgezxm9SKlfp5sXIK7esd0Xk979W",
+	"+EZc/NiasTRxdPzc4qDOcw/FDXCSIJVYQNVQF4FegEg8SyHtoEIXTnC87JQA1a2y5aoDyCvbJO9DbI9x",
+	"6J7jVF/qLPVryeN0iTCfZnMQtTS7IyROY8xjJGYkSZBYphK/D8NCeoOT9+oWgb8s5Cfbat8yrLhlr4C9",
+	"lb3OzK/hbGqSIUHSWMkBB+d5USlWvwnjeE4gITJGj9+/txKmrZ1Yjbi+pAvNgOgMCnUIdnZJF+jKa2Jx",
+	"RkskqobDj2c1if7q/BZh+y2A8+9ZBX6i+LH5SpjGhnjWgVB9BdVOpIhw++H7S2jZiqJIlS3IqAYDbO4r",
+	"mqIZExISWSGtQ4DXX2eqaEQH0waemyyKCIlF+4bUgsIbSeYl+tTNEdjrZAUIVWYz4LpL50Gz0KkvFMO+",
+	"ZljDQlvOZiSZFwheCM+B+VrgumARo65zrfKqsPm4KCaLhC3nNp2P47jmyw28WGzkUwTmBw+9Bl2SdvQt",
+	"aVYKor8eIbQwj1PHfEwlx5wmS5TqYlwu9L9cq9OB25f0B+mUpu9BaJ6qShajx9s6mxZUwB6Ab7rKfxTb",
+	"JSvkFIAE6l+DHTuDEbGU1Kc/L0BFMdg0P2pLwuAEMo/Zt5AT2NQey1I52HlSSPSoNjjYeb7lgLuXZEIS",
+	"fngS1vBqeCnX8gY/TQtUmhAvnbspI+2dN4Jx9KNIEgwMGmxNF0kyZd2oejY5XiLGY8LRmEwY14nZNoyy",
+	"IDYzFo7iV7PWDVNYRx3pEs8Vy2w+sCvCOY2JGC3nyeCdp1ZrL8XnX2595MFk5NULz9jlblS966U7G9Bk",
+	"OXWeycZry0bNiQxUWx4TpF7QzFRz7KQw/E6LGvVKw/X5+I9dCnot7n19bn1tztxhx2mWtnPArrV5z1fo",
+	"oRLGYC4LnHPnzm8xlS8Z7yY8lHuBCGGY9KblVB51HmzTmWPXYisMoRLWJEv/o1DMr8KmExbPcWq1TKZ9",
+	"UKyt6EYrq2u+/6dZYI8kvfoZ85tk7j5IryhnKRghrjCn6llRqVs3tDf7AlMuFJf/u3Y9MkSZZ6m6MMHC",
+	"YzxLW0UHj9ysLUJQoYuv2SA3geYmhYGdSaAFXYACYkrkjHCosqrZsqX2NLCLQFmq3gqs9I0ztBHp2KL3",
+	"YXdNJbHt05qwN/URni3KweJnS/9lwkYnWXnOE3g6oEvWhh/2TldwhPkxbSvRhzwazlXOi1dj8dXm9fwE",
+	"sbR9m3aSobfqTtsuBO5V1+dGUwtaEK5YeMTSqkjiqRLhfg6GAyHZwrh06B84UfJ6RzVj/UrPzHBNLdii",
+	"scGpW1NTG73aAtR8ilxBGAiUvKpz/rZf0ZjIa0JShKUk84UUn5NubXvt1/qehfXh4Lp4VNUDUQI8UtuU",
+	"ciGszmLBkgQpIp0g0FvLjKcBbQQq8VoUanl28Zjxl9V8Q713vEe11VDtugC6ldUTki9Lecs1RtSoJW6M",
+	"DOdRPS7kgsLOKtyt66YCjY+7sY+uz8H7hQKPVvO2rctrXE1AnCLiPnvilLEyaJ0Iz4jCOuefEJayzOND",
+	"YodzApnbbl4pphgutTXMqWCp0IH6NP8MGKdPAk2yNNIMKRQCtxycsNmQLaOhYwFLtVfzarMccsAqZoyJ",
+	"QyWemjRUwwLT637UVV6GKK+mCyODAG8aBTmn0IkG+JWWN/yhSoCe6tkQlpAcgySqcofztFAn5Eov21/d",
+	"yXSPCC2kBAiV+6/1+MAmQN65fuhUIAg8Mh1r6DAJPIQiDbqVsKj1bpq4dFZzNQsG3hWM1kW1mFqkMkEj",
+	"yXEqFFYH3JfwKOIBZcALSGyCbGITzphEe7tB/FGa1WvG4zonG/0VmYT4OgorsC4Xl+bGu+kzz8mcSfuy",
+	"Fx798KMuE9EJGJZDQDadSqelq9EvybL76Jdk2X1w5ZZSFxeo3F5uBfqZzegRnMh+bZ2rXdXi3YBmjzHF",
+	"XnV0GUv1Sro5jSmqcBIkI+pX6ybminSr5obuqrnyQow2G4bm9TSLo36HpQii8DLnk0x+jRu7nPGqy5n1",
+	"GMPCSFdphBqc0UQ2UarnwOa5S24EPK0ilRFTLAmeSFN6NPcOOtSePlqVQNAfGeFLtMAcz4kkXNiHbgdd",
+	"DDYVRdyUbNOG1P8TWn8LrS8GYbSpdWtzx3f/nmwWI+vo+pruSIAwFjZFbySdHojIaKb4igJ+VxF7Xd+h",
+	"W/ACKtk0G+VzD1DKGqIFmiZHIICPdf/BSRJ2/PEMMJuRdbVq8feB5Ola8q+5FWpafWO0QokpvaQ6FNtV",
+	"KdF0RL0x0+UwA09CLtAc6veoK2rvllajgcQEr6/ZnNVajZcWRfU9FkruUDPpleiEPFToOjYzkiw0NZYz",
+	"4paVC78grVrsakf1Fp8l4FUDts1qlqT1jJzHe4cI2kJuLiWp40gGzZILHF3iaQdb9SrWH9jekbLD/cyS",
+	"bE7K2yuuXrfRrrr5wuequ2IqvdxfNW6gDiqN+VpVIz1Vnv19rm2FzT11J9hODVTsQLWwOMmSJI/XyJ1L",
+	"DyevmTzRbv4Vl9LjhaZ8Rbn8gd/nwQi9nRH1SIPg/GA3ucZL8cCIXgBHKtAigwAZ9ZYuQTwu9XqtvhQ6",
+	"AW+PE3DbUV4tQiJWzjNliZaeUyWdLm4GRu1IzRR83Djqj9JY6iczngVpGLMCTqPmaD7cFtZ0vBfDQbVv",
+	"BfX3C7V/DCPCJooVO9473AADIsWprF7m6i1YFHCsdVMeSsKODAVpIS7tC9OhIJxMqZB8aUisCkgZE+TK",
+	"wBHudUxNKjMTA6hIgB0MLB8JU6+DQCaegfG5qNK5ovdxB17I7jd4cmlC07XoM3QMVYKyqaR82mtY385i",
+	"vbegPDlei81eL6gj2YbGXYSK9n06pwidhbBKPjorMmzCsLIO426Z1FrAKTCxmNjc10cspZLx1dIbhjpX",
+	"PZrm+mt71KwvwphO7fv0Rw862ZbUBRV+2gygGCqqIIIyFZg/QrsJ4dJLjKT10UDfZpi7g4E+ws8Da6VD",
+	"JTGphhTKBq4SrpRrqFQbEyvtDU2FXbXx1/AX3k0jFc6PWJ9G/n5CoavzB4NbCOeMH9VlUFCzQwtkkiyU",
+	"lbHK9T3jYXmYcTqlKU5cTctO6bU5kXy5Z5mw4nJeF/JC6RdSYnEJ/qljQlKketOCLrFThqYCFMorD1/4",
+	"Dqn+7/+gK0u5izNf2Ek+ldOHlAf64K2PnA4Dn2N+qZXQixww1XCNdVDEW2gXfPn+WnYIxgu16hCJ9/3b",
+	"c188BZH1+7c/nIXqeMc0zNIdvF9otxjbBEUJpnPr0Gh0d9+/PQ+lX846xPUVHvgWL8PhgAqREd6wTN3A",
+	"X+QN1qgHC6Lx79eX4k2d/kQBGT38/uz4NXpLxugHskRnRD7KVU6gkvAVTSbg7ZIsgRMypwaLhuL22DnW",
+	"1oBo9cjG369le8kxqZHc7jaEwj88F81Ce6mBV8UUox+yMeEpkURsHi9IejajE+k4sDb1G17Q2iOghvp5",
+	"M0C0pVKlhqAYU7FI8DKcQOu7UulY3RY5/bwJvqpjG4e5L7Mn0Yc8sd/OiMnVTAX64bnIQUEFMoOEzS2M",
+	"T3FK/wRI7QqFMvMO9FWh/HG4pxaCYfL2h6lUQN6HhUW3y+ci+OjwMY5ei/Dwpy9290q+8nk29/Bt4Cwh",
+	"q+3/tNjDjFGnnrSaFqujlAzs1AutkzKu4mpIvW7tD5dCqT/6p0lBYr6BtlIzl+DWt8FJQrAgnj849OfE",
+	"H1eYVAsWKnkRPj2hSZ0/gRrmkUw2cDyn6cZFtrX1JHK94E/SoWB5AQeG9soF6YC7aDo+u1ksvC2RbDgQ",
+	"MFvXVAf5KpHu+JlWcMhSuaZJDUvPpKZh4JnNjC61Nral/cxysK4aHOM+dxjq863KENAg+BE9+dG2ZpYx",
+	"vfMLELqWkJwnnMI8V8HEVEiaRtK5vWiyQ3A0Q9SIw9qoLTXffDG4JMtvgb+6GIwu0mKYCck9rr/NY02A",
+	"O55Sln6biQ2ChdzYVuClhH+r8ieRNF4l4mQ4KKYdCu1ONUA2i5HJ0w6/aeMp+BO5UgPF4gvqzyyBD3Ms",
+	"oxlMpqNw4O/cl0orLXZf7yuHp4P5Qi430yxJSrML3Q0pDaYpBFtS4ZRGbXu6jsrtFVnIV3oDf/ldNMcL",
+	"tfG/LslyCGf8QXvJB5zhP9Sh3Ft8FQyIct8gGZcwiXPzZD4PhJ/y6Vq1HCLIQMC4RkhYkc49BDAeWnKX",
+	"33WIi1JXWg+ki+czYP3h9WSpozH6mIw4g7n1I9NBVmiixDdYRfXExviSnNM6kghOlsp9AVNp7PkYBvIS",
+	"2BqhdMHZnFn/RbWmlLyXetJP3Av0xdIW0RkWF47Aa1uo1QnGUusqseDkirJMwAE4OKzvPQqH9wOpceO4",
+	"JMviKWstnzlrLT/DV8CyMHPhZQLskDvNpc8rRGJWV1ZAOsngKpibANis8bdRMp7T9FB/3G5R7Ls9ePBy",
+	"yws+GbYuQTCcUX3xZDibec14JC5TOSOQq9WS09z7z4+DUS+PJqfKoVOhhM1RBssQI7TrhgCbkLm3ydJe",
+	"3r/yXG5DZBf2IVyIjKZZ4JoeaVOTINJFZQvC4W+MEjqnzpSZJ4KHA3EeSPpS0DQG73aRJzY2bnJK/wh1",
+	"sgBC+ArTRMlw+lob7YRAbIH/yIh5W5bOKUEyrYBwZq88NL9cLwPr9Gok1pIjPOuSGeXXFcnvpnnr3Epy",
+	"cO9pMKmzAduboAKcrWAstSxTcmPBdJl3CzKz06InmNq3dfUE/T+o9TFQVHJtSYo+U4glj92jCyduM11q",
+	"tw0LbS1Mad0W7NMerQEleGeMCaKxlkUTC6mCHmhCuZAuhcEQZWlChEBLlun1cFPaVE9hHP6UcIfTov6z",
+	"xrVsjqkKflKXs0ZhWa7XMBbqYFNpkMusEwCvOXXMdW49fX3025QftN0KaLdcT4ss1owaG6LDuIGq40yA",
+	"dJfx3O3DLkqgLL1M2XXqPKz1MBboCZlIlKVwedIYsTmVXjSdIJwqCdjEkfsL9VK6o4eGSR+TCGeCIJ0j",
+	"QW09mmUpRJ2x/CuAgApD4IVp9CjfDycGdBoDy3vSG6HiJjuxRW1YEtsaW1fbo+2nlt0QRHpzaCynqSSp",
+	"Oka1CedmWMYbtbN/ECHpHJye/qFvG/3TsD4RSxKt2RuhPdClCssfqHk5AUpZN7b2fQJqwF20ovEV6FLT",
+	"ovJmlNjRqsB/WfdKa7RUb7VHPQ3LrgPeRV2KZBsu0CEiTgfcAwEBLtnw4LmxRLmhMAn/PVBeLFDbnxHx",
+	"mkn4O6i8anzji6H/kumJV9F3lx7yS3i43abftR+DaBL6YDle1Ef3MlLlw25mR4aDIzJnfNlqB/+kbNor",
+	"G+WVcbezI5vSM8foClpqnUtV2R1wUDIeRBUHpRs7p9U7pb0mUgX8lrZ8RCSnNfmTUt0DzaGNMdKDpZ9n",
+	"NrmFDSuKEZ5imgo5QhfalCYuBpamOycA5XlIpGGvIKo55myx0MzFDMf6XRZDdDGQM86y6WyRyXyc/Lc8",
+	"qYbhDBKaXiKxICT2qYEeTuGQ69nRO60JWgd21KZG596MtdD/sl1ILH6BXm2CQWPT4EliS4oYdLRKH6Ws",
+	"0g4pOgIz4Ctqx2/XeVaW5AMnYHRUWdHy5SPyPiILiRLGFlARQK099z6x/NY4E5SAeyXRRT5N3duaEnTm",
+	"fjZhRuPdrhyh/jns1aKNpwUjdcB2Um2UW7FdcE3RalmhigXHB604WyxM2WeTrLVmt3W5b4dgCq3pFDTQ",
+	"Dwd8En397Nnj2iunP1d75pTbmBo1MD8MOwaMNgzc3LFu8239gvsPugRW7ed1GFBnDU6NDb67ATiTM8YN",
+	"L15rCjaDFhoXTPHBG2T9ExrH1I2U+aB+CG0N6zJMg7njEzRPl8+qzUJNy8ShMU9/gJ40uH94sNRNjA5g",
+	"QglHDzNrZi19M88LTTXlEY9qHJY+ccs6U20e19UzubE1XERs0ZTazMBdN9NaJ9A8rObYAyfQdoWhUfvV",
+	"zQThNJ2wtuFsu24jquu0p9yKCtdEWcjJhHBO4t9sK3UUJQcu5QrkZ8i3TY2jEk3dr7Agl2ATA1k0yd4m",
+	"eghBpto3wJj6f70IrOFi8A6+KNE/sX+IbHwxePfoBiJo2R2gTIC9gyyeg0dQS4Sx9oZV0Df46hzu77W8",
+	"OaUWpRfncH+v83vT8iaooW78IniDfGbvQQGSra9BEyVXI+kG6kZaPHcp8aNISatiNGVsquMPP1fKTePo",
+	"49FtBeUbUu17oovKC1LT/k+cHhqsvjNil1cvqpI59w3RslVOiZsLwsGkE4ctc9rQYAwMAnroeQWciWmr",
+	"I3QCjHiaMold4Z41HQ/yxqCZHi+dgYlG4UR8sB7KUmWGFxLPF022+Jm1doFjuN5KXFB4x1iSDdU4SHJJ",
+	"QtaZy1gVoPsq801JWpvwbRdpk1HkTDaFmvzYxbihfBSrq4iJUNhrSoahE7bIEizzbAraTWyETgmON5TB",
+	"tWM17aTV72SO39vY8GdPhm3YcKSdUPRn7RmtzcVanT7DOh+CZy01V0tbUiNl81a8CUEPgcrBr1ob9MiZ",
+	"PQdrpzTQ7dUA3rYePw3tC1zRQoeYq9LUbtg1JLKlwv0OvgAXg0uaxpuaiBkvrLoSpb7xNDBhak3NBqgw",
+	"rZOURDF1m/OgvtLjmWDTfN8dMo9oonRaHzG6W/bP9Ms6lQxINA0wXj/QNNaeXWZP2tZbuA5qW6cHZ+c+",
+	"vKn1NMibityap+zdNJ1Y1saVhvJM7sRxadl4TqWwDygYq9AeUEQ0du5EI3SYoj08J8keFmSEjhgnagq2",
+	"g7xCKqPL52JEmXrk51lK5XIzYqnkdJxJxsVmTK5IsinodAPzaEYlgXzbqkbQRsTSK7VdZcaZx/+pTkJs",
+	"KJCJG7hyurOJG4+9YKNSpzSsLcgxOI6oYlcCmFBklxSfqkJ2TXQwJaJN+Rez6JLwOh5pH77C1FUdnGLV",
+	"zlfSw/nDNWxzZS4xvG3LL5othjjG44iumQxFTZfHWpuJl9Uo6dKLDyk4jlhMimkK1KtRSU+wC43RnMW5",
+	"AGInUvlqVCdN2xC3r44qApUkj4bm81tOJfHbXKsfdCOg7ItMzB75wDIrcZ2DYLuFjF0sx+hGjZZp9mE4",
+	"sFuvkX7y419CzkN1l4bo5U/7r6Hw1eGJS4oIEV3W0xhBakTDA/+R4eWIsqEbacRJPMMSfpsv3a8Rm+88",
+	"3draGqLtbx6Ptp89H22Pts0vv+7sbL+Df4fFK9gZCZRAq5w/5HSB1nB+xYSNPjJUMtwMzYjv7j192c1T",
+	"9LCIdrQaepdXUYxj1bGahsAgTUOuGBdC1aIRCTUrqUVsE60r6zXygaEgJEe5zXGWnCQ4JfX7ddA0vYDg",
+	"cpagher3OQWlBaL0bqTquSOl/YIzdSnA2/wlTWRo/sOJb5KFN8d0Eza9ExXWc9hIceAYGRNuHRZLIQa5",
+	"g7Z1NtSZah9ckuUDxDh64MImHoBdGGaVxleZung/cCx1y7GrwSY+Az3kZIp5DH6L1sPokVuj9RI0CVX0",
+	"2QhD+jbU8lWMjCQ6uyr400lJuM24idOaPHa3q/pakFQoPKrVf32xEXifn8mlSSkWfKc8HVioAB31RNjm",
+	"LDeu5YdhLyDepoB4dwXV/cMPllX3zn9o5Um3nDZ0Cke6lVuYWDB7nbyvIhhmvhY+uptYc4nLs3ZyvfR7",
+	"hS51fwk+wiVwATMrobI98TaUrmHiSy2K/LtvZahidDtfiRxfCfykmCnHf532lodhRd5rdWGIPz8w39Dh",
+	"vlOflhbYQZl4oryOTzX+qDncfWlUdqyYed2rnuKzKziOB7psnI7S5WTOrtQ/JKlxDQ+nntpFYPI60UHB",
+	"Lkll2LE8vFT4pJaJY/B/NYsaVZAPKqvUlqAvE46TiDfoaf2vJnZLnaItaGaU8hCYYMoTn+ydhhBvSqCM",
+	"X2hj1cHUFCd7pwgLNCPvN6w25uy73Y3HT58hM5rmxFU78GjXdSmpFAZS5I8MQ+SuDQacrx1YNxzQNCbv",
+	"6zKOxOS9t2pjGlAV4AY7j5/AwPqPrdYMQ3qaoYNX6ABPIv5zGEvsF+v/7AXM5odjJRQLzDFOL+sOrAkR",
+	"i8eEGw4qiOgrAnQluIXB5nyEA4Bz3yzobA0KLcYV3svc2di0Cu7vxOUrCRGDPJuJdoRV49pr4EiSQCxt",
+	"tIzkLeu5jcCoRk65GEyJvBiof6iLrf+lraP63/pt1v9eqBum/6kNmvrf/zCaWTAbuxkerSaP2A3Wqd30",
+	"13zZJkxcrwBix0V1NbabeNSpYJ1ewNAHaQ0SmXML85sO6i7OMj9pXVoVw1NaPUuvXf2w/mD5FJ4LRWd2",
+	"0kPPVlcHb2UhmPyU4Tgh8tZr93bsd2DqBK7QRWXvWKV9ILCneyHLxjzebYtozjKrakcGDsQ92EcEi4wT",
+	"60pUk7PXa4VmLIk1ASQ2sdr5yREQ+fx1Nvcvr96OpvSKpOj4TCfa1e+ycd8wKndjZFYD/ZExFzJth4I6",
+	"5LYAo9bYYymJkKZ+qo4VQlSWSgz7ItrAKV6UjHC1PSYSb1v2O7zfQZHV18bRAZ+RZOObjae+YGtqBw12",
+	"BsbCYrPgb0LrMWMy2vlm9FTd64jrgsSO6fl18OxphCfP43ibbBH8NX725Kuvx8+ePI0fj598/U30zZPx",
+	"N3j7ydNtEkeP8SR6QqIYf721RZ4++YqMn2w9e64unnkxv1Zn/keGOU4lTclx+lInXcwTzfRalS9IqxJC",
+	"69W1K2aurtQk/PjVtSwqXUKt7k/5Ujt7p1cz1LtXxvxtlTG1d6sT6peUM/b5M++oe19Z6l5B96B6b2mV",
+	"6bcvUVB5b7rBbHMPxU0cvDrvQ7iQWmR2YbhI+r29vEpafMxzsrfUedGPX2hpbsMVMV8ERVL9xWX6Viia",
+	"aD9W+6fhLLpXdS+oOtok/uAT+1eNidRCSK9XJRQWQQbGePmZNahzyKeJVeob7087pMlLIlDKUEquS2WL",
+	"XCFUSKcSmLWY4A6UYyFzbVmHZLDMnGjj7YhPnWfEG01B7jfhdcNCwkbG9aqA6SB0LByZbJUrC/mivVnD",
+	"0NQB6uHkjqeO8INCQDfV2R3D9W7qtJDVvsVa0SP0mkmDxjg1hV1AE6LaW0szuyLcq7OWl4gSPNoEPnX0",
+	"u+im3PX9X4L7dl9dVL/BkVIJKA8hplQaB6LBcAVvHH+yVzBEpXrWcFD119G/1SFU/s17vRFGr6j0kQuK",
+	"nKBCfbKbiDpeFbeAgGNG3VDz+3ym79vn+c/5jmtW/MkPV8FXjQFZvEzeLa2WV0B91wskX5xAYpHPBr7n",
+	"qNGxn25/ewKMHbhObPG/l4UV88045N6LjMJLk3YUTdyd7wWSv69AYg/5JBOzU5Ow7r65rdAaahitK1qj",
+	"0daaffPZy++jvLnBC06/9dqxyjz2BhvQmOMUzH2MI4mnOo8d5WiSgd7fMIPC5U9RTLNCCirRDItZ6VKt",
+	"FvdXYuns/poPq05m9L8XqiMUkyoWmZyWPCgNeUBcnIDhjRpKlnpN1fte79ruGt40wYm/vtZK+P4K2xoX",
+	"FtlyTu6hqj0paOFzcjTVNkKQt8aQyhXMh9AOZOfi8VVylDpeqTzrXsY50EiJZQ3D2+llcFXpW5HbW00z",
+	"oN6S8UzXu7+N6upawNXVUARkynbirfd8KIZZu7IOkWAmDhBSjeqcTwvOIiIEiRGdz0lMsSSJeoiEJDiG",
+	"k5B5OtQFSwKJn0SDfzjkJItt3nyXsTBfFZRrEejhd0e7extn3+0+fvrsETI1vI1PLBHgnnCtoZc/esrN",
+	"t2P58NKJmRWHT0u/15Ah6zTTQkT5ffB2WZUVZyVH8/yzxUbI7hb2YM/qQlj3zRcnztG5Fii96gT4inA8",
+	"NVXXELAbJs2tyZwNEyvTO3oJMNtpzoTdnuO6mN+6mJf64iL+n/pU1IsGv4JzXR3LfFdQ0zvS6MPpdEq4",
+	"CEJSR/eq8aG+OZXtmaj98z4znXRwWwVpzIjeMRX2UbyyrchVmKwahWO+VnDGMhRvMU+1TL7HKaTvVYVi",
+	"0wnrLLbXrCUfuLaJN2NtG70Ub9M/BJnpU8cfK/ZRqTuZIkZXFMO2d08O/U3vEW6oHDmjU7VM6+A2HByk",
+	"nCXJnKQy/01ntB8MBy8TQuTAJ8be2s+WqXqyz8l8kWBJciZT+XRb0/FgWGcLzSc2VV+HA5e+4Jxnwv4c",
+	"4vVKueSMt2Etp1LwFmg1wIcyGQ4Heydvat+8RRbus0/FZW1sJxWX4V5QzrPeRl9T69PmP63rWJ8d1eV/",
+	"XC2Bn+mrjp9wqBxW199rEs4SWWbm/DyOnXm6mlNo49jq4dLWs+4E290sao6wrWMrHNfJw9ipa8vt+PCu",
+	"SPMLeTir1zUsoTRm42xyTMOW5wjlIbY5XLzUsCN0bBP8Y5vQE9lnCjQU+i1fQRtSZn4CShGbCvTQZAJt",
+	"4FXGRF4Tktr96yyiRNwL++FqYtTxIA0pV4f+UQR23PS2w2NS+8ypr0WNdiGhgzpKWwBA13k2Nb9zcwpD",
+	"Qo0hWa5a0r6QLjBiXe134TFs0H+r+X21pjaaDDbtekTRbFNSnA8HEvMpkadGC6CoJKZprwzvleEVOqRw",
+	"cVV1uNfzthXi+dB7IEE3X3PdxlNQzdg13F2FTtcQ+KoF8dzPvOFp6JQGtDr9rssFGtPJpK4CHFHui7b0",
+	"D70ipUw7/mLNmyOIBMqrMVOs/MKoFb5U8+6rZXVWvUOZInVbnV4nB9jaSVvMEGgPS5wwV+2C63axqUal",
+	"laWRaYOF/SdkGlot0cvQHmc3NNutyQ+tdqIHcsWEPNyS+JJUPeGdIMUJBhultviDqJQQSVYUHsuLdMPW",
+	"NXDT1TWwyyhDwha/qtXaa/RsrXltdV80lVBpzybyogL58xnEHgVTd2mt+HdYBHwF1K/uKkGRFWgc1vbc",
+	"jaEhALX6+uWtAINWApEUskgSvjrAmmwBHiiHhSMsLK/tnuSUpJEiu2Yl/zI9byHiB2ii/qWeKKfkuqag",
+	"9dnxaxfVU4j70eNqimLu6wi9Sb2aWrrFtU2MoCPnwlnrWRJ3mB8IenURzdPiOK6ZNOwio2ZdgFuMP0XR",
+	"yWVBopE0OpYR/MXEiBp/qZYQ+bqwPP94rZ36nqzNemJ1iGu9fr29+W9sb86P+YSTKxqiE4FGQbIkio87",
+	"LlJdfWHn+DJAnkz/5vgw4bN4Y2LzfA7toSL16JhcnOg01EmXG9OMLCe2/tdad8Kw1YGbod+J8FY8I3Vu",
+	"YbJGZthoAvXpwkWx9PsWCMKG3/2NLjhR+Gfc/tJI3xmRRREhqkjjUP17hjBE0tLYpGnSw+g0XpOEumw5",
+	"+QHMcYqn+lnVhQt9kIhVgwKtVN3kOtDkprnQuNjFUdNNNnS45uDZ8V548emt18O09TNwYkuSSGwXrh9t",
+	"v3v1ZphOdbmx8rB7O6bpMHS5UznBcaEFA8dkwEI0JiRVWLnI6urN3OyY6IqnZDfrzbva8dy/s0z9Smpc",
+	"ZtY70Fs+HGbHX9nJeY0jqnOPKbYovSlKqfhQPHJqRF2ps9HZIubL0ywtpD0LeiioIpU8I0NPxCoDyIYg",
+	"6DqFwDCAn8Gw/r3LHzjobas42cG1ViicSK2dU8UAD3jiKCcRrLGyakg8hvMWerF5B5PEzOQE1SnKQqpZ",
+	"TfZTprgp25sSMUIHOJrphZSGkjN/ALVgXz+cF2AvsNkFNayfa3nrq+e359XvVzXLdOEN9yzm4lJL1EtZ",
+	"ERya36bfdteuej6F7YMuubjxr75qX4mhX10ZlaCDAPdty6W9DbuJMPWuVOU2qzlTNT+HK7lT4fVEnwZ3",
+	"quHA+qnsNXB5njLFY/UUx6LWUSchm4FfNSSHd4N7ud8DY3dI6b7Ief2uiGTFg5V9ykq46FNnndxhMAzs",
+	"s1yUUAQUOZodFaUAqIRoXUGhMp//ZttJjVp0DUWi2kjup1H8fc+O6m3+I0VNFSYPciMpuT4O57A/N361",
+	"kOIePaQT/RRGylMMMm6kWQLZKWxqUVnNKkmuKMtEwwS2yQ1mMcIfKMwalCDGcpD7IZt+OZHO3wJHJSwk",
+	"YXUDVwjBWF70f0Y2RehgWNQb2b+vMKdqB9BP8wA6EivsAbWSf3Jx8+Hrd8UuSex5IwWrB5BUcufAFeWN",
+	"1WPGjHtAQjUXWtIsMrk7kXUHTN4vqCVUdE4CM+hcEnp+Klz5V6fkKS3AKCSp7F5ugxMs2lVqHoRO3ZSn",
+	"uqvmtBUcd2VLbRAfdooqm37dV6urkGuldngu3cKr6F6e12ZrwjGJ6Bwn9VmMKn6D3twOcP7mh/l5B7Gt",
+	"rohllU2oaZmXDQ2UDM2UyhudvtxDqq966NMY8xiSvWquLlCG0iaX1rVDvDTRaFJ03g0m1KvPFl5cmmrn",
+	"E19bRjR0v7O6zKxuZ6HNr5apVRoCwWtcQJTzSiZfJDi6ZFlIqVFsoJW6C8IpA5KpPXlThsYmMZtmt3Qn",
+	"EH9iKiCllFLQKUAbvldhJlMqpyWacEL+DCgDSZ051V2ysV0VMRrVblcrbGXd1YKD/nFsNdR2inAWX4m5",
+	"7LpGaNx5leUbCTMNASYN56idR8/gJQr7ZBdM+5KZxNYuMYE6IXN6bSEoL9SpnpkiRXXUtNhoONjDKa73",
+	"kzRfhwPI6v0WXxFR19RrUfVhFJJjSabL7g6MxXW2ueSZdbY189fYcGgm54BxuS2wxWeZWJC0Wm7jLeiM",
+	"GYqZTn6N9f0r3r4JThKBxiRRJz0jWu0rFH3kRKj8TyNkxkdCsoUmnLazzjagfjF8dB4Nj9S6oYo1J1eE",
+	"m0SRFoPK/JQZz6Quc4ye5Y5s7SGfZua7tjN1Zc0DAC0OVv6aT5Cfh38QAVKoP9ugBru/hf6VTXKYVSma",
+	"pQUimJiQslisTlPJFbwQkPQ9b3VN05hdd7dolJ6BgMBrkFILEapCmnkuGr10bdAB9K+Qpg4rKhO0D0CT",
+	"eAZge5HFU9K+iHJ7IApeoo8OyyheUUX59V06t1epQxY+G3PxYTjQp1Nj5jIfc0wIooGyhY59TMiL0BM5",
+	"dH0qzZBJ96BenRHS4PXFIZxwguOl30PfaISjiPE4L+lCuZLW7FeN/6si3FvYa1DWqaOWZ5a2h3li81X7",
+	"zTAa6UoZGvnMY2dUcsWb69Ofmker8CwFIyMKm6qjH/qzZqQ4iTLOTa7RAkvV9dDVpiPOUiVhcaITjVKB",
+	"iDK4+3RYY9UDAQf/L5a6gpCxpzJ5c74X8ONrvWF7nKUHbvrmoLDv2DVKWDr1FoWExEuB2IKkRqojSr0M",
+	"LI/BU1CrmjG9EhxOq6NFnUbXbAxDoSylUr17C6OUdz9q97l2B24lPTQ5cT/8547z4370z4uLuD6gzB5F",
+	"G3zPbbuKuV/6kV0hFqOuEI7+3WSBFCQ1gMsLiioRnCg/HK6th/Z5Hy9NzLKr+WGoh5frSKrUU0IPRsEP",
+	"iy8XUtMeToQEGHIS48gg6O7JoSuIfQN/cBfCFEr1aBKbbEScgDEWJ8L37bXNlRw4+h30BIO/LuBPcTHY",
+	"+evCjTAyi1OVtfQX1ehisHMxiP/3dRL9vrj+5X9f/xk//mb5r91vv70YfPjwQf3/3iH8i3II18h4qwka",
+	"9ZDh3Cb5t2JeE/37/aU08ebrxAjo9r1n2d/Ws0wfcLlcXUl33MntvfTujMJaUzWbtXickkmdR0ShJK15",
+	"DR26uDrK6s17IFpqbUw5yxZ1cQqJ6g8thh67QqhOJqjwWG+RKz0u4TRShe0KdqyLAWdMXgxGg5AMPGUb",
+	"6scNVSpxwxaK3Fjo6hMmU/BwMGdZKsMJ59SviKbFDWvTPlgn1KOdx+tr+HosGIxcY8rsdqwl4AdHykSd",
+	"EtxAGCwzdwphVeTSVrnvCvjSHTJ988Oo3pdwjypW19+0mgBP963kXQNMmnYjd5UQSzZJyxl1KvlehNiZ",
+	"km/IWU3973Nv/hy5hqpSnubZAQ1TbCUAk09UO9XUcI8mZQhZ6nL2OLlWssXYFUqEHip/0GjQBvzaalRn",
+	"YnZLCVHOzr5DkuNULBgPgH7B6RWW5AeyPMFCLGYcC1LnHmS/w7hCzE5c344JR+643GphSa3leM3OAUCX",
+	"nbcQUhvU+afo360YLjOeGlZCwS8C1alOXM/SB9K20M6uXmn52+GpIldkubDCbDrVfqKQLdIsIcpLLFNh",
+	"PGaGaMs5OhBZdv148jjo+tHzV7fKXwkRTAzTJblO7vak4Wgrk4yardjlieY4mtGU1E51PVuWJlAHbZ7+",
+	"i4HRcV4MrIeiUihC+ytbaojMF1KNQTj8mbKiH5etU6LSG2uLOYoSbIpC2KSnZrOAxuNM3S9IeCwhMxBX",
+	"Wmxaa2xrusgGljnw0HGqeAxVffxMa2svBooL8HZ652ij+JENnMYbFafPGjtfiM02GzdkwjPHW6QLvlHA",
+	"vMUqQvGKKBCReq+iGZ3ONhK1KdCEQYTmlT5TNXfBng3f9CoShmP95NPU/awyYpPYxIuqQaBBTAp/zjFN",
+	"JUlxaiqtTTgRM/0pSy9Tdp12NPhUd7lrF1L9dOqtuPr1MN9D9eNLu6uaCe3Gqp/3CW5ucFSARWjVHnSq",
+	"n99YeOVnfgDlgFvOXNcMLqacg8NXPJd/4LphPHDVpDd4lhqbdULTSxK7f3hfcEKx9uYVuoX+h9dCzUwj",
+	"bWO2M9BUexkPhgPj2Qg/A4dEdeaiMY49LBkOVkMUDzQHbl+1307dYqtNfrRbr/vU1HnXQKf65cjCq+5T",
+	"07BnFqTVT/s5kKsfD3OwVz++8g4igGDe0VS/vsDhXm/c8QVgr94YH51/ZDhuQWZ1rzugspDZWCErwzFs",
+	"J2VyY8IyILJjHG8IIs01hYggoLB86qHvuvTJbeFMr6D88492ReUPr5l8aRZY/vQCx2duveWPB2b95d+P",
+	"7H4qH0p45z4E6MublMqcq64mfTCUqVXzF36hylJi8MGqZ6lsXXyw8xTc7KGu/dl3VmKJMZmztFPAAcmx",
+	"s+OmyiT4g8a6VYYooj2YnMeuf+gKXOsnfAhbN7oJW7Y8f8YdOEwJkiIAnn1VTEiEN/7c2vhm493/BO1X",
+	"aqLwatQXrXZxtfyEmMUjUyrsYvCouBj/YyuPBNMWsaR4Rj6whwWU9KAYYppaknx1LnZXl+areFf89E2N",
+	"3t5+5IrpNFopOVRgrytlAFMcv/If/ZOlBMkcSGKEdm0WL+PeoNUzclZopxVM0NuVpZAMUqRGJi8jggL/",
+	"rhxCEVK2USHfXQhi+Wje5DRFMZlyQgTaI4mgINpojyZBYksx3Aad3A9SiY7eQji0ULBjWrHF5DjNP8JL",
+	"xASxw40GXoHY7ZBE/idLa/blV8IoHobDiKJ35fvnz35bXE5/U3DIPUOsdlTHg82YlERIPVBB5a1gY4at",
+	"C9cs4dO7D9VklNWdFBsUk4oZPzCNKi7nwqC3mn5RVtMSiqyWSavc+XaTaZVGD5thA42K9thSg/szzIYm",
+	"7mShLXXsTbV/W1Nt6PK1YXilokGBjpvnpJ6c6xC8sGVPfULX+gk1A3hl1UQ4O0YJFnr8Lpt1FKYbi2cc",
+	"U8Oc3crJ/vOqezePTDVY3RihVLDpOuCqOCUIK/Xqnq0Rq9AYCPpu2IJPa4QKV33J1fl6znW5Q/2PTOcA",
+	"L61BwQTYIJeogwvjPQizHe6+3rUl4ndPD3Y3fzze2z0/PH6tvCgJJ/BjkZ9R1IGqY0OMIxYRnGrfQdvT",
+	"cWOq8QJzSaMswRwJqk6CyhlNXU1EXGTudsGMjTdfk+vffmH8cogOMoV/myeYU5tiN0vxfEynGcsEerIR",
+	"zTDHESQFtHvVnLpwnpAPLwavjs51ffU353tGRquQp3MVkuTlQFnB/qmDskxQE3cZz0t3B0j3bzTwoJj+",
+	"uoV3VG1x+0o1wzQljsmUpBvkveR4Q+KppkGMzwc73sQfak1yagGMG0+Q3BSH/Z9/g5+nHKeyPXC149JY",
+	"TIZsrmiDUo7Z9f1m6mIGXCNOftg70OuzbW5zLW7i0qJg07+FQ+XM4UGTapScVnL/BqgxGA6qAB28W2+5",
+	"3pI0ndKqzt8yTmvXaBuhN6eH6KElbY0nrcyvNI2SzHgYFNpZXH90W2fg76J0BEVIhsrXqs/mDuqaLF6H",
+	"20XbwtCldYqINWAJfL2tZcBghelLD5aHI0OPDAS5Bk39tLPJzcifGaOaNjciQtSdnxkDm0gu1ag+RVht",
+	"d/gK5KG+82+NWtjCQN6n8Hgq+JuI32hIJwDQKIeH09R64IfTmdK4FkCH+3sqeZKG8sPv354/GqET/Szr",
+	"WDkdPAztIL0NW5CUxjnKBSzujVfKEQ3vZgXHgS811FGDoUwWXxDMCxHWTY4uft2O6vj5xzyyWrFRdJpq",
+	"VZ5RU9kC1fp6QBiNTu1DuC4ZLYboeO8QYS7pBEdSF1+z9aSFLdsDHlgZKL9UqVnIkaGb5jNYF0yM/NWN",
+	"l1pxrP0SKAc+Sft/+c10nuMLy9pdDEyGJmEjDe081i/Ac+EsByykLCXrRx34cA+GHpglFqINzE+5+9wp",
+	"secIiChAADGnMCc63+0lWarfBxvq/14cvDp8jU7evPjxcA/9cPAL/HiRHr28vD64/uW7H9i/Dv/8fWtv",
+	"96dfDs2/93d/ivZ/mu4ejEajixTaH7zerw7h4dsZnQrJOFSuIfEgp9u2OuymhdmHPuX9F6eryxH/VsMc",
+	"vHFrlGzFBiUFm/t4n5VcK7N2U63lG+nVan9ftZp3T8Jcb7kFoqXXGXkvgTNxmxfXJDjTwWk5G1NNH+q9",
+	"JK1Ieeq1r+fWd/N5H4bC4x4Njfe9WOAIXPC9fHymTExT/03Gp5t4sXg0VH2xqkofR5g7mx3kJWRzTFMD",
+	"Bv0HeviPwio6JLyB/Q0LYGo7yrB2tNig5Gxf5rbMCcJlg5jX4jHaU68ep8c/rHWUtRmWXVOdCsRfoHMm",
+	"MIukihV0hysKpwtJItWIcyZk3lPvCrY+N/HNONWTWPZNK8nMvHlLSEIJvcGtf06ItLkmFSD8la9DffW9",
+	"bE+5paFeixmnxVsWQA2vhaHhtVdcw6B69poLDB3fIhsnNFL+8sKpDpFU0+oYXjWXRzlG6OTgyOXcF4bV",
+	"Qw8jpho+KgwHwQoFZlAf1O7Z3uHhBuZzpjQkr05ehXqlJH6xVE7BkGTa27IKy05d0gzoooASRWRRzsfd",
+	"nByteyRUwtglFjSuMbS/Of3RLse1RBouAFEdMK3vMuhivSvNJh5wh6XN/0jwFWRb1j7UpoRBft/VFBUi",
+	"QaUgyeQmgV9dsoSWETOYKVTW+JEMgr0bnRhqkH6EduHgzfkLSwSUkK60/qBzXCruJofQEGm5CXH4D5SP",
+	"cwdQxFc7lbl0+kzzhcDfXhZAfS4aye0pFodwx6+W9PDVyatH+XC+EK93BQkifzdOloWF6R9ggo5ehSGQ",
+	"u1lCH93MoY+V1dQ0Miv8YAvwnKkEFFkSOOx9X8FgWlntKZtjSSMUs+vUeGMDiE1RpqFRoqqfJZ3brxa9",
+	"kdR5Z24nSQUkmHjFcUT2vXQVXfPZ3E7yhsAaQpdMRR6qNJHrah6VssuOUa96rFEaHjRrC8NRni9Vif6a",
+	"OlPDga+NCTxmaqkFjU13kerY6xWSqRag9uEk/i2z0ZxV44ltg2yb4CZENg4FbWkZq2id6qC+86TS4qlc",
+	"1blTqYrknrVd6pSQ1pDczPDaQUPI9jNLsjk5CldZgp9NKLYfyYjRFXSrXs1wTnM9zsILPs71jKCWNYZJ",
+	"GJwtHBJ4WcOJjDbTKU3fK5FxMop3OFu/Ks9bxWUeXAU5t91CpXpjhMa2gghEkFs5e4iE5AR0k+Ol1klY",
+	"CVpo9fODazXTAxDxeBaAl1lT7Q3XhpD68ih5QZHCqnOnCVXKFO0f/HhwfrBfaCN0Jng/De4DgayMq1Y8",
+	"zTDHqSSan1QZeIgcodc67hCO6sXx8Q9Hu6c/FAcOBNsOB3aOWn/B4wX+IyMmrU+O5IVtWeTRZ6GBP0Iq",
+	"XFYxWybH+4PSVA+UwR3PCdjDgSBmc+X+IKMZPDmmeh4VhbnCSv0ODFaOW/WsVRUczVjazmZZ9ARBAOU9",
+	"c5l0VHNaKMKcL5XIpWeGNxicBVR3nalKucKqoopMT6A1Q6pniiRXuQF1NTlwMC3trMAc7e8f7Kv62cf7",
+	"hy8P4Z8GMwfDgV1dR7Yo3+JurMMp8l+OWAyer4UfdeG+4m8vGLucY64SAup0Ehmncql4nbnJ4Qc2GWXz",
+	"yf96aZ1kvn97PlAitmo92DFfc7RRei/z/NXV/XjzJq/8UbVZsOtUlEpAoiO8AKMHTgsd8os7si8YTUFx",
+	"TyCXmTFOMD5Vts78oVzQH4ixkSplnXFnkliTJDLHNBnsDCTB8//j20XyEdUuXsIXtMdSyVmCzgmemyS0",
+	"OwOr/Cv0ruQG+LU4xLuHoW6PjAytXz2T6U2FnGoXc50WHgRqlflb26DYBJF4mtuHFG5rQ5Mq0K14UjG6",
+	"SCGmLSKG1TI7213gaEbQ49FWZTPX19cjDJ9HSmdl+orNHw/3Dl6fHWw8Hm2NZnKeaM5RwgNWAtLuyeFg",
+	"mL/2A2toUviyICle0MHO4Mloa7RtSjMAOm4qT4PNyKUjmIbc6V4RWUpwXHzBFXK4wNnD2Di6mBwHw4Fl",
+	"GGHCx1tbFifMY4nzAuWbv5vYZE36WlXs+SyAcCXy/4Pa+1fbz29tPucRXJlLrUSXBTNw0daurx5/cw+T",
+	"nzOGjpQixLhVaZ9l7cXw66B4cJou6VNfED6nIM6IxqMHUmwMFS5IG3m9nT7Vm8twv2HUeEXkiTf5HaJI",
+	"Pg3YggLQ+7FpZ3CIW9v3cIhvUuvzQ+IvF2+Hg6dbW/cwNdS1VxoBbb9COuCx27VRaG2ftuCdKYrLln9X",
+	"biTsPSX2AYYtW2+DHPxlQmvTVWlGU3JKrgjcLN+xNXzL7BLu8n5VNAsh1C6ttr9U/aUqXypTP5LUXqqf",
+	"TQPFp5auiHOZql4B2wtYHiOyad+YQGHxwKjq1tmlORZ4RnAMbLnl63xnzcHQg2NZmfDuDm9iE0qoncA2",
+	"9NW7j0lf4Nii4P3d93NT7yLfa3/hP9EL/5d92NQl+rDpnCMXTMhaJ0lpvD2NbiLwtPqxAWKF1/Xhye4R",
+	"okJkhD+qemobV30VzgHKRXCPNxrGMOE5N57ojVTntZfGsOHZz0ROe0AB6SiPD8OBrxXSSr4WQgRAesHi",
+	"5a2hSiG4Q521P9T7jevr6w3FBWxkPDF247XH/lDe7oc7pK1Ft+1awsNdi9ulsq3TF4htl+tnEade8AOx",
+	"SGGyTb1arL5ZxHjV2G8r2jB/N82NcgVdKqiXXLVPyLDpfMl05g0vl6O9OzCCGkA7d4DDhyw3eqAjqjLy",
+	"wFT+p2mxpAiIuPYI6/RddpDGZ76SHWEX2epuRl8sOY2KgrVOz0dimx1Q6f+1XklXiyumHCVXhC/lzFTj",
+	"Ci0Uep15NeXuabUAWzG01FHpwzWuMK5AfEnQg28fDNGDb9X/QjL8//j2QZ7m45Ist7+Fc9seXpLl4//Q",
+	"fzw24WShncKM6+0UXIzwezrP5l4dNIt4bpM0zTfvEASdO5RUjmUJmDCaEK3QXXlwFLCcvKdC6kFtf4O/",
+	"yrVSXeNKxYL84oAvnMjGQtGAVOpbVIsZdE5lAU6VbI8GJoOd7a2tLS/txFY1mOJOeVqfptTpb4ya7+/L",
+	"1FaE2K0n9zDrS8bHNI5J+tE52fvY7ZkxAbxJnRqw8pDaNxN8WMJs6h4nRkQNvpzVh1N38BsP7oYzK0zR",
+	"iXvavsO5Q1CzeQBhem1aK3Tc+asEu7japsh1OMPLfzmiPWbx8j83rWVrE76rBb0isnmyKZG3M9MpWSQ4",
+	"atkaDzRac8YPPXG8a+K4dR/EUdm5EhrJnhyHyPH7DUtjBzuFr2JQEXk2/wKVg6beioSEPBATshId32+j",
+	"Rb+2lTEITqQrJ6mhaxQA6wn+966B7Hm0+yBDX93DlMpXS6cU7elQgA7Vu090JiWviLwTOjIl8nMgIm3M",
+	"Yk9KelLyZUiYSo0ZrLcUzVYgJ9D+TggKLPBWSUpXsXcDpv6fFT2BVJ+PZD/oidqXSdR6yfDjk9EswJG9",
+	"WcSr6elOWxUy69NRHbv2UQjpXeoP75t6fgyNZU+0e6LdE+17V+dFhJtwI2ICqK3HT7M7w17e70z3M7Bo",
+	"822o7dg7OvSODr2jQ+/ocFPaWUtgeq+H3uvho73Lte9sBxeIDo9tnTtEbc878o2on++eHSVaFtLRa6J+",
+	"lBoXiiZ4r+9PscIypkTewRqMzL7COnhbj7XXohUOtQPvLhSDi5PqkrKOHXvvkN47pBcnuzxbBdmyQZJs",
+	"FjQ7OJHExonEfwmRub4opyghR5KuFKhV6dj+CPcuJj0t6+3CnysxC+q6OMGx1iM5ITpqICgV95N7pj63",
+	"5pgCFWX/yMihTvSmGn8kqb0nUD2B6glUuxfLWkoC6HvPNKr3demJYk8UexvqZ0uGsyCfCOquEqu415lV",
+	"PF1NXXZLpPizcJe5oUr5o1Ljj67R7l+E/kXoX4TPSQ26iT0DRvCt0YYKqOETk3TZxPpXOf43axlBbvDe",
+	"SIZwccH9e9Nz/z2t72n935nW51RcEX2d4BpHagViU+e4r0/QdgrfXVbsMRbKZy7VPn25mx1O401mfOfc",
+	"ryF3ezXavh7sjrw+9Oh6po9ELItLqE/v1dPJ3tnrzklI4b6rkgnvN/gYQ41C/aPzRvGKTQx2TD9HIT6U",
+	"6U35uyMtLc7a+nK0eWbnNKJ3w+7dsHs37L+/G3YAfcaMJQSnaJLgqUIhXQSO6HJEaqHzOeauTqShPiP0",
+	"Vm0SoMigltLQlkbREAMgm6pSeWUjO5iffR0d268P2HVK+AONaIUr4dUMEroYjkZYEpu6TmZgNVShulMI",
+	"pF7bEAIaeISAdTgxW6WpkMpPwF0udXN0GeyhR/1MSSRRqvBjaliZAlNiiOYs9j9Dof6E6L/YRNNINYMj",
+	"5wqhvKJC5l5DMSh/IFs1CAuUkuuEpmQjJoBRJEbfnx2/1qV9hVnuBjQmV1BMyBTJNFWnbVnIB5K8l5vQ",
+	"ZENv7kEdmKGi04oAfhso0jW0JaIArjCnKxJVqkcF6YWhdFSp6tFQbdKnEDh/SXI4jtDhBGWpIHLoTwZl",
+	"AgUydb2ijHMFEV2YW1ELOifQs7wcVdkjSdi1rgleXRScT8pQwtIpRLWqu6Jqu3oIpO9TrAtxoq+2t9Ar",
+	"lhJbN8eeDrykQLH88kQC4Smmad35lFbz0VKvayakD9XoufePzL13icso8dV1QRi62Z3K3vcdXuHP2iGW",
+	"ImJzUwbIdAyET1TarB0hoB1/62fyvt4kKqNugimRtzb6j1jIM0LShllck5vPZu5M/VymwU1mOiVpTDiJ",
+	"G6BXanLTqJW6mXjh8+3MUgdBHmjUx5n0cSa90r3y5oY0Xr6qa4Wco+0P9H79Y9Bq8ywN3kd/9BSmd67+",
+	"LEhMfWrRdorxishbIxefSR7Rema/pxU9rfi7qwCaoy5a6QU0vDWK0QdP9FSrp1q9r9QnSCebkoO2k8nT",
+	"BmXMOoTyswhtWEV3e3+E8X71xD0l7ilxT4k/ggJt0ze51AYbqJXFWUI8dw+t6PL6VpVqLbac9VRr+aCf",
+	"BVn3odDzvj3F7SnuF0Vxi+Q1QH4TLKQwpt1ahSQ4H2IhkWoJzkVC4vmihk42aCtrrMRrai1r1zVh/FaJ",
+	"8916GVmYNLDCX1XP5TVDe2YRPSntlZ9fHGFzhCtA1Lhx3Wglarah4SmDlKvRD+QmlKs0uXXNNn6jt0jD",
+	"gl7rQDcvU3aduoUYr8s650xofFpsO/hUrUE9zezZz579/OhU2lHiIJW+Ynq5tXL/Kblil1rqn+MUT8mc",
+	"pNLPfSgQFSIjMUR8ON1ASLGrBtJEw4toFzcl59czJkhxQRDxpGb7LPQDp/khfKRYWTv/KcQx9dqCnsXt",
+	"iaclnvndrJJP4Zx8G1lc3UzRr1XcioLOwb1zUU98euLzhTkXrUxDPFejW6MivcNRT8l6StZTspu4/6xM",
+	"yE5bo6V6l6CedPWkq1fY/Y1kTiNVKnmTpJwlyZykMmLphE4bRc28cSGRSkjCPHBN9/S4KxBV3DGntM4C",
+	"NYEEdVZF6GnpIDeGKUHsJbegkU0SMyPRpU03Uj+jySUjwpNARgxI3UMFirAgLo0NtQYgk/yjDJEROkwR",
+	"ThLE5Ixw6KsX6UHZn0hnCYKVjwki84Wszd0TCf7RbDaVg+8pfc+kfiF0N7+5eR7PIpFdsIRGtC2JXn6H",
+	"TlT7ZVs6vVJ72mfW6zPr9Zn1+gLnt/iYa0LUJ8vqk2V9Aq8rvKLLLmmzal/SugRa5Q53lEqrMs09J9UK",
+	"z9+xVHmlc03iqwAs10/m1D7plMjbm9HoBdtn5TUN+5RLfcqlXh/VQLkLmqmAhBQWnFZJybQC8d/vQrBa",
+	"LQG1E/YJm3r61CtuPjMC1ZC6aQXK8orIOyUrn4nvVReGs6cuPXX5cgTX5mRPK1AY6HKnNKb3zOrpXE/n",
+	"ekeHz4SyNqaHWoGwnnZS7dyMtH4WnmLraSs/BlH9WDrSnp739Lyn55+CotCVwO7oYVF2LGt1sXBQ610s",
+	"eheL3sWid7G4LS7DEJbex6L3sfikPBjbnCzShte03c3C9LhzP4uV9EHbd72AVk+LXSjqHoBTxQEB17W8",
+	"YVGzDlPHNQ1vx8+jdtopkXc8Z0N1srq2t+dpUrtvXtfy1uduqS12yzDofV56n5cv5CWtkWW5t/yALLuC",
+	"08tqj/F+JwK+goYzFKbVO770RKpX8fV0sYku1vvarEbQXhF5x9Tss/O3aZA7eqrWO9x8QVqMRo+b1ehM",
+	"yefmTihN73XTU7ue2vU83GdDX5v8blYjr6fdNF03JLCfme/Np09bP5rivKfrPV3v6fqnqLPc1OYpnNSm",
+	"fzeWLsQ4ikm6DD4V1Rdit5vVa40XQjKEi0v63F6IXQvyj/1S2IX0etVeA9FT0lZKmtPKZpK6elL4mytR",
+	"10uN2qtSe0LWE7IvTJV6I9oTVqzeBfXp1as9BewpYC+G/x3UqzciuaerOPX1Ktee3vb0tuc4PzXR2U9p",
+	"f6VWUisenxLJKbkiAmEX66W7jC7ScOyfHrAt3u+LCSk7Y1wixmPCIfm+nOUhXuNlXqG9GM73QI3xAD1M",
+	"ybV6FCaUC1m7OBi8sKhYDwVBByIaDAckzeYKXTD8BT++G64bDqfPX5+bOiIbz9YWKnnLcWbDLzyG9HCC",
+	"4MlHNBWS4Di/M+pC6Os69PaIhOQEzwVKmXRFtQXCY5ZJhOOYwt9DNGex/zmNtU8y/MUmGhJqBhcDjLBA",
+	"b0ESVYhhr+sIvS7Ow9VCUqlap+Q6oSnZiAngBInR92fHr4fKhICFWe4GNDa4ZupORAmFEaKILKRADyR5",
+	"LzUF29Cbe1AH4mu1vhB8x4wlBKchAL+dkRQ9gJ4PEBUG2gqD5paJVHMiPAE8U6ydt2N0TeVMV7qwkDIV",
+	"wodqk34sKc7xJYcjFOTIUkHk0J9MSMylQFjTyijjXEFkwSiUGQF6Aj3LyxFowpKEXauTCy0KzidlKGHp",
+	"lHC1PExTvxII4QaPY6G39tX2FnrFUl3UwzsduC+A94YoWCyZYlpbSb20mo9XkEOBvg8q7YNKPx5HpzAw",
+	"wMWpnzXLNkkIaUvZ8FK1aUvT8FIP1Kdm6FMz9KkZvoTUDFUe0pTfUiuazzFf2htoip9ZeADJqVskjmNd",
+	"p1Cc6UFW5LN6RrZnZHtG9pYZWXjbe0a2Z2Q/GiMLb0aXsjNFXrUuCQq0uqPEJ3rse0524k3asZSM7lGT",
+	"WMTCZ/3EHjXDT4m8pbEbEoX439eeR5G7czJfJFha+huYLQm1Ks+pkXeFrCA1wOP+15tmHmkEIq+26TOM",
+	"9BlGejeB8mtUUKvAz75aZfMv+O+HTWlIxJVHSIL6Fs0hmtboKqcoVYVLC9kJuguw61SLuooJrUxT4xww",
+	"8R7Lbt4Bw17t06t9erVPn5FzRYpcImm9xNlLnJ/mG1990Ds8+h1yicW2gF75ba7JH1a6MDdmAe6OAyg7",
+	"K3acuU9S1lOk3iPwEyCCQWmFKwOLnPl8SivhekVkT7Xuk2qVod2Tr5589TxcGw/XvdZxm8Vhv1aj3hrR",
+	"URy6z+jaU5ue2ny2zJKuX9xGLV4ReUuk4hZj/D8JV587d3DoaVVPq75Af4rmasht9Ara3RLF6vMC9ASr",
+	"J1h9LoBPjkQ2ljVuo5Cn9V47a9DIzyKMfwUXuHsjiffqbdeT4J4E9yT4Hv2sOqXnA3NFnqylaLiw9Dks",
+	"jq+XkeVOhfJeHu5pWy8P3688XMr2tIJ0fFsEpJeReyLWE7GeiK0hsZqgjhU5oNO2UJBeiO1pVk+zepp1",
+	"Fy4aXm45HRbRKbdcTIWkaSRd+ILu61Km5SQvJ0rLBalLQvejnrkD1VOjmIgCR+u4WZhbBGfzOovoJU3j",
+	"RtJnU69pu2mntGu7aEITE21TXgtLkyUsyAtIlzPsx9RM6RVJdXsXJnInMSi3sEodftG2yluPH8nRTa/3",
+	"Y+eyW08xQN7j+SLRPfRGDvQv6gdj5R/sDMyPbk9wqRJ7QyCCRaeSvKKcpXOSym8XnMVZJLWnJydTytJv",
+	"M7FBsJAb24PhQFLCvx3j6JKk8eDdhw8+IJqIDtzLPkakjxH5aI8X4H318TLXQb1ajE9xSv+EZa2WGLXQ",
+	"c4TQsaKCmq6I4kdNDBWhyQThaIYF5IIRihKFc3UdF1b1pWZXvUsFqg/hnkT1JOreSVT+YkMKP1a68ZaC",
+	"+b9XCVmxl6JnnEwIJ2lE5gSLjJN5Y8JnmPrUdjnKu7QlEwz16XML9kHmfZB5H2R+U1oaoi39E90/0R9N",
+	"igi9qV1SnTU+rHWZz0Kd7igRWnCqe86LVr+GjmnSggPUZE2rge36ic66TT4l8nZnNiafbrPzhsZ9zrA+",
+	"Z1jvy9ZC5QsSV1i+qpW8VolTXfG52O9K0lrtv40T90GtPdXq7bOfIdlqiHFdkdK8IvJeyMxn4nvblWXt",
+	"KU5Pcb4scbg5UnVFqmM8U++B7vQuuz3t62lfH2L1mVHbxqDXFYntaWcl0c3J7WfhXLy+bvRjEduPqZXt",
+	"aX1P63ta/wmoIBdMUMk4Ja0+H6blst3Twxuzd/DoHTx6B4/eweOm3IUlPr1bR+/W8RFfW4uG3Zw5Ki9m",
+	"vQuHG/iuhBM3wb27axRnbnXSsBDREDtbplHVQyGqtqnATZFI9V/v0Dr4KQydlTTvVeca4p3ZTRxC6iea",
+	"EnkbszhRvX4mXmnSO3r0jh69lBWk+yXZypN2yiLVas4cHZ6L/WbS00HVVpmkd9foaU9vPP1siE+jk0YH",
+	"CvKKyFsnH5+NG0YTK9rTj55+fAlCa5vLRQcaYvwJbpmK9E4VPSXrKVlvXvuEaWeLA0UH0nnaomhZl3h+",
+	"Ji4Sq2kh75dg3r/Ws6fSPZXuqfR9q+f0N7FMo1aXh9y+0O70kLftvR56r4fe66H3erg5E5HTlN7vofd7",
+	"+IgPbP5mdvN8CDyc9b4PTVb8W79I9+//UJ67c5qKJg+IuNrmZl4ITZNNibydmZz02zQbDzTqvRF6b4Re",
+	"3KmhxiWBJ/8akHhW80joRMb320hRB51WYKLeL6GnQr1d8TMiQ42eCZ0oySsi74SMfDb+Cc2sYk9Jekry",
+	"ZYiXbT4KnaiJMdDfAT3pPRV6mtbTtN4K9olT0RZvhU5E9LRVGbM+Gf1MfBZW1R3eN/H8GNrKnmb3NLun",
+	"2Z+EKm8TTPjkWq0ybJcx+0YYmaaWMkcznE5t6TU1prJXl56Ga5YlMZrjSyDZqpcu5AfW6whLnLCpQFSi",
+	"OU7xlIghuqZyxjKJFECXakQ5I3Nt27XzU4EUHDOZm5dVtbApV7hoRgaLL9R3VwNCm8N9u3JnnDYkP/Rw",
+	"wQd/LycGULcmBujxbusBC9rn1RSCsjTfuHP/81ZQ8I5QzSTmUyIrvf2t1BnIbZ8VCy3dzRtkjqwgvPQv",
+	"Uq8Z6d+H8vvgnoG2d2LzL0MzD+MPDeXrcJz7h+kXo/QwwKNxjYUlwSRGBPOEKh81d4qK0jtanaWSJoVR",
+	"Z1igMSGpew20UxSegFuPROT9QtHOVk3x7VJ2uzGzShI7UnkXFD5/1CxUvDctMKs7vU9Sb21Polf19ES6",
+	"J9KNRFqQiJO2Untn0MjzPs79f229f8pRjCVGGPwpYxzJEMVUo52ZGXv35N49uXdP7t2Tb0gxgZr0jsm9",
+	"Y/JHe3r1E9rFJbn0jtY5I+tmd+SGbAa/Zwdkf9aOrsemS43TsYPR+u7GdRNMibzp6MZgVTcDL3zu3Yp7",
+	"t+LeFlGhpQUBRv8ufJFlFSfiVsK7X09UWhU4pcF7l+GewvSaks+CxDQ4C5cpRknjQaXoou94ReSt0ZTP",
+	"xH+4ntPrCUpPUP7u8l+jt1srF3LaIBesQzI+C9+2VQTS+yNT9yv89nSx92Trpcd7kR4lz4RcsIRGrdWG",
+	"zlXTE9W0tdxQ3rSvN9SbtnrTVm/aujkd9MhPb9/q7Vsf7VXNX8xOFYdCr2adpctre0fmLn+Ge7Z5Vabu",
+	"aPjy+9VYv4pwW98E1jjVlMhbmcdItY1z8Wqb3izWm8V6wSZMggvSTVGiqcg4q9jJutHu/RYa1KqqCk3T",
+	"m816CtRruT8fEtRgO+tGRV4ReQck5DOxkrXwhj0R6YnIFyFKNtrLutGR0zbRYW1a8lmYz1YWcO+ZiH0E",
+	"ibonnb01rRc671vovCIcIvZ3/qrnDYWZ0rQNMoU/m3HukHDZKRo4r165/WUgucXad9BXW7Q0z5DxZLAz",
+	"2MQLunm1PfjwzvUpI/axxWCBJowjdaYklWYjo5xjKH4YfBg2DMRStJvJ2QlnVzQmvGh+9sZbmAato+0R",
+	"LulEzU3O6DSl6dScRXDoKG8tdGvunrnmefYJgDs0aAyfmkdQANTtEI7gp8oA5vfWlRyknCXJnKRBE74Z",
+	"krhGhtB1H7UJfvmwneCmds2J5JRcKZMxuVLI7Q+nfmhd2suEkPByIFnOSkvQZneEI86EQDGdTAgnaXh0",
+	"aLvS6Md8ilP6J3wMDsm8Bq37PiWwuIgcESwyTuZ1C+W24Txv2GH0Sqm74pj2c4eR6io5ubG8GO620Sox",
+	"2fk4xg2mbYRa9xYzjP/+dzjdiFA43MAbbwa8ss/uuw///wDQsbgulFcEAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -25,6 +25,12 @@ const (
 	AppTypeQuadlet   AppType = "quadlet"
 )
 
+// Defines values for ApplicationDependencyCondition.
+const (
+	ApplicationDependencyConditionHealthy ApplicationDependencyCondition = "Healthy"
+	ApplicationDependencyConditionStarted ApplicationDependencyCondition = "Started"
+)
+
 // Defines values for ApplicationStatusType.
 const (
 	ApplicationStatusCompleted ApplicationStatusType = "Completed"
@@ -37,6 +43,7 @@ const (
 
 // Defines values for ApplicationVolumeReclaimPolicy.
 const (
+	Delete ApplicationVolumeReclaimPolicy = "Delete"
 	Retain ApplicationVolumeReclaimPolicy = "Retain"
 )
 
@@ -74,6 +81,14 @@ const (
 	AuthStaticRoleAssignmentTypeStatic AuthStaticRoleAssignmentType = "static"
 )
 
+// Defines values for CertificateRevocationReason.
+const (
+	CertificateRevocationReasonCessationOfOperation CertificateRevocationReason = "CessationOfOperation"
+	CertificateRevocationReasonKeyCompromise        CertificateRevocationReason = "KeyCompromise"
+	CertificateRevocationReasonSuperseded           CertificateRevocationReason = "Superseded"
+	CertificateRevocationReasonUnspecified          CertificateRevocationReason = "Unspecified"
+)
+
 // Defines values for ConditionStatus.
 const (
 	ConditionStatusFalse   ConditionStatus = "False"
@@ -193,6 +208,7 @@ const (
 // Defines values for EncodingType.
 const (
 	EncodingBase64 EncodingType = "base64"
+	EncodingJWE    EncodingType = "jwe"
 	EncodingPlain  EncodingType = "plain"
 )
 
@@ -201,6 +217,9 @@ const (
 	EventReasonDeviceApplicationDegraded       EventReason = "DeviceApplicationDegraded"
 	EventReasonDeviceApplicationError          EventReason = "DeviceApplicationError"
 	EventReasonDeviceApplicationHealthy        EventReason = "DeviceApplicationHealthy"
+	EventReasonDeviceApplicationUsageCritical  EventReason = "DeviceApplicationUsageCritical"
+	EventReasonDeviceApplicationUsageNormal    EventReason = "DeviceApplicationUsageNormal"
+	EventReasonDeviceApplicationUsageWarning   EventReason = "DeviceApplicationUsageWarning"
 	EventReasonDeviceCPUCritical               EventReason = "DeviceCPUCritical"
 	EventReasonDeviceCPUNormal                 EventReason = "DeviceCPUNormal"
 	EventReasonDeviceCPUWarning                EventReason = "DeviceCPUWarning"
@@ -216,14 +235,26 @@ const (
 	EventReasonDeviceDiskCritical              EventReason = "DeviceDiskCritical"
 	EventReasonDeviceDiskNormal                EventReason = "DeviceDiskNormal"
 	EventReasonDeviceDiskWarning               EventReason = "DeviceDiskWarning"
+	EventReasonDeviceEncryptionKeyUpdated      EventReason = "DeviceEncryptionKeyUpdated"
+	EventReasonDeviceInodeCritical             EventReason = "DeviceInodeCritical"
+	EventReasonDeviceInodeNormal               EventReason = "DeviceInodeNormal"
+	EventReasonDeviceInodeWarning              EventReason = "DeviceInodeWarning"
+	EventReasonDeviceIntegrityFailed           EventReason = "DeviceIntegrityFailed"
+	EventReasonDeviceIntegrityVerified         EventReason = "DeviceIntegrityVerified"
 	EventReasonDeviceIsRebooting               EventReason = "DeviceIsRebooting"
 	EventReasonDeviceMemoryCritical            EventReason = "DeviceMemoryCritical"
 	EventReasonDeviceMemoryNormal              EventReason = "DeviceMemoryNormal"
 	EventReasonDeviceMemoryWarning             EventReason = "DeviceMemoryWarning"
 	EventReasonDeviceMultipleOwnersDetected    EventReason = "DeviceMultipleOwnersDetected"
 	EventReasonDeviceMultipleOwnersResolved    EventReason = "DeviceMultipleOwnersResolved"
+	EventReasonDeviceNetworkCritical           EventReason = "DeviceNetworkCritical"
+	EventReasonDeviceNetworkNormal             EventReason = "DeviceNetworkNormal"
+	EventReasonDeviceNetworkWarning            EventReason = "DeviceNetworkWarning"
 	EventReasonDeviceSpecInvalid               EventReason = "DeviceSpecInvalid"
 	EventReasonDeviceSpecValid                 EventReason = "DeviceSpecValid"
+	EventReasonDeviceTemperatureCritical       EventReason = "DeviceTemperatureCritical"
+	EventReasonDeviceTemperatureNormal         EventReason = "DeviceTemperatureNormal"
+	EventReasonDeviceTemperatureWarning        EventReason = "DeviceTemperatureWarning"
 	EventReasonDeviceUpdateFailed              EventReason = "DeviceUpdateFailed"
 	EventReasonEnrollmentRequestApprovalFailed EventReason = "EnrollmentRequestApprovalFailed"
 	EventReasonEnrollmentRequestApproved       EventReason = "EnrollmentRequestApproved"
@@ -234,6 +265,7 @@ const (
 	EventReasonFleetRolloutCreated             EventReason = "FleetRolloutCreated"
 	EventReasonFleetRolloutDeviceSelected      EventReason = "FleetRolloutDeviceSelected"
 	EventReasonFleetRolloutFailed              EventReason = "FleetRolloutFailed"
+	EventReasonFleetRolloutRolledBack          EventReason = "FleetRolloutRolledBack"
 	EventReasonFleetRolloutStarted             EventReason = "FleetRolloutStarted"
 	EventReasonFleetValid                      EventReason = "FleetValid"
 	EventReasonInternalTaskFailed              EventReason = "InternalTaskFailed"
@@ -241,6 +273,7 @@ const (
 	EventReasonReferencedRepositoryUpdated     EventReason = "ReferencedRepositoryUpdated"
 	EventReasonRepositoryAccessible            EventReason = "RepositoryAccessible"
 	EventReasonRepositoryInaccessible          EventReason = "RepositoryInaccessible"
+	EventReasonRepositoryPushReceived          EventReason = "RepositoryPushReceived"
 	EventReasonResourceCreated                 EventReason = "ResourceCreated"
 	EventReasonResourceCreationFailed          EventReason = "ResourceCreationFailed"
 	EventReasonResourceDeleted                 EventReason = "ResourceDeleted"
@@ -250,6 +283,7 @@ const (
 	EventReasonResourceSyncInaccessible        EventReason = "ResourceSyncInaccessible"
 	EventReasonResourceSyncParsed              EventReason = "ResourceSyncParsed"
 	EventReasonResourceSyncParsingFailed       EventReason = "ResourceSyncParsingFailed"
+	EventReasonResourceSyncPreviewRequested    EventReason = "ResourceSyncPreviewRequested"
 	EventReasonResourceSyncSyncFailed          EventReason = "ResourceSyncSyncFailed"
 	EventReasonResourceSyncSynced              EventReason = "ResourceSyncSynced"
 	EventReasonResourceUpdateFailed            EventReason = "ResourceUpdateFailed"
@@ -295,6 +329,11 @@ const (
 	FleetRolloutFailed FleetRolloutFailedDetailsDetailType = "FleetRolloutFailed"
 )
 
+// Defines values for FleetRolloutRolledBackDetailsDetailType.
+const (
+	FleetRolloutRolledBack FleetRolloutRolledBackDetailsDetailType = "FleetRolloutRolledBack"
+)
+
 // Defines values for FleetRolloutStartedDetailsDetailType.
 const (
 	FleetRolloutStarted FleetRolloutStartedDetailsDetailType = "FleetRolloutStarted"
@@ -316,6 +355,14 @@ const (
 	GitRepoSpecTypeGit GitRepoSpecType = "git"
 )
 
+// Defines values for HookActionSystemdOperation.
+const (
+	HookActionSystemdOperationReload  HookActionSystemdOperation = "reload"
+	HookActionSystemdOperationRestart HookActionSystemdOperation = "restart"
+	HookActionSystemdOperationStart   HookActionSystemdOperation = "start"
+	HookActionSystemdOperationStop    HookActionSystemdOperation = "stop"
+)
+
 // Defines values for HttpRepoSpecType.
 const (
 	HttpRepoSpecTypeHttp HttpRepoSpecType = "http"
@@ -356,6 +403,12 @@ const (
 	NotIn        MatchExpressionOperator = "NotIn"
 )
 
+// Defines values for NetworkResourceMonitorMetric.
+const (
+	NetworkResourceMonitorMetricErrors     NetworkResourceMonitorMetric = "errors"
+	NetworkResourceMonitorMetricThroughput NetworkResourceMonitorMetric = "throughput"
+)
+
 // Defines values for OAuth2ProviderSpecProviderType.
 const (
 	Oauth2 OAuth2ProviderSpecProviderType = "oauth2"
@@ -413,6 +466,11 @@ const (
 	RepoSpecTypeOci  RepoSpecType = "oci"
 )
 
+// Defines values for RepositoryPushReceivedDetailsDetailType.
+const (
+	RepositoryPushReceived RepositoryPushReceivedDetailsDetailType = "RepositoryPushReceived"
+)
+
 // Defines values for ResourceAlertSeverityType.
 const (
 	ResourceAlertSeverityTypeCritical ResourceAlertSeverityType = "Critical"
@@ -425,11 +483,22 @@ const (
 	ResourceKindAuthProvider              ResourceKind = "AuthProvider"
 	ResourceKindCertificateSigningRequest ResourceKind = "CertificateSigningRequest"
 	ResourceKindDevice                    ResourceKind = "Device"
+	ResourceKindEnrollmentPolicy          ResourceKind = "EnrollmentPolicy"
 	ResourceKindEnrollmentRequest         ResourceKind = "EnrollmentRequest"
 	ResourceKindFleet                     ResourceKind = "Fleet"
+	ResourceKindReferenceMeasurement      ResourceKind = "ReferenceMeasurement"
 	ResourceKindRepository                ResourceKind = "Repository"
 	ResourceKindResourceSync              ResourceKind = "ResourceSync"
+	ResourceKindSecret                    ResourceKind = "Secret"
 	ResourceKindTemplateVersion           ResourceKind = "TemplateVersion"
+	ResourceKindTrustPolicy               ResourceKind = "TrustPolicy"
+)
+
+// Defines values for ResourceSyncChangeAction.
+const (
+	ResourceSyncChangeActionCreate ResourceSyncChangeAction = "Create"
+	ResourceSyncChangeActionDelete ResourceSyncChangeAction = "Delete"
+	ResourceSyncChangeActionUpdate ResourceSyncChangeAction = "Update"
 )
 
 // Defines values for ResourceSyncCompletedDetailsDetailType.
@@ -437,6 +506,11 @@ const (
 	ResourceSyncCompleted ResourceSyncCompletedDetailsDetailType = "ResourceSyncCompleted"
 )
 
+// Defines values for ResourceSyncPreviewRequestedDetailsDetailType.
+const (
+	ResourceSyncPreviewRequested ResourceSyncPreviewRequestedDetailsDetailType = "ResourceSyncPreviewRequested"
+)
+
 // Defines values for ResourceSyncType.
 const (
 	ResourceSyncTypeCatalog ResourceSyncType = "catalog"
@@ -450,11 +524,13 @@ const (
 
 // Defines values for ResourceUpdatedDetailsUpdatedFields.
 const (
-	Labels       ResourceUpdatedDetailsUpdatedFields = "labels"
-	Owner        ResourceUpdatedDetailsUpdatedFields = "owner"
-	Spec         ResourceUpdatedDetailsUpdatedFields = "spec"
-	SpecSelector ResourceUpdatedDetailsUpdatedFields = "spec.selector"
-	SpecTemplate ResourceUpdatedDetailsUpdatedFields = "spec.template"
+	Labels           ResourceUpdatedDetailsUpdatedFields = "labels"
+	Owner            ResourceUpdatedDetailsUpdatedFields = "owner"
+	Spec             ResourceUpdatedDetailsUpdatedFields = "spec"
+	SpecSelector     ResourceUpdatedDetailsUpdatedFields = "spec.selector"
+	SpecTemplate     ResourceUpdatedDetailsUpdatedFields = "spec.template"
+	SpecVariables    ResourceUpdatedDetailsUpdatedFields = "spec.variables"
+	StatusSystemInfo ResourceUpdatedDetailsUpdatedFields = "status.systemInfo"
 )
 
 // Defines values for Rfc7662IntrospectionSpecType.
@@ -462,9 +538,17 @@ const (
 	Rfc7662 Rfc7662IntrospectionSpecType = "rfc7662"
 )
 
+// Defines values for RolloutFailurePolicy.
+const (
+	RolloutFailurePolicyRollback RolloutFailurePolicy = "Rollback"
+	RolloutFailurePolicySuspend  RolloutFailurePolicy = "Suspend"
+)
+
 // Defines values for RolloutStrategy.
 const (
 	RolloutStrategyBatchSequence RolloutStrategy = "BatchSequence"
+	RolloutStrategyCanary        RolloutStrategy = "Canary"
+	RolloutStrategyLabelWaves    RolloutStrategy = "LabelWaves"
 )
 
 // Defines values for SystemdActiveStateType.
@@ -522,6 +606,22 @@ const (
 	Bearer TokenResponseTokenType = "Bearer"
 )
 
+// Defines values for TrustRequirementType.
+const (
+	TrustRequirementTypeAccept         TrustRequirementType = "Accept"
+	TrustRequirementTypeReject         TrustRequirementType = "Reject"
+	TrustRequirementTypeSignedBy       TrustRequirementType = "SignedBy"
+	TrustRequirementTypeSigstoreSigned TrustRequirementType = "SigstoreSigned"
+)
+
+// Defines values for WatchEventType.
+const (
+	WatchEventAdded    WatchEventType = "ADDED"
+	WatchEventBookmark WatchEventType = "BOOKMARK"
+	WatchEventDeleted  WatchEventType = "DELETED"
+	WatchEventModified WatchEventType = "MODIFIED"
+)
+
 // Defines values for ListEventsParamsOrder.
 const (
 	Asc  ListEventsParamsOrder = "asc"
@@ -583,13 +683,25 @@ type ApplicationContent struct {
 	// Content The plain text (UTF-8) or base64-encoded content of the file.
 	Content *string `json:"content,omitempty"`
 
-	// ContentEncoding Specifies the encoding type used for data representation.
+	// ContentEncoding Specifies the encoding type used for data representation. The service sets jwe on sensitive content it encrypted to the device, which only the device can decode.
 	ContentEncoding *EncodingType `json:"contentEncoding,omitempty"`
 
 	// Path A relative file path on the system. Note that any existing file will be overwritten.
 	Path string `json:"path"`
 }
 
+// ApplicationDependency A dependency of an application on another application of the same device.
+type ApplicationDependency struct {
+	// Condition The status the dependency must reach before the dependent application starts. Started requires the dependency to be Starting, Running or Completed. Healthy requires it to be Running with all containers ready, or Completed. Defaults to Healthy.
+	Condition *ApplicationDependencyCondition `json:"condition,omitempty"`
+
+	// Name The name of the application this application depends on.
+	Name string `json:"name"`
+}
+
+// ApplicationDependencyCondition The status the dependency must reach before the dependent application starts. Started requires the dependency to be Starting, Running or Completed. Healthy requires it to be Running with all containers ready, or Completed. Defaults to Healthy.
+type ApplicationDependencyCondition string
+
 // ApplicationEnvVars defines model for ApplicationEnvVars.
 type ApplicationEnvVars struct {
 	// EnvVars Environment variable key-value pairs, injected during runtime. The key and value each must be between 1 and 253 characters.
@@ -604,6 +716,9 @@ type ApplicationProviderBase struct {
 	// AppType The type of the application.
 	AppType AppType `json:"appType"`
 
+	// DependsOn Applications of the device that must be ready before this application starts. The agent starts and updates the dependencies first and stops this application before them.
+	DependsOn *[]ApplicationDependency `json:"dependsOn,omitempty"`
+
 	// Name The application name must be 1–253 characters long, start with a letter or number, and contain no whitespace.
 	Name *string `json:"name,omitempty"`
 }
@@ -622,6 +737,21 @@ type ApplicationResourceLimits struct {
 	Memory *string `json:"memory,omitempty"`
 }
 
+// ApplicationResourceMonitorSpec defines model for ApplicationResourceMonitorSpec.
+type ApplicationResourceMonitorSpec struct {
+	// AlertRules Array of alert rules. Only one alert per severity is allowed.
+	AlertRules []ResourceAlertRule `json:"alertRules"`
+
+	// Application The name of the application to monitor. If not specified, all applications are monitored and the one with the highest usage is reported.
+	Application *string `json:"application,omitempty"`
+
+	// MonitorType The type of resource to monitor.
+	MonitorType string `json:"monitorType"`
+
+	// SamplingInterval Duration between monitor samples. Format: positive integer followed by 's' for seconds, 'm' for minutes, 'h' for hours.
+	SamplingInterval string `json:"samplingInterval"`
+}
+
 // ApplicationResources Resource constraints for the application.
 type ApplicationResources struct {
 	// Limits Resource limits for the application.
@@ -642,9 +772,12 @@ type ApplicationVolume struct {
 	// Name Unique name of the volume used within the application.
 	Name string `json:"name"`
 
-	// ReclaimPolicy Defines how the agent handles a volume when the owning application is removed.
+	// ReclaimPolicy Defines how the agent handles a volume when the owning application is removed. Retain keeps the volume and its data, Delete removes them.
 	ReclaimPolicy *ApplicationVolumeReclaimPolicy `json:"reclaimPolicy,omitempty"`
-	union         json.RawMessage
+
+	// SnapshotBeforeUpdate If true, the agent archives the volume before an update changes the owning application and restores it if the update is rolled back. Only supported for mount volumes.
+	SnapshotBeforeUpdate *bool `json:"snapshotBeforeUpdate,omitempty"`
+	union                json.RawMessage
 }
 
 // ApplicationVolumeProviderSpec defines model for ApplicationVolumeProviderSpec.
@@ -653,7 +786,7 @@ type ApplicationVolumeProviderSpec struct {
 	Volumes *[]ApplicationVolume `json:"volumes,omitempty"`
 }
 
-// ApplicationVolumeReclaimPolicy Defines how the agent handles a volume when the owning application is removed.
+// ApplicationVolumeReclaimPolicy Defines how the agent handles a volume when the owning application is removed. Retain keeps the volume and its data, Delete removes them.
 type ApplicationVolumeReclaimPolicy string
 
 // ApplicationVolumeStatus Status of a volume used by an application.
@@ -668,6 +801,33 @@ type ApplicationVolumeStatus struct {
 // ApplicationsSummaryStatusType Status of all applications on the device.
 type ApplicationsSummaryStatusType string
 
+// AttestationChallenge AttestationChallenge is issued to a device to start a remote attestation round.
+type AttestationChallenge struct {
+	// Nonce A fresh nonce that the device must include as qualifying data in its PCR quote.
+	Nonce []byte `json:"nonce"`
+
+	// Pcrs The indexes of the PCRs that the device must quote.
+	Pcrs []int `json:"pcrs"`
+}
+
+// AttestationReport AttestationReport carries a PCR quote signed by the device's attestation key in response to an AttestationChallenge.
+type AttestationReport struct {
+	// EventLog The TCG boot event log of the device, if available.
+	EventLog *[]byte `json:"eventLog,omitempty"`
+
+	// Nonce The nonce of the challenge being answered.
+	Nonce []byte `json:"nonce"`
+
+	// Pcrs The values of the quoted PCRs.
+	Pcrs []PcrValue `json:"pcrs"`
+
+	// Quote The TPMS_ATTEST structure returned by TPM2_Quote.
+	Quote []byte `json:"quote"`
+
+	// Signature The TPMT_SIGNATURE over the quote, made with the attestation key.
+	Signature []byte `json:"signature"`
+}
+
 // AuthConfig defines model for AuthConfig.
 type AuthConfig struct {
 	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
@@ -827,6 +987,21 @@ type BatchSequence struct {
 	Strategy RolloutStrategy `json:"strategy"`
 }
 
+// Canary Canary rolls out to a growing share of the fleet's devices in steps, waiting for a bake time after each step before promoting to the next one.
+type Canary struct {
+	// BakeTime The time to wait after a step completed before promoting to the next step. The duration should be specified as a positive integer followed by a time unit. Supported time units are 's' for seconds, 'm' for minutes, and 'h' for hours. Defaults to 1h.
+	BakeTime *string `json:"bakeTime,omitempty"`
+
+	// Steps The share of the fleet's devices that is updated after each step, in increasing order. The remaining devices are updated in a final step. Defaults to 1%, 5%, 25% and 100%.
+	Steps *[]Percentage `json:"steps,omitempty"`
+
+	// Strategy The strategy of choice for device selection in rollout policy.
+	Strategy RolloutStrategy `json:"strategy"`
+}
+
+// CertificateRevocationReason The reason a certificate was revoked, as defined in RFC 5280.
+type CertificateRevocationReason string
+
 // CertificateSigningRequest CertificateSigningRequest represents a request for a signed certificate from the CA.
 type CertificateSigningRequest struct {
 	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
@@ -898,6 +1073,9 @@ type ComposeApplication struct {
 	// AppType The type of the application.
 	AppType AppType `json:"appType"`
 
+	// DependsOn Applications of the device that must be ready before this application starts. The agent starts and updates the dependencies first and stops this application before them.
+	DependsOn *[]ApplicationDependency `json:"dependsOn,omitempty"`
+
 	// EnvVars Environment variable key-value pairs, injected during runtime. The key and value each must be between 1 and 253 characters.
 	EnvVars *map[string]string `json:"envVars,omitempty"`
 
@@ -964,6 +1142,9 @@ type ContainerApplication struct {
 	// AppType The type of the application.
 	AppType AppType `json:"appType"`
 
+	// DependsOn Applications of the device that must be ready before this application starts. The agent starts and updates the dependencies first and stops this application before them.
+	DependsOn *[]ApplicationDependency `json:"dependsOn,omitempty"`
+
 	// EnvVars Environment variable key-value pairs, injected during runtime. The key and value each must be between 1 and 253 characters.
 	EnvVars *map[string]string `json:"envVars,omitempty"`
 
@@ -1072,6 +1253,9 @@ type DeviceApplicationsSummaryStatus struct {
 
 // DeviceConfigStatus Current status of the device config.
 type DeviceConfigStatus struct {
+	// EncryptionKey PEM-encoded public key that the service encrypts sensitive config content to. Only reported by agents that can decrypt such content; content for other devices is rendered in cleartext.
+	EncryptionKey *string `json:"encryptionKey,omitempty"`
+
 	// RenderedVersion Rendered version of the device config.
 	RenderedVersion string `json:"renderedVersion"`
 }
@@ -1094,6 +1278,21 @@ type DeviceDecommission struct {
 // DeviceDecommissionTargetType Specifies the desired decommissioning method of the device.
 type DeviceDecommissionTargetType string
 
+// DeviceHealthChecks Health checks that must pass after an update has been applied for the update to be considered successful. If they do not pass in time, the device rolls back to the previous renderedVersion.
+type DeviceHealthChecks struct {
+	// FailureThreshold The number of consecutive failed evaluations after the grace period after which the update is considered failed and rolled back. Defaults to 3.
+	FailureThreshold *int32 `json:"failureThreshold,omitempty"`
+
+	// GracePeriod The time the device is given to become healthy after the update has been applied. Failing probes do not count against the failure threshold during the grace period. The duration should be specified as a positive integer followed by a time unit. Supported time units are 's' for seconds, 'm' for minutes, and 'h' for hours. Defaults to 5m.
+	GracePeriod *string `json:"gracePeriod,omitempty"`
+
+	// Interval The interval between two evaluations of the probes. The duration should be specified as a positive integer followed by a time unit. Supported time units are 's' for seconds, 'm' for minutes, and 'h' for hours. Defaults to 10s.
+	Interval *string `json:"interval,omitempty"`
+
+	// Probes The probes to evaluate. All probes must pass for the device to be considered healthy.
+	Probes []HealthProbe `json:"probes"`
+}
+
 // DeviceIntegrityCheckStatus DeviceIntegrityCheckStatus represents the status of the integrity check performed on the device.
 type DeviceIntegrityCheckStatus struct {
 	// Info Human-readable information about the integrity check status.
@@ -1108,6 +1307,9 @@ type DeviceIntegrityCheckStatusType string
 
 // DeviceIntegrityStatus Summary status of the integrity of the device.
 type DeviceIntegrityStatus struct {
+	// Attestation DeviceIntegrityCheckStatus represents the status of the integrity check performed on the device.
+	Attestation *DeviceIntegrityCheckStatus `json:"attestation,omitempty"`
+
 	// DeviceIdentity DeviceIntegrityCheckStatus represents the status of the integrity check performed on the device.
 	DeviceIdentity *DeviceIntegrityCheckStatus `json:"deviceIdentity,omitempty"`
 
@@ -1231,14 +1433,26 @@ type DeviceOwnershipChangedDetailsDetailType string
 
 // DeviceResourceStatus Current status of the resources of the device.
 type DeviceResourceStatus struct {
+	// Application The types of resource statuses.
+	Application *DeviceResourceStatusType `json:"application,omitempty"`
+
 	// Cpu The types of resource statuses.
 	Cpu DeviceResourceStatusType `json:"cpu"`
 
 	// Disk The types of resource statuses.
 	Disk DeviceResourceStatusType `json:"disk"`
 
+	// Inode The types of resource statuses.
+	Inode *DeviceResourceStatusType `json:"inode,omitempty"`
+
 	// Memory The types of resource statuses.
 	Memory DeviceResourceStatusType `json:"memory"`
+
+	// Network The types of resource statuses.
+	Network *DeviceResourceStatusType `json:"network,omitempty"`
+
+	// Temperature The types of resource statuses.
+	Temperature *DeviceResourceStatusType `json:"temperature,omitempty"`
 }
 
 // DeviceResourceStatusType The types of resource statuses.
@@ -1266,6 +1480,18 @@ type DeviceResumeResponse struct {
 	ResumedDevices int `json:"resumedDevices"`
 }
 
+// DeviceRevocation A request to revoke the management certificates issued to a device.
+type DeviceRevocation struct {
+	// Reason The reason a certificate was revoked, as defined in RFC 5280.
+	Reason CertificateRevocationReason `json:"reason"`
+}
+
+// DeviceRevocationResult The result of revoking the management certificates of a device.
+type DeviceRevocationResult struct {
+	// Certificates The unexpired certificates of the device that are now revoked.
+	Certificates []RevokedCertificate `json:"certificates"`
+}
+
 // DeviceSpec DeviceSpec describes a device.
 type DeviceSpec struct {
 	// Applications List of application providers.
@@ -1280,6 +1506,9 @@ type DeviceSpec struct {
 	// Decommissioning Metadata about a device decommissioning request.
 	Decommissioning *DeviceDecommission `json:"decommissioning,omitempty"`
 
+	// HealthChecks Health checks that must pass after an update has been applied for the update to be considered successful. If they do not pass in time, the device rolls back to the previous renderedVersion.
+	HealthChecks *DeviceHealthChecks `json:"healthChecks,omitempty"`
+
 	// Os DeviceOsSpec describes the target OS for the device.
 	Os *DeviceOsSpec `json:"os,omitempty"`
 
@@ -1292,8 +1521,14 @@ type DeviceSpec struct {
 		MatchPatterns *[]string `json:"matchPatterns,omitempty"`
 	} `json:"systemd,omitempty"`
 
+	// TrustPolicy The signature verification policy of the device. Device and fleet specs reference a TrustPolicy resource by name, and the service fills in the spec of the TrustPolicy when it renders the device.
+	TrustPolicy *DeviceTrustPolicy `json:"trustPolicy,omitempty"`
+
 	// UpdatePolicy Specifies the policy for managing device updates, including when updates should be downloaded and applied.
 	UpdatePolicy *DeviceUpdatePolicySpec `json:"updatePolicy,omitempty"`
+
+	// Variables Per-device variables that fleet template parameters can reference as {{ .spec.variables.NAME }}. Names must be valid identifiers. Unlike label values, variable values may be long or structured text and are not used for selecting devices. Variables are kept when the device's fleet rolls out a new template, and are not supported in fleet templates themselves.
+	Variables *map[string]string `json:"variables,omitempty"`
 }
 
 // DeviceStatus DeviceStatus represents information about the status of a device. Status may trail the actual state of a device.
@@ -1369,6 +1604,15 @@ type DeviceSystemInfo struct {
 	AdditionalProperties map[string]string `json:"-"`
 }
 
+// DeviceTrustPolicy The signature verification policy of the device. Device and fleet specs reference a TrustPolicy resource by name, and the service fills in the spec of the TrustPolicy when it renders the device.
+type DeviceTrustPolicy struct {
+	// Name The name of the TrustPolicy resource of the organization that applies to the device.
+	Name string `json:"name"`
+
+	// Spec TrustPolicySpec describes the signatures that images from each registry scope require.
+	Spec *TrustPolicySpec `json:"spec,omitempty"`
+}
+
 // DeviceUpdatePolicySpec Specifies the policy for managing device updates, including when updates should be downloaded and applied.
 type DeviceUpdatePolicySpec struct {
 	// DownloadSchedule Defines the schedule for automatic downloading and updates, including timing and optional timeout.
@@ -1447,7 +1691,7 @@ type DockerAuth struct {
 // Duration The maximum duration allowed for the action to complete. The duration should be specified as a positive integer followed by a time unit. Supported time units are: `s` for seconds, `m` for minutes, `h` for hours.
 type Duration = string
 
-// EncodingType Specifies the encoding type used for data representation.
+// EncodingType Specifies the encoding type used for data representation. The service sets jwe on sensitive content it encrypted to the device, which only the device can decode.
 type EncodingType string
 
 // EnrollmentConfig defines model for EnrollmentConfig.
@@ -1456,6 +1700,78 @@ type EnrollmentConfig struct {
 	EnrollmentService EnrollmentService `json:"enrollment-service"`
 }
 
+// EnrollmentPolicy EnrollmentPolicy approves enrollment requests automatically when they match a set of rules.
+type EnrollmentPolicy struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
+	ApiVersion ApiVersion `json:"apiVersion"`
+
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds.
+	Kind string `json:"kind"`
+
+	// Metadata ObjectMeta is metadata that all persisted resources must have, which includes all objects users must create.
+	Metadata ObjectMeta `json:"metadata"`
+
+	// Spec EnrollmentPolicySpec describes which enrollment requests a policy approves and how.
+	Spec EnrollmentPolicySpec `json:"spec"`
+}
+
+// EnrollmentPolicyAllowList EnrollmentPolicyAllowList lists the devices a policy approves by their product identity. A request matches if the device's product serial or product UUID is listed.
+type EnrollmentPolicyAllowList struct {
+	// ProductSerials The allowed product serial numbers. The serial number embedded in a TPM-signed certificate signing request takes precedence over the one reported in the system information.
+	ProductSerials *[]string `json:"productSerials,omitempty"`
+
+	// ProductUuids The allowed product UUIDs, as reported in the system information.
+	ProductUuids *[]string `json:"productUuids,omitempty"`
+}
+
+// EnrollmentPolicyApproval EnrollmentPolicyApproval describes what is attached to the devices a policy approves.
+type EnrollmentPolicyApproval struct {
+	// Fleet The name of the fleet that owns the device. The labels of the fleet's matchLabels selector are applied to the device so that the fleet keeps selecting it.
+	Fleet *string `json:"fleet,omitempty"`
+
+	// Labels A set of labels to apply to the device. They take precedence over the labels requested by the device.
+	Labels *map[string]string `json:"labels,omitempty"`
+}
+
+// EnrollmentPolicyList EnrollmentPolicyList is a list of EnrollmentPolicies.
+type EnrollmentPolicyList struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
+	ApiVersion ApiVersion `json:"apiVersion"`
+
+	// Items List of EnrollmentPolicies.
+	Items []EnrollmentPolicy `json:"items"`
+
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds.
+	Kind string `json:"kind"`
+
+	// Metadata ListMeta describes metadata that synthetic resources must have, including lists and various status objects. A resource may have only one of {ObjectMeta, ListMeta}.
+	Metadata ListMeta `json:"metadata"`
+}
+
+// EnrollmentPolicyMatch EnrollmentPolicyMatch lists the rules an enrollment request must satisfy to be approved. All rules that are set must be satisfied, and at least one must be set.
+type EnrollmentPolicyMatch struct {
+	// AllowList EnrollmentPolicyAllowList lists the devices a policy approves by their product identity. A request matches if the device's product serial or product UUID is listed.
+	AllowList *EnrollmentPolicyAllowList `json:"allowList,omitempty"`
+
+	// EnrollmentCertificates The names of the enrollment certificates the request may be submitted with. The request matches if it was submitted with any of them.
+	EnrollmentCertificates *[]string `json:"enrollmentCertificates,omitempty"`
+
+	// SystemInfo Values that fields of the system information reported in the enrollment request must equal, such as architecture, operatingSystem or productName.
+	SystemInfo *map[string]string `json:"systemInfo,omitempty"`
+
+	// TpmVerified Whether the device's TPM endorsement key chain must have been verified against the configured TPM manufacturer certificates and the device must have passed the credential activation challenge.
+	TpmVerified *bool `json:"tpmVerified,omitempty"`
+}
+
+// EnrollmentPolicySpec EnrollmentPolicySpec describes which enrollment requests a policy approves and how.
+type EnrollmentPolicySpec struct {
+	// Approval EnrollmentPolicyApproval describes what is attached to the devices a policy approves.
+	Approval *EnrollmentPolicyApproval `json:"approval,omitempty"`
+
+	// Match EnrollmentPolicyMatch lists the rules an enrollment request must satisfy to be approved. All rules that are set must be satisfied, and at least one must be set.
+	Match EnrollmentPolicyMatch `json:"match"`
+}
+
 // EnrollmentRequest EnrollmentRequest represents a request for approval to enroll a device.
 type EnrollmentRequest struct {
 	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
@@ -1640,7 +1956,7 @@ type FileContent struct {
 	// Content The plain text (UTF-8) or base64-encoded content of the file.
 	Content *string `json:"content,omitempty"`
 
-	// ContentEncoding Specifies the encoding type used for data representation.
+	// ContentEncoding Specifies the encoding type used for data representation. The service sets jwe on sensitive content it encrypted to the device, which only the device can decode.
 	ContentEncoding *EncodingType `json:"contentEncoding,omitempty"`
 }
 
@@ -1664,7 +1980,7 @@ type FileSpec struct {
 	// Content The plain text (UTF-8) or base64-encoded content of the file.
 	Content string `json:"content"`
 
-	// ContentEncoding Specifies the encoding type used for data representation.
+	// ContentEncoding Specifies the encoding type used for data representation. The service sets jwe on sensitive content it encrypted to the device, which only the device can decode.
 	ContentEncoding *EncodingType `json:"contentEncoding,omitempty"`
 
 	// Group The file's group, specified either as a name or numeric ID. Defaults to "root".
@@ -1676,6 +1992,9 @@ type FileSpec struct {
 	// Path The absolute path to a file on the system. Note that any existing file will be overwritten.
 	Path string `json:"path"`
 
+	// Sensitive Set by the service on rendered files written from Secret resources. Their content is redacted when the rendered configuration is read by anyone other than the device.
+	Sensitive *bool `json:"sensitive,omitempty"`
+
 	// User The file's owner, specified either as a name or numeric ID. Defaults to "root".
 	User Username `json:"user,omitempty"`
 }
@@ -1797,6 +2116,33 @@ type FleetRolloutFailedDetails struct {
 // FleetRolloutFailedDetailsDetailType The type of detail for discriminator purposes.
 type FleetRolloutFailedDetailsDetailType string
 
+// FleetRolloutRollback FleetRolloutRollback records the automatic rollback of a failed rollout.
+type FleetRolloutRollback struct {
+	// Message Why the rollout was rolled back.
+	Message *string `json:"message,omitempty"`
+
+	// RestoredTemplateVersion The name of the TemplateVersion the devices were rolled back to.
+	RestoredTemplateVersion string `json:"restoredTemplateVersion"`
+
+	// TemplateVersion The name of the TemplateVersion whose rollout failed and was rolled back.
+	TemplateVersion string `json:"templateVersion"`
+}
+
+// FleetRolloutRolledBackDetails defines model for FleetRolloutRolledBackDetails.
+type FleetRolloutRolledBackDetails struct {
+	// DetailType The type of detail for discriminator purposes.
+	DetailType FleetRolloutRolledBackDetailsDetailType `json:"detailType"`
+
+	// RestoredTemplateVersion The name of the TemplateVersion the devices were rolled back to.
+	RestoredTemplateVersion string `json:"restoredTemplateVersion"`
+
+	// TemplateVersion The name of the TemplateVersion whose rollout was rolled back.
+	TemplateVersion string `json:"templateVersion"`
+}
+
+// FleetRolloutRolledBackDetailsDetailType The type of detail for discriminator purposes.
+type FleetRolloutRolledBackDetailsDetailType string
+
 // FleetRolloutStartedDetails defines model for FleetRolloutStartedDetails.
 type FleetRolloutStartedDetails struct {
 	// DetailType The type of detail for discriminator purposes.
@@ -1819,6 +2165,9 @@ type FleetRolloutStartedDetailsRolloutStrategy string
 type FleetRolloutStatus struct {
 	// CurrentBatch The batch number currently being rolled out.
 	CurrentBatch *int `json:"currentBatch,omitempty"`
+
+	// Rollback FleetRolloutRollback records the automatic rollback of a failed rollout.
+	Rollback *FleetRolloutRollback `json:"rollback,omitempty"`
 }
 
 // FleetSpec FleetSpec is a description of a fleet's target state.
@@ -1894,16 +2243,65 @@ type GitRepoSpec struct {
 
 	// Url The Git repository URL to clone from.
 	Url string `json:"url"`
+
+	// Webhook Configuration for receiving push notifications from the Git server, so that changes are processed immediately instead of at the next poll.
+	Webhook *RepositoryWebhookConfig `json:"webhook,omitempty"`
 }
 
 // GitRepoSpecType The repository type discriminator.
 type GitRepoSpecType string
 
+// HealthProbe defines model for HealthProbe.
+type HealthProbe struct {
+	// Name The name of the probe, used to report which probe failed.
+	Name string `json:"name"`
+
+	// Timeout The maximum duration allowed for a single evaluation of the probe. The duration should be specified as a positive integer followed by a time unit. Supported time units are 's' for seconds, 'm' for minutes, and 'h' for hours. Defaults to 5s.
+	Timeout *string `json:"timeout,omitempty"`
+	union   json.RawMessage
+}
+
+// HealthProbeApplication defines model for HealthProbeApplication.
+type HealthProbeApplication struct {
+	// Application The name of the application. The probe passes if all workloads of the application are running and ready.
+	Application string `json:"application"`
+}
+
+// HealthProbeExec defines model for HealthProbeExec.
+type HealthProbeExec struct {
+	// Exec The command to run, including any arguments using standard shell syntax. The probe passes if the command exits with code 0.
+	Exec string `json:"exec"`
+}
+
+// HealthProbeHttp defines model for HealthProbeHttp.
+type HealthProbeHttp struct {
+	// Http The URL to send a GET request to. The probe passes if the response has a 2xx status code.
+	Http string `json:"http"`
+
+	// SkipServerVerification Skip verification of the server's TLS certificate.
+	SkipServerVerification *bool `json:"skipServerVerification,omitempty"`
+}
+
+// HealthProbeSystemdUnit defines model for HealthProbeSystemdUnit.
+type HealthProbeSystemdUnit struct {
+	// SystemdUnit The name of the systemd unit. The probe passes if the unit is active.
+	SystemdUnit string `json:"systemdUnit"`
+}
+
+// HealthProbeTcp defines model for HealthProbeTcp.
+type HealthProbeTcp struct {
+	// Tcp The address in host:port format to connect to. The probe passes if the connection succeeds.
+	Tcp string `json:"tcp"`
+}
+
 // HelmApplication defines model for HelmApplication.
 type HelmApplication struct {
 	// AppType The type of the application.
 	AppType AppType `json:"appType"`
 
+	// DependsOn Applications of the device that must be ready before this application starts. The agent starts and updates the dependencies first and stops this application before them.
+	DependsOn *[]ApplicationDependency `json:"dependsOn,omitempty"`
+
 	// Image Reference to the chart for this helm application.
 	Image string `json:"image"`
 
@@ -1930,6 +2328,12 @@ type HookAction struct {
 	union   json.RawMessage
 }
 
+// HookActionRestartApplication defines model for HookActionRestartApplication.
+type HookActionRestartApplication struct {
+	// RestartApplication The name of the application to restart. Only applications run by Podman can be restarted.
+	RestartApplication string `json:"restartApplication"`
+}
+
 // HookActionRun defines model for HookActionRun.
 type HookActionRun struct {
 	// EnvVars Environment variable key-value pairs, injected during runtime.
@@ -1942,12 +2346,45 @@ type HookActionRun struct {
 	WorkDir *string `json:"workDir,omitempty"`
 }
 
+// HookActionSystemd defines model for HookActionSystemd.
+type HookActionSystemd struct {
+	// Operation The operation to perform on the systemd unit.
+	Operation HookActionSystemdOperation `json:"operation"`
+
+	// Systemd The name of the systemd unit to operate on.
+	Systemd string `json:"systemd"`
+}
+
+// HookActionSystemdOperation The operation to perform on the systemd unit.
+type HookActionSystemdOperation string
+
+// HookActionWaitForHttp defines model for HookActionWaitForHttp.
+type HookActionWaitForHttp struct {
+	// Interval The interval between attempts. The duration should be specified as a positive integer followed by a time unit. Supported time units are 's' for seconds, 'm' for minutes, and 'h' for hours. Defaults to 1s.
+	Interval *string `json:"interval,omitempty"`
+
+	// SkipServerVerification Skip verification of the server's TLS certificate.
+	SkipServerVerification *bool `json:"skipServerVerification,omitempty"`
+
+	// WaitForHttp The http or https URL to poll until it returns a 2xx status code or the action times out.
+	WaitForHttp string `json:"waitForHttp"`
+}
+
+// HookActionWaitForTcp defines model for HookActionWaitForTcp.
+type HookActionWaitForTcp struct {
+	// Interval The interval between attempts. The duration should be specified as a positive integer followed by a time unit. Supported time units are 's' for seconds, 'm' for minutes, and 'h' for hours. Defaults to 1s.
+	Interval *string `json:"interval,omitempty"`
+
+	// WaitForTcp The address in host:port format to try connecting to until the connection succeeds or the action times out.
+	WaitForTcp string `json:"waitForTcp"`
+}
+
 // HookCondition defines model for HookCondition.
 type HookCondition struct {
 	union json.RawMessage
 }
 
-// HookConditionExpression An expression that must evaluate to true as condition for the action to be performed.
+// HookConditionExpression An expression that must evaluate to true as condition for the action to be performed. Supports boolean operators, comparisons, the in operator and string functions over variables describing the update and the device, such as rebooted, osImageChanged, applicationsChanged, labels, systemInfo and filesChanged.
 type HookConditionExpression = string
 
 // HookConditionPathOp defines model for HookConditionPathOp.
@@ -2067,6 +2504,21 @@ type InlineConfigProviderSpec struct {
 	Name string `json:"name"`
 }
 
+// InodeResourceMonitorSpec defines model for InodeResourceMonitorSpec.
+type InodeResourceMonitorSpec struct {
+	// AlertRules Array of alert rules. Only one alert per severity is allowed.
+	AlertRules []ResourceAlertRule `json:"alertRules"`
+
+	// MonitorType The type of resource to monitor.
+	MonitorType string `json:"monitorType"`
+
+	// Path The directory path whose filesystem is monitored for inode usage.
+	Path string `json:"path"`
+
+	// SamplingInterval Duration between monitor samples. Format: positive integer followed by 's' for seconds, 'm' for minutes, 'h' for hours.
+	SamplingInterval string `json:"samplingInterval"`
+}
+
 // InternalTaskFailedDetails defines model for InternalTaskFailedDetails.
 type InternalTaskFailedDetails struct {
 	// DetailType The type of detail for discriminator purposes.
@@ -2187,6 +2639,21 @@ type LabelSelector struct {
 	MatchLabels *map[string]string `json:"matchLabels,omitempty"`
 }
 
+// LabelWaves LabelWaves rolls out to the fleet's devices in waves, one for each value of a label, in the specified order. Devices that do not have one of the label values are updated in a final wave.
+type LabelWaves struct {
+	// BakeTime The time to wait after a wave completed before promoting to the next wave. The duration should be specified as a positive integer followed by a time unit. Supported time units are 's' for seconds, 'm' for minutes, and 'h' for hours. By default, the next wave starts as soon as the previous one completed.
+	BakeTime *string `json:"bakeTime,omitempty"`
+
+	// LabelKey The key of the label whose values define the waves.
+	LabelKey string `json:"labelKey"`
+
+	// Strategy The strategy of choice for device selection in rollout policy.
+	Strategy RolloutStrategy `json:"strategy"`
+
+	// Values The label values to roll out to, in order.
+	Values []string `json:"values"`
+}
+
 // ListMeta ListMeta describes metadata that synthetic resources must have, including lists and various status objects. A resource may have only one of {ObjectMeta, ListMeta}.
 type ListMeta struct {
 	// Continue May be set if the user set a limit on the number of items returned, and indicates that the server has more data available. The value is opaque and may be used to issue another request to the endpoint that served this list to retrieve the next set of available objects. Continuing a consistent list may not be possible if the server configuration has changed or more than a few minutes have passed. The resourceVersion field returned when using this continue value will be identical to the value in the first response, unless you have received this token from an error message.
@@ -2232,6 +2699,27 @@ type MountVolumeProviderSpec struct {
 	Mount VolumeMount `json:"mount"`
 }
 
+// NetworkResourceMonitorMetric The network metric that alert rules are evaluated against. "errors" is the share of packets that were dropped or had errors, "throughput" is the throughput relative to the link speed.
+type NetworkResourceMonitorMetric string
+
+// NetworkResourceMonitorSpec defines model for NetworkResourceMonitorSpec.
+type NetworkResourceMonitorSpec struct {
+	// AlertRules Array of alert rules. Only one alert per severity is allowed.
+	AlertRules []ResourceAlertRule `json:"alertRules"`
+
+	// Interface The name of the network interface to monitor. If not specified, all interfaces except loopback are monitored and the busiest one is reported.
+	Interface *string `json:"interface,omitempty"`
+
+	// Metric The network metric that alert rules are evaluated against. "errors" is the share of packets that were dropped or had errors, "throughput" is the throughput relative to the link speed.
+	Metric NetworkResourceMonitorMetric `json:"metric"`
+
+	// MonitorType The type of resource to monitor.
+	MonitorType string `json:"monitorType"`
+
+	// SamplingInterval Duration between monitor samples. Format: positive integer followed by 's' for seconds, 'm' for minutes, 'h' for hours.
+	SamplingInterval string `json:"samplingInterval"`
+}
+
 // OAuth2Introspection OAuth2Introspection defines the token introspection configuration.
 type OAuth2Introspection struct {
 	union json.RawMessage
@@ -2494,6 +2982,24 @@ type PatchRequest = []struct {
 // PatchRequestOp The operation to perform.
 type PatchRequestOp string
 
+// PcrReference PcrReference lists the allowed values of a single PCR.
+type PcrReference struct {
+	// Digests The allowed values of the PCR as hex-encoded SHA-256 digests. The PCR matches if its value equals any of them.
+	Digests []string `json:"digests"`
+
+	// Index The index of the PCR.
+	Index int `json:"index"`
+}
+
+// PcrValue PcrValue is the value of a single PCR in the SHA-256 bank.
+type PcrValue struct {
+	// Digest The value of the PCR as a hex-encoded SHA-256 digest.
+	Digest string `json:"digest"`
+
+	// Index The index of the PCR.
+	Index int `json:"index"`
+}
+
 // Percentage Percentage is the string format representing percentage string.
 type Percentage = string
 
@@ -2517,6 +3023,9 @@ type QuadletApplication struct {
 	// AppType The type of the application.
 	AppType AppType `json:"appType"`
 
+	// DependsOn Applications of the device that must be ready before this application starts. The agent starts and updates the dependencies first and stops this application before them.
+	DependsOn *[]ApplicationDependency `json:"dependsOn,omitempty"`
+
 	// EnvVars Environment variable key-value pairs, injected during runtime. The key and value each must be between 1 and 253 characters.
 	EnvVars *map[string]string `json:"envVars,omitempty"`
 
@@ -2531,6 +3040,48 @@ type QuadletApplication struct {
 	union   json.RawMessage
 }
 
+// ReferenceMeasurement ReferenceMeasurement holds the expected TPM PCR values of devices running a given OS image. The service verifies the PCR quotes that devices send during remote attestation against it.
+type ReferenceMeasurement struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
+	ApiVersion ApiVersion `json:"apiVersion"`
+
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds.
+	Kind string `json:"kind"`
+
+	// Metadata ObjectMeta is metadata that all persisted resources must have, which includes all objects users must create.
+	Metadata ObjectMeta `json:"metadata"`
+
+	// Spec ReferenceMeasurementSpec describes the PCR values expected on devices running an OS image.
+	Spec ReferenceMeasurementSpec `json:"spec"`
+}
+
+// ReferenceMeasurementList ReferenceMeasurementList is a list of ReferenceMeasurements.
+type ReferenceMeasurementList struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
+	ApiVersion ApiVersion `json:"apiVersion"`
+
+	// Items List of ReferenceMeasurements.
+	Items []ReferenceMeasurement `json:"items"`
+
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds.
+	Kind string `json:"kind"`
+
+	// Metadata ListMeta describes metadata that synthetic resources must have, including lists and various status objects. A resource may have only one of {ObjectMeta, ListMeta}.
+	Metadata ListMeta `json:"metadata"`
+}
+
+// ReferenceMeasurementSpec ReferenceMeasurementSpec describes the PCR values expected on devices running an OS image.
+type ReferenceMeasurementSpec struct {
+	// OsImage The OS image the measurements apply to. It is matched against the OS image in the spec of a device.
+	OsImage string `json:"osImage"`
+
+	// Pcrs The expected values of the PCRs in the SHA-256 bank. PCRs that are not listed are not verified.
+	Pcrs []PcrReference `json:"pcrs"`
+
+	// QuarantineOnFailure Whether a device that fails attestation against this reference is quarantined. A quarantined device receives no new specification until it passes attestation again. Defaults to false.
+	QuarantineOnFailure *bool `json:"quarantineOnFailure,omitempty"`
+}
+
 // ReferencedRepositoryUpdatedDetails defines model for ReferencedRepositoryUpdatedDetails.
 type ReferencedRepositoryUpdatedDetails struct {
 	// DetailType The type of detail for discriminator purposes.
@@ -2585,6 +3136,18 @@ type RepositoryList struct {
 	Metadata ListMeta `json:"metadata"`
 }
 
+// RepositoryPushReceivedDetails defines model for RepositoryPushReceivedDetails.
+type RepositoryPushReceivedDetails struct {
+	// DetailType The type of detail for discriminator purposes.
+	DetailType RepositoryPushReceivedDetailsDetailType `json:"detailType"`
+
+	// Revisions The revisions that were pushed, as the names of the updated branches or tags, their full references and the new commit hashes.
+	Revisions []string `json:"revisions"`
+}
+
+// RepositoryPushReceivedDetailsDetailType The type of detail for discriminator purposes.
+type RepositoryPushReceivedDetailsDetailType string
+
 // RepositorySpec RepositorySpec describes a configuration repository.
 type RepositorySpec struct {
 	union json.RawMessage
@@ -2596,6 +3159,12 @@ type RepositoryStatus struct {
 	Conditions []Condition `json:"conditions"`
 }
 
+// RepositoryWebhookConfig Configuration for receiving push notifications from the Git server, so that changes are processed immediately instead of at the next poll.
+type RepositoryWebhookConfig struct {
+	// Secret The shared secret that the Git server signs (HMAC-SHA256) or authenticates its webhook requests with.
+	Secret string `json:"secret"`
+}
+
 // ResourceAlertRule defines model for ResourceAlertRule.
 type ResourceAlertRule struct {
 	// Description A human-readable description of the alert.
@@ -2649,15 +3218,33 @@ type ResourceSync struct {
 	Status *ResourceSyncStatus `json:"status,omitempty"`
 }
 
-// ResourceSyncCompletedDetails defines model for ResourceSyncCompletedDetails.
-type ResourceSyncCompletedDetails struct {
-	// ChangeCount Number of changes introduced by this ResourceSync update.
-	ChangeCount int `json:"changeCount"`
+// ResourceSyncChange ResourceSyncChange describes how syncing would change a single resource.
+type ResourceSyncChange struct {
+	// Action The action that syncing would take on a resource.
+	Action ResourceSyncChangeAction `json:"action"`
 
-	// CommitHash Hash of the last commit.
-	CommitHash string `json:"commitHash"`
+	// Diff The fields of the live resource that would change. Only set for updates.
+	Diff *[]ResourceSyncFieldDiff `json:"diff,omitempty"`
 
-	// DetailType The type of detail for discriminator purposes.
+	// Kind The kind of the resource.
+	Kind string `json:"kind"`
+
+	// Name The name of the resource. Catalog items are named after their catalog as catalog/name.
+	Name string `json:"name"`
+}
+
+// ResourceSyncChangeAction The action that syncing would take on a resource.
+type ResourceSyncChangeAction string
+
+// ResourceSyncCompletedDetails defines model for ResourceSyncCompletedDetails.
+type ResourceSyncCompletedDetails struct {
+	// ChangeCount Number of changes introduced by this ResourceSync update.
+	ChangeCount int `json:"changeCount"`
+
+	// CommitHash Hash of the last commit.
+	CommitHash string `json:"commitHash"`
+
+	// DetailType The type of detail for discriminator purposes.
 	DetailType ResourceSyncCompletedDetailsDetailType `json:"detailType"`
 
 	// ErrorCount Number of errors encountered by this ResourceSync update.
@@ -2667,6 +3254,18 @@ type ResourceSyncCompletedDetails struct {
 // ResourceSyncCompletedDetailsDetailType The type of detail for discriminator purposes.
 type ResourceSyncCompletedDetailsDetailType string
 
+// ResourceSyncFieldDiff ResourceSyncFieldDiff describes the change of a single field of a resource.
+type ResourceSyncFieldDiff struct {
+	// New The JSON-encoded value of the field after syncing. Unset if the field would be removed.
+	New *string `json:"new,omitempty"`
+
+	// Old The JSON-encoded live value of the field. Unset if the field would be added.
+	Old *string `json:"old,omitempty"`
+
+	// Path The path of the field.
+	Path string `json:"path"`
+}
+
 // ResourceSyncList defines model for ResourceSyncList.
 type ResourceSyncList struct {
 	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
@@ -2682,8 +3281,50 @@ type ResourceSyncList struct {
 	Metadata ListMeta `json:"metadata"`
 }
 
+// ResourceSyncPreview ResourceSyncPreview describes the changes that syncing a ResourceSync would make.
+type ResourceSyncPreview struct {
+	// Changes The resources that would be created, updated or deleted. Resources that would not change are omitted.
+	Changes []ResourceSyncChange `json:"changes"`
+
+	// Commit The commit hash that the revision resolved to.
+	Commit *string `json:"commit,omitempty"`
+
+	// Errors Errors that would prevent the sync from succeeding, such as validation errors or conflicts with resources managed by other ResourceSyncs.
+	Errors []string `json:"errors"`
+
+	// Revision The revision of the repository that was previewed.
+	Revision string `json:"revision"`
+}
+
+// ResourceSyncPreviewRequest ResourceSyncPreviewRequest identifies a requested preview of a ResourceSync.
+type ResourceSyncPreviewRequest struct {
+	// RequestId The ID of the preview request, used to read the preview once it has been computed.
+	RequestId string `json:"requestId"`
+
+	// Revision The revision of the repository that is previewed.
+	Revision string `json:"revision"`
+}
+
+// ResourceSyncPreviewRequestedDetails defines model for ResourceSyncPreviewRequestedDetails.
+type ResourceSyncPreviewRequestedDetails struct {
+	// DetailType The type of detail for discriminator purposes.
+	DetailType ResourceSyncPreviewRequestedDetailsDetailType `json:"detailType"`
+
+	// RequestId The ID of the preview request.
+	RequestId string `json:"requestId"`
+
+	// Revision The revision of the repository to preview.
+	Revision string `json:"revision"`
+}
+
+// ResourceSyncPreviewRequestedDetailsDetailType The type of detail for discriminator purposes.
+type ResourceSyncPreviewRequestedDetailsDetailType string
+
 // ResourceSyncSpec ResourceSyncSpec describes the file(s) to sync from a repository.
 type ResourceSyncSpec struct {
+	// DryRun If true, changes in the repository are not applied. Instead, the changes that syncing would make are reported in the status.
+	DryRun *bool `json:"dryRun,omitempty"`
+
 	// Path The path of a file or directory in the repository. If a directory, the directory should contain only resource definitions with no subdirectories. Each file should contain the definition of one or more resources.
 	Path string `json:"path"`
 
@@ -2707,6 +3348,9 @@ type ResourceSyncStatus struct {
 
 	// ObservedGeneration The last generation that was synced.
 	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
+
+	// Preview ResourceSyncPreview describes the changes that syncing a ResourceSync would make.
+	Preview *ResourceSyncPreview `json:"preview,omitempty"`
 }
 
 // ResourceSyncType The type of resources this ResourceSync manages. Defaults to fleet if not specified.
@@ -2733,6 +3377,21 @@ type ResourceUpdatedDetailsDetailType string
 // ResourceUpdatedDetailsUpdatedFields defines model for ResourceUpdatedDetails.UpdatedFields.
 type ResourceUpdatedDetailsUpdatedFields string
 
+// RevokedCertificate An entry of the certificate revocation list.
+type RevokedCertificate struct {
+	// NotAfter The expiration time of the certificate. The entry is dropped from the revocation list after it.
+	NotAfter time.Time `json:"notAfter"`
+
+	// Reason The reason a certificate was revoked, as defined in RFC 5280.
+	Reason CertificateRevocationReason `json:"reason"`
+
+	// RevokedAt The time the certificate was revoked.
+	RevokedAt time.Time `json:"revokedAt"`
+
+	// SerialNumber The serial number of the certificate as a hexadecimal string.
+	SerialNumber string `json:"serialNumber"`
+}
+
 // Rfc7662IntrospectionSpec Rfc7662IntrospectionSpec defines token introspection using RFC 7662 standard. Uses the OAuth2ProviderSpec clientId and clientSecret for authentication.
 type Rfc7662IntrospectionSpec struct {
 	// Type The introspection type.
@@ -2745,13 +3404,31 @@ type Rfc7662IntrospectionSpec struct {
 // Rfc7662IntrospectionSpecType The introspection type.
 type Rfc7662IntrospectionSpecType string
 
+// RolloutBlackout RolloutBlackout is a period in which no batches of a rollout are dispatched, for example a holiday freeze.
+type RolloutBlackout struct {
+	// End The time the blackout ends.
+	End time.Time `json:"end"`
+
+	// Name A name describing the blackout.
+	Name *string `json:"name,omitempty"`
+
+	// Start The time the blackout starts.
+	Start time.Time `json:"start"`
+}
+
 // RolloutDeviceSelection Describes how to select devices for rollout.
 type RolloutDeviceSelection struct {
 	union json.RawMessage
 }
 
+// RolloutFailurePolicy What to do when a batch of a rollout falls below the success threshold. Suspend stops the rollout until the fleet is updated. Rollback reverts the devices updated in the rollout to the previous template version.
+type RolloutFailurePolicy string
+
 // RolloutPolicy RolloutPolicy is the rollout policy of the fleet.
 type RolloutPolicy struct {
+	// Blackouts Periods in which no batches of a rollout are dispatched, even inside a rollout window.
+	Blackouts *[]RolloutBlackout `json:"blackouts,omitempty"`
+
 	// DefaultUpdateTimeout The maximum duration allowed for the action to complete. The duration should be specified as a positive integer followed by a time unit. Supported time units are: `s` for seconds, `m` for minutes, `h` for hours.
 	DefaultUpdateTimeout *Duration `json:"defaultUpdateTimeout,omitempty"`
 
@@ -2761,13 +3438,91 @@ type RolloutPolicy struct {
 	// DisruptionBudget DisruptionBudget defines the level of allowed disruption when rollout is in progress.
 	DisruptionBudget *DisruptionBudget `json:"disruptionBudget,omitempty"`
 
+	// OnFailure What to do when a batch of a rollout falls below the success threshold. Suspend stops the rollout until the fleet is updated. Rollback reverts the devices updated in the rollout to the previous template version.
+	OnFailure *RolloutFailurePolicy `json:"onFailure,omitempty"`
+
 	// SuccessThreshold Percentage is the string format representing percentage string.
 	SuccessThreshold *Percentage `json:"successThreshold,omitempty"`
+
+	// Windows The windows in which batches of a rollout may be dispatched. If not set, batches may be dispatched at any time. Devices that were already dispatched update according to their own update policy.
+	Windows *[]RolloutWindow `json:"windows,omitempty"`
 }
 
 // RolloutStrategy The strategy of choice for device selection in rollout policy.
 type RolloutStrategy string
 
+// RolloutWindow RolloutWindow is a recurring period in which batches of a rollout may be dispatched. The cron expression is evaluated in the window's timeZone, which defaults to UTC.
+type RolloutWindow struct {
+	// At Cron expression format for scheduling times.
+	// The format is `* * * * *`: - Minutes: `*` matches 0-59. - Hours: `*` matches 0-23. - Day of Month: `*` matches 1-31. - Month: `*` matches 1-12. - Day of Week: `*` matches 0-6.
+	// Supported operators: - `*`: Matches any value (e.g., `*` in hours matches every hour). - `-`: Range (e.g., `0-8` for 12 AM to 8 AM). - `,`: List (e.g., `1,12` for 1st and 12th minute). - `/`: Step (e.g., `*/12` for every 12th minute). - Single value (e.g., `8` matches the 8th minute).
+	// Example: `* 0-8,16-23 * * *`.
+	At CronExpression `json:"at"`
+
+	// Duration How long the window stays open after each start time. The duration should be specified as a positive integer followed by a time unit. Supported time units are 's' for seconds, 'm' for minutes, and 'h' for hours.
+	Duration string `json:"duration"`
+
+	// TimeZone Time zone identifiers follow the IANA format AREA/LOCATION, where AREA represents a continent or ocean, and LOCATION specifies a particular site within that area, for example America/New_York, Europe/Paris. Only unambiguous 3-character time zones are supported ("GMT", "UTC").
+	TimeZone *TimeZone `json:"timeZone,omitempty"`
+}
+
+// Secret Secret holds sensitive data that is delivered to devices by config providers that reference it. Its data is encrypted at rest and redacted in API responses.
+type Secret struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
+	ApiVersion ApiVersion `json:"apiVersion"`
+
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds.
+	Kind string `json:"kind"`
+
+	// Metadata ObjectMeta is metadata that all persisted resources must have, which includes all objects users must create.
+	Metadata ObjectMeta `json:"metadata"`
+
+	// Spec SecretSpec describes the data of a secret.
+	Spec SecretSpec `json:"spec"`
+}
+
+// SecretList SecretList is a list of Secrets.
+type SecretList struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
+	ApiVersion ApiVersion `json:"apiVersion"`
+
+	// Items List of Secrets.
+	Items []Secret `json:"items"`
+
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds.
+	Kind string `json:"kind"`
+
+	// Metadata ListMeta describes metadata that synthetic resources must have, including lists and various status objects. A resource may have only one of {ObjectMeta, ListMeta}.
+	Metadata ListMeta `json:"metadata"`
+}
+
+// SecretProviderSpec defines model for SecretProviderSpec.
+type SecretProviderSpec struct {
+	// Name The name of the config provider.
+	Name string `json:"name"`
+
+	// SecretResourceRef The reference to a Secret resource of the device's organization.
+	SecretResourceRef struct {
+		// Group The file's group, specified either as a name or numeric ID. Defaults to "root".
+		Group string `json:"group,omitempty"`
+
+		// MountPath Path in the device's file system at which the secret should be mounted.
+		MountPath string `json:"mountPath"`
+
+		// Name The name of the Secret resource.
+		Name string `json:"name"`
+
+		// User The file's owner, specified either as a name or numeric ID. Defaults to "root".
+		User Username `json:"user,omitempty"`
+	} `json:"secretResourceRef"`
+}
+
+// SecretSpec SecretSpec describes the data of a secret.
+type SecretSpec struct {
+	// Data The data of the secret, keyed by file name. The values are redacted in API responses, so they must always be provided in full.
+	Data map[string]SecureString `json:"data"`
+}
+
 // SshConfig Configuration for SSH transport.
 type SshConfig struct {
 	// PrivateKeyPassphrase The passphrase for sshPrivateKey.
@@ -2831,6 +3586,24 @@ type SystemdUnitStatus struct {
 	Unit string `json:"unit"`
 }
 
+// TemperatureResourceMonitorSpec defines model for TemperatureResourceMonitorSpec.
+type TemperatureResourceMonitorSpec struct {
+	// AlertRules Array of alert rules. Only one alert per severity is allowed.
+	AlertRules []ResourceAlertRule `json:"alertRules"`
+
+	// CriticalTemperature The critical temperature in degrees Celsius. It is used for thermal zones that do not report a critical trip point and overrides the trip point of those that do.
+	CriticalTemperature *int `json:"criticalTemperature,omitempty"`
+
+	// MonitorType The type of resource to monitor.
+	MonitorType string `json:"monitorType"`
+
+	// SamplingInterval Duration between monitor samples. Format: positive integer followed by 's' for seconds, 'm' for minutes, 'h' for hours.
+	SamplingInterval string `json:"samplingInterval"`
+
+	// Zone The type of the thermal zone to monitor, for example x86_pkg_temp. If not specified, the hottest zone of the device is monitored.
+	Zone *string `json:"zone,omitempty"`
+}
+
 // TemplateVersion TemplateVersion represents a version of a template.
 type TemplateVersion struct {
 	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
@@ -2887,6 +3660,9 @@ type TemplateVersionStatus struct {
 	// Decommissioning Metadata about a device decommissioning request.
 	Decommissioning *DeviceDecommission `json:"decommissioning,omitempty"`
 
+	// HealthChecks Health checks that must pass after an update has been applied for the update to be considered successful. If they do not pass in time, the device rolls back to the previous renderedVersion.
+	HealthChecks *DeviceHealthChecks `json:"healthChecks,omitempty"`
+
 	// Os DeviceOsSpec describes the target OS for the device.
 	Os *DeviceOsSpec `json:"os,omitempty"`
 
@@ -2899,11 +3675,17 @@ type TemplateVersionStatus struct {
 		MatchPatterns *[]string `json:"matchPatterns,omitempty"`
 	} `json:"systemd,omitempty"`
 
+	// TrustPolicy The signature verification policy of the device. Device and fleet specs reference a TrustPolicy resource by name, and the service fills in the spec of the TrustPolicy when it renders the device.
+	TrustPolicy *DeviceTrustPolicy `json:"trustPolicy,omitempty"`
+
 	// UpdatePolicy Specifies the policy for managing device updates, including when updates should be downloaded and applied.
 	UpdatePolicy *DeviceUpdatePolicySpec `json:"updatePolicy,omitempty"`
 
 	// UpdatedAt The time at which the template was last updated.
 	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+
+	// Variables Per-device variables that fleet template parameters can reference as {{ .spec.variables.NAME }}. Names must be valid identifiers. Unlike label values, variable values may be long or structured text and are not used for selecting devices. Variables are kept when the device's fleet rolls out a new template, and are not supported in fleet templates themselves.
+	Variables *map[string]string `json:"variables,omitempty"`
 }
 
 // TimeZone Time zone identifiers follow the IANA format AREA/LOCATION, where AREA represents a continent or ocean, and LOCATION specifies a particular site within that area, for example America/New_York, Europe/Paris. Only unambiguous 3-character time zones are supported ("GMT", "UTC").
@@ -2963,6 +3745,69 @@ type TokenResponse struct {
 // TokenResponseTokenType Token type.
 type TokenResponseTokenType string
 
+// TrustPolicy TrustPolicy defines the signatures that devices require of container images, OCI artifacts and OS images before they use them. Fleets and devices reference a TrustPolicy by name in their spec. The TrustPolicy named "default" applies to the devices of the organization that reference none.
+type TrustPolicy struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
+	ApiVersion ApiVersion `json:"apiVersion"`
+
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds.
+	Kind string `json:"kind"`
+
+	// Metadata ObjectMeta is metadata that all persisted resources must have, which includes all objects users must create.
+	Metadata ObjectMeta `json:"metadata"`
+
+	// Spec TrustPolicySpec describes the signatures that images from each registry scope require.
+	Spec TrustPolicySpec `json:"spec"`
+}
+
+// TrustPolicyList TrustPolicyList is a list of TrustPolicies.
+type TrustPolicyList struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
+	ApiVersion ApiVersion `json:"apiVersion"`
+
+	// Items List of TrustPolicies.
+	Items []TrustPolicy `json:"items"`
+
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds.
+	Kind string `json:"kind"`
+
+	// Metadata ListMeta describes metadata that synthetic resources must have, including lists and various status objects. A resource may have only one of {ObjectMeta, ListMeta}.
+	Metadata ListMeta `json:"metadata"`
+}
+
+// TrustPolicyScope TrustPolicyScope is the signature requirement for the images of a registry scope.
+type TrustPolicyScope struct {
+	// Requirement TrustRequirement is a signature requirement for images.
+	Requirement TrustRequirement `json:"requirement"`
+
+	// Scope A registry (registry.example.com), a namespace or repository in a registry (registry.example.com/org/app), or a wildcard for the subdomains of a domain (*.example.com).
+	Scope string `json:"scope"`
+}
+
+// TrustPolicySpec TrustPolicySpec describes the signatures that images from each registry scope require.
+type TrustPolicySpec struct {
+	// Default TrustRequirement is a signature requirement for images.
+	Default TrustRequirement `json:"default"`
+
+	// Scopes The requirements for images from specific registries, namespaces or repositories. The most specific scope that matches an image applies, and images that match no scope must meet the default requirement.
+	Scopes *[]TrustPolicyScope `json:"scopes,omitempty"`
+}
+
+// TrustRequirement TrustRequirement is a signature requirement for images.
+type TrustRequirement struct {
+	// Keys The public keys that are trusted to sign the images. PEM-encoded sigstore (cosign) public keys for SigstoreSigned, and ASCII-armored GPG public keys for SignedBy. A valid signature by any of the keys is accepted.
+	Keys []string `json:"keys,omitempty"`
+
+	// Lookaside The URL of the lookaside store that holds the simple signatures of the images, for SignedBy. Leave it empty if the registry stores the signatures itself.
+	Lookaside string `json:"lookaside,omitempty"`
+
+	// Type The type of a signature requirement. Accept accepts images without verifying signatures, Reject rejects all images, SigstoreSigned requires a sigstore signature stored in the registry, and SignedBy requires a simple signing (GPG) signature.
+	Type TrustRequirementType `json:"type"`
+}
+
+// TrustRequirementType The type of a signature requirement. Accept accepts images without verifying signatures, Reject rejects all images, SigstoreSigned requires a sigstore signature stored in the registry, and SignedBy requires a simple signing (GPG) signature.
+type TrustRequirementType string
+
 // UpdateSchedule Defines the schedule for automatic downloading and updates, including timing and optional timeout.
 type UpdateSchedule struct {
 	// At Cron expression format for scheduling times.
@@ -3008,6 +3853,21 @@ type VolumeMount struct {
 	Path string `json:"path"`
 }
 
+// WatchEvent A notification about a change to a resource, streamed by list endpoints when 'watch' is true.
+type WatchEvent struct {
+	// Object The resource that the notification refers to. For DELETED notifications only the resource's metadata is guaranteed to be set. Not set for BOOKMARK notifications.
+	Object *map[string]interface{} `json:"object,omitempty"`
+
+	// ResourceVersion Opaque position of the notification in the change stream. Pass it as the 'resourceVersion' parameter to resume watching after this notification.
+	ResourceVersion string `json:"resourceVersion"`
+
+	// Type The type of change that a WatchEvent describes. BOOKMARK notifications carry no object and are sent periodically so that clients can track the latest resourceVersion.
+	Type WatchEventType `json:"type"`
+}
+
+// WatchEventType The type of change that a WatchEvent describes. BOOKMARK notifications carry no object and are sent periodically so that clients can track the latest resourceVersion.
+type WatchEventType string
+
 // AuthValidateParams defines parameters for AuthValidate.
 type AuthValidateParams struct {
 	// Authorization The authentication token to validate.
@@ -3060,6 +3920,12 @@ type ListDevicesParams struct {
 
 	// SummaryOnly A boolean flag to include only a summary of the devices. When set to true, the response will contain only the summary information. Only the 'owner' and 'labelSelector' parameters are supported when 'summaryOnly' is true.
 	SummaryOnly *bool `form:"summaryOnly,omitempty" json:"summaryOnly,omitempty"`
+
+	// Watch If true, instead of returning a list, the server streams notifications about additions, modifications and deletions of matching resources as WatchEvent objects. Notifications are sent as newline-delimited JSON, or as server-sent events if the client accepts 'text/event-stream'.
+	Watch *bool `form:"watch,omitempty" json:"watch,omitempty"`
+
+	// ResourceVersion When 'watch' is true, resume the stream after the notification with this resourceVersion, as returned in a previous WatchEvent. If unset, the stream starts at the current point in time. If the notifications following this resourceVersion are no longer retained, the server responds with 410 Gone and the client must list the resources again.
+	ResourceVersion *string `form:"resourceVersion,omitempty" json:"resourceVersion,omitempty"`
 }
 
 // GetRenderedDeviceParams defines parameters for GetRenderedDevice.
@@ -3074,6 +3940,21 @@ type GetEnrollmentConfigParams struct {
 	Csr *string `form:"csr,omitempty" json:"csr,omitempty"`
 }
 
+// ListEnrollmentPoliciesParams defines parameters for ListEnrollmentPolicies.
+type ListEnrollmentPoliciesParams struct {
+	// Continue An optional parameter to query more results from the server. The value of the paramter must match the value of the 'continue' field in the previous list response.
+	Continue *string `form:"continue,omitempty" json:"continue,omitempty"`
+
+	// LabelSelector A selector to restrict the list of returned objects by their labels. Defaults to everything.
+	LabelSelector *string `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+
+	// FieldSelector A selector to restrict the list of returned objects by their fields, supporting operators like '=', '==', and '!=' (e.g., "key1=value1,key2!=value2").
+	FieldSelector *string `form:"fieldSelector,omitempty" json:"fieldSelector,omitempty"`
+
+	// Limit The maximum number of results returned in the list response. The server will set the 'continue' field in the list response if more results exist. The continue value may then be specified as parameter in a subsequent query.
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // ListEnrollmentRequestsParams defines parameters for ListEnrollmentRequests.
 type ListEnrollmentRequestsParams struct {
 	// Continue An optional parameter to query more results from the server. The value of the paramter must match the value of the 'continue' field in the previous list response.
@@ -3102,6 +3983,12 @@ type ListEventsParams struct {
 
 	// Continue An optional parameter to query more results from the server. The value of the paramter must match the value of the 'continue' field in the previous list response.
 	Continue *string `form:"continue,omitempty" json:"continue,omitempty"`
+
+	// Watch If true, instead of returning a list, the server streams notifications about additions, modifications and deletions of matching resources as WatchEvent objects. Notifications are sent as newline-delimited JSON, or as server-sent events if the client accepts 'text/event-stream'.
+	Watch *bool `form:"watch,omitempty" json:"watch,omitempty"`
+
+	// ResourceVersion When 'watch' is true, resume the stream after the notification with this resourceVersion, as returned in a previous WatchEvent. If unset, the stream starts at the current point in time. If the notifications following this resourceVersion are no longer retained, the server responds with 410 Gone and the client must list the resources again.
+	ResourceVersion *string `form:"resourceVersion,omitempty" json:"resourceVersion,omitempty"`
 }
 
 // ListEventsParamsOrder defines parameters for ListEvents.
@@ -3123,6 +4010,12 @@ type ListFleetsParams struct {
 
 	// AddDevicesSummary Include a summary of the devices in the fleet.
 	AddDevicesSummary *bool `form:"addDevicesSummary,omitempty" json:"addDevicesSummary,omitempty"`
+
+	// Watch If true, instead of returning a list, the server streams notifications about additions, modifications and deletions of matching resources as WatchEvent objects. Notifications are sent as newline-delimited JSON, or as server-sent events if the client accepts 'text/event-stream'.
+	Watch *bool `form:"watch,omitempty" json:"watch,omitempty"`
+
+	// ResourceVersion When 'watch' is true, resume the stream after the notification with this resourceVersion, as returned in a previous WatchEvent. If unset, the stream starts at the current point in time. If the notifications following this resourceVersion are no longer retained, the server responds with 410 Gone and the client must list the resources again.
+	ResourceVersion *string `form:"resourceVersion,omitempty" json:"resourceVersion,omitempty"`
 }
 
 // ListTemplateVersionsParams defines parameters for ListTemplateVersions.
@@ -3170,6 +4063,21 @@ type ListOrganizationsParams struct {
 	FieldSelector *string `form:"fieldSelector,omitempty" json:"fieldSelector,omitempty"`
 }
 
+// ListReferenceMeasurementsParams defines parameters for ListReferenceMeasurements.
+type ListReferenceMeasurementsParams struct {
+	// Continue An optional parameter to query more results from the server. The value of the paramter must match the value of the 'continue' field in the previous list response.
+	Continue *string `form:"continue,omitempty" json:"continue,omitempty"`
+
+	// LabelSelector A selector to restrict the list of returned objects by their labels. Defaults to everything.
+	LabelSelector *string `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+
+	// FieldSelector A selector to restrict the list of returned objects by their fields, supporting operators like '=', '==', and '!=' (e.g., "key1=value1,key2!=value2").
+	FieldSelector *string `form:"fieldSelector,omitempty" json:"fieldSelector,omitempty"`
+
+	// Limit The maximum number of results returned in the list response. The server will set the 'continue' field in the list response if more results exist. The continue value may then be specified as parameter in a subsequent query.
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // ListRepositoriesParams defines parameters for ListRepositories.
 type ListRepositoriesParams struct {
 	// Continue An optional parameter to query more results from the server. The value of the paramter must match the value of the 'continue' field in the previous list response.
@@ -3200,6 +4108,42 @@ type ListResourceSyncsParams struct {
 	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
+// RequestResourceSyncPreviewParams defines parameters for RequestResourceSyncPreview.
+type RequestResourceSyncPreviewParams struct {
+	// Revision The revision of the repository to preview. Defaults to the target revision of the ResourceSync.
+	Revision *string `form:"revision,omitempty" json:"revision,omitempty"`
+}
+
+// ListSecretsParams defines parameters for ListSecrets.
+type ListSecretsParams struct {
+	// Continue An optional parameter to query more results from the server. The value of the paramter must match the value of the 'continue' field in the previous list response.
+	Continue *string `form:"continue,omitempty" json:"continue,omitempty"`
+
+	// LabelSelector A selector to restrict the list of returned objects by their labels. Defaults to everything.
+	LabelSelector *string `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+
+	// FieldSelector A selector to restrict the list of returned objects by their fields, supporting operators like '=', '==', and '!=' (e.g., "key1=value1,key2!=value2").
+	FieldSelector *string `form:"fieldSelector,omitempty" json:"fieldSelector,omitempty"`
+
+	// Limit The maximum number of results returned in the list response. The server will set the 'continue' field in the list response if more results exist. The continue value may then be specified as parameter in a subsequent query.
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ListTrustPoliciesParams defines parameters for ListTrustPolicies.
+type ListTrustPoliciesParams struct {
+	// Continue An optional parameter to query more results from the server. The value of the paramter must match the value of the 'continue' field in the previous list response.
+	Continue *string `form:"continue,omitempty" json:"continue,omitempty"`
+
+	// LabelSelector A selector to restrict the list of returned objects by their labels. Defaults to everything.
+	LabelSelector *string `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+
+	// FieldSelector A selector to restrict the list of returned objects by their fields, supporting operators like '=', '==', and '!=' (e.g., "key1=value1,key2!=value2").
+	FieldSelector *string `form:"fieldSelector,omitempty" json:"fieldSelector,omitempty"`
+
+	// Limit The maximum number of results returned in the list response. The server will set the 'continue' field in the list response if more results exist. The continue value may then be specified as parameter in a subsequent query.
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // AuthTokenJSONRequestBody defines body for AuthToken for application/json ContentType.
 type AuthTokenJSONRequestBody = TokenRequest
 
@@ -3242,12 +4186,24 @@ type ReplaceDeviceJSONRequestBody = Device
 // DecommissionDeviceJSONRequestBody defines body for DecommissionDevice for application/json ContentType.
 type DecommissionDeviceJSONRequestBody = DeviceDecommission
 
+// RevokeDeviceCertificatesJSONRequestBody defines body for RevokeDeviceCertificates for application/json ContentType.
+type RevokeDeviceCertificatesJSONRequestBody = DeviceRevocation
+
 // PatchDeviceStatusApplicationJSONPatchPlusJSONRequestBody defines body for PatchDeviceStatus for application/json-patch+json ContentType.
 type PatchDeviceStatusApplicationJSONPatchPlusJSONRequestBody = PatchRequest
 
 // ReplaceDeviceStatusJSONRequestBody defines body for ReplaceDeviceStatus for application/json ContentType.
 type ReplaceDeviceStatusJSONRequestBody = Device
 
+// CreateEnrollmentPolicyJSONRequestBody defines body for CreateEnrollmentPolicy for application/json ContentType.
+type CreateEnrollmentPolicyJSONRequestBody = EnrollmentPolicy
+
+// PatchEnrollmentPolicyApplicationJSONPatchPlusJSONRequestBody defines body for PatchEnrollmentPolicy for application/json-patch+json ContentType.
+type PatchEnrollmentPolicyApplicationJSONPatchPlusJSONRequestBody = PatchRequest
+
+// ReplaceEnrollmentPolicyJSONRequestBody defines body for ReplaceEnrollmentPolicy for application/json ContentType.
+type ReplaceEnrollmentPolicyJSONRequestBody = EnrollmentPolicy
+
 // CreateEnrollmentRequestJSONRequestBody defines body for CreateEnrollmentRequest for application/json ContentType.
 type CreateEnrollmentRequestJSONRequestBody = EnrollmentRequest
 
@@ -3281,6 +4237,15 @@ type PatchFleetStatusApplicationJSONPatchPlusJSONRequestBody = PatchRequest
 // ReplaceFleetStatusJSONRequestBody defines body for ReplaceFleetStatus for application/json ContentType.
 type ReplaceFleetStatusJSONRequestBody = Fleet
 
+// CreateReferenceMeasurementJSONRequestBody defines body for CreateReferenceMeasurement for application/json ContentType.
+type CreateReferenceMeasurementJSONRequestBody = ReferenceMeasurement
+
+// PatchReferenceMeasurementApplicationJSONPatchPlusJSONRequestBody defines body for PatchReferenceMeasurement for application/json-patch+json ContentType.
+type PatchReferenceMeasurementApplicationJSONPatchPlusJSONRequestBody = PatchRequest
+
+// ReplaceReferenceMeasurementJSONRequestBody defines body for ReplaceReferenceMeasurement for application/json ContentType.
+type ReplaceReferenceMeasurementJSONRequestBody = ReferenceMeasurement
+
 // CreateRepositoryJSONRequestBody defines body for CreateRepository for application/json ContentType.
 type CreateRepositoryJSONRequestBody = Repository
 
@@ -3299,6 +4264,18 @@ type PatchResourceSyncApplicationJSONPatchPlusJSONRequestBody = PatchRequest
 // ReplaceResourceSyncJSONRequestBody defines body for ReplaceResourceSync for application/json ContentType.
 type ReplaceResourceSyncJSONRequestBody = ResourceSync
 
+// CreateSecretJSONRequestBody defines body for CreateSecret for application/json ContentType.
+type CreateSecretJSONRequestBody = Secret
+
+// ReplaceSecretJSONRequestBody defines body for ReplaceSecret for application/json ContentType.
+type ReplaceSecretJSONRequestBody = Secret
+
+// CreateTrustPolicyJSONRequestBody defines body for CreateTrustPolicy for application/json ContentType.
+type CreateTrustPolicyJSONRequestBody = TrustPolicy
+
+// ReplaceTrustPolicyJSONRequestBody defines body for ReplaceTrustPolicy for application/json ContentType.
+type ReplaceTrustPolicyJSONRequestBody = TrustPolicy
+
 // Getter for additional properties for DeviceSystemInfo. Returns the specified
 // element and whether it was found
 func (a DeviceSystemInfo) Get(fieldName string) (value string, found bool) {
@@ -3670,6 +4647,13 @@ func (t ApplicationVolume) MarshalJSON() ([]byte, error) {
 			return nil, fmt.Errorf("error marshaling 'reclaimPolicy': %w", err)
 		}
 	}
+
+	if t.SnapshotBeforeUpdate != nil {
+		object["snapshotBeforeUpdate"], err = json.Marshal(t.SnapshotBeforeUpdate)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'snapshotBeforeUpdate': %w", err)
+		}
+	}
 	b, err = json.Marshal(object)
 	return b, err
 }
@@ -3699,6 +4683,13 @@ func (t *ApplicationVolume) UnmarshalJSON(b []byte) error {
 		}
 	}
 
+	if raw, found := object["snapshotBeforeUpdate"]; found {
+		err = json.Unmarshal(raw, &t.SnapshotBeforeUpdate)
+		if err != nil {
+			return fmt.Errorf("error reading 'snapshotBeforeUpdate': %w", err)
+		}
+	}
+
 	return err
 }
 
@@ -4221,6 +5212,13 @@ func (t ComposeApplication) MarshalJSON() ([]byte, error) {
 		return nil, fmt.Errorf("error marshaling 'appType': %w", err)
 	}
 
+	if t.DependsOn != nil {
+		object["dependsOn"], err = json.Marshal(t.DependsOn)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'dependsOn': %w", err)
+		}
+	}
+
 	if t.EnvVars != nil {
 		object["envVars"], err = json.Marshal(t.EnvVars)
 		if err != nil {
@@ -4263,6 +5261,13 @@ func (t *ComposeApplication) UnmarshalJSON(b []byte) error {
 		}
 	}
 
+	if raw, found := object["dependsOn"]; found {
+		err = json.Unmarshal(raw, &t.DependsOn)
+		if err != nil {
+			return fmt.Errorf("error reading 'dependsOn': %w", err)
+		}
+	}
+
 	if raw, found := object["envVars"]; found {
 		err = json.Unmarshal(raw, &t.EnvVars)
 		if err != nil {
@@ -4339,22 +5344,22 @@ func (t *ConfigProviderSpec) MergeKubernetesSecretProviderSpec(v KubernetesSecre
 	return err
 }
 
-// AsInlineConfigProviderSpec returns the union data inside the ConfigProviderSpec as a InlineConfigProviderSpec
-func (t ConfigProviderSpec) AsInlineConfigProviderSpec() (InlineConfigProviderSpec, error) {
-	var body InlineConfigProviderSpec
+// AsSecretProviderSpec returns the union data inside the ConfigProviderSpec as a SecretProviderSpec
+func (t ConfigProviderSpec) AsSecretProviderSpec() (SecretProviderSpec, error) {
+	var body SecretProviderSpec
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromInlineConfigProviderSpec overwrites any union data inside the ConfigProviderSpec as the provided InlineConfigProviderSpec
-func (t *ConfigProviderSpec) FromInlineConfigProviderSpec(v InlineConfigProviderSpec) error {
+// FromSecretProviderSpec overwrites any union data inside the ConfigProviderSpec as the provided SecretProviderSpec
+func (t *ConfigProviderSpec) FromSecretProviderSpec(v SecretProviderSpec) error {
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeInlineConfigProviderSpec performs a merge with any union data inside the ConfigProviderSpec, using the provided InlineConfigProviderSpec
-func (t *ConfigProviderSpec) MergeInlineConfigProviderSpec(v InlineConfigProviderSpec) error {
+// MergeSecretProviderSpec performs a merge with any union data inside the ConfigProviderSpec, using the provided SecretProviderSpec
+func (t *ConfigProviderSpec) MergeSecretProviderSpec(v SecretProviderSpec) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -4365,15 +5370,41 @@ func (t *ConfigProviderSpec) MergeInlineConfigProviderSpec(v InlineConfigProvide
 	return err
 }
 
-// AsHttpConfigProviderSpec returns the union data inside the ConfigProviderSpec as a HttpConfigProviderSpec
-func (t ConfigProviderSpec) AsHttpConfigProviderSpec() (HttpConfigProviderSpec, error) {
-	var body HttpConfigProviderSpec
+// AsInlineConfigProviderSpec returns the union data inside the ConfigProviderSpec as a InlineConfigProviderSpec
+func (t ConfigProviderSpec) AsInlineConfigProviderSpec() (InlineConfigProviderSpec, error) {
+	var body InlineConfigProviderSpec
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromHttpConfigProviderSpec overwrites any union data inside the ConfigProviderSpec as the provided HttpConfigProviderSpec
-func (t *ConfigProviderSpec) FromHttpConfigProviderSpec(v HttpConfigProviderSpec) error {
+// FromInlineConfigProviderSpec overwrites any union data inside the ConfigProviderSpec as the provided InlineConfigProviderSpec
+func (t *ConfigProviderSpec) FromInlineConfigProviderSpec(v InlineConfigProviderSpec) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeInlineConfigProviderSpec performs a merge with any union data inside the ConfigProviderSpec, using the provided InlineConfigProviderSpec
+func (t *ConfigProviderSpec) MergeInlineConfigProviderSpec(v InlineConfigProviderSpec) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsHttpConfigProviderSpec returns the union data inside the ConfigProviderSpec as a HttpConfigProviderSpec
+func (t ConfigProviderSpec) AsHttpConfigProviderSpec() (HttpConfigProviderSpec, error) {
+	var body HttpConfigProviderSpec
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromHttpConfigProviderSpec overwrites any union data inside the ConfigProviderSpec as the provided HttpConfigProviderSpec
+func (t *ConfigProviderSpec) FromHttpConfigProviderSpec(v HttpConfigProviderSpec) error {
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
@@ -4735,6 +5766,62 @@ func (t *EventDetails) MergeReferencedRepositoryUpdatedDetails(v ReferencedRepos
 	return err
 }
 
+// AsRepositoryPushReceivedDetails returns the union data inside the EventDetails as a RepositoryPushReceivedDetails
+func (t EventDetails) AsRepositoryPushReceivedDetails() (RepositoryPushReceivedDetails, error) {
+	var body RepositoryPushReceivedDetails
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromRepositoryPushReceivedDetails overwrites any union data inside the EventDetails as the provided RepositoryPushReceivedDetails
+func (t *EventDetails) FromRepositoryPushReceivedDetails(v RepositoryPushReceivedDetails) error {
+	v.DetailType = "RepositoryPushReceived"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeRepositoryPushReceivedDetails performs a merge with any union data inside the EventDetails, using the provided RepositoryPushReceivedDetails
+func (t *EventDetails) MergeRepositoryPushReceivedDetails(v RepositoryPushReceivedDetails) error {
+	v.DetailType = "RepositoryPushReceived"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsResourceSyncPreviewRequestedDetails returns the union data inside the EventDetails as a ResourceSyncPreviewRequestedDetails
+func (t EventDetails) AsResourceSyncPreviewRequestedDetails() (ResourceSyncPreviewRequestedDetails, error) {
+	var body ResourceSyncPreviewRequestedDetails
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromResourceSyncPreviewRequestedDetails overwrites any union data inside the EventDetails as the provided ResourceSyncPreviewRequestedDetails
+func (t *EventDetails) FromResourceSyncPreviewRequestedDetails(v ResourceSyncPreviewRequestedDetails) error {
+	v.DetailType = "ResourceSyncPreviewRequested"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeResourceSyncPreviewRequestedDetails performs a merge with any union data inside the EventDetails, using the provided ResourceSyncPreviewRequestedDetails
+func (t *EventDetails) MergeResourceSyncPreviewRequestedDetails(v ResourceSyncPreviewRequestedDetails) error {
+	v.DetailType = "ResourceSyncPreviewRequested"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
 // AsFleetRolloutStartedDetails returns the union data inside the EventDetails as a FleetRolloutStartedDetails
 func (t EventDetails) AsFleetRolloutStartedDetails() (FleetRolloutStartedDetails, error) {
 	var body FleetRolloutStartedDetails
@@ -4791,6 +5878,34 @@ func (t *EventDetails) MergeFleetRolloutFailedDetails(v FleetRolloutFailedDetail
 	return err
 }
 
+// AsFleetRolloutRolledBackDetails returns the union data inside the EventDetails as a FleetRolloutRolledBackDetails
+func (t EventDetails) AsFleetRolloutRolledBackDetails() (FleetRolloutRolledBackDetails, error) {
+	var body FleetRolloutRolledBackDetails
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromFleetRolloutRolledBackDetails overwrites any union data inside the EventDetails as the provided FleetRolloutRolledBackDetails
+func (t *EventDetails) FromFleetRolloutRolledBackDetails(v FleetRolloutRolledBackDetails) error {
+	v.DetailType = "FleetRolloutRolledBack"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeFleetRolloutRolledBackDetails performs a merge with any union data inside the EventDetails, using the provided FleetRolloutRolledBackDetails
+func (t *EventDetails) MergeFleetRolloutRolledBackDetails(v FleetRolloutRolledBackDetails) error {
+	v.DetailType = "FleetRolloutRolledBack"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
 // AsFleetRolloutCompletedDetails returns the union data inside the EventDetails as a FleetRolloutCompletedDetails
 func (t EventDetails) AsFleetRolloutCompletedDetails() (FleetRolloutCompletedDetails, error) {
 	var body FleetRolloutCompletedDetails
@@ -4933,6 +6048,8 @@ func (t EventDetails) ValueByDiscriminator() (interface{}, error) {
 		return t.AsFleetRolloutDeviceSelectedDetails()
 	case "FleetRolloutFailed":
 		return t.AsFleetRolloutFailedDetails()
+	case "FleetRolloutRolledBack":
+		return t.AsFleetRolloutRolledBackDetails()
 	case "FleetRolloutStarted":
 		return t.AsFleetRolloutStartedDetails()
 	case "InternalTaskFailed":
@@ -4941,8 +6058,12 @@ func (t EventDetails) ValueByDiscriminator() (interface{}, error) {
 		return t.AsInternalTaskPermanentlyFailedDetails()
 	case "ReferencedRepositoryUpdated":
 		return t.AsReferencedRepositoryUpdatedDetails()
+	case "RepositoryPushReceived":
+		return t.AsRepositoryPushReceivedDetails()
 	case "ResourceSyncCompleted":
 		return t.AsResourceSyncCompletedDetails()
+	case "ResourceSyncPreviewRequested":
+		return t.AsResourceSyncPreviewRequestedDetails()
 	case "ResourceUpdated":
 		return t.AsResourceUpdatedDetails()
 	default:
@@ -4960,6 +6081,192 @@ func (t *EventDetails) UnmarshalJSON(b []byte) error {
 	return err
 }
 
+// AsHealthProbeHttp returns the union data inside the HealthProbe as a HealthProbeHttp
+func (t HealthProbe) AsHealthProbeHttp() (HealthProbeHttp, error) {
+	var body HealthProbeHttp
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromHealthProbeHttp overwrites any union data inside the HealthProbe as the provided HealthProbeHttp
+func (t *HealthProbe) FromHealthProbeHttp(v HealthProbeHttp) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeHealthProbeHttp performs a merge with any union data inside the HealthProbe, using the provided HealthProbeHttp
+func (t *HealthProbe) MergeHealthProbeHttp(v HealthProbeHttp) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsHealthProbeTcp returns the union data inside the HealthProbe as a HealthProbeTcp
+func (t HealthProbe) AsHealthProbeTcp() (HealthProbeTcp, error) {
+	var body HealthProbeTcp
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromHealthProbeTcp overwrites any union data inside the HealthProbe as the provided HealthProbeTcp
+func (t *HealthProbe) FromHealthProbeTcp(v HealthProbeTcp) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeHealthProbeTcp performs a merge with any union data inside the HealthProbe, using the provided HealthProbeTcp
+func (t *HealthProbe) MergeHealthProbeTcp(v HealthProbeTcp) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsHealthProbeExec returns the union data inside the HealthProbe as a HealthProbeExec
+func (t HealthProbe) AsHealthProbeExec() (HealthProbeExec, error) {
+	var body HealthProbeExec
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromHealthProbeExec overwrites any union data inside the HealthProbe as the provided HealthProbeExec
+func (t *HealthProbe) FromHealthProbeExec(v HealthProbeExec) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeHealthProbeExec performs a merge with any union data inside the HealthProbe, using the provided HealthProbeExec
+func (t *HealthProbe) MergeHealthProbeExec(v HealthProbeExec) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsHealthProbeSystemdUnit returns the union data inside the HealthProbe as a HealthProbeSystemdUnit
+func (t HealthProbe) AsHealthProbeSystemdUnit() (HealthProbeSystemdUnit, error) {
+	var body HealthProbeSystemdUnit
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromHealthProbeSystemdUnit overwrites any union data inside the HealthProbe as the provided HealthProbeSystemdUnit
+func (t *HealthProbe) FromHealthProbeSystemdUnit(v HealthProbeSystemdUnit) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeHealthProbeSystemdUnit performs a merge with any union data inside the HealthProbe, using the provided HealthProbeSystemdUnit
+func (t *HealthProbe) MergeHealthProbeSystemdUnit(v HealthProbeSystemdUnit) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsHealthProbeApplication returns the union data inside the HealthProbe as a HealthProbeApplication
+func (t HealthProbe) AsHealthProbeApplication() (HealthProbeApplication, error) {
+	var body HealthProbeApplication
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromHealthProbeApplication overwrites any union data inside the HealthProbe as the provided HealthProbeApplication
+func (t *HealthProbe) FromHealthProbeApplication(v HealthProbeApplication) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeHealthProbeApplication performs a merge with any union data inside the HealthProbe, using the provided HealthProbeApplication
+func (t *HealthProbe) MergeHealthProbeApplication(v HealthProbeApplication) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t HealthProbe) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	object := make(map[string]json.RawMessage)
+	if t.union != nil {
+		err = json.Unmarshal(b, &object)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	object["name"], err = json.Marshal(t.Name)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'name': %w", err)
+	}
+
+	if t.Timeout != nil {
+		object["timeout"], err = json.Marshal(t.Timeout)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'timeout': %w", err)
+		}
+	}
+	b, err = json.Marshal(object)
+	return b, err
+}
+
+func (t *HealthProbe) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	if err != nil {
+		return err
+	}
+	object := make(map[string]json.RawMessage)
+	err = json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["name"]; found {
+		err = json.Unmarshal(raw, &t.Name)
+		if err != nil {
+			return fmt.Errorf("error reading 'name': %w", err)
+		}
+	}
+
+	if raw, found := object["timeout"]; found {
+		err = json.Unmarshal(raw, &t.Timeout)
+		if err != nil {
+			return fmt.Errorf("error reading 'timeout': %w", err)
+		}
+	}
+
+	return err
+}
+
 // AsHookActionRun returns the union data inside the HookAction as a HookActionRun
 func (t HookAction) AsHookActionRun() (HookActionRun, error) {
 	var body HookActionRun
@@ -4986,6 +6293,110 @@ func (t *HookAction) MergeHookActionRun(v HookActionRun) error {
 	return err
 }
 
+// AsHookActionSystemd returns the union data inside the HookAction as a HookActionSystemd
+func (t HookAction) AsHookActionSystemd() (HookActionSystemd, error) {
+	var body HookActionSystemd
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromHookActionSystemd overwrites any union data inside the HookAction as the provided HookActionSystemd
+func (t *HookAction) FromHookActionSystemd(v HookActionSystemd) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeHookActionSystemd performs a merge with any union data inside the HookAction, using the provided HookActionSystemd
+func (t *HookAction) MergeHookActionSystemd(v HookActionSystemd) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsHookActionRestartApplication returns the union data inside the HookAction as a HookActionRestartApplication
+func (t HookAction) AsHookActionRestartApplication() (HookActionRestartApplication, error) {
+	var body HookActionRestartApplication
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromHookActionRestartApplication overwrites any union data inside the HookAction as the provided HookActionRestartApplication
+func (t *HookAction) FromHookActionRestartApplication(v HookActionRestartApplication) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeHookActionRestartApplication performs a merge with any union data inside the HookAction, using the provided HookActionRestartApplication
+func (t *HookAction) MergeHookActionRestartApplication(v HookActionRestartApplication) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsHookActionWaitForHttp returns the union data inside the HookAction as a HookActionWaitForHttp
+func (t HookAction) AsHookActionWaitForHttp() (HookActionWaitForHttp, error) {
+	var body HookActionWaitForHttp
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromHookActionWaitForHttp overwrites any union data inside the HookAction as the provided HookActionWaitForHttp
+func (t *HookAction) FromHookActionWaitForHttp(v HookActionWaitForHttp) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeHookActionWaitForHttp performs a merge with any union data inside the HookAction, using the provided HookActionWaitForHttp
+func (t *HookAction) MergeHookActionWaitForHttp(v HookActionWaitForHttp) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsHookActionWaitForTcp returns the union data inside the HookAction as a HookActionWaitForTcp
+func (t HookAction) AsHookActionWaitForTcp() (HookActionWaitForTcp, error) {
+	var body HookActionWaitForTcp
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromHookActionWaitForTcp overwrites any union data inside the HookAction as the provided HookActionWaitForTcp
+func (t *HookAction) FromHookActionWaitForTcp(v HookActionWaitForTcp) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeHookActionWaitForTcp performs a merge with any union data inside the HookAction, using the provided HookActionWaitForTcp
+func (t *HookAction) MergeHookActionWaitForTcp(v HookActionWaitForTcp) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
 func (t HookAction) MarshalJSON() ([]byte, error) {
 	b, err := t.union.MarshalJSON()
 	if err != nil {
@@ -5354,6 +6765,13 @@ func (t QuadletApplication) MarshalJSON() ([]byte, error) {
 		return nil, fmt.Errorf("error marshaling 'appType': %w", err)
 	}
 
+	if t.DependsOn != nil {
+		object["dependsOn"], err = json.Marshal(t.DependsOn)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'dependsOn': %w", err)
+		}
+	}
+
 	if t.EnvVars != nil {
 		object["envVars"], err = json.Marshal(t.EnvVars)
 		if err != nil {
@@ -5401,6 +6819,13 @@ func (t *QuadletApplication) UnmarshalJSON(b []byte) error {
 		}
 	}
 
+	if raw, found := object["dependsOn"]; found {
+		err = json.Unmarshal(raw, &t.DependsOn)
+		if err != nil {
+			return fmt.Errorf("error reading 'dependsOn': %w", err)
+		}
+	}
+
 	if raw, found := object["envVars"]; found {
 		err = json.Unmarshal(raw, &t.EnvVars)
 		if err != nil {
@@ -5635,6 +7060,118 @@ func (t *ResourceMonitor) MergeDiskResourceMonitorSpec(v DiskResourceMonitorSpec
 	return err
 }
 
+// AsInodeResourceMonitorSpec returns the union data inside the ResourceMonitor as a InodeResourceMonitorSpec
+func (t ResourceMonitor) AsInodeResourceMonitorSpec() (InodeResourceMonitorSpec, error) {
+	var body InodeResourceMonitorSpec
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromInodeResourceMonitorSpec overwrites any union data inside the ResourceMonitor as the provided InodeResourceMonitorSpec
+func (t *ResourceMonitor) FromInodeResourceMonitorSpec(v InodeResourceMonitorSpec) error {
+	v.MonitorType = "Inode"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeInodeResourceMonitorSpec performs a merge with any union data inside the ResourceMonitor, using the provided InodeResourceMonitorSpec
+func (t *ResourceMonitor) MergeInodeResourceMonitorSpec(v InodeResourceMonitorSpec) error {
+	v.MonitorType = "Inode"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsTemperatureResourceMonitorSpec returns the union data inside the ResourceMonitor as a TemperatureResourceMonitorSpec
+func (t ResourceMonitor) AsTemperatureResourceMonitorSpec() (TemperatureResourceMonitorSpec, error) {
+	var body TemperatureResourceMonitorSpec
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromTemperatureResourceMonitorSpec overwrites any union data inside the ResourceMonitor as the provided TemperatureResourceMonitorSpec
+func (t *ResourceMonitor) FromTemperatureResourceMonitorSpec(v TemperatureResourceMonitorSpec) error {
+	v.MonitorType = "Temperature"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeTemperatureResourceMonitorSpec performs a merge with any union data inside the ResourceMonitor, using the provided TemperatureResourceMonitorSpec
+func (t *ResourceMonitor) MergeTemperatureResourceMonitorSpec(v TemperatureResourceMonitorSpec) error {
+	v.MonitorType = "Temperature"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsNetworkResourceMonitorSpec returns the union data inside the ResourceMonitor as a NetworkResourceMonitorSpec
+func (t ResourceMonitor) AsNetworkResourceMonitorSpec() (NetworkResourceMonitorSpec, error) {
+	var body NetworkResourceMonitorSpec
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromNetworkResourceMonitorSpec overwrites any union data inside the ResourceMonitor as the provided NetworkResourceMonitorSpec
+func (t *ResourceMonitor) FromNetworkResourceMonitorSpec(v NetworkResourceMonitorSpec) error {
+	v.MonitorType = "Network"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeNetworkResourceMonitorSpec performs a merge with any union data inside the ResourceMonitor, using the provided NetworkResourceMonitorSpec
+func (t *ResourceMonitor) MergeNetworkResourceMonitorSpec(v NetworkResourceMonitorSpec) error {
+	v.MonitorType = "Network"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsApplicationResourceMonitorSpec returns the union data inside the ResourceMonitor as a ApplicationResourceMonitorSpec
+func (t ResourceMonitor) AsApplicationResourceMonitorSpec() (ApplicationResourceMonitorSpec, error) {
+	var body ApplicationResourceMonitorSpec
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromApplicationResourceMonitorSpec overwrites any union data inside the ResourceMonitor as the provided ApplicationResourceMonitorSpec
+func (t *ResourceMonitor) FromApplicationResourceMonitorSpec(v ApplicationResourceMonitorSpec) error {
+	v.MonitorType = "Application"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeApplicationResourceMonitorSpec performs a merge with any union data inside the ResourceMonitor, using the provided ApplicationResourceMonitorSpec
+func (t *ResourceMonitor) MergeApplicationResourceMonitorSpec(v ApplicationResourceMonitorSpec) error {
+	v.MonitorType = "Application"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
 func (t ResourceMonitor) Discriminator() (string, error) {
 	var discriminator struct {
 		Discriminator string `json:"monitorType"`
@@ -5649,12 +7186,20 @@ func (t ResourceMonitor) ValueByDiscriminator() (interface{}, error) {
 		return nil, err
 	}
 	switch discriminator {
+	case "Application":
+		return t.AsApplicationResourceMonitorSpec()
 	case "CPU":
 		return t.AsCpuResourceMonitorSpec()
 	case "Disk":
 		return t.AsDiskResourceMonitorSpec()
+	case "Inode":
+		return t.AsInodeResourceMonitorSpec()
 	case "Memory":
 		return t.AsMemoryResourceMonitorSpec()
+	case "Network":
+		return t.AsNetworkResourceMonitorSpec()
+	case "Temperature":
+		return t.AsTemperatureResourceMonitorSpec()
 	default:
 		return nil, errors.New("unknown discriminator value: " + discriminator)
 	}
@@ -5698,6 +7243,62 @@ func (t *RolloutDeviceSelection) MergeBatchSequence(v BatchSequence) error {
 	return err
 }
 
+// AsCanary returns the union data inside the RolloutDeviceSelection as a Canary
+func (t RolloutDeviceSelection) AsCanary() (Canary, error) {
+	var body Canary
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromCanary overwrites any union data inside the RolloutDeviceSelection as the provided Canary
+func (t *RolloutDeviceSelection) FromCanary(v Canary) error {
+	v.Strategy = "Canary"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeCanary performs a merge with any union data inside the RolloutDeviceSelection, using the provided Canary
+func (t *RolloutDeviceSelection) MergeCanary(v Canary) error {
+	v.Strategy = "Canary"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsLabelWaves returns the union data inside the RolloutDeviceSelection as a LabelWaves
+func (t RolloutDeviceSelection) AsLabelWaves() (LabelWaves, error) {
+	var body LabelWaves
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromLabelWaves overwrites any union data inside the RolloutDeviceSelection as the provided LabelWaves
+func (t *RolloutDeviceSelection) FromLabelWaves(v LabelWaves) error {
+	v.Strategy = "LabelWaves"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeLabelWaves performs a merge with any union data inside the RolloutDeviceSelection, using the provided LabelWaves
+func (t *RolloutDeviceSelection) MergeLabelWaves(v LabelWaves) error {
+	v.Strategy = "LabelWaves"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
 func (t RolloutDeviceSelection) Discriminator() (string, error) {
 	var discriminator struct {
 		Discriminator string `json:"strategy"`
@@ -5714,6 +7315,10 @@ func (t RolloutDeviceSelection) ValueByDiscriminator() (interface{}, error) {
 	switch discriminator {
 	case "BatchSequence":
 		return t.AsBatchSequence()
+	case "Canary":
+		return t.AsCanary()
+	case "LabelWaves":
+		return t.AsLabelWaves()
 	default:
 		return nil, errors.New("unknown discriminator value: " + discriminator)
 	}
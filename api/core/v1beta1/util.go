@@ -2,8 +2,12 @@ package v1beta1
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"regexp"
 	"strings"
 	"text/template"
 )
@@ -19,7 +23,11 @@ type DeviceCompletionCount struct {
 type HookActionType string
 
 const (
-	HookActionTypeRun HookActionType = "run"
+	HookActionTypeRun                HookActionType = "run"
+	HookActionTypeSystemd            HookActionType = "systemd"
+	HookActionTypeRestartApplication HookActionType = "restartApplication"
+	HookActionTypeWaitForHttp        HookActionType = "waitForHttp"
+	HookActionTypeWaitForTcp         HookActionType = "waitForTcp"
 )
 
 type HookConditionType string
@@ -29,6 +37,31 @@ const (
 	HookConditionTypeExpression HookConditionType = "expression"
 )
 
+// HookConditionExpressionVariables are the variables that expression conditions of hooks can refer to.
+var HookConditionExpressionVariables = []string{
+	"rebooted",
+	"osImageChanged",
+	"osImage",
+	"applicationsChanged",
+	"labels",
+	"systemInfo",
+	"customInfo",
+	"filesChanged",
+	"filesCreated",
+	"filesUpdated",
+	"filesRemoved",
+}
+
+type HealthProbeType string
+
+const (
+	HealthProbeTypeHttp        HealthProbeType = "http"
+	HealthProbeTypeTcp         HealthProbeType = "tcp"
+	HealthProbeTypeExec        HealthProbeType = "exec"
+	HealthProbeTypeSystemdUnit HealthProbeType = "systemdUnit"
+	HealthProbeTypeApplication HealthProbeType = "application"
+)
+
 type ConfigProviderType string
 
 const (
@@ -36,6 +69,7 @@ const (
 	HttpConfigProviderType       ConfigProviderType = "httpRef"
 	InlineConfigProviderType     ConfigProviderType = "inline"
 	KubernetesSecretProviderType ConfigProviderType = "secretRef"
+	SecretProviderType           ConfigProviderType = "secretResourceRef"
 )
 
 type ApplicationProviderType string
@@ -68,6 +102,10 @@ func (t HookAction) Type() (HookActionType, error) {
 
 	types := []HookActionType{
 		HookActionTypeRun,
+		HookActionTypeSystemd,
+		HookActionTypeRestartApplication,
+		HookActionTypeWaitForHttp,
+		HookActionTypeWaitForTcp,
 	}
 	for _, t := range types {
 		if _, exists := data[t]; exists {
@@ -78,6 +116,29 @@ func (t HookAction) Type() (HookActionType, error) {
 	return "", fmt.Errorf("unable to determine hook action type: %+v", data)
 }
 
+// Type returns the type of the health probe.
+func (t HealthProbe) Type() (HealthProbeType, error) {
+	var data map[HealthProbeType]interface{}
+	if err := json.Unmarshal(t.union, &data); err != nil {
+		return "", err
+	}
+
+	types := []HealthProbeType{
+		HealthProbeTypeHttp,
+		HealthProbeTypeTcp,
+		HealthProbeTypeExec,
+		HealthProbeTypeSystemdUnit,
+		HealthProbeTypeApplication,
+	}
+	for _, t := range types {
+		if _, exists := data[t]; exists {
+			return t, nil
+		}
+	}
+
+	return "", fmt.Errorf("unable to determine health probe type: %+v", data)
+}
+
 // Type returns the type of the condition.
 func (t HookCondition) Type() (HookConditionType, error) {
 	var data map[string]interface{}
@@ -113,6 +174,7 @@ func (c ConfigProviderSpec) Type() (ConfigProviderType, error) {
 		HttpConfigProviderType,
 		InlineConfigProviderType,
 		KubernetesSecretProviderType,
+		SecretProviderType,
 	}
 	for _, t := range types {
 		if _, exists := data[t]; exists {
@@ -195,6 +257,55 @@ func (a ApplicationProviderSpec) GetName() (*string, error) {
 	}
 }
 
+// GetDependsOn returns the dependencies of the application from the underlying type.
+func (a ApplicationProviderSpec) GetDependsOn() ([]ApplicationDependency, error) {
+	appType, err := a.GetAppType()
+	if err != nil {
+		return nil, err
+	}
+	var dependsOn *[]ApplicationDependency
+	switch appType {
+	case AppTypeContainer:
+		app, err := a.AsContainerApplication()
+		if err != nil {
+			return nil, err
+		}
+		dependsOn = app.DependsOn
+	case AppTypeHelm:
+		app, err := a.AsHelmApplication()
+		if err != nil {
+			return nil, err
+		}
+		dependsOn = app.DependsOn
+	case AppTypeCompose:
+		app, err := a.AsComposeApplication()
+		if err != nil {
+			return nil, err
+		}
+		dependsOn = app.DependsOn
+	case AppTypeQuadlet:
+		app, err := a.AsQuadletApplication()
+		if err != nil {
+			return nil, err
+		}
+		dependsOn = app.DependsOn
+	default:
+		return nil, fmt.Errorf("unknown app type: %s", appType)
+	}
+	if dependsOn == nil {
+		return nil, nil
+	}
+	return *dependsOn, nil
+}
+
+// ConditionWithDefault returns the condition of the dependency, defaulting to Healthy.
+func (d ApplicationDependency) ConditionWithDefault() ApplicationDependencyCondition {
+	if d.Condition == nil || *d.Condition == "" {
+		return ApplicationDependencyConditionHealthy
+	}
+	return *d.Condition
+}
+
 func (c ApplicationVolume) Type() (ApplicationVolumeProviderType, error) {
 	var data map[ApplicationVolumeProviderType]interface{}
 	if err := json.Unmarshal(c.union, &data); err != nil {
@@ -269,24 +380,120 @@ func GetGoTemplateFuncMap() template.FuncMap {
 		return defaultValue
 	}
 
+	defaultValue := func(defaultValue any, value any) any {
+		switch v := value.(type) {
+		case nil:
+			return defaultValue
+		case string:
+			if v == "" {
+				return defaultValue
+			}
+		case *string:
+			if v == nil || *v == "" {
+				return defaultValue
+			}
+		}
+		return value
+	}
+
+	b64enc := func(s any) string {
+		return base64.StdEncoding.EncodeToString([]byte(stringOrDefault(s)))
+	}
+
+	b64dec := func(s any) (string, error) {
+		decoded, err := base64.StdEncoding.DecodeString(stringOrDefault(s))
+		if err != nil {
+			return "", fmt.Errorf("b64dec: %w", err)
+		}
+		return string(decoded), nil
+	}
+
+	sha256sum := func(s any) string {
+		sum := sha256.Sum256([]byte(stringOrDefault(s)))
+		return hex.EncodeToString(sum[:])
+	}
+
+	indent := func(spaces int, s any) (string, error) {
+		if spaces < 0 || spaces > maxTemplateIndent {
+			return "", fmt.Errorf("indent: number of spaces must be between 0 and %d, got %d", maxTemplateIndent, spaces)
+		}
+		pad := strings.Repeat(" ", spaces)
+		return pad + strings.ReplaceAll(stringOrDefault(s), "\n", "\n"+pad), nil
+	}
+
+	toJson := func(v any) (string, error) {
+		out, err := json.Marshal(v)
+		if err != nil {
+			return "", fmt.Errorf("toJson: %w", err)
+		}
+		return string(out), nil
+	}
+
+	regexMatch := func(regex string, s any) (bool, error) {
+		return regexp.MatchString(regex, stringOrDefault(s))
+	}
+
+	split := func(sep string, s any) []string {
+		return strings.Split(stringOrDefault(s), sep)
+	}
+
+	join := func(sep string, list any) (string, error) {
+		switch l := list.(type) {
+		case []string:
+			return strings.Join(l, sep), nil
+		case []any:
+			elems := make([]string, 0, len(l))
+			for _, e := range l {
+				elems = append(elems, fmt.Sprint(e))
+			}
+			return strings.Join(elems, sep), nil
+		default:
+			return "", fmt.Errorf("join: unsupported list type %T", list)
+		}
+	}
+
 	return template.FuncMap{
 		"upper":        toUpper,
 		"lower":        toLower,
 		"replace":      replace,
 		"getOrDefault": getOrDefault,
+		"default":      defaultValue,
+		"b64enc":       b64enc,
+		"b64dec":       b64dec,
+		"sha256sum":    sha256sum,
+		"indent":       indent,
+		"toJson":       toJson,
+		"regexMatch":   regexMatch,
+		"split":        split,
+		"join":         join,
 	}
 }
 
+// maxTemplateIndent bounds the indentation of the "indent" template function, so that a template cannot
+// blow up the size of the rendered content.
+const maxTemplateIndent = 64
+
 // This function wraps template.Execute.  Instead of passing the device directly,
 // it converts it into a map first.  This has two purposes:
 // 1. The user-provided template uses the yaml/json API format (e.g., lower case)
 // 2. The map contains only the device fields we allow access to
 func ExecuteGoTemplateOnDevice(t *template.Template, dev *Device) (string, error) {
+	variables := map[string]string{}
+	if dev.Spec != nil && dev.Spec.Variables != nil {
+		variables = *dev.Spec.Variables
+	}
+
 	devMap := map[string]interface{}{
 		"metadata": map[string]interface{}{
 			"name":   dev.Metadata.Name,
 			"labels": dev.Metadata.Labels,
 		},
+		"spec": map[string]interface{}{
+			"variables": &variables,
+		},
+		"status": map[string]interface{}{
+			"systemInfo": systemInfoTemplateMap(dev.Status),
+		},
 	}
 
 	buf := new(bytes.Buffer)
@@ -297,6 +504,30 @@ func ExecuteGoTemplateOnDevice(t *template.Template, dev *Device) (string, error
 	return buf.String(), nil
 }
 
+// systemInfoTemplateMap returns the system info reported by the device, including the additional
+// and custom info, in the format used by the API.  Fields the device did not report yet are empty.
+func systemInfoTemplateMap(status *DeviceStatus) map[string]interface{} {
+	systemInfo := DeviceSystemInfo{}
+	if status != nil {
+		systemInfo = status.SystemInfo
+	}
+	customInfo := map[string]string{}
+	if systemInfo.CustomInfo != nil {
+		customInfo = *systemInfo.CustomInfo
+	}
+
+	m := make(map[string]interface{}, len(systemInfo.AdditionalProperties)+5)
+	for k, v := range systemInfo.AdditionalProperties {
+		m[k] = v
+	}
+	m["agentVersion"] = systemInfo.AgentVersion
+	m["architecture"] = systemInfo.Architecture
+	m["bootID"] = systemInfo.BootID
+	m["operatingSystem"] = systemInfo.OperatingSystem
+	m["customInfo"] = &customInfo
+	return m
+}
+
 // String converts a MatchExpression into its string representation.
 // Example formats:
 // - Exists: "key"
@@ -448,6 +679,9 @@ func (r *Repository) HideSensitiveData() error {
 		}
 		hideSshConfig(git.SshConfig)
 		hideHttpConfig(git.HttpConfig)
+		if git.Webhook != nil {
+			hideValue(&git.Webhook.Secret)
+		}
 		if err := spec.FromGitRepoSpec(git); err != nil {
 			return err
 		}
@@ -554,6 +788,9 @@ func (r *Repository) PreserveSensitiveData(existing SensitiveDataPreserver) erro
 		}
 		preserveSshConfig(git.SshConfig, existingGit.SshConfig)
 		preserveHttpConfig(git.HttpConfig, existingGit.HttpConfig)
+		if git.Webhook != nil && existingGit.Webhook != nil {
+			preserveValue(&git.Webhook.Secret, &existingGit.Webhook.Secret)
+		}
 		if err := spec.FromGitRepoSpec(git); err != nil {
 			return err
 		}
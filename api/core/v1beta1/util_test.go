@@ -2,6 +2,7 @@ package v1beta1
 
 import (
 	"testing"
+	"text/template"
 
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
@@ -1057,3 +1058,67 @@ func TestAuthProviderPreserveSensitiveData(t *testing.T) {
 		})
 	}
 }
+
+func TestExecuteGoTemplateOnDevice(t *testing.T) {
+	dev := &Device{
+		Metadata: ObjectMeta{
+			Name:   lo.ToPtr("device1"),
+			Labels: &map[string]string{"site": "Paris"},
+		},
+		Spec: &DeviceSpec{
+			Variables: &map[string]string{
+				"gateway": "10.0.0.1",
+				"dns":     "10.0.0.2,10.0.0.3",
+				"motd":    "line1\nline2",
+			},
+		},
+		Status: &DeviceStatus{
+			SystemInfo: DeviceSystemInfo{
+				Architecture:         "arm64",
+				OperatingSystem:      "linux",
+				CustomInfo:           &CustomDeviceInfo{"rack": "r12"},
+				AdditionalProperties: map[string]string{"hostname": "edge-1"},
+			},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		template    string
+		expected    string
+		expectedErr bool
+	}{
+		{name: "labels", template: `{{ .metadata.labels.site | lower }}`, expected: "paris"},
+		{name: "variables", template: `{{ .spec.variables.gateway }}`, expected: "10.0.0.1"},
+		{name: "system info", template: `{{ .status.systemInfo.architecture }}-{{ .status.systemInfo.hostname }}`, expected: "arm64-edge-1"},
+		{name: "custom info", template: `{{ .status.systemInfo.customInfo.rack }}`, expected: "r12"},
+		{name: "custom info default", template: `{{ getOrDefault .status.systemInfo.customInfo "row" "a" }}`, expected: "a"},
+		{name: "variables default", template: `{{ getOrDefault .spec.variables "ntp" "pool.ntp.org" }}`, expected: "pool.ntp.org"},
+		{name: "default for empty value", template: `{{ .status.systemInfo.bootID | default "unknown" }}`, expected: "unknown"},
+		{name: "base64", template: `{{ .spec.variables.gateway | b64enc }}`, expected: "MTAuMC4wLjE="},
+		{name: "base64 round trip", template: `{{ .spec.variables.gateway | b64enc | b64dec }}`, expected: "10.0.0.1"},
+		{name: "sha256", template: `{{ .metadata.name | sha256sum }}`, expected: "18faa0dd7a927906cb3e38fd4ff6899fbe468e6841caac211b8d2cebe0ef4a44"},
+		{name: "indent", template: `{{ .spec.variables.motd | indent 2 }}`, expected: "  line1\n  line2"},
+		{name: "toJson", template: `{{ toJson .status.systemInfo.customInfo }}`, expected: `{"rack":"r12"}`},
+		{name: "regex match", template: `{{ regexMatch "^10\\." .spec.variables.gateway }}`, expected: "true"},
+		{name: "split and join", template: `{{ .spec.variables.dns | split "," | join " " }}`, expected: "10.0.0.2 10.0.0.3"},
+		{name: "missing variable", template: `{{ .spec.variables.missing }}`, expectedErr: true},
+		{name: "invalid base64", template: `{{ .metadata.name | b64dec }}`, expectedErr: true},
+		{name: "negative indent", template: `{{ .spec.variables.motd | indent -1 }}`, expectedErr: true},
+		{name: "oversized indent", template: `{{ .spec.variables.motd | indent 1000000000 }}`, expectedErr: true},
+		{name: "maximal indent", template: `{{ .spec.variables.gateway | indent 64 | len }}`, expected: "72"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl, err := template.New("t").Option("missingkey=error").Funcs(GetGoTemplateFuncMap()).Parse(tt.template)
+			require.NoError(t, err)
+			output, err := ExecuteGoTemplateOnDevice(tmpl, dev)
+			if tt.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, output)
+		})
+	}
+}
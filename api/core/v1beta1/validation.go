@@ -2,10 +2,15 @@ package v1beta1
 
 import (
 	"context"
+	"crypto/x509"
 	"encoding/base64"
 	"encoding/json"
+	"encoding/pem"
 	"errors"
 	"fmt"
+	"maps"
+	"net"
+	"net/url"
 	"reflect"
 	"regexp"
 	"slices"
@@ -18,14 +23,17 @@ import (
 	"github.com/flightctl/flightctl/internal/api/common"
 	"github.com/flightctl/flightctl/internal/contextutil"
 	"github.com/flightctl/flightctl/internal/util"
+	"github.com/flightctl/flightctl/internal/util/expression"
 	"github.com/flightctl/flightctl/internal/util/validation"
 	"github.com/robfig/cron/v3"
 	"github.com/samber/lo"
+	"sigs.k8s.io/yaml"
 )
 
 const (
 	maxBase64CertificateLength  = 20 * 1024 * 1024
 	maxInlineLength             = 1024 * 1024
+	maxDeviceVariableLength     = 64 * 1024
 	privilegedPortRangeStart    = 1
 	nonPrivilegedPortRangeStart = 1024
 	portRangeEnd                = 65535
@@ -113,9 +121,136 @@ func (r DeviceSpec) Validate(fleetTemplate bool) []error {
 			allErrs = append(allErrs, validation.ValidateSystemdName(&matchPattern, fmt.Sprintf("spec.systemd.matchPatterns[%d]", i))...)
 		}
 	}
+	if r.HealthChecks != nil {
+		allErrs = append(allErrs, r.HealthChecks.Validate("spec.healthChecks")...)
+	}
+	if r.TrustPolicy != nil {
+		allErrs = append(allErrs, validation.ValidateResourceNameReference(&r.TrustPolicy.Name, "spec.trustPolicy.name")...)
+		if r.TrustPolicy.Spec != nil {
+			allErrs = append(allErrs, r.TrustPolicy.Spec.Validate("spec.trustPolicy.spec")...)
+		}
+	}
+	if r.Variables != nil {
+		if fleetTemplate {
+			allErrs = append(allErrs, fmt.Errorf("spec.variables: variables are set per device and are not supported in fleet templates"))
+		} else {
+			allErrs = append(allErrs, validateDeviceVariables(*r.Variables, "spec.variables")...)
+		}
+	}
+	return allErrs
+}
+
+// validateDeviceVariables ensures variable names can be referenced as fields in fleet template parameters
+func validateDeviceVariables(variables map[string]string, path string) []error {
+	allErrs := []error{}
+	for name, value := range variables {
+		if !deviceVariableNamePattern.MatchString(name) {
+			allErrs = append(allErrs, fmt.Errorf("%s: invalid variable name %q: must be at most 63 characters, start with a letter or underscore and contain only letters, digits and underscores", path, name))
+		}
+		if len(value) > maxDeviceVariableLength {
+			allErrs = append(allErrs, fmt.Errorf("%s.%s: value must be at most %d bytes", path, name, maxDeviceVariableLength))
+		}
+	}
+	return allErrs
+}
+
+func (h DeviceHealthChecks) Validate(path string) []error {
+	allErrs := []error{}
+	if len(h.Probes) == 0 {
+		allErrs = append(allErrs, fmt.Errorf("%s.probes: at least one probe is required", path))
+	}
+	allErrs = append(allErrs, validatePositiveDuration(h.GracePeriod, path+".gracePeriod")...)
+	allErrs = append(allErrs, validatePositiveDuration(h.Interval, path+".interval")...)
+	if h.FailureThreshold != nil && *h.FailureThreshold < 1 {
+		allErrs = append(allErrs, fmt.Errorf("%s.failureThreshold: must be at least 1", path))
+	}
+
+	seenNames := make(map[string]struct{}, len(h.Probes))
+	for i, probe := range h.Probes {
+		probePath := fmt.Sprintf("%s.probes[%d]", path, i)
+		if _, exists := seenNames[probe.Name]; exists {
+			allErrs = append(allErrs, fmt.Errorf("%s.name: probe name must be unique: %s", probePath, probe.Name))
+		}
+		seenNames[probe.Name] = struct{}{}
+		allErrs = append(allErrs, probe.Validate(probePath)...)
+	}
+	return allErrs
+}
+
+func (p HealthProbe) Validate(path string) []error {
+	allErrs := []error{}
+	allErrs = append(allErrs, validation.ValidateString(&p.Name, path+".name", 1, 63, nil, "")...)
+	allErrs = append(allErrs, validatePositiveDuration(p.Timeout, path+".timeout")...)
+
+	t, err := p.Type()
+	if err != nil {
+		allErrs = append(allErrs, err)
+		return allErrs
+	}
+
+	switch t {
+	case HealthProbeTypeHttp:
+		httpProbe, err := p.AsHealthProbeHttp()
+		if err != nil {
+			allErrs = append(allErrs, err)
+			return allErrs
+		}
+		u, err := url.Parse(httpProbe.Http)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			allErrs = append(allErrs, fmt.Errorf("%s.http: must be an http or https URL: %q", path, httpProbe.Http))
+		}
+	case HealthProbeTypeTcp:
+		tcpProbe, err := p.AsHealthProbeTcp()
+		if err != nil {
+			allErrs = append(allErrs, err)
+			return allErrs
+		}
+		if _, port, err := net.SplitHostPort(tcpProbe.Tcp); err != nil || port == "" {
+			allErrs = append(allErrs, fmt.Errorf("%s.tcp: must be an address in host:port format: %q", path, tcpProbe.Tcp))
+		}
+	case HealthProbeTypeExec:
+		execProbe, err := p.AsHealthProbeExec()
+		if err != nil {
+			allErrs = append(allErrs, err)
+			return allErrs
+		}
+		allErrs = append(allErrs, validation.ValidateString(&execProbe.Exec, path+".exec", 1, 2048, nil, "")...)
+	case HealthProbeTypeSystemdUnit:
+		systemdProbe, err := p.AsHealthProbeSystemdUnit()
+		if err != nil {
+			allErrs = append(allErrs, err)
+			return allErrs
+		}
+		allErrs = append(allErrs, validation.ValidateSystemdName(&systemdProbe.SystemdUnit, path+".systemdUnit")...)
+	case HealthProbeTypeApplication:
+		appProbe, err := p.AsHealthProbeApplication()
+		if err != nil {
+			allErrs = append(allErrs, err)
+			return allErrs
+		}
+		allErrs = append(allErrs, validation.ValidateString(&appProbe.Application, path+".application", 1, 253, nil, "")...)
+	default:
+		// if we hit this case, it means that the type should be added to the switch statement above
+		allErrs = append(allErrs, fmt.Errorf("%s: unknown health probe type: %s", path, t))
+	}
+
 	return allErrs
 }
 
+func validatePositiveDuration(d *string, path string) []error {
+	if d == nil {
+		return nil
+	}
+	duration, err := time.ParseDuration(*d)
+	if err != nil {
+		return []error{fmt.Errorf("%s: invalid duration %q: %w", path, *d, err)}
+	}
+	if duration <= 0 {
+		return []error{fmt.Errorf("%s: duration must be positive: %q", path, *d)}
+	}
+	return nil
+}
+
 func validateConfigs(configs []ConfigProviderSpec, fleetTemplate bool) []error {
 	allErrs := []error{}
 	seenPath := make(map[string]struct{}, len(configs))
@@ -170,6 +305,13 @@ func validateConfigs(configs []ConfigProviderSpec, fleetTemplate bool) []error {
 				break
 			}
 			allErrs = append(allErrs, provider.Validate(fleetTemplate)...)
+		case SecretProviderType:
+			provider, err := config.AsSecretProviderSpec()
+			if err != nil {
+				allErrs = append(allErrs, err)
+				break
+			}
+			allErrs = append(allErrs, provider.Validate(fleetTemplate)...)
 		default:
 			// if we hit this case, it means that the type should be added to the switch statement above
 			allErrs = append(allErrs, fmt.Errorf("unknown config provider type: %s", t))
@@ -198,6 +340,44 @@ func (a HookAction) Validate(path string) []error {
 		// TODO: pull the extra validation done by the agent up here
 		allErrs = append(allErrs, validation.ValidateStringMap(runAction.EnvVars, path+".envVars", 1, 256, nil, nil, "")...)
 		allErrs = append(allErrs, validation.ValidateFileOrDirectoryPath(runAction.WorkDir, path+".workDir")...)
+	case HookActionTypeSystemd:
+		systemdAction, err := a.AsHookActionSystemd()
+		if err != nil {
+			allErrs = append(allErrs, err)
+			return allErrs
+		}
+		allErrs = append(allErrs, validation.ValidateSystemdName(&systemdAction.Systemd, path+".systemd")...)
+		switch systemdAction.Operation {
+		case HookActionSystemdOperationStart, HookActionSystemdOperationStop, HookActionSystemdOperationRestart, HookActionSystemdOperationReload:
+		default:
+			allErrs = append(allErrs, fmt.Errorf("%s.operation: unsupported systemd operation: %q", path, systemdAction.Operation))
+		}
+	case HookActionTypeRestartApplication:
+		restartAction, err := a.AsHookActionRestartApplication()
+		if err != nil {
+			allErrs = append(allErrs, err)
+			return allErrs
+		}
+		allErrs = append(allErrs, validation.ValidateString(&restartAction.RestartApplication, path+".restartApplication", 1, 253, nil, "")...)
+	case HookActionTypeWaitForHttp:
+		httpAction, err := a.AsHookActionWaitForHttp()
+		if err != nil {
+			allErrs = append(allErrs, err)
+			return allErrs
+		}
+		u, err := url.Parse(httpAction.WaitForHttp)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			allErrs = append(allErrs, fmt.Errorf("%s.waitForHttp: must be an http or https URL: %q", path, httpAction.WaitForHttp))
+		}
+	case HookActionTypeWaitForTcp:
+		tcpAction, err := a.AsHookActionWaitForTcp()
+		if err != nil {
+			allErrs = append(allErrs, err)
+			return allErrs
+		}
+		if _, port, err := net.SplitHostPort(tcpAction.WaitForTcp); err != nil || port == "" {
+			allErrs = append(allErrs, fmt.Errorf("%s.waitForTcp: must be an address in host:port format: %q", path, tcpAction.WaitForTcp))
+		}
 	default:
 		// if we hit this case, it means that the type should be added to the switch statement above
 		allErrs = append(allErrs, fmt.Errorf("%s: unknown hook action type: %s", path, t))
@@ -228,6 +408,7 @@ func (c HookCondition) Validate(path string) []error {
 			allErrs = append(allErrs, err)
 		}
 		allErrs = append(allErrs, validation.ValidateString(&expression, path, 1, 2048, nil, "")...)
+		allErrs = append(allErrs, validateHookConditionExpression(expression, path)...)
 	case HookConditionTypePathOp:
 		pathOpCondition, err := c.AsHookConditionPathOp()
 		if err != nil {
@@ -242,6 +423,22 @@ func (c HookCondition) Validate(path string) []error {
 	return allErrs
 }
 
+// validateHookConditionExpression parses an expression condition and checks the variables it refers to,
+// so that invalid expressions are rejected instead of failing the update on the device.
+func validateHookConditionExpression(expr string, path string) []error {
+	parsed, err := expression.Parse(expr)
+	if err != nil {
+		return []error{fmt.Errorf("%s: invalid expression %q: %w", path, expr, err)}
+	}
+	allErrs := []error{}
+	for _, name := range parsed.Variables() {
+		if !slices.Contains(HookConditionExpressionVariables, name) {
+			allErrs = append(allErrs, fmt.Errorf("%s: unknown variable %q in expression %q", path, name, expr))
+		}
+	}
+	return allErrs
+}
+
 func (r ResourceMonitor) Validate() []error {
 	allErrs := []error{}
 
@@ -278,8 +475,44 @@ func (r ResourceMonitor) Validate() []error {
 			allErrs = append(allErrs, fmt.Errorf("%w: Memory monitors cannot have a path field", ErrInvalidMemoryMonitorField))
 		}
 		allErrs = append(allErrs, validateAlertRules(spec.AlertRules, spec.SamplingInterval)...)
+	case "Inode":
+		spec, err := r.AsInodeResourceMonitorSpec()
+		if err != nil {
+			allErrs = append(allErrs, err)
+		}
+		allErrs = append(allErrs, validation.ValidateString(&spec.Path, "spec.resources[].inode.path", 0, 2048, nil, "")...)
+		allErrs = append(allErrs, validateAlertRules(spec.AlertRules, spec.SamplingInterval)...)
+	case "Temperature":
+		spec, err := r.AsTemperatureResourceMonitorSpec()
+		if err != nil {
+			allErrs = append(allErrs, err)
+		}
+		allErrs = append(allErrs, validation.ValidateString(spec.Zone, "spec.resources[].temperature.zone", 1, 256, nil, "")...)
+		if spec.CriticalTemperature != nil && *spec.CriticalTemperature < 1 {
+			allErrs = append(allErrs, fmt.Errorf("spec.resources[].temperature.criticalTemperature must be positive: %d", *spec.CriticalTemperature))
+		}
+		allErrs = append(allErrs, validateAlertRules(spec.AlertRules, spec.SamplingInterval)...)
+	case "Network":
+		spec, err := r.AsNetworkResourceMonitorSpec()
+		if err != nil {
+			allErrs = append(allErrs, err)
+		}
+		if !slices.Contains([]NetworkResourceMonitorMetric{NetworkResourceMonitorMetricErrors, NetworkResourceMonitorMetricThroughput}, spec.Metric) {
+			allErrs = append(allErrs, fmt.Errorf("spec.resources[].network.metric must be %q or %q: %q",
+				NetworkResourceMonitorMetricErrors, NetworkResourceMonitorMetricThroughput, spec.Metric))
+		}
+		// interface names are limited to IFNAMSIZ-1 characters by the kernel
+		allErrs = append(allErrs, validation.ValidateString(spec.Interface, "spec.resources[].network.interface", 1, 15, nil, "")...)
+		allErrs = append(allErrs, validateAlertRules(spec.AlertRules, spec.SamplingInterval)...)
+	case "Application":
+		spec, err := r.AsApplicationResourceMonitorSpec()
+		if err != nil {
+			allErrs = append(allErrs, err)
+		}
+		allErrs = append(allErrs, validation.ValidateString(spec.Application, "spec.resources[].application.application", 1, 253, nil, "")...)
+		allErrs = append(allErrs, validateAlertRules(spec.AlertRules, spec.SamplingInterval)...)
 	default:
-		allErrs = append(allErrs, fmt.Errorf("unknown monitor type valid types are CPU, Disk and Memory: %s", monitorType))
+		allErrs = append(allErrs, fmt.Errorf("unknown monitor type valid types are CPU, Disk, Memory, Inode, Temperature, Network and Application: %s", monitorType))
 	}
 
 	return allErrs
@@ -387,6 +620,28 @@ func (c KubernetesSecretProviderSpec) Validate(fleetTemplate bool) []error {
 	return allErrs
 }
 
+func (c SecretProviderSpec) Validate(fleetTemplate bool) []error {
+	allErrs := []error{}
+	allErrs = append(allErrs, validation.ValidateConfigName(&c.Name, "spec.config[].name")...)
+
+	containsParams, paramErrs := validateParametersInString(&c.SecretResourceRef.Name, "spec.config[].secretResourceRef.name", fleetTemplate)
+	allErrs = append(allErrs, paramErrs...)
+	if !containsParams {
+		allErrs = append(allErrs, validation.ValidateResourceNameReference(&c.SecretResourceRef.Name, "spec.config[].secretResourceRef.name")...)
+	}
+
+	containsParams, paramErrs = validateParametersInString(&c.SecretResourceRef.MountPath, "spec.config[].secretResourceRef.mountPath", fleetTemplate)
+	allErrs = append(allErrs, paramErrs...)
+	if !containsParams {
+		allErrs = append(allErrs, validation.ValidateFilePath(&c.SecretResourceRef.MountPath, "spec.config[].secretResourceRef.mountPath")...)
+		if err := validation.DenyForbiddenDevicePath(c.SecretResourceRef.MountPath); err != nil {
+			allErrs = append(allErrs, fmt.Errorf("spec.config[].secretResourceRef.mountPath: %w", err))
+		}
+	}
+
+	return allErrs
+}
+
 func (c InlineConfigProviderSpec) Validate(fleetTemplate bool) []error {
 	allErrs := []error{}
 	allErrs = append(allErrs, validation.ValidateConfigName(&c.Name, "spec.config[].name")...)
@@ -409,13 +664,19 @@ func (c InlineConfigProviderSpec) Validate(fleetTemplate bool) []error {
 			allErrs = append(allErrs, validation.ValidateBase64Field(c.Inline[i].Content, fmt.Sprintf("spec.config[].inline[%d].content", i), maxInlineLength)...)
 			// Can ignore errors because we just validated it in the previous line
 			b, _ := base64.StdEncoding.DecodeString(c.Inline[i].Content)
-			_, paramErrs = validateParametersInString(lo.ToPtr(string(b)), "spec.config[].inline[%d].content", fleetTemplate)
+			containsParams, paramErrs = validateParametersInString(lo.ToPtr(string(b)), "spec.config[].inline[%d].content", fleetTemplate)
 			allErrs = append(allErrs, paramErrs...)
+			if !containsParams {
+				allErrs = append(allErrs, validateHookActionsFile(c.Inline[i].Path, b, fmt.Sprintf("spec.config[].inline[%d].content", i))...)
+			}
 		} else if c.Inline[i].ContentEncoding == nil || (c.Inline[i].ContentEncoding != nil && *(c.Inline[i].ContentEncoding) == EncodingPlain) {
 			// Contents should be limited to 1MB (1024*1024=1048576 bytes)
 			allErrs = append(allErrs, validation.ValidateString(&c.Inline[i].Content, fmt.Sprintf("spec.config[].inline[%d].content", i), 0, maxInlineLength, nil, "")...)
-			_, paramErrs = validateParametersInString(&c.Inline[i].Content, fmt.Sprintf("spec.config[].inline[%d].content", i), fleetTemplate)
+			containsParams, paramErrs = validateParametersInString(&c.Inline[i].Content, fmt.Sprintf("spec.config[].inline[%d].content", i), fleetTemplate)
 			allErrs = append(allErrs, paramErrs...)
+			if !containsParams {
+				allErrs = append(allErrs, validateHookActionsFile(c.Inline[i].Path, []byte(c.Inline[i].Content), fmt.Sprintf("spec.config[].inline[%d].content", i))...)
+			}
 		} else {
 			allErrs = append(allErrs, fmt.Errorf("unknown contentEncoding: %s", *(c.Inline[i].ContentEncoding)))
 		}
@@ -423,6 +684,26 @@ func (c InlineConfigProviderSpec) Validate(fleetTemplate bool) []error {
 	return allErrs
 }
 
+// hookActionsFileRegex matches the files from which the agent loads the actions of its lifecycle hooks.
+var hookActionsFileRegex = regexp.MustCompile(`^/etc/flightctl/hooks\.d/(beforeupdating|afterupdating|beforerebooting|afterrebooting)/[^/]+\.yaml$`)
+
+// validateHookActionsFile validates the actions of a lifecycle hook file, so that invalid actions are rejected
+// instead of failing the update on the device.
+func validateHookActionsFile(path string, content []byte, fieldPath string) []error {
+	if !hookActionsFileRegex.MatchString(path) {
+		return nil
+	}
+	actions := []HookAction{}
+	if err := yaml.UnmarshalStrict(content, &actions); err != nil {
+		return []error{fmt.Errorf("%s: invalid hook actions: %w", fieldPath, err)}
+	}
+	allErrs := []error{}
+	for i, action := range actions {
+		allErrs = append(allErrs, action.Validate(fmt.Sprintf("%s[%d]", fieldPath, i))...)
+	}
+	return allErrs
+}
+
 func (h HttpConfigProviderSpec) Validate(fleetTemplate bool) []error {
 	allErrs := []error{}
 	allErrs = append(allErrs, validation.ValidateConfigName(&h.Name, "spec.config[].name")...)
@@ -470,6 +751,162 @@ func (r EnrollmentRequestApproval) Validate() []error {
 	return allErrs
 }
 
+func (p EnrollmentPolicy) Validate() []error {
+	allErrs := []error{}
+	allErrs = append(allErrs, validation.ValidateResourceName(p.Metadata.Name)...)
+	allErrs = append(allErrs, validation.ValidateLabels(p.Metadata.Labels)...)
+	allErrs = append(allErrs, validation.ValidateAnnotations(p.Metadata.Annotations)...)
+
+	match := p.Spec.Match
+	hasRule := lo.FromPtr(match.TpmVerified)
+	for i := range lo.FromPtr(match.EnrollmentCertificates) {
+		allErrs = append(allErrs, validation.ValidateResourceNameReference(&(*match.EnrollmentCertificates)[i], fmt.Sprintf("spec.match.enrollmentCertificates[%d]", i))...)
+		hasRule = true
+	}
+	if match.AllowList != nil {
+		if len(lo.FromPtr(match.AllowList.ProductSerials)) == 0 && len(lo.FromPtr(match.AllowList.ProductUuids)) == 0 {
+			allErrs = append(allErrs, errors.New("spec.match.allowList must list at least one product serial or product UUID"))
+		}
+		hasRule = true
+	}
+	for key := range lo.FromPtr(match.SystemInfo) {
+		if key == "" {
+			allErrs = append(allErrs, errors.New("spec.match.systemInfo must not contain empty field names"))
+		}
+		hasRule = true
+	}
+	if !hasRule {
+		allErrs = append(allErrs, errors.New("spec.match must set at least one rule"))
+	}
+
+	if p.Spec.Approval != nil {
+		allErrs = append(allErrs, validation.ValidateLabelsWithPath(p.Spec.Approval.Labels, "spec.approval.labels")...)
+		if p.Spec.Approval.Fleet != nil {
+			allErrs = append(allErrs, validation.ValidateResourceNameReference(p.Spec.Approval.Fleet, "spec.approval.fleet")...)
+		}
+	}
+	return allErrs
+}
+
+// ValidateUpdate ensures immutable fields are unchanged for EnrollmentPolicy.
+func (p *EnrollmentPolicy) ValidateUpdate(newObj *EnrollmentPolicy) []error {
+	return validateImmutableCoreFields(p.Metadata.Name, newObj.Metadata.Name,
+		p.ApiVersion, newObj.ApiVersion,
+		p.Kind, newObj.Kind,
+		nil, nil)
+}
+
+// maxSecretDataSize is the maximum total size of the data of a Secret, as for Kubernetes secrets.
+const maxSecretDataSize = 1024 * 1024
+
+func (s Secret) Validate() []error {
+	allErrs := []error{}
+	allErrs = append(allErrs, validation.ValidateResourceName(s.Metadata.Name)...)
+	allErrs = append(allErrs, validation.ValidateLabels(s.Metadata.Labels)...)
+	allErrs = append(allErrs, validation.ValidateAnnotations(s.Metadata.Annotations)...)
+
+	if len(s.Spec.Data) == 0 {
+		allErrs = append(allErrs, errors.New("spec.data must contain at least one entry"))
+	}
+	size := 0
+	for _, key := range slices.Sorted(maps.Keys(s.Spec.Data)) {
+		path := fmt.Sprintf("spec.data[%s]", key)
+		allErrs = append(allErrs, validation.ValidateSecretDataKey(key, path)...)
+		value := s.Spec.Data[key].Value()
+		// values are redacted in responses, so writing back a fetched secret must not overwrite its data
+		if value == RedactedPlaceholder {
+			allErrs = append(allErrs, fmt.Errorf("%s: must be the actual value rather than the redacted placeholder", path))
+		}
+		size += len(key) + len(value)
+	}
+	if size > maxSecretDataSize {
+		allErrs = append(allErrs, fmt.Errorf("spec.data must not exceed %d bytes", maxSecretDataSize))
+	}
+	return allErrs
+}
+
+// trustPolicyScopePattern matches a registry host with an optional port and repository path, or a wildcard for the
+// subdomains of a domain.
+var trustPolicyScopePattern = regexp.MustCompile(`^(\*\.)?[a-z0-9]([a-z0-9-]*[a-z0-9])?(\.[a-z0-9]([a-z0-9-]*[a-z0-9])?)*(:[0-9]+)?(/[a-z0-9]+([._-][a-z0-9]+)*)*$`)
+
+func (p TrustPolicy) Validate() []error {
+	allErrs := []error{}
+	allErrs = append(allErrs, validation.ValidateResourceName(p.Metadata.Name)...)
+	allErrs = append(allErrs, validation.ValidateLabels(p.Metadata.Labels)...)
+	allErrs = append(allErrs, validation.ValidateAnnotations(p.Metadata.Annotations)...)
+	allErrs = append(allErrs, p.Spec.Validate("spec")...)
+	return allErrs
+}
+
+func (p *TrustPolicy) ValidateUpdate(newObj *TrustPolicy) []error {
+	return validateImmutableCoreFields(p.Metadata.Name, newObj.Metadata.Name,
+		p.ApiVersion, newObj.ApiVersion,
+		p.Kind, newObj.Kind,
+		nil, nil)
+}
+
+func (s TrustPolicySpec) Validate(path string) []error {
+	allErrs := []error{}
+	allErrs = append(allErrs, s.Default.Validate(path+".default")...)
+	scopes := map[string]struct{}{}
+	for i, scope := range lo.FromPtr(s.Scopes) {
+		scopePath := fmt.Sprintf("%s.scopes[%d]", path, i)
+		if !trustPolicyScopePattern.MatchString(scope.Scope) {
+			allErrs = append(allErrs, fmt.Errorf("%s.scope: invalid scope %q: must be a registry, a namespace or repository in a registry, or a wildcard such as *.example.com", scopePath, scope.Scope))
+		} else if strings.HasPrefix(scope.Scope, "*.") && strings.Contains(scope.Scope, "/") {
+			allErrs = append(allErrs, fmt.Errorf("%s.scope: invalid scope %q: wildcards match registries and must not contain a path", scopePath, scope.Scope))
+		} else if strings.HasPrefix(scope.Scope, "*.") && scope.Requirement.Lookaside != "" {
+			allErrs = append(allErrs, fmt.Errorf("%s.requirement.lookaside: must not be set for wildcard scope %q", scopePath, scope.Scope))
+		}
+		if _, ok := scopes[scope.Scope]; ok {
+			allErrs = append(allErrs, fmt.Errorf("%s.scope: duplicate scope %q", scopePath, scope.Scope))
+		}
+		scopes[scope.Scope] = struct{}{}
+		allErrs = append(allErrs, scope.Requirement.Validate(scopePath+".requirement")...)
+	}
+	return allErrs
+}
+
+func (r TrustRequirement) Validate(path string) []error {
+	allErrs := []error{}
+	switch r.Type {
+	case TrustRequirementTypeAccept, TrustRequirementTypeReject:
+		if len(r.Keys) > 0 {
+			allErrs = append(allErrs, fmt.Errorf("%s.keys: must not be set for type %s", path, r.Type))
+		}
+	case TrustRequirementTypeSigstoreSigned:
+		if len(r.Keys) == 0 {
+			allErrs = append(allErrs, fmt.Errorf("%s.keys: at least one key is required for type %s", path, r.Type))
+		}
+		for i, key := range r.Keys {
+			if block, _ := pem.Decode([]byte(key)); block == nil || block.Type != "PUBLIC KEY" {
+				allErrs = append(allErrs, fmt.Errorf("%s.keys[%d]: must be a PEM-encoded public key", path, i))
+			} else if _, err := x509.ParsePKIXPublicKey(block.Bytes); err != nil {
+				allErrs = append(allErrs, fmt.Errorf("%s.keys[%d]: invalid public key: %w", path, i, err))
+			}
+		}
+	case TrustRequirementTypeSignedBy:
+		if len(r.Keys) == 0 {
+			allErrs = append(allErrs, fmt.Errorf("%s.keys: at least one key is required for type %s", path, r.Type))
+		}
+		for i, key := range r.Keys {
+			if !strings.HasPrefix(strings.TrimSpace(key), "-----BEGIN PGP PUBLIC KEY BLOCK-----") {
+				allErrs = append(allErrs, fmt.Errorf("%s.keys[%d]: must be an ASCII-armored GPG public key", path, i))
+			}
+		}
+	default:
+		allErrs = append(allErrs, fmt.Errorf("%s.type: unsupported type %q", path, r.Type))
+	}
+	if r.Lookaside != "" {
+		if r.Type != TrustRequirementTypeSignedBy {
+			allErrs = append(allErrs, fmt.Errorf("%s.lookaside: must only be set for type %s", path, TrustRequirementTypeSignedBy))
+		} else if u, err := url.Parse(r.Lookaside); err != nil || !lo.Contains([]string{"http", "https", "file"}, u.Scheme) {
+			allErrs = append(allErrs, fmt.Errorf("%s.lookaside: must be an http, https or file URL", path))
+		}
+	}
+	return allErrs
+}
+
 func (r CertificateSigningRequest) Validate() []error {
 	allErrs := []error{}
 	allErrs = append(allErrs, validation.ValidateResourceName(r.Metadata.Name)...)
@@ -534,6 +971,39 @@ func (b BatchSequence) Validate() []error {
 	return errs
 }
 
+func (c Canary) Validate() []error {
+	var errs []error
+	previous := 0
+	for _, step := range lo.FromPtr(c.Steps) {
+		if err := validatePercentage(step); err != nil {
+			errs = append(errs, fmt.Errorf("canary step: %w", err))
+			continue
+		}
+		percentage, _ := util.PercentageAsInt(step)
+		if percentage <= previous {
+			errs = append(errs, fmt.Errorf("canary steps must be increasing and greater than 0%%: %s", step))
+		}
+		previous = percentage
+	}
+	errs = append(errs, validatePositiveDuration(c.BakeTime, "bakeTime")...)
+	return errs
+}
+
+func (l LabelWaves) Validate() []error {
+	var errs []error
+	if len(l.Values) == 0 {
+		errs = append(errs, errors.New("label waves must define at least one label value"))
+	}
+	if len(l.Values) != len(lo.Uniq(l.Values)) {
+		errs = append(errs, errors.New("label wave values must be unique"))
+	}
+	for _, value := range l.Values {
+		errs = append(errs, validation.ValidateLabelsWithPath(&map[string]string{l.LabelKey: value}, "labelKey")...)
+	}
+	errs = append(errs, validatePositiveDuration(l.BakeTime, "bakeTime")...)
+	return errs
+}
+
 func (r *RolloutDeviceSelection) Validate() []error {
 	var errs []error
 	if r == nil {
@@ -546,6 +1016,10 @@ func (r *RolloutDeviceSelection) Validate() []error {
 		switch v := i.(type) {
 		case BatchSequence:
 			errs = append(errs, v.Validate()...)
+		case Canary:
+			errs = append(errs, v.Validate()...)
+		case LabelWaves:
+			errs = append(errs, v.Validate()...)
 		}
 	}
 	return errs
@@ -581,9 +1055,39 @@ func (r *RolloutPolicy) Validate() []error {
 			errs = append(errs, fmt.Errorf("rollout policy success threshold: %w", err))
 		}
 	}
+	if (len(lo.FromPtr(r.Windows)) > 0 || len(lo.FromPtr(r.Blackouts)) > 0) && r.DeviceSelection == nil {
+		errs = append(errs, errors.New("rollout windows and blackouts require a device selection strategy"))
+	}
+	for i, window := range lo.FromPtr(r.Windows) {
+		errs = append(errs, window.Validate(fmt.Sprintf("rolloutPolicy.windows[%d]", i))...)
+	}
+	for i, blackout := range lo.FromPtr(r.Blackouts) {
+		errs = append(errs, blackout.Validate(fmt.Sprintf("rolloutPolicy.blackouts[%d]", i))...)
+	}
+	return errs
+}
+
+func (w RolloutWindow) Validate(path string) []error {
+	var errs []error
+	if w.TimeZone != nil {
+		errs = append(errs, validateTimeZone(*w.TimeZone)...)
+	}
+	// allow only the standard 5 input cron syntax e.g. "* * * * *"
+	parser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+	if _, err := parser.Parse(w.At); err != nil {
+		errs = append(errs, fmt.Errorf("%s.at: invalid cron schedule: %s", path, err))
+	}
+	errs = append(errs, validatePositiveDuration(&w.Duration, path+".duration")...)
 	return errs
 }
 
+func (b RolloutBlackout) Validate(path string) []error {
+	if !b.End.After(b.Start) {
+		return []error{fmt.Errorf("%s: end must be after start", path)}
+	}
+	return nil
+}
+
 func (r Fleet) Validate() []error {
 	allErrs := []error{}
 	allErrs = append(allErrs, validation.ValidateResourceName(r.Metadata.Name)...)
@@ -706,6 +1210,9 @@ func (r *Repository) Validate() []error {
 		if gitRepoSpec.SshConfig != nil {
 			allErrs = append(allErrs, validateSshConfig(gitRepoSpec.SshConfig)...)
 		}
+		if gitRepoSpec.Webhook != nil {
+			allErrs = append(allErrs, validateRepositoryWebhookConfig(gitRepoSpec.Webhook)...)
+		}
 	default:
 		allErrs = append(allErrs, fmt.Errorf("unknown repository type: %s", specType))
 	}
@@ -754,6 +1261,45 @@ func (rs *ResourceSync) ValidateUpdate(newObj *ResourceSync) []error {
 	return allErrs
 }
 
+var sha256HexDigestRegexp = regexp.MustCompile(`^[a-fA-F0-9]{64}$`)
+
+func (r ReferenceMeasurement) Validate() []error {
+	allErrs := []error{}
+	allErrs = append(allErrs, validation.ValidateResourceName(r.Metadata.Name)...)
+	allErrs = append(allErrs, validation.ValidateLabels(r.Metadata.Labels)...)
+	allErrs = append(allErrs, validation.ValidateAnnotations(r.Metadata.Annotations)...)
+	allErrs = append(allErrs, validation.ValidateOciImageReference(&r.Spec.OsImage, "spec.osImage")...)
+	if len(r.Spec.Pcrs) == 0 {
+		allErrs = append(allErrs, errors.New("spec.pcrs must contain at least one PCR"))
+	}
+	seen := make(map[int]struct{}, len(r.Spec.Pcrs))
+	for i, pcr := range r.Spec.Pcrs {
+		path := fmt.Sprintf("spec.pcrs[%d]", i)
+		if pcr.Index < 0 || pcr.Index > 23 {
+			allErrs = append(allErrs, fmt.Errorf("%s.index must be between 0 and 23, got %d", path, pcr.Index))
+		}
+		if _, ok := seen[pcr.Index]; ok {
+			allErrs = append(allErrs, fmt.Errorf("%s.index %d is listed more than once", path, pcr.Index))
+		}
+		seen[pcr.Index] = struct{}{}
+		if len(pcr.Digests) == 0 {
+			allErrs = append(allErrs, fmt.Errorf("%s.digests must contain at least one digest", path))
+		}
+		for j := range pcr.Digests {
+			allErrs = append(allErrs, validation.ValidateString(&pcr.Digests[j], fmt.Sprintf("%s.digests[%d]", path, j), 64, 64, sha256HexDigestRegexp, "hex-encoded SHA-256 digest")...)
+		}
+	}
+	return allErrs
+}
+
+// ValidateUpdate ensures immutable fields are unchanged for ReferenceMeasurement.
+func (r *ReferenceMeasurement) ValidateUpdate(newObj *ReferenceMeasurement) []error {
+	return validateImmutableCoreFields(r.Metadata.Name, newObj.Metadata.Name,
+		r.ApiVersion, newObj.ApiVersion,
+		r.Kind, newObj.Kind,
+		nil, nil)
+}
+
 func (tv TemplateVersion) Validate() []error {
 	allErrs := []error{}
 	allErrs = append(allErrs, validation.ValidateResourceName(tv.Metadata.Name)...)
@@ -808,6 +1354,14 @@ func validateHttpConfig(config *HttpConfig) []error {
 	return errs
 }
 
+func validateRepositoryWebhookConfig(config *RepositoryWebhookConfig) []error {
+	// the masked secret of an existing repository is restored after validation
+	if config.Secret == MaskedValuePlaceholder {
+		return nil
+	}
+	return validation.ValidateString(&config.Secret, "spec.webhook.secret", 16, 256, nil, "")
+}
+
 func validateSshConfig(config *SshConfig) []error {
 	var errs []error
 	if config != nil {
@@ -954,6 +1508,48 @@ func validateApplications(apps []ApplicationProviderSpec, fleetTemplate bool) []
 		}
 	}
 
+	// dependencies may refer to applications declared later, so they are checked once all names are known
+	for _, app := range apps {
+		appName, err := ensureAppName(app)
+		if err != nil {
+			continue
+		}
+		allErrs = append(allErrs, validateApplicationDependencies(app, appName, seenAppNames)...)
+	}
+
+	return allErrs
+}
+
+func validateApplicationDependencies(app ApplicationProviderSpec, appName string, appNames map[string]struct{}) []error {
+	dependsOn, err := app.GetDependsOn()
+	if err != nil {
+		return []error{fmt.Errorf("invalid application %s: %w", appName, err)}
+	}
+
+	allErrs := []error{}
+	seen := make(map[string]struct{}, len(dependsOn))
+	for i, dep := range dependsOn {
+		path := fmt.Sprintf("spec.applications[%s].dependsOn[%d]", appName, i)
+		switch {
+		case dep.Name == appName:
+			allErrs = append(allErrs, fmt.Errorf("%s.name: application cannot depend on itself", path))
+		case !lo.HasKey(appNames, dep.Name):
+			allErrs = append(allErrs, fmt.Errorf("%s.name: unknown application %q", path, dep.Name))
+		}
+		if _, exists := seen[dep.Name]; exists {
+			allErrs = append(allErrs, fmt.Errorf("%s.name: duplicate dependency %q", path, dep.Name))
+		}
+		seen[dep.Name] = struct{}{}
+
+		if dep.Condition != nil {
+			switch *dep.Condition {
+			case ApplicationDependencyConditionStarted, ApplicationDependencyConditionHealthy:
+			default:
+				allErrs = append(allErrs, fmt.Errorf("%s.condition: must be %q or %q", path,
+					ApplicationDependencyConditionStarted, ApplicationDependencyConditionHealthy))
+			}
+		}
+	}
 	return allErrs
 }
 
@@ -1112,8 +1708,8 @@ func validateApplicationVolumes(volumes *[]ApplicationVolume, appName string, ap
 func validateVolume(vol ApplicationVolume, path string, fleetTemplate bool, appType AppType) []error {
 	var errs []error
 
-	if vol.ReclaimPolicy != nil && *vol.ReclaimPolicy != Retain {
-		errs = append(errs, fmt.Errorf("%s.reclaimPolicy: only %q is supported", path, Retain))
+	if vol.ReclaimPolicy != nil && *vol.ReclaimPolicy != Retain && *vol.ReclaimPolicy != Delete {
+		errs = append(errs, fmt.Errorf("%s.reclaimPolicy: must be %q or %q", path, Retain, Delete))
 	}
 
 	providerType, err := vol.Type()
@@ -1121,6 +1717,11 @@ func validateVolume(vol ApplicationVolume, path string, fleetTemplate bool, appT
 		return []error{fmt.Errorf("invalid application volume provider: %w", err)}
 	}
 
+	// image backed volumes are recreated from their image, only mount volumes hold data worth restoring
+	if vol.SnapshotBeforeUpdate != nil && *vol.SnapshotBeforeUpdate && providerType != MountApplicationVolumeProviderType {
+		errs = append(errs, fmt.Errorf("%s.snapshotBeforeUpdate: only supported for mount volumes", path))
+	}
+
 	switch providerType {
 	case ImageApplicationVolumeProviderType:
 		imgProvider, err := vol.AsImageVolumeProviderSpec()
@@ -1213,6 +1814,8 @@ func validatePodmanCPULimit(cpu *string, path string) []error {
 	return errs
 }
 
+var deviceVariableNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]{0,62}$`)
+
 var podmanMemoryLimitPattern = regexp.MustCompile(`^[0-9]+[bkmg]?$`)
 
 func validatePodmanMemoryLimit(memory *string, path string) []error {
@@ -1427,8 +2030,8 @@ func validateParametersInString(s *string, path string, fleetTemplate bool) (boo
 		}
 	}
 
-	// When the template is executed here, any missing label/annotation keys are evaluated to empty
-	// strings, so an empty map is fine.
+	// When the template is executed here, any missing label, variable or system info keys are evaluated
+	// to empty strings, so empty maps are fine.
 	dev := &Device{
 		Metadata: ObjectMeta{
 			Name:   lo.ToPtr("name"),
@@ -2,7 +2,13 @@ package v1beta1
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
 	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
 	"strings"
 	"testing"
 
@@ -402,6 +408,36 @@ func TestValidateParametersInString(t *testing.T) {
 			containsParams: true,
 			expectError:    0,
 		},
+		{
+			name:           "variable access",
+			paramString:    "{{ .spec.variables.gateway }}",
+			containsParams: true,
+			expectError:    0,
+		},
+		{
+			name:           "system info access",
+			paramString:    "{{ .status.systemInfo.architecture }}-{{ .status.systemInfo.customInfo.site }}",
+			containsParams: true,
+			expectError:    0,
+		},
+		{
+			name:           "accessing non-exposed status field fails",
+			paramString:    "{{ .status.conditions.key }}",
+			containsParams: true,
+			expectError:    1,
+		},
+		{
+			name:           "chained functions",
+			paramString:    "{{ .spec.variables.dns | default \"8.8.8.8\" | split \",\" | join \" \" | b64enc }}",
+			containsParams: true,
+			expectError:    0,
+		},
+		{
+			name:           "invalid regex",
+			paramString:    "{{ regexMatch \"(\" .metadata.name }}",
+			containsParams: true,
+			expectError:    1,
+		},
 		{
 			name:           "missing function",
 			paramString:    "{{ badfunction .metadata.labels \"key\" }}",
@@ -1081,6 +1117,50 @@ func TestValidateApplications(t *testing.T) {
 			},
 			wantErrs: []string{"must be in format 'number[unit]' where unit is b, k, m, or g"},
 		},
+		{
+			name: "dependency on application declared later - valid",
+			apps: []ApplicationProviderSpec{
+				newTestApplicationWithDependencies(require, "api", ApplicationDependency{Name: "db"}),
+				newTestApplicationWithDependencies(require, "db"),
+			},
+		},
+		{
+			name: "dependency with started condition - valid",
+			apps: []ApplicationProviderSpec{
+				newTestApplicationWithDependencies(require, "db"),
+				newTestApplicationWithDependencies(require, "api", ApplicationDependency{Name: "db", Condition: lo.ToPtr(ApplicationDependencyConditionStarted)}),
+			},
+		},
+		{
+			name: "dependency on unknown application",
+			apps: []ApplicationProviderSpec{
+				newTestApplicationWithDependencies(require, "api", ApplicationDependency{Name: "db"}),
+			},
+			wantErrs: []string{`spec.applications[api].dependsOn[0].name: unknown application "db"`},
+		},
+		{
+			name: "dependency on itself",
+			apps: []ApplicationProviderSpec{
+				newTestApplicationWithDependencies(require, "api", ApplicationDependency{Name: "api"}),
+			},
+			wantErrs: []string{"spec.applications[api].dependsOn[0].name: application cannot depend on itself"},
+		},
+		{
+			name: "duplicate dependency",
+			apps: []ApplicationProviderSpec{
+				newTestApplicationWithDependencies(require, "db"),
+				newTestApplicationWithDependencies(require, "api", ApplicationDependency{Name: "db"}, ApplicationDependency{Name: "db"}),
+			},
+			wantErrs: []string{`spec.applications[api].dependsOn[1].name: duplicate dependency "db"`},
+		},
+		{
+			name: "dependency with invalid condition",
+			apps: []ApplicationProviderSpec{
+				newTestApplicationWithDependencies(require, "db"),
+				newTestApplicationWithDependencies(require, "api", ApplicationDependency{Name: "db", Condition: lo.ToPtr(ApplicationDependencyCondition("Running"))}),
+			},
+			wantErrs: []string{"spec.applications[api].dependsOn[0].condition: must be"},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1172,6 +1252,22 @@ func newTestApplicationWithVolume(require *require.Assertions, name string, appT
 	return app
 }
 
+func newTestApplicationWithDependencies(require *require.Assertions, name string, dependsOn ...ApplicationDependency) ApplicationProviderSpec {
+	var app ApplicationProviderSpec
+
+	containerApp := ContainerApplication{
+		Name:    lo.ToPtr(name),
+		AppType: AppTypeContainer,
+		Image:   "quay.io/app/" + name + ":1",
+	}
+	if len(dependsOn) > 0 {
+		containerApp.DependsOn = &dependsOn
+	}
+	require.NoError(app.FromContainerApplication(containerApp))
+
+	return app
+}
+
 func newTestApplicationWithPortsAndResources(require *require.Assertions, name string, appImage string, ports []string, resources *ApplicationResources) ApplicationProviderSpec {
 	var app ApplicationProviderSpec
 
@@ -1306,14 +1402,13 @@ func TestValidateVolumeAppTypeCompatibility(t *testing.T) {
 func TestValidateVolumeReclaimPolicy(t *testing.T) {
 	require := require.New(t)
 
-	t.Run("delete reclaim policy unsupported", func(t *testing.T) {
+	t.Run("delete reclaim policy", func(t *testing.T) {
 		vol := createImageVolume(t, "data", "quay.io/test/image:v1")
-		policy := ApplicationVolumeReclaimPolicy("Delete")
+		policy := Delete
 		vol.ReclaimPolicy = &policy
 
 		errs := validateVolume(vol, "spec.applications[test].volumes[0]", false, AppTypeCompose)
-		require.NotEmpty(errs)
-		require.Contains(errs[0].Error(), "only \"Retain\" is supported")
+		require.Empty(errs)
 	})
 
 	t.Run("invalid reclaim policy value", func(t *testing.T) {
@@ -1327,6 +1422,27 @@ func TestValidateVolumeReclaimPolicy(t *testing.T) {
 	})
 }
 
+func TestValidateVolumeSnapshotBeforeUpdate(t *testing.T) {
+	require := require.New(t)
+
+	t.Run("mount volume", func(t *testing.T) {
+		vol := createMountVolume(t, "data", "/var/lib/data")
+		vol.SnapshotBeforeUpdate = lo.ToPtr(true)
+
+		errs := validateVolume(vol, "spec.applications[test].volumes[0]", false, AppTypeContainer)
+		require.Empty(errs)
+	})
+
+	t.Run("image mount volume", func(t *testing.T) {
+		vol := createImageMountVolume(t, "data", "quay.io/test/image:v1", "/var/lib/data")
+		vol.SnapshotBeforeUpdate = lo.ToPtr(true)
+
+		errs := validateVolume(vol, "spec.applications[test].volumes[0]", false, AppTypeContainer)
+		require.Len(errs, 1)
+		require.Contains(errs[0].Error(), "snapshotBeforeUpdate: only supported for mount volumes")
+	})
+}
+
 func TestValidateResourceMonitor(t *testing.T) {
 	require := require.New(t)
 	tests := []struct {
@@ -1450,6 +1566,83 @@ func TestResourceMonitorValidate_CPUPathField(t *testing.T) {
 	}
 }
 
+func TestResourceMonitorValidate_MonitorFields(t *testing.T) {
+	require := require.New(t)
+	alertRules := []ResourceAlertRule{
+		{
+			Severity:    ResourceAlertSeverityTypeWarning,
+			Percentage:  80,
+			Duration:    "5m",
+			Description: "Usage above 80%",
+		},
+	}
+	tests := []struct {
+		name          string
+		monitor       func(*ResourceMonitor) error
+		errorContains string
+	}{
+		{
+			name: "valid Inode monitor",
+			monitor: func(m *ResourceMonitor) error {
+				return m.FromInodeResourceMonitorSpec(InodeResourceMonitorSpec{MonitorType: "Inode", Path: "/var", SamplingInterval: "30s", AlertRules: alertRules})
+			},
+		},
+		{
+			name: "valid Temperature monitor",
+			monitor: func(m *ResourceMonitor) error {
+				return m.FromTemperatureResourceMonitorSpec(TemperatureResourceMonitorSpec{MonitorType: "Temperature", Zone: lo.ToPtr("x86_pkg_temp"), CriticalTemperature: lo.ToPtr(95), SamplingInterval: "30s", AlertRules: alertRules})
+			},
+		},
+		{
+			name: "Temperature monitor with invalid critical temperature",
+			monitor: func(m *ResourceMonitor) error {
+				return m.FromTemperatureResourceMonitorSpec(TemperatureResourceMonitorSpec{MonitorType: "Temperature", CriticalTemperature: lo.ToPtr(0), SamplingInterval: "30s", AlertRules: alertRules})
+			},
+			errorContains: "criticalTemperature must be positive",
+		},
+		{
+			name: "valid Network monitor",
+			monitor: func(m *ResourceMonitor) error {
+				return m.FromNetworkResourceMonitorSpec(NetworkResourceMonitorSpec{MonitorType: "Network", Metric: NetworkResourceMonitorMetricErrors, Interface: lo.ToPtr("eth0"), SamplingInterval: "30s", AlertRules: alertRules})
+			},
+		},
+		{
+			name: "Network monitor with invalid interface",
+			monitor: func(m *ResourceMonitor) error {
+				return m.FromNetworkResourceMonitorSpec(NetworkResourceMonitorSpec{MonitorType: "Network", Metric: NetworkResourceMonitorMetricErrors, Interface: lo.ToPtr("a-very-long-interface"), SamplingInterval: "30s", AlertRules: alertRules})
+			},
+			errorContains: "spec.resources[].network.interface",
+		},
+		{
+			name: "Network monitor without metric",
+			monitor: func(m *ResourceMonitor) error {
+				return m.FromNetworkResourceMonitorSpec(NetworkResourceMonitorSpec{MonitorType: "Network", SamplingInterval: "30s", AlertRules: alertRules})
+			},
+			errorContains: "spec.resources[].network.metric",
+		},
+		{
+			name: "valid Application monitor",
+			monitor: func(m *ResourceMonitor) error {
+				return m.FromApplicationResourceMonitorSpec(ApplicationResourceMonitorSpec{MonitorType: "Application", Application: lo.ToPtr("web"), SamplingInterval: "30s", AlertRules: alertRules})
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var monitor ResourceMonitor
+			require.NoError(tt.monitor(&monitor))
+			errs := monitor.Validate()
+			if tt.errorContains != "" {
+				require.Len(errs, 1)
+				require.ErrorContains(errs[0], tt.errorContains)
+				return
+			}
+			require.Empty(errs, "expected no errors but got: %v", errs)
+		})
+	}
+}
+
 func TestDeviceSpecValidate_ResourceMonitors(t *testing.T) {
 	require := require.New(t)
 	tests := []struct {
@@ -2098,6 +2291,46 @@ func TestKubernetesSecretProviderSpec_Validate_ForbiddenPaths(t *testing.T) {
 	}
 }
 
+func TestKubernetesSecretProviderSpec_Validate_RequiresNamespace(t *testing.T) {
+	var spec KubernetesSecretProviderSpec
+	require.NoError(t, json.Unmarshal([]byte(`{"name": "credentials", "secretRef": {"name": "registry", "mountPath": "/etc/myapp/secrets"}}`), &spec))
+	require.NotEmpty(t, spec.Validate(false))
+
+	spec.SecretRef.Namespace = "default"
+	require.Empty(t, spec.Validate(false))
+}
+
+func TestSecretProviderSpec_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    string
+		wantErr bool
+	}{
+		{"valid", `{"name": "credentials", "secretResourceRef": {"name": "registry", "mountPath": "/etc/myapp/secrets"}}`, false},
+		{"invalid name", `{"name": "credentials", "secretResourceRef": {"name": "Not_Valid", "mountPath": "/etc/myapp/secrets"}}`, true},
+		{"forbidden mount path", `{"name": "credentials", "secretResourceRef": {"name": "registry", "mountPath": "/var/lib/flightctl/data"}}`, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var provider ConfigProviderSpec
+			require.NoError(t, json.Unmarshal([]byte(tt.spec), &provider))
+			providerType, err := provider.Type()
+			require.NoError(t, err)
+			require.Equal(t, SecretProviderType, providerType)
+
+			spec, err := provider.AsSecretProviderSpec()
+			require.NoError(t, err)
+			errs := spec.Validate(false)
+			if tt.wantErr {
+				require.NotEmpty(t, errs)
+			} else {
+				require.Empty(t, errs)
+			}
+		})
+	}
+}
+
 func newOciAuth(username, password string) *OciAuth {
 	auth := &OciAuth{}
 	_ = auth.FromDockerAuth(DockerAuth{
@@ -2320,3 +2553,341 @@ func TestRepository_Validate_BackwardCompatibility(t *testing.T) {
 		require.Empty(t, errs, "HttpRepoSpec should validate successfully")
 	})
 }
+
+func TestHookActionValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		json      string
+		expectErr bool
+	}{
+		{name: "run", json: `{"run": "/usr/bin/true"}`},
+		{name: "systemd", json: `{"systemd": "nginx.service", "operation": "restart"}`},
+		{name: "systemd with invalid operation", json: `{"systemd": "nginx.service", "operation": "enable"}`, expectErr: true},
+		{name: "systemd with invalid unit", json: `{"systemd": "nginx service", "operation": "restart"}`, expectErr: true},
+		{name: "restart application", json: `{"restartApplication": "my-app"}`},
+		{name: "restart application without name", json: `{"restartApplication": ""}`, expectErr: true},
+		{name: "wait for http", json: `{"waitForHttp": "http://localhost:8080/healthz", "interval": "2s"}`},
+		{name: "wait for http with invalid scheme", json: `{"waitForHttp": "ftp://localhost/"}`, expectErr: true},
+		{name: "wait for tcp", json: `{"waitForTcp": "localhost:5432"}`},
+		{name: "wait for tcp without port", json: `{"waitForTcp": "localhost"}`, expectErr: true},
+		{name: "expression condition", json: `{"run": "/usr/bin/true", "if": ["'app-a' in applicationsChanged && labels.region == 'eu'"]}`},
+		{name: "path condition", json: `{"run": "/usr/bin/true", "if": [{"path": "/etc/app/", "op": ["update"]}]}`},
+		{name: "malformed expression condition", json: `{"run": "/usr/bin/true", "if": ["rebooted &&"]}`, expectErr: true},
+		{name: "expression condition with unknown function", json: `{"run": "/usr/bin/true", "if": ["exists(osImage)"]}`, expectErr: true},
+		{name: "expression condition with wrong arity", json: `{"run": "/usr/bin/true", "if": ["lower(osImage, 'a') == 'b'"]}`, expectErr: true},
+		{name: "expression condition with unknown variable", json: `{"run": "/usr/bin/true", "if": ["labels.region == region"]}`, expectErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var action HookAction
+			require.NoError(t, action.UnmarshalJSON([]byte(tt.json)))
+			errs := action.Validate("action")
+			if tt.expectErr {
+				require.NotEmpty(t, errs)
+			} else {
+				require.Empty(t, errs)
+			}
+		})
+	}
+}
+
+func TestInlineConfigProviderSpec_Validate_HookActions(t *testing.T) {
+	tests := []struct {
+		name    string
+		path    string
+		content string
+		wantErr bool
+	}{
+		{"valid hook actions", "/etc/flightctl/hooks.d/afterupdating/10-app.yaml", "- systemd: nginx.service\n  operation: restart\n- waitForTcp: localhost:5432\n", false},
+		{"invalid systemd operation", "/etc/flightctl/hooks.d/afterupdating/10-app.yaml", "- systemd: nginx.service\n  operation: enable\n", true},
+		{"invalid wait for http", "/etc/flightctl/hooks.d/beforeupdating/10-app.yaml", "- waitForHttp: ftp://localhost/\n", true},
+		{"not a list of actions", "/etc/flightctl/hooks.d/afterrebooting/10-app.yaml", "run: /usr/bin/true\n", true},
+		{"not a hook file", "/etc/flightctl/hooks.d/afterupdating/README.md", "- systemd: nginx.service\n  operation: enable\n", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := InlineConfigProviderSpec{
+				Name:   "hooks",
+				Inline: []FileSpec{{Path: tt.path, Content: tt.content, Mode: lo.ToPtr(0644)}},
+			}
+
+			errs := spec.Validate(false)
+
+			if tt.wantErr {
+				require.NotEmpty(t, errs)
+			} else {
+				require.Empty(t, errs)
+			}
+		})
+	}
+}
+
+func TestDeviceHealthChecksValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		json      string
+		expectErr bool
+	}{
+		{name: "all probe types", json: `{"gracePeriod": "2m", "interval": "5s", "failureThreshold": 2, "probes": [
+			{"name": "http", "http": "https://localhost:8443/healthz", "skipServerVerification": true},
+			{"name": "tcp", "tcp": "localhost:5432", "timeout": "1s"},
+			{"name": "exec", "exec": "test -f /run/app/ready"},
+			{"name": "unit", "systemdUnit": "nginx.service"},
+			{"name": "app", "application": "my-app"}]}`},
+		{name: "no probes", json: `{"probes": []}`, expectErr: true},
+		{name: "duplicate probe names", json: `{"probes": [{"name": "a", "tcp": "localhost:1"}, {"name": "a", "tcp": "localhost:2"}]}`, expectErr: true},
+		{name: "probe without name", json: `{"probes": [{"tcp": "localhost:1"}]}`, expectErr: true},
+		{name: "unknown probe type", json: `{"probes": [{"name": "a", "grpc": "localhost:1"}]}`, expectErr: true},
+		{name: "invalid http url", json: `{"probes": [{"name": "a", "http": "localhost/healthz"}]}`, expectErr: true},
+		{name: "invalid tcp address", json: `{"probes": [{"name": "a", "tcp": "localhost"}]}`, expectErr: true},
+		{name: "invalid systemd unit", json: `{"probes": [{"name": "a", "systemdUnit": "nginx service"}]}`, expectErr: true},
+		{name: "zero grace period", json: `{"gracePeriod": "0s", "probes": [{"name": "a", "tcp": "localhost:1"}]}`, expectErr: true},
+		{name: "zero failure threshold", json: `{"failureThreshold": 0, "probes": [{"name": "a", "tcp": "localhost:1"}]}`, expectErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var healthChecks DeviceHealthChecks
+			require.NoError(t, json.Unmarshal([]byte(tt.json), &healthChecks))
+			errs := healthChecks.Validate("spec.healthChecks")
+			if tt.expectErr {
+				require.NotEmpty(t, errs)
+			} else {
+				require.Empty(t, errs)
+			}
+		})
+	}
+}
+
+func TestDeviceSpecValidate_Variables(t *testing.T) {
+	tests := []struct {
+		name          string
+		variables     map[string]string
+		fleetTemplate bool
+		expectErr     bool
+	}{
+		{name: "valid variables", variables: map[string]string{"gateway": "10.0.0.1", "_site_id": strings.Repeat("x", 4096)}},
+		{name: "name with dash", variables: map[string]string{"site-id": "a"}, expectErr: true},
+		{name: "name starting with digit", variables: map[string]string{"1site": "a"}, expectErr: true},
+		{name: "value too long", variables: map[string]string{"motd": strings.Repeat("x", maxDeviceVariableLength+1)}, expectErr: true},
+		{name: "variables in fleet template", variables: map[string]string{"gateway": "10.0.0.1"}, fleetTemplate: true, expectErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := DeviceSpec{Variables: &tt.variables}
+			errs := spec.Validate(tt.fleetTemplate)
+			if tt.expectErr {
+				require.NotEmpty(t, errs)
+			} else {
+				require.Empty(t, errs)
+			}
+		})
+	}
+}
+
+func TestRolloutDeviceSelectionValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		json      string
+		expectErr bool
+	}{
+		{name: "canary with defaults", json: `{"strategy": "Canary"}`},
+		{name: "canary with steps", json: `{"strategy": "Canary", "steps": ["10%", "50%", "100%"], "bakeTime": "30m"}`},
+		{name: "canary steps not increasing", json: `{"strategy": "Canary", "steps": ["10%", "5%"]}`, expectErr: true},
+		{name: "canary step out of range", json: `{"strategy": "Canary", "steps": ["150%"]}`, expectErr: true},
+		{name: "canary zero bake time", json: `{"strategy": "Canary", "bakeTime": "0s"}`, expectErr: true},
+		{name: "label waves", json: `{"strategy": "LabelWaves", "labelKey": "site", "values": ["lab", "store-1"], "bakeTime": "1h"}`},
+		{name: "label waves without values", json: `{"strategy": "LabelWaves", "labelKey": "site", "values": []}`, expectErr: true},
+		{name: "label waves duplicate values", json: `{"strategy": "LabelWaves", "labelKey": "site", "values": ["lab", "lab"]}`, expectErr: true},
+		{name: "label waves invalid key", json: `{"strategy": "LabelWaves", "labelKey": "-site", "values": ["lab"]}`, expectErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var selection RolloutDeviceSelection
+			require.NoError(t, json.Unmarshal([]byte(tt.json), &selection))
+			errs := selection.Validate()
+			if tt.expectErr {
+				require.NotEmpty(t, errs)
+			} else {
+				require.Empty(t, errs)
+			}
+		})
+	}
+}
+
+func TestRolloutPolicyWindowsValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		json      string
+		expectErr bool
+	}{
+		{name: "windows and blackouts", json: `{"deviceSelection": {"strategy": "Canary"},
+			"windows": [{"at": "0 22 * * 1-5", "duration": "4h", "timeZone": "Europe/Paris"}],
+			"blackouts": [{"name": "holiday freeze", "start": "2025-12-20T00:00:00Z", "end": "2026-01-02T00:00:00Z"}]}`},
+		{name: "windows without device selection", json: `{"disruptionBudget": {"maxUnavailable": 1},
+			"windows": [{"at": "0 22 * * *", "duration": "4h"}]}`, expectErr: true},
+		{name: "invalid cron", json: `{"deviceSelection": {"strategy": "Canary"}, "windows": [{"at": "0 22 * *", "duration": "4h"}]}`, expectErr: true},
+		{name: "zero duration", json: `{"deviceSelection": {"strategy": "Canary"}, "windows": [{"at": "0 22 * * *", "duration": "0s"}]}`, expectErr: true},
+		{name: "invalid time zone", json: `{"deviceSelection": {"strategy": "Canary"}, "windows": [{"at": "0 22 * * *", "duration": "4h", "timeZone": "Not/A/Zone/"}]}`, expectErr: true},
+		{name: "blackout ends before start", json: `{"deviceSelection": {"strategy": "Canary"},
+			"blackouts": [{"start": "2026-01-02T00:00:00Z", "end": "2025-12-20T00:00:00Z"}]}`, expectErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var policy RolloutPolicy
+			require.NoError(t, json.Unmarshal([]byte(tt.json), &policy))
+			errs := policy.Validate()
+			if tt.expectErr {
+				require.NotEmpty(t, errs)
+			} else {
+				require.Empty(t, errs)
+			}
+		})
+	}
+}
+
+func TestReferenceMeasurementValidate(t *testing.T) {
+	digest := strings.Repeat("ab", 32)
+	tests := []struct {
+		name      string
+		spec      string
+		expectErr bool
+	}{
+		{name: "valid", spec: `{"osImage": "quay.io/flightctl/os:v1", "pcrs": [{"index": 0, "digests": ["` + digest + `"]}], "quarantineOnFailure": true}`},
+		{name: "no pcrs", spec: `{"osImage": "quay.io/flightctl/os:v1", "pcrs": []}`, expectErr: true},
+		{name: "index out of range", spec: `{"osImage": "quay.io/flightctl/os:v1", "pcrs": [{"index": 24, "digests": ["` + digest + `"]}]}`, expectErr: true},
+		{name: "duplicate index", spec: `{"osImage": "quay.io/flightctl/os:v1", "pcrs": [{"index": 7, "digests": ["` + digest + `"]}, {"index": 7, "digests": ["` + digest + `"]}]}`, expectErr: true},
+		{name: "no digests", spec: `{"osImage": "quay.io/flightctl/os:v1", "pcrs": [{"index": 7, "digests": []}]}`, expectErr: true},
+		{name: "short digest", spec: `{"osImage": "quay.io/flightctl/os:v1", "pcrs": [{"index": 7, "digests": ["abcd"]}]}`, expectErr: true},
+		{name: "invalid os image", spec: `{"osImage": "not a reference", "pcrs": [{"index": 7, "digests": ["` + digest + `"]}]}`, expectErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rm := ReferenceMeasurement{Metadata: ObjectMeta{Name: lo.ToPtr("os-v1")}}
+			require.NoError(t, json.Unmarshal([]byte(tt.spec), &rm.Spec))
+			errs := rm.Validate()
+			if tt.expectErr {
+				require.NotEmpty(t, errs)
+			} else {
+				require.Empty(t, errs)
+			}
+		})
+	}
+}
+
+func TestEnrollmentPolicyValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		spec      string
+		expectErr bool
+	}{
+		{name: "valid", spec: `{"match": {"tpmVerified": true, "enrollmentCertificates": ["line-1"], "allowList": {"productSerials": ["SN1"]}, "systemInfo": {"architecture": "amd64"}}, "approval": {"labels": {"site": "a"}, "fleet": "gateways"}}`},
+		{name: "tpm only", spec: `{"match": {"tpmVerified": true}}`},
+		{name: "no rules", spec: `{"match": {}}`, expectErr: true},
+		{name: "tpm not required and nothing else", spec: `{"match": {"tpmVerified": false}}`, expectErr: true},
+		{name: "empty allow list", spec: `{"match": {"allowList": {}}}`, expectErr: true},
+		{name: "invalid certificate name", spec: `{"match": {"enrollmentCertificates": ["Not_Valid"]}}`, expectErr: true},
+		{name: "empty system info field", spec: `{"match": {"systemInfo": {"": "amd64"}}}`, expectErr: true},
+		{name: "invalid fleet", spec: `{"match": {"tpmVerified": true}, "approval": {"fleet": "Not_Valid"}}`, expectErr: true},
+		{name: "invalid label", spec: `{"match": {"tpmVerified": true}, "approval": {"labels": {"bad key!": "a"}}}`, expectErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := EnrollmentPolicy{Metadata: ObjectMeta{Name: lo.ToPtr("factory")}}
+			require.NoError(t, json.Unmarshal([]byte(tt.spec), &policy.Spec))
+			errs := policy.Validate()
+			if tt.expectErr {
+				require.NotEmpty(t, errs)
+			} else {
+				require.Empty(t, errs)
+			}
+		})
+	}
+}
+
+func TestSecretValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		data      map[string]SecureString
+		expectErr bool
+	}{
+		{name: "valid", data: map[string]SecureString{"auth.json": "{}", "token": "s3cr3t"}},
+		{name: "no data", data: map[string]SecureString{}, expectErr: true},
+		{name: "key with path separator", data: map[string]SecureString{"etc/token": "s3cr3t"}, expectErr: true},
+		{name: "dot key", data: map[string]SecureString{"..": "s3cr3t"}, expectErr: true},
+		{name: "redacted value", data: map[string]SecureString{"token": RedactedPlaceholder}, expectErr: true},
+		{name: "too large", data: map[string]SecureString{"token": SecureString(strings.Repeat("a", maxSecretDataSize))}, expectErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			secret := Secret{Metadata: ObjectMeta{Name: lo.ToPtr("credentials")}, Spec: SecretSpec{Data: tt.data}}
+			errs := secret.Validate()
+			if tt.expectErr {
+				require.NotEmpty(t, errs)
+			} else {
+				require.Empty(t, errs)
+			}
+		})
+	}
+}
+
+func TestTrustPolicyValidate(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+	cosignKey := string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+	gpgKey := "-----BEGIN PGP PUBLIC KEY BLOCK-----\n\nmQENBF...\n-----END PGP PUBLIC KEY BLOCK-----\n"
+
+	tests := []struct {
+		name      string
+		spec      TrustPolicySpec
+		expectErr bool
+	}{
+		{name: "accept everything", spec: TrustPolicySpec{Default: TrustRequirement{Type: TrustRequirementTypeAccept}}},
+		{name: "valid scopes", spec: TrustPolicySpec{
+			Default: TrustRequirement{Type: TrustRequirementTypeReject},
+			Scopes: &[]TrustPolicyScope{
+				{Scope: "quay.io/example", Requirement: TrustRequirement{Type: TrustRequirementTypeSigstoreSigned, Keys: []string{cosignKey}}},
+				{Scope: "registry.example.com:5000/os", Requirement: TrustRequirement{Type: TrustRequirementTypeSignedBy, Keys: []string{gpgKey}, Lookaside: "https://sigs.example.com"}},
+				{Scope: "*.internal.example.com", Requirement: TrustRequirement{Type: TrustRequirementTypeAccept}},
+			},
+		}},
+		{name: "unsupported type", spec: TrustPolicySpec{Default: TrustRequirement{Type: "Maybe"}}, expectErr: true},
+		{name: "sigstore without keys", spec: TrustPolicySpec{Default: TrustRequirement{Type: TrustRequirementTypeSigstoreSigned}}, expectErr: true},
+		{name: "sigstore with gpg key", spec: TrustPolicySpec{Default: TrustRequirement{Type: TrustRequirementTypeSigstoreSigned, Keys: []string{gpgKey}}}, expectErr: true},
+		{name: "signed by with cosign key", spec: TrustPolicySpec{Default: TrustRequirement{Type: TrustRequirementTypeSignedBy, Keys: []string{cosignKey}}}, expectErr: true},
+		{name: "accept with keys", spec: TrustPolicySpec{Default: TrustRequirement{Type: TrustRequirementTypeAccept, Keys: []string{cosignKey}}}, expectErr: true},
+		{name: "lookaside for sigstore", spec: TrustPolicySpec{Default: TrustRequirement{Type: TrustRequirementTypeSigstoreSigned, Keys: []string{cosignKey}, Lookaside: "https://sigs.example.com"}}, expectErr: true},
+		{name: "invalid scope", spec: TrustPolicySpec{
+			Default: TrustRequirement{Type: TrustRequirementTypeAccept},
+			Scopes:  &[]TrustPolicyScope{{Scope: "docker://quay.io/example", Requirement: TrustRequirement{Type: TrustRequirementTypeReject}}},
+		}, expectErr: true},
+		{name: "wildcard with path", spec: TrustPolicySpec{
+			Default: TrustRequirement{Type: TrustRequirementTypeAccept},
+			Scopes:  &[]TrustPolicyScope{{Scope: "*.example.com/app", Requirement: TrustRequirement{Type: TrustRequirementTypeReject}}},
+		}, expectErr: true},
+		{name: "wildcard with lookaside", spec: TrustPolicySpec{
+			Default: TrustRequirement{Type: TrustRequirementTypeAccept},
+			Scopes:  &[]TrustPolicyScope{{Scope: "*.example.com", Requirement: TrustRequirement{Type: TrustRequirementTypeSignedBy, Keys: []string{gpgKey}, Lookaside: "https://sigs.example.com"}}},
+		}, expectErr: true},
+		{name: "duplicate scope", spec: TrustPolicySpec{
+			Default: TrustRequirement{Type: TrustRequirementTypeAccept},
+			Scopes: &[]TrustPolicyScope{
+				{Scope: "quay.io", Requirement: TrustRequirement{Type: TrustRequirementTypeReject}},
+				{Scope: "quay.io", Requirement: TrustRequirement{Type: TrustRequirementTypeAccept}},
+			},
+		}, expectErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := TrustPolicy{Metadata: ObjectMeta{Name: lo.ToPtr("default")}, Spec: tt.spec}
+			errs := policy.Validate()
+			if tt.expectErr {
+				require.NotEmpty(t, errs)
+			} else {
+				require.Empty(t, errs)
+			}
+		})
+	}
+}
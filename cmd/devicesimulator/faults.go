@@ -0,0 +1,305 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	baseclient "github.com/flightctl/flightctl/internal/client"
+	"github.com/samber/lo"
+)
+
+var errSimulatedOffline = errors.New("simulated network outage")
+
+// faultInjector is the transport of the management client of a simulated device. It injects the behaviors of
+// the cohort of the device by holding back rendered device specs and rewriting the status that the device reports,
+// so that the agent itself is unaware of the faults and keeps running normally.
+type faultInjector struct {
+	next       http.RoundTripper
+	deviceName string
+	cohort     *Cohort
+	summary    *scenarioSummary
+	now        func() time.Time
+
+	mu      sync.Mutex
+	started time.Time
+	offline bool
+	// the OS image and applications of the last rendered spec that was passed to the agent
+	appliedOSImage      string
+	appliedApplications map[string]string
+	// the update that is held back, and why
+	pendingVersion string
+	pendingSince   time.Time
+	pendingFailure string
+	pendingApps    []v1beta1.ApplicationProviderSpec
+}
+
+func newFaultInjector(next http.RoundTripper, deviceName string, cohort *Cohort, summary *scenarioSummary) *faultInjector {
+	return &faultInjector{
+		next:       next,
+		deviceName: deviceName,
+		cohort:     cohort,
+		summary:    summary,
+		now:        time.Now,
+	}
+}
+
+// withFaultInjection wraps the transport of the device's management client with a fault injector.
+func withFaultInjection(deviceName string, cohort *Cohort, summary *scenarioSummary) baseclient.HTTPClientOption {
+	return func(client *http.Client) error {
+		next := client.Transport
+		if next == nil {
+			next = http.DefaultTransport
+		}
+		client.Transport = newFaultInjector(next, deviceName, cohort, summary)
+		return nil
+	}
+}
+
+// UnwrapTransport allows HTTP client options to configure the wrapped transport.
+func (f *faultInjector) UnwrapTransport() http.RoundTripper {
+	return f.next
+}
+
+func (f *faultInjector) RoundTrip(req *http.Request) (*http.Response, error) {
+	if f.isOffline() {
+		f.summary.record(f.cohort.Name, eventRequestDroppedOffline)
+		return nil, errSimulatedOffline
+	}
+
+	devicePath := "/devices/" + f.deviceName
+	switch {
+	case req.Method == http.MethodGet && strings.HasSuffix(req.URL.Path, devicePath+"/rendered"):
+		return f.roundTripRenderedDevice(req)
+	case req.Method == http.MethodPut && strings.HasSuffix(req.URL.Path, devicePath+"/status"):
+		return f.roundTripStatus(req)
+	default:
+		return f.next.RoundTrip(req)
+	}
+}
+
+func (f *faultInjector) behavior(behaviorType BehaviorType) (Behavior, bool) {
+	for _, behavior := range f.cohort.Behaviors {
+		if behavior.Type == behaviorType && behavior.active(f.now().Sub(f.started)) {
+			return behavior, true
+		}
+	}
+	return Behavior{}, false
+}
+
+func (f *faultInjector) isOffline() bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.started.IsZero() {
+		f.started = f.now()
+	}
+	_, offline := f.behavior(BehaviorOffline)
+	if offline && !f.offline {
+		f.summary.record(f.cohort.Name, eventWentOffline)
+	}
+	f.offline = offline
+	return offline
+}
+
+// roundTripRenderedDevice holds back rendered device specs that the behaviors of the cohort fail or delay, by
+// answering that there is no new spec.
+func (f *faultInjector) roundTripRenderedDevice(req *http.Request) (*http.Response, error) {
+	resp, err := f.next.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusOK {
+		return resp, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	var device v1beta1.Device
+	if err := json.Unmarshal(body, &device); err != nil {
+		return resp, nil
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.holdBack(&device) {
+		return noContentResponse(req), nil
+	}
+	return resp, nil
+}
+
+// holdBack decides whether the agent must not see the rendered device spec. It must be called with the lock held.
+func (f *faultInjector) holdBack(device *v1beta1.Device) bool {
+	version := device.Version()
+	osImage := ""
+	var apps []v1beta1.ApplicationProviderSpec
+	if device.Spec != nil {
+		if device.Spec.Os != nil {
+			osImage = device.Spec.Os.Image
+		}
+		apps = lo.FromPtr(device.Spec.Applications)
+	}
+	appSpecs := applicationSpecs(apps)
+
+	if version != f.pendingVersion {
+		f.pendingVersion = version
+		f.pendingSince = f.now()
+		f.pendingFailure = ""
+		f.pendingApps = nil
+
+		if _, ok := f.behavior(BehaviorFailOSUpdate); ok && osImage != f.appliedOSImage {
+			f.pendingFailure = fmt.Sprintf("simulated failure updating the OS to %s, rolled back", osImage)
+			f.summary.record(f.cohort.Name, eventUpdateFailedOS)
+		} else if _, ok := f.behavior(BehaviorFailApplicationStart); ok && len(apps) > 0 && !maps.Equal(appSpecs, f.appliedApplications) {
+			f.pendingFailure = fmt.Sprintf("simulated failure starting applications %s", strings.Join(slices.Sorted(maps.Keys(appSpecs)), ", "))
+			f.pendingApps = apps
+			f.summary.record(f.cohort.Name, eventUpdateFailedApplication)
+		} else if _, ok := f.behavior(BehaviorSlowDownload); ok {
+			f.summary.record(f.cohort.Name, eventUpdateDelayed)
+		}
+	}
+
+	if f.pendingFailure != "" {
+		return true
+	}
+	if behavior, ok := f.behavior(BehaviorSlowDownload); ok && f.now().Sub(f.pendingSince) < time.Duration(behavior.Duration) {
+		return true
+	}
+
+	f.summary.record(f.cohort.Name, eventUpdateApplied)
+	f.appliedOSImage = osImage
+	f.appliedApplications = appSpecs
+	f.pendingVersion = ""
+	return false
+}
+
+// roundTripStatus rewrites the status that the agent reports according to the behaviors of the cohort.
+func (f *faultInjector) roundTripStatus(req *http.Request) (*http.Response, error) {
+	f.summary.record(f.cohort.Name, eventStatusReported)
+	if req.Body == nil {
+		return f.next.RoundTrip(req)
+	}
+	body, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	var device v1beta1.Device
+	if err := json.Unmarshal(body, &device); err == nil && device.Status != nil {
+		f.mu.Lock()
+		modified := f.rewriteStatus(device.Status)
+		f.mu.Unlock()
+		if modified {
+			if rewritten, err := json.Marshal(device); err == nil {
+				body = rewritten
+				f.summary.record(f.cohort.Name, eventStatusReportModified)
+			}
+		}
+	}
+
+	req = req.Clone(req.Context())
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	req.ContentLength = int64(len(body))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+	return f.next.RoundTrip(req)
+}
+
+// rewriteStatus applies the behaviors of the cohort to the status of the device and returns whether it changed
+// anything. It must be called with the lock held.
+func (f *faultInjector) rewriteStatus(status *v1beta1.DeviceStatus) bool {
+	modified := false
+
+	if _, ok := f.behavior(BehaviorCPUCritical); ok {
+		status.Resources.Cpu = v1beta1.DeviceResourceStatusCritical
+		modified = true
+	}
+
+	if _, ok := f.behavior(BehaviorDegradedApplications); ok {
+		for i := range status.Applications {
+			status.Applications[i].Status = v1beta1.ApplicationStatusRunning
+			status.Applications[i].Ready = "0/1"
+		}
+		status.ApplicationsSummary.Status = v1beta1.ApplicationsSummaryStatusDegraded
+		status.ApplicationsSummary.Info = lo.ToPtr("Simulated degraded applications")
+		modified = true
+	}
+
+	switch {
+	case f.pendingVersion != "" && f.pendingFailure != "":
+		for _, app := range f.pendingApps {
+			name, _ := app.GetName()
+			appType, _ := app.GetAppType()
+			status.Applications = append(status.Applications, v1beta1.DeviceApplicationStatus{
+				Name:    lo.FromPtr(name),
+				AppType: appType,
+				Status:  v1beta1.ApplicationStatusError,
+				Ready:   "0/1",
+			})
+		}
+		if len(f.pendingApps) > 0 {
+			status.ApplicationsSummary.Status = v1beta1.ApplicationsSummaryStatusError
+			status.ApplicationsSummary.Info = lo.ToPtr(f.pendingFailure)
+		}
+		status.Updated.Status = v1beta1.DeviceUpdatedStatusOutOfDate
+		v1beta1.SetStatusCondition(&status.Conditions, v1beta1.Condition{
+			Type:    v1beta1.ConditionTypeDeviceUpdating,
+			Status:  v1beta1.ConditionStatusFalse,
+			Reason:  string(v1beta1.UpdateStateError),
+			Message: fmt.Sprintf("Failed to update to renderedVersion: %s: %s", f.pendingVersion, f.pendingFailure),
+		})
+		modified = true
+	case f.pendingVersion != "":
+		status.Updated.Status = v1beta1.DeviceUpdatedStatusUpdating
+		v1beta1.SetStatusCondition(&status.Conditions, v1beta1.Condition{
+			Type:    v1beta1.ConditionTypeDeviceUpdating,
+			Status:  v1beta1.ConditionStatusTrue,
+			Reason:  string(v1beta1.UpdateStatePreparing),
+			Message: fmt.Sprintf("Downloading the images of renderedVersion: %s", f.pendingVersion),
+		})
+		modified = true
+	}
+	return modified
+}
+
+// applicationSpecs returns the serialized spec of each application by name, so that updates that change
+// an application, such as its image, are told apart from updates that leave it as is.
+func applicationSpecs(apps []v1beta1.ApplicationProviderSpec) map[string]string {
+	specs := make(map[string]string, len(apps))
+	for _, app := range apps {
+		name, err := app.GetName()
+		if err != nil || name == nil {
+			continue
+		}
+		spec, err := json.Marshal(app)
+		if err != nil {
+			continue
+		}
+		specs[*name] = string(spec)
+	}
+	return specs
+}
+
+func noContentResponse(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:     fmt.Sprintf("%d %s", http.StatusNoContent, http.StatusText(http.StatusNoContent)),
+		StatusCode: http.StatusNoContent,
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header:     http.Header{},
+		Body:       http.NoBody,
+		Request:    req,
+	}
+}
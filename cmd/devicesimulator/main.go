@@ -79,6 +79,7 @@ func main() {
 	agentStartupJitter := pflag.Duration("agent-startup-jitter", -1*time.Second, "maximum random delay when starting agents (negative = use status-update-interval, 0 = no jitter, positive = custom duration)")
 	versionFormat := pflag.StringP("output", "o", "", fmt.Sprintf("Output format. One of: (%s). Default: text format", strings.Join(outputTypes, ", ")))
 	logLevel := pflag.StringP("log-level", "v", "debug", "logger verbosity level (one of \"fatal\", \"error\", \"warn\", \"warning\", \"info\", \"debug\")")
+	scenarioFile := pflag.String("scenario", "", "path of a scenario file that assigns faulty behaviors to percentages of the simulated devices")
 
 	pflag.Usage = printUsage
 
@@ -135,13 +136,28 @@ func main() {
 
 	formattedLables := formatLabels(labels)
 
+	// without a scenario, all devices belong to the default cohort and behave perfectly
+	scenario := &Scenario{}
+	if *scenarioFile != "" {
+		loaded, err := loadScenario(*scenarioFile)
+		if err != nil {
+			log.Fatalf("Error loading scenario: %v", err)
+		}
+		scenario = loaded
+	}
+	cohorts := scenario.assignCohorts(*numDevices)
+	summary := newScenarioSummary(cohorts)
+
 	agentConfigTemplate := createAgentConfigTemplate(*dataDir, *configFile, *logLevel)
 
 	log.Infoln("starting device simulator")
 	defer log.Infoln("device simulator stopped")
 
 	log.Infoln("setting up metrics endpoint")
-	setupMetricsEndpoint(*metricsAddr)
+	setupMetricsEndpoint(*metricsAddr, summary)
+	if *scenarioFile != "" {
+		defer summary.log(log)
+	}
 
 	baseDir, err := client.DefaultFlightctlClientConfigPath()
 	if err != nil {
@@ -177,6 +193,8 @@ func main() {
 		agentConfigTemplate: agentConfigTemplate,
 		parsedSourceIPs:     parsedSourceIPs,
 		maxConcurrency:      *maxConcurrency,
+		cohorts:             cohorts,
+		summary:             summary,
 	})
 
 	sigShutdown := make(chan os.Signal, 1)
@@ -204,6 +222,7 @@ func main() {
 		log:             log,
 		serviceClient:   serviceClient.ClientWithResponses,
 		formattedLabels: formattedLables,
+		cohorts:         cohorts,
 		sem:             sem,
 		jitterDuration:  jitterDuration,
 	}
@@ -237,7 +256,8 @@ func launchAgent(ctx context.Context, i int, params agentLaunchParams) {
 	// leave the agent process running in the background
 	// when the agent is approved, we return and release the semaphore to allow other agents to onboard
 	go startAgent(ctx, params.agents[i], params.log, i)
-	approveAgent(ctx, params.log, params.serviceClient, params.agentFolders[i], params.formattedLabels)
+	labels := util.MergeLabels(*params.formattedLabels, map[string]string{cohortLabelKey: params.cohorts[i].Name})
+	approveAgent(ctx, params.log, params.serviceClient, params.agentFolders[i], &labels)
 }
 
 func reportVersion(versionFormat *string) error {
@@ -336,6 +356,8 @@ type createAgentsConfig struct {
 	agentConfigTemplate *agent_config.Config
 	parsedSourceIPs     []net.IP
 	maxConcurrency      int
+	cohorts             []*Cohort
+	summary             *scenarioSummary
 }
 
 type agentLaunchParams struct {
@@ -344,6 +366,7 @@ type agentLaunchParams struct {
 	log             *logrus.Logger
 	serviceClient   *apiClient.ClientWithResponses
 	formattedLabels *map[string]string
+	cohorts         []*Cohort
 	sem             *semaphore.Weighted
 	jitterDuration  time.Duration
 }
@@ -425,6 +448,11 @@ func createAgents(agentCfg createAgentsConfig) ([]*agent.Agent, []string) {
 			}))
 			logger.Infof("Agent %s assigned source IP: %s", agentName, sourceIP.String())
 		}
+		// The fault injector wraps the transport that the options above configure, so it has to be added last.
+		if cohort := agentCfg.cohorts[i]; len(cohort.Behaviors) > 0 {
+			cfg.ManagementService.Config.AddHTTPOptions(withFaultInjection(agentName, cohort, agentCfg.summary))
+			logger.Infof("Agent %s assigned to cohort %s", agentName, cohort.Name)
+		}
 		// The enrollment client configuration is the same for all agents and thus no need to create a new connection for
 		// each agent. By using the CachedTransport option, we let the agents setup their enrollment clients (and
 		// individual transports), and then swap out the transport such that all HTTP clients are using the same transport.
@@ -53,8 +53,9 @@ var (
 	)
 )
 
-func setupMetricsEndpoint(metricsAddress string) {
+func setupMetricsEndpoint(metricsAddress string, summary *scenarioSummary) {
 	http.Handle("/metrics", promhttp.Handler())
+	http.Handle("/scenario", summary)
 	srv := &http.Server{Addr: metricsAddress, ReadHeaderTimeout: time.Second}
 	go func() {
 		err := srv.ListenAndServe()
@@ -66,6 +67,8 @@ func setupMetricsEndpoint(metricsAddress string) {
 	prometheus.MustRegister(apiRequests)
 	prometheus.MustRegister(apiErrors)
 	prometheus.MustRegister(apiRequestDurations)
+	prometheus.MustRegister(cohortDevices)
+	prometheus.MustRegister(cohortEvents)
 }
 
 func rpcMetricsCallback(operation string, duractionSeconds float64, err error) {
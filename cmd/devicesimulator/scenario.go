@@ -0,0 +1,155 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"os"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/util"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// cohortLabelKey is the label that identifies the cohort of a simulated device
+	cohortLabelKey = "simulator-cohort"
+	// defaultCohortName is the cohort of the devices that are not assigned to any cohort of the scenario
+	defaultCohortName = "default"
+)
+
+// BehaviorType is a fault that simulated devices of a cohort inject.
+type BehaviorType string
+
+const (
+	// BehaviorFailApplicationStart fails updates that add or change applications, as if they failed to start.
+	BehaviorFailApplicationStart BehaviorType = "FailApplicationStart"
+	// BehaviorDegradedApplications reports the applications of the device as degraded.
+	BehaviorDegradedApplications BehaviorType = "DegradedApplications"
+	// BehaviorCPUCritical reports the CPU usage of the device as critical.
+	BehaviorCPUCritical BehaviorType = "CPUCritical"
+	// BehaviorOffline makes the device unreachable for a period.
+	BehaviorOffline BehaviorType = "Offline"
+	// BehaviorFailOSUpdate fails updates that change the OS image and rolls them back.
+	BehaviorFailOSUpdate BehaviorType = "FailOSUpdate"
+	// BehaviorSlowDownload delays every update as if its images took long to download.
+	BehaviorSlowDownload BehaviorType = "SlowDownload"
+)
+
+// Scenario assigns behaviors to percentages of the simulated devices.
+type Scenario struct {
+	// Seed makes the assignment of devices to cohorts reproducible.
+	Seed    uint64   `json:"seed,omitempty"`
+	Cohorts []Cohort `json:"cohorts"`
+}
+
+// Cohort is a group of simulated devices that share the same behaviors.
+type Cohort struct {
+	Name       string     `json:"name"`
+	Percentage float64    `json:"percentage"`
+	Behaviors  []Behavior `json:"behaviors"`
+}
+
+// Behavior is a fault that the devices of a cohort inject.
+type Behavior struct {
+	Type BehaviorType `json:"type"`
+	// After is how long after the device starts the behavior begins. Defaults to immediately.
+	After util.Duration `json:"after,omitempty"`
+	// Duration is how long the device is offline or an update takes to download.
+	Duration util.Duration `json:"duration,omitempty"`
+}
+
+func loadScenario(path string) (*Scenario, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading scenario %s: %w", path, err)
+	}
+	var scenario Scenario
+	if err := yaml.UnmarshalStrict(data, &scenario); err != nil {
+		return nil, fmt.Errorf("parsing scenario %s: %w", path, err)
+	}
+	if err := scenario.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid scenario %s: %w", path, err)
+	}
+	return &scenario, nil
+}
+
+func (s *Scenario) Validate() error {
+	var errs []error
+	names := map[string]struct{}{defaultCohortName: {}}
+	total := 0.0
+	for i, cohort := range s.Cohorts {
+		if msgs := validation.IsValidLabelValue(cohort.Name); cohort.Name == "" || len(msgs) > 0 {
+			errs = append(errs, fmt.Errorf("cohorts[%d].name %q must be a valid label value", i, cohort.Name))
+		}
+		if _, ok := names[cohort.Name]; ok {
+			errs = append(errs, fmt.Errorf("cohorts[%d].name %q is already used", i, cohort.Name))
+		}
+		names[cohort.Name] = struct{}{}
+		if cohort.Percentage <= 0 || cohort.Percentage > 100 {
+			errs = append(errs, fmt.Errorf("cohorts[%d].percentage must be greater than 0 and at most 100", i))
+		}
+		total += cohort.Percentage
+		for j, behavior := range cohort.Behaviors {
+			errs = append(errs, behavior.validate(fmt.Sprintf("cohorts[%d].behaviors[%d]", i, j))...)
+		}
+	}
+	if total > 100 {
+		errs = append(errs, fmt.Errorf("the percentages of all cohorts add up to %g, which is more than 100", total))
+	}
+	return errors.Join(errs...)
+}
+
+func (b Behavior) validate(path string) []error {
+	var errs []error
+	switch b.Type {
+	case BehaviorFailApplicationStart, BehaviorDegradedApplications, BehaviorCPUCritical, BehaviorFailOSUpdate:
+	case BehaviorOffline, BehaviorSlowDownload:
+		if b.Duration <= 0 {
+			errs = append(errs, fmt.Errorf("%s.duration is required for %s", path, b.Type))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("%s.type %q is not supported", path, b.Type))
+	}
+	if b.After < 0 {
+		errs = append(errs, fmt.Errorf("%s.after must not be negative", path))
+	}
+	return errs
+}
+
+// active returns whether a behavior applies at the given time since the device started.
+func (b Behavior) active(sinceStart time.Duration) bool {
+	if sinceStart < time.Duration(b.After) {
+		return false
+	}
+	if b.Type == BehaviorOffline {
+		return sinceStart < time.Duration(b.After)+time.Duration(b.Duration)
+	}
+	return true
+}
+
+// assignCohorts returns the cohort of each of the numDevices devices. Each cohort gets its percentage of the
+// devices, rounded down, and the devices are shuffled so that cohorts are spread over fleets and rollout batches
+// that select devices by name. Devices that are left over belong to the default cohort, which has no behaviors.
+func (s *Scenario) assignCohorts(numDevices int) []*Cohort {
+	defaultCohort := &Cohort{Name: defaultCohortName}
+	assignment := make([]*Cohort, numDevices)
+	next := 0
+	for i := range s.Cohorts {
+		count := int(float64(numDevices) * s.Cohorts[i].Percentage / 100)
+		for j := 0; j < count && next < numDevices; j++ {
+			assignment[next] = &s.Cohorts[i]
+			next++
+		}
+	}
+	for ; next < numDevices; next++ {
+		assignment[next] = defaultCohort
+	}
+
+	rng := rand.New(rand.NewPCG(s.Seed, s.Seed)) //nolint:gosec
+	rng.Shuffle(numDevices, func(i, j int) {
+		assignment[i], assignment[j] = assignment[j], assignment[i]
+	})
+	return assignment
+}
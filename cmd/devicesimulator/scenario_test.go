@@ -0,0 +1,192 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScenarioValidate(t *testing.T) {
+	valid := Scenario{Cohorts: []Cohort{
+		{Name: "bad-os", Percentage: 10, Behaviors: []Behavior{{Type: BehaviorFailOSUpdate}}},
+		{Name: "offline", Percentage: 90, Behaviors: []Behavior{{Type: BehaviorOffline, Duration: util.Duration(time.Minute)}}},
+	}}
+	require.NoError(t, valid.Validate())
+
+	invalid := Scenario{Cohorts: []Cohort{
+		{Name: "default", Percentage: 10},
+		{Name: "slow", Percentage: 95, Behaviors: []Behavior{{Type: BehaviorSlowDownload}, {Type: "Explode"}}},
+	}}
+	err := invalid.Validate()
+	require.ErrorContains(t, err, `cohorts[0].name "default" is already used`)
+	require.ErrorContains(t, err, "cohorts[1].behaviors[0].duration is required for SlowDownload")
+	require.ErrorContains(t, err, `cohorts[1].behaviors[1].type "Explode" is not supported`)
+	require.ErrorContains(t, err, "add up to 105")
+}
+
+func TestAssignCohorts(t *testing.T) {
+	scenario := Scenario{Seed: 7, Cohorts: []Cohort{
+		{Name: "a", Percentage: 25},
+		{Name: "b", Percentage: 10.5},
+	}}
+	assignment := scenario.assignCohorts(40)
+	counts := map[string]int{}
+	for _, cohort := range assignment {
+		counts[cohort.Name]++
+	}
+	require.Equal(t, map[string]int{"a": 10, "b": 4, defaultCohortName: 26}, counts)
+
+	// the same seed assigns the same devices
+	again := scenario.assignCohorts(40)
+	for i := range assignment {
+		require.Equal(t, assignment[i].Name, again[i].Name)
+	}
+}
+
+type fakeServer struct {
+	rendered   v1beta1.Device
+	lastStatus *v1beta1.Device
+}
+
+func (s *fakeServer) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method == http.MethodPut {
+		s.lastStatus = &v1beta1.Device{}
+		if err := json.NewDecoder(req.Body).Decode(s.lastStatus); err != nil {
+			return nil, err
+		}
+		return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+	}
+	body, err := json.Marshal(s.rendered)
+	if err != nil {
+		return nil, err
+	}
+	return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(body))}, nil
+}
+
+func renderedDevice(version string, osImage string) v1beta1.Device {
+	device := v1beta1.Device{
+		Metadata: v1beta1.ObjectMeta{
+			Name:        lo.ToPtr("device-00000"),
+			Annotations: &map[string]string{v1beta1.DeviceAnnotationRenderedVersion: version},
+		},
+		Spec: &v1beta1.DeviceSpec{},
+	}
+	if osImage != "" {
+		device.Spec.Os = &v1beta1.DeviceOsSpec{Image: osImage}
+	}
+	return device
+}
+
+func TestFaultInjector(t *testing.T) {
+	require := require.New(t)
+	now := time.Now()
+	cohort := &Cohort{Name: "faulty", Behaviors: []Behavior{
+		{Type: BehaviorFailOSUpdate},
+		{Type: BehaviorCPUCritical},
+		{Type: BehaviorOffline, After: util.Duration(time.Hour), Duration: util.Duration(time.Minute)},
+	}}
+	summary := newScenarioSummary([]*Cohort{cohort})
+	server := &fakeServer{rendered: renderedDevice("1", "")}
+	injector := newFaultInjector(server, "device-00000", cohort, summary)
+	injector.now = func() time.Time { return now }
+	client := &http.Client{Transport: injector}
+
+	getRendered := func() int {
+		resp, err := client.Get("https://api.example.com/api/v1/devices/device-00000/rendered")
+		require.NoError(err)
+		defer resp.Body.Close()
+		return resp.StatusCode
+	}
+	putStatus := func() {
+		device := renderedDevice("1", "")
+		device.Status = &v1beta1.DeviceStatus{}
+		body, err := json.Marshal(device)
+		require.NoError(err)
+		req, err := http.NewRequest(http.MethodPut, "https://api.example.com/api/v1/devices/device-00000/status", bytes.NewReader(body))
+		require.NoError(err)
+		resp, err := client.Do(req)
+		require.NoError(err)
+		resp.Body.Close()
+	}
+
+	// the initial spec does not change the OS and reaches the agent
+	require.Equal(http.StatusOK, getRendered())
+	putStatus()
+	require.Equal(v1beta1.DeviceResourceStatusCritical, server.lastStatus.Status.Resources.Cpu)
+	require.Nil(v1beta1.FindStatusCondition(server.lastStatus.Status.Conditions, v1beta1.ConditionTypeDeviceUpdating))
+
+	// an OS update is held back and reported as failed
+	server.rendered = renderedDevice("2", "quay.io/os:v2")
+	require.Equal(http.StatusNoContent, getRendered())
+	require.Equal(http.StatusNoContent, getRendered())
+	putStatus()
+	condition := v1beta1.FindStatusCondition(server.lastStatus.Status.Conditions, v1beta1.ConditionTypeDeviceUpdating)
+	require.NotNil(condition)
+	require.Equal(string(v1beta1.UpdateStateError), condition.Reason)
+	require.Equal(v1beta1.DeviceUpdatedStatusOutOfDate, server.lastStatus.Status.Updated.Status)
+
+	// rolling the fleet back to the previous OS clears the failure
+	server.rendered = renderedDevice("3", "")
+	require.Equal(http.StatusOK, getRendered())
+	putStatus()
+	require.Nil(v1beta1.FindStatusCondition(server.lastStatus.Status.Conditions, v1beta1.ConditionTypeDeviceUpdating))
+
+	// the device goes offline after an hour
+	now = now.Add(time.Hour)
+	_, err := client.Get("https://api.example.com/api/v1/devices/device-00000/rendered")
+	require.ErrorIs(err, errSimulatedOffline)
+
+	events := summary.snapshot()["faulty"].Events
+	require.Equal(int64(1), events[eventUpdateFailedOS])
+	require.Equal(int64(2), events[eventUpdateApplied])
+	require.Equal(int64(1), events[eventWentOffline])
+	require.Equal(int64(3), events[eventStatusReportModified])
+}
+
+func TestFaultInjectorApplicationStart(t *testing.T) {
+	require := require.New(t)
+	cohort := &Cohort{Name: "bad-apps", Behaviors: []Behavior{{Type: BehaviorFailApplicationStart}}}
+	summary := newScenarioSummary([]*Cohort{cohort})
+	withApp := func(version string, image string) v1beta1.Device {
+		device := renderedDevice(version, "")
+		var app v1beta1.ApplicationProviderSpec
+		require.NoError(app.FromContainerApplication(v1beta1.ContainerApplication{
+			AppType: v1beta1.AppTypeContainer,
+			Name:    lo.ToPtr("web"),
+			Image:   image,
+		}))
+		device.Spec.Applications = &[]v1beta1.ApplicationProviderSpec{app}
+		return device
+	}
+	server := &fakeServer{rendered: withApp("1", "quay.io/web:v1")}
+	injector := newFaultInjector(server, "device-00000", cohort, summary)
+	// the device already runs the application
+	injector.appliedApplications = applicationSpecs(lo.FromPtr(server.rendered.Spec.Applications))
+	client := &http.Client{Transport: injector}
+
+	getRendered := func() int {
+		resp, err := client.Get("https://api.example.com/api/v1/devices/device-00000/rendered")
+		require.NoError(err)
+		defer resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	// an update that leaves the application as is reaches the agent
+	require.Equal(http.StatusOK, getRendered())
+
+	// an update that changes the image of the application is held back
+	server.rendered = withApp("2", "quay.io/web:v2")
+	require.Equal(http.StatusNoContent, getRendered())
+
+	events := summary.snapshot()["bad-apps"].Events
+	require.Equal(int64(1), events[eventUpdateFailedApplication])
+	require.Equal(int64(1), events[eventUpdateApplied])
+}
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+)
+
+// Events that simulated devices experience, as counted in the scenario summary
+const (
+	eventWentOffline             = "went_offline"
+	eventRequestDroppedOffline   = "requests_dropped_offline"
+	eventUpdateApplied           = "updates_applied"
+	eventUpdateDelayed           = "updates_delayed"
+	eventUpdateFailedOS          = "updates_failed_os"
+	eventUpdateFailedApplication = "updates_failed_application_start"
+	eventStatusReported          = "status_reports"
+	eventStatusReportModified    = "status_reports_modified"
+)
+
+var (
+	cohortDevices = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricNamespace,
+			Subsystem: metricSubsystem,
+			Name:      "cohort_devices",
+			Help:      "Number of simulated devices in each cohort of the scenario",
+		},
+		[]string{"cohort"},
+	)
+	cohortEvents = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricNamespace,
+			Subsystem: metricSubsystem,
+			Name:      "cohort_events_total",
+			Help:      "Total number of events that the simulated devices of each cohort experienced, partitioned by event",
+		},
+		[]string{"cohort", "event"},
+	)
+)
+
+// CohortSummary is what the devices of a cohort experienced during the simulation.
+type CohortSummary struct {
+	Devices   int              `json:"devices"`
+	Behaviors []BehaviorType   `json:"behaviors"`
+	Events    map[string]int64 `json:"events"`
+}
+
+// scenarioSummary counts the events of each cohort. It is published as metrics and on the summary endpoint,
+// and logged when the simulator stops.
+type scenarioSummary struct {
+	mu      sync.Mutex
+	cohorts map[string]*CohortSummary
+}
+
+func newScenarioSummary(assignment []*Cohort) *scenarioSummary {
+	s := &scenarioSummary{cohorts: map[string]*CohortSummary{}}
+	for _, cohort := range assignment {
+		summary, ok := s.cohorts[cohort.Name]
+		if !ok {
+			summary = &CohortSummary{Behaviors: []BehaviorType{}, Events: map[string]int64{}}
+			for _, behavior := range cohort.Behaviors {
+				summary.Behaviors = append(summary.Behaviors, behavior.Type)
+			}
+			s.cohorts[cohort.Name] = summary
+		}
+		summary.Devices++
+	}
+	for name, summary := range s.cohorts {
+		cohortDevices.WithLabelValues(name).Set(float64(summary.Devices))
+	}
+	return s
+}
+
+func (s *scenarioSummary) record(cohort string, event string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if summary, ok := s.cohorts[cohort]; ok {
+		summary.Events[event]++
+	}
+	cohortEvents.WithLabelValues(cohort, event).Inc()
+}
+
+func (s *scenarioSummary) snapshot() map[string]CohortSummary {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	snapshot := make(map[string]CohortSummary, len(s.cohorts))
+	for name, summary := range s.cohorts {
+		events := make(map[string]int64, len(summary.Events))
+		for event, count := range summary.Events {
+			events[event] = count
+		}
+		snapshot[name] = CohortSummary{Devices: summary.Devices, Behaviors: summary.Behaviors, Events: events}
+	}
+	return snapshot
+}
+
+// ServeHTTP publishes the summary as JSON.
+func (s *scenarioSummary) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(s.snapshot())
+}
+
+func (s *scenarioSummary) log(log *logrus.Logger) {
+	snapshot := s.snapshot()
+	names := make([]string, 0, len(snapshot))
+	for name := range snapshot {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	log.Infoln("scenario summary:")
+	for _, name := range names {
+		summary := snapshot[name]
+		log.Infof("  cohort %s: %d devices, behaviors %v", name, summary.Devices, summary.Behaviors)
+		events := make([]string, 0, len(summary.Events))
+		for event := range summary.Events {
+			events = append(events, event)
+		}
+		sort.Strings(events)
+		for _, event := range events {
+			log.Infof("    %s: %d", event, summary.Events[event])
+		}
+	}
+}
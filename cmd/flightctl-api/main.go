@@ -42,7 +42,7 @@ func main() {
 	defer log.Println("API service stopped")
 	log.Printf("Using config: %s", cfg)
 
-	ca, err := crypto.LoadInternalCA(cfg.CA)
+	ca, err := crypto.LoadCA(cfg.CA)
 	if err != nil {
 		log.Fatalf("loading client-signer certificates: %v", err)
 	}
@@ -66,8 +66,13 @@ func main() {
 		log.Fatalf("initializing data store: %v", err)
 	}
 
-	store := store.NewStore(db, log.WithField("pkg", "store"))
+	secretEnvelope, err := cfg.SecretEnvelope()
+	if err != nil {
+		log.Fatalf("initializing secret encryption: %v", err)
+	}
+	store := store.NewStore(db, log.WithField("pkg", "store"), store.WithSecretEnvelope(secretEnvelope))
 	defer store.Close()
+	caClient.SetRevocationStore(store.CertificateRevocation())
 
 	tlsConfig, agentTlsConfig, err := crypto.TLSConfigForServer(ca.GetCABundleX509(), serverCerts)
 	if err != nil {
@@ -76,7 +76,7 @@ func main() {
 
 	// Initialize CA client for generating enrollment credentials
 	log.Println("Initializing CA client")
-	ca, err := crypto.LoadInternalCA(cfg.CA)
+	ca, err := crypto.LoadCA(cfg.CA)
 	if err != nil {
 		log.Fatalf("loading CA certificates: %v", err)
 	}
@@ -39,7 +39,7 @@ func main() {
 		log.Fatalf("PAM OIDC issuer not configured")
 	}
 
-	ca, err := crypto.LoadInternalCA(cfg.CA)
+	ca, err := crypto.LoadCA(cfg.CA)
 	if err != nil {
 		log.Fatalf("loading client-signer certificates: %v", err)
 	}
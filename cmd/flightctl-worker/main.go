@@ -50,7 +50,11 @@ func main() {
 		log.Fatalf("initializing data store: %v", err)
 	}
 
-	store := store.NewStore(db, log.WithField("pkg", "store"))
+	secretEnvelope, err := cfg.SecretEnvelope()
+	if err != nil {
+		log.Fatalf("initializing secret encryption: %v", err)
+	}
+	store := store.NewStore(db, log.WithField("pkg", "store"), store.WithSecretEnvelope(secretEnvelope))
 	defer store.Close()
 
 	ctx, cancel := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGHUP, syscall.SIGTERM, syscall.SIGQUIT)
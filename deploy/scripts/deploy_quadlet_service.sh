@@ -25,6 +25,7 @@ deploy_service() {
         podman volume rm flightctl-db || true
         podman volume create --opt device=tmpfs --opt type=tmpfs --opt o=nodev,noexec flightctl-db
         ensure_postgres_secrets
+        ensure_secret_encryption_secrets
     elif [[ "$service_name" == "kv" ]]; then
         ensure_kv_secrets
     else
@@ -10,6 +10,7 @@ main() {
     echo "Configuring DB secrets"
 
     ensure_postgres_secrets
+    ensure_secret_encryption_secrets
 
     echo "DB configuration complete"
 }
@@ -28,6 +28,14 @@ ensure_kv_secrets() {
     ensure_secret "flightctl-kv-password" "FLIGHTCTL_KV_PASSWORD"
 }
 
+# Ensure the key encryption key for Secret resources exists
+# Secret resources are stored encrypted in the database, so the key is created along with the database secrets.
+# Losing it makes the stored Secret resources unreadable.
+ensure_secret_encryption_secrets() {
+    echo "Ensuring secrets for Secret resource encryption"
+    ensure_secret "flightctl-secrets-kek" "FLIGHTCTL_SECRETS_KEK"
+}
+
 # Ensure a specific secret exists
 # Args:
 #   $1: Secret name
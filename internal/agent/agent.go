@@ -11,11 +11,13 @@ import (
 	agent_config "github.com/flightctl/flightctl/internal/agent/config"
 	"github.com/flightctl/flightctl/internal/agent/device"
 	"github.com/flightctl/flightctl/internal/agent/device/applications"
+	"github.com/flightctl/flightctl/internal/agent/device/attestation"
 	"github.com/flightctl/flightctl/internal/agent/device/certmanager"
 	"github.com/flightctl/flightctl/internal/agent/device/config"
 	"github.com/flightctl/flightctl/internal/agent/device/console"
 	"github.com/flightctl/flightctl/internal/agent/device/dependency"
 	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/flightctl/flightctl/internal/agent/device/healthcheck"
 	"github.com/flightctl/flightctl/internal/agent/device/hook"
 	imagepruning "github.com/flightctl/flightctl/internal/agent/device/image_pruning"
 	"github.com/flightctl/flightctl/internal/agent/device/lifecycle"
@@ -28,6 +30,7 @@ import (
 	"github.com/flightctl/flightctl/internal/agent/device/systemd"
 	"github.com/flightctl/flightctl/internal/agent/device/systeminfo"
 	systeminfocommon "github.com/flightctl/flightctl/internal/agent/device/systeminfo/common"
+	"github.com/flightctl/flightctl/internal/agent/device/volumesnapshot"
 	"github.com/flightctl/flightctl/internal/agent/identity"
 	"github.com/flightctl/flightctl/internal/agent/instrumentation"
 	"github.com/flightctl/flightctl/internal/agent/reload"
@@ -134,6 +137,14 @@ func (a *Agent) Run(ctx context.Context) error {
 		return fmt.Errorf("failed to get device name: %w", err)
 	}
 
+	// sensitive file contents of the device spec are encrypted to the device's identity and only decrypted
+	// when written, so recreate the readers and writers with the identity provider as decrypter
+	rwFactory = fileio.NewReadWriterFactory(a.config.GetTestRootDir(), fileio.WithContentDecrypter(identityProvider))
+	rootReadWriter, err = rwFactory("")
+	if err != nil {
+		return fmt.Errorf("initialize root read/writer: %w", err)
+	}
+
 	clientCSRPath := identity.GetCSRPath(a.config.DataDir)
 
 	// Try to load persisted CSR first, generate a new one only if not found
@@ -278,14 +289,6 @@ func (a *Agent) Run(ctx context.Context) error {
 		a.log,
 	)
 
-	// create resource manager
-	resourceManager := resource.NewManager(
-		a.log,
-	)
-
-	// create hook manager
-	hookManager := hook.NewManager(rootReadWriter, exec, a.log)
-
 	// create systemd manager
 	systemdManagerFactory := systemd.NewManagerFactory(a.log)
 	rootSystemdManager, err := systemdManagerFactory("")
@@ -310,6 +313,18 @@ func (a *Agent) Run(ctx context.Context) error {
 	// register the application manager with the shutdown manager
 	shutdownManager.Register("applications", applicationsManager.Shutdown)
 
+	// create resource manager
+	resourceManager := resource.NewManager(
+		a.log,
+		applicationsManager,
+	)
+
+	// create hook manager
+	hookManager := hook.NewManager(rootReadWriter, exec, systemInfoManager, rootSystemdClient, applicationsManager, a.log)
+
+	// create health check manager
+	healthCheckManager := healthcheck.NewManager(exec, rootSystemdClient, applicationsManager, a.log)
+
 	// create os manager
 	osManager := os.NewManager(a.log, osClient, rootReadWriter, rootPodmanClient, pullConfigResolver)
 
@@ -356,6 +371,7 @@ func (a *Agent) Run(ctx context.Context) error {
 	statusManager.RegisterStatusExporter(osManager)
 	statusManager.RegisterStatusExporter(specManager)
 	statusManager.RegisterStatusExporter(systemInfoManager)
+	statusManager.RegisterStatusExporter(identity.NewEncryptionKeyExporter(identityProvider, a.log))
 
 	// create config controller
 	configController := config.NewController(
@@ -414,6 +430,9 @@ func (a *Agent) Run(ctx context.Context) error {
 		exec,
 		specManager.Watch(),
 		a.log,
+		console.WithPortForwardAllowedPorts(a.config.PortForward.AllowedPorts),
+		console.WithFileTransferAllowedPaths(a.config.FileTransfer.AllowedPaths),
+		console.WithAppLogger(applicationsManager),
 	)
 
 	applicationsController := applications.NewController(
@@ -438,6 +457,18 @@ func (a *Agent) Run(ctx context.Context) error {
 		a.config.DataDir,
 	)
 
+	// create volume snapshot manager
+	volumeSnapshotManager := volumesnapshot.New(
+		podmanClientFactory,
+		systemdManagerFactory,
+		rwFactory,
+		rootReadWriter,
+		resourceManager,
+		a.log,
+		a.config.VolumeSnapshots,
+		a.config.DataDir,
+	)
+
 	// create agent
 	agent := device.NewAgent(
 		deviceName,
@@ -449,6 +480,7 @@ func (a *Agent) Run(ctx context.Context) error {
 		rootSystemdManager,
 		a.config.StatusUpdateInterval,
 		hookManager,
+		healthCheckManager,
 		osManager,
 		policyManager,
 		lifecycleManager,
@@ -461,6 +493,9 @@ func (a *Agent) Run(ctx context.Context) error {
 		prefetchManager,
 		pullConfigResolver,
 		pruningManager,
+		volumeSnapshotManager,
+		systemInfoManager,
+		a.config.DataDir,
 		backoff,
 		a.log,
 	)
@@ -471,6 +506,7 @@ func (a *Agent) Run(ctx context.Context) error {
 	reloadManager.Register(statusManager.ReloadCollect)
 	reloadManager.Register(certManager.Sync)
 	reloadManager.Register(pruningManager.ReloadConfig)
+	reloadManager.Register(volumeSnapshotManager.ReloadConfig)
 
 	// When the server returns ConflictPaused (e.g. post-restore), clear lastStatus so the next status sync pushes device details.
 	specManager.Publisher().SetOnConflictPausedInvalidator(statusManager)
@@ -485,6 +521,17 @@ func (a *Agent) Run(ctx context.Context) error {
 	startAsync(consoleManager.Run)
 	startAsync(specManager.Publisher().Run)
 	startAsync(certManager.Run)
+	if tpmClient != nil {
+		attestationManager := attestation.NewManager(
+			deviceName,
+			bootstrap.ManagementClient(),
+			tpmClient,
+			rootReadWriter,
+			time.Duration(a.config.TPM.AttestationInterval),
+			a.log,
+		)
+		startAsync(attestationManager.Run)
+	}
 
 	// main agent loop: all critical work happens here serially
 	err = agent.Run(ctx)
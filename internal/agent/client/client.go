@@ -61,6 +61,8 @@ type Management interface {
 	SetRPCMetricsCallback(cb RPCMetricsCallback)
 	CreateCertificateSigningRequest(ctx context.Context, csr v1beta1.CertificateSigningRequest, rcb ...client.RequestEditorFn) (*v1beta1.CertificateSigningRequest, int, error)
 	GetCertificateSigningRequest(ctx context.Context, name string, rcb ...client.RequestEditorFn) (*v1beta1.CertificateSigningRequest, int, error)
+	CreateAttestationChallenge(ctx context.Context, name string, rcb ...client.RequestEditorFn) (*v1beta1.AttestationChallenge, error)
+	ReplaceDeviceAttestation(ctx context.Context, name string, report v1beta1.AttestationReport, rcb ...client.RequestEditorFn) (*v1beta1.DeviceIntegrityStatus, error)
 }
 
 // Enrollment is client the interface for managing device enrollment.
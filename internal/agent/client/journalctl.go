@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"fmt"
+	"os/exec"
 	"strings"
 	"time"
 
@@ -51,6 +52,22 @@ func WithLogSince(t time.Time) LogOptions {
 	}
 }
 
+func WithLogFollow() LogOptions {
+	return func(o *logOptions) {
+		o.args = append(o.args, "--follow")
+	}
+}
+
+// LogsCmd returns a command that writes the journal entries selected by the options to its stdout. After
+// creating the command, it should be started with exec.Start().
+func (j *Journalctl) LogsCmd(ctx context.Context, options ...LogOptions) *exec.Cmd {
+	opts := logOptions{args: []string{"--no-pager", "-o", "short-iso"}}
+	for _, option := range options {
+		option(&opts)
+	}
+	return j.exec.CommandContext(ctx, journalctlCommand, opts.args...)
+}
+
 func (j *Journalctl) Logs(ctx context.Context, options ...LogOptions) ([]string, error) {
 	args := []string{
 		"-o", "cat",
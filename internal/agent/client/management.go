@@ -183,3 +183,54 @@ func (m *management) GetCertificateSigningRequest(ctx context.Context, name stri
 
 	return nil, resp.StatusCode(), nil
 }
+
+// CreateAttestationChallenge requests a fresh nonce and PCR selection from the management server.
+// The returned challenge must be answered with ReplaceDeviceAttestation before it expires.
+func (m *management) CreateAttestationChallenge(ctx context.Context, name string, rcb ...client.RequestEditorFn) (*v1beta1.AttestationChallenge, error) {
+	start := time.Now()
+	resp, err := m.client.CreateDeviceAttestationChallengeWithResponse(ctx, name, rcb...)
+
+	if m.rpcMetricsCallbackFunc != nil {
+		m.rpcMetricsCallbackFunc("create_attestation_challenge_duration", time.Since(start).Seconds(), err)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	if resp.HTTPResponse != nil {
+		defer func() { _ = resp.HTTPResponse.Body.Close() }()
+	}
+
+	if resp.JSON201 == nil {
+		return nil, fmt.Errorf("create attestation challenge failed: %s", resp.Status())
+	}
+
+	return resp.JSON201, nil
+}
+
+// ReplaceDeviceAttestation submits a signed PCR quote answering a previously issued challenge.
+// It returns the integrity status the management server computed from the quote.
+func (m *management) ReplaceDeviceAttestation(ctx context.Context, name string, report v1beta1.AttestationReport, rcb ...client.RequestEditorFn) (*v1beta1.DeviceIntegrityStatus, error) {
+	start := time.Now()
+	resp, err := m.client.ReplaceDeviceAttestationWithResponse(ctx, name, report, rcb...)
+
+	if m.rpcMetricsCallbackFunc != nil {
+		m.rpcMetricsCallbackFunc("replace_device_attestation_duration", time.Since(start).Seconds(), err)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	if resp.HTTPResponse != nil {
+		defer func() { _ = resp.HTTPResponse.Body.Close() }()
+	}
+
+	if resp.JSON400 != nil {
+		return nil, fmt.Errorf("replace device attestation failed: %s", resp.JSON400.Message)
+	}
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("replace device attestation failed: %s", resp.Status())
+	}
+
+	return resp.JSON200, nil
+}
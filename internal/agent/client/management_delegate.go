@@ -175,3 +175,28 @@ func (d *ManagementDelegate) GetCertificateSigningRequest(
 	}
 	return m.GetCertificateSigningRequest(ctx, name, rcb...)
 }
+
+func (d *ManagementDelegate) CreateAttestationChallenge(
+	ctx context.Context,
+	name string,
+	rcb ...agentclient.RequestEditorFn,
+) (*api.AttestationChallenge, error) {
+	m, err := d.mgmt()
+	if err != nil {
+		return nil, err
+	}
+	return m.CreateAttestationChallenge(ctx, name, rcb...)
+}
+
+func (d *ManagementDelegate) ReplaceDeviceAttestation(
+	ctx context.Context,
+	name string,
+	report api.AttestationReport,
+	rcb ...agentclient.RequestEditorFn,
+) (*api.DeviceIntegrityStatus, error) {
+	m, err := d.mgmt()
+	if err != nil {
+		return nil, err
+	}
+	return m.ReplaceDeviceAttestation(ctx, name, report, rcb...)
+}
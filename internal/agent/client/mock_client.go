@@ -42,6 +42,26 @@ func (m *MockManagement) EXPECT() *MockManagementMockRecorder {
 	return m.recorder
 }
 
+// CreateAttestationChallenge mocks base method.
+func (m *MockManagement) CreateAttestationChallenge(ctx context.Context, name string, rcb ...client.RequestEditorFn) (*v1beta1.AttestationChallenge, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range rcb {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateAttestationChallenge", varargs...)
+	ret0, _ := ret[0].(*v1beta1.AttestationChallenge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAttestationChallenge indicates an expected call of CreateAttestationChallenge.
+func (mr *MockManagementMockRecorder) CreateAttestationChallenge(ctx, name any, rcb ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, rcb...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAttestationChallenge", reflect.TypeOf((*MockManagement)(nil).CreateAttestationChallenge), varargs...)
+}
+
 // CreateCertificateSigningRequest mocks base method.
 func (m *MockManagement) CreateCertificateSigningRequest(ctx context.Context, csr v1beta1.CertificateSigningRequest, rcb ...client.RequestEditorFn) (*v1beta1.CertificateSigningRequest, int, error) {
 	m.ctrl.T.Helper()
@@ -124,6 +144,26 @@ func (mr *MockManagementMockRecorder) PatchDeviceStatus(ctx, name, patch any, rc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchDeviceStatus", reflect.TypeOf((*MockManagement)(nil).PatchDeviceStatus), varargs...)
 }
 
+// ReplaceDeviceAttestation mocks base method.
+func (m *MockManagement) ReplaceDeviceAttestation(ctx context.Context, name string, report v1beta1.AttestationReport, rcb ...client.RequestEditorFn) (*v1beta1.DeviceIntegrityStatus, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name, report}
+	for _, a := range rcb {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReplaceDeviceAttestation", varargs...)
+	ret0, _ := ret[0].(*v1beta1.DeviceIntegrityStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaceDeviceAttestation indicates an expected call of ReplaceDeviceAttestation.
+func (mr *MockManagementMockRecorder) ReplaceDeviceAttestation(ctx, name, report any, rcb ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name, report}, rcb...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceDeviceAttestation", reflect.TypeOf((*MockManagement)(nil).ReplaceDeviceAttestation), varargs...)
+}
+
 // SetRPCMetricsCallback mocks base method.
 func (m *MockManagement) SetRPCMetricsCallback(cb RPCMetricsCallback) {
 	m.ctrl.T.Helper()
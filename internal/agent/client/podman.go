@@ -479,6 +479,104 @@ func (p *Podman) EventsSinceCmd(ctx context.Context, events []string, sinceTime
 	return p.exec.CommandContext(ctx, podmanCmd, args...)
 }
 
+// ListContainers returns the names of all containers, running or not, that have the given labels.
+func (p *Podman) ListContainers(ctx context.Context, labels []string) ([]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	args := []string{
+		"ps",
+		"-a",
+		"--format",
+		"{{.Names}}",
+	}
+	args = applyFilters(args, labels, []string{})
+
+	stdout, stderr, exitCode := p.exec.ExecuteWithContext(ctx, podmanCmd, args...)
+	if exitCode != 0 {
+		return nil, fmt.Errorf("list containers: %w", errors.FromStderr(stderr, exitCode))
+	}
+
+	var containers []string
+	for _, line := range strings.Split(strings.TrimSpace(stdout), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			containers = append(containers, line)
+		}
+	}
+	return containers, nil
+}
+
+// LogsCmd returns a command that writes the logs of the given containers to its stdout and stderr, prefixed with
+// the container name. If since is non-zero, only logs written after it are included. After creating the command,
+// it should be started with exec.Start().
+func (p *Podman) LogsCmd(ctx context.Context, containers []string, follow bool, since time.Time) *exec.Cmd {
+	args := []string{"logs", "--names", "--timestamps"}
+	if follow {
+		args = append(args, "--follow")
+	}
+	if !since.IsZero() {
+		args = append(args, "--since", since.Format(time.RFC3339))
+	}
+	args = append(args, containers...)
+	return p.exec.CommandContext(ctx, podmanCmd, args...)
+}
+
+// ContainerStats is the resource usage of a running container as reported by podman stats.
+type ContainerStats struct {
+	Name string
+	// CPUPercent is the CPU usage relative to a single CPU, so it exceeds 100 for containers using more than one.
+	CPUPercent float64
+	// MemoryPercent is the memory usage relative to the memory limit of the container or the memory of the host.
+	MemoryPercent float64
+}
+
+// Stats returns the resource usage of all running containers.
+func (p *Podman) Stats(ctx context.Context) ([]ContainerStats, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	args := []string{"stats", "--no-stream", "--format", "json"}
+	stdout, stderr, exitCode := p.exec.ExecuteWithContext(ctx, podmanCmd, args...)
+	if exitCode != 0 {
+		return nil, fmt.Errorf("container stats: %w", errors.FromStderr(stderr, exitCode))
+	}
+	return parseContainerStats(stdout)
+}
+
+func parseContainerStats(out string) ([]ContainerStats, error) {
+	out = strings.TrimSpace(out)
+	if out == "" {
+		return nil, nil
+	}
+	var raw []struct {
+		Name       string `json:"name"`
+		CPUPercent string `json:"cpu_percent"`
+		MemPercent string `json:"mem_percent"`
+	}
+	if err := json.Unmarshal([]byte(out), &raw); err != nil {
+		return nil, fmt.Errorf("unmarshal container stats: %w", err)
+	}
+	stats := make([]ContainerStats, 0, len(raw))
+	for _, r := range raw {
+		stats = append(stats, ContainerStats{
+			Name:          r.Name,
+			CPUPercent:    parsePercent(r.CPUPercent),
+			MemoryPercent: parsePercent(r.MemPercent),
+		})
+	}
+	return stats, nil
+}
+
+// parsePercent parses a percentage such as "12.34%". Values that are not available yet are reported as "--" and
+// parse as zero.
+func parsePercent(s string) float64 {
+	v, err := strconv.ParseFloat(strings.TrimSuffix(strings.TrimSpace(s), "%"), 64)
+	if err != nil {
+		return 0
+	}
+	return v
+}
+
 func (p *Podman) Mount(ctx context.Context, image string) (string, error) {
 	ctx, cancel := context.WithTimeout(ctx, p.timeout)
 	defer cancel()
@@ -680,6 +778,26 @@ func (p *Podman) RemoveVolumes(ctx context.Context, volumes ...string) error {
 	return nil
 }
 
+// ExportVolume writes the contents of a volume to a tar archive at path.
+func (p *Podman) ExportVolume(ctx context.Context, name string, path string) error {
+	args := []string{"volume", "export", name, "--output", path}
+	_, stderr, exitCode := p.exec.ExecuteWithContext(ctx, podmanCmd, args...)
+	if exitCode != 0 {
+		return fmt.Errorf("export volume: %w", errors.FromStderr(stderr, exitCode))
+	}
+	return nil
+}
+
+// ImportVolume extracts a tar archive at path into an existing volume.
+func (p *Podman) ImportVolume(ctx context.Context, name string, path string) error {
+	args := []string{"volume", "import", name, path}
+	_, stderr, exitCode := p.exec.ExecuteWithContext(ctx, podmanCmd, args...)
+	if exitCode != 0 {
+		return fmt.Errorf("import volume: %w", errors.FromStderr(stderr, exitCode))
+	}
+	return nil
+}
+
 func applyFilters(args, labels, filters []string) []string {
 	for _, label := range labels {
 		args = append(args, "--filter", fmt.Sprintf("label=%s", label))
@@ -367,3 +367,62 @@ func TestPodman_RemoveArtifact(t *testing.T) {
 		})
 	}
 }
+
+func TestPodman_Stats(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	log := log.NewPrefixLogger("test")
+	mockExec := executer.NewMockExecuter(ctrl)
+	readWriter := fileio.NewReadWriter(fileio.NewReader(), fileio.NewWriter())
+	backoff := poll.Config{}
+
+	testCases := []struct {
+		name      string
+		setupMock func(*executer.MockExecuter)
+		want      []ContainerStats
+		wantErr   bool
+	}{
+		{
+			name: "success",
+			setupMock: func(mock *executer.MockExecuter) {
+				mock.EXPECT().ExecuteWithContext(gomock.Any(), "podman", []string{"stats", "--no-stream", "--format", "json"}).
+					Return(`[{"id":"1","name":"web","cpu_percent":"150.25%","mem_percent":"12.50%"},{"id":"2","name":"db","cpu_percent":"--","mem_percent":"1.00%"}]`, "", 0)
+			},
+			want: []ContainerStats{
+				{Name: "web", CPUPercent: 150.25, MemoryPercent: 12.5},
+				{Name: "db", CPUPercent: 0, MemoryPercent: 1},
+			},
+		},
+		{
+			name: "no running containers",
+			setupMock: func(mock *executer.MockExecuter) {
+				mock.EXPECT().ExecuteWithContext(gomock.Any(), "podman", []string{"stats", "--no-stream", "--format", "json"}).
+					Return("\n", "", 0)
+			},
+		},
+		{
+			name: "error from podman command",
+			setupMock: func(mock *executer.MockExecuter) {
+				mock.EXPECT().ExecuteWithContext(gomock.Any(), "podman", []string{"stats", "--no-stream", "--format", "json"}).
+					Return("", "Error: cannot connect", 125)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMock(mockExec)
+			podman := NewPodman(log, mockExec, readWriter, backoff)
+			stats, err := podman.Stats(context.Background())
+			if tc.wantErr {
+				require.Error(err)
+				return
+			}
+			require.NoError(err)
+			require.Equal(tc.want, stats)
+		})
+	}
+}
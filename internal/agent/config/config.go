@@ -38,6 +38,10 @@ const (
 	// DefaultPullTimeout is the default timeout for pulling a single OCI
 	// targets. Pull Timeout can not be greater that the prefetch timeout.
 	DefaultPullTimeout = util.Duration(10 * time.Minute)
+	// DefaultTPMAttestationInterval is the default interval between two TPM attestations
+	DefaultTPMAttestationInterval = util.Duration(10 * time.Minute)
+	// MinTPMAttestationInterval is the minimum interval allowed between two TPM attestations
+	MinTPMAttestationInterval = util.Duration(1 * time.Minute)
 	// MinSyncInterval is the minimum interval allowed for the spec fetch and status update
 	MinSyncInterval = util.Duration(2 * time.Second)
 	// DefaultConfigDir is the default directory where the device's configuration is stored
@@ -74,6 +78,11 @@ const (
 	DefaultMetricsEnabled = false
 	// DefaultProfilingEnabled controls whether runtime profiling (pprof) is enabled by default.
 	DefaultProfilingEnabled = false
+	// DefaultVolumeSnapshotRetention is the default number of snapshots kept per application volume
+	DefaultVolumeSnapshotRetention = 2
+	// DefaultVolumeSnapshotReservedDiskPercent is the default share of the disk that must remain free after taking
+	// volume snapshots
+	DefaultVolumeSnapshotReservedDiskPercent = 10
 )
 
 type Config struct {
@@ -146,6 +155,15 @@ type Config struct {
 	// ImagePruning holds all image/artifact pruning-related configuration
 	ImagePruning ImagePruning `json:"image-pruning,omitempty"`
 
+	// PortForward holds all port forwarding-related configuration
+	PortForward PortForward `json:"port-forward,omitempty"`
+
+	// FileTransfer holds all file transfer-related configuration
+	FileTransfer FileTransfer `json:"file-transfer,omitempty"`
+
+	// VolumeSnapshots holds all application volume snapshot-related configuration
+	VolumeSnapshots VolumeSnapshots `json:"volume-snapshots,omitempty"`
+
 	readWriter fileio.ReadWriter
 }
 
@@ -158,6 +176,8 @@ type TPM struct {
 	AuthEnabled bool `json:"auth-enabled,omitempty"`
 	// StorageFilePath specifies the file path for TPM key storage.
 	StorageFilePath string `json:"storage-file-path,omitempty"`
+	// AttestationInterval is the interval between two PCR quotes sent to the management service.
+	AttestationInterval util.Duration `json:"attestation-interval,omitempty"`
 }
 
 type ImagePruning struct {
@@ -166,6 +186,26 @@ type ImagePruning struct {
 	Enabled *bool `json:"enabled,omitempty"`
 }
 
+type PortForward struct {
+	// AllowedPorts lists the loopback ports that may be reached through port forwarding sessions.
+	// If empty, any loopback port may be forwarded.
+	AllowedPorts []int `json:"allowed-ports,omitempty"`
+}
+
+type FileTransfer struct {
+	// AllowedPaths lists the directories and files that may be read or written through file transfer sessions.
+	// If empty, any file may be transferred.
+	AllowedPaths []string `json:"allowed-paths,omitempty"`
+}
+
+type VolumeSnapshots struct {
+	// Retention is the number of snapshots kept per application volume, including the latest one.
+	Retention int `json:"retention,omitempty"`
+	// ReservedDiskPercent is the share of the disk, in percent, that must remain free after taking the snapshots
+	// of an update. Updates that would exceed it are deferred.
+	ReservedDiskPercent int `json:"reserved-disk-percent,omitempty"`
+}
+
 // DefaultSystemInfo defines the list of system information keys that are included
 // in the default system info status report generated by the agent.
 var DefaultSystemInfo = append([]string{
@@ -198,15 +238,20 @@ func NewDefault() *Config {
 		MetricsEnabled:       DefaultMetricsEnabled,
 		ProfilingEnabled:     DefaultProfilingEnabled,
 		TPM: TPM{
-			Enabled:         false,
-			AuthEnabled:     false,
-			DevicePath:      DefaultTPMDevicePath,
-			StorageFilePath: filepath.Join(DefaultDataDir, DefaultTPMKeyFile),
+			Enabled:             false,
+			AuthEnabled:         false,
+			DevicePath:          DefaultTPMDevicePath,
+			StorageFilePath:     filepath.Join(DefaultDataDir, DefaultTPMKeyFile),
+			AttestationInterval: DefaultTPMAttestationInterval,
 		},
 		AuditLog: *audit.NewDefaultAuditConfig(),
 		ImagePruning: ImagePruning{
 			Enabled: lo.ToPtr(false),
 		},
+		VolumeSnapshots: VolumeSnapshots{
+			Retention:           DefaultVolumeSnapshotRetention,
+			ReservedDiskPercent: DefaultVolumeSnapshotReservedDiskPercent,
+		},
 	}
 
 	if value := os.Getenv(TestRootDirEnvKey); value != "" {
@@ -303,10 +348,33 @@ func (cfg *Config) Validate() error {
 		return fmt.Errorf("system-info-timeout cannot exceed %s, got %s", MaxSystemInfoTimeout, cfg.SystemInfoTimeout)
 	}
 
+	for _, port := range cfg.PortForward.AllowedPorts {
+		if port < 1 || port > 65535 {
+			return fmt.Errorf("port-forward allowed port %d is out of range", port)
+		}
+	}
+
+	for _, p := range cfg.FileTransfer.AllowedPaths {
+		if !filepath.IsAbs(p) {
+			return fmt.Errorf("file-transfer allowed path %q must be absolute", p)
+		}
+	}
+
+	if cfg.VolumeSnapshots.Retention < 1 {
+		return fmt.Errorf("volume-snapshots retention must be at least 1, got %d", cfg.VolumeSnapshots.Retention)
+	}
+	if cfg.VolumeSnapshots.ReservedDiskPercent < 0 || cfg.VolumeSnapshots.ReservedDiskPercent > 100 {
+		return fmt.Errorf("volume-snapshots reserved-disk-percent must be between 0 and 100, got %d", cfg.VolumeSnapshots.ReservedDiskPercent)
+	}
+
 	if cfg.TPM.AuthEnabled && !cfg.TPM.Enabled {
 		return fmt.Errorf("cannot enable TPM password authentication when TPM device identity is disabled")
 	}
 
+	if cfg.TPM.AttestationInterval < MinTPMAttestationInterval {
+		return fmt.Errorf("minimum TPM attestation interval is %s have %s", MinTPMAttestationInterval, cfg.TPM.AttestationInterval)
+	}
+
 	// Validate audit log configuration
 	if err := cfg.AuditLog.Validate(cfg.readWriter); err != nil {
 		return fmt.Errorf("audit log configuration validation failed: %w", err)
@@ -456,6 +524,7 @@ func mergeConfigs(base, override *Config) {
 	overrideIfNotEmpty(&base.TPM.AuthEnabled, override.TPM.AuthEnabled)
 	overrideIfNotEmpty(&base.TPM.DevicePath, override.TPM.DevicePath)
 	overrideIfNotEmpty(&base.TPM.StorageFilePath, override.TPM.StorageFilePath)
+	overrideIfNotEmpty(&base.TPM.AttestationInterval, override.TPM.AttestationInterval)
 
 	// audit log
 	overrideIfNotEmpty(&base.AuditLog.Enabled, override.AuditLog.Enabled)
@@ -471,6 +540,16 @@ func mergeConfigs(base, override *Config) {
 	// but a dropin with image-pruning.enabled: false will override to false.
 	overrideIfNotEmpty(&base.ImagePruning.Enabled, override.ImagePruning.Enabled)
 
+	// port forward
+	overrideSliceIfNotNil(&base.PortForward.AllowedPorts, override.PortForward.AllowedPorts)
+
+	// file transfer
+	overrideSliceIfNotNil(&base.FileTransfer.AllowedPaths, override.FileTransfer.AllowedPaths)
+
+	// volume snapshots
+	overrideIfNotEmpty(&base.VolumeSnapshots.Retention, override.VolumeSnapshots.Retention)
+	overrideIfNotEmpty(&base.VolumeSnapshots.ReservedDiskPercent, override.VolumeSnapshots.ReservedDiskPercent)
+
 	for k, v := range override.DefaultLabels {
 		base.DefaultLabels[k] = v
 	}
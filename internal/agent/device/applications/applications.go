@@ -3,10 +3,13 @@ package applications
 import (
 	"context"
 	"fmt"
+	"os/exec"
 	"slices"
 	"strconv"
+	"time"
 
 	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/client"
 	"github.com/flightctl/flightctl/internal/agent/device/applications/lifecycle"
 	"github.com/flightctl/flightctl/internal/agent/device/applications/provider"
 	"github.com/flightctl/flightctl/internal/agent/device/dependency"
@@ -77,6 +80,13 @@ type Manager interface {
 	BeforeUpdate(ctx context.Context, desired *v1beta1.DeviceSpec, opts ...UpdateOpt) error
 	// AfterUpdate is called after the application has been validated and is ready to be executed.
 	AfterUpdate(ctx context.Context) error
+	// Restart stops and starts the workloads of the application with the given name.
+	Restart(ctx context.Context, name string) error
+	// LogsCmd returns a command that writes the container logs of the application with the given name, optionally
+	// following them and limited to those written after since if it is non-zero.
+	LogsCmd(ctx context.Context, name string, follow bool, since time.Time) (*exec.Cmd, error)
+	// Stats returns the resource usage of the running containers of each application, keyed by application name.
+	Stats(ctx context.Context) (map[string][]client.ContainerStats, error)
 	// Shutdown closes the manager according to the corresponding shutdown state
 	Shutdown(ctx context.Context, state shutdown.State) error
 
@@ -121,6 +131,8 @@ type Application interface {
 	Status() (*v1beta1.DeviceApplicationStatus, v1beta1.DeviceApplicationsSummaryStatus, error)
 	// ActionSpec returns the type-specific action configuration for this application.
 	ActionSpec() lifecycle.ActionSpec
+	// DependsOn returns the applications that must be ready before this application starts.
+	DependsOn() []v1beta1.ApplicationDependency
 }
 
 // Workload represents an application workload tracked by a Monitor.
@@ -139,6 +151,7 @@ type application struct {
 	volume     provider.VolumeManager
 	status     *v1beta1.DeviceApplicationStatus
 	actionSpec lifecycle.ActionSpec
+	dependsOn  []v1beta1.ApplicationDependency
 }
 
 // NewApplication creates a new application from an application provider.
@@ -154,7 +167,8 @@ func NewApplication(p provider.Provider) *application {
 			AppType:  spec.AppType,
 			RunAs:    spec.User,
 		},
-		volume: spec.Volume,
+		volume:    spec.Volume,
+		dependsOn: spec.DependsOn(),
 	}
 }
 
@@ -244,6 +258,10 @@ func (a *application) ActionSpec() lifecycle.ActionSpec {
 	return a.actionSpec
 }
 
+func (a *application) DependsOn() []v1beta1.ApplicationDependency {
+	return a.dependsOn
+}
+
 func (a *application) Path() string {
 	return a.path
 }
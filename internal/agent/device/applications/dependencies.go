@@ -0,0 +1,188 @@
+package applications
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/device/applications/lifecycle"
+	"github.com/flightctl/flightctl/internal/agent/device/errors"
+)
+
+const (
+	// defaultDependencyTimeout is how long the agent waits for the dependencies of an application to reach their
+	// condition before it fails to start the application.
+	defaultDependencyTimeout = 5 * time.Minute
+	// defaultDependencyPollInterval is how often the agent checks the status of the dependencies of an application.
+	defaultDependencyPollInterval = 2 * time.Second
+)
+
+// orderActions splits the actions into batches that are executed one after the other. Applications are stopped
+// before the applications they depend on, then started and updated after them. Without dependencies between the
+// actions all of them are executed in a single batch.
+func orderActions(actions []lifecycle.Action) ([][]lifecycle.Action, error) {
+	var stops, starts []lifecycle.Action
+	hasDependencies := false
+	for _, action := range actions {
+		if len(action.DependsOn) > 0 {
+			hasDependencies = true
+		}
+		if action.Type == lifecycle.ActionRemove {
+			stops = append(stops, action)
+		} else {
+			starts = append(starts, action)
+		}
+	}
+	if !hasDependencies {
+		return [][]lifecycle.Action{actions}, nil
+	}
+
+	stopBatches, err := dependencyBatches(stops, true)
+	if err != nil {
+		return nil, err
+	}
+	startBatches, err := dependencyBatches(starts, false)
+	if err != nil {
+		return nil, err
+	}
+	return append(stopBatches, startBatches...), nil
+}
+
+// dependencyBatches groups the actions by their depth in the dependency graph. Dependencies of an action that are
+// not part of the actions are already in place and do not affect the order. If reverse is true, dependents come
+// before their dependencies.
+func dependencyBatches(actions []lifecycle.Action, reverse bool) ([][]lifecycle.Action, error) {
+	byName := make(map[string]lifecycle.Action, len(actions))
+	for _, action := range actions {
+		byName[action.Name] = action
+	}
+
+	// edges point from an action to the actions that must be executed before it
+	before := make(map[string][]string, len(actions))
+	for _, action := range actions {
+		for _, dep := range action.DependsOn {
+			if _, ok := byName[dep.Name]; !ok {
+				continue
+			}
+			if reverse {
+				before[dep.Name] = append(before[dep.Name], action.Name)
+			} else {
+				before[action.Name] = append(before[action.Name], dep.Name)
+			}
+		}
+	}
+
+	depths := make(map[string]int, len(actions))
+	visiting := make(map[string]bool, len(actions))
+	var depth func(name string, path []string) (int, error)
+	depth = func(name string, path []string) (int, error) {
+		if d, ok := depths[name]; ok {
+			return d, nil
+		}
+		path = append(path, name)
+		if visiting[name] {
+			return 0, fmt.Errorf("%w: %s", errors.ErrAppDependencyCycle, strings.Join(path, " -> "))
+		}
+		visiting[name] = true
+		d := 0
+		for _, prev := range before[name] {
+			prevDepth, err := depth(prev, path)
+			if err != nil {
+				return 0, err
+			}
+			d = max(d, prevDepth+1)
+		}
+		visiting[name] = false
+		depths[name] = d
+		return d, nil
+	}
+
+	var batches [][]lifecycle.Action
+	for _, action := range actions {
+		d, err := depth(action.Name, nil)
+		if err != nil {
+			return nil, err
+		}
+		for len(batches) <= d {
+			batches = append(batches, nil)
+		}
+		batches[d] = append(batches[d], action)
+	}
+	return batches, nil
+}
+
+// waitForDependencies waits until the dependencies of the applications that the actions start or update reach their
+// condition.
+func (m *PodmanMonitor) waitForDependencies(ctx context.Context, actions []lifecycle.Action) error {
+	for _, action := range actions {
+		if action.Type == lifecycle.ActionRemove {
+			continue
+		}
+		for _, dep := range action.DependsOn {
+			if err := m.waitForDependency(ctx, action.Name, dep); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (m *PodmanMonitor) waitForDependency(ctx context.Context, appName string, dep v1beta1.ApplicationDependency) error {
+	condition := dep.ConditionWithDefault()
+	app, err := m.appByName(dep.Name)
+	if err != nil {
+		// applications run by other monitors are ordered independently
+		m.log.Debugf("Not waiting for dependency %s of application %s: %v", dep.Name, appName, err)
+		return nil
+	}
+
+	ticker := time.NewTicker(m.dependencyPollInterval)
+	defer ticker.Stop()
+	timeout := time.NewTimer(m.dependencyTimeout)
+	defer timeout.Stop()
+
+	logged := false
+	for {
+		if m.dependencyReady(app, condition) {
+			return nil
+		}
+		if !logged {
+			m.log.Infof("Waiting for dependency %s to become %s before starting application %s", dep.Name, condition, appName)
+			logged = true
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timeout.C:
+			return fmt.Errorf("%w: %s did not become %s within %s, not starting application %s",
+				errors.ErrAppDependencyNotReady, dep.Name, condition, m.dependencyTimeout, appName)
+		case <-ticker.C:
+		}
+	}
+}
+
+// dependencyReady returns true if the status of the application meets the condition.
+func (m *PodmanMonitor) dependencyReady(app Application, condition v1beta1.ApplicationDependencyCondition) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	status, summary, err := app.Status()
+	if err != nil {
+		// the workloads are in transition, the next event settles the status
+		m.log.Debugf("Status of dependency %s: %v", app.Name(), err)
+		return false
+	}
+	switch condition {
+	case v1beta1.ApplicationDependencyConditionStarted:
+		switch status.Status {
+		case v1beta1.ApplicationStatusStarting, v1beta1.ApplicationStatusRunning, v1beta1.ApplicationStatusCompleted:
+			return true
+		}
+		return false
+	default:
+		return summary.Status == v1beta1.ApplicationsSummaryStatusHealthy
+	}
+}
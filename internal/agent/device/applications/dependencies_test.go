@@ -0,0 +1,149 @@
+package applications
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/device/applications/lifecycle"
+	"github.com/flightctl/flightctl/internal/agent/device/errors"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func newDependentAction(name string, actionType lifecycle.ActionType, dependsOn ...string) lifecycle.Action {
+	return lifecycle.Action{
+		Name: name,
+		Type: actionType,
+		DependsOn: lo.Map(dependsOn, func(dep string, _ int) v1beta1.ApplicationDependency {
+			return v1beta1.ApplicationDependency{Name: dep}
+		}),
+	}
+}
+
+func TestOrderActions(t *testing.T) {
+	testCases := []struct {
+		name        string
+		actions     []lifecycle.Action
+		wantBatches [][]string
+		wantErr     error
+	}{
+		{
+			name: "no dependencies keeps a single batch",
+			actions: []lifecycle.Action{
+				newDependentAction("app1", lifecycle.ActionRemove),
+				newDependentAction("app2", lifecycle.ActionAdd),
+				newDependentAction("app3", lifecycle.ActionUpdate),
+			},
+			wantBatches: [][]string{{"app1", "app2", "app3"}},
+		},
+		{
+			name: "dependencies start first",
+			actions: []lifecycle.Action{
+				newDependentAction("api", lifecycle.ActionAdd, "broker", "db"),
+				newDependentAction("broker", lifecycle.ActionAdd, "db"),
+				newDependentAction("db", lifecycle.ActionUpdate),
+			},
+			wantBatches: [][]string{{"db"}, {"broker"}, {"api"}},
+		},
+		{
+			name: "dependents stop first",
+			actions: []lifecycle.Action{
+				newDependentAction("db", lifecycle.ActionRemove),
+				newDependentAction("api", lifecycle.ActionRemove, "db"),
+				newDependentAction("ui", lifecycle.ActionAdd),
+			},
+			wantBatches: [][]string{{"api"}, {"db"}, {"ui"}},
+		},
+		{
+			name: "dependency outside of the actions",
+			actions: []lifecycle.Action{
+				newDependentAction("api", lifecycle.ActionUpdate, "db"),
+				newDependentAction("ui", lifecycle.ActionUpdate),
+			},
+			wantBatches: [][]string{{"api", "ui"}},
+		},
+		{
+			name: "cycle",
+			actions: []lifecycle.Action{
+				newDependentAction("api", lifecycle.ActionAdd, "db"),
+				newDependentAction("db", lifecycle.ActionAdd, "api"),
+			},
+			wantErr: errors.ErrAppDependencyCycle,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require := require.New(t)
+			batches, err := orderActions(tc.actions)
+			if tc.wantErr != nil {
+				require.ErrorIs(err, tc.wantErr)
+				return
+			}
+			require.NoError(err)
+			names := lo.Map(batches, func(batch []lifecycle.Action, _ int) []string {
+				return lo.Map(batch, func(action lifecycle.Action, _ int) string { return action.Name })
+			})
+			require.Equal(tc.wantBatches, names)
+		})
+	}
+}
+
+func TestWaitForDependency(t *testing.T) {
+	testCases := []struct {
+		name      string
+		workloads []StatusType
+		condition *v1beta1.ApplicationDependencyCondition
+		wantErr   error
+	}{
+		{
+			name:      "healthy dependency",
+			workloads: []StatusType{StatusRunning},
+		},
+		{
+			name:      "started dependency",
+			workloads: []StatusType{StatusRunning, StatusInit},
+			condition: lo.ToPtr(v1beta1.ApplicationDependencyConditionStarted),
+		},
+		{
+			name:      "dependency not healthy",
+			workloads: []StatusType{StatusRunning, StatusInit},
+			wantErr:   errors.ErrAppDependencyNotReady,
+		},
+		{
+			name:    "dependency without workloads",
+			wantErr: errors.ErrAppDependencyNotReady,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require := require.New(t)
+			podmanMonitor := NewPodmanMonitor(log.NewPrefixLogger("test"), nil, nil, "", nil)
+			podmanMonitor.dependencyTimeout = 50 * time.Millisecond
+			podmanMonitor.dependencyPollInterval = 10 * time.Millisecond
+
+			app := createTestApplication(require, "db", v1beta1.ApplicationStatusPreparing, v1beta1.CurrentProcessUsername)
+			for i, status := range tc.workloads {
+				app.AddWorkload(&Workload{Name: fmt.Sprintf("db-%d", i), Status: status})
+			}
+			podmanMonitor.apps[app.ID()] = app
+
+			err := podmanMonitor.waitForDependency(t.Context(), "api", v1beta1.ApplicationDependency{Name: "db", Condition: tc.condition})
+			if tc.wantErr != nil {
+				require.ErrorIs(err, tc.wantErr)
+				return
+			}
+			require.NoError(err)
+		})
+	}
+}
+
+func TestWaitForDependencyNotManaged(t *testing.T) {
+	podmanMonitor := NewPodmanMonitor(log.NewPrefixLogger("test"), nil, nil, "", nil)
+	err := podmanMonitor.waitForDependency(t.Context(), "api", v1beta1.ApplicationDependency{Name: "chart"})
+	require.NoError(t, err)
+}
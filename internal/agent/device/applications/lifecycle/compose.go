@@ -73,6 +73,10 @@ func (c *Compose) remove(ctx context.Context, action *Action) error {
 		return err
 	}
 
+	if err := removeDeletedVolumes(ctx, c.log, podman, *action); err != nil {
+		return fmt.Errorf("removing volumes: %w", err)
+	}
+
 	c.log.Infof("Removed application: %s", appName)
 	return nil
 }
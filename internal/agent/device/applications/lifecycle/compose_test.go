@@ -137,6 +137,53 @@ func TestComposeRemoveImageBackedVolumes(t *testing.T) {
 					Return("", "Error: no such volume", 1)
 			},
 		},
+		{
+			name: "removes volumes with delete reclaim policy on app removal",
+			action: Action{
+				Name: "test-app",
+				ID:   "app-delete-vol",
+				Type: ActionRemove,
+				Volumes: []Volume{
+					{ID: "app-delete-vol-data", Reference: "artifact:seed", ReclaimPolicy: api.Delete},
+					{ID: "app-delete-vol-keep", Reference: "artifact:keep", ReclaimPolicy: api.Retain},
+				},
+			},
+			setupMocks: func(mockExec *executer.MockExecuter) {
+				mockExec.EXPECT().ExecuteWithContext(gomock.Any(), "podman", newMatcher("ps")).Return("[]", "", 0).AnyTimes()
+				mockExec.EXPECT().ExecuteWithContext(gomock.Any(), "podman", newMatcher("rm")).Return("", "", 0).AnyTimes()
+				mockExec.EXPECT().ExecuteWithContext(gomock.Any(), "podman", newMatcher("network")).Return("[]", "", 0).AnyTimes()
+				mockExec.EXPECT().ExecuteWithContext(gomock.Any(), "podman", newMatcher("pod")).Return("[]", "", 0).AnyTimes()
+				mockExec.EXPECT().ExecuteWithContext(gomock.Any(), "podman", newMatcher("stop")).Return("", "", 0).AnyTimes()
+
+				mockExec.EXPECT().ExecuteWithContext(gomock.Any(), "podman", newMatcher("volume", "ls")).
+					Return("[]", "", 0)
+				mockExec.EXPECT().ExecuteWithContext(gomock.Any(), "podman", newMatcher("volume", "exists", "app-delete-vol-data")).
+					Return("", "", 0)
+				mockExec.EXPECT().ExecuteWithContext(gomock.Any(), "podman", newMatcher("volume", "rm", "app-delete-vol-data")).
+					Return("", "", 0)
+			},
+		},
+		{
+			name: "keeps volumes with delete reclaim policy on app update",
+			action: Action{
+				Name: "test-app",
+				ID:   "app-delete-vol",
+				Type: ActionUpdate,
+				Volumes: []Volume{
+					{ID: "app-delete-vol-data", Reference: "artifact:seed", ReclaimPolicy: api.Delete},
+				},
+			},
+			setupMocks: func(mockExec *executer.MockExecuter) {
+				mockExec.EXPECT().ExecuteWithContext(gomock.Any(), "podman", newMatcher("ps")).Return("[]", "", 0).AnyTimes()
+				mockExec.EXPECT().ExecuteWithContext(gomock.Any(), "podman", newMatcher("rm")).Return("", "", 0).AnyTimes()
+				mockExec.EXPECT().ExecuteWithContext(gomock.Any(), "podman", newMatcher("network")).Return("[]", "", 0).AnyTimes()
+				mockExec.EXPECT().ExecuteWithContext(gomock.Any(), "podman", newMatcher("pod")).Return("[]", "", 0).AnyTimes()
+				mockExec.EXPECT().ExecuteWithContext(gomock.Any(), "podman", newMatcher("stop")).Return("", "", 0).AnyTimes()
+
+				mockExec.EXPECT().ExecuteWithContext(gomock.Any(), "podman", newMatcher("volume", "ls")).
+					Return("[]", "", 0)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -56,6 +56,8 @@ type Action struct {
 	Embedded bool
 	// Volumes is a list of volume names related to this application
 	Volumes []Volume
+	// DependsOn lists the applications that must be ready before this application starts
+	DependsOn []v1beta1.ApplicationDependency
 	// Spec holds type-specific configuration, discriminated by AppType.
 	Spec ActionSpec
 }
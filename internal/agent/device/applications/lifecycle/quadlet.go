@@ -218,9 +218,33 @@ func (q *Quadlet) cleanResources(ctx context.Context, action Action) error {
 	if err := cleanPodmanResources(ctx, q.log, podman, labels, filters); err != nil {
 		return fmt.Errorf("cleaning podman resources: %w", err)
 	}
+	if err := removeDeletedVolumes(ctx, q.log, podman, action); err != nil {
+		return fmt.Errorf("removing volumes: %w", err)
+	}
 	return nil
 }
 
+// removeDeletedVolumes removes the volumes of a removed application whose reclaim policy is Delete. Updates keep
+// all volumes.
+func removeDeletedVolumes(ctx context.Context, log *log.PrefixLogger, podman *client.Podman, action Action) error {
+	if action.Type != ActionRemove {
+		return nil
+	}
+
+	var volumes []string
+	for _, volume := range action.Volumes {
+		if volume.ReclaimPolicy == v1beta1.Delete && podman.VolumeExists(ctx, volume.ID) {
+			volumes = append(volumes, volume.ID)
+		}
+	}
+	if len(volumes) == 0 {
+		return nil
+	}
+
+	log.Infof("Removing %d volume(s) of application %s with reclaim policy %s", len(volumes), action.Name, v1beta1.Delete)
+	return podman.RemoveVolumes(ctx, volumes...)
+}
+
 // removeImageBackedVolumes removes volumes that use Podman's native image driver.
 // Image-driver volumes are read-only overlays fully derived from their
 // source image — they contain no user data and Podman recreates them automatically on next container start.
@@ -308,6 +332,10 @@ func (q *Quadlet) ensureArtifactVolumes(ctx context.Context, action Action) erro
 		return err
 	}
 	for _, volume := range action.Volumes {
+		if volume.Reference == "" {
+			// mount volumes are created by podman when the container starts
+			continue
+		}
 		if podman.ImageExists(ctx, volume.Reference) {
 			q.log.Debugf("Skipping image-backed volume with reference %s", volume.Reference)
 			continue
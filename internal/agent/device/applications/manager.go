@@ -3,7 +3,9 @@ package applications
 import (
 	"context"
 	"fmt"
+	"os/exec"
 	"sort"
+	"time"
 
 	"github.com/flightctl/flightctl/api/core/v1beta1"
 	"github.com/flightctl/flightctl/internal/agent/client"
@@ -210,6 +212,29 @@ func (m *manager) AfterUpdate(ctx context.Context) error {
 	return nil
 }
 
+func (m *manager) Restart(ctx context.Context, name string) error {
+	if err := m.podmanMonitor.Restart(ctx, name); err != nil {
+		return fmt.Errorf("restarting application %s: %w", name, err)
+	}
+	return nil
+}
+
+func (m *manager) Stats(ctx context.Context) (map[string][]client.ContainerStats, error) {
+	stats, err := m.podmanMonitor.Stats(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("collecting application stats: %w", err)
+	}
+	return stats, nil
+}
+
+func (m *manager) LogsCmd(ctx context.Context, name string, follow bool, since time.Time) (*exec.Cmd, error) {
+	cmd, err := m.podmanMonitor.LogsCmd(ctx, name, follow, since)
+	if err != nil {
+		return nil, fmt.Errorf("reading logs of application %s: %w", name, err)
+	}
+	return cmd, nil
+}
+
 func (m *manager) clearAppDataCache() {
 	for name, cachedData := range m.appDataCache {
 		if err := cachedData.Cleanup(); err != nil {
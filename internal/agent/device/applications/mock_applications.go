@@ -11,9 +11,12 @@ package applications
 
 import (
 	context "context"
+	exec "os/exec"
 	reflect "reflect"
+	time "time"
 
 	v1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
+	client "github.com/flightctl/flightctl/internal/agent/client"
 	lifecycle "github.com/flightctl/flightctl/internal/agent/device/applications/lifecycle"
 	provider "github.com/flightctl/flightctl/internal/agent/device/applications/provider"
 	dependency "github.com/flightctl/flightctl/internal/agent/device/dependency"
@@ -161,6 +164,21 @@ func (mr *MockManagerMockRecorder) Ensure(ctx, provider any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ensure", reflect.TypeOf((*MockManager)(nil).Ensure), ctx, provider)
 }
 
+// LogsCmd mocks base method.
+func (m *MockManager) LogsCmd(ctx context.Context, name string, follow bool, since time.Time) (*exec.Cmd, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LogsCmd", ctx, name, follow, since)
+	ret0, _ := ret[0].(*exec.Cmd)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LogsCmd indicates an expected call of LogsCmd.
+func (mr *MockManagerMockRecorder) LogsCmd(ctx, name, follow, since any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogsCmd", reflect.TypeOf((*MockManager)(nil).LogsCmd), ctx, name, follow, since)
+}
+
 // Remove mocks base method.
 func (m *MockManager) Remove(ctx context.Context, provider provider.Provider) error {
 	m.ctrl.T.Helper()
@@ -175,6 +193,20 @@ func (mr *MockManagerMockRecorder) Remove(ctx, provider any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockManager)(nil).Remove), ctx, provider)
 }
 
+// Restart mocks base method.
+func (m *MockManager) Restart(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restart", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restart indicates an expected call of Restart.
+func (mr *MockManagerMockRecorder) Restart(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restart", reflect.TypeOf((*MockManager)(nil).Restart), ctx, name)
+}
+
 // Shutdown mocks base method.
 func (m *MockManager) Shutdown(ctx context.Context, state shutdown.State) error {
 	m.ctrl.T.Helper()
@@ -189,6 +221,21 @@ func (mr *MockManagerMockRecorder) Shutdown(ctx, state any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Shutdown", reflect.TypeOf((*MockManager)(nil).Shutdown), ctx, state)
 }
 
+// Stats mocks base method.
+func (m *MockManager) Stats(ctx context.Context) (map[string][]client.ContainerStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stats", ctx)
+	ret0, _ := ret[0].(map[string][]client.ContainerStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Stats indicates an expected call of Stats.
+func (mr *MockManagerMockRecorder) Stats(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stats", reflect.TypeOf((*MockManager)(nil).Stats), ctx)
+}
+
 // Status mocks base method.
 func (m *MockManager) Status(arg0 context.Context, arg1 *v1beta1.DeviceStatus, arg2 ...status.CollectorOpt) error {
 	m.ctrl.T.Helper()
@@ -309,6 +356,20 @@ func (mr *MockApplicationMockRecorder) CopyWorkloadsFrom(other any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyWorkloadsFrom", reflect.TypeOf((*MockApplication)(nil).CopyWorkloadsFrom), other)
 }
 
+// DependsOn mocks base method.
+func (m *MockApplication) DependsOn() []v1beta1.ApplicationDependency {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DependsOn")
+	ret0, _ := ret[0].([]v1beta1.ApplicationDependency)
+	return ret0
+}
+
+// DependsOn indicates an expected call of DependsOn.
+func (mr *MockApplicationMockRecorder) DependsOn() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DependsOn", reflect.TypeOf((*MockApplication)(nil).DependsOn))
+}
+
 // ID mocks base method.
 func (m *MockApplication) ID() string {
 	m.ctrl.T.Helper()
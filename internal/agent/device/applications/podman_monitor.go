@@ -43,6 +43,10 @@ type PodmanMonitor struct {
 	watchers       map[v1beta1.Username]*podmanEventWatcher
 	events         chan client.PodmanEvent
 
+	// dependencyTimeout bounds the wait for the dependencies of an application before it starts
+	dependencyTimeout      time.Duration
+	dependencyPollInterval time.Duration
+
 	log *log.PrefixLogger
 }
 
@@ -70,6 +74,8 @@ func NewPodmanMonitor(
 		apps:                   make(map[string]Application),
 		startTime:              startTime,
 		lastActionsSuccessTime: startTime,
+		dependencyTimeout:      defaultDependencyTimeout,
+		dependencyPollInterval: defaultDependencyPollInterval,
 		log:                    log,
 	}
 }
@@ -199,14 +205,15 @@ func (m *PodmanMonitor) Ensure(ctx context.Context, app Application) error {
 
 	appName := app.Name()
 	action := lifecycle.Action{
-		AppType:  app.AppType(),
-		Type:     lifecycle.ActionAdd,
-		User:     app.User(),
-		Name:     appName,
-		ID:       appID,
-		Path:     app.Path(),
-		Embedded: app.IsEmbedded(),
-		Volumes:  provider.ToLifecycleVolumes(app.Volume().List()),
+		AppType:   app.AppType(),
+		Type:      lifecycle.ActionAdd,
+		User:      app.User(),
+		Name:      appName,
+		ID:        appID,
+		Path:      app.Path(),
+		Embedded:  app.IsEmbedded(),
+		Volumes:   provider.ToLifecycleVolumes(app.Volume().List()),
+		DependsOn: app.DependsOn(),
 	}
 
 	m.actions = append(m.actions, action)
@@ -226,12 +233,13 @@ func (m *PodmanMonitor) QueueRemove(app Application) error {
 	appName := app.Name()
 
 	action := lifecycle.Action{
-		AppType: app.AppType(),
-		Type:    lifecycle.ActionRemove,
-		Name:    appName,
-		User:    app.User(),
-		ID:      appID,
-		Volumes: provider.ToLifecycleVolumes(app.Volume().List()),
+		AppType:   app.AppType(),
+		Type:      lifecycle.ActionRemove,
+		Name:      appName,
+		User:      app.User(),
+		ID:        appID,
+		Volumes:   provider.ToLifecycleVolumes(app.Volume().List()),
+		DependsOn: app.DependsOn(),
 	}
 
 	m.actions = append(m.actions, action)
@@ -252,18 +260,120 @@ func (m *PodmanMonitor) QueueUpdate(app Application) error {
 	m.apps[appID] = app
 
 	// currently we don't support updating embedded applications
+	action := lifecycle.Action{
+		AppType:   app.AppType(),
+		Type:      lifecycle.ActionUpdate,
+		Name:      app.Name(),
+		User:      app.User(),
+		ID:        appID,
+		Path:      app.Path(),
+		Volumes:   provider.ToLifecycleVolumes(app.Volume().List()),
+		DependsOn: app.DependsOn(),
+	}
+
+	m.actions = append(m.actions, action)
+	return nil
+}
+
+// appByName returns the application with the given name.
+func (m *PodmanMonitor) appByName(name string) (Application, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, a := range m.apps {
+		if a.Name() == name {
+			return a, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", errors.ErrAppNotFound, name)
+}
+
+// Restart immediately stops and starts the workloads of the application with the given name, independent of
+// any queued actions.
+func (m *PodmanMonitor) Restart(ctx context.Context, name string) error {
+	app, err := m.appByName(name)
+	if err != nil {
+		return err
+	}
+
+	appType := normalizeActionAppType(app.AppType())
+	handler, ok := m.handlers[appType]
+	if !ok {
+		return fmt.Errorf("%w: no action handler registered: %s", errors.ErrUnsupportedAppType, app.AppType())
+	}
 	action := lifecycle.Action{
 		AppType: app.AppType(),
 		Type:    lifecycle.ActionUpdate,
 		Name:    app.Name(),
 		User:    app.User(),
-		ID:      appID,
+		ID:      app.ID(),
 		Path:    app.Path(),
 		Volumes: provider.ToLifecycleVolumes(app.Volume().List()),
 	}
+	return handler.Execute(m.addBatchTimeToCtx(ctx), lifecycle.Actions{action})
+}
 
-	m.actions = append(m.actions, action)
-	return nil
+// LogsCmd returns a command that writes the logs of the containers of the application with the given name to
+// its stdout and stderr.
+func (m *PodmanMonitor) LogsCmd(ctx context.Context, name string, follow bool, since time.Time) (*exec.Cmd, error) {
+	app, err := m.appByName(name)
+	if err != nil {
+		return nil, err
+	}
+	podman, err := m.clientFactory(app.User())
+	if err != nil {
+		return nil, fmt.Errorf("creating podman client for user %s: %w", app.User(), err)
+	}
+
+	containers, err := appContainers(ctx, podman, app)
+	if err != nil {
+		return nil, err
+	}
+	if len(containers) == 0 {
+		return nil, fmt.Errorf("application %s has no containers", name)
+	}
+	return podman.LogsCmd(ctx, containers, follow, since), nil
+}
+
+// Stats returns the resource usage of the running containers of each application, keyed by application name.
+// Applications without running containers are omitted.
+func (m *PodmanMonitor) Stats(ctx context.Context) (map[string][]client.ContainerStats, error) {
+	m.mu.Lock()
+	apps := lo.Values(m.apps)
+	m.mu.Unlock()
+
+	result := make(map[string][]client.ContainerStats)
+	for user, userApps := range lo.GroupBy(apps, func(a Application) v1beta1.Username { return a.User() }) {
+		podman, err := m.clientFactory(user)
+		if err != nil {
+			return nil, fmt.Errorf("creating podman client for user %s: %w", user, err)
+		}
+		stats, err := podman.Stats(ctx)
+		if err != nil {
+			return nil, err
+		}
+		byName := lo.KeyBy(stats, func(s client.ContainerStats) string { return s.Name })
+		for _, app := range userApps {
+			containers, err := appContainers(ctx, podman, app)
+			if err != nil {
+				return nil, err
+			}
+			for _, container := range containers {
+				if s, ok := byName[container]; ok {
+					result[app.Name()] = append(result[app.Name()], s)
+				}
+			}
+		}
+	}
+	return result, nil
+}
+
+// appContainers returns the names of the containers of the application.
+func appContainers(ctx context.Context, podman *client.Podman, app Application) ([]string, error) {
+	labelKey := client.QuadletProjectLabelKey
+	if app.AppType() == v1beta1.AppTypeCompose {
+		labelKey = client.ComposeDockerProjectLabelKey
+	}
+	return podman.ListContainers(ctx, []string{fmt.Sprintf("%s=%s", labelKey, app.ID())})
 }
 
 func (m *PodmanMonitor) addBatchTimeToCtx(ctx context.Context) context.Context {
@@ -295,6 +405,30 @@ func (m *PodmanMonitor) executeActions(ctx context.Context, systemShutdown bool)
 	ctx = m.addBatchTimeToCtx(ctx)
 	actions := m.drainActions()
 
+	batches, err := orderActions(actions)
+	if err != nil {
+		return err
+	}
+
+	for _, batch := range batches {
+		if err := m.waitForDependencies(ctx, batch); err != nil {
+			return err
+		}
+		if err := m.executeBatch(ctx, batch, systemShutdown); err != nil {
+			return err
+		}
+		// dependents of this batch wait for the status reported by the monitor
+		if err := m.updateMonitors(ctx, batch); err != nil {
+			return err
+		}
+	}
+
+	m.updateLastSuccessTime(time.Now())
+	return nil
+}
+
+// executeBatch executes the actions with the handlers of their application types.
+func (m *PodmanMonitor) executeBatch(ctx context.Context, actions []lifecycle.Action, systemShutdown bool) error {
 	groupedActions := make(map[v1beta1.AppType][]lifecycle.Action)
 	for i := range actions {
 		action := actions[i]
@@ -317,9 +451,11 @@ func (m *PodmanMonitor) executeActions(ctx context.Context, systemShutdown bool)
 			return err
 		}
 	}
+	return nil
+}
 
-	m.updateLastSuccessTime(time.Now())
-
+// updateMonitors starts and stops the podman event watchers of the users of the executed actions.
+func (m *PodmanMonitor) updateMonitors(ctx context.Context, actions []lifecycle.Action) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
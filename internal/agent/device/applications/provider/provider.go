@@ -87,6 +87,22 @@ type ApplicationSpec struct {
 	QuadletApp   *v1beta1.QuadletApplication
 }
 
+// DependsOn returns the applications that must be ready before the application starts.
+func (s *ApplicationSpec) DependsOn() []v1beta1.ApplicationDependency {
+	switch {
+	case s.ContainerApp != nil:
+		return lo.FromPtr(s.ContainerApp.DependsOn)
+	case s.HelmApp != nil:
+		return lo.FromPtr(s.HelmApp.DependsOn)
+	case s.ComposeApp != nil:
+		return lo.FromPtr(s.ComposeApp.DependsOn)
+	case s.QuadletApp != nil:
+		return lo.FromPtr(s.QuadletApp.DependsOn)
+	default:
+		return nil
+	}
+}
+
 func pullAuthPathForUser(username v1beta1.Username) string {
 	u, err := user.Lookup(username.WithDefault(v1beta1.RootUsername).String())
 	// If we have an error it is because the user doesn't exist or the homedir isn't set, in which
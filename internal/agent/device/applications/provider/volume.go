@@ -23,7 +23,7 @@ type Volume struct {
 	Name string
 	// ID is a unique internal idenfier used to create the actual volume
 	ID string
-	// Reference is the reference used to populate the volume
+	// Reference is the reference used to populate the volume. It is empty for mount volumes.
 	Reference string
 	// Available is true if the volume has been created
 	Available bool
@@ -69,23 +69,22 @@ func NewVolumeManager(log *log.PrefixLogger, appName string, appType v1beta1.App
 		if err != nil {
 			return nil, fmt.Errorf("volume type: %w", err)
 		}
-		var image *v1beta1.ImageVolumeSource
+		var reference string
 		switch volType {
 		case v1beta1.ImageApplicationVolumeProviderType:
 			provider, err := v.AsImageVolumeProviderSpec()
 			if err != nil {
 				return nil, err
 			}
-			image = &provider.Image
+			reference = provider.Image.Reference
 		case v1beta1.ImageMountApplicationVolumeProviderType:
 			provider, err := v.AsImageMountVolumeProviderSpec()
 			if err != nil {
 				return nil, err
 			}
-			image = &provider.Image
+			reference = provider.Image.Reference
 		case v1beta1.MountApplicationVolumeProviderType:
-			// nothing to manage
-			continue
+			// podman creates the volume, it is tracked for its reclaim policy
 		default:
 			return nil, fmt.Errorf("%w: %s", errors.ErrUnsupportedVolumeType, volType)
 		}
@@ -93,7 +92,7 @@ func NewVolumeManager(log *log.PrefixLogger, appName string, appType v1beta1.App
 		policy := v.GetReclaimPolicy()
 		m.volumes[volID] = &Volume{
 			Name:          v.Name,
-			Reference:     image.Reference,
+			Reference:     reference,
 			ID:            volID,
 			Available:     true, // TODO: event support is broken for volumes.  https://github.com/containers/podman/issues/26480
 			ReclaimPolicy: policy,
@@ -174,8 +173,8 @@ func (m *volumeManager) List() []*Volume {
 func (m *volumeManager) Status(status *v1beta1.DeviceApplicationStatus) {
 	volumes := make([]v1beta1.ApplicationVolumeStatus, 0, len(m.volumes))
 	for _, vol := range m.List() {
-		if !vol.Available {
-			// only report obsereved status
+		if !vol.Available || vol.Reference == "" {
+			// only report obsereved status of image backed volumes
 			continue
 		}
 		volumes = append(volumes, v1beta1.ApplicationVolumeStatus{
@@ -0,0 +1,137 @@
+package attestation
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/client"
+	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/flightctl/flightctl/internal/tpm"
+	"github.com/flightctl/flightctl/pkg/log"
+)
+
+// Manager periodically answers attestation challenges from the management
+// server with a PCR quote signed by the device's TPM attestation key.
+type Manager struct {
+	deviceName       string
+	managementClient client.Management
+	tpmClient        tpm.Client
+	reader           fileio.Reader
+	interval         time.Duration
+	eventLogPath     string
+	log              *log.PrefixLogger
+}
+
+// NewManager creates a new attestation manager.
+func NewManager(
+	deviceName string,
+	managementClient client.Management,
+	tpmClient tpm.Client,
+	reader fileio.Reader,
+	interval time.Duration,
+	log *log.PrefixLogger,
+) *Manager {
+	return &Manager{
+		deviceName:       deviceName,
+		managementClient: managementClient,
+		tpmClient:        tpmClient,
+		reader:           reader,
+		interval:         interval,
+		eventLogPath:     tpm.DefaultEventLogPath,
+		log:              log,
+	}
+}
+
+// Run attests the device once at startup and then on every interval until the context is cancelled.
+func (m *Manager) Run(ctx context.Context) {
+	if m.interval <= 0 {
+		m.log.Info("TPM attestation is disabled")
+		return
+	}
+
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := m.Sync(ctx); err != nil {
+			m.log.Errorf("Failed to attest device: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Sync performs a single challenge-response round with the management server.
+func (m *Manager) Sync(ctx context.Context) error {
+	challenge, err := m.managementClient.CreateAttestationChallenge(ctx, m.deviceName)
+	if err != nil {
+		return fmt.Errorf("requesting challenge: %w", err)
+	}
+
+	quote, err := m.tpmClient.Quote(challenge.Nonce, challenge.Pcrs)
+	if err != nil {
+		return fmt.Errorf("quoting PCRs: %w", err)
+	}
+
+	report := v1beta1.AttestationReport{
+		Nonce:     challenge.Nonce,
+		Quote:     quote.Quoted,
+		Signature: quote.Signature,
+		Pcrs:      pcrValues(quote.PCRs),
+	}
+
+	eventLog, err := m.readEventLog()
+	if err != nil {
+		// the quote alone is still meaningful, so only the event log replay is skipped
+		m.log.Warnf("Failed to read TPM event log: %v", err)
+	} else if eventLog != nil {
+		report.EventLog = &eventLog
+	}
+
+	integrity, err := m.managementClient.ReplaceDeviceAttestation(ctx, m.deviceName, report)
+	if err != nil {
+		return fmt.Errorf("submitting attestation: %w", err)
+	}
+
+	if integrity.Status == v1beta1.DeviceIntegrityStatusFailed {
+		m.log.Warnf("Device attestation failed: %s", integrityInfo(integrity))
+	} else {
+		m.log.Debugf("Device attestation completed with status %s", integrity.Status)
+	}
+	return nil
+}
+
+func (m *Manager) readEventLog() ([]byte, error) {
+	exists, err := m.reader.PathExists(m.eventLogPath, fileio.WithSkipContentCheck())
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, nil
+	}
+	return m.reader.ReadFile(m.eventLogPath)
+}
+
+func pcrValues(pcrs map[int][]byte) []v1beta1.PcrValue {
+	values := make([]v1beta1.PcrValue, 0, len(pcrs))
+	for index, digest := range pcrs {
+		values = append(values, v1beta1.PcrValue{Index: index, Digest: hex.EncodeToString(digest)})
+	}
+	sort.Slice(values, func(i, j int) bool { return values[i].Index < values[j].Index })
+	return values
+}
+
+func integrityInfo(integrity *v1beta1.DeviceIntegrityStatus) string {
+	if integrity.Info == nil {
+		return "no details"
+	}
+	return *integrity.Info
+}
@@ -0,0 +1,96 @@
+package attestation
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/client"
+	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/flightctl/flightctl/internal/tpm"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestManagerSync(t *testing.T) {
+	nonce := []byte("nonce")
+	challenge := &v1beta1.AttestationChallenge{Nonce: nonce, Pcrs: []int{7, 0}}
+	quote := &tpm.PCRQuote{
+		Quoted:    []byte("quoted"),
+		Signature: []byte("signature"),
+		PCRs:      map[int][]byte{7: {0xbb}, 0: {0xaa}},
+	}
+	eventLog := []byte("event-log")
+
+	tests := []struct {
+		name          string
+		setupMocks    func(mgmt *client.MockManagement, tpmClient *tpm.MockClient, reader *fileio.MockReader)
+		expectedError string
+	}{
+		{
+			name: "sends quote with event log",
+			setupMocks: func(mgmt *client.MockManagement, tpmClient *tpm.MockClient, reader *fileio.MockReader) {
+				mgmt.EXPECT().CreateAttestationChallenge(gomock.Any(), "device").Return(challenge, nil)
+				tpmClient.EXPECT().Quote(nonce, challenge.Pcrs).Return(quote, nil)
+				reader.EXPECT().PathExists(tpm.DefaultEventLogPath, gomock.Any()).Return(true, nil)
+				reader.EXPECT().ReadFile(tpm.DefaultEventLogPath).Return(eventLog, nil)
+				mgmt.EXPECT().ReplaceDeviceAttestation(gomock.Any(), "device", v1beta1.AttestationReport{
+					Nonce:     nonce,
+					Quote:     quote.Quoted,
+					Signature: quote.Signature,
+					Pcrs:      []v1beta1.PcrValue{{Index: 0, Digest: "aa"}, {Index: 7, Digest: "bb"}},
+					EventLog:  &eventLog,
+				}).Return(&v1beta1.DeviceIntegrityStatus{Status: v1beta1.DeviceIntegrityStatusVerified}, nil)
+			},
+		},
+		{
+			name: "sends quote without event log when it is not exposed",
+			setupMocks: func(mgmt *client.MockManagement, tpmClient *tpm.MockClient, reader *fileio.MockReader) {
+				mgmt.EXPECT().CreateAttestationChallenge(gomock.Any(), "device").Return(challenge, nil)
+				tpmClient.EXPECT().Quote(nonce, challenge.Pcrs).Return(quote, nil)
+				reader.EXPECT().PathExists(tpm.DefaultEventLogPath, gomock.Any()).Return(false, nil)
+				mgmt.EXPECT().ReplaceDeviceAttestation(gomock.Any(), "device", gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ string, report v1beta1.AttestationReport, _ ...any) (*v1beta1.DeviceIntegrityStatus, error) {
+						require.Nil(t, report.EventLog)
+						return &v1beta1.DeviceIntegrityStatus{Status: v1beta1.DeviceIntegrityStatusFailed}, nil
+					})
+			},
+		},
+		{
+			name: "challenge request fails",
+			setupMocks: func(mgmt *client.MockManagement, tpmClient *tpm.MockClient, reader *fileio.MockReader) {
+				mgmt.EXPECT().CreateAttestationChallenge(gomock.Any(), "device").Return(nil, errors.New("unavailable"))
+			},
+			expectedError: "requesting challenge",
+		},
+		{
+			name: "quote fails",
+			setupMocks: func(mgmt *client.MockManagement, tpmClient *tpm.MockClient, reader *fileio.MockReader) {
+				mgmt.EXPECT().CreateAttestationChallenge(gomock.Any(), "device").Return(challenge, nil)
+				tpmClient.EXPECT().Quote(nonce, challenge.Pcrs).Return(nil, errors.New("tpm error"))
+			},
+			expectedError: "quoting PCRs",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mgmt := client.NewMockManagement(ctrl)
+			tpmClient := tpm.NewMockClient(ctrl)
+			reader := fileio.NewMockReader(ctrl)
+			tt.setupMocks(mgmt, tpmClient, reader)
+
+			m := NewManager("device", mgmt, tpmClient, reader, time.Minute, log.NewPrefixLogger("test"))
+			err := m.Sync(context.Background())
+			if tt.expectedError != "" {
+				require.ErrorContains(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
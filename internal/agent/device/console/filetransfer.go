@@ -0,0 +1,313 @@
+package console
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	grpc_v1 "github.com/flightctl/flightctl/api/grpc/v1"
+	"github.com/flightctl/flightctl/internal/console/filetransfer"
+	"github.com/flightctl/flightctl/pkg/log"
+	"golang.org/x/sys/unix"
+)
+
+const defaultUploadMode = 0o644
+
+var errSessionClosed = errors.New("session closed")
+
+// fileTransfer copies a single file from or to the device over a router stream.
+type fileTransfer struct {
+	streamClient grpc_v1.RouterService_StreamClient
+	allowedPaths []string
+	log          *log.PrefixLogger
+}
+
+func newFileTransfer(streamClient grpc_v1.RouterService_StreamClient, allowedPaths []string, log *log.PrefixLogger) *fileTransfer {
+	return &fileTransfer{
+		streamClient: streamClient,
+		allowedPaths: allowedPaths,
+		log:          log,
+	}
+}
+
+func (f *fileTransfer) send(m filetransfer.Message) error {
+	return f.streamClient.Send(&grpc_v1.StreamRequest{Payload: m.Encode()})
+}
+
+func (f *fileTransfer) sendJSON(t filetransfer.MessageType, v any) error {
+	m, err := filetransfer.NewMessage(t, v)
+	if err != nil {
+		return err
+	}
+	return f.send(m)
+}
+
+func (f *fileTransfer) recv() (filetransfer.Message, error) {
+	msg, err := f.streamClient.Recv()
+	if err == io.EOF || msg != nil && msg.Closed {
+		return filetransfer.Message{}, errSessionClosed
+	}
+	if err != nil {
+		return filetransfer.Message{}, err
+	}
+	return filetransfer.Decode(msg.GetPayload())
+}
+
+// isAllowed reports whether the path is within one of the allow-listed paths. If no paths are allow-listed,
+// any path may be used.
+func (f *fileTransfer) isAllowed(path string) bool {
+	if len(f.allowedPaths) == 0 {
+		return true
+	}
+	for _, allowed := range f.allowedPaths {
+		allowed = filepath.Clean(allowed)
+		if path == allowed || strings.HasPrefix(path, strings.TrimSuffix(allowed, "/")+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// resolvePath returns the path with all symbolic links resolved, after verifying that it is allowed.
+// The file itself does not need to exist, but its parent directory does.
+func (f *fileTransfer) resolvePath(path string) (string, error) {
+	if !filepath.IsAbs(path) {
+		return "", fmt.Errorf("path %q must be absolute", path)
+	}
+	path = filepath.Clean(path)
+	resolved, err := filepath.EvalSymlinks(path)
+	if errors.Is(err, os.ErrNotExist) {
+		var dir string
+		dir, err = filepath.EvalSymlinks(filepath.Dir(path))
+		resolved = filepath.Join(dir, filepath.Base(path))
+	}
+	if err != nil {
+		return "", fmt.Errorf("resolving path %q: %w", path, err)
+	}
+	if !f.isAllowed(resolved) {
+		return "", fmt.Errorf("path %q is not allowed for file transfer", path)
+	}
+	return resolved, nil
+}
+
+// partialPath returns the path of the partial file an upload to the resolved path is received into, after
+// verifying that neither the partial file nor its recorded source is a symbolic link or another non-regular
+// file that would redirect the writes elsewhere.
+func (f *fileTransfer) partialPath(path string) (string, error) {
+	partPath := path + filetransfer.PartialSuffix
+	if !f.isAllowed(partPath) {
+		return "", fmt.Errorf("path %q is not allowed for file transfer", partPath)
+	}
+	for _, p := range []string{partPath, filetransfer.PartialInfoPath(partPath)} {
+		info, err := os.Lstat(p)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			return "", err
+		}
+		if !info.Mode().IsRegular() {
+			return "", fmt.Errorf("%q is not a regular file", p)
+		}
+	}
+	return partPath, nil
+}
+
+func (f *fileTransfer) download(req *filetransfer.Request) error {
+	path, err := f.resolvePath(req.Path)
+	if err != nil {
+		return err
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	if !info.Mode().IsRegular() {
+		return fmt.Errorf("%q is not a regular file", req.Path)
+	}
+	sum, err := filetransfer.FileSHA256(path)
+	if err != nil {
+		return fmt.Errorf("computing checksum of %q: %w", req.Path, err)
+	}
+
+	// Resume only if the client received the first bytes from the same, unchanged file
+	offset := req.Offset
+	if offset < 0 || offset > info.Size() || req.SHA256 != sum {
+		offset = 0
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	if _, err = file.Seek(offset, io.SeekStart); err != nil {
+		return err
+	}
+
+	if err = f.sendJSON(filetransfer.MessageInfo, &filetransfer.Info{
+		Offset: offset,
+		Size:   info.Size(),
+		Mode:   uint32(info.Mode().Perm()),
+		SHA256: sum,
+	}); err != nil {
+		return err
+	}
+
+	f.log.Debugf("file transfer: sending %q from offset %d", path, offset)
+	buffer := make([]byte, filetransfer.ChunkSize)
+	for {
+		n, err := file.Read(buffer)
+		if n > 0 {
+			if sendErr := f.send(filetransfer.Message{Type: filetransfer.MessageData, Payload: buffer[:n]}); sendErr != nil {
+				return sendErr
+			}
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("reading %q: %w", req.Path, err)
+		}
+	}
+	return f.sendJSON(filetransfer.MessageDone, &filetransfer.Done{})
+}
+
+func (f *fileTransfer) upload(req *filetransfer.Request) error {
+	path, err := f.resolvePath(req.Path)
+	if err != nil {
+		return err
+	}
+	if info, err := os.Stat(path); err == nil && info.IsDir() {
+		name := filepath.Base(req.Name)
+		if req.Name == "" || name == "." || name == ".." || name == "/" {
+			return fmt.Errorf("%q is a directory", req.Path)
+		}
+		if path, err = f.resolvePath(filepath.Join(path, name)); err != nil {
+			return err
+		}
+	}
+
+	// Continue an earlier, interrupted upload of the same file if there is one
+	partPath, err := f.partialPath(path)
+	if err != nil {
+		return err
+	}
+	source := filetransfer.PartialInfo{Size: req.Size, SHA256: req.SHA256}
+	offset := filetransfer.ResumeOffset(partPath, source)
+	mode := os.FileMode(req.Mode).Perm()
+	if mode == 0 {
+		mode = defaultUploadMode
+	}
+	// never follow a symbolic link placed at the partial file's path after it was checked
+	file, err := os.OpenFile(partPath, os.O_CREATE|os.O_WRONLY|unix.O_NOFOLLOW, mode)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	if err = file.Truncate(offset); err != nil {
+		return err
+	}
+	if _, err = file.Seek(offset, io.SeekStart); err != nil {
+		return err
+	}
+	if err = filetransfer.WritePartialInfo(partPath, source); err != nil {
+		return err
+	}
+
+	if err = f.sendJSON(filetransfer.MessageInfo, &filetransfer.Info{Offset: offset}); err != nil {
+		return err
+	}
+
+	f.log.Debugf("file transfer: receiving %q from offset %d", path, offset)
+	for {
+		m, err := f.recv()
+		if err != nil {
+			// keep the partial file, so that the upload can be resumed
+			return err
+		}
+		switch m.Type {
+		case filetransfer.MessageData:
+			if _, err = file.Write(m.Payload); err != nil {
+				return fmt.Errorf("writing %q: %w", req.Path, err)
+			}
+		case filetransfer.MessageDone:
+			var done filetransfer.Done
+			if err = m.Unmarshal(&done); err != nil {
+				return err
+			}
+			return f.completeUpload(file, partPath, path, mode, req.Size, done.SHA256)
+		case filetransfer.MessageError:
+			return fmt.Errorf("client error: %s", string(m.Payload))
+		default:
+			return fmt.Errorf("unexpected message type %d", m.Type)
+		}
+	}
+}
+
+// completeUpload verifies the received file and moves it to its final location.
+func (f *fileTransfer) completeUpload(file *os.File, partPath, path string, mode os.FileMode, size int64, expectedSum string) error {
+	if err := file.Chmod(mode); err != nil {
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	sum, err := filetransfer.FileSHA256(partPath)
+	if err != nil {
+		return err
+	}
+	info, err := os.Stat(partPath)
+	if err != nil {
+		return err
+	}
+	if info.Size() != size || sum != expectedSum {
+		filetransfer.RemovePartial(partPath)
+		return fmt.Errorf("checksum mismatch: expected %s, got %s", expectedSum, sum)
+	}
+	if err = os.Rename(partPath, path); err != nil {
+		return err
+	}
+	filetransfer.RemovePartial(partPath)
+	f.log.Debugf("file transfer: received %q", path)
+	return f.sendJSON(filetransfer.MessageDone, &filetransfer.Done{SHA256: sum})
+}
+
+func (f *fileTransfer) run() {
+	err := f.serve()
+	switch {
+	case err == nil:
+	case errors.Is(err, errSessionClosed):
+		f.log.Debug("file transfer: connection closed")
+	default:
+		f.log.Errorf("file transfer: %v", err)
+		if sendErr := f.send(filetransfer.NewErrorMessage(err)); sendErr != nil {
+			f.log.Debugf("file transfer: failed sending error: %v", sendErr)
+		}
+	}
+}
+
+func (f *fileTransfer) serve() error {
+	m, err := f.recv()
+	if err != nil {
+		return err
+	}
+	if m.Type != filetransfer.MessageRequest {
+		return fmt.Errorf("expected a request, got message type %d", m.Type)
+	}
+	var req filetransfer.Request
+	if err = m.Unmarshal(&req); err != nil {
+		return err
+	}
+	switch req.Operation {
+	case filetransfer.OperationDownload:
+		return f.download(&req)
+	case filetransfer.OperationUpload:
+		return f.upload(&req)
+	default:
+		return fmt.Errorf("unsupported operation %q", req.Operation)
+	}
+}
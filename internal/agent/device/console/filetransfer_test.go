@@ -0,0 +1,260 @@
+package console
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	grpc_v1 "github.com/flightctl/flightctl/api/grpc/v1"
+	"github.com/flightctl/flightctl/internal/console/filetransfer"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func sha256Hex(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+func jsonMessage(t *testing.T, msgType filetransfer.MessageType, v any) filetransfer.Message {
+	m, err := filetransfer.NewMessage(msgType, v)
+	require.NoError(t, err)
+	return m
+}
+
+// runFileTransfer runs a file transfer session that receives the given messages and returns the messages sent by the agent.
+func runFileTransfer(t *testing.T, allowedPaths []string, input ...filetransfer.Message) []filetransfer.Message {
+	ctrl := gomock.NewController(t)
+	streamClient := NewMockRouterService_StreamClient(ctrl)
+	var sent []filetransfer.Message
+
+	streamClient.EXPECT().Recv().DoAndReturn(func() (*grpc_v1.StreamResponse, error) {
+		if len(input) == 0 {
+			return nil, io.EOF
+		}
+		m := input[0]
+		input = input[1:]
+		return &grpc_v1.StreamResponse{Payload: m.Encode()}, nil
+	}).AnyTimes()
+	streamClient.EXPECT().Send(gomock.Any()).DoAndReturn(func(req *grpc_v1.StreamRequest) error {
+		m, err := filetransfer.Decode(req.Payload)
+		require.NoError(t, err)
+		sent = append(sent, m)
+		return nil
+	}).AnyTimes()
+
+	newFileTransfer(streamClient, allowedPaths, log.NewPrefixLogger("test")).run()
+	return sent
+}
+
+func TestFileTransferDownload(t *testing.T) {
+	dir := t.TempDir()
+	content := []byte("0123456789")
+	path := filepath.Join(dir, "file")
+	require.NoError(t, os.WriteFile(path, content, 0640))
+
+	tests := []struct {
+		name           string
+		allowedPaths   []string
+		offset         int64
+		sha256         string
+		expectedOffset int64
+		expectedError  string
+	}{
+		{
+			name: "whole file",
+		},
+		{
+			name:           "resumes from offset",
+			offset:         4,
+			sha256:         sha256Hex(content),
+			expectedOffset: 4,
+		},
+		{
+			name:           "invalid offset restarts",
+			offset:         100,
+			sha256:         sha256Hex(content),
+			expectedOffset: 0,
+		},
+		{
+			name:           "changed file restarts",
+			offset:         4,
+			sha256:         sha256Hex([]byte("other")),
+			expectedOffset: 0,
+		},
+		{
+			name:         "within allowed path",
+			allowedPaths: []string{dir},
+		},
+		{
+			name:          "outside allowed path",
+			allowedPaths:  []string{filepath.Join(dir, "other")},
+			expectedError: "not allowed",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sent := runFileTransfer(t, tt.allowedPaths, jsonMessage(t, filetransfer.MessageRequest, &filetransfer.Request{
+				Operation: filetransfer.OperationDownload,
+				Path:      path,
+				Offset:    tt.offset,
+				SHA256:    tt.sha256,
+			}))
+			if tt.expectedError != "" {
+				require.Len(t, sent, 1)
+				require.Equal(t, filetransfer.MessageError, sent[0].Type)
+				require.Contains(t, string(sent[0].Payload), tt.expectedError)
+				return
+			}
+
+			require.Equal(t, filetransfer.MessageInfo, sent[0].Type)
+			var info filetransfer.Info
+			require.NoError(t, sent[0].Unmarshal(&info))
+			require.Equal(t, tt.expectedOffset, info.Offset)
+			require.Equal(t, int64(len(content)), info.Size)
+			require.Equal(t, uint32(0640), info.Mode)
+			require.Equal(t, sha256Hex(content), info.SHA256)
+
+			var received []byte
+			for _, m := range sent[1 : len(sent)-1] {
+				require.Equal(t, filetransfer.MessageData, m.Type)
+				received = append(received, m.Payload...)
+			}
+			require.Equal(t, content[tt.expectedOffset:], received)
+			require.Equal(t, filetransfer.MessageDone, sent[len(sent)-1].Type)
+		})
+	}
+}
+
+func TestFileTransferUpload(t *testing.T) {
+	content := []byte("0123456789")
+
+	upload := func(t *testing.T, path string, sum string, data ...[]byte) []filetransfer.Message {
+		input := []filetransfer.Message{jsonMessage(t, filetransfer.MessageRequest, &filetransfer.Request{
+			Operation: filetransfer.OperationUpload,
+			Path:      path,
+			Name:      "file",
+			Size:      int64(len(content)),
+			Mode:      0600,
+			SHA256:    sum,
+		})}
+		for _, d := range data {
+			input = append(input, filetransfer.Message{Type: filetransfer.MessageData, Payload: d})
+		}
+		input = append(input, jsonMessage(t, filetransfer.MessageDone, &filetransfer.Done{SHA256: sum}))
+		return runFileTransfer(t, nil, input...)
+	}
+
+	t.Run("into a directory", func(t *testing.T) {
+		dir := t.TempDir()
+		sent := upload(t, dir, sha256Hex(content), content[:4], content[4:])
+
+		require.Len(t, sent, 2)
+		require.Equal(t, filetransfer.MessageDone, sent[1].Type)
+		written, err := os.ReadFile(filepath.Join(dir, "file"))
+		require.NoError(t, err)
+		require.Equal(t, content, written)
+		info, err := os.Stat(filepath.Join(dir, "file"))
+		require.NoError(t, err)
+		require.Equal(t, os.FileMode(0600), info.Mode().Perm())
+	})
+
+	t.Run("resumes a partial upload", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "target")
+		partPath := path + filetransfer.PartialSuffix
+		require.NoError(t, os.WriteFile(partPath, content[:6], 0600))
+		require.NoError(t, filetransfer.WritePartialInfo(partPath, filetransfer.PartialInfo{Size: int64(len(content)), SHA256: sha256Hex(content)}))
+		sent := upload(t, path, sha256Hex(content), content[6:])
+
+		var info filetransfer.Info
+		require.NoError(t, sent[0].Unmarshal(&info))
+		require.Equal(t, int64(6), info.Offset)
+		require.Equal(t, filetransfer.MessageDone, sent[1].Type)
+		written, err := os.ReadFile(path)
+		require.NoError(t, err)
+		require.Equal(t, content, written)
+		require.NoFileExists(t, path+filetransfer.PartialInfoSuffix)
+	})
+
+	t.Run("discards a partial upload of another file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "target")
+		partPath := path + filetransfer.PartialSuffix
+		require.NoError(t, os.WriteFile(partPath, []byte("other"), 0600))
+		require.NoError(t, filetransfer.WritePartialInfo(partPath, filetransfer.PartialInfo{Size: int64(len(content)), SHA256: sha256Hex([]byte("other"))}))
+		sent := upload(t, path, sha256Hex(content), content)
+
+		var info filetransfer.Info
+		require.NoError(t, sent[0].Unmarshal(&info))
+		require.Equal(t, int64(0), info.Offset)
+		require.Equal(t, filetransfer.MessageDone, sent[1].Type)
+		written, err := os.ReadFile(path)
+		require.NoError(t, err)
+		require.Equal(t, content, written)
+	})
+
+	t.Run("rejects a checksum mismatch", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "target")
+		sent := upload(t, path, sha256Hex([]byte("other")), content)
+
+		require.Equal(t, filetransfer.MessageError, sent[len(sent)-1].Type)
+		require.Contains(t, string(sent[len(sent)-1].Payload), "checksum mismatch")
+		require.NoFileExists(t, path)
+		require.NoFileExists(t, path+filetransfer.PartialSuffix)
+		require.NoFileExists(t, path+filetransfer.PartialInfoSuffix)
+	})
+
+	t.Run("rejects a partial file that is a symbolic link", func(t *testing.T) {
+		dir := t.TempDir()
+		victim := filepath.Join(t.TempDir(), "victim")
+		require.NoError(t, os.WriteFile(victim, []byte("untouched"), 0600))
+		path := filepath.Join(dir, "target")
+		require.NoError(t, os.Symlink(victim, path+filetransfer.PartialSuffix))
+		sent := upload(t, path, sha256Hex(content), content)
+
+		require.Len(t, sent, 1)
+		require.Equal(t, filetransfer.MessageError, sent[0].Type)
+		require.Contains(t, string(sent[0].Payload), "is not a regular file")
+		written, err := os.ReadFile(victim)
+		require.NoError(t, err)
+		require.Equal(t, []byte("untouched"), written)
+		require.NoFileExists(t, path)
+	})
+
+	t.Run("rejects a recorded source that is a symbolic link", func(t *testing.T) {
+		dir := t.TempDir()
+		victim := filepath.Join(t.TempDir(), "victim")
+		require.NoError(t, os.WriteFile(victim, []byte("untouched"), 0600))
+		path := filepath.Join(dir, "target")
+		require.NoError(t, os.Symlink(victim, path+filetransfer.PartialInfoSuffix))
+		sent := upload(t, path, sha256Hex(content), content)
+
+		require.Len(t, sent, 1)
+		require.Equal(t, filetransfer.MessageError, sent[0].Type)
+		written, err := os.ReadFile(victim)
+		require.NoError(t, err)
+		require.Equal(t, []byte("untouched"), written)
+	})
+
+	t.Run("keeps the partial file when interrupted", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "target")
+		runFileTransfer(t, nil,
+			jsonMessage(t, filetransfer.MessageRequest, &filetransfer.Request{
+				Operation: filetransfer.OperationUpload,
+				Path:      path,
+				Size:      int64(len(content)),
+				SHA256:    sha256Hex(content),
+			}),
+			filetransfer.Message{Type: filetransfer.MessageData, Payload: content[:3]},
+		)
+
+		require.NoFileExists(t, path)
+		partial, err := os.ReadFile(path + filetransfer.PartialSuffix)
+		require.NoError(t, err)
+		require.Equal(t, content[:3], partial)
+		require.Equal(t, int64(3), filetransfer.ResumeOffset(path+filetransfer.PartialSuffix, filetransfer.PartialInfo{Size: int64(len(content)), SHA256: sha256Hex(content)}))
+	})
+}
@@ -0,0 +1,152 @@
+package console
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"sync"
+	"time"
+
+	grpc_v1 "github.com/flightctl/flightctl/api/grpc/v1"
+	"github.com/flightctl/flightctl/internal/agent/client"
+	"github.com/flightctl/flightctl/internal/console/logstream"
+	"github.com/flightctl/flightctl/pkg/log"
+)
+
+// AppLogger creates commands that write the logs of the applications running on the device.
+type AppLogger interface {
+	LogsCmd(ctx context.Context, name string, follow bool, since time.Time) (*exec.Cmd, error)
+}
+
+// logStreamer streams the logs of an application or a systemd unit over a router stream.
+type logStreamer struct {
+	streamClient grpc_v1.RouterService_StreamClient
+	appLogger    AppLogger
+	journalctl   *client.Journalctl
+	log          *log.PrefixLogger
+}
+
+func newLogStreamer(streamClient grpc_v1.RouterService_StreamClient, appLogger AppLogger, journalctl *client.Journalctl, log *log.PrefixLogger) *logStreamer {
+	return &logStreamer{
+		streamClient: streamClient,
+		appLogger:    appLogger,
+		journalctl:   journalctl,
+		log:          log,
+	}
+}
+
+func (l *logStreamer) send(m logstream.Message) error {
+	return l.streamClient.Send(&grpc_v1.StreamRequest{Payload: m.Encode()})
+}
+
+func (l *logStreamer) recv() (logstream.Message, error) {
+	msg, err := l.streamClient.Recv()
+	if err == io.EOF || msg != nil && msg.Closed {
+		return logstream.Message{}, errSessionClosed
+	}
+	if err != nil {
+		return logstream.Message{}, err
+	}
+	return logstream.Decode(msg.GetPayload())
+}
+
+// streamWriter sends everything written to it as data messages. The log command writes its stdout and stderr
+// from separate goroutines, so writes are serialized.
+type streamWriter struct {
+	mu   sync.Mutex
+	send func(logstream.Message) error
+}
+
+func (w *streamWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for written := 0; written < len(p); {
+		n := min(len(p)-written, logstream.ChunkSize)
+		if err := w.send(logstream.Message{Type: logstream.MessageData, Payload: p[written : written+n]}); err != nil {
+			return written, err
+		}
+		written += n
+	}
+	return len(p), nil
+}
+
+// command returns the command that writes the requested logs.
+func (l *logStreamer) command(ctx context.Context, req *logstream.Request) (*exec.Cmd, error) {
+	var since time.Time
+	if req.SinceSeconds > 0 {
+		since = time.Now().Add(-time.Duration(req.SinceSeconds) * time.Second)
+	}
+	if req.App != "" {
+		if l.appLogger == nil {
+			return nil, fmt.Errorf("application logs are not available on this device")
+		}
+		return l.appLogger.LogsCmd(ctx, req.App, req.Follow, since)
+	}
+	options := []client.LogOptions{client.WithLogUnit(req.Unit)}
+	if !since.IsZero() {
+		options = append(options, client.WithLogSince(since))
+	}
+	if req.Follow {
+		options = append(options, client.WithLogFollow())
+	}
+	return l.journalctl.LogsCmd(ctx, options...), nil
+}
+
+func (l *logStreamer) run(ctx context.Context) {
+	err := l.serve(ctx)
+	switch {
+	case err == nil:
+	case errors.Is(err, errSessionClosed):
+		l.log.Debug("logs: connection closed")
+	default:
+		l.log.Errorf("logs: %v", err)
+		if sendErr := l.send(logstream.NewErrorMessage(err)); sendErr != nil {
+			l.log.Debugf("logs: failed sending error: %v", sendErr)
+		}
+	}
+}
+
+func (l *logStreamer) serve(ctx context.Context) error {
+	m, err := l.recv()
+	if err != nil {
+		return err
+	}
+	if m.Type != logstream.MessageRequest {
+		return fmt.Errorf("expected a request, got message type %d", m.Type)
+	}
+	var req logstream.Request
+	if err = m.Unmarshal(&req); err != nil {
+		return err
+	}
+	if err = req.Validate(); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	cmd, err := l.command(ctx, &req)
+	if err != nil {
+		return err
+	}
+
+	// The client sends nothing after the request, so the next receive only returns once it closes the session.
+	// Stop the log command then, which is the only way a followed log ends.
+	go func() {
+		defer cancel()
+		_, _ = l.recv()
+	}()
+
+	writer := &streamWriter{send: l.send}
+	cmd.Stdout = writer
+	cmd.Stderr = writer
+	l.log.Debugf("logs: streaming %s", cmd.String())
+	if err = cmd.Run(); err != nil {
+		if ctx.Err() != nil {
+			return errSessionClosed
+		}
+		return fmt.Errorf("reading logs: %w", err)
+	}
+	return l.send(logstream.Message{Type: logstream.MessageDone})
+}
@@ -0,0 +1,132 @@
+package console
+
+import (
+	"context"
+	"io"
+	"os/exec"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	grpc_v1 "github.com/flightctl/flightctl/api/grpc/v1"
+	"github.com/flightctl/flightctl/internal/agent/client"
+	"github.com/flightctl/flightctl/internal/console/logstream"
+	"github.com/flightctl/flightctl/pkg/executer"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+type fakeAppLogger struct {
+	name   string
+	follow bool
+	since  time.Time
+	script string
+}
+
+func (f *fakeAppLogger) LogsCmd(ctx context.Context, name string, follow bool, since time.Time) (*exec.Cmd, error) {
+	f.name, f.follow, f.since = name, follow, since
+	return exec.CommandContext(ctx, "sh", "-c", f.script), nil
+}
+
+// runLogStreamer runs a log session that receives the request and returns the messages sent by the agent. The
+// client keeps the session open until the agent is done.
+func runLogStreamer(t *testing.T, appLogger AppLogger, execMock executer.Executer, req *logstream.Request) []logstream.Message {
+	ctrl := gomock.NewController(t)
+	streamClient := NewMockRouterService_StreamClient(ctrl)
+	var sent []logstream.Message
+	done := make(chan struct{})
+
+	request, err := logstream.NewRequestMessage(req)
+	require.NoError(t, err)
+	requestSent := false
+	streamClient.EXPECT().Recv().DoAndReturn(func() (*grpc_v1.StreamResponse, error) {
+		if !requestSent {
+			requestSent = true
+			return &grpc_v1.StreamResponse{Payload: request.Encode()}, nil
+		}
+		<-done
+		return nil, io.EOF
+	}).AnyTimes()
+	streamClient.EXPECT().Send(gomock.Any()).DoAndReturn(func(r *grpc_v1.StreamRequest) error {
+		m, err := logstream.Decode(r.Payload)
+		require.NoError(t, err)
+		sent = append(sent, m)
+		return nil
+	}).AnyTimes()
+
+	journalctl := client.NewJournalctl(execMock, v1beta1.CurrentProcessUsername)
+	newLogStreamer(streamClient, appLogger, journalctl, log.NewPrefixLogger("test")).run(context.Background())
+	close(done)
+	return sent
+}
+
+func logOutput(messages []logstream.Message) string {
+	var b strings.Builder
+	for _, m := range messages {
+		if m.Type == logstream.MessageData {
+			b.Write(m.Payload)
+		}
+	}
+	return b.String()
+}
+
+func TestLogStreamerApp(t *testing.T) {
+	appLogger := &fakeAppLogger{script: "echo out; echo err >&2"}
+	sent := runLogStreamer(t, appLogger, nil, &logstream.Request{App: "web", Follow: true, SinceSeconds: 60})
+
+	require.Equal(t, "web", appLogger.name)
+	require.True(t, appLogger.follow)
+	require.WithinDuration(t, time.Now().Add(-time.Minute), appLogger.since, 5*time.Second)
+	require.ElementsMatch(t, []string{"out", "err"}, strings.Fields(logOutput(sent)))
+	require.Equal(t, logstream.MessageDone, sent[len(sent)-1].Type)
+}
+
+func TestLogStreamerUnit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	execMock := executer.NewMockExecuter(ctrl)
+	execMock.EXPECT().CommandContext(gomock.Any(), "/usr/bin/journalctl", gomock.Any()).DoAndReturn(
+		func(ctx context.Context, command string, args ...string) *exec.Cmd {
+			return exec.CommandContext(ctx, "echo", args...)
+		})
+
+	sent := runLogStreamer(t, nil, execMock, &logstream.Request{Unit: "crio.service", Follow: true})
+	require.Equal(t, "--no-pager -o short-iso -u crio.service --follow\n", logOutput(sent))
+	require.Equal(t, logstream.MessageDone, sent[len(sent)-1].Type)
+}
+
+func TestLogStreamerErrors(t *testing.T) {
+	tests := []struct {
+		name          string
+		appLogger     AppLogger
+		req           logstream.Request
+		expectedError string
+	}{
+		{
+			name:          "invalid request",
+			req:           logstream.Request{},
+			expectedError: "exactly one of app and unit",
+		},
+		{
+			name:          "application logs unavailable",
+			req:           logstream.Request{App: "web"},
+			expectedError: "application logs are not available",
+		},
+		{
+			name:          "log command fails",
+			appLogger:     &fakeAppLogger{script: "echo no such container >&2; exit 125"},
+			req:           logstream.Request{App: "web"},
+			expectedError: "reading logs",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sent := runLogStreamer(t, tt.appLogger, nil, &tt.req)
+			require.NotEmpty(t, sent)
+			last := sent[len(sent)-1]
+			require.Equal(t, logstream.MessageError, last.Type)
+			require.Contains(t, string(last.Payload), tt.expectedError)
+		})
+	}
+}
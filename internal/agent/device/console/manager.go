@@ -9,7 +9,11 @@ import (
 
 	"github.com/flightctl/flightctl/api/core/v1beta1"
 	grpc_v1 "github.com/flightctl/flightctl/api/grpc/v1"
+	"github.com/flightctl/flightctl/internal/agent/client"
 	"github.com/flightctl/flightctl/internal/agent/device/spec"
+	"github.com/flightctl/flightctl/internal/console/filetransfer"
+	"github.com/flightctl/flightctl/internal/console/logstream"
+	"github.com/flightctl/flightctl/internal/console/portforward"
 	"github.com/flightctl/flightctl/internal/consts"
 	"github.com/flightctl/flightctl/pkg/executer"
 	"github.com/flightctl/flightctl/pkg/log"
@@ -29,6 +33,12 @@ type Manager struct {
 	deviceName string
 	watcher    spec.Watcher
 	user       string
+	// portForwardAllowedPorts restricts the loopback ports reachable by port forwarding sessions
+	portForwardAllowedPorts []int
+	// fileTransferAllowedPaths restricts the paths that file transfer sessions may read or write
+	fileTransferAllowedPaths []string
+	// appLogger creates the commands that log sessions use to read application logs
+	appLogger AppLogger
 
 	activeSessions   []*session
 	inactiveSessions []*session
@@ -37,6 +47,33 @@ type Manager struct {
 	sessionWg        sync.WaitGroup
 }
 
+// ManagerOption is a functional option for configuring the console manager.
+type ManagerOption func(*Manager)
+
+// WithPortForwardAllowedPorts restricts port forwarding sessions to the given loopback ports.
+// If no ports are given, any loopback port may be forwarded.
+func WithPortForwardAllowedPorts(ports []int) ManagerOption {
+	return func(m *Manager) {
+		m.portForwardAllowedPorts = ports
+	}
+}
+
+// WithFileTransferAllowedPaths restricts file transfer sessions to files within the given paths.
+// If no paths are given, any file may be transferred.
+func WithFileTransferAllowedPaths(paths []string) ManagerOption {
+	return func(m *Manager) {
+		m.fileTransferAllowedPaths = paths
+	}
+}
+
+// WithAppLogger enables log sessions to read the logs of the applications managed by the given logger.
+// Without it, log sessions can only read the journal of systemd units.
+func WithAppLogger(appLogger AppLogger) ManagerOption {
+	return func(m *Manager) {
+		m.appLogger = appLogger
+	}
+}
+
 type TerminalSize struct {
 	Width  uint16
 	Height uint16
@@ -49,8 +86,9 @@ func NewManager(
 	executor executer.Executer,
 	watcher spec.Watcher,
 	log *log.PrefixLogger,
+	opts ...ManagerOption,
 ) *Manager {
-	return &Manager{
+	m := &Manager{
 		grpcClient: grpcClient,
 		deviceName: deviceName,
 		user:       user,
@@ -58,6 +96,10 @@ func NewManager(
 		watcher:    watcher,
 		log:        log,
 	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
 func (c *Manager) cleanup() {
@@ -111,6 +153,9 @@ func (c *Manager) parseMetadata(metadata string) (*v1beta1.DeviceConsoleSessionM
 func (c *Manager) selectProtocol(requestedProtocols []string) (string, error) {
 	supportedProtocols := []string{
 		StreamProtocolV5Name,
+		portforward.ProtocolV1Name,
+		filetransfer.ProtocolV1Name,
+		logstream.ProtocolV1Name,
 	}
 	for _, protocol := range supportedProtocols {
 		if lo.Contains(requestedProtocols, protocol) {
@@ -156,7 +201,16 @@ func (c *Manager) start(ctx context.Context, dc v1beta1.DeviceConsole) {
 		return
 	}
 	s.streamClient = streamClient
-	s.run(ctx, sessionMetadata)
+	switch selectedProtocol {
+	case portforward.ProtocolV1Name:
+		s.runPortForward(ctx, c.portForwardAllowedPorts)
+	case filetransfer.ProtocolV1Name:
+		s.runFileTransfer(c.fileTransferAllowedPaths)
+	case logstream.ProtocolV1Name:
+		s.runLogs(ctx, c.appLogger, client.NewJournalctl(c.executor, v1beta1.CurrentProcessUsername))
+	default:
+		s.run(ctx, sessionMetadata)
+	}
 }
 
 func (c *Manager) sync(ctx context.Context, desired *v1beta1.DeviceSpec) {
@@ -0,0 +1,209 @@
+package console
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	grpc_v1 "github.com/flightctl/flightctl/api/grpc/v1"
+	"github.com/flightctl/flightctl/internal/console/portforward"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/samber/lo"
+)
+
+const (
+	// portForwardHost is the only address the agent dials on behalf of a port forwarding session.
+	portForwardHost        = "127.0.0.1"
+	portForwardDialTimeout = 10 * time.Second
+)
+
+// portForwarder multiplexes TCP connections to loopback ports of the device over a single router stream.
+type portForwarder struct {
+	streamClient grpc_v1.RouterService_StreamClient
+	allowedPorts []int
+	log          *log.PrefixLogger
+
+	output chan []byte
+	mu     sync.Mutex
+	conns  map[uint32]net.Conn
+	connWg sync.WaitGroup
+}
+
+func newPortForwarder(streamClient grpc_v1.RouterService_StreamClient, allowedPorts []int, log *log.PrefixLogger) *portForwarder {
+	return &portForwarder{
+		streamClient: streamClient,
+		allowedPorts: allowedPorts,
+		log:          log,
+		output:       make(chan []byte, 16),
+		conns:        make(map[uint32]net.Conn),
+	}
+}
+
+func (p *portForwarder) send(f portforward.Frame) {
+	p.output <- f.Encode()
+}
+
+func (p *portForwarder) sendError(id uint32, err error) {
+	p.send(portforward.Frame{Type: portforward.FrameError, ConnectionID: id, Payload: []byte(err.Error())})
+}
+
+// isAllowed reports whether the port may be forwarded. If no ports are allow-listed, any loopback port may be used.
+func (p *portForwarder) isAllowed(port uint16) bool {
+	return len(p.allowedPorts) == 0 || lo.Contains(p.allowedPorts, int(port))
+}
+
+func (p *portForwarder) connect(ctx context.Context, f portforward.Frame) {
+	port, err := f.Port()
+	if err != nil {
+		p.sendError(f.ConnectionID, err)
+		return
+	}
+	if !p.isAllowed(port) {
+		p.sendError(f.ConnectionID, fmt.Errorf("port %d is not allowed for port forwarding", port))
+		return
+	}
+
+	dialer := net.Dialer{Timeout: portForwardDialTimeout}
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(portForwardHost, strconv.Itoa(int(port))))
+	if err != nil {
+		p.sendError(f.ConnectionID, fmt.Errorf("connecting to port %d: %w", port, err))
+		return
+	}
+
+	p.mu.Lock()
+	if _, exists := p.conns[f.ConnectionID]; exists {
+		p.mu.Unlock()
+		_ = conn.Close()
+		p.sendError(f.ConnectionID, fmt.Errorf("connection %d already exists", f.ConnectionID))
+		return
+	}
+	p.conns[f.ConnectionID] = conn
+	p.mu.Unlock()
+
+	p.log.Debugf("port forward: connection %d opened to port %d", f.ConnectionID, port)
+	p.connWg.Add(1)
+	go p.readConn(f.ConnectionID, conn)
+}
+
+// readConn copies data from the local connection to the stream until the connection is closed.
+func (p *portForwarder) readConn(id uint32, conn net.Conn) {
+	defer p.connWg.Done()
+	buffer := make([]byte, 32*1024)
+	for {
+		n, err := conn.Read(buffer)
+		if n > 0 {
+			p.send(portforward.Frame{Type: portforward.FrameData, ConnectionID: id, Payload: append([]byte{}, buffer[:n]...)})
+		}
+		if err != nil {
+			if !errors.Is(err, io.EOF) && !errors.Is(err, net.ErrClosed) {
+				p.log.Debugf("port forward: reading connection %d: %v", id, err)
+			}
+			break
+		}
+	}
+	if p.remove(id) != nil {
+		// the connection was closed locally, so tell the other side
+		p.send(portforward.Frame{Type: portforward.FrameClose, ConnectionID: id})
+	}
+}
+
+func (p *portForwarder) get(id uint32) net.Conn {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.conns[id]
+}
+
+func (p *portForwarder) remove(id uint32) net.Conn {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	conn := p.conns[id]
+	if conn != nil {
+		delete(p.conns, id)
+		_ = conn.Close()
+	}
+	return conn
+}
+
+func (p *portForwarder) closeAll() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for id, conn := range p.conns {
+		_ = conn.Close()
+		delete(p.conns, id)
+	}
+}
+
+func (p *portForwarder) handle(ctx context.Context, f portforward.Frame) {
+	switch f.Type {
+	case portforward.FrameConnect:
+		// Dialing a loopback address completes immediately, and doing it inline guarantees that the
+		// connection exists before the data frames that follow are handled
+		p.connect(ctx, f)
+	case portforward.FrameData:
+		conn := p.get(f.ConnectionID)
+		if conn == nil {
+			p.sendError(f.ConnectionID, fmt.Errorf("connection %d does not exist", f.ConnectionID))
+			return
+		}
+		if _, err := conn.Write(f.Payload); err != nil {
+			p.remove(f.ConnectionID)
+			p.sendError(f.ConnectionID, fmt.Errorf("writing to connection %d: %w", f.ConnectionID, err))
+		}
+	case portforward.FrameClose, portforward.FrameError:
+		p.remove(f.ConnectionID)
+	}
+}
+
+// runSender writes the frames queued by all connections to the stream.
+func (p *portForwarder) runSender(done chan<- struct{}) {
+	defer close(done)
+	failed := false
+	for msg := range p.output {
+		// keep draining after a failure so that connection readers never block
+		if failed {
+			continue
+		}
+		if err := p.streamClient.Send(&grpc_v1.StreamRequest{Payload: msg}); err != nil {
+			p.log.Errorf("port forward: failed sending outgoing message: %v", err)
+			failed = true
+		}
+	}
+}
+
+func (p *portForwarder) run(ctx context.Context) {
+	ctx, cancel := context.WithCancel(ctx)
+	senderDone := make(chan struct{})
+	go p.runSender(senderDone)
+	defer func() {
+		// close all connections and wait for their readers before shutting down the sender
+		cancel()
+		p.closeAll()
+		p.connWg.Wait()
+		close(p.output)
+		<-senderDone
+		p.log.Debug("port forward: streams finished")
+	}()
+
+	for {
+		msg, err := p.streamClient.Recv()
+		if err == io.EOF || msg != nil && msg.Closed {
+			p.log.Debug("port forward: connection closed")
+			return
+		}
+		if err != nil {
+			p.log.Errorf("port forward: error receiving message: %v", err)
+			return
+		}
+		f, err := portforward.Decode(msg.GetPayload())
+		if err != nil {
+			p.log.Errorf("port forward: %v", err)
+			return
+		}
+		p.handle(ctx, f)
+	}
+}
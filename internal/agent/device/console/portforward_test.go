@@ -0,0 +1,143 @@
+package console
+
+import (
+	"context"
+	"io"
+	"net"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	grpc_v1 "github.com/flightctl/flightctl/api/grpc/v1"
+	"github.com/flightctl/flightctl/internal/console/portforward"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+type frameRecorder struct {
+	mu     sync.Mutex
+	frames []portforward.Frame
+}
+
+func (r *frameRecorder) add(f portforward.Frame) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.frames = append(r.frames, f)
+}
+
+func (r *frameRecorder) find(t portforward.FrameType) (portforward.Frame, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return lo.Find(r.frames, func(f portforward.Frame) bool { return f.Type == t })
+}
+
+func startEchoServer(t *testing.T) uint16 {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = l.Close() })
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				_, _ = io.Copy(conn, conn)
+			}()
+		}
+	}()
+	_, portStr, err := net.SplitHostPort(l.Addr().String())
+	require.NoError(t, err)
+	port, err := strconv.Atoi(portStr)
+	require.NoError(t, err)
+	return uint16(port)
+}
+
+func setupPortForwarder(t *testing.T, allowedPorts []int) (chan<- portforward.Frame, *frameRecorder, <-chan struct{}) {
+	ctrl := gomock.NewController(t)
+	streamClient := NewMockRouterService_StreamClient(ctrl)
+	input := make(chan portforward.Frame)
+	recorder := &frameRecorder{}
+
+	streamClient.EXPECT().Recv().DoAndReturn(func() (*grpc_v1.StreamResponse, error) {
+		f, ok := <-input
+		if !ok {
+			return nil, io.EOF
+		}
+		return &grpc_v1.StreamResponse{Payload: f.Encode()}, nil
+	}).AnyTimes()
+	streamClient.EXPECT().Send(gomock.Any()).DoAndReturn(func(req *grpc_v1.StreamRequest) error {
+		f, err := portforward.Decode(req.Payload)
+		require.NoError(t, err)
+		recorder.add(f)
+		return nil
+	}).AnyTimes()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		newPortForwarder(streamClient, allowedPorts, log.NewPrefixLogger("test")).run(context.Background())
+	}()
+	return input, recorder, done
+}
+
+func TestPortForward(t *testing.T) {
+	t.Run("forwards data to a loopback port", func(t *testing.T) {
+		port := startEchoServer(t)
+		input, recorder, done := setupPortForwarder(t, nil)
+
+		input <- portforward.NewConnectFrame(1, port)
+		input <- portforward.Frame{Type: portforward.FrameData, ConnectionID: 1, Payload: []byte("hello")}
+
+		require.Eventually(t, func() bool {
+			f, found := recorder.find(portforward.FrameData)
+			return found && f.ConnectionID == 1 && string(f.Payload) == "hello"
+		}, 2*time.Second, 50*time.Millisecond, "Expected the echoed data")
+
+		input <- portforward.Frame{Type: portforward.FrameClose, ConnectionID: 1}
+		close(input)
+		<-done
+		_, found := recorder.find(portforward.FrameError)
+		require.False(t, found)
+	})
+
+	t.Run("rejects ports that are not allowed", func(t *testing.T) {
+		port := startEchoServer(t)
+		input, recorder, done := setupPortForwarder(t, []int{int(port) + 1})
+
+		input <- portforward.NewConnectFrame(7, port)
+		close(input)
+		<-done
+
+		f, found := recorder.find(portforward.FrameError)
+		require.True(t, found)
+		require.Equal(t, uint32(7), f.ConnectionID)
+		require.Contains(t, string(f.Payload), "not allowed")
+	})
+
+	t.Run("reports closed connections", func(t *testing.T) {
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		defer l.Close()
+		go func() {
+			conn, err := l.Accept()
+			if err == nil {
+				_ = conn.Close()
+			}
+		}()
+		port := uint16(l.Addr().(*net.TCPAddr).Port)
+		input, recorder, done := setupPortForwarder(t, nil)
+
+		input <- portforward.NewConnectFrame(3, port)
+		require.Eventually(t, func() bool {
+			f, found := recorder.find(portforward.FrameClose)
+			return found && f.ConnectionID == 3
+		}, 2*time.Second, 50*time.Millisecond, "Expected the connection to be closed")
+		close(input)
+		<-done
+	})
+}
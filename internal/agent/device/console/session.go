@@ -12,6 +12,7 @@ import (
 	"github.com/creack/pty"
 	api "github.com/flightctl/flightctl/api/core/v1beta1"
 	grpc_v1 "github.com/flightctl/flightctl/api/grpc/v1"
+	"github.com/flightctl/flightctl/internal/agent/client"
 	"github.com/flightctl/flightctl/pkg/executer"
 	"github.com/flightctl/flightctl/pkg/log"
 )
@@ -159,3 +160,33 @@ func (s *session) run(ctx context.Context, metadata *api.DeviceConsoleSessionMet
 	oStreams.start(&wg)
 	wg.Wait()
 }
+
+// runPortForward serves a port forwarding session instead of a shell on the session's stream.
+func (s *session) runPortForward(ctx context.Context, allowedPorts []int) {
+	defer func() {
+		_ = s.streamClient.CloseSend()
+	}()
+	defer s.log.Debugf("port forward session %s finished", s.id)
+	s.log.Debugf("port forward session %s started", s.id)
+	newPortForwarder(s.streamClient, allowedPorts, s.log).run(ctx)
+}
+
+// runFileTransfer serves a file transfer session instead of a shell on the session's stream.
+func (s *session) runFileTransfer(allowedPaths []string) {
+	defer func() {
+		_ = s.streamClient.CloseSend()
+	}()
+	defer s.log.Debugf("file transfer session %s finished", s.id)
+	s.log.Debugf("file transfer session %s started", s.id)
+	newFileTransfer(s.streamClient, allowedPaths, s.log).run()
+}
+
+// runLogs streams the logs of an application or a systemd unit instead of running a shell on the session's stream.
+func (s *session) runLogs(ctx context.Context, appLogger AppLogger, journalctl *client.Journalctl) {
+	defer func() {
+		_ = s.streamClient.CloseSend()
+	}()
+	defer s.log.Debugf("log session %s finished", s.id)
+	s.log.Debugf("log session %s started", s.id)
+	newLogStreamer(s.streamClient, appLogger, journalctl, s.log).run(ctx)
+}
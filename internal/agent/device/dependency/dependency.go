@@ -141,6 +141,8 @@ type PrefetchManager interface {
 	RegisterOCICollector(collector OCICollector)
 	// BeforeUpdate collects and prefetches OCI targets from all registered collectors
 	BeforeUpdate(ctx context.Context, current, desired *v1beta1.DeviceSpec, opts ...OCICollectOpt) error
+	// SyncTrustPolicy writes the signature verification policy that the container tools enforce when pulling
+	SyncTrustPolicy(trustPolicy *v1beta1.DeviceTrustPolicy) error
 	// StatusMessage returns a human readable prefetch progress status message
 	StatusMessage(ctx context.Context) string
 	// Cleanup fires all cleanupFn cancels active pulls and drains the queue
@@ -209,6 +211,8 @@ type prefetchManager struct {
 	tasks      map[imageRef]*prefetchTask
 	queue      chan imageRef
 	collectors []OCICollector
+	// trustPolicy is the signature verification policy that targets are pulled with
+	trustPolicy *v1beta1.DeviceTrustPolicy
 }
 
 type prefetchTask struct {
@@ -302,6 +306,13 @@ func (m *prefetchManager) isTargetsChanged(seenTargets map[imageRef]struct{}) bo
 }
 
 func (m *prefetchManager) BeforeUpdate(ctx context.Context, current, desired *v1beta1.DeviceSpec, opts ...OCICollectOpt) error {
+	// targets must be verified against the desired trust policy
+	if desired != nil {
+		if err := m.SyncTrustPolicy(desired.TrustPolicy); err != nil {
+			return err
+		}
+	}
+
 	m.log.Debug("Collecting OCI targets from all dependency sources")
 
 	allTargets := make(OCIPullTargetsByUser)
@@ -348,6 +359,9 @@ func (m *prefetchManager) BeforeUpdate(ctx context.Context, current, desired *v1
 		if m.resourceManager.IsCriticalAlert(resource.DiskMonitorType) {
 			return fmt.Errorf("%w: insufficient disk storage space, please clear storage", errors.ErrCriticalResourceAlert)
 		}
+		if m.resourceManager.IsCriticalAlert(resource.InodeMonitorType) {
+			return fmt.Errorf("%w: insufficient free inodes, please clear storage", errors.ErrCriticalResourceAlert)
+		}
 		m.log.Debugf("Scheduling %d new targets for prefetch", len(newTargets))
 		if err := m.Schedule(ctx, newTargets); err != nil {
 			return fmt.Errorf("%w: %w", errors.ErrSchedulingPrefetchTargets, err)
@@ -577,6 +591,10 @@ func (m *prefetchManager) prepareTask(ctx context.Context, target imageRef, ociT
 		return false, nil
 	}
 
+	if m.trustPolicy != nil && m.trustPolicy.Spec != nil {
+		return m.prepareVerifiedTask(target, ociType, clientOptsFn)
+	}
+
 	podman, err := m.podmanFactory(target.owner)
 	if err != nil {
 		return false, fmt.Errorf("creating podman client: %w", err)
@@ -625,6 +643,44 @@ func (m *prefetchManager) prepareTask(ctx context.Context, target imageRef, ociT
 	return true, nil
 }
 
+// prepareVerifiedTask queues a target for pulling even if it already exists locally, because pulling verifies the
+// target's signature against the trust policy.
+// caller must hold m.mu lock
+func (m *prefetchManager) prepareVerifiedTask(target imageRef, ociType OCIType, clientOptsFn ClientOptsFn) (bool, error) {
+	if ociType == OCITypeHelmChart {
+		// helm does not verify signatures, so charts are only allowed where the policy does not require any
+		requirement := requirementForImage(m.trustPolicy.Spec, target.image)
+		if requirement.Type != v1beta1.TrustRequirementTypeAccept {
+			return false, fmt.Errorf("%w: helm chart %s cannot be verified against trust policy %s requiring %s",
+				errors.ErrSignatureVerificationFailed, target.image, m.trustPolicy.Name, requirement.Type)
+		}
+	}
+
+	m.tasks[target] = &prefetchTask{
+		ociType:      ociType,
+		clientOptsFn: clientOptsFn,
+	}
+	return true, nil
+}
+
+// SyncTrustPolicy writes the trust policy to the signature verification policy of the container tools. When the
+// trust policy changes, all targets are pulled again so that they are verified against it.
+func (m *prefetchManager) SyncTrustPolicy(trustPolicy *v1beta1.DeviceTrustPolicy) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if err := writeTrustPolicy(m.readWriter, trustPolicy); err != nil {
+		return fmt.Errorf("writing trust policy: %w", err)
+	}
+
+	if trustPolicyDigest(trustPolicy) != trustPolicyDigest(m.trustPolicy) {
+		m.log.Info("Trust policy changed, OCI targets will be pulled and verified again")
+		m.cleanupStaleTasks(nil)
+	}
+	m.trustPolicy = trustPolicy
+	return nil
+}
+
 func (m *prefetchManager) removeTask(target imageRef) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -54,7 +54,7 @@ func TestEnsureScheduled(t *testing.T) {
 				mockExec.EXPECT().ExecuteWithContext(
 					gomock.Any(), "podman", []string{"image", "exists", "quay.io/test/existing:latest"},
 				).Return("", "", 0)
-				mockResourceManager.EXPECT().IsCriticalAlert(gomock.Any()).Return(false).Times(1)
+				mockResourceManager.EXPECT().IsCriticalAlert(gomock.Any()).Return(false).Times(2)
 			},
 		},
 		{
@@ -72,7 +72,7 @@ func TestEnsureScheduled(t *testing.T) {
 				mockExec.EXPECT().ExecuteWithContext(
 					gomock.Any(), "podman", []string{"image", "exists", "quay.io/test/missing:latest"},
 				).Return("", "", 1)
-				mockResourceManager.EXPECT().IsCriticalAlert(gomock.Any()).Return(false).Times(1)
+				mockResourceManager.EXPECT().IsCriticalAlert(gomock.Any()).Return(false).Times(2)
 			},
 			expectedError: errors.ErrPrefetchNotReady,
 		},
@@ -101,7 +101,7 @@ func TestEnsureScheduled(t *testing.T) {
 				mockExec.EXPECT().ExecuteWithContext(
 					gomock.Any(), "podman", []string{"image", "exists", "quay.io/test/missing1:latest"},
 				).Return("", "", 1)
-				mockResourceManager.EXPECT().IsCriticalAlert(gomock.Any()).Return(false).Times(1)
+				mockResourceManager.EXPECT().IsCriticalAlert(gomock.Any()).Return(false).Times(2)
 			},
 			expectedError: errors.ErrPrefetchNotReady,
 		},
@@ -120,7 +120,7 @@ func TestEnsureScheduled(t *testing.T) {
 				mockExec.EXPECT().ExecuteWithContext(
 					gomock.Any(), "podman", []string{"artifact", "inspect", "quay.io/test/artifact:latest"},
 				).Return("", "", 1)
-				mockResourceManager.EXPECT().IsCriticalAlert(gomock.Any()).Return(false).Times(1)
+				mockResourceManager.EXPECT().IsCriticalAlert(gomock.Any()).Return(false).Times(2)
 			},
 			expectedError: errors.ErrPrefetchNotReady,
 		},
@@ -139,7 +139,7 @@ func TestEnsureScheduled(t *testing.T) {
 				mockExec.EXPECT().ExecuteWithContext(
 					gomock.Any(), "podman", []string{"artifact", "inspect", "quay.io/test/existing-artifact:latest"},
 				).Return("", "", 0)
-				mockResourceManager.EXPECT().IsCriticalAlert(gomock.Any()).Return(false).Times(1)
+				mockResourceManager.EXPECT().IsCriticalAlert(gomock.Any()).Return(false).Times(2)
 			},
 		},
 		{
@@ -167,7 +167,7 @@ func TestEnsureScheduled(t *testing.T) {
 				mockExec.EXPECT().ExecuteWithContext(
 					gomock.Any(), "podman", []string{"artifact", "inspect", "quay.io/test/missing-artifact:latest"},
 				).Return("", "", 1)
-				mockResourceManager.EXPECT().IsCriticalAlert(gomock.Any()).Return(false).Times(1)
+				mockResourceManager.EXPECT().IsCriticalAlert(gomock.Any()).Return(false).Times(2)
 			},
 			expectedError: errors.ErrPrefetchNotReady,
 		},
@@ -196,7 +196,7 @@ func TestEnsureScheduled(t *testing.T) {
 				mockExec.EXPECT().ExecuteWithContext(
 					gomock.Any(), "podman", []string{"artifact", "inspect", "quay.io/test/existing-artifact:latest"},
 				).Return("", "", 0)
-				mockResourceManager.EXPECT().IsCriticalAlert(gomock.Any()).Return(false).Times(1)
+				mockResourceManager.EXPECT().IsCriticalAlert(gomock.Any()).Return(false).Times(2)
 			},
 		},
 		{
@@ -214,7 +214,7 @@ func TestEnsureScheduled(t *testing.T) {
 				mockExec.EXPECT().ExecuteWithContext(
 					gomock.Any(), "podman", []string{"artifact", "inspect", "quay.io/test/always-artifact:latest"},
 				).Return("", "", 0)
-				mockResourceManager.EXPECT().IsCriticalAlert(gomock.Any()).Return(false).Times(1)
+				mockResourceManager.EXPECT().IsCriticalAlert(gomock.Any()).Return(false).Times(2)
 			},
 		},
 		{
@@ -232,7 +232,7 @@ func TestEnsureScheduled(t *testing.T) {
 				mockExec.EXPECT().ExecuteWithContext(
 					gomock.Any(), "podman", []string{"image", "exists", "quay.io/test/always:latest"},
 				).Return("", "", 0)
-				mockResourceManager.EXPECT().IsCriticalAlert(gomock.Any()).Return(false).Times(1)
+				mockResourceManager.EXPECT().IsCriticalAlert(gomock.Any()).Return(false).Times(2)
 			},
 		},
 	}
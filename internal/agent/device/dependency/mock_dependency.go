@@ -97,6 +97,20 @@ func (mr *MockPrefetchManagerMockRecorder) StatusMessage(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StatusMessage", reflect.TypeOf((*MockPrefetchManager)(nil).StatusMessage), ctx)
 }
 
+// SyncTrustPolicy mocks base method.
+func (m *MockPrefetchManager) SyncTrustPolicy(trustPolicy *v1beta1.DeviceTrustPolicy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncTrustPolicy", trustPolicy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SyncTrustPolicy indicates an expected call of SyncTrustPolicy.
+func (mr *MockPrefetchManagerMockRecorder) SyncTrustPolicy(trustPolicy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncTrustPolicy", reflect.TypeOf((*MockPrefetchManager)(nil).SyncTrustPolicy), trustPolicy)
+}
+
 // MockOCICollector is a mock of OCICollector interface.
 type MockOCICollector struct {
 	ctrl     *gomock.Controller
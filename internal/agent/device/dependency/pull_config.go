@@ -84,9 +84,11 @@ func (r *pullConfigResolver) BeforeUpdate(desired *v1beta1.DeviceSpec) {
 
 		// exists inline in the spec
 		if newFile != nil {
-			content, err := newFile.ContentsDecoded()
-			if err == nil && bytes.Equal(content, cached.inlineContent) {
-				continue
+			if readWriter, err := r.rwFactory(newFile.User); err == nil {
+				content, err := readWriter.DecodeContents(*newFile)
+				if err == nil && bytes.Equal(content, cached.inlineContent) {
+					continue
+				}
 			}
 		}
 
@@ -144,7 +146,7 @@ func (r *pullConfigResolver) resolve(configPath string) (*resolvedConfig, bool)
 			return nil, false
 		}
 
-		specContent, err := specFile.ContentsDecoded()
+		specContent, err := readWriter.DecodeContents(*specFile)
 		if err != nil {
 			r.log.Warnf("Failed to decode config file %s contents: %v", specFile.Path, err)
 			return nil, false
@@ -0,0 +1,275 @@
+package dependency
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/device/errors"
+	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/samber/lo"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// ContainersPolicyPath is the signature verification policy of podman, skopeo, CRI-O and bootc.
+	ContainersPolicyPath = "/etc/containers/policy.json"
+	// containersPolicyBackupPath holds the policy that was in place before the agent managed it.
+	containersPolicyBackupPath = "/etc/containers/policy.json.flightctl-orig"
+	// containersPolicyAbsentPath records that the device had no policy before the agent managed it.
+	containersPolicyAbsentPath = "/etc/containers/policy.json.flightctl-absent"
+	// RegistriesTrustConfigPath configures where the container tools look up the signatures of images.
+	RegistriesTrustConfigPath = "/etc/containers/registries.d/flightctl-trust.yaml"
+)
+
+// policyRequirement is a requirement of a containers-policy.json(5) file.
+type policyRequirement struct {
+	Type     string   `json:"type"`
+	KeyType  string   `json:"keyType,omitempty"`
+	KeyData  []byte   `json:"keyData,omitempty"`
+	KeyDatas [][]byte `json:"keyDatas,omitempty"`
+}
+
+// containersPolicy is the containers-policy.json(5) file rendered from a trust policy.
+type containersPolicy struct {
+	Default    []policyRequirement                       `json:"default"`
+	Transports map[string]map[string][]policyRequirement `json:"transports"`
+}
+
+// registryTrustConfig is an entry of a containers-registries.d(5) file.
+type registryTrustConfig struct {
+	Lookaside              string `json:"lookaside,omitempty"`
+	UseSigstoreAttachments bool   `json:"use-sigstore-attachments,omitempty"`
+}
+
+// registriesTrustConfig is the containers-registries.d(5) file rendered from a trust policy.
+type registriesTrustConfig struct {
+	DefaultDocker *registryTrustConfig           `json:"default-docker,omitempty"`
+	Docker        map[string]registryTrustConfig `json:"docker,omitempty"`
+}
+
+// toPolicyRequirement converts a trust requirement to the requirement enforced by the container tools.
+// A signature by any of the keys of the trust requirement is accepted.
+func toPolicyRequirement(r v1beta1.TrustRequirement) ([]policyRequirement, error) {
+	switch r.Type {
+	case v1beta1.TrustRequirementTypeAccept:
+		return []policyRequirement{{Type: "insecureAcceptAnything"}}, nil
+	case v1beta1.TrustRequirementTypeReject:
+		return []policyRequirement{{Type: "reject"}}, nil
+	case v1beta1.TrustRequirementTypeSigstoreSigned:
+		req := policyRequirement{Type: "sigstoreSigned"}
+		if len(r.Keys) == 1 {
+			req.KeyData = []byte(r.Keys[0])
+		} else {
+			req.KeyDatas = lo.Map(r.Keys, func(key string, _ int) []byte { return []byte(key) })
+		}
+		return []policyRequirement{req}, nil
+	case v1beta1.TrustRequirementTypeSignedBy:
+		// a keyring of several armored keys accepts a signature by any of them
+		return []policyRequirement{{Type: "signedBy", KeyType: "GPGKeys", KeyData: []byte(strings.Join(r.Keys, "\n"))}}, nil
+	default:
+		return nil, fmt.Errorf("%w: unsupported trust requirement type %q", errors.ErrInvalidSpec, r.Type)
+	}
+}
+
+// renderContainersPolicy renders the containers-policy.json(5) and containers-registries.d(5) files that enforce
+// the trust policy. Images in local storage were verified when they were pulled, so the local transports accept them.
+func renderContainersPolicy(spec *v1beta1.TrustPolicySpec) ([]byte, []byte, error) {
+	defaultRequirement, err := toPolicyRequirement(spec.Default)
+	if err != nil {
+		return nil, nil, fmt.Errorf("default: %w", err)
+	}
+	policy := containersPolicy{
+		Default: defaultRequirement,
+		Transports: map[string]map[string][]policyRequirement{
+			"docker": {},
+			"containers-storage": {
+				"": {{Type: "insecureAcceptAnything"}},
+			},
+			"docker-daemon": {
+				"": {{Type: "insecureAcceptAnything"}},
+			},
+		},
+	}
+
+	registries := registriesTrustConfig{Docker: map[string]registryTrustConfig{}}
+	useSigstore := spec.Default.Type == v1beta1.TrustRequirementTypeSigstoreSigned
+	for _, scope := range lo.FromPtr(spec.Scopes) {
+		requirement, err := toPolicyRequirement(scope.Requirement)
+		if err != nil {
+			return nil, nil, fmt.Errorf("scope %s: %w", scope.Scope, err)
+		}
+		policy.Transports["docker"][scope.Scope] = requirement
+		if scope.Requirement.Type == v1beta1.TrustRequirementTypeSigstoreSigned {
+			useSigstore = true
+		}
+		if scope.Requirement.Lookaside != "" {
+			registries.Docker[scope.Scope] = registryTrustConfig{Lookaside: scope.Requirement.Lookaside}
+		}
+	}
+	if useSigstore || spec.Default.Lookaside != "" {
+		registries.DefaultDocker = &registryTrustConfig{
+			Lookaside:              spec.Default.Lookaside,
+			UseSigstoreAttachments: useSigstore,
+		}
+	}
+	// sigstore attachments are looked up per registry, so scopes with a lookaside need them as well
+	if useSigstore {
+		for scope, config := range registries.Docker {
+			config.UseSigstoreAttachments = true
+			registries.Docker[scope] = config
+		}
+	}
+
+	policyJSON, err := json.MarshalIndent(policy, "", "  ")
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: containers policy: %w", errors.ErrMarshal, err)
+	}
+	registriesYAML, err := yaml.Marshal(registries)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: registries configuration: %w", errors.ErrMarshal, err)
+	}
+	return policyJSON, registriesYAML, nil
+}
+
+// trustPolicyDigest identifies the trust policy spec. It is empty when no trust policy applies.
+func trustPolicyDigest(trustPolicy *v1beta1.DeviceTrustPolicy) string {
+	if trustPolicy == nil || trustPolicy.Spec == nil {
+		return ""
+	}
+	specBytes, _ := json.Marshal(trustPolicy.Spec)
+	sum := sha256.Sum256(specBytes)
+	return hex.EncodeToString(sum[:])
+}
+
+// writeTrustPolicy writes the files that enforce the trust policy, backing up the original policy of the device the
+// first time. Without a trust policy the original policy is restored.
+func writeTrustPolicy(readWriter fileio.ReadWriter, trustPolicy *v1beta1.DeviceTrustPolicy) error {
+	if trustPolicy == nil || trustPolicy.Spec == nil {
+		return restoreContainersPolicy(readWriter)
+	}
+
+	policyJSON, registriesYAML, err := renderContainersPolicy(trustPolicy.Spec)
+	if err != nil {
+		return err
+	}
+
+	managed, err := isContainersPolicyManaged(readWriter)
+	if err != nil {
+		return err
+	}
+	if !managed {
+		policyExists, err := readWriter.PathExists(ContainersPolicyPath)
+		if err != nil {
+			return fmt.Errorf("checking %w: %w", errors.WithElement(ContainersPolicyPath), err)
+		}
+		if policyExists {
+			if err := readWriter.CopyFile(ContainersPolicyPath, containersPolicyBackupPath); err != nil {
+				return fmt.Errorf("backing up %w: %w", errors.WithElement(ContainersPolicyPath), err)
+			}
+		} else if err := readWriter.WriteFile(containersPolicyAbsentPath, []byte("no policy before flightctl\n"), fileio.DefaultFilePermissions); err != nil {
+			return fmt.Errorf("writing %w: %w", errors.WithElement(containersPolicyAbsentPath), err)
+		}
+	}
+
+	if err := writeFileIfChanged(readWriter, RegistriesTrustConfigPath, registriesYAML); err != nil {
+		return err
+	}
+	return writeFileIfChanged(readWriter, ContainersPolicyPath, policyJSON)
+}
+
+// isContainersPolicyManaged returns whether the agent has recorded the policy the device had before it managed it.
+func isContainersPolicyManaged(readWriter fileio.ReadWriter) (bool, error) {
+	for _, path := range []string{containersPolicyBackupPath, containersPolicyAbsentPath} {
+		exists, err := readWriter.PathExists(path)
+		if err != nil {
+			return false, fmt.Errorf("checking %w: %w", errors.WithElement(path), err)
+		}
+		if exists {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// restoreContainersPolicy restores the policy the device had before the agent managed it. If the device had no
+// policy, the policy written by the agent is removed.
+func restoreContainersPolicy(readWriter fileio.ReadWriter) error {
+	backupExists, err := readWriter.PathExists(containersPolicyBackupPath)
+	if err != nil {
+		return fmt.Errorf("checking %w: %w", errors.WithElement(containersPolicyBackupPath), err)
+	}
+	absent, err := readWriter.PathExists(containersPolicyAbsentPath)
+	if err != nil {
+		return fmt.Errorf("checking %w: %w", errors.WithElement(containersPolicyAbsentPath), err)
+	}
+	switch {
+	case backupExists:
+		if err := readWriter.Rename(containersPolicyBackupPath, ContainersPolicyPath); err != nil {
+			return fmt.Errorf("restoring %w: %w", errors.WithElement(ContainersPolicyPath), err)
+		}
+	case absent:
+		if err := readWriter.RemoveFile(ContainersPolicyPath); err != nil {
+			return fmt.Errorf("removing %w: %w", errors.WithElement(ContainersPolicyPath), err)
+		}
+	}
+	if err := readWriter.RemoveFile(containersPolicyAbsentPath); err != nil {
+		return fmt.Errorf("removing %w: %w", errors.WithElement(containersPolicyAbsentPath), err)
+	}
+	if err := readWriter.RemoveFile(RegistriesTrustConfigPath); err != nil {
+		return fmt.Errorf("removing %w: %w", errors.WithElement(RegistriesTrustConfigPath), err)
+	}
+	return nil
+}
+
+func writeFileIfChanged(readWriter fileio.ReadWriter, path string, content []byte) error {
+	existing, err := readWriter.ReadFile(path)
+	if err == nil && bytes.Equal(existing, content) {
+		return nil
+	}
+	if err := readWriter.WriteFile(path, content, fileio.DefaultFilePermissions); err != nil {
+		return fmt.Errorf("writing %w: %w", errors.WithElement(path), err)
+	}
+	return nil
+}
+
+// requirementForImage returns the requirement of the trust policy that applies to an image reference, following
+// the scope matching of containers-policy.json(5): the longest matching repository, namespace or registry, then the
+// most specific wildcard, and finally the default requirement.
+func requirementForImage(spec *v1beta1.TrustPolicySpec, image string) v1beta1.TrustRequirement {
+	name := image
+	if i := strings.Index(name, "@"); i >= 0 {
+		name = name[:i]
+	}
+	if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+		name = name[:i]
+	}
+	host := name
+	if i := strings.Index(host, "/"); i >= 0 {
+		host = host[:i]
+	}
+
+	scopes := slices.Clone(lo.FromPtr(spec.Scopes))
+	// longer scopes are more specific
+	sort.SliceStable(scopes, func(i, j int) bool { return len(scopes[i].Scope) > len(scopes[j].Scope) })
+	for _, scope := range scopes {
+		if strings.HasPrefix(scope.Scope, "*.") {
+			continue
+		}
+		if name == scope.Scope || strings.HasPrefix(name, scope.Scope+"/") {
+			return scope.Requirement
+		}
+	}
+	for _, scope := range scopes {
+		if domain, ok := strings.CutPrefix(scope.Scope, "*"); ok && strings.HasSuffix(host, domain) {
+			return scope.Requirement
+		}
+	}
+	return spec.Default
+}
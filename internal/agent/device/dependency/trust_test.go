@@ -0,0 +1,146 @@
+package dependency
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	testCosignKey = "-----BEGIN PUBLIC KEY-----\nMFkwEwYHKoZIzj0CAQYIKoZIzj0DAQcDQgAE\n-----END PUBLIC KEY-----\n"
+	testGPGKey    = "-----BEGIN PGP PUBLIC KEY BLOCK-----\n\nmQENBF\n-----END PGP PUBLIC KEY BLOCK-----\n"
+)
+
+func newTestTrustPolicySpec() *v1beta1.TrustPolicySpec {
+	return &v1beta1.TrustPolicySpec{
+		Default: v1beta1.TrustRequirement{Type: v1beta1.TrustRequirementTypeReject},
+		Scopes: &[]v1beta1.TrustPolicyScope{
+			{Scope: "quay.io/example", Requirement: v1beta1.TrustRequirement{Type: v1beta1.TrustRequirementTypeSigstoreSigned, Keys: []string{testCosignKey}}},
+			{Scope: "quay.io/example/charts", Requirement: v1beta1.TrustRequirement{Type: v1beta1.TrustRequirementTypeAccept}},
+			{Scope: "registry.example.com:5000", Requirement: v1beta1.TrustRequirement{Type: v1beta1.TrustRequirementTypeSignedBy, Keys: []string{testGPGKey}, Lookaside: "https://sigs.example.com"}},
+			{Scope: "*.internal.example.com", Requirement: v1beta1.TrustRequirement{Type: v1beta1.TrustRequirementTypeAccept}},
+		},
+	}
+}
+
+func TestRequirementForImage(t *testing.T) {
+	spec := newTestTrustPolicySpec()
+	tests := []struct {
+		name     string
+		image    string
+		expected v1beta1.TrustRequirementType
+	}{
+		{name: "repository in scope", image: "quay.io/example/app:v1", expected: v1beta1.TrustRequirementTypeSigstoreSigned},
+		{name: "more specific scope", image: "quay.io/example/charts/nginx:1.0", expected: v1beta1.TrustRequirementTypeAccept},
+		{name: "digest reference", image: "quay.io/example/app@sha256:0123456789abcdef", expected: v1beta1.TrustRequirementTypeSigstoreSigned},
+		{name: "scope matches on path boundary", image: "quay.io/examples/app:v1", expected: v1beta1.TrustRequirementTypeReject},
+		{name: "registry with port", image: "registry.example.com:5000/os/rhel:9.6", expected: v1beta1.TrustRequirementTypeSignedBy},
+		{name: "wildcard", image: "registry.internal.example.com/tools/debug:latest", expected: v1beta1.TrustRequirementTypeAccept},
+		{name: "default", image: "docker.io/library/nginx:latest", expected: v1beta1.TrustRequirementTypeReject},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, requirementForImage(spec, tt.image).Type)
+		})
+	}
+	// matching must not reorder the scopes of the spec
+	require.Equal(t, "quay.io/example", (*spec.Scopes)[0].Scope)
+}
+
+func TestRenderContainersPolicy(t *testing.T) {
+	require := require.New(t)
+	policyJSON, registriesYAML, err := renderContainersPolicy(newTestTrustPolicySpec())
+	require.NoError(err)
+
+	var policy containersPolicy
+	require.NoError(json.Unmarshal(policyJSON, &policy))
+	require.Equal([]policyRequirement{{Type: "reject"}}, policy.Default)
+	docker := policy.Transports["docker"]
+	require.Len(docker, 4)
+	require.Equal("sigstoreSigned", docker["quay.io/example"][0].Type)
+	require.Equal([]byte(testCosignKey), docker["quay.io/example"][0].KeyData)
+	require.Equal("signedBy", docker["registry.example.com:5000"][0].Type)
+	require.Equal("GPGKeys", docker["registry.example.com:5000"][0].KeyType)
+	require.Equal("insecureAcceptAnything", docker["*.internal.example.com"][0].Type)
+	require.Equal("insecureAcceptAnything", policy.Transports["containers-storage"][""][0].Type)
+
+	var registries registriesTrustConfig
+	require.NoError(yaml.Unmarshal(registriesYAML, &registries))
+	require.NotNil(registries.DefaultDocker)
+	require.True(registries.DefaultDocker.UseSigstoreAttachments)
+	require.Equal("https://sigs.example.com", registries.Docker["registry.example.com:5000"].Lookaside)
+}
+
+func TestWriteTrustPolicy(t *testing.T) {
+	require := require.New(t)
+	rootDir := t.TempDir()
+	rw := fileio.NewReadWriter(
+		fileio.NewReader(fileio.WithReaderRootDir(rootDir)),
+		fileio.NewWriter(fileio.WithWriterRootDir(rootDir)),
+	)
+	originalPolicy := []byte(`{"default":[{"type":"insecureAcceptAnything"}]}`)
+	require.NoError(rw.WriteFile(ContainersPolicyPath, originalPolicy, fileio.DefaultFilePermissions))
+
+	trustPolicy := &v1beta1.DeviceTrustPolicy{Name: "default", Spec: newTestTrustPolicySpec()}
+	require.NoError(writeTrustPolicy(rw, trustPolicy))
+	written, err := rw.ReadFile(ContainersPolicyPath)
+	require.NoError(err)
+	require.Contains(string(written), "sigstoreSigned")
+	exists, err := rw.PathExists(RegistriesTrustConfigPath)
+	require.NoError(err)
+	require.True(exists)
+
+	// writing again keeps the original policy as the backup
+	require.NoError(writeTrustPolicy(rw, trustPolicy))
+	backup, err := rw.ReadFile(containersPolicyBackupPath)
+	require.NoError(err)
+	require.Equal(originalPolicy, backup)
+
+	// removing the trust policy restores the original policy
+	require.NoError(writeTrustPolicy(rw, nil))
+	restored, err := rw.ReadFile(ContainersPolicyPath)
+	require.NoError(err)
+	require.Equal(originalPolicy, restored)
+	exists, err = rw.PathExists(RegistriesTrustConfigPath)
+	require.NoError(err)
+	require.False(exists)
+}
+
+func TestWriteTrustPolicyWithoutOriginalPolicy(t *testing.T) {
+	require := require.New(t)
+	rootDir := t.TempDir()
+	rw := fileio.NewReadWriter(
+		fileio.NewReader(fileio.WithReaderRootDir(rootDir)),
+		fileio.NewWriter(fileio.WithWriterRootDir(rootDir)),
+	)
+	// without a trust policy, a policy the agent did not write is left alone
+	require.NoError(writeTrustPolicy(rw, nil))
+
+	trustPolicy := &v1beta1.DeviceTrustPolicy{Name: "default", Spec: newTestTrustPolicySpec()}
+	require.NoError(writeTrustPolicy(rw, trustPolicy))
+	require.NoError(writeTrustPolicy(rw, trustPolicy))
+	exists, err := rw.PathExists(ContainersPolicyPath)
+	require.NoError(err)
+	require.True(exists)
+
+	// removing the trust policy removes the policy written by the agent
+	require.NoError(writeTrustPolicy(rw, nil))
+	exists, err = rw.PathExists(ContainersPolicyPath)
+	require.NoError(err)
+	require.False(exists)
+	exists, err = rw.PathExists(containersPolicyAbsentPath)
+	require.NoError(err)
+	require.False(exists)
+
+	// a policy written by the user afterwards is left alone
+	userPolicy := []byte(`{"default":[{"type":"reject"}]}`)
+	require.NoError(rw.WriteFile(ContainersPolicyPath, userPolicy, fileio.DefaultFilePermissions))
+	require.NoError(writeTrustPolicy(rw, nil))
+	policy, err := rw.ReadFile(ContainersPolicyPath)
+	require.NoError(err)
+	require.Equal(userPolicy, policy)
+}
@@ -2,7 +2,9 @@ package device
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"path/filepath"
 	"time"
 
 	"github.com/flightctl/flightctl/api/core/v1beta1"
@@ -14,6 +16,7 @@ import (
 	"github.com/flightctl/flightctl/internal/agent/device/dependency"
 	"github.com/flightctl/flightctl/internal/agent/device/errors"
 	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/flightctl/flightctl/internal/agent/device/healthcheck"
 	"github.com/flightctl/flightctl/internal/agent/device/hook"
 	imagepruning "github.com/flightctl/flightctl/internal/agent/device/image_pruning"
 	"github.com/flightctl/flightctl/internal/agent/device/lifecycle"
@@ -23,6 +26,8 @@ import (
 	"github.com/flightctl/flightctl/internal/agent/device/spec"
 	"github.com/flightctl/flightctl/internal/agent/device/status"
 	"github.com/flightctl/flightctl/internal/agent/device/systemd"
+	"github.com/flightctl/flightctl/internal/agent/device/systeminfo"
+	"github.com/flightctl/flightctl/internal/agent/device/volumesnapshot"
 	"github.com/flightctl/flightctl/internal/util"
 	"github.com/flightctl/flightctl/pkg/log"
 	"github.com/samber/lo"
@@ -33,10 +38,11 @@ import (
 type Agent struct {
 	name                   string
 	systemdClient          *client.Systemd
-	deviceWriter           fileio.Writer
+	deviceReadWriter       fileio.ReadWriter
 	statusManager          status.Manager
 	specManager            spec.Manager
 	hookManager            hook.Manager
+	healthCheckManager     healthcheck.Manager
 	appManager             applications.Manager
 	systemdManager         systemd.Manager
 	osManager              os.Manager
@@ -51,6 +57,16 @@ type Agent struct {
 	prefetchManager        dependency.PrefetchManager
 	pullConfigResolver     dependency.PullConfigResolver
 	pruningManager         imagepruning.Manager
+	volumeSnapshotManager  volumesnapshot.Manager
+	systemInfoManager      systeminfo.Manager
+
+	// dataDir is where the agent records the boot an update started in.
+	dataDir string
+
+	// healthCheckPendingVersion is the renderedVersion that has been applied and whose health checks have
+	// not passed yet. It is not applied again while the checks are evaluated, so that hooks and application
+	// restarts do not interfere with them.
+	healthCheckPendingVersion string
 
 	statusUpdateInterval util.Duration
 
@@ -62,13 +78,14 @@ type Agent struct {
 func NewAgent(
 	name string,
 	systemdClient *client.Systemd,
-	deviceWriter fileio.Writer,
+	deviceReadWriter fileio.ReadWriter,
 	statusManager status.Manager,
 	specManager spec.Manager,
 	appManager applications.Manager,
 	systemdManager systemd.Manager,
 	statusUpdateInterval util.Duration,
 	hookManager hook.Manager,
+	healthCheckManager healthcheck.Manager,
 	osManager os.Manager,
 	policyManager policy.Manager,
 	lifecycleManager lifecycle.Manager,
@@ -81,16 +98,20 @@ func NewAgent(
 	prefetchManager dependency.PrefetchManager,
 	pullConfigResolver dependency.PullConfigResolver,
 	pruningManager imagepruning.Manager,
+	volumeSnapshotManager volumesnapshot.Manager,
+	systemInfoManager systeminfo.Manager,
+	dataDir string,
 	backoff wait.Backoff,
 	log *log.PrefixLogger,
 ) *Agent {
 	return &Agent{
 		name:                   name,
 		systemdClient:          systemdClient,
-		deviceWriter:           deviceWriter,
+		deviceReadWriter:       deviceReadWriter,
 		statusManager:          statusManager,
 		specManager:            specManager,
 		hookManager:            hookManager,
+		healthCheckManager:     healthCheckManager,
 		osManager:              osManager,
 		policyManager:          policyManager,
 		lifecycleManager:       lifecycleManager,
@@ -106,6 +127,9 @@ func NewAgent(
 		prefetchManager:        prefetchManager,
 		pullConfigResolver:     pullConfigResolver,
 		pruningManager:         pruningManager,
+		volumeSnapshotManager:  volumeSnapshotManager,
+		systemInfoManager:      systemInfoManager,
+		dataDir:                dataDir,
 		backoff:                backoff,
 		log:                    log,
 	}
@@ -124,6 +148,8 @@ func (a *Agent) Run(ctx context.Context) error {
 }
 
 func (a *Agent) sync(ctx context.Context, current, desired *v1beta1.Device) error {
+	a.recordUpdateBoot(desired)
+
 	if !spec.IsRollback(current, desired) {
 		if err := a.beforeUpdate(ctx, current, desired); err != nil {
 			return fmt.Errorf("%w: %w", errors.ErrPhasePreparing, err)
@@ -134,7 +160,7 @@ func (a *Agent) sync(ctx context.Context, current, desired *v1beta1.Device) erro
 		return fmt.Errorf("%w: %w", errors.ErrPhaseApplyingUpdate, err)
 	}
 
-	if err := a.afterUpdate(ctx, current.Spec, desired.Spec); err != nil {
+	if err := a.afterUpdate(ctx, current, desired); err != nil {
 		return fmt.Errorf("%w: %w", errors.ErrPhaseActivatingConfig, err)
 	}
 
@@ -167,7 +193,14 @@ func (a *Agent) syncDeviceSpec(ctx context.Context) {
 		return
 	}
 
-	if syncErr := a.sync(ctx, current, desired); syncErr != nil {
+	var syncErr error
+	if a.specManager.IsUpgrading() && a.healthCheckPendingVersion == desired.Version() {
+		a.log.Tracef("Update to renderedVersion %s already applied, skipping sync", desired.Version())
+	} else {
+		a.healthCheckPendingVersion = ""
+		syncErr = a.sync(ctx, current, desired)
+	}
+	if syncErr != nil {
 		// if context is canceled return to exit the sync loop
 		if errors.Is(syncErr, context.Canceled) {
 			return
@@ -228,6 +261,23 @@ func (a *Agent) syncDeviceSpec(ctx context.Context) {
 			return
 		}
 
+		// Wait for the health checks of the desired spec to pass before committing the spec.
+		healthy, err := a.healthCheckManager.Check(ctx, desired)
+		if err != nil {
+			if errors.IsContext(err) {
+				a.log.Debugf("Sync is shutting down : %v", err)
+				return
+			}
+			a.handleHealthCheckFailure(ctx, current, desired, fmt.Errorf("%w: %w", errors.ErrPhaseVerifyingHealth, err))
+			return
+		}
+		if !healthy {
+			a.log.Debug("Waiting for health checks to pass before upgrading spec")
+			a.healthCheckPendingVersion = desired.Version()
+			return
+		}
+		a.healthCheckPendingVersion = ""
+
 		// reconciliation is a success, upgrade the current spec
 		// This updates the spec files to reflect that all managers have successfully applied the changes
 		if err := a.specManager.Upgrade(ctx); err != nil {
@@ -241,6 +291,9 @@ func (a *Agent) syncDeviceSpec(ctx context.Context) {
 		if err := a.updatedStatus(ctx, desired); err != nil {
 			a.log.Warnf("Failed updating status: %v", err)
 		}
+		if err := a.volumeSnapshotManager.Prune(ctx, desired); err != nil {
+			a.log.Warnf("Failed to remove volume snapshots: %v", err)
+		}
 		a.pruningManager.RequestPrune()
 	} else {
 		a.log.Debug("No upgrade in progress, skipping status update")
@@ -257,6 +310,22 @@ func (a *Agent) syncDeviceSpec(ctx context.Context) {
 	}
 }
 
+// handleHealthCheckFailure fails the desired renderedVersion and rolls the device back to the current one.
+func (a *Agent) handleHealthCheckFailure(ctx context.Context, current, desired *v1beta1.Device, healthErr error) {
+	a.healthCheckPendingVersion = ""
+	a.log.Errorf("Marking template version %v as failed: %v", desired.Version(), healthErr)
+	if err := a.specManager.SetUpgradeFailed(desired.Version(), desired.SpecHash()); err != nil {
+		a.log.Errorf("Failed to set upgrade failed: %v", err)
+	}
+
+	a.log.Warnf("Attempting to rollback to previous renderedVersion: %s", current.Version())
+	if err := a.rollbackDevice(ctx, current, desired, healthErr, a.sync); err != nil {
+		a.log.Errorf("Rollback did not complete cleanly: %v", err)
+	}
+
+	a.handleSyncError(ctx, desired, healthErr)
+}
+
 func (a *Agent) rollbackDevice(ctx context.Context, current, desired *v1beta1.Device, syncErr error, syncFn func(context.Context, *v1beta1.Device, *v1beta1.Device) error) error {
 	updateErr := a.statusManager.UpdateCondition(ctx, v1beta1.Condition{
 		Type:    v1beta1.ConditionTypeDeviceUpdating,
@@ -268,6 +337,11 @@ func (a *Agent) rollbackDevice(ctx context.Context, current, desired *v1beta1.De
 		a.log.Warnf("Failed setting status: %v", updateErr)
 	}
 
+	// restore the volumes before the previous version of their applications starts again
+	if err := a.volumeSnapshotManager.Restore(ctx, desired); err != nil {
+		a.log.Errorf("Failed to restore volume snapshots: %v", err)
+	}
+
 	needsOSRollback := false
 	if !errors.IsRetryable(syncErr) && a.specManager.IsOSUpdate() {
 		_, isReconciled, err := a.specManager.CheckOsReconciliation(ctx)
@@ -389,6 +463,10 @@ func (a *Agent) beforeUpdate(ctx context.Context, current, desired *v1beta1.Devi
 		return fmt.Errorf("%w: %w", errors.ErrComponentResources,
 			fmt.Errorf("%w: %w", errors.WithElement("Memory"), errors.ErrCriticalResourceAlert))
 	}
+	if a.resourceManager.IsCriticalAlert(resource.TemperatureMonitorType) {
+		return fmt.Errorf("%w: %w", errors.ErrComponentResources,
+			fmt.Errorf("%w: %w", errors.WithElement("Temperature"), errors.ErrCriticalResourceAlert))
+	}
 
 	if err := a.specManager.CheckPolicy(ctx, policy.Download, desired.Version()); err != nil {
 		return fmt.Errorf("%w: %w", errors.ErrComponentDownloadPolicy, err)
@@ -442,7 +520,7 @@ func (a *Agent) beforeUpdate(ctx context.Context, current, desired *v1beta1.Devi
 		return fmt.Errorf("%w: %w", errors.ErrComponentApplications, err)
 	}
 
-	if err := a.hookManager.OnBeforeUpdating(ctx, current.Spec, desired.Spec); err != nil {
+	if err := a.hookManager.OnBeforeUpdating(ctx, current, desired); err != nil {
 		return fmt.Errorf("%w: %w", errors.ErrComponentHooks, err)
 	}
 
@@ -481,6 +559,17 @@ func (a *Agent) syncDevice(ctx context.Context, current, desired *v1beta1.Device
 		}
 	}
 
+	// the trust policy is synced here as well as before prefetching so that a rollback restores the previous one
+	if err := a.prefetchManager.SyncTrustPolicy(desired.Spec.TrustPolicy); err != nil {
+		return fmt.Errorf("%w: %w", errors.ErrComponentTrustPolicy, err)
+	}
+
+	if a.specManager.IsUpgrading() && !spec.IsRollback(current, desired) {
+		if err := a.volumeSnapshotManager.Snapshot(ctx, current, desired); err != nil {
+			return fmt.Errorf("%w: %w", errors.ErrComponentVolumeSnapshot, err)
+		}
+	}
+
 	if err := a.applicationsController.Sync(ctx, current.Spec, desired.Spec); err != nil {
 		return fmt.Errorf("%w: %w", errors.ErrComponentApplications, err)
 	}
@@ -520,12 +609,12 @@ func (a *Agent) systemdControllerSync(_ context.Context, desired *v1beta1.Device
 	return nil
 }
 
-func (a *Agent) afterUpdate(ctx context.Context, current, desired *v1beta1.DeviceSpec) error {
+func (a *Agent) afterUpdate(ctx context.Context, current, desired *v1beta1.Device) error {
 	a.log.Debug("Executing after update actions")
 	defer a.log.Debug("Finished executing after update actions")
 
 	// execute after update for lifecycle
-	if err := a.lifecycleManager.AfterUpdate(ctx, current, desired); err != nil {
+	if err := a.lifecycleManager.AfterUpdate(ctx, current.Spec, desired.Spec); err != nil {
 		a.log.Errorf("Error executing lifecycle: %v", err)
 		return err
 	}
@@ -540,7 +629,7 @@ func (a *Agent) afterUpdate(ctx context.Context, current, desired *v1beta1.Devic
 	// after the os is updated.This happens because the os update requires a
 	// reboot so the lower blocks are not executed until after reboot.
 	if !isOSReconciled && a.specManager.IsOSUpdate() {
-		if err = a.afterUpdateOS(ctx, desired); err != nil {
+		if err = a.afterUpdateOS(ctx, desired.Spec); err != nil {
 			a.log.Errorf("Error executing OS: %v", err)
 			return err
 		}
@@ -548,8 +637,8 @@ func (a *Agent) afterUpdate(ctx context.Context, current, desired *v1beta1.Devic
 	}
 
 	// execute after update hooks. in the new OS image case, these will fire after the reboot
-	// TODO: handle rebooted
-	rebooted := false
+	// into the new image.
+	rebooted := a.rebootedDuringUpdate(desired)
 	if err := a.hookManager.OnAfterUpdating(ctx, current, desired, rebooted); err != nil {
 		a.log.Errorf("Error executing AfterUpdating hook: %v", err)
 		return err
@@ -564,6 +653,58 @@ func (a *Agent) afterUpdate(ctx context.Context, current, desired *v1beta1.Devic
 	return nil
 }
 
+// updateBootFileName is the name of the file in the data-dir recording the boot an update started in.
+const updateBootFileName = "update-boot.json"
+
+// updateBoot records the boot in which the update to a rendered version started.
+type updateBoot struct {
+	Version string `json:"version"`
+	BootID  string `json:"bootID"`
+}
+
+func (a *Agent) readUpdateBoot() (*updateBoot, error) {
+	b, err := a.deviceReadWriter.ReadFile(filepath.Join(a.dataDir, updateBootFileName))
+	if err != nil {
+		return nil, err
+	}
+	var boot updateBoot
+	if err := json.Unmarshal(b, &boot); err != nil {
+		return nil, err
+	}
+	return &boot, nil
+}
+
+// recordUpdateBoot records the current boot as the one in which the update to the desired version started,
+// unless the update already started earlier, possibly before a reboot.
+func (a *Agent) recordUpdateBoot(desired *v1beta1.Device) {
+	if a.systemInfoManager == nil || a.deviceReadWriter == nil {
+		return
+	}
+	if boot, err := a.readUpdateBoot(); err == nil && boot.Version == desired.Version() {
+		return
+	}
+	b, err := json.Marshal(updateBoot{Version: desired.Version(), BootID: a.systemInfoManager.BootID()})
+	if err != nil {
+		a.log.Warnf("Failed marshalling update boot: %v", err)
+		return
+	}
+	if err := a.deviceReadWriter.WriteFile(filepath.Join(a.dataDir, updateBootFileName), b, fileio.DefaultFilePermissions); err != nil {
+		a.log.Warnf("Failed recording update boot: %v", err)
+	}
+}
+
+// rebootedDuringUpdate returns true if the system booted again since the update to the desired version started.
+func (a *Agent) rebootedDuringUpdate(desired *v1beta1.Device) bool {
+	if a.systemInfoManager == nil || a.deviceReadWriter == nil {
+		return false
+	}
+	boot, err := a.readUpdateBoot()
+	if err != nil {
+		return false
+	}
+	return boot.Version == desired.Version() && boot.BootID != a.systemInfoManager.BootID()
+}
+
 func (a *Agent) afterUpdateOS(ctx context.Context, desired *v1beta1.DeviceSpec) error {
 	if desired.Os == nil {
 		a.log.Debug("No OS image to update")
@@ -623,6 +764,9 @@ func (a *Agent) handleSyncError(ctx context.Context, desired *v1beta1.Device, sy
 	if !errors.IsRetryable(syncErr) {
 		msg := fmt.Sprintf("Failed to update to renderedVersion: %s: %v", version, syncErr.Error())
 		conditionUpdate.Reason = string(v1beta1.UpdateStateError)
+		if errors.Is(syncErr, errors.ErrPhaseVerifyingHealth) {
+			conditionUpdate.Reason = string(v1beta1.UpdateStateHealthCheckFailed)
+		}
 		conditionUpdate.Message = log.Truncate(msg, status.MaxMessageLength)
 		conditionUpdate.Status = v1beta1.ConditionStatusFalse
 		a.pullConfigResolver.Cleanup()
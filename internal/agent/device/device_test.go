@@ -3,6 +3,7 @@ package device
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"path/filepath"
 	"testing"
 	"time"
@@ -15,6 +16,7 @@ import (
 	"github.com/flightctl/flightctl/internal/agent/device/dependency"
 	"github.com/flightctl/flightctl/internal/agent/device/errors"
 	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/flightctl/flightctl/internal/agent/device/healthcheck"
 	"github.com/flightctl/flightctl/internal/agent/device/hook"
 	imagepruning "github.com/flightctl/flightctl/internal/agent/device/image_pruning"
 	"github.com/flightctl/flightctl/internal/agent/device/lifecycle"
@@ -26,6 +28,7 @@ import (
 	"github.com/flightctl/flightctl/internal/agent/device/status"
 	"github.com/flightctl/flightctl/internal/agent/device/systemd"
 	"github.com/flightctl/flightctl/internal/agent/device/systeminfo"
+	"github.com/flightctl/flightctl/internal/agent/device/volumesnapshot"
 	"github.com/flightctl/flightctl/pkg/executer"
 	"github.com/flightctl/flightctl/pkg/log"
 	"github.com/flightctl/flightctl/pkg/poll"
@@ -124,12 +127,13 @@ func TestSync(t *testing.T) {
 				// Mock systemctl for boot success check (via systemd client)
 				mockExec.EXPECT().ExecuteWithContext(gomock.Any(), "/usr/bin/systemctl", "is-active", "boot-complete.target").Return("active\n", "", 0).AnyTimes()
 				// OnAfterUpdating is called twice - once with error, once without during rollback
-				mockHookManager.EXPECT().OnAfterUpdating(ctx, current.Spec, desired.Spec, false).Return(nonRetryableHookError).AnyTimes()
-				mockHookManager.EXPECT().OnAfterUpdating(ctx, desired.Spec, current.Spec, false).Return(nil).AnyTimes()
+				mockHookManager.EXPECT().OnAfterUpdating(ctx, current, desired, false).Return(nonRetryableHookError).AnyTimes()
+				mockHookManager.EXPECT().OnAfterUpdating(ctx, desired, current, false).Return(nil).AnyTimes()
 				mockAppManager.EXPECT().AfterUpdate(ctx).Return(nil).AnyTimes()
 				mockSpecManager.EXPECT().SetUpgradeFailed(desired.Version(), desired.SpecHash()).Return(nil).AnyTimes()
 				mockSpecManager.EXPECT().Rollback(ctx).Return(nil).AnyTimes()
 				mockPrefetchManager.EXPECT().Cleanup().AnyTimes()
+				mockPrefetchManager.EXPECT().SyncTrustPolicy(gomock.Any()).Return(nil).AnyTimes()
 				// Upgrade should NOT be called when sync fails, but allow it for flexibility
 				mockSpecManager.EXPECT().Upgrade(gomock.Any()).Return(nil).AnyTimes()
 			},
@@ -168,6 +172,7 @@ func TestSync(t *testing.T) {
 				mockResourceManager.EXPECT().IsCriticalAlert(gomock.Any()).Return(true).AnyTimes()
 				mockSpecManager.EXPECT().Rollback(ctx).Return(nil).AnyTimes()
 				mockPrefetchManager.EXPECT().Cleanup().AnyTimes()
+				mockPrefetchManager.EXPECT().SyncTrustPolicy(gomock.Any()).Return(nil).AnyTimes()
 				mockHookManager.EXPECT().Sync(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 				mockLifecycleManager.EXPECT().Sync(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 				mockLifecycleManager.EXPECT().AfterUpdate(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
@@ -213,6 +218,7 @@ func TestSync(t *testing.T) {
 				}).AnyTimes()
 				mockSpecManager.EXPECT().Rollback(ctx).Return(nil).AnyTimes()
 				mockPrefetchManager.EXPECT().Cleanup().AnyTimes()
+				mockPrefetchManager.EXPECT().SyncTrustPolicy(gomock.Any()).Return(nil).AnyTimes()
 				mockHookManager.EXPECT().Sync(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 				mockLifecycleManager.EXPECT().Sync(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 				mockLifecycleManager.EXPECT().AfterUpdate(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
@@ -244,6 +250,10 @@ func TestSync(t *testing.T) {
 			mockOSManager := os.NewMockManager(ctrl)
 			mockPruningManager := imagepruning.NewMockManager(ctrl)
 			mockPullConfigResolver := dependency.NewMockPullConfigResolver(ctrl)
+			mockVolumeSnapshotManager := volumesnapshot.NewMockManager(ctrl)
+			mockVolumeSnapshotManager.EXPECT().Snapshot(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			mockVolumeSnapshotManager.EXPECT().Restore(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			mockVolumeSnapshotManager.EXPECT().Prune(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 			tc.setupMocks(
 				tc.current,
 				tc.desired,
@@ -292,7 +302,7 @@ func TestSync(t *testing.T) {
 			agent := Agent{
 				log:                    log,
 				systemdClient:          systemdClient,
-				deviceWriter:           readWriter,
+				deviceReadWriter:       readWriter,
 				specManager:            mockSpecManager,
 				policyManager:          mockPolicyManager,
 				statusManager:          statusManager,
@@ -308,6 +318,7 @@ func TestSync(t *testing.T) {
 				osManager:              mockOSManager,
 				pruningManager:         mockPruningManager,
 				pullConfigResolver:     mockPullConfigResolver,
+				volumeSnapshotManager:  mockVolumeSnapshotManager,
 			}
 
 			// initial sync
@@ -321,6 +332,172 @@ func TestSync(t *testing.T) {
 
 }
 
+func TestSyncDeviceSpecHealthChecksPending(t *testing.T) {
+	require := require.New(t)
+	deviceName := "test-device"
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockManagementClient := client.NewMockManagement(ctrl)
+	mockExec := executer.NewMockExecuter(ctrl)
+	mockRouterService := console.NewMockRouterServiceClient(ctrl)
+	mockResourceManager := resource.NewMockManager(ctrl)
+	mockSystemdManager := systemd.NewMockManager(ctrl)
+	mockHookManager := hook.NewMockManager(ctrl)
+	mockHealthCheckManager := healthcheck.NewMockManager(ctrl)
+	mockAppManager := applications.NewMockManager(ctrl)
+	mockLifecycleManager := lifecycle.NewMockManager(ctrl)
+	mockPolicyManager := policy.NewMockManager(ctrl)
+	mockSpecManager := spec.NewMockManager(ctrl)
+	mockPrefetchManager := dependency.NewMockPrefetchManager(ctrl)
+	mockOSManager := os.NewMockManager(ctrl)
+	mockPruningManager := imagepruning.NewMockManager(ctrl)
+	mockPullConfigResolver := dependency.NewMockPullConfigResolver(ctrl)
+	mockVolumeSnapshotManager := volumesnapshot.NewMockManager(ctrl)
+
+	current := newVersionedDevice("0")
+	desired := newVersionedDevice("1")
+
+	upgrading := true
+	mockSpecManager.EXPECT().IsUpgrading().DoAndReturn(func() bool { return upgrading }).AnyTimes()
+	mockSpecManager.EXPECT().IsOSUpdate().Return(false).AnyTimes()
+	mockSpecManager.EXPECT().IsOSUpdatePending(gomock.Any()).Return(false, nil).AnyTimes()
+	mockSpecManager.EXPECT().CheckPolicy(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockSpecManager.EXPECT().CheckOsReconciliation(gomock.Any()).Return("", true, nil).AnyTimes()
+	mockSpecManager.EXPECT().GetDesired(ctx).Return(desired, false, nil).AnyTimes()
+	mockSpecManager.EXPECT().Read(spec.Current).Return(current, nil).AnyTimes()
+	mockManagementClient.EXPECT().UpdateDeviceStatus(gomock.Any(), deviceName, gomock.Any()).Return(nil).AnyTimes()
+	mockResourceManager.EXPECT().IsCriticalAlert(gomock.Any()).Return(false).AnyTimes()
+	mockResourceManager.EXPECT().BeforeUpdate(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockSystemdManager.EXPECT().EnsurePatterns(gomock.Any()).Return(nil).AnyTimes()
+	mockPullConfigResolver.EXPECT().BeforeUpdate(gomock.Any()).AnyTimes()
+	mockPullConfigResolver.EXPECT().Cleanup().AnyTimes()
+	mockPrefetchManager.EXPECT().RegisterOCICollector(gomock.Any()).AnyTimes()
+	mockPrefetchManager.EXPECT().BeforeUpdate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockPrefetchManager.EXPECT().SyncTrustPolicy(gomock.Any()).Return(nil).AnyTimes()
+	mockPrefetchManager.EXPECT().Cleanup().AnyTimes()
+	mockPruningManager.EXPECT().RecordReferences(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockPruningManager.EXPECT().RequestPrune().AnyTimes()
+	mockPruningManager.EXPECT().PrunePending().Return(false).AnyTimes()
+	mockAppManager.EXPECT().BeforeUpdate(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockAppManager.EXPECT().AfterUpdate(gomock.Any()).Return(nil).AnyTimes()
+	mockHookManager.EXPECT().Sync(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockLifecycleManager.EXPECT().Sync(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockLifecycleManager.EXPECT().AfterUpdate(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockVolumeSnapshotManager.EXPECT().Snapshot(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockVolumeSnapshotManager.EXPECT().Prune(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	// the update is applied and its hooks run only once while the health checks are pending
+	mockHookManager.EXPECT().OnBeforeUpdating(gomock.Any(), current, desired).Return(nil).Times(1)
+	mockHookManager.EXPECT().OnAfterUpdating(gomock.Any(), current, desired, false).Return(nil).Times(1)
+	gomock.InOrder(
+		mockHealthCheckManager.EXPECT().Check(gomock.Any(), desired).Return(false, nil).Times(2),
+		mockHealthCheckManager.EXPECT().Check(gomock.Any(), desired).Return(true, nil).Times(1),
+	)
+	mockSpecManager.EXPECT().Upgrade(gomock.Any()).DoAndReturn(func(context.Context) error {
+		upgrading = false
+		return nil
+	}).Times(1)
+
+	log := log.NewPrefixLogger("test")
+	tempDir := t.TempDir()
+	readWriter := fileio.NewReadWriter(
+		fileio.NewReader(fileio.WithReaderRootDir(tempDir)),
+		fileio.NewWriter(fileio.WithWriterRootDir(tempDir)),
+	)
+	podmanClient := client.NewPodman(log, mockExec, readWriter, testutil.NewPollConfig())
+	var podmanFactory client.PodmanFactory = func(user v1beta1.Username) (*client.Podman, error) {
+		return podmanClient, nil
+	}
+	var rwFactory fileio.ReadWriterFactory = func(username v1beta1.Username) (fileio.ReadWriter, error) {
+		return readWriter, nil
+	}
+	statusManager := status.NewManager(deviceName, log)
+	statusManager.SetClient(mockManagementClient)
+
+	agent := Agent{
+		log:                    log,
+		systemdClient:          client.NewSystemd(mockExec, v1beta1.RootUsername),
+		deviceReadWriter:       readWriter,
+		specManager:            mockSpecManager,
+		policyManager:          mockPolicyManager,
+		statusManager:          statusManager,
+		appManager:             mockAppManager,
+		applicationsController: applications.NewController(podmanFactory, nil, mockAppManager, rwFactory, log, "2025-01-01T00:00:00Z"),
+		hookManager:            mockHookManager,
+		healthCheckManager:     mockHealthCheckManager,
+		consoleManager:         console.NewManager(mockRouterService, deviceName, "root", mockExec, spec.NewMockWatcher(ctrl), log),
+		configController:       config.NewController(readWriter, log),
+		resourceManager:        mockResourceManager,
+		systemdManager:         mockSystemdManager,
+		lifecycleManager:       mockLifecycleManager,
+		prefetchManager:        mockPrefetchManager,
+		osManager:              mockOSManager,
+		pruningManager:         mockPruningManager,
+		pullConfigResolver:     mockPullConfigResolver,
+		volumeSnapshotManager:  mockVolumeSnapshotManager,
+	}
+
+	// apply the update, health checks pending
+	agent.syncDeviceSpec(ctx)
+	require.Equal(desired.Version(), agent.healthCheckPendingVersion)
+	// health checks still pending, the update is not applied again
+	agent.syncDeviceSpec(ctx)
+	// health checks passed, the spec is upgraded
+	agent.syncDeviceSpec(ctx)
+	require.Empty(agent.healthCheckPendingVersion)
+}
+
+func TestHandleSyncError(t *testing.T) {
+	testCases := []struct {
+		name       string
+		syncErr    error
+		wantReason v1beta1.UpdateState
+	}{
+		{
+			name:       "failed update",
+			syncErr:    fmt.Errorf("%w: %w", errors.ErrPhaseApplyingUpdate, errors.New("failed")),
+			wantReason: v1beta1.UpdateStateError,
+		},
+		{
+			name:       "failed health checks",
+			syncErr:    fmt.Errorf("%w: %w", errors.ErrPhaseVerifyingHealth, errors.New("failed")),
+			wantReason: v1beta1.UpdateStateHealthCheckFailed,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require := require.New(t)
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStatusManager := status.NewMockManager(ctrl)
+			mockPullConfigResolver := dependency.NewMockPullConfigResolver(ctrl)
+			mockPrefetchManager := dependency.NewMockPrefetchManager(ctrl)
+			mockPullConfigResolver.EXPECT().Cleanup()
+			mockPrefetchManager.EXPECT().Cleanup()
+			var condition v1beta1.Condition
+			mockStatusManager.EXPECT().UpdateCondition(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, c v1beta1.Condition) error {
+					condition = c
+					return nil
+				})
+
+			agent := Agent{
+				log:                log.NewPrefixLogger("test"),
+				statusManager:      mockStatusManager,
+				pullConfigResolver: mockPullConfigResolver,
+				prefetchManager:    mockPrefetchManager,
+			}
+			agent.handleSyncError(context.Background(), newVersionedDevice("1"), tc.syncErr)
+			require.Equal(string(tc.wantReason), condition.Reason)
+			require.Equal(v1beta1.ConditionStatusFalse, condition.Status)
+			require.True(v1beta1.IsUpdateFailed(condition.Reason))
+		})
+	}
+}
+
 func TestRollbackDevice(t *testing.T) {
 	deviceName := "test-device"
 	ctx, cancel := context.WithCancel(context.Background())
@@ -390,6 +567,9 @@ func TestRollbackDevice(t *testing.T) {
 			defer ctrl.Finish()
 			mockOSClient := os.NewMockClient(ctrl)
 			mockManagementClient := client.NewMockManagement(ctrl)
+			mockVolumeSnapshotManager := volumesnapshot.NewMockManager(ctrl)
+			mockVolumeSnapshotManager.EXPECT().Restore(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			mockVolumeSnapshotManager.EXPECT().Prune(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 			tc.setupMocks(
 				tc.current,
 				tc.desired,
@@ -436,9 +616,10 @@ func TestRollbackDevice(t *testing.T) {
 			require.NoError(err)
 
 			agent := Agent{
-				log:           log,
-				statusManager: statusManager,
-				specManager:   specManager,
+				log:                   log,
+				statusManager:         statusManager,
+				specManager:           specManager,
+				volumeSnapshotManager: mockVolumeSnapshotManager,
 			}
 
 			mockSync := &mockSync{
@@ -595,6 +776,8 @@ func TestOSRollback(t *testing.T) {
 
 			mockPrefetchManager.EXPECT().Cleanup().AnyTimes()
 			mockPullConfigResolver.EXPECT().Cleanup().AnyTimes()
+			mockVolumeSnapshotManager := volumesnapshot.NewMockManager(ctrl)
+			mockVolumeSnapshotManager.EXPECT().Restore(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 			tc.setupMocks(mockSpecManager, mockManagementClient, mockHookManager, mockOSManager)
 
@@ -604,13 +787,14 @@ func TestOSRollback(t *testing.T) {
 			statusManager.SetClient(mockManagementClient)
 
 			agent := Agent{
-				log:                log,
-				specManager:        mockSpecManager,
-				statusManager:      statusManager,
-				hookManager:        mockHookManager,
-				osManager:          mockOSManager,
-				prefetchManager:    mockPrefetchManager,
-				pullConfigResolver: mockPullConfigResolver,
+				log:                   log,
+				specManager:           mockSpecManager,
+				statusManager:         statusManager,
+				hookManager:           mockHookManager,
+				osManager:             mockOSManager,
+				prefetchManager:       mockPrefetchManager,
+				pullConfigResolver:    mockPullConfigResolver,
+				volumeSnapshotManager: mockVolumeSnapshotManager,
 			}
 
 			syncFnCalled := false
@@ -632,6 +816,44 @@ func TestOSRollback(t *testing.T) {
 	}
 }
 
+func TestRebootedDuringUpdate(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tempDir := t.TempDir()
+	readWriter := fileio.NewReadWriter(
+		fileio.NewReader(fileio.WithReaderRootDir(tempDir)),
+		fileio.NewWriter(fileio.WithWriterRootDir(tempDir)),
+	)
+	require.NoError(readWriter.MkdirAll("/var/lib/flightctl", fileio.DefaultDirectoryPermissions))
+	mockSystemInfoManager := systeminfo.NewMockManager(ctrl)
+	agent := Agent{
+		log:               log.NewPrefixLogger("test"),
+		deviceReadWriter:  readWriter,
+		systemInfoManager: mockSystemInfoManager,
+		dataDir:           "/var/lib/flightctl",
+	}
+	desired := newVersionedDevice("2")
+
+	mockSystemInfoManager.EXPECT().BootID().Return("boot-1").Times(2)
+	mockSystemInfoManager.EXPECT().BootID().Return("boot-2").AnyTimes()
+
+	// the update starts and completes within the same boot, for example with a staged OS image
+	agent.recordUpdateBoot(desired)
+	require.False(agent.rebootedDuringUpdate(desired))
+
+	// the update is retried after a reboot, the boot it started in is kept
+	agent.recordUpdateBoot(desired)
+	require.True(agent.rebootedDuringUpdate(desired))
+
+	// a later update starts in the current boot
+	next := newVersionedDevice("3")
+	agent.recordUpdateBoot(next)
+	require.False(agent.rebootedDuringUpdate(next))
+	require.False(agent.rebootedDuringUpdate(desired))
+}
+
 func newVersionedDevice(version string) *v1beta1.Device {
 	device := &v1beta1.Device{
 		Metadata: v1beta1.ObjectMeta{
@@ -23,6 +23,7 @@ var (
 	ErrPhasePreparing        = errors.New("before update")
 	ErrPhaseApplyingUpdate   = errors.New("sync device")
 	ErrPhaseActivatingConfig = errors.New("after update")
+	ErrPhaseVerifyingHealth  = errors.New("verify health")
 
 	// components - used to wrap errors indicating which component failed
 	ErrComponentResources      = errors.New("resources")
@@ -36,6 +37,9 @@ var (
 	ErrComponentLifecycle      = errors.New("lifecycle")
 	ErrComponentOS             = errors.New("os")
 	ErrComponentOSReconciled   = errors.New("os reconciliation")
+	ErrComponentHealthChecks   = errors.New("health checks")
+	ErrComponentTrustPolicy    = errors.New("trust policy")
+	ErrComponentVolumeSnapshot = errors.New("volume snapshot")
 
 	// bootstrap
 	ErrEnrollmentRequestFailed = errors.New("enrollment request failed")
@@ -48,6 +52,8 @@ var (
 	ErrUnsupportedVolumeType  = errors.New("unsupported volume type")
 	ErrParseAppType           = errors.New("failed to parse application type")
 	ErrAppDependency          = errors.New("application dependency")
+	ErrAppDependencyCycle     = errors.New("application dependency cycle")
+	ErrAppDependencyNotReady  = errors.New("application dependency not ready")
 	ErrUnsupportedAppProvider = errors.New("unsupported application provider")
 	ErrAppLabel               = errors.New("required label not found")
 	ErrKubernetesAppsDisabled = errors.New("kubernetes applications disabled")
@@ -102,10 +108,16 @@ var (
 	ErrNotExist    = os.ErrNotExist
 	ErrInvalidPath = errors.New("invalid path")
 
+	// encryption
+	ErrDecryptingContents = errors.New("failed decrypting contents")
+
 	// images
 	ErrImageNotFound     = errors.New("image not found")
 	ErrImageUnauthorized = errors.New("image unauthorized")
 
+	// signature verification
+	ErrSignatureVerificationFailed = errors.New("signature verification failed")
+
 	// policy
 	ErrDownloadPolicyNotReady = errors.New("download policy not ready")
 	ErrUpdatePolicyNotReady   = errors.New("update policy not ready")
@@ -179,6 +191,10 @@ var (
 	ErrUnknownHookConditionType             = errors.New("unknown hook condition type")
 	ErrFailedToExecute                      = errors.New("failed to execute")
 	ErrLookingForHook                       = errors.New("looking for hook")
+	ErrHookWaitTimedOut                     = errors.New("timed out waiting")
+
+	// health check errors
+	ErrHealthCheckFailed = errors.New("health check failed")
 
 	// OS errors
 	ErrUnableToParseImageReference = errors.New("unable to parse image reference into a valid bootc target")
@@ -234,6 +250,10 @@ var (
 		// no such object
 		"no such object":          ErrNotFound,
 		"no space left on device": ErrNoSpaceLeft,
+		// signature verification
+		"Source image rejected":  ErrSignatureVerificationFailed,
+		"is rejected by policy":  ErrSignatureVerificationFailed,
+		"signature verification": ErrSignatureVerificationFailed,
 	}
 
 	// errorTypeToCode maps error types from stderrKeywords to status codes.
@@ -242,6 +262,9 @@ var (
 		ErrAuthenticationFailed: codes.Unauthenticated,
 		ErrImageUnauthorized:    codes.PermissionDenied,
 
+		// signature verification
+		ErrSignatureVerificationFailed: codes.PermissionDenied,
+
 		// not found / filesystem
 		ErrNotFound:            codes.NotFound,
 		ErrNotExist:            codes.NotFound,
@@ -270,12 +293,14 @@ var (
 		ErrPathIsDir:          codes.InvalidArgument,
 		ErrInvalidPath:        codes.InvalidArgument,
 		ErrInvalidSpec:        codes.InvalidArgument,
+		ErrAppDependencyCycle: codes.InvalidArgument,
 
 		// internal errors
 		ErrParseAppType:             codes.Internal,
 		ErrActionTypeNotFound:       codes.Internal,
 		ErrInvalidSpecType:          codes.Internal,
 		ErrInvalidPolicyType:        codes.Internal,
+		ErrDecryptingContents:       codes.Internal,
 		ErrParseRenderedVersion:     codes.Internal,
 		ErrUnmarshalSpec:            codes.Internal,
 		ErrBootcStatusInvalidJSON:   codes.Internal,
@@ -290,6 +315,7 @@ var (
 
 		// failed precondition
 		ErrAppDependency:          codes.FailedPrecondition,
+		ErrAppDependencyNotReady:  codes.FailedPrecondition,
 		ErrAppLabel:               codes.FailedPrecondition,
 		ErrDownloadPolicyNotReady: codes.FailedPrecondition,
 		ErrUpdatePolicyNotReady:   codes.FailedPrecondition,
@@ -363,6 +389,10 @@ var (
 		ErrUnknownHookConditionType:             codes.Internal,
 		ErrFailedToExecute:                      codes.Unavailable,
 		ErrLookingForHook:                       codes.InvalidArgument,
+		ErrHookWaitTimedOut:                     codes.DeadlineExceeded,
+
+		// health check errors
+		ErrHealthCheckFailed: codes.Aborted,
 
 		// OS errors
 		ErrUnableToParseImageReference: codes.InvalidArgument,
@@ -512,6 +542,9 @@ func IsRetryable(err error) bool {
 		return true
 	case errors.Is(err, ErrImageUnauthorized):
 		return false
+	case errors.Is(err, ErrSignatureVerificationFailed):
+		// a rejected signature does not change until the image or the trust policy does
+		return false
 	default:
 		// this will need to be updated as we identify more errors that are
 		// retryable but for now we will fail the update.
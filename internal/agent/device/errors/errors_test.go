@@ -36,6 +36,13 @@ func TestFromStderr(t *testing.T) {
 			expected:   ErrNotFound,
 			shouldWrap: true,
 		},
+		{
+			name:       "rejected source image returns ErrSignatureVerificationFailed",
+			stderr:     "Error: copying system image from manifest list: Source image rejected: A signature was required, but no signature exists",
+			exitCode:   125,
+			expected:   ErrSignatureVerificationFailed,
+			shouldWrap: true,
+		},
 		{
 			name:     "unknown error returns generic error",
 			stderr:   "some other error",
@@ -12,6 +12,7 @@ type StructuredError struct {
 	Phase      error
 	Component  error
 	Element    string
+	Detail     string
 	Category   Category
 	StatusCode codes.Code
 	Timestamp  time.Time
@@ -37,6 +38,7 @@ func FormatError(err error) *StructuredError {
 		Phase:      phase,
 		Component:  component,
 		Element:    truncateElement(GetElement(err)),
+		Detail:     causeMessage(rest),
 		StatusCode: statusCode,
 		Category:   inferCategory(statusCode),
 		Timestamp:  time.Now(),
@@ -60,6 +62,7 @@ var phaseDisplayNames = map[error]string{
 	ErrPhasePreparing:        "Preparing",
 	ErrPhaseApplyingUpdate:   "ApplyingUpdate",
 	ErrPhaseActivatingConfig: "Rebooting",
+	ErrPhaseVerifyingHealth:  "VerifyingHealth",
 }
 
 func phaseDisplayName(err error) string {
@@ -82,6 +85,9 @@ func (se *StructuredError) Message() string {
 	}
 
 	statusMsg := statusCodeMessage(se.StatusCode)
+	if se.Detail != "" {
+		statusMsg = se.Detail
+	}
 
 	if se.Element != "" {
 		return fmt.Sprintf("[%s] While %s: %s failed for %s: %s",
@@ -141,6 +147,20 @@ func inferCategory(statusCode codes.Code) Category {
 	return CategoryUnknown
 }
 
+// causeMessages are shown instead of the generic status code message for causes that need a precise explanation.
+var causeMessages = map[error]string{
+	ErrSignatureVerificationFailed: "image signature verification failed: rejected by the device's trust policy",
+}
+
+func causeMessage(err error) string {
+	for cause, msg := range causeMessages {
+		if Is(err, cause) {
+			return msg
+		}
+	}
+	return ""
+}
+
 func statusCodeMessage(code codes.Code) string {
 	switch code {
 	case codes.Canceled:
@@ -193,6 +193,13 @@ func TestMessage(t *testing.T) {
 				fmt.Errorf("%w: %w", ErrComponentConfig, ErrPermissionDenied)),
 			contains: []string{"While ApplyingUpdate", "config failed:", "permission denied"},
 		},
+		{
+			name: "signature verification failed",
+			err: fmt.Errorf("%w: %w", ErrPhasePreparing,
+				fmt.Errorf("%w: %w", ErrComponentPrefetch,
+					fmt.Errorf("pulling %w: %w", WithElement("quay.io/org/app:v1"), FromStderr("Error: Source image rejected: A signature was required, but no signature exists", 125)))),
+			contains: []string{"While Preparing", "prefetch failed for", "quay.io/org/app:v1", "signature verification failed", "trust policy"},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -54,6 +54,13 @@ type Writer interface {
 	CreateManagedFile(file v1beta1.FileSpec) (ManagedFile, error)
 	// OverwriteAndWipe overwrites the file at the given path with zeros and then deletes it.
 	OverwriteAndWipe(file string) error
+	// DecodeContents returns the decoded contents of the file spec, decrypting contents that were encrypted to the device.
+	DecodeContents(file v1beta1.FileSpec) ([]byte, error)
+}
+
+// ContentDecrypter decrypts file contents that the service encrypted to the device.
+type ContentDecrypter interface {
+	DecryptContent(content string) ([]byte, error)
 }
 
 type Reader interface {
@@ -75,11 +82,11 @@ type readWriter struct {
 
 type ReadWriterFactory func(username v1beta1.Username) (ReadWriter, error)
 
-func NewReadWriterFactory(rootDir string) ReadWriterFactory {
+func NewReadWriterFactory(rootDir string, opts ...WriterOption) ReadWriterFactory {
 	return func(username v1beta1.Username) (ReadWriter, error) {
-		writerOptions := []WriterOption{
+		writerOptions := append([]WriterOption{
 			WithWriterRootDir(rootDir),
-		}
+		}, opts...)
 
 		if !username.IsCurrentProcessUser() {
 			uid, gid, _, err := userutil.LookupUser(username)
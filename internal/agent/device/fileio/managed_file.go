@@ -55,7 +55,7 @@ func (m *managedFile) decodeFile() error {
 	if m.contents != nil {
 		return nil
 	}
-	contents, err := m.file.ContentsDecoded()
+	contents, err := m.writer.DecodeContents(m.file)
 	if err != nil {
 		return fmt.Errorf("%w: %w", err, errors.WithElement(m.Path()))
 	}
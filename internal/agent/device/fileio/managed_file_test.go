@@ -1,6 +1,8 @@
 package fileio
 
 import (
+	"fmt"
+	"os"
 	"strconv"
 	"testing"
 
@@ -134,6 +136,61 @@ func TestIsUpToDate(t *testing.T) {
 	}
 }
 
+type decrypterFunc func(content string) ([]byte, error)
+
+func (f decrypterFunc) DecryptContent(content string) ([]byte, error) {
+	return f(content)
+}
+
+func TestWriteEncrypted(t *testing.T) {
+	require := require.New(t)
+	testUid, testGid, err := getUserIdentity()
+	require.NoError(err)
+	decrypter := decrypterFunc(func(content string) ([]byte, error) {
+		if content != "ciphertext" {
+			return nil, fmt.Errorf("invalid ciphertext")
+		}
+		return []byte("secret"), nil
+	})
+
+	file := createTestFile("encrypted", "ciphertext", int(DefaultFilePermissions), testUid, testGid)
+	file.ContentEncoding = lo.ToPtr(v1beta1.EncodingJWE)
+
+	t.Run("contents are decrypted when written", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		writer := NewWriter(WithWriterRootDir(tmpDir), WithContentDecrypter(decrypter))
+		managed, err := writer.CreateManagedFile(*file)
+		require.NoError(err)
+		require.NoError(managed.Write())
+
+		contents, err := os.ReadFile(writer.PathFor(file.Path))
+		require.NoError(err)
+		require.Equal("secret", string(contents))
+
+		managed, err = writer.CreateManagedFile(*file)
+		require.NoError(err)
+		upToDate, err := managed.IsUpToDate()
+		require.NoError(err)
+		require.True(upToDate)
+	})
+
+	t.Run("writer without decrypter fails", func(t *testing.T) {
+		writer := NewWriter(WithWriterRootDir(t.TempDir()))
+		managed, err := writer.CreateManagedFile(*file)
+		require.NoError(err)
+		require.ErrorIs(managed.Write(), errors.ErrDecryptingContents)
+	})
+
+	t.Run("invalid ciphertext fails", func(t *testing.T) {
+		writer := NewWriter(WithWriterRootDir(t.TempDir()), WithContentDecrypter(decrypter))
+		tampered := *file
+		tampered.Content = "tampered"
+		managed, err := writer.CreateManagedFile(tampered)
+		require.NoError(err)
+		require.ErrorIs(managed.Write(), errors.ErrDecryptingContents)
+	})
+}
+
 func createTestFile(path, data string, mode, user, group int) *v1beta1.FileSpec {
 	return &v1beta1.FileSpec{
 		Path:    path,
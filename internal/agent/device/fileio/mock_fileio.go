@@ -185,6 +185,21 @@ func (mr *MockWriterMockRecorder) CreateManagedFile(file any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateManagedFile", reflect.TypeOf((*MockWriter)(nil).CreateManagedFile), file)
 }
 
+// DecodeContents mocks base method.
+func (m *MockWriter) DecodeContents(file v1beta1.FileSpec) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DecodeContents", file)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DecodeContents indicates an expected call of DecodeContents.
+func (mr *MockWriterMockRecorder) DecodeContents(file any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecodeContents", reflect.TypeOf((*MockWriter)(nil).DecodeContents), file)
+}
+
 // MkdirAll mocks base method.
 func (m *MockWriter) MkdirAll(path string, perm fs.FileMode) error {
 	m.ctrl.T.Helper()
@@ -317,6 +332,44 @@ func (mr *MockWriterMockRecorder) WriteFile(name, data, perm any, opts ...any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteFile", reflect.TypeOf((*MockWriter)(nil).WriteFile), varargs...)
 }
 
+// MockContentDecrypter is a mock of ContentDecrypter interface.
+type MockContentDecrypter struct {
+	ctrl     *gomock.Controller
+	recorder *MockContentDecrypterMockRecorder
+}
+
+// MockContentDecrypterMockRecorder is the mock recorder for MockContentDecrypter.
+type MockContentDecrypterMockRecorder struct {
+	mock *MockContentDecrypter
+}
+
+// NewMockContentDecrypter creates a new mock instance.
+func NewMockContentDecrypter(ctrl *gomock.Controller) *MockContentDecrypter {
+	mock := &MockContentDecrypter{ctrl: ctrl}
+	mock.recorder = &MockContentDecrypterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockContentDecrypter) EXPECT() *MockContentDecrypterMockRecorder {
+	return m.recorder
+}
+
+// DecryptContent mocks base method.
+func (m *MockContentDecrypter) DecryptContent(content string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DecryptContent", content)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DecryptContent indicates an expected call of DecryptContent.
+func (mr *MockContentDecrypterMockRecorder) DecryptContent(content any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecryptContent", reflect.TypeOf((*MockContentDecrypter)(nil).DecryptContent), content)
+}
+
 // MockReader is a mock of Reader interface.
 type MockReader struct {
 	ctrl     *gomock.Controller
@@ -490,6 +543,21 @@ func (mr *MockReadWriterMockRecorder) CreateManagedFile(file any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateManagedFile", reflect.TypeOf((*MockReadWriter)(nil).CreateManagedFile), file)
 }
 
+// DecodeContents mocks base method.
+func (m *MockReadWriter) DecodeContents(file v1beta1.FileSpec) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DecodeContents", file)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DecodeContents indicates an expected call of DecodeContents.
+func (mr *MockReadWriterMockRecorder) DecodeContents(file any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecodeContents", reflect.TypeOf((*MockReadWriter)(nil).DecodeContents), file)
+}
+
 // MkdirAll mocks base method.
 func (m *MockReadWriter) MkdirAll(path string, perm fs.FileMode) error {
 	m.ctrl.T.Helper()
@@ -28,6 +28,8 @@ type writer struct {
 	uid int
 	// Default GID of the owner of any files created or moved. -1 disabled gid handling.
 	gid int
+	// decrypter decrypts file contents that were encrypted to the device
+	decrypter ContentDecrypter
 }
 
 type symlinkBehavior int
@@ -92,9 +94,10 @@ func WithPreserveSymlinkWithinRoot() CopyDirOption {
 }
 
 type writerOptions struct {
-	uid     int
-	gid     int
-	rootDir string
+	uid       int
+	gid       int
+	rootDir   string
+	decrypter ContentDecrypter
 }
 
 type WriterOption func(*writerOptions)
@@ -117,6 +120,13 @@ func WithWriterRootDir(rootDir string) WriterOption {
 	}
 }
 
+// WithContentDecrypter sets the decrypter for file contents that were encrypted to the device.
+func WithContentDecrypter(decrypter ContentDecrypter) WriterOption {
+	return func(wo *writerOptions) {
+		wo.decrypter = decrypter
+	}
+}
+
 // New creates a new writer
 func NewWriter(options ...WriterOption) *writer {
 	opts := writerOptions{
@@ -130,9 +140,10 @@ func NewWriter(options ...WriterOption) *writer {
 		o(&opts)
 	}
 	return &writer{
-		uid:     opts.uid,
-		gid:     opts.gid,
-		rootDir: opts.rootDir,
+		uid:       opts.uid,
+		gid:       opts.gid,
+		rootDir:   opts.rootDir,
+		decrypter: opts.decrypter,
 	}
 }
 
@@ -476,6 +487,20 @@ func (w *writer) CreateManagedFile(file v1beta1.FileSpec) (ManagedFile, error) {
 	return newManagedFile(file, w)
 }
 
+func (w *writer) DecodeContents(file v1beta1.FileSpec) ([]byte, error) {
+	if file.ContentEncoding == nil || *file.ContentEncoding != v1beta1.EncodingJWE {
+		return file.ContentsDecoded()
+	}
+	if w.decrypter == nil {
+		return nil, fmt.Errorf("%w: no decrypter for encrypted contents", errors.ErrDecryptingContents)
+	}
+	contents, err := w.decrypter.DecryptContent(file.Content)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errors.ErrDecryptingContents, err)
+	}
+	return contents, nil
+}
+
 func (w *writer) OverwriteAndWipe(file string) error {
 	if err := w.overwriteFileWithRandomData(file); err != nil {
 		return fmt.Errorf("could not overwrite file %w with random data: %w", errors.WithElement(file), err)
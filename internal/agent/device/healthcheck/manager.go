@@ -0,0 +1,185 @@
+package healthcheck
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/client"
+	"github.com/flightctl/flightctl/internal/agent/device/errors"
+	"github.com/flightctl/flightctl/internal/agent/device/status"
+	"github.com/flightctl/flightctl/pkg/executer"
+	"github.com/flightctl/flightctl/pkg/log"
+)
+
+const (
+	// DefaultGracePeriod is the time a device is given to become healthy after an update.
+	DefaultGracePeriod = 5 * time.Minute
+	// DefaultInterval is the time between two evaluations of the probes.
+	DefaultInterval = 10 * time.Second
+	// DefaultFailureThreshold is the number of consecutive failed evaluations after the grace period
+	// after which the update is considered failed.
+	DefaultFailureThreshold = 3
+	// DefaultProbeTimeout is the maximum duration of a single evaluation of a probe.
+	DefaultProbeTimeout = 5 * time.Second
+)
+
+var _ Manager = (*manager)(nil)
+
+// Manager evaluates the health checks of a device after an update has been applied.
+type Manager interface {
+	// Check evaluates the health checks of the desired device. It returns true once all probes pass and false
+	// while the checks are still pending. Once the checks failed for longer than the grace period and failure
+	// threshold allow, an error wrapping errors.ErrHealthCheckFailed is returned.
+	Check(ctx context.Context, desired *v1beta1.Device) (bool, error)
+}
+
+type manager struct {
+	exec    executer.Executer
+	systemd *client.Systemd
+	apps    status.Exporter
+	log     *log.PrefixLogger
+	now     func() time.Time
+
+	// state of the evaluation of the current renderedVersion
+	version       string
+	started       time.Time
+	lastEvaluated time.Time
+	failures      int
+}
+
+// NewManager creates a new health check manager. The systemd client and application status exporter are used by
+// systemd unit and application probes.
+func NewManager(exec executer.Executer, systemd *client.Systemd, apps status.Exporter, log *log.PrefixLogger) Manager {
+	return &manager{
+		exec:    exec,
+		systemd: systemd,
+		apps:    apps,
+		log:     log,
+		now:     time.Now,
+	}
+}
+
+func (m *manager) Check(ctx context.Context, desired *v1beta1.Device) (bool, error) {
+	if desired.Spec == nil || desired.Spec.HealthChecks == nil || len(desired.Spec.HealthChecks.Probes) == 0 {
+		return true, nil
+	}
+	healthChecks := desired.Spec.HealthChecks
+
+	gracePeriod, err := parseDuration(healthChecks.GracePeriod, DefaultGracePeriod)
+	if err != nil {
+		return false, fmt.Errorf("%w: grace period: %w", errors.ErrInvalidSpec, err)
+	}
+	interval, err := parseDuration(healthChecks.Interval, DefaultInterval)
+	if err != nil {
+		return false, fmt.Errorf("%w: interval: %w", errors.ErrInvalidSpec, err)
+	}
+	failureThreshold := DefaultFailureThreshold
+	if healthChecks.FailureThreshold != nil {
+		failureThreshold = int(*healthChecks.FailureThreshold)
+	}
+
+	now := m.now()
+	if m.version != desired.Version() {
+		m.version = desired.Version()
+		m.started = now
+		m.lastEvaluated = time.Time{}
+		m.failures = 0
+	}
+	if !m.lastEvaluated.IsZero() && now.Sub(m.lastEvaluated) < interval {
+		return false, nil
+	}
+	m.lastEvaluated = now
+
+	probeErr := m.runProbes(ctx, healthChecks.Probes)
+	if probeErr == nil {
+		m.log.Infof("All health checks passed for renderedVersion: %s", desired.Version())
+		return true, nil
+	}
+	if ctx.Err() != nil {
+		return false, ctx.Err()
+	}
+
+	if now.Sub(m.started) < gracePeriod {
+		m.log.Debugf("Health check failed within the grace period: %v", probeErr)
+		return false, nil
+	}
+
+	m.failures++
+	m.log.Warnf("Health check failed (%d/%d): %v", m.failures, failureThreshold, probeErr)
+	if m.failures < failureThreshold {
+		return false, nil
+	}
+
+	// start over if the same renderedVersion is checked again
+	m.version = ""
+	return false, fmt.Errorf("%w: %w", errors.ErrComponentHealthChecks, probeErr)
+}
+
+// runProbes evaluates the probes in order and returns the error of the first probe that fails.
+func (m *manager) runProbes(ctx context.Context, probes []v1beta1.HealthProbe) error {
+	for _, probe := range probes {
+		if err := m.runProbe(ctx, probe); err != nil {
+			// the cause is not wrapped, as it would otherwise determine whether the failure is retryable
+			return fmt.Errorf("probe %w: %w: %v", errors.WithElement(probe.Name), errors.ErrHealthCheckFailed, err)
+		}
+	}
+	return nil
+}
+
+func (m *manager) runProbe(ctx context.Context, probe v1beta1.HealthProbe) error {
+	timeout, err := parseDuration(probe.Timeout, DefaultProbeTimeout)
+	if err != nil {
+		return fmt.Errorf("timeout: %w", err)
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	probeType, err := probe.Type()
+	if err != nil {
+		return err
+	}
+
+	switch probeType {
+	case v1beta1.HealthProbeTypeHttp:
+		httpProbe, err := probe.AsHealthProbeHttp()
+		if err != nil {
+			return err
+		}
+		return checkHTTP(ctx, httpProbe)
+	case v1beta1.HealthProbeTypeTcp:
+		tcpProbe, err := probe.AsHealthProbeTcp()
+		if err != nil {
+			return err
+		}
+		return checkTCP(ctx, tcpProbe)
+	case v1beta1.HealthProbeTypeExec:
+		execProbe, err := probe.AsHealthProbeExec()
+		if err != nil {
+			return err
+		}
+		return checkExec(ctx, m.exec, execProbe)
+	case v1beta1.HealthProbeTypeSystemdUnit:
+		systemdProbe, err := probe.AsHealthProbeSystemdUnit()
+		if err != nil {
+			return err
+		}
+		return checkSystemdUnit(ctx, m.systemd, systemdProbe)
+	case v1beta1.HealthProbeTypeApplication:
+		appProbe, err := probe.AsHealthProbeApplication()
+		if err != nil {
+			return err
+		}
+		return checkApplication(ctx, m.apps, appProbe)
+	default:
+		return fmt.Errorf("unsupported health probe type: %s", probeType)
+	}
+}
+
+func parseDuration(d *string, defaultDuration time.Duration) (time.Duration, error) {
+	if d == nil {
+		return defaultDuration, nil
+	}
+	return time.ParseDuration(*d)
+}
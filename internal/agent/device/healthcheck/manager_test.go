@@ -0,0 +1,239 @@
+package healthcheck
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/client"
+	"github.com/flightctl/flightctl/internal/agent/device/errors"
+	"github.com/flightctl/flightctl/internal/agent/device/status"
+	"github.com/flightctl/flightctl/pkg/executer"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func newTestProbe(t *testing.T, name string, fromFn func(*v1beta1.HealthProbe) error) v1beta1.HealthProbe {
+	probe := v1beta1.HealthProbe{Name: name}
+	require.NoError(t, fromFn(&probe))
+	return probe
+}
+
+func newTestDevice(version string, healthChecks *v1beta1.DeviceHealthChecks) *v1beta1.Device {
+	return &v1beta1.Device{
+		Metadata: v1beta1.ObjectMeta{
+			Annotations: &map[string]string{v1beta1.DeviceAnnotationRenderedVersion: version},
+		},
+		Spec: &v1beta1.DeviceSpec{HealthChecks: healthChecks},
+	}
+}
+
+func TestCheck(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	mockExec := executer.NewMockExecuter(ctrl)
+
+	now := time.Now()
+	m := &manager{
+		exec: mockExec,
+		log:  log.NewPrefixLogger("test"),
+		now:  func() time.Time { return now },
+	}
+	probe := newTestProbe(t, "ready", func(p *v1beta1.HealthProbe) error {
+		return p.FromHealthProbeExec(v1beta1.HealthProbeExec{Exec: "test -f /run/ready"})
+	})
+	desired := newTestDevice("2", &v1beta1.DeviceHealthChecks{
+		Probes:           []v1beta1.HealthProbe{probe},
+		GracePeriod:      lo.ToPtr("1m"),
+		Interval:         lo.ToPtr("10s"),
+		FailureThreshold: lo.ToPtr(int32(2)),
+	})
+	failing := func() {
+		mockExec.EXPECT().ExecuteWithContext(gomock.Any(), "/bin/sh", "-c", "test -f /run/ready").Return("", "", 1)
+	}
+
+	t.Run("no health checks", func(t *testing.T) {
+		healthy, err := m.Check(ctx, newTestDevice("1", nil))
+		require.NoError(t, err)
+		require.True(t, healthy)
+	})
+
+	t.Run("failures within the grace period are pending", func(t *testing.T) {
+		failing()
+		healthy, err := m.Check(ctx, desired)
+		require.NoError(t, err)
+		require.False(t, healthy)
+
+		// not evaluated again before the interval has passed
+		now = now.Add(5 * time.Second)
+		healthy, err = m.Check(ctx, desired)
+		require.NoError(t, err)
+		require.False(t, healthy)
+		require.Equal(t, 0, m.failures)
+	})
+
+	t.Run("fails once the failure threshold is reached", func(t *testing.T) {
+		now = now.Add(time.Minute)
+		failing()
+		healthy, err := m.Check(ctx, desired)
+		require.NoError(t, err)
+		require.False(t, healthy)
+		require.Equal(t, 1, m.failures)
+
+		now = now.Add(10 * time.Second)
+		failing()
+		_, err = m.Check(ctx, desired)
+		require.ErrorIs(t, err, errors.ErrComponentHealthChecks)
+		require.ErrorIs(t, err, errors.ErrHealthCheckFailed)
+		require.Equal(t, "ready", errors.GetElement(err))
+		require.False(t, errors.IsRetryable(err))
+	})
+
+	t.Run("passes once the probes pass", func(t *testing.T) {
+		now = now.Add(10 * time.Second)
+		mockExec.EXPECT().ExecuteWithContext(gomock.Any(), "/bin/sh", "-c", "test -f /run/ready").Return("", "", 0)
+		healthy, err := m.Check(ctx, desired)
+		require.NoError(t, err)
+		require.True(t, healthy)
+	})
+}
+
+func TestProbes(t *testing.T) {
+	healthyServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer healthyServer.Close()
+	unhealthyServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer unhealthyServer.Close()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			_ = conn.Close()
+		}
+	}()
+	closed, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	closedAddr := closed.Addr().String()
+	require.NoError(t, closed.Close())
+
+	tests := []struct {
+		name        string
+		fromFn      func(*v1beta1.HealthProbe) error
+		setupMocks  func(*executer.MockExecuter, *status.MockExporter)
+		expectError bool
+	}{
+		{
+			name: "http healthy",
+			fromFn: func(p *v1beta1.HealthProbe) error {
+				return p.FromHealthProbeHttp(v1beta1.HealthProbeHttp{Http: healthyServer.URL})
+			},
+		},
+		{
+			name: "http unhealthy",
+			fromFn: func(p *v1beta1.HealthProbe) error {
+				return p.FromHealthProbeHttp(v1beta1.HealthProbeHttp{Http: unhealthyServer.URL})
+			},
+			expectError: true,
+		},
+		{
+			name: "tcp open",
+			fromFn: func(p *v1beta1.HealthProbe) error {
+				return p.FromHealthProbeTcp(v1beta1.HealthProbeTcp{Tcp: listener.Addr().String()})
+			},
+		},
+		{
+			name: "tcp closed",
+			fromFn: func(p *v1beta1.HealthProbe) error {
+				return p.FromHealthProbeTcp(v1beta1.HealthProbeTcp{Tcp: closedAddr})
+			},
+			expectError: true,
+		},
+		{
+			name: "systemd unit active",
+			fromFn: func(p *v1beta1.HealthProbe) error {
+				return p.FromHealthProbeSystemdUnit(v1beta1.HealthProbeSystemdUnit{SystemdUnit: "nginx.service"})
+			},
+			setupMocks: func(mockExec *executer.MockExecuter, _ *status.MockExporter) {
+				mockExec.EXPECT().ExecuteWithContext(gomock.Any(), "/usr/bin/systemctl", "is-active", "nginx.service").Return("active\n", "", 0)
+			},
+		},
+		{
+			name: "systemd unit inactive",
+			fromFn: func(p *v1beta1.HealthProbe) error {
+				return p.FromHealthProbeSystemdUnit(v1beta1.HealthProbeSystemdUnit{SystemdUnit: "nginx.service"})
+			},
+			setupMocks: func(mockExec *executer.MockExecuter, _ *status.MockExporter) {
+				mockExec.EXPECT().ExecuteWithContext(gomock.Any(), "/usr/bin/systemctl", "is-active", "nginx.service").Return("inactive\n", "", 3)
+			},
+			expectError: true,
+		},
+		{
+			name: "application running and ready",
+			fromFn: func(p *v1beta1.HealthProbe) error {
+				return p.FromHealthProbeApplication(v1beta1.HealthProbeApplication{Application: "my-app"})
+			},
+			setupMocks: func(_ *executer.MockExecuter, mockApps *status.MockExporter) {
+				mockApps.EXPECT().Status(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, s *v1beta1.DeviceStatus, _ ...status.CollectorOpt) error {
+					s.Applications = []v1beta1.DeviceApplicationStatus{{Name: "my-app", Status: v1beta1.ApplicationStatusRunning, Ready: "2/2"}}
+					return nil
+				})
+			},
+		},
+		{
+			name: "application running but not ready",
+			fromFn: func(p *v1beta1.HealthProbe) error {
+				return p.FromHealthProbeApplication(v1beta1.HealthProbeApplication{Application: "my-app"})
+			},
+			setupMocks: func(_ *executer.MockExecuter, mockApps *status.MockExporter) {
+				mockApps.EXPECT().Status(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, s *v1beta1.DeviceStatus, _ ...status.CollectorOpt) error {
+					s.Applications = []v1beta1.DeviceApplicationStatus{{Name: "my-app", Status: v1beta1.ApplicationStatusRunning, Ready: "1/2"}}
+					return nil
+				})
+			},
+			expectError: true,
+		},
+		{
+			name: "application not found",
+			fromFn: func(p *v1beta1.HealthProbe) error {
+				return p.FromHealthProbeApplication(v1beta1.HealthProbeApplication{Application: "my-app"})
+			},
+			setupMocks: func(_ *executer.MockExecuter, mockApps *status.MockExporter) {
+				mockApps.EXPECT().Status(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			expectError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockExec := executer.NewMockExecuter(ctrl)
+			mockApps := status.NewMockExporter(ctrl)
+			if tt.setupMocks != nil {
+				tt.setupMocks(mockExec, mockApps)
+			}
+			m := NewManager(mockExec, client.NewSystemd(mockExec, v1beta1.RootUsername), mockApps, log.NewPrefixLogger("test")).(*manager)
+
+			err := m.runProbe(context.Background(), newTestProbe(t, "probe", tt.fromFn))
+			if tt.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package healthcheck
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/client"
+	"github.com/flightctl/flightctl/internal/agent/device/status"
+	"github.com/flightctl/flightctl/pkg/executer"
+	"github.com/samber/lo"
+)
+
+func checkHTTP(ctx context.Context, probe v1beta1.HealthProbeHttp) error {
+	httpClient := &http.Client{
+		Transport: &http.Transport{
+			//nolint:gosec
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: lo.FromPtr(probe.SkipServerVerification)},
+		},
+	}
+	defer httpClient.CloseIdleConnections()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, probe.Http, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("GET %s returned status code %d", probe.Http, resp.StatusCode)
+	}
+	return nil
+}
+
+func checkTCP(ctx context.Context, probe v1beta1.HealthProbeTcp) error {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", probe.Tcp)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+func checkExec(ctx context.Context, exec executer.Executer, probe v1beta1.HealthProbeExec) error {
+	_, stderr, exitCode := exec.ExecuteWithContext(ctx, "/bin/sh", "-c", probe.Exec)
+	if exitCode != 0 {
+		return fmt.Errorf("%q exited with code %d: %s", probe.Exec, exitCode, strings.TrimSpace(stderr))
+	}
+	return nil
+}
+
+func checkSystemdUnit(ctx context.Context, systemd *client.Systemd, probe v1beta1.HealthProbeSystemdUnit) error {
+	if systemd == nil {
+		return fmt.Errorf("systemd unit probes are not supported")
+	}
+	active, err := systemd.IsActive(ctx, probe.SystemdUnit)
+	if err != nil {
+		return err
+	}
+	if !active {
+		return fmt.Errorf("systemd unit %s is not active", probe.SystemdUnit)
+	}
+	return nil
+}
+
+func checkApplication(ctx context.Context, apps status.Exporter, probe v1beta1.HealthProbeApplication) error {
+	if apps == nil {
+		return fmt.Errorf("application probes are not supported")
+	}
+	var deviceStatus v1beta1.DeviceStatus
+	if err := apps.Status(ctx, &deviceStatus); err != nil {
+		return err
+	}
+	for _, app := range deviceStatus.Applications {
+		if app.Name != probe.Application {
+			continue
+		}
+		if !isApplicationHealthy(app) {
+			return fmt.Errorf("application %s is %s with %s workloads ready", app.Name, app.Status, app.Ready)
+		}
+		return nil
+	}
+	return fmt.Errorf("application %s not found", probe.Application)
+}
+
+// isApplicationHealthy reports whether an application has completed, or is running with all of its workloads ready.
+func isApplicationHealthy(app v1beta1.DeviceApplicationStatus) bool {
+	switch app.Status {
+	case v1beta1.ApplicationStatusCompleted:
+		return true
+	case v1beta1.ApplicationStatusRunning:
+		ready, total, found := strings.Cut(app.Ready, "/")
+		return found && ready == total
+	default:
+		return false
+	}
+}
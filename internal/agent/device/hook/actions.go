@@ -2,25 +2,36 @@ package hook
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
+	"io"
+	"maps"
+	"net"
+	"net/http"
 	"os"
 	"os/exec"
 	"reflect"
+	"slices"
 	"strings"
 	"time"
 
 	api "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/client"
+	"github.com/flightctl/flightctl/internal/agent/device/applications"
 	"github.com/flightctl/flightctl/internal/agent/device/config"
 	"github.com/flightctl/flightctl/internal/agent/device/errors"
 	"github.com/flightctl/flightctl/internal/util"
 	"github.com/flightctl/flightctl/pkg/executer"
 	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/samber/lo"
 )
 
 type CommandLineVarKey string
 
 const (
 	DefaultHookActionTimeout = 10 * time.Second
+	// DefaultHookActionWaitInterval is the default interval between attempts of wait actions
+	DefaultHookActionWaitInterval = 1 * time.Second
 
 	// PathKey defines the name of the variable that contains the path operated on
 	PathKey CommandLineVarKey = "Path"
@@ -43,18 +54,27 @@ const (
 )
 
 type actionContext struct {
-	hook            api.DeviceLifecycleHookType
-	systemRebooted  bool
-	createdFiles    map[string]api.FileSpec
-	updatedFiles    map[string]api.FileSpec
-	removedFiles    map[string]api.FileSpec
-	commandLineVars map[CommandLineVarKey]string
+	hook                api.DeviceLifecycleHookType
+	systemRebooted      bool
+	osImageChanged      bool
+	osImage             string
+	changedApplications []string
+	labels              map[string]string
+	systemInfo          map[string]string
+	customInfo          map[string]string
+	createdFiles        map[string]api.FileSpec
+	updatedFiles        map[string]api.FileSpec
+	removedFiles        map[string]api.FileSpec
+	commandLineVars     map[CommandLineVarKey]string
 }
 
-func newActionContext(hook api.DeviceLifecycleHookType, current *api.DeviceSpec, desired *api.DeviceSpec, systemRebooted bool) *actionContext {
+func newActionContext(hook api.DeviceLifecycleHookType, current *api.Device, desired *api.Device, systemRebooted bool) *actionContext {
 	actionContext := &actionContext{
 		hook:            hook,
 		systemRebooted:  systemRebooted,
+		labels:          make(map[string]string),
+		systemInfo:      make(map[string]string),
+		customInfo:      make(map[string]string),
 		createdFiles:    make(map[string]api.FileSpec),
 		updatedFiles:    make(map[string]api.FileSpec),
 		removedFiles:    make(map[string]api.FileSpec),
@@ -62,17 +82,37 @@ func newActionContext(hook api.DeviceLifecycleHookType, current *api.DeviceSpec,
 	}
 	resetCommandLineVars(actionContext)
 	if current != nil || desired != nil {
-		defaultIfNil := func(spec *api.DeviceSpec) *api.DeviceSpec {
-			if spec == nil {
+		defaultIfNil := func(device *api.Device) *api.DeviceSpec {
+			if device == nil || device.Spec == nil {
 				return &api.DeviceSpec{}
 			}
-			return spec
+			return device.Spec
 		}
 		computeFileDiff(actionContext, defaultIfNil(current), defaultIfNil(desired))
+		computeOSDiff(actionContext, defaultIfNil(current), defaultIfNil(desired))
+		computeApplicationDiff(actionContext, defaultIfNil(current), defaultIfNil(desired))
+	}
+	if desired != nil && desired.Metadata.Labels != nil {
+		maps.Copy(actionContext.labels, *desired.Metadata.Labels)
 	}
 	return actionContext
 }
 
+// setSystemInfo makes the device's system information available to hook conditions.
+func (a *actionContext) setSystemInfo(info *api.DeviceSystemInfo) {
+	if info == nil {
+		return
+	}
+	maps.Copy(a.systemInfo, info.AdditionalProperties)
+	a.systemInfo["agentVersion"] = info.AgentVersion
+	a.systemInfo["architecture"] = info.Architecture
+	a.systemInfo["bootID"] = info.BootID
+	a.systemInfo["operatingSystem"] = info.OperatingSystem
+	if info.CustomInfo != nil {
+		maps.Copy(a.customInfo, *info.CustomInfo)
+	}
+}
+
 func resetCommandLineVars(actionCtx *actionContext) {
 	clear(actionCtx.commandLineVars)
 	for _, key := range []CommandLineVarKey{PathKey, FilesKey, CreatedKey, UpdatedKey, RemovedKey, BackupKey} {
@@ -107,7 +147,48 @@ func computeFileDiff(actionCtx *actionContext, current *api.DeviceSpec, desired
 	}
 }
 
-func executeAction(ctx context.Context, exec executer.Executer, log *log.PrefixLogger, action api.HookAction, actionCtx *actionContext, actionTimeout time.Duration) error {
+func computeOSDiff(actionCtx *actionContext, current *api.DeviceSpec, desired *api.DeviceSpec) {
+	var currentImage string
+	if current.Os != nil {
+		currentImage = current.Os.Image
+	}
+	if desired.Os != nil {
+		actionCtx.osImage = desired.Os.Image
+	}
+	actionCtx.osImageChanged = currentImage != actionCtx.osImage
+}
+
+// computeApplicationDiff records the names of the applications that are added, updated or removed by the update.
+func computeApplicationDiff(actionCtx *actionContext, current *api.DeviceSpec, desired *api.DeviceSpec) {
+	toMap := func(spec *api.DeviceSpec) map[string]api.ApplicationProviderSpec {
+		apps := make(map[string]api.ApplicationProviderSpec)
+		for _, app := range lo.FromPtr(spec.Applications) {
+			name, err := app.GetName()
+			if err != nil || name == nil {
+				continue
+			}
+			apps[*name] = app
+		}
+		return apps
+	}
+	currentApps := toMap(current)
+	desiredApps := toMap(desired)
+
+	changed := make(map[string]struct{})
+	for name, app := range desiredApps {
+		if currentApp, ok := currentApps[name]; !ok || !reflect.DeepEqual(app, currentApp) {
+			changed[name] = struct{}{}
+		}
+	}
+	for name := range currentApps {
+		if _, ok := desiredApps[name]; !ok {
+			changed[name] = struct{}{}
+		}
+	}
+	actionCtx.changedApplications = slices.Sorted(maps.Keys(changed))
+}
+
+func (m *manager) executeAction(ctx context.Context, action api.HookAction, actionCtx *actionContext, actionTimeout time.Duration) error {
 	actionType, err := action.Type()
 	if err != nil {
 		return err
@@ -122,7 +203,31 @@ func executeAction(ctx context.Context, exec executer.Executer, log *log.PrefixL
 		if err != nil {
 			return err
 		}
-		return executeRunAction(ctx, exec, log, runAction, actionCtx)
+		return executeRunAction(ctx, m.exec, m.log, runAction, actionCtx)
+	case api.HookActionTypeSystemd:
+		systemdAction, err := action.AsHookActionSystemd()
+		if err != nil {
+			return err
+		}
+		return executeSystemdAction(ctx, m.systemd, m.log, systemdAction, actionCtx)
+	case api.HookActionTypeRestartApplication:
+		restartAction, err := action.AsHookActionRestartApplication()
+		if err != nil {
+			return err
+		}
+		return executeRestartApplicationAction(ctx, m.apps, m.log, restartAction, actionCtx)
+	case api.HookActionTypeWaitForHttp:
+		httpAction, err := action.AsHookActionWaitForHttp()
+		if err != nil {
+			return err
+		}
+		return executeWaitForHTTPAction(ctx, m.log, httpAction, actionCtx)
+	case api.HookActionTypeWaitForTcp:
+		tcpAction, err := action.AsHookActionWaitForTcp()
+		if err != nil {
+			return err
+		}
+		return executeWaitForTCPAction(ctx, m.log, tcpAction, actionCtx)
 	default:
 		return fmt.Errorf("%w: %q", errors.ErrUnknownHookActionType, actionType)
 	}
@@ -167,6 +272,132 @@ func executeRunAction(ctx context.Context, exec executer.Executer, log *log.Pref
 	return nil
 }
 
+func executeSystemdAction(ctx context.Context, systemd *client.Systemd, log *log.PrefixLogger,
+	action api.HookActionSystemd, actionCtx *actionContext) error {
+	if systemd == nil {
+		return fmt.Errorf("systemd actions are not supported")
+	}
+
+	var err error
+	switch action.Operation {
+	case api.HookActionSystemdOperationStart:
+		err = systemd.Start(ctx, action.Systemd)
+	case api.HookActionSystemdOperationStop:
+		err = systemd.Stop(ctx, action.Systemd)
+	case api.HookActionSystemdOperationRestart:
+		err = systemd.Restart(ctx, action.Systemd)
+	case api.HookActionSystemdOperationReload:
+		err = systemd.Reload(ctx, action.Systemd)
+	default:
+		return fmt.Errorf("unsupported systemd operation: %q", action.Operation)
+	}
+	if err != nil {
+		return err
+	}
+	log.Infof("Hook %s executed systemd %s of unit %s without error", actionCtx.hook, action.Operation, action.Systemd)
+	return nil
+}
+
+func executeRestartApplicationAction(ctx context.Context, apps applications.Manager, log *log.PrefixLogger,
+	action api.HookActionRestartApplication, actionCtx *actionContext) error {
+	if apps == nil {
+		return fmt.Errorf("restarting applications is not supported")
+	}
+	if err := apps.Restart(ctx, action.RestartApplication); err != nil {
+		return err
+	}
+	log.Infof("Hook %s restarted application %s without error", actionCtx.hook, action.RestartApplication)
+	return nil
+}
+
+func executeWaitForHTTPAction(ctx context.Context, log *log.PrefixLogger, action api.HookActionWaitForHttp, actionCtx *actionContext) error {
+	interval, err := parseInterval(action.Interval)
+	if err != nil {
+		return err
+	}
+	httpClient := &http.Client{
+		Timeout: interval,
+		Transport: &http.Transport{
+			//nolint:gosec
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: lo.FromPtr(action.SkipServerVerification)},
+		},
+	}
+	defer httpClient.CloseIdleConnections()
+
+	var lastErr error
+	check := func() bool {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, action.WaitForHttp, nil)
+		if err != nil {
+			lastErr = err
+			return false
+		}
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			lastErr = err
+			return false
+		}
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+		if resp.StatusCode < 200 || resp.StatusCode > 299 {
+			lastErr = fmt.Errorf("status code %d", resp.StatusCode)
+			return false
+		}
+		return true
+	}
+	if err := waitFor(ctx, interval, check); err != nil {
+		return fmt.Errorf("%w for %s: %w", errors.ErrHookWaitTimedOut, action.WaitForHttp, lastErr)
+	}
+	log.Infof("Hook %s finished waiting for %s", actionCtx.hook, action.WaitForHttp)
+	return nil
+}
+
+func executeWaitForTCPAction(ctx context.Context, log *log.PrefixLogger, action api.HookActionWaitForTcp, actionCtx *actionContext) error {
+	interval, err := parseInterval(action.Interval)
+	if err != nil {
+		return err
+	}
+	dialer := &net.Dialer{Timeout: interval}
+
+	var lastErr error
+	check := func() bool {
+		conn, err := dialer.DialContext(ctx, "tcp", action.WaitForTcp)
+		if err != nil {
+			lastErr = err
+			return false
+		}
+		_ = conn.Close()
+		return true
+	}
+	if err := waitFor(ctx, interval, check); err != nil {
+		return fmt.Errorf("%w for %s: %w", errors.ErrHookWaitTimedOut, action.WaitForTcp, lastErr)
+	}
+	log.Infof("Hook %s finished waiting for %s", actionCtx.hook, action.WaitForTcp)
+	return nil
+}
+
+// waitFor calls check every interval until it succeeds or the context is done.
+func waitFor(ctx context.Context, interval time.Duration, check func() bool) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if check() {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func parseInterval(interval *string) (time.Duration, error) {
+	if interval == nil {
+		return DefaultHookActionWaitInterval, nil
+	}
+	return time.ParseDuration(*interval)
+}
+
 func dirExists(path string) (bool, error) {
 	info, err := os.Stat(path)
 	if err == nil {
@@ -331,6 +562,9 @@ func checkActionDependency(action api.HookAction) error {
 			return err
 		}
 		return checkRunActionDependency(runAction)
+	case api.HookActionTypeSystemd, api.HookActionTypeRestartApplication, api.HookActionTypeWaitForHttp, api.HookActionTypeWaitForTcp:
+		// executed by the agent itself, so there are no external dependencies
+		return nil
 	default:
 		return fmt.Errorf("%w: %q", errors.ErrUnknownHookActionType, actionType)
 	}
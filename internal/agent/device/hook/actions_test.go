@@ -1,14 +1,28 @@
 package hook
 
 import (
+	"context"
+	"maps"
+	"net"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"os/exec"
+	"slices"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/client"
+	"github.com/flightctl/flightctl/internal/agent/device/applications"
 	"github.com/flightctl/flightctl/internal/agent/device/errors"
 	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/flightctl/flightctl/pkg/executer"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
 )
 
 func TestSplitCommandAndArgs(t *testing.T) {
@@ -278,3 +292,150 @@ func BenchmarkReplaceTokensOptimized(b *testing.B) {
 		replaceTokens(testString, testTokens)
 	}
 }
+
+func newTestAction(t *testing.T, fromFn func(*v1beta1.HookAction) error) v1beta1.HookAction {
+	var action v1beta1.HookAction
+	require.NoError(t, fromFn(&action))
+	return action
+}
+
+func TestSystemdAction(t *testing.T) {
+	tests := []struct {
+		name         string
+		operation    v1beta1.HookActionSystemdOperation
+		exitCode     int
+		expectedArgs []string
+		expectError  bool
+	}{
+		{
+			name:         "restart",
+			operation:    v1beta1.HookActionSystemdOperationRestart,
+			expectedArgs: []string{"restart", "nginx.service"},
+		},
+		{
+			name:         "reload",
+			operation:    v1beta1.HookActionSystemdOperationReload,
+			expectedArgs: []string{"reload", "nginx.service"},
+		},
+		{
+			name:         "failed start",
+			operation:    v1beta1.HookActionSystemdOperationStart,
+			exitCode:     1,
+			expectedArgs: []string{"start", "nginx.service"},
+			expectError:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockExecuter := executer.NewMockExecuter(ctrl)
+			mockExecuter.EXPECT().ExecuteWithContext(gomock.Any(), "/usr/bin/systemctl", tt.expectedArgs).Return("", "failed", tt.exitCode)
+
+			m := &manager{
+				log:     log.NewPrefixLogger("test"),
+				exec:    mockExecuter,
+				systemd: client.NewSystemd(mockExecuter, v1beta1.RootUsername),
+			}
+			action := newTestAction(t, func(a *v1beta1.HookAction) error {
+				return a.FromHookActionSystemd(v1beta1.HookActionSystemd{Systemd: "nginx.service", Operation: tt.operation})
+			})
+			err := m.executeAction(context.Background(), action, newActionContext(v1beta1.DeviceLifecycleHookAfterUpdating, nil, nil, false), time.Second)
+			if tt.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestRestartApplicationAction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockApps := applications.NewMockManager(ctrl)
+	mockApps.EXPECT().Restart(gomock.Any(), "my-app").Return(nil)
+
+	m := &manager{log: log.NewPrefixLogger("test"), apps: mockApps}
+	action := newTestAction(t, func(a *v1beta1.HookAction) error {
+		return a.FromHookActionRestartApplication(v1beta1.HookActionRestartApplication{RestartApplication: "my-app"})
+	})
+	require.NoError(t, m.executeAction(context.Background(), action, newActionContext(v1beta1.DeviceLifecycleHookAfterUpdating, nil, nil, false), time.Second))
+}
+
+func TestWaitForHTTPAction(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// become healthy on the third request
+		if requests.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	m := &manager{log: log.NewPrefixLogger("test")}
+	actionCtx := newActionContext(v1beta1.DeviceLifecycleHookAfterUpdating, nil, nil, false)
+
+	t.Run("succeeds once the endpoint is healthy", func(t *testing.T) {
+		action := newTestAction(t, func(a *v1beta1.HookAction) error {
+			return a.FromHookActionWaitForHttp(v1beta1.HookActionWaitForHttp{WaitForHttp: server.URL, Interval: lo.ToPtr("1s")})
+		})
+		require.NoError(t, m.executeAction(context.Background(), action, actionCtx, 10*time.Second))
+		require.Equal(t, int32(3), requests.Load())
+	})
+
+	t.Run("times out if the endpoint is not healthy", func(t *testing.T) {
+		notFound := httptest.NewServer(http.NotFoundHandler())
+		defer notFound.Close()
+		action := newTestAction(t, func(a *v1beta1.HookAction) error {
+			return a.FromHookActionWaitForHttp(v1beta1.HookActionWaitForHttp{WaitForHttp: notFound.URL, Interval: lo.ToPtr("1s")})
+		})
+		err := m.executeAction(context.Background(), action, actionCtx, 1500*time.Millisecond)
+		require.ErrorIs(t, err, errors.ErrHookWaitTimedOut)
+		require.ErrorContains(t, err, "status code 404")
+	})
+}
+
+func TestWaitForTCPAction(t *testing.T) {
+	m := &manager{log: log.NewPrefixLogger("test")}
+	actionCtx := newActionContext(v1beta1.DeviceLifecycleHookAfterUpdating, nil, nil, false)
+
+	t.Run("succeeds if the port accepts connections", func(t *testing.T) {
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		defer l.Close()
+		go func() {
+			for {
+				conn, err := l.Accept()
+				if err != nil {
+					return
+				}
+				_ = conn.Close()
+			}
+		}()
+
+		action := newTestAction(t, func(a *v1beta1.HookAction) error {
+			return a.FromHookActionWaitForTcp(v1beta1.HookActionWaitForTcp{WaitForTcp: l.Addr().String()})
+		})
+		require.NoError(t, m.executeAction(context.Background(), action, actionCtx, 5*time.Second))
+	})
+
+	t.Run("times out if the port is closed", func(t *testing.T) {
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		addr := l.Addr().String()
+		require.NoError(t, l.Close())
+
+		action := newTestAction(t, func(a *v1beta1.HookAction) error {
+			return a.FromHookActionWaitForTcp(v1beta1.HookActionWaitForTcp{WaitForTcp: addr})
+		})
+		err = m.executeAction(context.Background(), action, actionCtx, 1500*time.Millisecond)
+		require.ErrorIs(t, err, errors.ErrHookWaitTimedOut)
+	})
+}
+
+func TestExpressionVariables(t *testing.T) {
+	// the service validates expression conditions against the variables the agent provides
+	vars := expressionVars(&actionContext{})
+	require.ElementsMatch(t, v1beta1.HookConditionExpressionVariables, slices.Collect(maps.Keys(vars)))
+}
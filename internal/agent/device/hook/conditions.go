@@ -2,13 +2,13 @@ package hook
 
 import (
 	"fmt"
-	"regexp"
+	"maps"
 	"slices"
-	"strconv"
 	"strings"
 
 	"github.com/flightctl/flightctl/api/core/v1beta1"
 	"github.com/flightctl/flightctl/internal/agent/device/errors"
+	"github.com/flightctl/flightctl/internal/util/expression"
 )
 
 func checkCondition(cond *v1beta1.HookCondition, actionContext *actionContext) (bool, error) {
@@ -27,7 +27,7 @@ func checkCondition(cond *v1beta1.HookCondition, actionContext *actionContext) (
 		if err != nil {
 			return false, err
 		}
-		return checkExpressionCondition(expression, actionContext), nil
+		return checkExpressionCondition(expression, actionContext)
 	case v1beta1.HookConditionTypePathOp:
 		pathOp, err := (*cond).AsHookConditionPathOp()
 		if err != nil {
@@ -39,41 +39,71 @@ func checkCondition(cond *v1beta1.HookCondition, actionContext *actionContext) (
 	}
 }
 
+// Variables available to expression conditions
 const (
-	hookExpressionConditionFmt string = `(\w+)\s*(==)\s*(\w+)`
+	// RebootedVar is true if the system rebooted during the update
+	RebootedVar = "rebooted"
+	// OSImageChangedVar is true if the update changes the OS image
+	OSImageChangedVar = "osImageChanged"
+	// OSImageVar is the OS image the device is updated to
+	OSImageVar = "osImage"
+	// ApplicationsChangedVar is the list of names of applications added, updated or removed during the update
+	ApplicationsChangedVar = "applicationsChanged"
+	// LabelsVar is the map of the device's labels
+	LabelsVar = "labels"
+	// SystemInfoVar is the map of the system information reported by the device
+	SystemInfoVar = "systemInfo"
+	// CustomInfoVar is the map of the custom system information reported by the device
+	CustomInfoVar = "customInfo"
+	// FilesChangedVar is the list of files created, updated or removed during the update
+	FilesChangedVar = "filesChanged"
+	// FilesCreatedVar is the list of files created during the update
+	FilesCreatedVar = "filesCreated"
+	// FilesUpdatedVar is the list of files updated during the update
+	FilesUpdatedVar = "filesUpdated"
+	// FilesRemovedVar is the list of files removed during the update
+	FilesRemovedVar = "filesRemoved"
 )
 
-var hookExpressionConditionRegex = regexp.MustCompile(hookExpressionConditionFmt)
+func checkExpressionCondition(cond v1beta1.HookConditionExpression, actionCtx *actionContext) (bool, error) {
+	return expression.Evaluate(cond, expressionVars(actionCtx))
+}
 
-func checkExpressionCondition(cond v1beta1.HookConditionExpression, actionCtx *actionContext) bool {
-	match := hookExpressionConditionRegex.FindStringSubmatch(cond)
-	if match == nil {
-		return false
-	}
-	lhs, op, rhs := match[1], match[2], match[3]
-	switch lhs {
-	case "rebooted":
-		b, err := strconv.ParseBool(rhs)
-		if err != nil {
-			return false
-		}
-		return checkOp(actionCtx.systemRebooted, op, b)
-	default:
-		return false
+// expressionVars returns the variables expression conditions can refer to.
+func expressionVars(actionCtx *actionContext) map[string]any {
+	created := slices.Sorted(maps.Keys(actionCtx.createdFiles))
+	updated := slices.Sorted(maps.Keys(actionCtx.updatedFiles))
+	removed := slices.Sorted(maps.Keys(actionCtx.removedFiles))
+	changed := slices.Sorted(slices.Values(slices.Concat(created, updated, removed)))
+	return map[string]any{
+		RebootedVar:            actionCtx.systemRebooted,
+		OSImageChangedVar:      actionCtx.osImageChanged,
+		OSImageVar:             actionCtx.osImage,
+		ApplicationsChangedVar: toExpressionList(actionCtx.changedApplications),
+		LabelsVar:              toExpressionMap(actionCtx.labels),
+		SystemInfoVar:          toExpressionMap(actionCtx.systemInfo),
+		CustomInfoVar:          toExpressionMap(actionCtx.customInfo),
+		FilesChangedVar:        toExpressionList(changed),
+		FilesCreatedVar:        toExpressionList(created),
+		FilesUpdatedVar:        toExpressionList(updated),
+		FilesRemovedVar:        toExpressionList(removed),
 	}
 }
 
-func checkOp[T comparable](lhs T, op string, rhs T) bool {
-	switch op {
-	case "==":
-		return checkEquals(lhs, rhs)
-	default:
-		return false
+func toExpressionList(items []string) []any {
+	list := make([]any, 0, len(items))
+	for _, item := range items {
+		list = append(list, item)
 	}
+	return list
 }
 
-func checkEquals[T comparable](a, b T) bool {
-	return a == b
+func toExpressionMap(m map[string]string) map[string]any {
+	ret := make(map[string]any, len(m))
+	for k, v := range m {
+		ret[k] = v
+	}
+	return ret
 }
 
 func checkPathOpCondition(cond v1beta1.HookConditionPathOp, actionCtx *actionContext) bool {
@@ -194,3 +224,24 @@ func appendFiles(actionCtx *actionContext, key CommandLineVarKey, files ...strin
 		actionCtx.commandLineVars[key] = strings.Join(files, " ")
 	}
 }
+
+// validateExpressionConditions verifies that the expression conditions of the action can be parsed.
+func validateExpressionConditions(action v1beta1.HookAction, path string) []error {
+	if action.If == nil {
+		return nil
+	}
+	allErrs := []error{}
+	for i, cond := range *action.If {
+		if t, err := cond.Type(); err != nil || t != v1beta1.HookConditionTypeExpression {
+			continue
+		}
+		expr, err := cond.AsHookConditionExpression()
+		if err != nil {
+			continue
+		}
+		if _, err := expression.Parse(expr); err != nil {
+			allErrs = append(allErrs, fmt.Errorf("%s.if[%d]: invalid expression %q: %w", path, i, expr, err))
+		}
+	}
+	return allErrs
+}
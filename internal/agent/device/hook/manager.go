@@ -9,8 +9,11 @@ import (
 	"strings"
 
 	api "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/client"
+	"github.com/flightctl/flightctl/internal/agent/device/applications"
 	"github.com/flightctl/flightctl/internal/agent/device/errors"
 	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/flightctl/flightctl/internal/agent/device/status"
 	"github.com/flightctl/flightctl/pkg/executer"
 	"github.com/flightctl/flightctl/pkg/log"
 	"sigs.k8s.io/yaml"
@@ -31,23 +34,39 @@ var _ Manager = (*manager)(nil)
 type Manager interface {
 	Sync(current, desired *api.DeviceSpec) error
 
-	OnBeforeUpdating(ctx context.Context, current *api.DeviceSpec, desired *api.DeviceSpec) error
-	OnAfterUpdating(ctx context.Context, current *api.DeviceSpec, desired *api.DeviceSpec, systemRebooted bool) error
+	OnBeforeUpdating(ctx context.Context, current *api.Device, desired *api.Device) error
+	OnAfterUpdating(ctx context.Context, current *api.Device, desired *api.Device, systemRebooted bool) error
 	OnBeforeRebooting(ctx context.Context) error
 	OnAfterRebooting(ctx context.Context) error
 }
 
 type manager struct {
-	log    *log.PrefixLogger
-	reader fileio.Reader
-	exec   executer.Executer
+	log        *log.PrefixLogger
+	reader     fileio.Reader
+	exec       executer.Executer
+	systemInfo status.Exporter
+	systemd    *client.Systemd
+	apps       applications.Manager
 }
 
-func NewManager(reader fileio.Reader, exec executer.Executer, log *log.PrefixLogger) Manager {
+// NewManager creates a new hook manager. The system info exporter provides the system information that hook
+// conditions can refer to, the systemd client and application manager are used by systemd and application
+// restart actions. Any of them may be nil, in which case the corresponding feature is not available.
+func NewManager(
+	reader fileio.Reader,
+	exec executer.Executer,
+	systemInfo status.Exporter,
+	systemd *client.Systemd,
+	apps applications.Manager,
+	log *log.PrefixLogger,
+) Manager {
 	return &manager{
-		log:    log,
-		reader: reader,
-		exec:   exec,
+		log:        log,
+		reader:     reader,
+		exec:       exec,
+		systemInfo: systemInfo,
+		systemd:    systemd,
+		apps:       apps,
 	}
 }
 
@@ -55,13 +74,12 @@ func (m *manager) Sync(currentPtr, desiredPtr *api.DeviceSpec) error {
 	return nil
 }
 
-func (m *manager) OnBeforeUpdating(ctx context.Context, current *api.DeviceSpec, desired *api.DeviceSpec) error {
+func (m *manager) OnBeforeUpdating(ctx context.Context, current *api.Device, desired *api.Device) error {
 	actionCtx := newActionContext(api.DeviceLifecycleHookBeforeUpdating, current, desired, false)
 	return m.loadAndExecuteActions(ctx, actionCtx)
 }
 
-func (m *manager) OnAfterUpdating(ctx context.Context, current *api.DeviceSpec, desired *api.DeviceSpec, systemRebooted bool) error {
-
+func (m *manager) OnAfterUpdating(ctx context.Context, current *api.Device, desired *api.Device, systemRebooted bool) error {
 	actionCtx := newActionContext(api.DeviceLifecycleHookAfterUpdating, current, desired, systemRebooted)
 	return m.loadAndExecuteActions(ctx, actionCtx)
 }
@@ -84,6 +102,13 @@ func (m *manager) loadAndExecuteActions(ctx context.Context, actionCtx *actionCo
 	if err != nil {
 		return err
 	}
+	if m.systemInfo != nil && len(actions) > 0 {
+		deviceStatus := api.NewDeviceStatus()
+		if err := m.systemInfo.Status(ctx, &deviceStatus); err != nil {
+			m.log.Warnf("Failed to get system info for hook conditions: %v", err)
+		}
+		actionCtx.setSystemInfo(&deviceStatus.SystemInfo)
+	}
 	return m.executeActions(ctx, actions, actionCtx)
 }
 
@@ -129,6 +154,7 @@ func (m *manager) loadActions(actionsMap map[string][]api.HookAction, actionFile
 		allErrs := []error{}
 		for i, action := range actions {
 			allErrs = append(allErrs, action.Validate(fmt.Sprintf("validating %q hook action[%d]", f, i))...)
+			allErrs = append(allErrs, validateExpressionConditions(action, fmt.Sprintf("validating %q hook action[%d]", f, i))...)
 		}
 		if len(allErrs) > 0 {
 			return errors.Join(allErrs...)
@@ -166,7 +192,7 @@ func (m *manager) executeActions(ctx context.Context, actions []api.HookAction,
 		if err != nil {
 			return err
 		}
-		if err := executeAction(ctx, m.exec, m.log, action, actionCtx, actionTimeout); err != nil {
+		if err := m.executeAction(ctx, action, actionCtx, actionTimeout); err != nil {
 			return fmt.Errorf("%w: %s hook action #%d: %w", errors.ErrFailedToExecute, actionCtx.hook, i+1, err)
 		}
 	}
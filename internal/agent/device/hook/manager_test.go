@@ -9,6 +9,8 @@ import (
 	"testing"
 
 	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/client"
+	"github.com/flightctl/flightctl/internal/agent/device/applications"
 	"github.com/flightctl/flightctl/internal/agent/device/config"
 	"github.com/flightctl/flightctl/internal/agent/device/fileio"
 	"github.com/flightctl/flightctl/internal/util"
@@ -29,48 +31,48 @@ func TestHookManager(t *testing.T) {
 	testCases := []struct {
 		name             string
 		hooks            map[string]string
-		current          *v1beta1.DeviceSpec
-		desired          *v1beta1.DeviceSpec
+		current          *v1beta1.Device
+		desired          *v1beta1.Device
 		rebooted         bool
 		expectedCommands []command
 	}{
 		{
 			name:             "creating a file outside the default hooks' paths should trigger no action",
 			hooks:            map[string]string{},
-			current:          createDeviceSpec(require, map[string]string{}),
-			desired:          createDeviceSpec(require, map[string]string{"/etc/systemd/user/some.config": "data:,content"}),
+			current:          createDevice(require, map[string]string{}),
+			desired:          createDevice(require, map[string]string{"/etc/systemd/user/some.config": "data:,content"}),
 			rebooted:         false,
 			expectedCommands: []command{},
 		},
 		{
 			name:             "creating a file inside a default hook's path should trigger its default action",
 			hooks:            map[string]string{},
-			current:          createDeviceSpec(require, map[string]string{}),
-			desired:          createDeviceSpec(require, map[string]string{"/etc/systemd/system/some.config": "data:,content"}),
+			current:          createDevice(require, map[string]string{}),
+			desired:          createDevice(require, map[string]string{"/etc/systemd/system/some.config": "data:,content"}),
 			rebooted:         false,
 			expectedCommands: []command{{"systemctl", []string{"daemon-reload"}}},
 		},
 		{
 			name:             "creating a file whose path is being watched should trigger the action once",
 			hooks:            map[string]string{"/etc/flightctl/hooks.d/afterupdating/01-test.yaml": testHookPathToFile},
-			current:          createDeviceSpec(require, map[string]string{}),
-			desired:          createDeviceSpec(require, map[string]string{"/etc/someservice/some.config": "data:,content"}),
+			current:          createDevice(require, map[string]string{}),
+			desired:          createDevice(require, map[string]string{"/etc/someservice/some.config": "data:,content"}),
 			rebooted:         false,
 			expectedCommands: []command{{"systemctl", []string{"restart", "someservice"}}},
 		},
 		{
 			name:             "creating a file whose parent directory's path is being watched should trigger the action once",
 			hooks:            map[string]string{"/etc/flightctl/hooks.d/afterupdating/01-test.yaml": testHookPathToDir},
-			current:          createDeviceSpec(require, map[string]string{}),
-			desired:          createDeviceSpec(require, map[string]string{"/etc/someservice/some.config": "data:,content"}),
+			current:          createDevice(require, map[string]string{}),
+			desired:          createDevice(require, map[string]string{"/etc/someservice/some.config": "data:,content"}),
 			rebooted:         false,
 			expectedCommands: []command{{"systemctl", []string{"restart", "someservice"}}},
 		},
 		{
 			name:    "creating multiple files whose parent directory's path is being watched should trigger the action once",
 			hooks:   map[string]string{"/etc/flightctl/hooks.d/afterupdating/01-test.yaml": testHookPathToDir},
-			current: createDeviceSpec(require, map[string]string{}),
-			desired: createDeviceSpec(require, map[string]string{
+			current: createDevice(require, map[string]string{}),
+			desired: createDevice(require, map[string]string{
 				"/etc/someservice/some.config":      "data:,content",
 				"/etc/someservice/someother.config": "data:,content",
 			}),
@@ -80,19 +82,35 @@ func TestHookManager(t *testing.T) {
 		{
 			name:             "actions with rebooted condition should run if the system rebooted during the update",
 			hooks:            map[string]string{"/etc/flightctl/hooks.d/afterupdating/01-test.yaml": testHookRebootedCondition},
-			current:          createDeviceSpec(require, map[string]string{}),
-			desired:          createDeviceSpec(require, map[string]string{"/etc/someservice/some.config": "data:,content"}),
+			current:          createDevice(require, map[string]string{}),
+			desired:          createDevice(require, map[string]string{"/etc/someservice/some.config": "data:,content"}),
 			rebooted:         true,
 			expectedCommands: []command{{"echo", []string{"System was rebooted."}}},
 		},
 		{
 			name:             "actions with rebooted condition should run if the system rebooted during the update",
 			hooks:            map[string]string{"/etc/flightctl/hooks.d/afterupdating/01-test.yaml": testHookRebootedCondition},
-			current:          createDeviceSpec(require, map[string]string{}),
-			desired:          createDeviceSpec(require, map[string]string{"/etc/someservice/some.config": "data:,content"}),
+			current:          createDevice(require, map[string]string{}),
+			desired:          createDevice(require, map[string]string{"/etc/someservice/some.config": "data:,content"}),
 			rebooted:         false,
 			expectedCommands: []command{{"echo", []string{"System was not rebooted."}}},
 		},
+		{
+			name:             "actions with expression conditions on labels and changed files should run if the conditions are met",
+			hooks:            map[string]string{"/etc/flightctl/hooks.d/afterupdating/01-test.yaml": testHookLabelsCondition},
+			current:          createDevice(require, map[string]string{}),
+			desired:          withLabels(createDevice(require, map[string]string{"/etc/someservice/some.config": "data:,content"}), map[string]string{"region": "eu"}),
+			rebooted:         false,
+			expectedCommands: []command{{"echo", []string{"Configuration changed in eu."}}},
+		},
+		{
+			name:             "actions with expression conditions on labels should not run if the labels do not match",
+			hooks:            map[string]string{"/etc/flightctl/hooks.d/afterupdating/01-test.yaml": testHookLabelsCondition},
+			current:          createDevice(require, map[string]string{}),
+			desired:          withLabels(createDevice(require, map[string]string{"/etc/someservice/some.config": "data:,content"}), map[string]string{"region": "us"}),
+			rebooted:         false,
+			expectedCommands: []command{},
+		},
 	}
 
 	for i := range testCases {
@@ -105,7 +123,7 @@ func TestHookManager(t *testing.T) {
 			mockExecuter := executer.NewMockExecuter(ctrl)
 			logger := log.NewPrefixLogger("test")
 			logger.SetLevel(logrus.DebugLevel)
-			hookManager := NewManager(readWriter, mockExecuter, logger)
+			hookManager := NewManager(readWriter, mockExecuter, nil, nil, nil, logger)
 			expectExecCalls(mockExecuter, tc.expectedCommands)
 
 			ctx, cancel := context.WithCancel(context.TODO())
@@ -115,6 +133,24 @@ func TestHookManager(t *testing.T) {
 	}
 }
 
+func TestHookManagerBuiltinActions(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+
+	readWriter := createTempHooksDir(t, map[string]string{"/etc/flightctl/hooks.d/afterupdating/01-test.yaml": testHookBuiltinActions})
+	mockExecuter := executer.NewMockExecuter(ctrl)
+	mockApps := applications.NewMockManager(ctrl)
+	gomock.InOrder(
+		mockExecuter.EXPECT().ExecuteWithContext(gomock.Any(), "/usr/bin/systemctl", []string{"restart", "someservice.service"}).Return("", "", 0),
+		mockApps.EXPECT().Restart(gomock.Any(), "my-app").Return(nil),
+	)
+
+	hookManager := NewManager(readWriter, mockExecuter, nil, client.NewSystemd(mockExecuter, v1beta1.RootUsername), mockApps, log.NewPrefixLogger("test"))
+	current := createDevice(require, map[string]string{})
+	desired := createDevice(require, map[string]string{"/etc/someservice/some.config": "data:,content"})
+	require.NoError(hookManager.OnAfterUpdating(context.Background(), current, desired, false))
+}
+
 const testHookPathToFile = `
 - if:
   - path: /etc/someservice/some.config
@@ -138,6 +174,24 @@ const testHookRebootedCondition = `
   run: echo "System was not rebooted."
 `
 
+const testHookBuiltinActions = `
+- if:
+  - path: /etc/someservice/
+    op: [created]
+  systemd: someservice.service
+  operation: restart
+- if:
+  - path: /etc/someservice/
+    op: [created]
+  restartApplication: my-app
+`
+
+const testHookLabelsCondition = `
+- if:
+  - labels.region == 'eu' && '/etc/someservice/some.config' in filesChanged
+  run: echo "Configuration changed in eu."
+`
+
 func createTempHooksDir(t *testing.T, hooks map[string]string) fileio.ReadWriter {
 	tempDir := t.TempDir()
 	readerWriter := fileio.NewReadWriter(
@@ -162,7 +216,7 @@ func createTempHooksDir(t *testing.T, hooks map[string]string) fileio.ReadWriter
 	return readerWriter
 }
 
-func createDeviceSpec(require *require.Assertions, fileMap map[string]string) *v1beta1.DeviceSpec {
+func createDevice(require *require.Assertions, fileMap map[string]string) *v1beta1.Device {
 	files := []v1beta1.FileSpec{}
 	for path, data := range fileMap {
 		files = append(files, v1beta1.FileSpec{
@@ -174,11 +228,18 @@ func createDeviceSpec(require *require.Assertions, fileMap map[string]string) *v
 	config, err := config.FilesToProviderSpec(files)
 	require.NoError(err)
 
-	return &v1beta1.DeviceSpec{
-		Config: config,
+	return &v1beta1.Device{
+		Spec: &v1beta1.DeviceSpec{
+			Config: config,
+		},
 	}
 }
 
+func withLabels(device *v1beta1.Device, labels map[string]string) *v1beta1.Device {
+	device.Metadata.Labels = &labels
+	return device
+}
+
 func expectExecCalls(mockExecuter *executer.MockExecuter, expectedCommands []command) {
 	if len(expectedCommands) > 0 {
 		calls := make([]any, len(expectedCommands))
@@ -108,7 +108,8 @@ func (m *manager) CollectOCITargets(ctx context.Context, current, desired *v1bet
 		return &dependency.OCICollection{}, nil
 	}
 
-	if m.podmanClient.ImageExists(ctx, osImage) {
+	// with a trust policy the image is pulled again so that its signature is verified before it is staged
+	if desired.TrustPolicy == nil && m.podmanClient.ImageExists(ctx, osImage) {
 		m.log.Debugf("OS image already exists in container storage: %s", osImage)
 		return &dependency.OCICollection{}, nil
 	}
@@ -0,0 +1,200 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/client"
+	"github.com/flightctl/flightctl/pkg/log"
+)
+
+const (
+	DefaultApplicationSyncTimeout = 30 * time.Second
+)
+
+// ApplicationStatsCollector collects the resource usage of the running containers of each application, keyed by
+// application name.
+type ApplicationStatsCollector interface {
+	Stats(ctx context.Context) (map[string][]client.ContainerStats, error)
+}
+
+var _ Monitor[ApplicationUsage] = (*ApplicationMonitor)(nil)
+
+type ApplicationMonitor struct {
+	mu          sync.Mutex
+	alerts      map[v1beta1.ResourceAlertSeverityType]*Alert
+	application string
+	collector   ApplicationStatsCollector
+	numCPU      int
+
+	updateIntervalCh chan time.Duration
+	samplingInterval time.Duration
+
+	log *log.PrefixLogger
+}
+
+func NewApplicationMonitor(
+	log *log.PrefixLogger,
+	collector ApplicationStatsCollector,
+) *ApplicationMonitor {
+	return &ApplicationMonitor{
+		alerts:           make(map[v1beta1.ResourceAlertSeverityType]*Alert),
+		updateIntervalCh: make(chan time.Duration, 1),
+		samplingInterval: DefaultSamplingInterval,
+		collector:        collector,
+		numCPU:           runtime.NumCPU(),
+		log:              log,
+	}
+}
+
+func (m *ApplicationMonitor) Run(ctx context.Context) {
+	defer m.log.Infof("Application monitor stopped")
+	samplingInterval := m.getSamplingInterval()
+	ticker := time.NewTicker(samplingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case newInterval := <-m.updateIntervalCh:
+			ticker.Reset(newInterval)
+		case <-ticker.C:
+			m.log.Debug("Checking application usage")
+			usage := ApplicationUsage{}
+			m.sync(ctx, &usage)
+		}
+	}
+}
+
+func (m *ApplicationMonitor) Update(monitor *v1beta1.ResourceMonitor) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	spec, err := getMonitorSpec(monitor)
+	if err != nil {
+		return false, err
+	}
+
+	updated, err := updateMonitor(m.log, monitor, &m.samplingInterval, m.alerts, m.updateIntervalCh)
+	if err != nil {
+		return updated, err
+	}
+
+	if spec.Application != m.application {
+		m.application = spec.Application
+		updated = true
+	}
+
+	return updated, nil
+}
+
+func (m *ApplicationMonitor) Alerts() []v1beta1.ResourceAlertRule {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var firing []v1beta1.ResourceAlertRule
+	for _, alert := range m.alerts {
+		if alert.IsFiring() {
+			firing = append(firing, alert.ResourceAlertRule)
+		}
+	}
+	return firing
+}
+
+// CollectUsage collects the usage of the monitored applications and reports the application with the highest
+// usage. The CPU usage of an application is relative to all CPUs of the device.
+func (m *ApplicationMonitor) CollectUsage(ctx context.Context, usage *ApplicationUsage) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+		m.mu.Lock()
+		application, collector, numCPU := m.application, m.collector, m.numCPU
+		m.mu.Unlock()
+
+		if collector == nil {
+			return fmt.Errorf("application usage is not available on this device")
+		}
+		stats, err := collector.Stats(ctx)
+		if err != nil {
+			return err
+		}
+
+		for name, containers := range stats {
+			if application != "" && name != application {
+				continue
+			}
+			var cpuPercent, memoryPercent float64
+			for _, c := range containers {
+				cpuPercent += c.CPUPercent
+				memoryPercent += c.MemoryPercent
+			}
+			cpuPercent /= float64(max(numCPU, 1))
+
+			appCPU := int64(math.Round(cpuPercent))
+			appMemory := int64(math.Round(memoryPercent))
+			if usedPercent := max(appCPU, appMemory); usage.Application == "" || usedPercent > usage.UsedPercent {
+				usage.Application = name
+				usage.CPUPercent = appCPU
+				usage.MemoryPercent = appMemory
+				usage.UsedPercent = usedPercent
+			}
+		}
+		usage.lastCollectedAt = time.Now()
+	}
+	return nil
+}
+
+func (m *ApplicationMonitor) sync(ctx context.Context, usage *ApplicationUsage) {
+	if !m.hasAlertRules() {
+		m.log.Debug("Skipping application usage sync: no alert rules")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, DefaultApplicationSyncTimeout)
+	defer cancel()
+
+	if err := m.CollectUsage(ctx, usage); err != nil {
+		m.log.Errorf("Failed to collect application usage: %v", err)
+	}
+
+	m.ensureAlerts(usage)
+}
+
+func (m *ApplicationMonitor) ensureAlerts(usage *ApplicationUsage) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.log.Tracef("Application usage of %s: %d%% (CPU: %d%%, memory: %d%%)", usage.Application, usage.UsedPercent, usage.CPUPercent, usage.MemoryPercent)
+	for _, alert := range m.alerts {
+		alert.Sync(usage.UsedPercent)
+	}
+}
+
+func (m *ApplicationMonitor) hasAlertRules() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.alerts) > 0
+}
+
+func (m *ApplicationMonitor) getSamplingInterval() time.Duration {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.samplingInterval
+}
+
+// ApplicationUsage represents the usage of the monitored application with the highest usage: the higher of its CPU
+// usage relative to all CPUs of the device and its memory usage.
+type ApplicationUsage struct {
+	Application   string
+	CPUPercent    int64
+	MemoryPercent int64
+	UsedPercent   int64
+
+	lastCollectedAt time.Time
+}
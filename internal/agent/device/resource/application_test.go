@@ -0,0 +1,95 @@
+package resource
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/agent/client"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeApplicationStats struct {
+	stats map[string][]client.ContainerStats
+	err   error
+}
+
+func (f *fakeApplicationStats) Stats(context.Context) (map[string][]client.ContainerStats, error) {
+	return f.stats, f.err
+}
+
+func TestApplicationCollectUsage(t *testing.T) {
+	stats := &fakeApplicationStats{
+		stats: map[string][]client.ContainerStats{
+			// two containers using 1.5 of the 2 CPUs of the device
+			"web": {
+				{Name: "web-frontend", CPUPercent: 100, MemoryPercent: 10},
+				{Name: "web-backend", CPUPercent: 50, MemoryPercent: 20},
+			},
+			"db": {
+				{Name: "db", CPUPercent: 10, MemoryPercent: 40},
+			},
+		},
+	}
+
+	tests := []struct {
+		name            string
+		application     string
+		collector       ApplicationStatsCollector
+		wantApplication string
+		wantCPU         int64
+		wantMemory      int64
+		wantErr         bool
+	}{
+		{
+			name:            "highest usage",
+			collector:       stats,
+			wantApplication: "web",
+			wantCPU:         75,
+			wantMemory:      30,
+		},
+		{
+			name:            "selected application",
+			application:     "db",
+			collector:       stats,
+			wantApplication: "db",
+			wantCPU:         5,
+			wantMemory:      40,
+		},
+		{
+			name:        "application not running",
+			application: "cache",
+			collector:   stats,
+		},
+		{
+			name:      "collector error",
+			collector: &fakeApplicationStats{err: errors.New("podman failed")},
+			wantErr:   true,
+		},
+		{
+			name:    "no collector",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			monitor := NewApplicationMonitor(log.NewPrefixLogger("test"), tt.collector)
+			monitor.numCPU = 2
+			monitor.application = tt.application
+
+			usage := ApplicationUsage{}
+			err := monitor.CollectUsage(context.Background(), &usage)
+			if tt.wantErr {
+				require.Error(err)
+				return
+			}
+			require.NoError(err)
+			require.Equal(tt.wantApplication, usage.Application)
+			require.Equal(tt.wantCPU, usage.CPUPercent)
+			require.Equal(tt.wantMemory, usage.MemoryPercent)
+			require.Equal(max(tt.wantCPU, tt.wantMemory), usage.UsedPercent)
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/flightctl/flightctl/api/core/v1beta1"
 	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/samber/lo"
 )
 
 func updateMonitor(
@@ -128,6 +129,56 @@ func getMonitorSpec(monitor *v1beta1.ResourceMonitor) (*MonitorSpec, error) {
 				AlertRules:       spec.AlertRules,
 			},
 		}, nil
+	case InodeMonitorType:
+		spec, err := monitor.AsInodeResourceMonitorSpec()
+		if err != nil {
+			return nil, err
+		}
+		return &MonitorSpec{
+			ResourceMonitorSpec: v1beta1.ResourceMonitorSpec{
+				SamplingInterval: spec.SamplingInterval,
+				AlertRules:       spec.AlertRules,
+			},
+			Path: spec.Path,
+		}, nil
+	case TemperatureMonitorType:
+		spec, err := monitor.AsTemperatureResourceMonitorSpec()
+		if err != nil {
+			return nil, err
+		}
+		return &MonitorSpec{
+			ResourceMonitorSpec: v1beta1.ResourceMonitorSpec{
+				SamplingInterval: spec.SamplingInterval,
+				AlertRules:       spec.AlertRules,
+			},
+			Zone:                lo.FromPtr(spec.Zone),
+			CriticalTemperature: lo.FromPtr(spec.CriticalTemperature),
+		}, nil
+	case NetworkMonitorType:
+		spec, err := monitor.AsNetworkResourceMonitorSpec()
+		if err != nil {
+			return nil, err
+		}
+		return &MonitorSpec{
+			ResourceMonitorSpec: v1beta1.ResourceMonitorSpec{
+				SamplingInterval: spec.SamplingInterval,
+				AlertRules:       spec.AlertRules,
+			},
+			Interface: lo.FromPtr(spec.Interface),
+			Metric:    spec.Metric,
+		}, nil
+	case ApplicationMonitorType:
+		spec, err := monitor.AsApplicationResourceMonitorSpec()
+		if err != nil {
+			return nil, err
+		}
+		return &MonitorSpec{
+			ResourceMonitorSpec: v1beta1.ResourceMonitorSpec{
+				SamplingInterval: spec.SamplingInterval,
+				AlertRules:       spec.AlertRules,
+			},
+			Application: lo.FromPtr(spec.Application),
+		}, nil
 	default:
 		return nil, fmt.Errorf("unknown monitor type: %s", monitorType)
 	}
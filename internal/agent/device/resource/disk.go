@@ -169,6 +169,16 @@ type DiskUsage struct {
 	lastCollectedAt time.Time
 }
 
+// DirUsage returns the usage of the filesystem that holds dir.
+func DirUsage(dir string) (*DiskUsage, error) {
+	usage, err := getDirUsage(dir)
+	if err != nil {
+		return nil, err
+	}
+	usage.UsedPercent = percentageDiskUsed(usage.Free, usage.Total)
+	return usage, nil
+}
+
 func getDirUsage(dir string) (*DiskUsage, error) {
 	var stat syscall.Statfs_t
 	err := syscall.Statfs(dir, &stat)
@@ -0,0 +1,165 @@
+package resource
+
+import (
+	"context"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/pkg/log"
+)
+
+const (
+	DefaultInodeSyncTimeout = 5 * time.Second
+)
+
+var _ Monitor[InodeUsage] = (*InodeMonitor)(nil)
+
+type InodeMonitor struct {
+	mu     sync.Mutex
+	alerts map[v1beta1.ResourceAlertSeverityType]*Alert
+	path   string
+
+	updateIntervalCh chan time.Duration
+	samplingInterval time.Duration
+
+	log *log.PrefixLogger
+}
+
+func NewInodeMonitor(
+	log *log.PrefixLogger,
+) *InodeMonitor {
+	return &InodeMonitor{
+		alerts:           make(map[v1beta1.ResourceAlertSeverityType]*Alert),
+		updateIntervalCh: make(chan time.Duration, 1),
+		samplingInterval: DefaultSamplingInterval,
+		log:              log,
+	}
+}
+
+func (m *InodeMonitor) Run(ctx context.Context) {
+	defer m.log.Infof("Inode monitor stopped")
+	samplingInterval := m.getSamplingInterval()
+	ticker := time.NewTicker(samplingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case newInterval := <-m.updateIntervalCh:
+			ticker.Reset(newInterval)
+		case <-ticker.C:
+			m.log.Debug("Checking inode usage")
+			usage := InodeUsage{}
+			m.sync(ctx, &usage)
+		}
+	}
+}
+
+func (m *InodeMonitor) Update(monitor *v1beta1.ResourceMonitor) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	spec, err := getMonitorSpec(monitor)
+	if err != nil {
+		return false, err
+	}
+
+	updated, err := updateMonitor(m.log, monitor, &m.samplingInterval, m.alerts, m.updateIntervalCh)
+	if err != nil {
+		return updated, err
+	}
+
+	if spec.Path != m.path {
+		m.path = spec.Path
+		updated = true
+	}
+
+	return updated, nil
+}
+
+func (m *InodeMonitor) Alerts() []v1beta1.ResourceAlertRule {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var firing []v1beta1.ResourceAlertRule
+	for _, alert := range m.alerts {
+		if alert.IsFiring() {
+			firing = append(firing, alert.ResourceAlertRule)
+		}
+	}
+	return firing
+}
+
+func (m *InodeMonitor) CollectUsage(ctx context.Context, usage *InodeUsage) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+		var stat syscall.Statfs_t
+		if err := syscall.Statfs(m.getPath(), &stat); err != nil {
+			return err
+		}
+		usage.Total = stat.Files
+		usage.Free = stat.Ffree
+		// filesystems with dynamically allocated inodes such as btrfs report no inodes at all
+		usage.UsedPercent = percentageDiskUsed(usage.Free, usage.Total)
+		usage.lastCollectedAt = time.Now()
+	}
+	return nil
+}
+
+func (m *InodeMonitor) getPath() string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.path
+}
+
+func (m *InodeMonitor) sync(ctx context.Context, usage *InodeUsage) {
+	if !m.hasAlertRules() {
+		m.log.Debug("Skipping inode usage sync: no alert rules")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, DefaultInodeSyncTimeout)
+	defer cancel()
+
+	path := m.getPath()
+	if err := m.CollectUsage(ctx, usage); err != nil {
+		m.log.Errorf("Failed to collect inode usage for path: %s: %v", path, err)
+	}
+
+	m.ensureAlerts(usage.UsedPercent)
+}
+
+func (m *InodeMonitor) ensureAlerts(percentageUsed int64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.log.Tracef("Inode usage: %d%%", percentageUsed)
+	for _, alert := range m.alerts {
+		alert.Sync(percentageUsed)
+	}
+}
+
+func (m *InodeMonitor) hasAlertRules() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.alerts) > 0
+}
+
+func (m *InodeMonitor) getSamplingInterval() time.Duration {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.samplingInterval
+}
+
+// InodeUsage represents the tracked inode usage of the filesystem of a path.
+type InodeUsage struct {
+	Total       uint64
+	Free        uint64
+	UsedPercent int64
+
+	lastCollectedAt time.Time
+}
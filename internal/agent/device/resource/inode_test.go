@@ -0,0 +1,50 @@
+package resource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInodeMonitor(t *testing.T) {
+	require := require.New(t)
+
+	inodeMonitor := NewInodeMonitor(log.NewPrefixLogger("test"))
+	monitorSpec := v1beta1.InodeResourceMonitorSpec{
+		SamplingInterval: "1m",
+		MonitorType:      InodeMonitorType,
+		Path:             t.TempDir(),
+		AlertRules: []v1beta1.ResourceAlertRule{
+			{
+				Severity:    v1beta1.ResourceAlertSeverityTypeCritical,
+				Percentage:  90,
+				Duration:    "10m",
+				Description: "Critical: inode usage is above 90% for 10m",
+			},
+		},
+	}
+	rm := &v1beta1.ResourceMonitor{}
+	require.NoError(rm.FromInodeResourceMonitorSpec(monitorSpec))
+
+	updated, err := inodeMonitor.Update(rm)
+	require.NoError(err)
+	require.True(updated)
+	require.Equal(monitorSpec.Path, inodeMonitor.getPath())
+
+	usage := InodeUsage{}
+	require.NoError(inodeMonitor.CollectUsage(context.Background(), &usage))
+	require.LessOrEqual(usage.Free, usage.Total)
+	require.GreaterOrEqual(usage.UsedPercent, int64(0))
+	require.LessOrEqual(usage.UsedPercent, int64(100))
+
+	// unchanged spec is not an update
+	updated, err = inodeMonitor.Update(rm)
+	require.NoError(err)
+	require.False(updated)
+
+	inodeMonitor.path = "/does/not/exist"
+	require.Error(inodeMonitor.CollectUsage(context.Background(), &InodeUsage{}))
+}
@@ -0,0 +1,300 @@
+package resource
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/pkg/log"
+)
+
+const (
+	DefaultNetworkSyncTimeout = 5 * time.Second
+	DefaultProcNetDevPath     = "/proc/net/dev"
+	DefaultSysClassNetPath    = "/sys/class/net"
+)
+
+var _ Monitor[NetworkUsage] = (*NetworkMonitor)(nil)
+
+type NetworkMonitor struct {
+	mu         sync.Mutex
+	alerts     map[v1beta1.ResourceAlertSeverityType]*Alert
+	iface      string
+	metric     v1beta1.NetworkResourceMonitorMetric
+	netDevPath string
+	sysNetPath string
+	prevUsage  *NetworkUsage
+
+	updateIntervalCh chan time.Duration
+	samplingInterval time.Duration
+
+	log *log.PrefixLogger
+}
+
+func NewNetworkMonitor(
+	log *log.PrefixLogger,
+) *NetworkMonitor {
+	return &NetworkMonitor{
+		alerts:           make(map[v1beta1.ResourceAlertSeverityType]*Alert),
+		updateIntervalCh: make(chan time.Duration, 1),
+		samplingInterval: DefaultSamplingInterval,
+		netDevPath:       DefaultProcNetDevPath,
+		sysNetPath:       DefaultSysClassNetPath,
+		log:              log,
+	}
+}
+
+func (m *NetworkMonitor) Run(ctx context.Context) {
+	defer m.log.Infof("Network monitor stopped")
+	samplingInterval := m.getSamplingInterval()
+	ticker := time.NewTicker(samplingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case newInterval := <-m.updateIntervalCh:
+			ticker.Reset(newInterval)
+		case <-ticker.C:
+			m.log.Debug("Checking network usage")
+			usage := NetworkUsage{}
+			m.sync(ctx, &usage)
+		}
+	}
+}
+
+func (m *NetworkMonitor) Update(monitor *v1beta1.ResourceMonitor) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	spec, err := getMonitorSpec(monitor)
+	if err != nil {
+		return false, err
+	}
+
+	updated, err := updateMonitor(m.log, monitor, &m.samplingInterval, m.alerts, m.updateIntervalCh)
+	if err != nil {
+		return updated, err
+	}
+
+	if spec.Interface != m.iface {
+		m.iface = spec.Interface
+		updated = true
+	}
+
+	if spec.Metric != m.metric {
+		m.metric = spec.Metric
+		updated = true
+	}
+
+	return updated, nil
+}
+
+func (m *NetworkMonitor) Alerts() []v1beta1.ResourceAlertRule {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var firing []v1beta1.ResourceAlertRule
+	for _, alert := range m.alerts {
+		if alert.IsFiring() {
+			firing = append(firing, alert.ResourceAlertRule)
+		}
+	}
+	return firing
+}
+
+// CollectUsage reads the counters and link speed of the monitored network interfaces.
+func (m *NetworkMonitor) CollectUsage(ctx context.Context, usage *NetworkUsage) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+		m.mu.Lock()
+		iface, netDevPath, sysNetPath := m.iface, m.netDevPath, m.sysNetPath
+		m.mu.Unlock()
+
+		file, err := os.ReadFile(netDevPath)
+		if err != nil {
+			return err
+		}
+		counters, err := parseNetDev(file)
+		if err != nil {
+			return err
+		}
+
+		usage.Interfaces = make(map[string]NetworkCounters)
+		for name, c := range counters {
+			if (iface == "" && name == "lo") || (iface != "" && name != iface) {
+				continue
+			}
+			// the speed is unknown (-1) or unreadable for virtual and disconnected interfaces
+			if speed, err := readSysfsString(filepath.Join(sysNetPath, name, "speed")); err == nil {
+				c.SpeedMbps, _ = strconv.ParseInt(speed, 10, 64)
+			}
+			usage.Interfaces[name] = c
+		}
+		if iface != "" && len(usage.Interfaces) == 0 {
+			return fmt.Errorf("network interface %q not found", iface)
+		}
+		usage.lastCollectedAt = time.Now()
+	}
+	return nil
+}
+
+func (m *NetworkMonitor) sync(ctx context.Context, current *NetworkUsage) {
+	if !m.hasAlertRules() {
+		m.log.Debug("Skipping network usage sync: no alert rules")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, DefaultNetworkSyncTimeout)
+	defer cancel()
+
+	if err := m.CollectUsage(ctx, current); err != nil {
+		m.log.Errorf("Failed to collect network usage: %v", err)
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// init on first sync
+	if m.prevUsage == nil {
+		m.prevUsage = current
+		return
+	}
+
+	calculateNetworkUsage(m.prevUsage, current, m.metric)
+	m.prevUsage = current
+
+	m.log.Tracef("Network %s of %s: %d%% (errors and drops: %d%%, throughput: %d%%)",
+		m.metric, current.Interface, current.UsedPercent, current.ErrorPercent, current.ThroughputPercent)
+	for _, alert := range m.alerts {
+		alert.Sync(current.UsedPercent)
+	}
+}
+
+func (m *NetworkMonitor) hasAlertRules() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.alerts) > 0
+}
+
+func (m *NetworkMonitor) getSamplingInterval() time.Duration {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.samplingInterval
+}
+
+// calculateNetworkUsage sets the usage of current to that of the interface with the highest value of metric since prev.
+func calculateNetworkUsage(prev, current *NetworkUsage, metric v1beta1.NetworkResourceMonitorMetric) {
+	elapsed := current.lastCollectedAt.Sub(prev.lastCollectedAt).Seconds()
+	for name, c := range current.Interfaces {
+		p, ok := prev.Interfaces[name]
+		// skip interfaces that just appeared or whose counters were reset
+		if !ok || c.RxPackets < p.RxPackets || c.TxPackets < p.TxPackets || c.faults() < p.faults() ||
+			c.RxBytes < p.RxBytes || c.TxBytes < p.TxBytes {
+			continue
+		}
+
+		var errorPercent, throughputPercent int64
+		faults := c.faults() - p.faults()
+		if packets := c.RxPackets - p.RxPackets + c.TxPackets - p.TxPackets + faults; packets > 0 {
+			errorPercent = int64(math.Round(float64(faults) / float64(packets) * 100))
+		}
+		if c.SpeedMbps > 0 && elapsed > 0 {
+			// links are full duplex, so the busier direction determines the utilization
+			transferred := max(c.RxBytes-p.RxBytes, c.TxBytes-p.TxBytes)
+			bitsPerSecond := float64(transferred) * 8 / elapsed
+			throughputPercent = int64(math.Round(bitsPerSecond / (float64(c.SpeedMbps) * 1e6) * 100))
+		}
+
+		usedPercent := errorPercent
+		if metric == v1beta1.NetworkResourceMonitorMetricThroughput {
+			usedPercent = throughputPercent
+		}
+		if current.Interface == "" || usedPercent > current.UsedPercent {
+			current.Interface = name
+			current.ErrorPercent = errorPercent
+			current.ThroughputPercent = throughputPercent
+			current.UsedPercent = usedPercent
+		}
+	}
+}
+
+// parseNetDev parses the per interface counters of /proc/net/dev.
+func parseNetDev(content []byte) (map[string]NetworkCounters, error) {
+	counters := make(map[string]NetworkCounters)
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		name, stats, ok := strings.Cut(scanner.Text(), ":")
+		if !ok {
+			// header lines
+			continue
+		}
+		fields := strings.Fields(stats)
+		if len(fields) < 16 {
+			return nil, fmt.Errorf("invalid number of fields in network stats: %d", len(fields))
+		}
+		values := make([]uint64, len(fields))
+		for i, field := range fields {
+			v, err := strconv.ParseUint(field, 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			values[i] = v
+		}
+		counters[strings.TrimSpace(name)] = NetworkCounters{
+			RxBytes:   values[0],
+			RxPackets: values[1],
+			RxErrors:  values[2],
+			RxDropped: values[3],
+			TxBytes:   values[8],
+			TxPackets: values[9],
+			TxErrors:  values[10],
+			TxDropped: values[11],
+		}
+	}
+	return counters, scanner.Err()
+}
+
+// NetworkCounters are the cumulative counters and the link speed of a network interface.
+type NetworkCounters struct {
+	RxBytes   uint64
+	RxPackets uint64
+	RxErrors  uint64
+	RxDropped uint64
+	TxBytes   uint64
+	TxPackets uint64
+	TxErrors  uint64
+	TxDropped uint64
+	// SpeedMbps is the link speed in Mbit/s, or zero or negative if unknown.
+	SpeedMbps int64
+}
+
+func (c NetworkCounters) faults() uint64 {
+	return c.RxErrors + c.RxDropped + c.TxErrors + c.TxDropped
+}
+
+// NetworkUsage represents the tracked network usage of this device. The usage is the selected metric of the
+// interface where it is highest since the previous sample: either the share of its packets that were dropped or
+// had errors, or its throughput relative to its link speed.
+type NetworkUsage struct {
+	Interfaces map[string]NetworkCounters
+
+	Interface         string
+	ErrorPercent      int64
+	ThroughputPercent int64
+	UsedPercent       int64
+
+	lastCollectedAt time.Time
+}
@@ -0,0 +1,182 @@
+package resource
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/stretchr/testify/require"
+)
+
+const netDevData = `Inter-|   Receive                                                |  Transmit
+ face |bytes    packets errs drop fifo frame compressed multicast|bytes    packets errs drop fifo colls carrier compressed
+    lo:  104400    1200    0    0    0     0          0         0   104400    1200    0    0    0     0       0          0
+  eth0: 5000000    4000   10   10    0     0          0         0  1000000    1000    0    0    0     0       0          0
+`
+
+func TestParseNetDev(t *testing.T) {
+	require := require.New(t)
+	counters, err := parseNetDev([]byte(netDevData))
+	require.NoError(err)
+	require.Len(counters, 2)
+	require.Equal(NetworkCounters{
+		RxBytes:   5000000,
+		RxPackets: 4000,
+		RxErrors:  10,
+		RxDropped: 10,
+		TxBytes:   1000000,
+		TxPackets: 1000,
+	}, counters["eth0"])
+
+	_, err = parseNetDev([]byte("eth0: 1 2 3\n"))
+	require.Error(err)
+}
+
+func TestCalculateNetworkUsage(t *testing.T) {
+	now := time.Now()
+	prev := &NetworkUsage{
+		Interfaces: map[string]NetworkCounters{
+			"eth0": {RxBytes: 1000, RxPackets: 100, TxBytes: 1000, TxPackets: 100, SpeedMbps: 100},
+			"eth1": {RxBytes: 1000, RxPackets: 100, TxBytes: 1000, TxPackets: 100, SpeedMbps: 1000},
+		},
+		lastCollectedAt: now,
+	}
+
+	tests := []struct {
+		name           string
+		interfaces     map[string]NetworkCounters
+		metric         v1beta1.NetworkResourceMonitorMetric
+		wantInterface  string
+		wantErrors     int64
+		wantThroughput int64
+		wantUsed       int64
+	}{
+		{
+			name: "errors and drops",
+			interfaces: map[string]NetworkCounters{
+				// 100 good and 25 faulty packets
+				"eth0": {RxBytes: 2000, RxPackets: 150, RxErrors: 20, TxBytes: 2000, TxPackets: 150, TxDropped: 5, SpeedMbps: 100},
+				"eth1": {RxBytes: 2000, RxPackets: 150, TxBytes: 2000, TxPackets: 150, SpeedMbps: 1000},
+			},
+			metric:        v1beta1.NetworkResourceMonitorMetricErrors,
+			wantInterface: "eth0",
+			wantErrors:    20,
+			wantUsed:      20,
+		},
+		{
+			name: "throughput relative to link speed",
+			interfaces: map[string]NetworkCounters{
+				"eth0": {RxBytes: 2000, RxPackets: 150, TxBytes: 2000, TxPackets: 150, SpeedMbps: 100},
+				// 75 MB received in 1s on a 1 Gbit/s link
+				"eth1": {RxBytes: 75_001_000, RxPackets: 50100, TxBytes: 2000, TxPackets: 150, SpeedMbps: 1000},
+			},
+			metric:         v1beta1.NetworkResourceMonitorMetricThroughput,
+			wantInterface:  "eth1",
+			wantThroughput: 60,
+			wantUsed:       60,
+		},
+		{
+			name: "errors ignore throughput",
+			interfaces: map[string]NetworkCounters{
+				"eth0": {RxBytes: 2000, RxPackets: 150, RxErrors: 20, TxBytes: 2000, TxPackets: 150, TxDropped: 5, SpeedMbps: 100},
+				"eth1": {RxBytes: 75_001_000, RxPackets: 50100, TxBytes: 2000, TxPackets: 150, SpeedMbps: 1000},
+			},
+			metric:        v1beta1.NetworkResourceMonitorMetricErrors,
+			wantInterface: "eth0",
+			wantErrors:    20,
+			wantUsed:      20,
+		},
+		{
+			name: "throughput ignores errors",
+			interfaces: map[string]NetworkCounters{
+				"eth0": {RxBytes: 2000, RxPackets: 150, RxErrors: 20, TxBytes: 2000, TxPackets: 150, TxDropped: 5, SpeedMbps: 100},
+				"eth1": {RxBytes: 75_001_000, RxPackets: 50100, TxBytes: 2000, TxPackets: 150, SpeedMbps: 1000},
+			},
+			metric:         v1beta1.NetworkResourceMonitorMetricThroughput,
+			wantInterface:  "eth1",
+			wantThroughput: 60,
+			wantUsed:       60,
+		},
+		{
+			name: "counter reset",
+			interfaces: map[string]NetworkCounters{
+				"eth0": {RxBytes: 10, RxPackets: 1, RxErrors: 1, SpeedMbps: 100},
+			},
+			metric: v1beta1.NetworkResourceMonitorMetricErrors,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			current := &NetworkUsage{Interfaces: tt.interfaces, lastCollectedAt: now.Add(time.Second)}
+			calculateNetworkUsage(prev, current, tt.metric)
+			require.Equal(tt.wantInterface, current.Interface)
+			require.Equal(tt.wantErrors, current.ErrorPercent)
+			require.Equal(tt.wantThroughput, current.ThroughputPercent)
+			require.Equal(tt.wantUsed, current.UsedPercent)
+		})
+	}
+}
+
+func TestNetworkCollectUsage(t *testing.T) {
+	require := require.New(t)
+	tmpDir := t.TempDir()
+	netDevPath := filepath.Join(tmpDir, "dev")
+	require.NoError(os.WriteFile(netDevPath, []byte(netDevData), 0600))
+	sysNetPath := filepath.Join(tmpDir, "net")
+	require.NoError(os.MkdirAll(filepath.Join(sysNetPath, "eth0"), 0755))
+	require.NoError(os.WriteFile(filepath.Join(sysNetPath, "eth0", "speed"), []byte("1000\n"), 0600))
+
+	monitor := NewNetworkMonitor(log.NewPrefixLogger("test"))
+	monitor.netDevPath = netDevPath
+	monitor.sysNetPath = sysNetPath
+
+	// loopback is skipped unless selected
+	usage := NetworkUsage{}
+	require.NoError(monitor.CollectUsage(context.Background(), &usage))
+	require.Len(usage.Interfaces, 1)
+	require.Equal(int64(1000), usage.Interfaces["eth0"].SpeedMbps)
+
+	monitor.iface = "lo"
+	usage = NetworkUsage{}
+	require.NoError(monitor.CollectUsage(context.Background(), &usage))
+	require.Len(usage.Interfaces, 1)
+	require.Contains(usage.Interfaces, "lo")
+
+	monitor.iface = "wlan0"
+	require.Error(monitor.CollectUsage(context.Background(), &NetworkUsage{}))
+}
+
+func TestNetworkMonitorUpdateMetric(t *testing.T) {
+	require := require.New(t)
+	monitor := NewNetworkMonitor(log.NewPrefixLogger("test"))
+
+	newMonitor := func(metric v1beta1.NetworkResourceMonitorMetric) *v1beta1.ResourceMonitor {
+		rm := &v1beta1.ResourceMonitor{}
+		require.NoError(rm.FromNetworkResourceMonitorSpec(v1beta1.NetworkResourceMonitorSpec{
+			MonitorType:      NetworkMonitorType,
+			Metric:           metric,
+			SamplingInterval: DefaultSamplingInterval.String(),
+			AlertRules:       []v1beta1.ResourceAlertRule{},
+		}))
+		return rm
+	}
+
+	updated, err := monitor.Update(newMonitor(v1beta1.NetworkResourceMonitorMetricErrors))
+	require.NoError(err)
+	require.True(updated)
+	require.Equal(v1beta1.NetworkResourceMonitorMetricErrors, monitor.metric)
+
+	updated, err = monitor.Update(newMonitor(v1beta1.NetworkResourceMonitorMetricErrors))
+	require.NoError(err)
+	require.False(updated)
+
+	updated, err = monitor.Update(newMonitor(v1beta1.NetworkResourceMonitorMetricThroughput))
+	require.NoError(err)
+	require.True(updated)
+	require.Equal(v1beta1.NetworkResourceMonitorMetricThroughput, monitor.metric)
+}
@@ -17,9 +17,13 @@ import (
 type MonitorType string
 
 const (
-	CPUMonitorType    = "CPU"
-	DiskMonitorType   = "Disk"
-	MemoryMonitorType = "Memory"
+	CPUMonitorType         = "CPU"
+	DiskMonitorType        = "Disk"
+	MemoryMonitorType      = "Memory"
+	InodeMonitorType       = "Inode"
+	TemperatureMonitorType = "Temperature"
+	NetworkMonitorType     = "Network"
+	ApplicationMonitorType = "Application"
 
 	DefaultSamplingInterval = 1 * time.Minute
 )
@@ -46,21 +50,31 @@ type Collector[T any] interface {
 }
 
 type ResourceManager struct {
-	cpuMonitor    Monitor[CPUUsage]
-	diskMonitor   Monitor[DiskUsage]
-	memoryMonitor Monitor[MemoryUsage]
-	log           *log.PrefixLogger
+	cpuMonitor         Monitor[CPUUsage]
+	diskMonitor        Monitor[DiskUsage]
+	memoryMonitor      Monitor[MemoryUsage]
+	inodeMonitor       Monitor[InodeUsage]
+	temperatureMonitor Monitor[TemperatureUsage]
+	networkMonitor     Monitor[NetworkUsage]
+	applicationMonitor Monitor[ApplicationUsage]
+	log                *log.PrefixLogger
 }
 
-// NewManager creates a new resource Manager.
+// NewManager creates a new resource Manager. The application monitor collects the usage of applications from
+// appStats.
 func NewManager(
 	log *log.PrefixLogger,
+	appStats ApplicationStatsCollector,
 ) Manager {
 	return &ResourceManager{
-		cpuMonitor:    NewCPUMonitor(log),
-		diskMonitor:   NewDiskMonitor(log),
-		memoryMonitor: NewMemoryMonitor(log),
-		log:           log,
+		cpuMonitor:         NewCPUMonitor(log),
+		diskMonitor:        NewDiskMonitor(log),
+		memoryMonitor:      NewMemoryMonitor(log),
+		inodeMonitor:       NewInodeMonitor(log),
+		temperatureMonitor: NewTemperatureMonitor(log),
+		networkMonitor:     NewNetworkMonitor(log),
+		applicationMonitor: NewApplicationMonitor(log, appStats),
+		log:                log,
 	}
 }
 
@@ -79,6 +93,10 @@ func (m *ResourceManager) Run(ctx context.Context) {
 	start(m.diskMonitor.Run)
 	start(m.cpuMonitor.Run)
 	start(m.memoryMonitor.Run)
+	start(m.inodeMonitor.Run)
+	start(m.temperatureMonitor.Run)
+	start(m.networkMonitor.Run)
+	start(m.applicationMonitor.Run)
 
 	wg.Wait()
 }
@@ -96,6 +114,14 @@ func (m *ResourceManager) Update(monitor *v1beta1.ResourceMonitor) (bool, error)
 		return m.diskMonitor.Update(monitor)
 	case MemoryMonitorType:
 		return m.memoryMonitor.Update(monitor)
+	case InodeMonitorType:
+		return m.inodeMonitor.Update(monitor)
+	case TemperatureMonitorType:
+		return m.temperatureMonitor.Update(monitor)
+	case NetworkMonitorType:
+		return m.networkMonitor.Update(monitor)
+	case ApplicationMonitorType:
+		return m.applicationMonitor.Update(monitor)
 	default:
 		return false, fmt.Errorf("unknown monitor type: %s", monitorType)
 	}
@@ -143,6 +169,34 @@ func (m *ResourceManager) ResetAlertDefaults() error {
 		m.log.Debug("Reset memory monitor alerts")
 	}
 
+	// inode, temperature, network and application monitors have no alert rules by default
+	optionalMonitors := []struct {
+		name    string
+		monitor interface {
+			Update(monitor *v1beta1.ResourceMonitor) (bool, error)
+		}
+		defaultFn func() (*v1beta1.ResourceMonitor, error)
+	}{
+		{name: "inode", monitor: m.inodeMonitor, defaultFn: defaultInodeResourceMonitor},
+		{name: "temperature", monitor: m.temperatureMonitor, defaultFn: defaultTemperatureResourceMonitor},
+		{name: "network", monitor: m.networkMonitor, defaultFn: defaultNetworkResourceMonitor},
+		{name: "application", monitor: m.applicationMonitor, defaultFn: defaultApplicationResourceMonitor},
+	}
+	for _, optional := range optionalMonitors {
+		monitor, err := optional.defaultFn()
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		updated, err = optional.monitor.Update(monitor)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		if updated {
+			m.log.Debugf("Reset %s monitor alerts", optional.name)
+		}
+	}
+
 	if len(errs) > 0 {
 		return errors.Join(errs...)
 	}
@@ -182,6 +236,30 @@ func (m *ResourceManager) Status(ctx context.Context, status *v1beta1.DeviceStat
 				status.Resources.Memory = resourceStatus
 			},
 		},
+		InodeMonitorType: {
+			alerts: alerts.InodeUsage,
+			setStatusFn: func(resourceStatus v1beta1.DeviceResourceStatusType) {
+				status.Resources.Inode = &resourceStatus
+			},
+		},
+		TemperatureMonitorType: {
+			alerts: alerts.TemperatureUsage,
+			setStatusFn: func(resourceStatus v1beta1.DeviceResourceStatusType) {
+				status.Resources.Temperature = &resourceStatus
+			},
+		},
+		NetworkMonitorType: {
+			alerts: alerts.NetworkUsage,
+			setStatusFn: func(resourceStatus v1beta1.DeviceResourceStatusType) {
+				status.Resources.Network = &resourceStatus
+			},
+		},
+		ApplicationMonitorType: {
+			alerts: alerts.ApplicationUsage,
+			setStatusFn: func(resourceStatus v1beta1.DeviceResourceStatusType) {
+				status.Resources.Application = &resourceStatus
+			},
+		},
 	}
 
 	// set the status for each monitor type
@@ -220,9 +298,13 @@ func (m *ResourceManager) Status(ctx context.Context, status *v1beta1.DeviceStat
 
 func (m *ResourceManager) Alerts() *Alerts {
 	return &Alerts{
-		DiskUsage:   m.diskMonitor.Alerts(),
-		CPUUsage:    m.cpuMonitor.Alerts(),
-		MemoryUsage: m.memoryMonitor.Alerts(),
+		DiskUsage:        m.diskMonitor.Alerts(),
+		CPUUsage:         m.cpuMonitor.Alerts(),
+		MemoryUsage:      m.memoryMonitor.Alerts(),
+		InodeUsage:       m.inodeMonitor.Alerts(),
+		TemperatureUsage: m.temperatureMonitor.Alerts(),
+		NetworkUsage:     m.networkMonitor.Alerts(),
+		ApplicationUsage: m.applicationMonitor.Alerts(),
 	}
 }
 
@@ -239,6 +321,14 @@ func (m *ResourceManager) isCriticalAlert(monitorType MonitorType) bool {
 		alertList = alerts.CPUUsage
 	case MemoryMonitorType:
 		alertList = alerts.MemoryUsage
+	case InodeMonitorType:
+		alertList = alerts.InodeUsage
+	case TemperatureMonitorType:
+		alertList = alerts.TemperatureUsage
+	case NetworkMonitorType:
+		alertList = alerts.NetworkUsage
+	case ApplicationMonitorType:
+		alertList = alerts.ApplicationUsage
 	default:
 		m.log.Warnf("Unknown monitor type: %s", monitorType)
 		return false
@@ -284,9 +374,13 @@ func (m *ResourceManager) IsCriticalAlert(monitorType MonitorType) bool {
 }
 
 type Alerts struct {
-	DiskUsage   []v1beta1.ResourceAlertRule
-	CPUUsage    []v1beta1.ResourceAlertRule
-	MemoryUsage []v1beta1.ResourceAlertRule
+	DiskUsage        []v1beta1.ResourceAlertRule
+	CPUUsage         []v1beta1.ResourceAlertRule
+	MemoryUsage      []v1beta1.ResourceAlertRule
+	InodeUsage       []v1beta1.ResourceAlertRule
+	TemperatureUsage []v1beta1.ResourceAlertRule
+	NetworkUsage     []v1beta1.ResourceAlertRule
+	ApplicationUsage []v1beta1.ResourceAlertRule
 }
 
 type Alert struct {
@@ -374,8 +468,18 @@ type AlertSeverity struct {
 type MonitorSpec struct {
 	v1beta1.ResourceMonitorSpec
 
-	// Path is the absolute path used for the disk monitor.
+	// Path is the absolute path used for the disk and inode monitors.
 	Path string `json:"path,omitempty"`
+	// Zone is the type of the thermal zone used for the temperature monitor.
+	Zone string `json:"zone,omitempty"`
+	// CriticalTemperature is the critical temperature in degrees Celsius used for the temperature monitor.
+	CriticalTemperature int `json:"criticalTemperature,omitempty"`
+	// Interface is the name of the network interface used for the network monitor.
+	Interface string `json:"interface,omitempty"`
+	// Metric is the network metric the alert rules of the network monitor are evaluated against.
+	Metric v1beta1.NetworkResourceMonitorMetric `json:"metric,omitempty"`
+	// Application is the name of the application used for the application monitor.
+	Application string `json:"application,omitempty"`
 }
 
 // getHighestSeverityResourceStatusFromAlerts returns the highest severity statusDeviceResourceStatusType from a list of alerts along with the alert message.
@@ -477,3 +581,49 @@ func defaultMemoryResourceMonitor() (*v1beta1.ResourceMonitor, error) {
 	err := rm.FromMemoryResourceMonitorSpec(spec)
 	return rm, err
 }
+
+func defaultInodeResourceMonitor() (*v1beta1.ResourceMonitor, error) {
+	spec := v1beta1.InodeResourceMonitorSpec{
+		SamplingInterval: DefaultSamplingInterval.String(),
+		MonitorType:      InodeMonitorType,
+		AlertRules:       []v1beta1.ResourceAlertRule{},
+		Path:             "/sysroot",
+	}
+	rm := &v1beta1.ResourceMonitor{}
+	err := rm.FromInodeResourceMonitorSpec(spec)
+	return rm, err
+}
+
+func defaultTemperatureResourceMonitor() (*v1beta1.ResourceMonitor, error) {
+	spec := v1beta1.TemperatureResourceMonitorSpec{
+		SamplingInterval: DefaultSamplingInterval.String(),
+		MonitorType:      TemperatureMonitorType,
+		AlertRules:       []v1beta1.ResourceAlertRule{},
+	}
+	rm := &v1beta1.ResourceMonitor{}
+	err := rm.FromTemperatureResourceMonitorSpec(spec)
+	return rm, err
+}
+
+func defaultNetworkResourceMonitor() (*v1beta1.ResourceMonitor, error) {
+	spec := v1beta1.NetworkResourceMonitorSpec{
+		SamplingInterval: DefaultSamplingInterval.String(),
+		MonitorType:      NetworkMonitorType,
+		Metric:           v1beta1.NetworkResourceMonitorMetricErrors,
+		AlertRules:       []v1beta1.ResourceAlertRule{},
+	}
+	rm := &v1beta1.ResourceMonitor{}
+	err := rm.FromNetworkResourceMonitorSpec(spec)
+	return rm, err
+}
+
+func defaultApplicationResourceMonitor() (*v1beta1.ResourceMonitor, error) {
+	spec := v1beta1.ApplicationResourceMonitorSpec{
+		SamplingInterval: DefaultSamplingInterval.String(),
+		MonitorType:      ApplicationMonitorType,
+		AlertRules:       []v1beta1.ResourceAlertRule{},
+	}
+	rm := &v1beta1.ResourceMonitor{}
+	err := rm.FromApplicationResourceMonitorSpec(spec)
+	return rm, err
+}
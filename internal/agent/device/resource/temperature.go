@@ -0,0 +1,255 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/pkg/log"
+)
+
+const (
+	DefaultTemperatureSyncTimeout = 5 * time.Second
+	DefaultThermalPath            = "/sys/class/thermal"
+)
+
+var _ Monitor[TemperatureUsage] = (*TemperatureMonitor)(nil)
+
+type TemperatureMonitor struct {
+	mu                  sync.Mutex
+	alerts              map[v1beta1.ResourceAlertSeverityType]*Alert
+	zone                string
+	criticalTemperature int
+	thermalPath         string
+
+	updateIntervalCh chan time.Duration
+	samplingInterval time.Duration
+
+	log *log.PrefixLogger
+}
+
+func NewTemperatureMonitor(
+	log *log.PrefixLogger,
+) *TemperatureMonitor {
+	return &TemperatureMonitor{
+		alerts:           make(map[v1beta1.ResourceAlertSeverityType]*Alert),
+		updateIntervalCh: make(chan time.Duration, 1),
+		samplingInterval: DefaultSamplingInterval,
+		thermalPath:      DefaultThermalPath,
+		log:              log,
+	}
+}
+
+func (m *TemperatureMonitor) Run(ctx context.Context) {
+	defer m.log.Infof("Temperature monitor stopped")
+	samplingInterval := m.getSamplingInterval()
+	ticker := time.NewTicker(samplingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case newInterval := <-m.updateIntervalCh:
+			ticker.Reset(newInterval)
+		case <-ticker.C:
+			m.log.Debug("Checking temperature")
+			usage := TemperatureUsage{}
+			m.sync(ctx, &usage)
+		}
+	}
+}
+
+func (m *TemperatureMonitor) Update(monitor *v1beta1.ResourceMonitor) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	spec, err := getMonitorSpec(monitor)
+	if err != nil {
+		return false, err
+	}
+
+	updated, err := updateMonitor(m.log, monitor, &m.samplingInterval, m.alerts, m.updateIntervalCh)
+	if err != nil {
+		return updated, err
+	}
+
+	if spec.Zone != m.zone {
+		m.zone = spec.Zone
+		updated = true
+	}
+	if spec.CriticalTemperature != m.criticalTemperature {
+		m.criticalTemperature = spec.CriticalTemperature
+		updated = true
+	}
+
+	return updated, nil
+}
+
+func (m *TemperatureMonitor) Alerts() []v1beta1.ResourceAlertRule {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var firing []v1beta1.ResourceAlertRule
+	for _, alert := range m.alerts {
+		if alert.IsFiring() {
+			firing = append(firing, alert.ResourceAlertRule)
+		}
+	}
+	return firing
+}
+
+// CollectUsage reads the temperature of the monitored thermal zones and reports the zone that is closest to its
+// critical temperature.
+func (m *TemperatureMonitor) CollectUsage(ctx context.Context, usage *TemperatureUsage) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+		m.mu.Lock()
+		zone, criticalTemperature, thermalPath := m.zone, m.criticalTemperature, m.thermalPath
+		m.mu.Unlock()
+
+		zoneDirs, err := filepath.Glob(filepath.Join(thermalPath, "thermal_zone*"))
+		if err != nil {
+			return err
+		}
+
+		found := false
+		for _, dir := range zoneDirs {
+			zoneType, err := readSysfsString(filepath.Join(dir, "type"))
+			if err != nil || (zone != "" && zoneType != zone) {
+				continue
+			}
+			temp, err := readMilliCelsius(filepath.Join(dir, "temp"))
+			if err != nil {
+				m.log.Debugf("Skipping thermal zone %s: %v", zoneType, err)
+				continue
+			}
+			critical := float64(criticalTemperature)
+			if critical == 0 {
+				critical, err = criticalTripPoint(dir)
+				if err != nil {
+					m.log.Debugf("Skipping thermal zone %s: %v", zoneType, err)
+					continue
+				}
+			}
+
+			usedPercent := int64(math.Round(temp / critical * 100))
+			if !found || usedPercent > usage.UsedPercent {
+				usage.Zone = zoneType
+				usage.Celsius = temp
+				usage.CriticalCelsius = critical
+				usage.UsedPercent = usedPercent
+			}
+			found = true
+		}
+		if !found {
+			if zone != "" {
+				return fmt.Errorf("thermal zone %q not found or has no critical temperature", zone)
+			}
+			return fmt.Errorf("no thermal zone with a critical temperature found")
+		}
+		usage.lastCollectedAt = time.Now()
+	}
+	return nil
+}
+
+func (m *TemperatureMonitor) sync(ctx context.Context, usage *TemperatureUsage) {
+	if !m.hasAlertRules() {
+		m.log.Debug("Skipping temperature sync: no alert rules")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, DefaultTemperatureSyncTimeout)
+	defer cancel()
+
+	if err := m.CollectUsage(ctx, usage); err != nil {
+		m.log.Errorf("Failed to collect temperature: %v", err)
+	}
+
+	m.ensureAlerts(usage)
+}
+
+func (m *TemperatureMonitor) ensureAlerts(usage *TemperatureUsage) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.log.Tracef("Temperature of %s: %.1f°C of %.1f°C (%d%%)", usage.Zone, usage.Celsius, usage.CriticalCelsius, usage.UsedPercent)
+	for _, alert := range m.alerts {
+		alert.Sync(usage.UsedPercent)
+	}
+}
+
+func (m *TemperatureMonitor) hasAlertRules() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.alerts) > 0
+}
+
+func (m *TemperatureMonitor) getSamplingInterval() time.Duration {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.samplingInterval
+}
+
+// criticalTripPoint returns the temperature of the critical trip point of the thermal zone in degrees Celsius.
+func criticalTripPoint(zoneDir string) (float64, error) {
+	types, err := filepath.Glob(filepath.Join(zoneDir, "trip_point_*_type"))
+	if err != nil {
+		return 0, err
+	}
+	for _, typePath := range types {
+		tripType, err := readSysfsString(typePath)
+		if err != nil || tripType != "critical" {
+			continue
+		}
+		temp, err := readMilliCelsius(strings.TrimSuffix(typePath, "_type") + "_temp")
+		if err != nil {
+			return 0, err
+		}
+		if temp <= 0 {
+			return 0, fmt.Errorf("invalid critical trip point: %.1f°C", temp)
+		}
+		return temp, nil
+	}
+	return 0, fmt.Errorf("no critical trip point")
+}
+
+func readSysfsString(path string) (string, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(b)), nil
+}
+
+// readMilliCelsius reads a sysfs temperature in millidegrees Celsius and returns it in degrees Celsius.
+func readMilliCelsius(path string) (float64, error) {
+	s, err := readSysfsString(path)
+	if err != nil {
+		return 0, err
+	}
+	milli, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("parsing temperature %s: %w", path, err)
+	}
+	return float64(milli) / 1000, nil
+}
+
+// TemperatureUsage represents the temperature of the hottest monitored thermal zone of this device, relative to its
+// critical temperature.
+type TemperatureUsage struct {
+	Zone            string
+	Celsius         float64
+	CriticalCelsius float64
+	UsedPercent     int64
+
+	lastCollectedAt time.Time
+}
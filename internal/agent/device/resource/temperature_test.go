@@ -0,0 +1,161 @@
+package resource
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/stretchr/testify/require"
+)
+
+// writeThermalZone creates a fake thermal zone. An empty critical temperature omits the critical trip point.
+func writeThermalZone(t *testing.T, thermalPath, name, zoneType, temp, critical string) {
+	t.Helper()
+	dir := filepath.Join(thermalPath, name)
+	files := map[string]string{
+		"type":              zoneType,
+		"temp":              temp,
+		"trip_point_0_type": "passive",
+		"trip_point_0_temp": "50000",
+	}
+	if critical != "" {
+		files["trip_point_1_type"] = "critical"
+		files["trip_point_1_temp"] = critical
+	}
+	require.NoError(t, os.MkdirAll(dir, 0755))
+	for file, content := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, file), []byte(content+"\n"), 0600))
+	}
+}
+
+func TestTemperatureCollectUsage(t *testing.T) {
+	thermalPath := t.TempDir()
+	writeThermalZone(t, thermalPath, "thermal_zone0", "acpitz", "45000", "100000")
+	writeThermalZone(t, thermalPath, "thermal_zone1", "x86_pkg_temp", "81500", "90000")
+	writeThermalZone(t, thermalPath, "thermal_zone2", "iwlwifi_1", "30000", "")
+
+	tests := []struct {
+		name                string
+		zone                string
+		criticalTemperature int
+		wantZone            string
+		wantPercent         int64
+		wantErr             bool
+	}{
+		{
+			name:        "hottest zone relative to its critical trip point",
+			wantZone:    "x86_pkg_temp",
+			wantPercent: 91,
+		},
+		{
+			name:        "selected zone",
+			zone:        "acpitz",
+			wantZone:    "acpitz",
+			wantPercent: 45,
+		},
+		{
+			name:                "critical temperature overrides trip point",
+			zone:                "acpitz",
+			criticalTemperature: 60,
+			wantZone:            "acpitz",
+			wantPercent:         75,
+		},
+		{
+			name:    "zone without critical trip point",
+			zone:    "iwlwifi_1",
+			wantErr: true,
+		},
+		{
+			name:                "zone without critical trip point with critical temperature",
+			zone:                "iwlwifi_1",
+			criticalTemperature: 60,
+			wantZone:            "iwlwifi_1",
+			wantPercent:         50,
+		},
+		{
+			name:    "unknown zone",
+			zone:    "gpu",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			monitor := NewTemperatureMonitor(log.NewPrefixLogger("test"))
+			monitor.thermalPath = thermalPath
+			monitor.zone = tt.zone
+			monitor.criticalTemperature = tt.criticalTemperature
+
+			usage := TemperatureUsage{}
+			err := monitor.CollectUsage(context.Background(), &usage)
+			if tt.wantErr {
+				require.Error(err)
+				return
+			}
+			require.NoError(err)
+			require.Equal(tt.wantZone, usage.Zone)
+			require.Equal(tt.wantPercent, usage.UsedPercent)
+		})
+	}
+}
+
+func TestTemperatureMonitor(t *testing.T) {
+	require := require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	thermalPath := t.TempDir()
+	writeThermalZone(t, thermalPath, "thermal_zone0", "x86_pkg_temp", "85000", "100000")
+
+	temperatureMonitor := NewTemperatureMonitor(log.NewPrefixLogger("test"))
+	temperatureMonitor.thermalPath = thermalPath
+
+	go temperatureMonitor.Run(ctx)
+
+	samplingInterval := 100 * time.Millisecond
+	monitorSpec := v1beta1.TemperatureResourceMonitorSpec{
+		SamplingInterval: samplingInterval.String(),
+		MonitorType:      TemperatureMonitorType,
+		AlertRules: []v1beta1.ResourceAlertRule{
+			{
+				Severity:    v1beta1.ResourceAlertSeverityTypeCritical,
+				Percentage:  95, // 85% of the critical temperature should never fire an alert
+				Duration:    "90ms",
+				Description: "Critical: temperature is above 95% of critical for 90ms",
+			},
+			{
+				Severity:    v1beta1.ResourceAlertSeverityTypeWarning,
+				Percentage:  80, // 85% of the critical temperature should always fire an alert
+				Duration:    "90ms",
+				Description: "Warning: temperature is above 80% of critical for 90ms",
+			},
+		},
+	}
+
+	rm := &v1beta1.ResourceMonitor{}
+	require.NoError(rm.FromTemperatureResourceMonitorSpec(monitorSpec))
+
+	updated, err := temperatureMonitor.Update(rm)
+	require.NoError(err)
+	require.True(updated)
+
+	var alerts []v1beta1.ResourceAlertRule
+	require.Eventually(func() bool {
+		alerts = temperatureMonitor.Alerts()
+		return len(alerts) == 1
+	}, retryTimeout, retryInterval, "alert add")
+
+	deviceResourceStatusType, alertMsg := getHighestSeverityResourceStatusFromAlerts(TemperatureMonitorType, alerts)
+	require.NotEmpty(alertMsg)
+	require.Equal(v1beta1.DeviceResourceStatusWarning, deviceResourceStatusType)
+
+	// cooling down clears the alert
+	require.NoError(os.WriteFile(filepath.Join(thermalPath, "thermal_zone0", "temp"), []byte("40000\n"), 0600))
+	require.Eventually(func() bool {
+		return len(temperatureMonitor.Alerts()) == 0
+	}, retryTimeout, retryInterval, "alerts remove")
+}
@@ -0,0 +1,495 @@
+package volumesnapshot
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/client"
+	"github.com/flightctl/flightctl/internal/agent/config"
+	"github.com/flightctl/flightctl/internal/agent/device/applications/lifecycle"
+	"github.com/flightctl/flightctl/internal/agent/device/applications/provider"
+	"github.com/flightctl/flightctl/internal/agent/device/errors"
+	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/flightctl/flightctl/internal/agent/device/resource"
+	"github.com/flightctl/flightctl/internal/agent/device/systemd"
+	"github.com/flightctl/flightctl/internal/quadlet"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/samber/lo"
+)
+
+var _ Manager = (*manager)(nil)
+
+const (
+	// SnapshotDirName is the directory in the agent's data directory that holds the volume snapshots
+	SnapshotDirName = "volume-snapshots"
+	// snapshotExtension is the extension of the tar archives of the snapshots, named after the renderedVersion
+	// they were taken for
+	snapshotExtension = ".tar"
+	// snapshotDirPermissions restricts the snapshots, which may hold sensitive application data, to their owner
+	snapshotDirPermissions = 0700
+)
+
+// Manager archives the application volumes that opt in to snapshots before an update changes their application
+// and restores them when the update is rolled back.
+type Manager interface {
+	// Snapshot archives the volumes that opt in to snapshots of the applications that the update from current to
+	// desired changes. It defers the update if the snapshots do not fit on the disk. Snapshots already taken for
+	// desired are kept, so that retries of the update do not replace them.
+	Snapshot(ctx context.Context, current, desired *v1beta1.Device) error
+	// Restore replaces the contents of the volumes archived before the update to failed with their snapshots. It
+	// stops the applications of the volumes, so that the rollback starts their previous version with the
+	// previous data.
+	Restore(ctx context.Context, failed *v1beta1.Device) error
+	// Prune removes the snapshots of the volumes that no longer opt in to snapshots in the device spec.
+	Prune(ctx context.Context, device *v1beta1.Device) error
+	// ReloadConfig reloads the snapshot configuration from the agent config.
+	ReloadConfig(ctx context.Context, cfg *config.Config) error
+}
+
+// Config holds configuration for volume snapshots.
+// This type is defined in internal/agent/config/config.go as config.VolumeSnapshots.
+type Config = config.VolumeSnapshots
+
+type manager struct {
+	podmanFactory   client.PodmanFactory
+	systemdFactory  systemd.ManagerFactory
+	rwFactory       fileio.ReadWriterFactory
+	readWriter      fileio.ReadWriter
+	resourceManager resource.Manager
+	snapshotDir     string
+	config          atomic.Pointer[Config]
+	log             *log.PrefixLogger
+}
+
+// New creates a new volume snapshot manager that keeps the snapshots in dataDir.
+func New(
+	podmanFactory client.PodmanFactory,
+	systemdFactory systemd.ManagerFactory,
+	rwFactory fileio.ReadWriterFactory,
+	readWriter fileio.ReadWriter,
+	resourceManager resource.Manager,
+	log *log.PrefixLogger,
+	cfg Config,
+	dataDir string,
+) Manager {
+	m := &manager{
+		podmanFactory:   podmanFactory,
+		systemdFactory:  systemdFactory,
+		rwFactory:       rwFactory,
+		readWriter:      readWriter,
+		resourceManager: resourceManager,
+		snapshotDir:     filepath.Join(dataDir, SnapshotDirName),
+		log:             log,
+	}
+	m.config.Store(&cfg)
+	return m
+}
+
+// volume is an application volume that opts in to snapshots.
+type volume struct {
+	// appName is the name of the application as defined by the user
+	appName string
+	// appID identifies the application on the device
+	appID string
+	// user runs the application and owns the volume
+	user v1beta1.Username
+	// id is the name of the podman volume
+	id string
+}
+
+func (m *manager) Snapshot(ctx context.Context, current, desired *v1beta1.Device) error {
+	volumes, err := snapshotVolumes(desired.Spec)
+	if err != nil {
+		return err
+	}
+	if len(volumes) == 0 {
+		return nil
+	}
+
+	changed, err := changedApplications(current.Spec, desired.Spec)
+	if err != nil {
+		return err
+	}
+	volumes = lo.Filter(volumes, func(vol volume, _ int) bool {
+		_, ok := changed[vol.appName]
+		return ok
+	})
+	if len(volumes) == 0 {
+		return nil
+	}
+
+	if m.resourceManager.IsCriticalAlert(resource.DiskMonitorType) {
+		return fmt.Errorf("%w: insufficient disk storage space for volume snapshots, please clear storage", errors.ErrCriticalResourceAlert)
+	}
+
+	// podman creates mount volumes when their application first starts, so there may be nothing to archive yet
+	var existing []volume
+	var size uint64
+	for _, vol := range volumes {
+		// a retried update keeps the snapshot taken before its first attempt, which may have migrated the volume
+		path := snapshotPath(m.snapshotDir, vol, desired.Version())
+		taken, err := m.readWriter.PathExists(path)
+		if err != nil {
+			return fmt.Errorf("checking %w: %w", errors.WithElement(path), err)
+		}
+		if taken {
+			m.log.Debugf("Keeping snapshot of volume %s taken before the previous attempt of the update", vol.id)
+			continue
+		}
+
+		podman, err := m.podmanFactory(vol.user)
+		if err != nil {
+			return fmt.Errorf("creating podman client: %w", err)
+		}
+		if !podman.VolumeExists(ctx, vol.id) {
+			continue
+		}
+		mountPath, err := podman.InspectVolumeMount(ctx, vol.id)
+		if err != nil {
+			return fmt.Errorf("inspect volume %w: %w", errors.WithElement(vol.id), err)
+		}
+		volumeSize, err := dirSize(m.readWriter.PathFor(mountPath))
+		if err != nil {
+			return fmt.Errorf("measuring volume %w: %w", errors.WithElement(vol.id), err)
+		}
+		size += volumeSize
+		existing = append(existing, vol)
+	}
+	if len(existing) == 0 {
+		return nil
+	}
+
+	if err := m.readWriter.MkdirAll(m.snapshotDir, fileio.DefaultDirectoryPermissions); err != nil {
+		return fmt.Errorf("creating %w: %w", errors.WithElement(m.snapshotDir), err)
+	}
+	if err := m.ensureDiskSpace(size); err != nil {
+		return err
+	}
+
+	cfg := m.config.Load()
+	for _, vol := range existing {
+		if err := m.snapshot(ctx, vol, desired.Version()); err != nil {
+			return fmt.Errorf("snapshot of volume %w: %w", errors.WithElement(vol.id), err)
+		}
+		if err := m.applyRetention(vol, cfg.Retention); err != nil {
+			m.log.Warnf("Failed to remove old snapshots of volume %s: %v", vol.id, err)
+		}
+	}
+	return nil
+}
+
+// ensureDiskSpace checks that snapshots of size bytes leave the reserved share of the disk free.
+func (m *manager) ensureDiskSpace(size uint64) error {
+	usage, err := resource.DirUsage(m.readWriter.PathFor(m.snapshotDir))
+	if err != nil {
+		return fmt.Errorf("checking disk usage: %w", err)
+	}
+	reservedPercent := m.config.Load().ReservedDiskPercent
+	reserved := usage.Total / 100 * uint64(max(reservedPercent, 0)) // #nosec G115 -- clamped to non-negative
+	if usage.Free < reserved || usage.Free-reserved < size {
+		return fmt.Errorf("%w: volume snapshots need %d bytes, which would leave less than %d%% of the disk free",
+			errors.ErrCriticalResourceAlert, size, reservedPercent)
+	}
+	return nil
+}
+
+// snapshot archives the volume as the snapshot taken before updating to version.
+func (m *manager) snapshot(ctx context.Context, vol volume, version string) error {
+	podman, err := m.podmanFactory(vol.user)
+	if err != nil {
+		return fmt.Errorf("creating podman client: %w", err)
+	}
+	// podman runs as the owner of the volume and writes the archive
+	userReadWriter, err := m.rwFactory(vol.user)
+	if err != nil {
+		return fmt.Errorf("creating read/writer: %w", err)
+	}
+	dir := filepath.Join(m.snapshotDir, vol.id)
+	if err := userReadWriter.MkdirAll(dir, snapshotDirPermissions); err != nil {
+		return fmt.Errorf("creating %w: %w", errors.WithElement(dir), err)
+	}
+
+	path := snapshotPath(m.snapshotDir, vol, version)
+	tmpPath := path + ".tmp"
+	m.log.Infof("Taking snapshot of volume %s of application %s", vol.id, vol.appName)
+	if err := podman.ExportVolume(ctx, vol.id, m.readWriter.PathFor(tmpPath)); err != nil {
+		_ = m.readWriter.RemoveFile(tmpPath)
+		return err
+	}
+	if err := m.readWriter.Rename(tmpPath, path); err != nil {
+		return fmt.Errorf("renaming %w: %w", errors.WithElement(tmpPath), err)
+	}
+	return nil
+}
+
+// applyRetention removes the oldest snapshots of the volume beyond retention.
+func (m *manager) applyRetention(vol volume, retention int) error {
+	versions, err := m.snapshotVersions(vol)
+	if err != nil {
+		return err
+	}
+	if len(versions) <= retention {
+		return nil
+	}
+	for _, version := range versions[:len(versions)-retention] {
+		path := snapshotPath(m.snapshotDir, vol, strconv.FormatInt(version, 10))
+		m.log.Debugf("Removing snapshot %s", path)
+		if err := m.readWriter.RemoveFile(path); err != nil {
+			return fmt.Errorf("removing %w: %w", errors.WithElement(path), err)
+		}
+	}
+	return nil
+}
+
+// snapshotVersions returns the renderedVersions the volume has snapshots for, oldest first.
+func (m *manager) snapshotVersions(vol volume) ([]int64, error) {
+	entries, err := m.readWriter.ReadDir(filepath.Join(m.snapshotDir, vol.id))
+	if err != nil {
+		return nil, err
+	}
+	var versions []int64
+	for _, entry := range entries {
+		name, ok := strings.CutSuffix(entry.Name(), snapshotExtension)
+		if !ok || entry.IsDir() {
+			continue
+		}
+		version, err := strconv.ParseInt(name, 10, 64)
+		if err != nil {
+			continue
+		}
+		versions = append(versions, version)
+	}
+	sort.Slice(versions, func(i, j int) bool { return versions[i] < versions[j] })
+	return versions, nil
+}
+
+func (m *manager) Restore(ctx context.Context, failed *v1beta1.Device) error {
+	volumes, err := snapshotVolumes(failed.Spec)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	stopped := make(map[string]struct{})
+	for _, vol := range volumes {
+		path := snapshotPath(m.snapshotDir, vol, failed.Version())
+		exists, err := m.readWriter.PathExists(path)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("checking %w: %w", errors.WithElement(path), err))
+			continue
+		}
+		if !exists {
+			continue
+		}
+
+		if _, ok := stopped[vol.appID]; !ok {
+			if err := m.stopApplication(ctx, vol); err != nil {
+				errs = append(errs, fmt.Errorf("stopping application %s: %w", vol.appName, err))
+				continue
+			}
+			stopped[vol.appID] = struct{}{}
+		}
+
+		if err := m.restore(ctx, vol, path); err != nil {
+			errs = append(errs, fmt.Errorf("restoring volume %w: %w", errors.WithElement(vol.id), err))
+			continue
+		}
+		// the snapshot is consumed, restoring it again would discard the data written after the rollback
+		if err := m.readWriter.RemoveFile(path); err != nil {
+			errs = append(errs, fmt.Errorf("removing %w: %w", errors.WithElement(path), err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// stopApplication stops the services of the application so that none of its containers uses the volume.
+func (m *manager) stopApplication(ctx context.Context, vol volume) error {
+	systemctl, err := m.systemdFactory(vol.user)
+	if err != nil {
+		return fmt.Errorf("creating systemd client: %w", err)
+	}
+	target := quadlet.NamespaceResource(vol.appID, lifecycle.QuadletTargetName)
+	services, err := systemctl.ListDependencies(ctx, target)
+	if err != nil {
+		return fmt.Errorf("listing dependencies: %w", err)
+	}
+	m.log.Debugf("Stopping application %s to restore its volumes", vol.appName)
+	return systemctl.Stop(ctx, append(services, target)...)
+}
+
+// restore replaces the contents of the volume with the snapshot at path.
+func (m *manager) restore(ctx context.Context, vol volume, path string) error {
+	podman, err := m.podmanFactory(vol.user)
+	if err != nil {
+		return fmt.Errorf("creating podman client: %w", err)
+	}
+	if !podman.VolumeExists(ctx, vol.id) {
+		m.log.Warnf("Skipping restore of removed volume %s", vol.id)
+		return nil
+	}
+	mountPath, err := podman.InspectVolumeMount(ctx, vol.id)
+	if err != nil {
+		return fmt.Errorf("inspect volume: %w", err)
+	}
+	if err := m.readWriter.RemoveContents(mountPath); err != nil {
+		return fmt.Errorf("removing volume content %w: %w", errors.WithElement(mountPath), err)
+	}
+	if err := podman.ImportVolume(ctx, vol.id, m.readWriter.PathFor(path)); err != nil {
+		return err
+	}
+	m.log.Infof("Restored volume %s of application %s from its snapshot", vol.id, vol.appName)
+	return nil
+}
+
+func (m *manager) Prune(ctx context.Context, device *v1beta1.Device) error {
+	volumes, err := snapshotVolumes(device.Spec)
+	if err != nil {
+		return err
+	}
+	keep := make(map[string]struct{}, len(volumes))
+	for _, vol := range volumes {
+		keep[vol.id] = struct{}{}
+	}
+
+	exists, err := m.readWriter.PathExists(m.snapshotDir)
+	if err != nil || !exists {
+		return err
+	}
+	entries, err := m.readWriter.ReadDir(m.snapshotDir)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, entry := range entries {
+		if _, ok := keep[entry.Name()]; ok || !entry.IsDir() {
+			continue
+		}
+		dir := filepath.Join(m.snapshotDir, entry.Name())
+		m.log.Infof("Removing snapshots of volume %s", entry.Name())
+		if err := m.readWriter.RemoveAll(dir); err != nil {
+			errs = append(errs, fmt.Errorf("removing %w: %w", errors.WithElement(dir), err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (m *manager) ReloadConfig(ctx context.Context, cfg *config.Config) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	m.config.Store(&cfg.VolumeSnapshots)
+	m.log.Infof("Volume snapshot config reloaded: retention=%d reservedDiskPercent=%d",
+		cfg.VolumeSnapshots.Retention, cfg.VolumeSnapshots.ReservedDiskPercent)
+	return nil
+}
+
+func snapshotPath(snapshotDir string, vol volume, version string) string {
+	return filepath.Join(snapshotDir, vol.id, version+snapshotExtension)
+}
+
+// snapshotVolumes returns the volumes of the applications of spec that opt in to snapshots. Only container
+// applications support mount volumes.
+func snapshotVolumes(spec *v1beta1.DeviceSpec) ([]volume, error) {
+	if spec == nil {
+		return nil, nil
+	}
+	var volumes []volume
+	for _, appSpec := range lo.FromPtr(spec.Applications) {
+		appType, err := appSpec.GetAppType()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", errors.ErrGettingProviderSpec, err)
+		}
+		if appType != v1beta1.AppTypeContainer {
+			continue
+		}
+		app, err := appSpec.AsContainerApplication()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", errors.ErrGettingProviderSpec, err)
+		}
+		appName, err := provider.ResolveImageAppName(&appSpec)
+		if err != nil {
+			return nil, err
+		}
+		user := app.RunAsWithDefault()
+		appID := lifecycle.GenerateAppID(appName, user)
+		for _, vol := range lo.FromPtr(app.Volumes) {
+			if !lo.FromPtr(vol.SnapshotBeforeUpdate) {
+				continue
+			}
+			volumes = append(volumes, volume{
+				appName: appName,
+				appID:   appID,
+				user:    user,
+				id:      quadlet.NamespaceResource(appID, vol.Name),
+			})
+		}
+	}
+	return volumes, nil
+}
+
+// changedApplications returns the names of the applications of current that desired changes.
+func changedApplications(current, desired *v1beta1.DeviceSpec) (map[string]struct{}, error) {
+	currentApps, err := applicationsByName(current)
+	if err != nil {
+		return nil, err
+	}
+	desiredApps, err := applicationsByName(desired)
+	if err != nil {
+		return nil, err
+	}
+	changed := make(map[string]struct{})
+	for name, desiredApp := range desiredApps {
+		if currentApp, ok := currentApps[name]; ok && currentApp != desiredApp {
+			changed[name] = struct{}{}
+		}
+	}
+	return changed, nil
+}
+
+// applicationsByName returns the serialized applications of spec, keyed by name.
+func applicationsByName(spec *v1beta1.DeviceSpec) (map[string]string, error) {
+	apps := make(map[string]string)
+	if spec == nil {
+		return apps, nil
+	}
+	for _, appSpec := range lo.FromPtr(spec.Applications) {
+		name, err := provider.ResolveImageAppName(&appSpec)
+		if err != nil {
+			return nil, err
+		}
+		appBytes, err := json.Marshal(appSpec)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", errors.ErrMarshal, err)
+		}
+		apps[name] = string(appBytes)
+	}
+	return apps, nil
+}
+
+// dirSize returns the total size of the regular files in dir.
+func dirSize(dir string) (uint64, error) {
+	var size uint64
+	err := filepath.WalkDir(dir, func(_ string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		size += uint64(info.Size()) // #nosec G115 -- file sizes are non-negative
+		return nil
+	})
+	return size, err
+}
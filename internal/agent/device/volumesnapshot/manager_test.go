@@ -0,0 +1,194 @@
+package volumesnapshot
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/client"
+	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/flightctl/flightctl/internal/agent/device/resource"
+	"github.com/flightctl/flightctl/pkg/executer"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/flightctl/flightctl/pkg/poll"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func newContainerApp(t *testing.T, name, image string, snapshot bool) v1beta1.ApplicationProviderSpec {
+	t.Helper()
+	var vol v1beta1.ApplicationVolume
+	require.NoError(t, vol.FromMountVolumeProviderSpec(v1beta1.MountVolumeProviderSpec{
+		Mount: v1beta1.VolumeMount{Path: "/data"},
+	}))
+	vol.Name = "data"
+	vol.SnapshotBeforeUpdate = lo.ToPtr(snapshot)
+
+	var appSpec v1beta1.ApplicationProviderSpec
+	require.NoError(t, appSpec.FromContainerApplication(v1beta1.ContainerApplication{
+		Name:    lo.ToPtr(name),
+		AppType: v1beta1.AppTypeContainer,
+		Image:   image,
+		Volumes: &[]v1beta1.ApplicationVolume{vol},
+	}))
+	return appSpec
+}
+
+func newDeviceSpec(apps ...v1beta1.ApplicationProviderSpec) *v1beta1.DeviceSpec {
+	return &v1beta1.DeviceSpec{Applications: &apps}
+}
+
+func newTestManager(t *testing.T) (*manager, fileio.ReadWriter) {
+	t.Helper()
+	tmpDir := t.TempDir()
+	readWriter := fileio.NewReadWriter(
+		fileio.NewReader(fileio.WithReaderRootDir(tmpDir)),
+		fileio.NewWriter(fileio.WithWriterRootDir(tmpDir)),
+	)
+	m := &manager{
+		readWriter:  readWriter,
+		snapshotDir: filepath.Join("/var/lib/flightctl", SnapshotDirName),
+		log:         log.NewPrefixLogger("test"),
+	}
+	m.config.Store(&Config{Retention: 2})
+	return m, readWriter
+}
+
+func TestSnapshotVolumes(t *testing.T) {
+	require := require.New(t)
+
+	spec := newDeviceSpec(
+		newContainerApp(t, "app1", "quay.io/example/app1:v1", true),
+		newContainerApp(t, "app2", "quay.io/example/app2:v1", false),
+	)
+	volumes, err := snapshotVolumes(spec)
+	require.NoError(err)
+	require.Len(volumes, 1)
+	require.Equal("app1", volumes[0].appName)
+	require.Equal(v1beta1.CurrentProcessUsername, volumes[0].user)
+	require.Contains(volumes[0].id, "data")
+
+	volumes, err = snapshotVolumes(nil)
+	require.NoError(err)
+	require.Empty(volumes)
+}
+
+func TestChangedApplications(t *testing.T) {
+	testCases := []struct {
+		name    string
+		current *v1beta1.DeviceSpec
+		desired *v1beta1.DeviceSpec
+		want    []string
+	}{
+		{
+			name:    "updated application",
+			current: newDeviceSpec(newContainerApp(t, "app1", "quay.io/example/app1:v1", true)),
+			desired: newDeviceSpec(newContainerApp(t, "app1", "quay.io/example/app1:v2", true)),
+			want:    []string{"app1"},
+		},
+		{
+			name:    "unchanged application",
+			current: newDeviceSpec(newContainerApp(t, "app1", "quay.io/example/app1:v1", true)),
+			desired: newDeviceSpec(newContainerApp(t, "app1", "quay.io/example/app1:v1", true)),
+		},
+		{
+			name:    "added application",
+			current: newDeviceSpec(),
+			desired: newDeviceSpec(newContainerApp(t, "app1", "quay.io/example/app1:v1", true)),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require := require.New(t)
+			changed, err := changedApplications(tc.current, tc.desired)
+			require.NoError(err)
+			require.ElementsMatch(tc.want, lo.Keys(changed))
+		})
+	}
+}
+
+func TestApplyRetention(t *testing.T) {
+	require := require.New(t)
+	m, readWriter := newTestManager(t)
+
+	vol := volume{appName: "app1", id: "app1-data"}
+	for _, version := range []string{"3", "10", "4"} {
+		require.NoError(readWriter.WriteFile(snapshotPath(m.snapshotDir, vol, version), []byte("data"), fileio.DefaultFilePermissions))
+	}
+
+	require.NoError(m.applyRetention(vol, 2))
+	versions, err := m.snapshotVersions(vol)
+	require.NoError(err)
+	require.Equal([]int64{4, 10}, versions)
+}
+
+func TestPrune(t *testing.T) {
+	require := require.New(t)
+	m, readWriter := newTestManager(t)
+
+	device := &v1beta1.Device{Spec: newDeviceSpec(newContainerApp(t, "app1", "quay.io/example/app1:v1", true))}
+	volumes, err := snapshotVolumes(device.Spec)
+	require.NoError(err)
+	require.Len(volumes, 1)
+
+	kept := snapshotPath(m.snapshotDir, volumes[0], "1")
+	removed := snapshotPath(m.snapshotDir, volume{id: "app2-data"}, "1")
+	require.NoError(readWriter.WriteFile(kept, []byte("data"), fileio.DefaultFilePermissions))
+	require.NoError(readWriter.WriteFile(removed, []byte("data"), fileio.DefaultFilePermissions))
+
+	require.NoError(m.Prune(context.Background(), device))
+	exists, err := readWriter.PathExists(kept)
+	require.NoError(err)
+	require.True(exists)
+	exists, err = readWriter.PathExists(filepath.Dir(removed))
+	require.NoError(err)
+	require.False(exists)
+}
+
+func TestSnapshotKeepsSnapshotOfRetriedUpdate(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+	m, readWriter := newTestManager(t)
+
+	mockExec := executer.NewMockExecuter(ctrl)
+	mockResourceManager := resource.NewMockManager(ctrl)
+	podman := client.NewPodman(m.log, mockExec, readWriter, poll.Config{})
+	m.podmanFactory = func(v1beta1.Username) (*client.Podman, error) { return podman, nil }
+	m.rwFactory = func(v1beta1.Username) (fileio.ReadWriter, error) { return readWriter, nil }
+	m.resourceManager = mockResourceManager
+
+	current := &v1beta1.Device{Spec: newDeviceSpec(newContainerApp(t, "app1", "quay.io/example/app1:v1", true))}
+	desired := &v1beta1.Device{
+		Metadata: v1beta1.ObjectMeta{Annotations: &map[string]string{v1beta1.DeviceAnnotationRenderedVersion: "2"}},
+		Spec:     newDeviceSpec(newContainerApp(t, "app1", "quay.io/example/app1:v2", true)),
+	}
+	volumes, err := snapshotVolumes(desired.Spec)
+	require.NoError(err)
+	require.Len(volumes, 1)
+	path := snapshotPath(m.snapshotDir, volumes[0], "2")
+	mountPath := "/var/lib/containers/storage/volumes/data"
+	require.NoError(readWriter.WriteFile(filepath.Join(mountPath, "db"), []byte("v1 data"), fileio.DefaultFilePermissions))
+
+	// the first attempt of the update archives the volume
+	mockResourceManager.EXPECT().IsCriticalAlert(resource.MonitorType(resource.DiskMonitorType)).Return(false).Times(2)
+	mockExec.EXPECT().ExecuteWithContext(gomock.Any(), "podman", "volume", "exists", volumes[0].id).Return("", "", 0)
+	mockExec.EXPECT().ExecuteWithContext(gomock.Any(), "podman", "volume", "inspect", volumes[0].id, "--format", "{{.Mountpoint}}").
+		Return(mountPath, "", 0)
+	mockExec.EXPECT().ExecuteWithContext(gomock.Any(), "podman", "volume", "export", volumes[0].id, "--output", readWriter.PathFor(path+".tmp")).
+		DoAndReturn(func(_ context.Context, _ string, args ...string) (string, string, int) {
+			require.NoError(os.WriteFile(args[len(args)-1], []byte("v1 data"), fileio.DefaultFilePermissions))
+			return "", "", 0
+		})
+	require.NoError(m.Snapshot(ctx, current, desired))
+
+	// the retry must not archive the volume the first attempt may have migrated
+	require.NoError(m.Snapshot(ctx, current, desired))
+	contents, err := readWriter.ReadFile(path)
+	require.NoError(err)
+	require.Equal("v1 data", string(contents))
+}
@@ -0,0 +1,98 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: manager.go
+//
+// Generated by this command:
+//
+//	mockgen -source=manager.go -destination=mock_manager.go -package=volumesnapshot
+//
+
+// Package volumesnapshot is a generated GoMock package.
+package volumesnapshot
+
+import (
+	context "context"
+	reflect "reflect"
+
+	v1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
+	config "github.com/flightctl/flightctl/internal/agent/config"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockManager is a mock of Manager interface.
+type MockManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockManagerMockRecorder
+}
+
+// MockManagerMockRecorder is the mock recorder for MockManager.
+type MockManagerMockRecorder struct {
+	mock *MockManager
+}
+
+// NewMockManager creates a new mock instance.
+func NewMockManager(ctrl *gomock.Controller) *MockManager {
+	mock := &MockManager{ctrl: ctrl}
+	mock.recorder = &MockManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockManager) EXPECT() *MockManagerMockRecorder {
+	return m.recorder
+}
+
+// Prune mocks base method.
+func (m *MockManager) Prune(ctx context.Context, device *v1beta1.Device) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Prune", ctx, device)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Prune indicates an expected call of Prune.
+func (mr *MockManagerMockRecorder) Prune(ctx, device any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Prune", reflect.TypeOf((*MockManager)(nil).Prune), ctx, device)
+}
+
+// ReloadConfig mocks base method.
+func (m *MockManager) ReloadConfig(ctx context.Context, cfg *config.Config) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReloadConfig", ctx, cfg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReloadConfig indicates an expected call of ReloadConfig.
+func (mr *MockManagerMockRecorder) ReloadConfig(ctx, cfg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReloadConfig", reflect.TypeOf((*MockManager)(nil).ReloadConfig), ctx, cfg)
+}
+
+// Restore mocks base method.
+func (m *MockManager) Restore(ctx context.Context, failed *v1beta1.Device) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, failed)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockManagerMockRecorder) Restore(ctx, failed any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockManager)(nil).Restore), ctx, failed)
+}
+
+// Snapshot mocks base method.
+func (m *MockManager) Snapshot(ctx context.Context, current, desired *v1beta1.Device) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Snapshot", ctx, current, desired)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Snapshot indicates an expected call of Snapshot.
+func (mr *MockManagerMockRecorder) Snapshot(ctx, current, desired any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Snapshot", reflect.TypeOf((*MockManager)(nil).Snapshot), ctx, current, desired)
+}
@@ -0,0 +1,68 @@
+package identity
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/device/status"
+	fccrypto "github.com/flightctl/flightctl/pkg/crypto"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/samber/lo"
+)
+
+var _ status.Exporter = (*EncryptionKeyExporter)(nil)
+
+// EncryptionKeyExporter reports the identity's encryption public key in the device status. The service only
+// encrypts sensitive content to devices that report a key, so agents that cannot decrypt keep receiving
+// cleartext content.
+type EncryptionKeyExporter struct {
+	provider Provider
+	log      *log.PrefixLogger
+
+	mu       sync.Mutex
+	resolved bool
+	keyPEM   *string
+}
+
+// NewEncryptionKeyExporter creates a status exporter for the encryption public key of the identity provider.
+func NewEncryptionKeyExporter(provider Provider, log *log.PrefixLogger) *EncryptionKeyExporter {
+	return &EncryptionKeyExporter{
+		provider: provider,
+		log:      log,
+	}
+}
+
+func (e *EncryptionKeyExporter) Status(_ context.Context, deviceStatus *v1beta1.DeviceStatus, _ ...status.CollectorOpt) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	// the key does not change for the lifetime of the identity
+	if !e.resolved {
+		e.resolveKey()
+	}
+	deviceStatus.Config.EncryptionKey = e.keyPEM
+	return nil
+}
+
+// resolveKey assumes the lock is held
+func (e *EncryptionKeyExporter) resolveKey() {
+	publicKey, err := e.provider.EncryptionPublicKey()
+	if err != nil {
+		if errors.Is(err, ErrUnsupportedEncryption) {
+			e.log.Warnf("Sensitive content will be delivered unencrypted: %v", err)
+			e.resolved = true
+		} else {
+			e.log.Errorf("Failed to get encryption public key: %v", err)
+		}
+		return
+	}
+	keyPEM, err := fccrypto.EncodePublicKeyPEM(publicKey)
+	if err != nil {
+		e.log.Errorf("Failed to encode encryption public key: %v", err)
+		return
+	}
+	e.keyPEM = lo.ToPtr(string(keyPEM))
+	e.resolved = true
+}
@@ -3,6 +3,9 @@ package identity
 import (
 	"context"
 	"crypto"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
 	"fmt"
 
 	"github.com/flightctl/flightctl/api/core/v1beta1"
@@ -117,6 +120,34 @@ func (f *fileProvider) StoreCertificate(certPEM []byte) error {
 	return f.rw.WriteFile(f.clientCertPath, certPEM, 0600)
 }
 
+// EncryptionPublicKey returns the public key of the management key, which content is encrypted to.
+func (f *fileProvider) EncryptionPublicKey() (crypto.PublicKey, error) {
+	privateKey, err := f.ecdhPrivateKey()
+	if err != nil {
+		return nil, err
+	}
+	return privateKey.PublicKey(), nil
+}
+
+func (f *fileProvider) DecryptContent(content string) ([]byte, error) {
+	privateKey, err := f.ecdhPrivateKey()
+	if err != nil {
+		return nil, err
+	}
+	return fccrypto.DecryptJWE(content, privateKey.ECDH)
+}
+
+func (f *fileProvider) ecdhPrivateKey() (*ecdh.PrivateKey, error) {
+	if f.privateKey == nil {
+		return nil, ErrNotInitialized
+	}
+	ecdsaKey, ok := f.privateKey.(*ecdsa.PrivateKey)
+	if !ok || ecdsaKey.Curve != elliptic.P256() {
+		return nil, fmt.Errorf("%w: encryption requires a P-256 management key", ErrUnsupportedEncryption)
+	}
+	return ecdsaKey.ECDH()
+}
+
 func (f *fileProvider) HasCertificate() bool {
 	return hasCertificate(f.rw, f.clientCertPath, f.log)
 }
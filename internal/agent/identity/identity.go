@@ -29,6 +29,8 @@ var (
 	ErrInvalidProvider = errors.New("invalid provider type")
 	// ErrIdentityProofFailed indicates a failure to prove the identity of the device
 	ErrIdentityProofFailed = errors.New("identity proof failed")
+	// ErrUnsupportedEncryption indicates the identity cannot decrypt content encrypted to the device
+	ErrUnsupportedEncryption = errors.New("encryption not supported")
 )
 
 type Exportable struct {
@@ -92,6 +94,10 @@ type Provider interface {
 	WipeCredentials() error
 	// WipeCertificateOnly securely removes only the certificate (not keys or CSR)
 	WipeCertificateOnly() error
+	// EncryptionPublicKey returns the public key that the service encrypts sensitive content for this device to
+	EncryptionPublicKey() (crypto.PublicKey, error)
+	// DecryptContent decrypts content that the service encrypted to this device
+	DecryptContent(content string) ([]byte, error)
 }
 
 // NewProvider creates an identity provider
@@ -11,6 +11,7 @@ package identity
 
 import (
 	context "context"
+	crypto "crypto"
 	reflect "reflect"
 
 	v1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
@@ -111,6 +112,36 @@ func (mr *MockProviderMockRecorder) CreateManagementClient(config, metricsCallba
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateManagementClient", reflect.TypeOf((*MockProvider)(nil).CreateManagementClient), config, metricsCallback)
 }
 
+// DecryptContent mocks base method.
+func (m *MockProvider) DecryptContent(content string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DecryptContent", content)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DecryptContent indicates an expected call of DecryptContent.
+func (mr *MockProviderMockRecorder) DecryptContent(content any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecryptContent", reflect.TypeOf((*MockProvider)(nil).DecryptContent), content)
+}
+
+// EncryptionPublicKey mocks base method.
+func (m *MockProvider) EncryptionPublicKey() (crypto.PublicKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EncryptionPublicKey")
+	ret0, _ := ret[0].(crypto.PublicKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EncryptionPublicKey indicates an expected call of EncryptionPublicKey.
+func (mr *MockProviderMockRecorder) EncryptionPublicKey() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EncryptionPublicKey", reflect.TypeOf((*MockProvider)(nil).EncryptionPublicKey))
+}
+
 // GenerateCSR mocks base method.
 func (m *MockProvider) GenerateCSR(deviceName string) ([]byte, error) {
 	m.ctrl.T.Helper()
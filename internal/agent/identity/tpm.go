@@ -2,6 +2,7 @@ package identity
 
 import (
 	"context"
+	"crypto"
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/pem"
@@ -20,6 +21,7 @@ import (
 	agent_client "github.com/flightctl/flightctl/internal/api/client/agent"
 	base_client "github.com/flightctl/flightctl/internal/client"
 	"github.com/flightctl/flightctl/internal/tpm"
+	fccrypto "github.com/flightctl/flightctl/pkg/crypto"
 	"github.com/flightctl/flightctl/pkg/log"
 	"github.com/samber/lo"
 	"google.golang.org/grpc"
@@ -126,6 +128,15 @@ func (t *tpmProvider) GenerateCSR(deviceName string) ([]byte, error) {
 	return t.client.MakeCSR(deviceName, qualifyingData)
 }
 
+// EncryptionPublicKey returns the public key of the TPM-bound encryption key, which content is encrypted to.
+func (t *tpmProvider) EncryptionPublicKey() (crypto.PublicKey, error) {
+	return t.client.EncryptionPublicKey()
+}
+
+func (t *tpmProvider) DecryptContent(content string) ([]byte, error) {
+	return fccrypto.DecryptJWE(content, t.client.ECDH)
+}
+
 // isTPMVerificationNeeded checks if TPM verification is necessary for the enrollment request
 func (t *tpmProvider) isTPMVerificationNeeded(enrollmentRequest *v1beta1.EnrollmentRequest) bool {
 	if enrollmentRequest.Status != nil {
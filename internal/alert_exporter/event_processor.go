@@ -243,6 +243,18 @@ func getListEventsParams(newerThan string) domain.ListEventsParams {
 		domain.EventReasonDeviceDiskCritical,
 		domain.EventReasonDeviceDiskNormal,
 		domain.EventReasonDeviceDiskWarning,
+		domain.EventReasonDeviceInodeCritical,
+		domain.EventReasonDeviceInodeNormal,
+		domain.EventReasonDeviceInodeWarning,
+		domain.EventReasonDeviceTemperatureCritical,
+		domain.EventReasonDeviceTemperatureNormal,
+		domain.EventReasonDeviceTemperatureWarning,
+		domain.EventReasonDeviceNetworkCritical,
+		domain.EventReasonDeviceNetworkNormal,
+		domain.EventReasonDeviceNetworkWarning,
+		domain.EventReasonDeviceApplicationUsageCritical,
+		domain.EventReasonDeviceApplicationUsageNormal,
+		domain.EventReasonDeviceApplicationUsageWarning,
 		domain.EventReasonResourceDeleted,
 		domain.EventReasonDeviceDecommissioned,
 	}
@@ -270,6 +282,10 @@ var (
 	cpuGroup       = []string{string(domain.EventReasonDeviceCPUCritical), string(domain.EventReasonDeviceCPUWarning)}
 	memoryGroup    = []string{string(domain.EventReasonDeviceMemoryCritical), string(domain.EventReasonDeviceMemoryWarning)}
 	diskGroup      = []string{string(domain.EventReasonDeviceDiskCritical), string(domain.EventReasonDeviceDiskWarning)}
+	inodeGroup     = []string{string(domain.EventReasonDeviceInodeCritical), string(domain.EventReasonDeviceInodeWarning)}
+	thermalGroup   = []string{string(domain.EventReasonDeviceTemperatureCritical), string(domain.EventReasonDeviceTemperatureWarning)}
+	networkGroup   = []string{string(domain.EventReasonDeviceNetworkCritical), string(domain.EventReasonDeviceNetworkWarning)}
+	appUsageGroup  = []string{string(domain.EventReasonDeviceApplicationUsageCritical), string(domain.EventReasonDeviceApplicationUsageWarning)}
 )
 
 func (c *CheckpointContext) processEvent(event domain.Event, orgID uuid.UUID) {
@@ -304,6 +320,34 @@ func (c *CheckpointContext) processEvent(event domain.Event, orgID uuid.UUID) {
 		c.setAlert(event, string(domain.EventReasonDeviceDiskWarning), diskGroup, orgID)
 	case domain.EventReasonDeviceDiskNormal:
 		c.clearAlertGroup(event, diskGroup, orgID)
+	// Inodes
+	case domain.EventReasonDeviceInodeCritical:
+		c.setAlert(event, string(domain.EventReasonDeviceInodeCritical), inodeGroup, orgID)
+	case domain.EventReasonDeviceInodeWarning:
+		c.setAlert(event, string(domain.EventReasonDeviceInodeWarning), inodeGroup, orgID)
+	case domain.EventReasonDeviceInodeNormal:
+		c.clearAlertGroup(event, inodeGroup, orgID)
+	// Temperature
+	case domain.EventReasonDeviceTemperatureCritical:
+		c.setAlert(event, string(domain.EventReasonDeviceTemperatureCritical), thermalGroup, orgID)
+	case domain.EventReasonDeviceTemperatureWarning:
+		c.setAlert(event, string(domain.EventReasonDeviceTemperatureWarning), thermalGroup, orgID)
+	case domain.EventReasonDeviceTemperatureNormal:
+		c.clearAlertGroup(event, thermalGroup, orgID)
+	// Network
+	case domain.EventReasonDeviceNetworkCritical:
+		c.setAlert(event, string(domain.EventReasonDeviceNetworkCritical), networkGroup, orgID)
+	case domain.EventReasonDeviceNetworkWarning:
+		c.setAlert(event, string(domain.EventReasonDeviceNetworkWarning), networkGroup, orgID)
+	case domain.EventReasonDeviceNetworkNormal:
+		c.clearAlertGroup(event, networkGroup, orgID)
+	// Application resource usage
+	case domain.EventReasonDeviceApplicationUsageCritical:
+		c.setAlert(event, string(domain.EventReasonDeviceApplicationUsageCritical), appUsageGroup, orgID)
+	case domain.EventReasonDeviceApplicationUsageWarning:
+		c.setAlert(event, string(domain.EventReasonDeviceApplicationUsageWarning), appUsageGroup, orgID)
+	case domain.EventReasonDeviceApplicationUsageNormal:
+		c.clearAlertGroup(event, appUsageGroup, orgID)
 	// Device connection status
 	case domain.EventReasonDeviceDisconnected:
 		c.setAlert(event, string(domain.EventReasonDeviceDisconnected), nil, orgID)
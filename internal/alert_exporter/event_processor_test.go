@@ -123,6 +123,25 @@ func TestProcessEvent_AppHealthy(t *testing.T) {
 	}
 }
 
+func TestProcessEvent_Temperature(t *testing.T) {
+	testOrgID := uuid.MustParse("11111111-1111-1111-1111-111111111111")
+	critical := fakeEvent("org", "Device", "dev1", string(domain.EventReasonDeviceTemperatureCritical))
+	key := AlertKeyFromEvent(critical, testOrgID)
+	checkpointCtx := &CheckpointContext{
+		alerts: make(map[AlertKey]map[string]*AlertInfo),
+	}
+
+	checkpointCtx.processEvent(critical, testOrgID)
+	if checkpointCtx.alerts[key][string(domain.EventReasonDeviceTemperatureCritical)].EndsAt != nil {
+		t.Errorf("expected DeviceTemperatureCritical to be set")
+	}
+
+	checkpointCtx.processEvent(fakeEvent("org", "Device", "dev1", string(domain.EventReasonDeviceTemperatureNormal)), testOrgID)
+	if checkpointCtx.alerts[key][string(domain.EventReasonDeviceTemperatureCritical)].EndsAt == nil {
+		t.Errorf("expected DeviceTemperatureCritical to be resolved")
+	}
+}
+
 func TestProcessEvent_Connected(t *testing.T) {
 	testOrgID := uuid.MustParse("11111111-1111-1111-1111-111111111111")
 	event := fakeEvent("org", "Device", "dev1", string(domain.EventReasonDeviceConnected))
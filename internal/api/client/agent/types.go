@@ -0,0 +1,9 @@
+package client
+
+import (
+	v1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
+)
+
+// ReplaceDeviceAttestationJSONRequestBody defines body for ReplaceDeviceAttestation for application/json ContentType.
+// The operation only exists in the agent API, so the alias is not generated into the core types.
+type ReplaceDeviceAttestationJSONRequestBody = v1beta1.AttestationReport
@@ -11,6 +11,7 @@ type CommonConverter interface {
 	PatchRequestToDomain(apiv1beta1.PatchRequest) domain.PatchRequest
 	StatusFromDomain(domain.Status) apiv1beta1.Status
 	LabelListFromDomain(*domain.LabelList) *apiv1beta1.LabelList
+	WatchEventFromDomain(domain.WatchEvent) apiv1beta1.WatchEvent
 
 	// Params conversions
 	ListLabelsParamsToDomain(apiv1beta1.ListLabelsParams) domain.ListLabelsParams
@@ -42,6 +43,10 @@ func (c *commonConverter) LabelListFromDomain(l *domain.LabelList) *apiv1beta1.L
 	return l
 }
 
+func (c *commonConverter) WatchEventFromDomain(e domain.WatchEvent) apiv1beta1.WatchEvent {
+	return e
+}
+
 func (c *commonConverter) ListLabelsParamsToDomain(p apiv1beta1.ListLabelsParams) domain.ListLabelsParams {
 	return p
 }
@@ -9,6 +9,10 @@ type Converter interface {
 	CertificateSigningRequest() CertificateSigningRequestConverter
 	AuthProvider() AuthProviderConverter
 	ResourceSync() ResourceSyncConverter
+	ReferenceMeasurement() ReferenceMeasurementConverter
+	EnrollmentPolicy() EnrollmentPolicyConverter
+	Secret() SecretConverter
+	TrustPolicy() TrustPolicyConverter
 	TemplateVersion() TemplateVersionConverter
 	Event() EventConverter
 	Organization() OrganizationConverter
@@ -24,6 +28,10 @@ type converterImpl struct {
 	certificateSigningRequest CertificateSigningRequestConverter
 	authProvider              AuthProviderConverter
 	resourceSync              ResourceSyncConverter
+	referenceMeasurement      ReferenceMeasurementConverter
+	enrollmentPolicy          EnrollmentPolicyConverter
+	secret                    SecretConverter
+	trustPolicy               TrustPolicyConverter
 	templateVersion           TemplateVersionConverter
 	event                     EventConverter
 	organization              OrganizationConverter
@@ -41,6 +49,10 @@ func NewConverter() Converter {
 		certificateSigningRequest: NewCertificateSigningRequestConverter(),
 		authProvider:              NewAuthProviderConverter(),
 		resourceSync:              NewResourceSyncConverter(),
+		referenceMeasurement:      NewReferenceMeasurementConverter(),
+		enrollmentPolicy:          NewEnrollmentPolicyConverter(),
+		secret:                    NewSecretConverter(),
+		trustPolicy:               NewTrustPolicyConverter(),
 		templateVersion:           NewTemplateVersionConverter(),
 		event:                     NewEventConverter(),
 		organization:              NewOrganizationConverter(),
@@ -77,6 +89,22 @@ func (c *converterImpl) ResourceSync() ResourceSyncConverter {
 	return c.resourceSync
 }
 
+func (c *converterImpl) ReferenceMeasurement() ReferenceMeasurementConverter {
+	return c.referenceMeasurement
+}
+
+func (c *converterImpl) EnrollmentPolicy() EnrollmentPolicyConverter {
+	return c.enrollmentPolicy
+}
+
+func (c *converterImpl) Secret() SecretConverter {
+	return c.secret
+}
+
+func (c *converterImpl) TrustPolicy() TrustPolicyConverter {
+	return c.trustPolicy
+}
+
 func (c *converterImpl) TemplateVersion() TemplateVersionConverter {
 	return c.templateVersion
 }
@@ -14,9 +14,14 @@ type DeviceConverter interface {
 
 	// Operation types
 	DecommissionToDomain(apiv1beta1.DeviceDecommission) domain.DeviceDecommission
+	RevocationToDomain(apiv1beta1.DeviceRevocation) domain.DeviceRevocation
+	RevocationResultFromDomain(*domain.DeviceRevocationResult) *apiv1beta1.DeviceRevocationResult
 	ResumeRequestToDomain(apiv1beta1.DeviceResumeRequest) domain.DeviceResumeRequest
 	ResumeResponseFromDomain(domain.DeviceResumeResponse) apiv1beta1.DeviceResumeResponse
 	LastSeenFromDomain(*domain.DeviceLastSeen) *apiv1beta1.DeviceLastSeen
+	AttestationChallengeFromDomain(*domain.AttestationChallenge) *apiv1beta1.AttestationChallenge
+	AttestationReportToDomain(apiv1beta1.AttestationReport) domain.AttestationReport
+	IntegrityStatusFromDomain(*domain.DeviceIntegrityStatus) *apiv1beta1.DeviceIntegrityStatus
 
 	// Params conversions
 	ListParamsToDomain(apiv1beta1.ListDevicesParams) domain.ListDevicesParams
@@ -46,6 +51,14 @@ func (c *deviceConverter) DecommissionToDomain(d apiv1beta1.DeviceDecommission)
 	return d
 }
 
+func (c *deviceConverter) RevocationToDomain(r apiv1beta1.DeviceRevocation) domain.DeviceRevocation {
+	return r
+}
+
+func (c *deviceConverter) RevocationResultFromDomain(r *domain.DeviceRevocationResult) *apiv1beta1.DeviceRevocationResult {
+	return r
+}
+
 func (c *deviceConverter) ResumeRequestToDomain(r apiv1beta1.DeviceResumeRequest) domain.DeviceResumeRequest {
 	return r
 }
@@ -58,6 +71,18 @@ func (c *deviceConverter) LastSeenFromDomain(l *domain.DeviceLastSeen) *apiv1bet
 	return l
 }
 
+func (c *deviceConverter) AttestationChallengeFromDomain(ch *domain.AttestationChallenge) *apiv1beta1.AttestationChallenge {
+	return ch
+}
+
+func (c *deviceConverter) AttestationReportToDomain(r apiv1beta1.AttestationReport) domain.AttestationReport {
+	return r
+}
+
+func (c *deviceConverter) IntegrityStatusFromDomain(s *domain.DeviceIntegrityStatus) *apiv1beta1.DeviceIntegrityStatus {
+	return s
+}
+
 func (c *deviceConverter) ListParamsToDomain(p apiv1beta1.ListDevicesParams) domain.ListDevicesParams {
 	return p
 }
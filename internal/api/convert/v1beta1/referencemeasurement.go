@@ -0,0 +1,39 @@
+package v1beta1
+
+import (
+	apiv1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/domain"
+)
+
+// ReferenceMeasurementConverter converts between v1beta1 API types and domain types for ReferenceMeasurement resources.
+type ReferenceMeasurementConverter interface {
+	ToDomain(apiv1beta1.ReferenceMeasurement) domain.ReferenceMeasurement
+	FromDomain(*domain.ReferenceMeasurement) *apiv1beta1.ReferenceMeasurement
+	ListFromDomain(*domain.ReferenceMeasurementList) *apiv1beta1.ReferenceMeasurementList
+
+	// Params conversions
+	ListParamsToDomain(apiv1beta1.ListReferenceMeasurementsParams) domain.ListReferenceMeasurementsParams
+}
+
+type referenceMeasurementConverter struct{}
+
+// NewReferenceMeasurementConverter creates a new ReferenceMeasurementConverter.
+func NewReferenceMeasurementConverter() ReferenceMeasurementConverter {
+	return &referenceMeasurementConverter{}
+}
+
+func (c *referenceMeasurementConverter) ToDomain(rm apiv1beta1.ReferenceMeasurement) domain.ReferenceMeasurement {
+	return rm
+}
+
+func (c *referenceMeasurementConverter) FromDomain(rm *domain.ReferenceMeasurement) *apiv1beta1.ReferenceMeasurement {
+	return rm
+}
+
+func (c *referenceMeasurementConverter) ListFromDomain(l *domain.ReferenceMeasurementList) *apiv1beta1.ReferenceMeasurementList {
+	return l
+}
+
+func (c *referenceMeasurementConverter) ListParamsToDomain(p apiv1beta1.ListReferenceMeasurementsParams) domain.ListReferenceMeasurementsParams {
+	return p
+}
@@ -10,9 +10,12 @@ type ResourceSyncConverter interface {
 	ToDomain(apiv1beta1.ResourceSync) domain.ResourceSync
 	FromDomain(*domain.ResourceSync) *apiv1beta1.ResourceSync
 	ListFromDomain(*domain.ResourceSyncList) *apiv1beta1.ResourceSyncList
+	PreviewFromDomain(*domain.ResourceSyncPreview) *apiv1beta1.ResourceSyncPreview
+	PreviewRequestFromDomain(*domain.ResourceSyncPreviewRequest) *apiv1beta1.ResourceSyncPreviewRequest
 
 	// Params conversions
 	ListParamsToDomain(apiv1beta1.ListResourceSyncsParams) domain.ListResourceSyncsParams
+	PreviewParamsToDomain(apiv1beta1.RequestResourceSyncPreviewParams) domain.RequestResourceSyncPreviewParams
 }
 
 type resourceSyncConverter struct{}
@@ -37,3 +40,15 @@ func (c *resourceSyncConverter) ListFromDomain(l *domain.ResourceSyncList) *apiv
 func (c *resourceSyncConverter) ListParamsToDomain(p apiv1beta1.ListResourceSyncsParams) domain.ListResourceSyncsParams {
 	return p
 }
+
+func (c *resourceSyncConverter) PreviewFromDomain(p *domain.ResourceSyncPreview) *apiv1beta1.ResourceSyncPreview {
+	return p
+}
+
+func (c *resourceSyncConverter) PreviewRequestFromDomain(r *domain.ResourceSyncPreviewRequest) *apiv1beta1.ResourceSyncPreviewRequest {
+	return r
+}
+
+func (c *resourceSyncConverter) PreviewParamsToDomain(p apiv1beta1.RequestResourceSyncPreviewParams) domain.RequestResourceSyncPreviewParams {
+	return p
+}
@@ -0,0 +1,39 @@
+package v1beta1
+
+import (
+	apiv1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/domain"
+)
+
+// SecretConverter converts between v1beta1 API types and domain types for Secret resources.
+type SecretConverter interface {
+	ToDomain(apiv1beta1.Secret) domain.Secret
+	FromDomain(*domain.Secret) *apiv1beta1.Secret
+	ListFromDomain(*domain.SecretList) *apiv1beta1.SecretList
+
+	// Params conversions
+	ListParamsToDomain(apiv1beta1.ListSecretsParams) domain.ListSecretsParams
+}
+
+type secretConverter struct{}
+
+// NewSecretConverter creates a new SecretConverter.
+func NewSecretConverter() SecretConverter {
+	return &secretConverter{}
+}
+
+func (c *secretConverter) ToDomain(secret apiv1beta1.Secret) domain.Secret {
+	return secret
+}
+
+func (c *secretConverter) FromDomain(secret *domain.Secret) *apiv1beta1.Secret {
+	return secret
+}
+
+func (c *secretConverter) ListFromDomain(l *domain.SecretList) *apiv1beta1.SecretList {
+	return l
+}
+
+func (c *secretConverter) ListParamsToDomain(p apiv1beta1.ListSecretsParams) domain.ListSecretsParams {
+	return p
+}
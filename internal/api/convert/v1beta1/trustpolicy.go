@@ -0,0 +1,39 @@
+package v1beta1
+
+import (
+	apiv1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/domain"
+)
+
+// TrustPolicyConverter converts between v1beta1 API types and domain types for TrustPolicy resources.
+type TrustPolicyConverter interface {
+	ToDomain(apiv1beta1.TrustPolicy) domain.TrustPolicy
+	FromDomain(*domain.TrustPolicy) *apiv1beta1.TrustPolicy
+	ListFromDomain(*domain.TrustPolicyList) *apiv1beta1.TrustPolicyList
+
+	// Params conversions
+	ListParamsToDomain(apiv1beta1.ListTrustPoliciesParams) domain.ListTrustPoliciesParams
+}
+
+type trustPolicyConverter struct{}
+
+// NewTrustPolicyConverter creates a new TrustPolicyConverter.
+func NewTrustPolicyConverter() TrustPolicyConverter {
+	return &trustPolicyConverter{}
+}
+
+func (c *trustPolicyConverter) ToDomain(trustPolicy apiv1beta1.TrustPolicy) domain.TrustPolicy {
+	return trustPolicy
+}
+
+func (c *trustPolicyConverter) FromDomain(trustPolicy *domain.TrustPolicy) *apiv1beta1.TrustPolicy {
+	return trustPolicy
+}
+
+func (c *trustPolicyConverter) ListFromDomain(l *domain.TrustPolicyList) *apiv1beta1.TrustPolicyList {
+	return l
+}
+
+func (c *trustPolicyConverter) ListParamsToDomain(p apiv1beta1.ListTrustPoliciesParams) domain.ListTrustPoliciesParams {
+	return p
+}
@@ -19,7 +19,9 @@ const (
 	API_RESOURCE_DEVICES_LASTSEEN = "devices/lastseen"
 	API_RESOURCE_DEVICES_RENDERED = "devices/rendered"
 	API_RESOURCE_DEVICES_RESUME = "devices/resume"
+	API_RESOURCE_DEVICES_REVOCATION = "devices/revocation"
 	API_RESOURCE_DEVICES_STATUS = "devices/status"
+	API_RESOURCE_ENROLLMENTPOLICIES = "enrollmentpolicies"
 	API_RESOURCE_ENROLLMENTREQUESTS = "enrollmentrequests"
 	API_RESOURCE_ENROLLMENTREQUESTS_APPROVAL = "enrollmentrequests/approval"
 	API_RESOURCE_ENROLLMENTREQUESTS_STATUS = "enrollmentrequests/status"
@@ -29,8 +31,12 @@ const (
 	API_RESOURCE_FLEETS_TEMPLATEVERSIONS = "fleets/templateversions"
 	API_RESOURCE_LABELS = "labels"
 	API_RESOURCE_ORGANIZATIONS = "organizations"
+	API_RESOURCE_REFERENCEMEASUREMENTS = "referencemeasurements"
 	API_RESOURCE_REPOSITORIES = "repositories"
 	API_RESOURCE_RESOURCESYNCS = "resourcesyncs"
+	API_RESOURCE_RESOURCESYNCS_PREVIEW = "resourcesyncs/preview"
+	API_RESOURCE_SECRETS = "secrets"
+	API_RESOURCE_TRUSTPOLICIES = "trustpolicies"
 )
 const (
 	API_ACTION_CREATE = "create"
@@ -406,6 +412,14 @@ var APIMetadataMap = map[string]*apimetadata.EndpointMetadata{
 			{Version: "v1beta1", DeprecatedAt: nil},
 		},
 	},
+	"PUT:/devices/{name}/revocation": {
+		OperationID: "revokeDeviceCertificates",
+		Resource:    "devices/revocation",
+		Action:      "update",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
 	"GET:/devices/{name}/status": {
 		OperationID: "getDeviceStatus",
 		Resource:    "devices/status",
@@ -438,6 +452,54 @@ var APIMetadataMap = map[string]*apimetadata.EndpointMetadata{
 			{Version: "v1beta1", DeprecatedAt: nil},
 		},
 	},
+	"GET:/enrollmentpolicies": {
+		OperationID: "listEnrollmentPolicies",
+		Resource:    "enrollmentpolicies",
+		Action:      "list",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"POST:/enrollmentpolicies": {
+		OperationID: "createEnrollmentPolicy",
+		Resource:    "enrollmentpolicies",
+		Action:      "create",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"DELETE:/enrollmentpolicies/{name}": {
+		OperationID: "deleteEnrollmentPolicy",
+		Resource:    "enrollmentpolicies",
+		Action:      "delete",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"GET:/enrollmentpolicies/{name}": {
+		OperationID: "getEnrollmentPolicy",
+		Resource:    "enrollmentpolicies",
+		Action:      "get",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"PATCH:/enrollmentpolicies/{name}": {
+		OperationID: "patchEnrollmentPolicy",
+		Resource:    "enrollmentpolicies",
+		Action:      "patch",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"PUT:/enrollmentpolicies/{name}": {
+		OperationID: "replaceEnrollmentPolicy",
+		Resource:    "enrollmentpolicies",
+		Action:      "update",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
 	"GET:/enrollmentrequests": {
 		OperationID: "listEnrollmentRequests",
 		Resource:    "enrollmentrequests",
@@ -638,6 +700,54 @@ var APIMetadataMap = map[string]*apimetadata.EndpointMetadata{
 			{Version: "v1beta1", DeprecatedAt: nil},
 		},
 	},
+	"GET:/referencemeasurements": {
+		OperationID: "listReferenceMeasurements",
+		Resource:    "referencemeasurements",
+		Action:      "list",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"POST:/referencemeasurements": {
+		OperationID: "createReferenceMeasurement",
+		Resource:    "referencemeasurements",
+		Action:      "create",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"DELETE:/referencemeasurements/{name}": {
+		OperationID: "deleteReferenceMeasurement",
+		Resource:    "referencemeasurements",
+		Action:      "delete",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"GET:/referencemeasurements/{name}": {
+		OperationID: "getReferenceMeasurement",
+		Resource:    "referencemeasurements",
+		Action:      "get",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"PATCH:/referencemeasurements/{name}": {
+		OperationID: "patchReferenceMeasurement",
+		Resource:    "referencemeasurements",
+		Action:      "patch",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"PUT:/referencemeasurements/{name}": {
+		OperationID: "replaceReferenceMeasurement",
+		Resource:    "referencemeasurements",
+		Action:      "update",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
 	"GET:/repositories": {
 		OperationID: "listRepositories",
 		Resource:    "repositories",
@@ -734,6 +844,102 @@ var APIMetadataMap = map[string]*apimetadata.EndpointMetadata{
 			{Version: "v1beta1", DeprecatedAt: nil},
 		},
 	},
+	"POST:/resourcesyncs/{name}/preview": {
+		OperationID: "requestResourceSyncPreview",
+		Resource:    "resourcesyncs/preview",
+		Action:      "create",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"GET:/resourcesyncs/{name}/preview/{requestId}": {
+		OperationID: "getResourceSyncPreview",
+		Resource:    "resourcesyncs/preview",
+		Action:      "get",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"GET:/secrets": {
+		OperationID: "listSecrets",
+		Resource:    "secrets",
+		Action:      "list",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"POST:/secrets": {
+		OperationID: "createSecret",
+		Resource:    "secrets",
+		Action:      "create",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"DELETE:/secrets/{name}": {
+		OperationID: "deleteSecret",
+		Resource:    "secrets",
+		Action:      "delete",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"GET:/secrets/{name}": {
+		OperationID: "getSecret",
+		Resource:    "secrets",
+		Action:      "get",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"PUT:/secrets/{name}": {
+		OperationID: "replaceSecret",
+		Resource:    "secrets",
+		Action:      "update",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"GET:/trustpolicies": {
+		OperationID: "listTrustPolicies",
+		Resource:    "trustpolicies",
+		Action:      "list",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"POST:/trustpolicies": {
+		OperationID: "createTrustPolicy",
+		Resource:    "trustpolicies",
+		Action:      "create",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"DELETE:/trustpolicies/{name}": {
+		OperationID: "deleteTrustPolicy",
+		Resource:    "trustpolicies",
+		Action:      "delete",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"GET:/trustpolicies/{name}": {
+		OperationID: "getTrustPolicy",
+		Resource:    "trustpolicies",
+		Action:      "get",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"PUT:/trustpolicies/{name}": {
+		OperationID: "replaceTrustPolicy",
+		Resource:    "trustpolicies",
+		Action:      "update",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
 	"GET:/version": {
 		OperationID: "getVersion",
 		Resource:    "",
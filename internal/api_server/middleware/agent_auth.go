@@ -3,6 +3,7 @@ package middleware
 import (
 	"context"
 	"crypto/tls"
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
@@ -63,6 +64,25 @@ func (m *AgentAuthMiddleware) AuthenticateAgent(next http.Handler) http.Handler
 			return
 		}
 
+		// Check revocation before the cache, so a revoked certificate is rejected on its next request
+		if len(r.TLS.PeerCertificates) > 0 {
+			revoked, err := m.ca.IsRevoked(ctx, r.TLS.PeerCertificates[0])
+			if errors.Is(err, crypto.ErrRevocationListUnavailable) {
+				// without a revocation list a revoked certificate cannot be told apart from a valid one
+				m.log.Errorf("Failed to check certificate revocation: %v", err)
+				transport.WriteJSONError(w, r, "certificate revocation status is unavailable", http.StatusServiceUnavailable)
+				return
+			}
+			if err != nil {
+				m.log.Warnf("Failed to refresh certificate revocation list, using the last known list: %v", err)
+			}
+			if revoked {
+				m.log.Warnf("Rejecting revoked agent certificate: serial=%x", r.TLS.PeerCertificates[0].SerialNumber)
+				transport.WriteJSONError(w, r, "certificate has been revoked", http.StatusUnauthorized)
+				return
+			}
+		}
+
 		// Create cache key from certificate fingerprint
 		cacheKey := m.createCacheKey(r.TLS)
 		if cacheKey != "" {
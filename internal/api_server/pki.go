@@ -0,0 +1,78 @@
+package apiserver
+
+import (
+	"encoding/base64"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/flightctl/flightctl/internal/crypto"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// CRLPath is where the DER encoded revocation list of the CA is published
+	CRLPath = "/pki/crl"
+	// OCSPPath is where the OCSP responder of the CA is served
+	OCSPPath = "/pki/ocsp"
+
+	// an OCSP request for a single certificate is well below this size
+	maxOCSPRequestSize = 4096
+)
+
+// CRLHandler returns an HTTP handler that serves the current revocation list of the CA,
+// so that TLS terminators in front of the service can reject revoked device certificates.
+func CRLHandler(ca *crypto.CAClient, log logrus.FieldLogger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		crl, err := ca.CreateCRL(r.Context())
+		if err != nil {
+			log.Errorf("Failed to create certificate revocation list: %v", err)
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/pkix-crl")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(crl)
+	})
+}
+
+// OCSPHandler returns an HTTP handler implementing the OCSP responder of the CA (RFC 6960).
+// Requests are accepted both as POST bodies and as base64 encoded GET path segments.
+func OCSPHandler(ca *crypto.CAClient, log logrus.FieldLogger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request []byte
+		var err error
+		switch r.Method {
+		case http.MethodPost:
+			if r.Header.Get("Content-Type") != "application/ocsp-request" {
+				w.WriteHeader(http.StatusUnsupportedMediaType)
+				return
+			}
+			request, err = io.ReadAll(io.LimitReader(r.Body, maxOCSPRequestSize))
+		case http.MethodGet:
+			var encoded string
+			encoded, err = url.PathUnescape(strings.TrimPrefix(r.URL.EscapedPath(), OCSPPath+"/"))
+			if err == nil {
+				request, err = base64.StdEncoding.DecodeString(encoded)
+			}
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		response, err := ca.CreateOCSPResponse(r.Context(), request)
+		if err != nil {
+			log.Errorf("Failed to create OCSP response: %v", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/ocsp-response")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(response)
+	})
+}
@@ -227,7 +227,7 @@ func (s *Server) Run(ctx context.Context) error {
 	// Create v1beta1 transport handler
 	handlerV1Beta1 := transportv1beta1.NewTransportHandler(
 		serviceHandler, convertv1beta1.NewConverter(),
-		s.authN, authTokenProxy, authUserInfoProxy, s.authZ,
+		s.authN, authTokenProxy, authUserInfoProxy, s.authZ, s.log,
 	)
 
 	// Create v1beta1 router with OpenAPI validation
@@ -348,6 +348,28 @@ func (s *Server) Run(ctx context.Context) error {
 		}
 	})
 
+	// certificate revocation endpoints: public so that relying parties can check device certificates
+	router.Group(func(r chi.Router) {
+		ConfigureRateLimiterFromConfig(
+			r,
+			s.cfg.Service.RateLimit,
+			RateLimitScopeGeneral,
+		)
+		r.Method(http.MethodGet, CRLPath, CRLHandler(s.ca, s.log))
+		r.Method(http.MethodPost, OCSPPath, OCSPHandler(s.ca, s.log))
+		r.Method(http.MethodGet, OCSPPath+"/*", OCSPHandler(s.ca, s.log))
+	})
+
+	// repository webhooks: authenticated with the repository's webhook secret so that Git servers can call them
+	router.Group(func(r chi.Router) {
+		ConfigureRateLimiterFromConfig(
+			r,
+			s.cfg.Service.RateLimit,
+			RateLimitScopeGeneral,
+		)
+		r.Method(http.MethodPost, RepositoryWebhookPath, RepositoryWebhookHandler(serviceHandler, s.log))
+	})
+
 	// ws handling
 	router.Group(func(r chi.Router) {
 		r.Use(fcmiddleware.CreateRouteExistsMiddleware(r))
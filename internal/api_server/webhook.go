@@ -0,0 +1,190 @@
+package apiserver
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/flightctl/flightctl/internal/consts"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/org"
+	"github.com/flightctl/flightctl/internal/service"
+	"github.com/flightctl/flightctl/internal/service/common"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// RepositoryWebhookPath is where Git servers notify the service of pushes to a repository
+	RepositoryWebhookPath = "/webhooks/repositories/{name}"
+
+	// GenericSignatureHeader carries the HMAC-SHA256 signature of generic webhook requests
+	GenericSignatureHeader = "X-Flightctl-Signature-256"
+
+	// push payloads are much smaller than this, but contain the list of pushed commits
+	maxWebhookPayloadSize = 4 << 20
+
+	// the commit hash that Git servers report for deleted references
+	zeroCommitHash = "0000000000000000000000000000000000000000"
+)
+
+var errWebhookUnauthorized = errors.New("webhook request could not be authenticated")
+
+// pushPayload holds the fields of push payloads that are common to GitHub, GitLab, Gitea and generic webhooks.
+type pushPayload struct {
+	Ref   string `json:"ref"`
+	After string `json:"after"`
+}
+
+// RepositoryWebhookHandler returns an HTTP handler that accepts push notifications for a Git repository from
+// GitHub, GitLab, Gitea or any other client that signs its requests like GitHub does. Requests are authenticated
+// with the webhook secret of the repository rather than with user credentials, so that Git servers can call it.
+func RepositoryWebhookHandler(serviceHandler service.Service, log logrus.FieldLogger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		orgId := org.DefaultID
+		if orgParam := r.URL.Query().Get("org_id"); orgParam != "" {
+			var err error
+			if orgId, err = uuid.Parse(orgParam); err != nil {
+				http.Error(w, "invalid org_id", http.StatusBadRequest)
+				return
+			}
+		}
+		name := chi.URLParam(r, "name")
+
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxWebhookPayloadSize))
+		if err != nil {
+			http.Error(w, "failed reading request body", http.StatusBadRequest)
+			return
+		}
+
+		ctx := context.WithValue(r.Context(), consts.EventActorCtxKey, "repository-webhook")
+		secret, err := repositoryWebhookSecret(ctx, serviceHandler, orgId, name)
+		if err != nil {
+			// unknown repositories are not distinguished from bad signatures to not disclose them
+			log.Warnf("Rejected webhook request for repository %s/%s: %v", orgId, name, err)
+			http.Error(w, errWebhookUnauthorized.Error(), http.StatusUnauthorized)
+			return
+		}
+
+		revisions, err := parsePushWebhook(r.Header, body, secret)
+		if errors.Is(err, errWebhookUnauthorized) {
+			log.Warnf("Rejected webhook request for repository %s/%s: invalid signature", orgId, name)
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if revisions == nil {
+			// pings, deleted references and other events have nothing to process
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		log.Infof("Received push to repository %s/%s for revisions %v", orgId, name, revisions)
+		serviceHandler.CreateEvent(ctx, orgId, common.GetRepositoryPushReceivedEvent(ctx, name, revisions))
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
+
+func repositoryWebhookSecret(ctx context.Context, serviceHandler service.Service, orgId uuid.UUID, name string) (string, error) {
+	repository, status := serviceHandler.GetRepository(ctx, orgId, name)
+	if err := service.ApiStatusToErr(status); err != nil {
+		return "", err
+	}
+	if specType, err := repository.Spec.Discriminator(); err != nil || specType != string(domain.RepoSpecTypeGit) {
+		return "", errors.New("not a Git repository")
+	}
+	gitSpec, err := repository.Spec.AsGitRepoSpec()
+	if err != nil {
+		return "", err
+	}
+	if gitSpec.Webhook == nil || gitSpec.Webhook.Secret == "" {
+		return "", errors.New("repository has no webhook secret")
+	}
+	return gitSpec.Webhook.Secret, nil
+}
+
+// parsePushWebhook authenticates the webhook request and returns the revisions that it reports as pushed. An empty,
+// non-nil list means that any revision may have changed. A nil list means that there is nothing to process.
+func parsePushWebhook(header http.Header, body []byte, secret string) ([]string, error) {
+	// Gitea also sends GitHub headers for compatibility, so it has to be checked first
+	switch {
+	case header.Get("X-Gitea-Event") != "":
+		if !validHMACSignature(header.Get("X-Gitea-Signature"), body, secret) {
+			return nil, errWebhookUnauthorized
+		}
+		if header.Get("X-Gitea-Event") != "push" {
+			return nil, nil
+		}
+	case header.Get("X-GitHub-Event") != "":
+		if !validHMACSignature(strings.TrimPrefix(header.Get("X-Hub-Signature-256"), "sha256="), body, secret) {
+			return nil, errWebhookUnauthorized
+		}
+		if header.Get("X-GitHub-Event") != "push" {
+			return nil, nil
+		}
+	case header.Get("X-Gitlab-Event") != "":
+		// GitLab sends the secret token itself instead of a signature
+		if subtle.ConstantTimeCompare([]byte(header.Get("X-Gitlab-Token")), []byte(secret)) != 1 {
+			return nil, errWebhookUnauthorized
+		}
+		if event := header.Get("X-Gitlab-Event"); event != "Push Hook" && event != "Tag Push Hook" {
+			return nil, nil
+		}
+	default:
+		if !validHMACSignature(strings.TrimPrefix(header.Get(GenericSignatureHeader), "sha256="), body, secret) {
+			return nil, errWebhookUnauthorized
+		}
+		// a generic request without payload asks to refresh all revisions
+		if len(body) == 0 {
+			return []string{}, nil
+		}
+	}
+
+	var payload pushPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, fmt.Errorf("invalid push payload: %w", err)
+	}
+	if payload.After == zeroCommitHash {
+		return nil, nil
+	}
+	revisions := []string{}
+	if payload.Ref != "" {
+		revisions = append(revisions, payload.Ref)
+		for _, prefix := range []string{"refs/heads/", "refs/tags/"} {
+			if short, ok := strings.CutPrefix(payload.Ref, prefix); ok {
+				revisions = append(revisions, short)
+			}
+		}
+	}
+	if payload.After != "" {
+		revisions = append(revisions, payload.After)
+	}
+	return revisions, nil
+}
+
+func validHMACSignature(signature string, body []byte, secret string) bool {
+	expected, err := hex.DecodeString(signature)
+	if err != nil || len(expected) == 0 {
+		return false
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hmac.Equal(mac.Sum(nil), expected)
+}
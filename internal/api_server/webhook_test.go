@@ -0,0 +1,118 @@
+package apiserver
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePushWebhook(t *testing.T) {
+	const secret = "0123456789abcdef"
+	const commit = "8f2c3a1b9d4e5f60718293a4b5c6d7e8f9012345"
+	pushBody := []byte(`{"ref":"refs/heads/main","after":"` + commit + `","repository":{"clone_url":"https://example.com/repo.git"}}`)
+
+	sign := func(body []byte, key string) string {
+		mac := hmac.New(sha256.New, []byte(key))
+		mac.Write(body)
+		return hex.EncodeToString(mac.Sum(nil))
+	}
+	headers := func(kv ...string) http.Header {
+		header := http.Header{}
+		for i := 0; i < len(kv); i += 2 {
+			header.Set(kv[i], kv[i+1])
+		}
+		return header
+	}
+	pushedMain := []string{"refs/heads/main", "main", commit}
+
+	tests := []struct {
+		name              string
+		header            http.Header
+		body              []byte
+		expectedRevisions []string
+		expectedErr       error
+	}{
+		{
+			name:              "GitHub push",
+			header:            headers("X-GitHub-Event", "push", "X-Hub-Signature-256", "sha256="+sign(pushBody, secret)),
+			body:              pushBody,
+			expectedRevisions: pushedMain,
+		},
+		{
+			name:        "GitHub push with wrong signature",
+			header:      headers("X-GitHub-Event", "push", "X-Hub-Signature-256", "sha256="+sign(pushBody, "another secret value")),
+			body:        pushBody,
+			expectedErr: errWebhookUnauthorized,
+		},
+		{
+			name:        "GitHub push without signature",
+			header:      headers("X-GitHub-Event", "push"),
+			body:        pushBody,
+			expectedErr: errWebhookUnauthorized,
+		},
+		{
+			name:              "GitHub ping",
+			header:            headers("X-GitHub-Event", "ping", "X-Hub-Signature-256", "sha256="+sign([]byte(`{}`), secret)),
+			body:              []byte(`{}`),
+			expectedRevisions: nil,
+		},
+		{
+			name:              "Gitea push",
+			header:            headers("X-Gitea-Event", "push", "X-Gitea-Signature", sign(pushBody, secret), "X-GitHub-Event", "push"),
+			body:              pushBody,
+			expectedRevisions: pushedMain,
+		},
+		{
+			name:              "GitLab tag push",
+			header:            headers("X-Gitlab-Event", "Tag Push Hook", "X-Gitlab-Token", secret),
+			body:              []byte(`{"ref":"refs/tags/v1.0","after":"` + commit + `"}`),
+			expectedRevisions: []string{"refs/tags/v1.0", "v1.0", commit},
+		},
+		{
+			name:        "GitLab push with wrong token",
+			header:      headers("X-Gitlab-Event", "Push Hook", "X-Gitlab-Token", "wrong"),
+			body:        pushBody,
+			expectedErr: errWebhookUnauthorized,
+		},
+		{
+			name:              "deleted branch",
+			header:            headers("X-GitHub-Event", "push", "X-Hub-Signature-256", "sha256="+sign([]byte(`{"ref":"refs/heads/old","after":"`+zeroCommitHash+`"}`), secret)),
+			body:              []byte(`{"ref":"refs/heads/old","after":"` + zeroCommitHash + `"}`),
+			expectedRevisions: nil,
+		},
+		{
+			name:              "generic push",
+			header:            headers(GenericSignatureHeader, "sha256="+sign([]byte(`{"ref":"stable"}`), secret)),
+			body:              []byte(`{"ref":"stable"}`),
+			expectedRevisions: []string{"stable"},
+		},
+		{
+			name:              "generic refresh of all revisions",
+			header:            headers(GenericSignatureHeader, "sha256="+sign(nil, secret)),
+			body:              nil,
+			expectedRevisions: []string{},
+		},
+		{
+			name:        "unsigned generic request",
+			header:      headers(),
+			body:        pushBody,
+			expectedErr: errWebhookUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			revisions, err := parsePushWebhook(tt.header, tt.body, secret)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedRevisions, revisions)
+		})
+	}
+}
@@ -33,17 +33,26 @@ var resourcePermissions = map[string]map[string][]string{
 		"resourcesyncs":         {"get", "list", "create", "update", "patch", "delete"},
 		"repositories":          {"get", "list", "create", "update", "patch", "delete"},
 		"catalogs":              {"get", "list", "create", "update", "patch", "delete"},
+		"secrets":               {"get", "list", "create", "update", "delete"},
 		"catalogitems":          {"get", "list", "create", "update", "patch", "delete"},
 		"imagebuilds":           {"get", "list", "create", "update", "patch", "delete"},
 		"imagebuilds/cancel":    {"create"},
 		"imageexports":          {"get", "list", "create", "update", "patch", "delete"},
 		"imageexports/cancel":   {"create"},
 		"imageexports/download": {"get"},
+		"devices/portforward":   {"get"},
+		"devices/copy":          {"get"},
+		"devices/logs":          {"get"},
+		"resourcesyncs/preview": {"get", "create"},
 		"*":                     {"get", "list"}, // Default read access for other resources
 	},
 	v1beta1.RoleViewer: {
 		"*":                     {"get", "list"}, // Default read access to all resources
 		"imageexports/download": {},              // Explicitly denied - empty list overrides wildcard
+		"devices/portforward":   {},              // Explicitly denied - reaches services on the device
+		"devices/copy":          {},              // Explicitly denied - reads and writes files on the device
+		"devices/logs":          {},              // Explicitly denied - reads the journal of any unit on the device
+		"resourcesyncs/preview": {},              // Explicitly denied - clones the repository at any revision
 	},
 	v1beta1.RoleInstaller: {
 		"enrollmentrequests":          {"get", "list"},
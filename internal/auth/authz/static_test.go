@@ -125,6 +125,20 @@ func TestStaticAuthZ_CheckPermission(t *testing.T) {
 			op:       "delete",
 			expected: true,
 		},
+		{
+			name:     "operator can create secrets",
+			roles:    []string{v1beta1.RoleOperator},
+			resource: "secrets",
+			op:       "create",
+			expected: true,
+		},
+		{
+			name:     "operator cannot patch secrets",
+			roles:    []string{v1beta1.RoleOperator},
+			resource: "secrets",
+			op:       "patch",
+			expected: false,
+		},
 		{
 			name:     "viewer can list any resource",
 			roles:    []string{v1beta1.RoleViewer},
@@ -216,6 +230,76 @@ func TestStaticAuthZ_CheckPermission(t *testing.T) {
 			op:       "get",
 			expected: true,
 		},
+		{
+			name:     "operator can port-forward to devices",
+			roles:    []string{v1beta1.RoleOperator},
+			resource: "devices/portforward",
+			op:       "get",
+			expected: true,
+		},
+		{
+			name:     "operator can copy files to and from devices",
+			roles:    []string{v1beta1.RoleOperator},
+			resource: "devices/copy",
+			op:       "get",
+			expected: true,
+		},
+		{
+			name:     "operator can read device logs",
+			roles:    []string{v1beta1.RoleOperator},
+			resource: "devices/logs",
+			op:       "get",
+			expected: true,
+		},
+		{
+			name:     "viewer cannot read device logs",
+			roles:    []string{v1beta1.RoleViewer},
+			resource: "devices/logs",
+			op:       "get",
+			expected: false,
+		},
+		{
+			name:     "installer cannot read device logs",
+			roles:    []string{v1beta1.RoleInstaller},
+			resource: "devices/logs",
+			op:       "get",
+			expected: false,
+		},
+		{
+			name:     "operator can request resourcesync previews",
+			roles:    []string{v1beta1.RoleOperator},
+			resource: "resourcesyncs/preview",
+			op:       "create",
+			expected: true,
+		},
+		{
+			name:     "viewer cannot request resourcesync previews",
+			roles:    []string{v1beta1.RoleViewer},
+			resource: "resourcesyncs/preview",
+			op:       "create",
+			expected: false,
+		},
+		{
+			name:     "viewer cannot read resourcesync previews",
+			roles:    []string{v1beta1.RoleViewer},
+			resource: "resourcesyncs/preview",
+			op:       "get",
+			expected: false,
+		},
+		{
+			name:     "viewer cannot copy files to and from devices",
+			roles:    []string{v1beta1.RoleViewer},
+			resource: "devices/copy",
+			op:       "get",
+			expected: false,
+		},
+		{
+			name:     "viewer cannot port-forward to devices",
+			roles:    []string{v1beta1.RoleViewer},
+			resource: "devices/portforward",
+			op:       "get",
+			expected: false,
+		},
 		{
 			name:     "viewer can list imagebuilds",
 			roles:    []string{v1beta1.RoleViewer},
@@ -345,10 +429,26 @@ func TestStaticAuthZ_GetUserPermissions(t *testing.T) {
 					Resource:   "catalogs",
 					Operations: []string{"create", "delete", "get", "list", "patch", "update"},
 				},
+				{
+					Resource:   "secrets",
+					Operations: []string{"create", "delete", "get", "list", "update"},
+				},
 				{
 					Resource:   "devices",
 					Operations: []string{"create", "delete", "get", "list", "patch", "update"},
 				},
+				{
+					Resource:   "devices/copy",
+					Operations: []string{"get"},
+				},
+				{
+					Resource:   "devices/logs",
+					Operations: []string{"get"},
+				},
+				{
+					Resource:   "devices/portforward",
+					Operations: []string{"get"},
+				},
 				{
 					Resource:   "fleets",
 					Operations: []string{"create", "delete", "get", "list", "patch", "update"},
@@ -381,6 +481,10 @@ func TestStaticAuthZ_GetUserPermissions(t *testing.T) {
 					Resource:   "resourcesyncs",
 					Operations: []string{"create", "delete", "get", "list", "patch", "update"},
 				},
+				{
+					Resource:   "resourcesyncs/preview",
+					Operations: []string{"create", "get"},
+				},
 			},
 		},
 		{
@@ -391,10 +495,26 @@ func TestStaticAuthZ_GetUserPermissions(t *testing.T) {
 					Resource:   "*",
 					Operations: []string{"get", "list"},
 				},
+				{
+					Resource:   "devices/copy",
+					Operations: []string{}, // Explicitly denied
+				},
+				{
+					Resource:   "devices/logs",
+					Operations: []string{}, // Explicitly denied
+				},
+				{
+					Resource:   "devices/portforward",
+					Operations: []string{}, // Explicitly denied
+				},
 				{
 					Resource:   "imageexports/download",
 					Operations: []string{}, // Explicitly denied
 				},
+				{
+					Resource:   "resourcesyncs/preview",
+					Operations: []string{}, // Explicitly denied
+				},
 			},
 		},
 		{
@@ -443,6 +563,18 @@ func TestStaticAuthZ_GetUserPermissions(t *testing.T) {
 					Resource:   "certificatesigningrequests",
 					Operations: []string{"create", "get", "list", "update"},
 				},
+				{
+					Resource:   "devices/copy",
+					Operations: []string{}, // Explicitly denied by viewer
+				},
+				{
+					Resource:   "devices/logs",
+					Operations: []string{}, // Explicitly denied by viewer
+				},
+				{
+					Resource:   "devices/portforward",
+					Operations: []string{}, // Explicitly denied by viewer
+				},
 				{
 					Resource:   "enrollmentrequests",
 					Operations: []string{"get", "list"},
@@ -467,6 +599,10 @@ func TestStaticAuthZ_GetUserPermissions(t *testing.T) {
 					Resource:   "organizations",
 					Operations: []string{"get", "list"},
 				},
+				{
+					Resource:   "resourcesyncs/preview",
+					Operations: []string{}, // Explicitly denied by viewer
+				},
 			},
 		},
 	}
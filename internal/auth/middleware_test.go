@@ -93,6 +93,36 @@ var requests []TestRequest = []TestRequest{
 		resource: "devices/console",
 		op:       "get",
 	},
+	{
+		url:      "wss://fctl.io/ws/v1/devices/foo/portforward",
+		method:   http.MethodGet,
+		resource: "devices/portforward",
+		op:       "get",
+	},
+	{
+		url:      "wss://fctl.io/ws/v1/devices/foo/copy",
+		method:   http.MethodGet,
+		resource: "devices/copy",
+		op:       "get",
+	},
+	{
+		url:      "wss://fctl.io/ws/v1/devices/foo/logs",
+		method:   http.MethodGet,
+		resource: "devices/logs",
+		op:       "get",
+	},
+	{
+		url:      "https://fctl.io/api/v1/resourcesyncs/foo/preview",
+		method:   http.MethodPost,
+		resource: "resourcesyncs/preview",
+		op:       "create",
+	},
+	{
+		url:      "https://fctl.io/api/v1/resourcesyncs/foo/preview/bar",
+		method:   http.MethodGet,
+		resource: "resourcesyncs/preview",
+		op:       "get",
+	},
 	{
 		url:      "https://fctl.io/api/v1/fleets/foo/templateVersions/bar",
 		method:   http.MethodGet,
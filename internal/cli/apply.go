@@ -311,6 +311,18 @@ func applyResourceByKind(ctx context.Context, c *client.Client, ibClient *client
 	case AuthProviderKind:
 		response, err := c.ReplaceAuthProviderWithBodyWithResponse(ctx, resourceName, "application/json", bytes.NewReader(buf))
 		return extractApplyResult(response, err)
+	case ReferenceMeasurementKind:
+		response, err := c.ReplaceReferenceMeasurementWithBodyWithResponse(ctx, resourceName, "application/json", bytes.NewReader(buf))
+		return extractApplyResult(response, err)
+	case EnrollmentPolicyKind:
+		response, err := c.ReplaceEnrollmentPolicyWithBodyWithResponse(ctx, resourceName, "application/json", bytes.NewReader(buf))
+		return extractApplyResult(response, err)
+	case SecretKind:
+		response, err := c.ReplaceSecretWithBodyWithResponse(ctx, resourceName, "application/json", bytes.NewReader(buf))
+		return extractApplyResult(response, err)
+	case TrustPolicyKind:
+		response, err := c.ReplaceTrustPolicyWithBodyWithResponse(ctx, resourceName, "application/json", bytes.NewReader(buf))
+		return extractApplyResult(response, err)
 	case ImageBuildKind:
 		if ibClient == nil {
 			return applyResult{err: fmt.Errorf("imagebuilder service is not configured. Please configure 'imageBuilderService.server' in your client config")}
@@ -363,6 +375,14 @@ func extractApplyResult(response interface{}, err error) applyResult {
 		return applyResult{httpResponse: r.HTTPResponse, message: string(r.Body)}
 	case *apiclient.ReplaceAuthProviderResponse:
 		return applyResult{httpResponse: r.HTTPResponse, message: string(r.Body)}
+	case *apiclient.ReplaceReferenceMeasurementResponse:
+		return applyResult{httpResponse: r.HTTPResponse, message: string(r.Body)}
+	case *apiclient.ReplaceEnrollmentPolicyResponse:
+		return applyResult{httpResponse: r.HTTPResponse, message: string(r.Body)}
+	case *apiclient.ReplaceSecretResponse:
+		return applyResult{httpResponse: r.HTTPResponse, message: string(r.Body)}
+	case *apiclient.ReplaceTrustPolicyResponse:
+		return applyResult{httpResponse: r.HTTPResponse, message: string(r.Body)}
 	case *apiclientv1alpha1.ReplaceCatalogResponse:
 		return applyResult{httpResponse: r.HTTPResponse, message: string(r.Body)}
 	case *apiclientv1alpha1.ReplaceCatalogItemResponse:
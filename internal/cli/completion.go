@@ -297,6 +297,42 @@ func (kna *KindNameAutocomplete) getAutocompleteNames(cmd *cobra.Command, o Clie
 					}
 				}
 			}
+		case ReferenceMeasurementKind:
+			resp, err := c.ListReferenceMeasurementsWithResponse(context.Background(), &api.ListReferenceMeasurementsParams{})
+			if err == nil && resp.JSON200 != nil {
+				for _, er := range resp.JSON200.Items {
+					if er.Metadata.Name != nil {
+						names = append(names, *er.Metadata.Name)
+					}
+				}
+			}
+		case EnrollmentPolicyKind:
+			resp, err := c.ListEnrollmentPoliciesWithResponse(context.Background(), &api.ListEnrollmentPoliciesParams{})
+			if err == nil && resp.JSON200 != nil {
+				for _, er := range resp.JSON200.Items {
+					if er.Metadata.Name != nil {
+						names = append(names, *er.Metadata.Name)
+					}
+				}
+			}
+		case SecretKind:
+			resp, err := c.ListSecretsWithResponse(context.Background(), &api.ListSecretsParams{})
+			if err == nil && resp.JSON200 != nil {
+				for _, secret := range resp.JSON200.Items {
+					if secret.Metadata.Name != nil {
+						names = append(names, *secret.Metadata.Name)
+					}
+				}
+			}
+		case TrustPolicyKind:
+			resp, err := c.ListTrustPoliciesWithResponse(context.Background(), &api.ListTrustPoliciesParams{})
+			if err == nil && resp.JSON200 != nil {
+				for _, tp := range resp.JSON200.Items {
+					if tp.Metadata.Name != nil {
+						names = append(names, *tp.Metadata.Name)
+					}
+				}
+			}
 		case TemplateVersionKind:
 			if kna.FleetName != nil {
 				resp, err := c.ListTemplateVersionsWithResponse(context.Background(), *kna.FleetName, &api.ListTemplateVersionsParams{})
@@ -0,0 +1,393 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/flightctl/flightctl/internal/client"
+	"github.com/flightctl/flightctl/internal/console/filetransfer"
+	"github.com/gorilla/websocket"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const defaultCopyRetries = 3
+
+type CopyOptions struct {
+	GlobalOptions
+	Retries int
+}
+
+// copyLocation is a local path, or a path on a device if deviceName is set.
+type copyLocation struct {
+	deviceName string
+	path       string
+}
+
+func (l copyLocation) isRemote() bool {
+	return l.deviceName != ""
+}
+
+// transferError is a failure reported by the agent, which resuming the transfer does not resolve.
+type transferError struct {
+	message string
+}
+
+func (e *transferError) Error() string {
+	return e.message
+}
+
+func DefaultCopyOptions() *CopyOptions {
+	return &CopyOptions{
+		GlobalOptions: DefaultGlobalOptions(),
+		Retries:       defaultCopyRetries,
+	}
+}
+
+func NewCmdCopy() *cobra.Command {
+	o := DefaultCopyOptions()
+	cmd := &cobra.Command{
+		Use:   "cp SOURCE DESTINATION",
+		Short: "Copy a file to or from a device.",
+		Long: "Copy a file to or from a device through the server. Interrupted transfers are resumed automatically, " +
+			"or when the same copy is run again, and the content is verified with a checksum.",
+		Example: `  # Copy a file from a device to the current directory
+  flightctl cp device/my-device:/var/log/messages .
+
+  # Copy a local file to a directory on a device
+  flightctl cp ./app.conf device/my-device:/etc/app/`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.Complete(cmd, args); err != nil {
+				return err
+			}
+			if err := o.Validate(args); err != nil {
+				return err
+			}
+			return o.Run(cmd.Context(), args)
+		},
+		SilenceUsage: true,
+	}
+	o.Bind(cmd.Flags())
+	return cmd
+}
+
+func (o *CopyOptions) Bind(fs *pflag.FlagSet) {
+	o.GlobalOptions.Bind(fs)
+	fs.IntVar(&o.Retries, "retries", o.Retries, "Number of times an interrupted transfer is resumed before giving up.")
+}
+
+func (o *CopyOptions) Complete(cmd *cobra.Command, args []string) error {
+	return o.GlobalOptions.Complete(cmd, args)
+}
+
+func (o *CopyOptions) Validate(args []string) error {
+	if err := o.GlobalOptions.Validate(args); err != nil {
+		return err
+	}
+	if o.Retries < 0 {
+		return fmt.Errorf("--retries must not be negative")
+	}
+	_, _, err := parseCopyLocations(args[0], args[1])
+	return err
+}
+
+// parseCopyLocation parses "device/NAME:PATH" as a path on a device and anything else as a local path.
+func parseCopyLocation(arg string) (copyLocation, error) {
+	resource, path, found := strings.Cut(arg, ":")
+	if !found || !strings.Contains(resource, "/") {
+		return copyLocation{path: arg}, nil
+	}
+	kind, name, err := parseAndValidateKindName(resource)
+	if err != nil {
+		// not a resource, so this is a local path that happens to contain a colon
+		return copyLocation{path: arg}, nil //nolint:nilerr
+	}
+	if kind != DeviceKind {
+		return copyLocation{}, fmt.Errorf("only devices support copying files")
+	}
+	if name == "" {
+		return copyLocation{}, fmt.Errorf("device name is required")
+	}
+	if !filepath.IsAbs(path) {
+		return copyLocation{}, fmt.Errorf("path %q on device %s must be absolute", path, name)
+	}
+	return copyLocation{deviceName: name, path: path}, nil
+}
+
+func parseCopyLocations(srcArg, dstArg string) (copyLocation, copyLocation, error) {
+	src, err := parseCopyLocation(srcArg)
+	if err != nil {
+		return copyLocation{}, copyLocation{}, err
+	}
+	dst, err := parseCopyLocation(dstArg)
+	if err != nil {
+		return copyLocation{}, copyLocation{}, err
+	}
+	if src.isRemote() == dst.isRemote() {
+		return copyLocation{}, copyLocation{}, fmt.Errorf("exactly one of SOURCE and DESTINATION must be of the form 'device/NAME:PATH'")
+	}
+	return src, dst, nil
+}
+
+func (o *CopyOptions) Run(ctx context.Context, args []string) error {
+	config, err := client.ParseConfigFile(o.ConfigFilePath)
+	if err != nil {
+		return fmt.Errorf("parsing config file: %w", err)
+	}
+	src, dst, err := parseCopyLocations(args[0], args[1])
+	if err != nil {
+		return err
+	}
+
+	refresher := client.NewAccessTokenRefresher(config, o.ConfigFilePath, 8080)
+	refresher.Start(ctx)
+
+	for attempt := 0; ; attempt++ {
+		err = o.copyOnce(ctx, config, refresher.GetAccessToken(), src, dst)
+		var terr *transferError
+		if err == nil || errors.As(err, &terr) || ctx.Err() != nil || attempt >= o.Retries {
+			return err
+		}
+		fmt.Fprintf(os.Stderr, "Transfer interrupted: %v. Resuming (attempt %d of %d)\n", err, attempt+1, o.Retries)
+	}
+}
+
+func (o *CopyOptions) copyOnce(ctx context.Context, config *client.Config, token string, src, dst copyLocation) error {
+	deviceName := remoteLocation(src, dst).deviceName
+	conn, err := o.dialDeviceSession(ctx, config, deviceName, "copy", filetransfer.ProtocolV1Name, token)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			_ = conn.Close()
+		case <-done:
+		}
+	}()
+
+	c := &fileTransferClient{ws: conn}
+	if src.isRemote() {
+		return c.download(src.path, dst.path)
+	}
+	return c.upload(src.path, dst.path)
+}
+
+// remoteLocation returns the location on the device.
+func remoteLocation(src, dst copyLocation) copyLocation {
+	if src.isRemote() {
+		return src
+	}
+	return dst
+}
+
+// fileTransferClient copies a single file over a websocket connection to the device.
+type fileTransferClient struct {
+	ws *websocket.Conn
+}
+
+func (c *fileTransferClient) send(m filetransfer.Message) error {
+	return c.ws.WriteMessage(websocket.BinaryMessage, m.Encode())
+}
+
+func (c *fileTransferClient) sendJSON(t filetransfer.MessageType, v any) error {
+	m, err := filetransfer.NewMessage(t, v)
+	if err != nil {
+		return err
+	}
+	return c.send(m)
+}
+
+// recv returns the next message from the device. Errors reported by the agent are returned as transferError.
+func (c *fileTransferClient) recv() (filetransfer.Message, error) {
+	for {
+		msgType, msg, err := c.ws.ReadMessage()
+		if err != nil {
+			return filetransfer.Message{}, fmt.Errorf("lost connection to the device: %w", err)
+		}
+		// the server signals the end of the session with an empty message
+		if msgType != websocket.BinaryMessage || len(msg) == 0 {
+			continue
+		}
+		m, err := filetransfer.Decode(msg)
+		if err != nil {
+			return filetransfer.Message{}, err
+		}
+		if m.Type == filetransfer.MessageError {
+			return filetransfer.Message{}, &transferError{message: string(m.Payload)}
+		}
+		return m, nil
+	}
+}
+
+func (c *fileTransferClient) recvJSON(t filetransfer.MessageType, v any) error {
+	m, err := c.recv()
+	if err != nil {
+		return err
+	}
+	if m.Type != t {
+		return &transferError{message: fmt.Sprintf("unexpected message type %d", m.Type)}
+	}
+	return m.Unmarshal(v)
+}
+
+func (c *fileTransferClient) download(remotePath, localPath string) error {
+	if info, err := os.Stat(localPath); err == nil && info.IsDir() {
+		localPath = filepath.Join(localPath, filepath.Base(remotePath))
+	}
+
+	// Continue an earlier, interrupted download of the same file if there is one
+	// The agent restarts from the beginning if the file changed since
+	partPath := localPath + filetransfer.PartialSuffix
+	var offset int64
+	var sum string
+	if source := filetransfer.ReadPartialInfo(partPath); source != nil {
+		offset = filetransfer.ResumeOffset(partPath, *source)
+		sum = source.SHA256
+	}
+	if err := c.sendJSON(filetransfer.MessageRequest, &filetransfer.Request{
+		Operation: filetransfer.OperationDownload,
+		Path:      remotePath,
+		Offset:    offset,
+		SHA256:    sum,
+	}); err != nil {
+		return err
+	}
+	var info filetransfer.Info
+	if err := c.recvJSON(filetransfer.MessageInfo, &info); err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(partPath, os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return &transferError{message: err.Error()}
+	}
+	defer file.Close()
+	if err = file.Truncate(info.Offset); err != nil {
+		return &transferError{message: err.Error()}
+	}
+	if _, err = file.Seek(info.Offset, io.SeekStart); err != nil {
+		return &transferError{message: err.Error()}
+	}
+	if err = filetransfer.WritePartialInfo(partPath, filetransfer.PartialInfo{Size: info.Size, SHA256: info.SHA256}); err != nil {
+		return &transferError{message: err.Error()}
+	}
+
+	for {
+		m, err := c.recv()
+		if err != nil {
+			// keep the partial file, so that the download can be resumed
+			return err
+		}
+		switch m.Type {
+		case filetransfer.MessageData:
+			if _, err = file.Write(m.Payload); err != nil {
+				return &transferError{message: fmt.Sprintf("writing %s: %v", partPath, err)}
+			}
+		case filetransfer.MessageDone:
+			if err = file.Close(); err != nil {
+				return &transferError{message: err.Error()}
+			}
+			return completeDownload(partPath, localPath, &info)
+		default:
+			return &transferError{message: fmt.Sprintf("unexpected message type %d", m.Type)}
+		}
+	}
+}
+
+// completeDownload verifies the received file and moves it to its final location.
+func completeDownload(partPath, localPath string, info *filetransfer.Info) error {
+	sum, err := filetransfer.FileSHA256(partPath)
+	if err != nil {
+		return &transferError{message: err.Error()}
+	}
+	if sum != info.SHA256 {
+		filetransfer.RemovePartial(partPath)
+		return &transferError{message: fmt.Sprintf("checksum mismatch: expected %s, got %s. The file may have changed during the transfer, please retry", info.SHA256, sum)}
+	}
+	if info.Mode != 0 {
+		if err = os.Chmod(partPath, os.FileMode(info.Mode).Perm()); err != nil {
+			return &transferError{message: err.Error()}
+		}
+	}
+	if err = os.Rename(partPath, localPath); err != nil {
+		return &transferError{message: err.Error()}
+	}
+	filetransfer.RemovePartial(partPath)
+	return nil
+}
+
+func (c *fileTransferClient) upload(localPath, remotePath string) error {
+	stat, err := os.Stat(localPath)
+	if err != nil {
+		return &transferError{message: err.Error()}
+	}
+	if !stat.Mode().IsRegular() {
+		return &transferError{message: fmt.Sprintf("%s is not a regular file", localPath)}
+	}
+	sum, err := filetransfer.FileSHA256(localPath)
+	if err != nil {
+		return &transferError{message: err.Error()}
+	}
+
+	if err = c.sendJSON(filetransfer.MessageRequest, &filetransfer.Request{
+		Operation: filetransfer.OperationUpload,
+		Path:      remotePath,
+		Name:      filepath.Base(localPath),
+		Size:      stat.Size(),
+		Mode:      uint32(stat.Mode().Perm()),
+		SHA256:    sum,
+	}); err != nil {
+		return err
+	}
+	var info filetransfer.Info
+	if err = c.recvJSON(filetransfer.MessageInfo, &info); err != nil {
+		return err
+	}
+
+	file, err := os.Open(localPath)
+	if err != nil {
+		return &transferError{message: err.Error()}
+	}
+	defer file.Close()
+	if _, err = file.Seek(info.Offset, io.SeekStart); err != nil {
+		return &transferError{message: err.Error()}
+	}
+	buffer := make([]byte, filetransfer.ChunkSize)
+	for {
+		n, err := file.Read(buffer)
+		if n > 0 {
+			if sendErr := c.send(filetransfer.Message{Type: filetransfer.MessageData, Payload: buffer[:n]}); sendErr != nil {
+				return sendErr
+			}
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return &transferError{message: fmt.Sprintf("reading %s: %v", localPath, err)}
+		}
+	}
+	if err = c.sendJSON(filetransfer.MessageDone, &filetransfer.Done{SHA256: sum}); err != nil {
+		return err
+	}
+
+	var done filetransfer.Done
+	if err = c.recvJSON(filetransfer.MessageDone, &done); err != nil {
+		return err
+	}
+	if done.SHA256 != sum {
+		return &transferError{message: fmt.Sprintf("checksum mismatch: expected %s, got %s", sum, done.SHA256)}
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCopyLocations(t *testing.T) {
+	tests := []struct {
+		name          string
+		src           string
+		dst           string
+		expectedSrc   copyLocation
+		expectedDst   copyLocation
+		errorContains string
+	}{
+		{
+			name:        "download",
+			src:         "device/my-device:/var/log/messages",
+			dst:         ".",
+			expectedSrc: copyLocation{deviceName: "my-device", path: "/var/log/messages"},
+			expectedDst: copyLocation{path: "."},
+		},
+		{
+			name:        "upload",
+			src:         "./app.conf",
+			dst:         "devices/my-device:/etc/app/",
+			expectedSrc: copyLocation{path: "./app.conf"},
+			expectedDst: copyLocation{deviceName: "my-device", path: "/etc/app/"},
+		},
+		{
+			name:        "local path with colon",
+			src:         "dir/file:1",
+			dst:         "device/my-device:/tmp",
+			expectedSrc: copyLocation{path: "dir/file:1"},
+			expectedDst: copyLocation{deviceName: "my-device", path: "/tmp"},
+		},
+		{
+			name:          "both local",
+			src:           "a",
+			dst:           "b",
+			errorContains: "exactly one of SOURCE and DESTINATION",
+		},
+		{
+			name:          "both remote",
+			src:           "device/a:/a",
+			dst:           "device/b:/b",
+			errorContains: "exactly one of SOURCE and DESTINATION",
+		},
+		{
+			name:          "relative remote path",
+			src:           "device/my-device:var/log",
+			dst:           ".",
+			errorContains: "must be absolute",
+		},
+		{
+			name:          "not a device",
+			src:           "fleet/my-fleet:/etc",
+			dst:           ".",
+			errorContains: "only devices",
+		},
+		{
+			name:          "missing device name",
+			src:           "device/:/etc",
+			dst:           ".",
+			errorContains: "device name is required",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src, dst, err := parseCopyLocations(tt.src, tt.dst)
+			if tt.errorContains != "" {
+				require.ErrorContains(t, err, tt.errorContains)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedSrc, src)
+			require.Equal(t, tt.expectedDst, dst)
+		})
+	}
+}
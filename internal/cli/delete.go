@@ -273,6 +273,14 @@ func (o *DeleteOptions) deleteOne(ctx context.Context, c *client.Client, kind Re
 		response, err = c.DeleteCertificateSigningRequestWithResponse(ctx, name)
 	case AuthProviderKind:
 		response, err = c.DeleteAuthProviderWithResponse(ctx, name)
+	case ReferenceMeasurementKind:
+		response, err = c.DeleteReferenceMeasurementWithResponse(ctx, name)
+	case EnrollmentPolicyKind:
+		response, err = c.DeleteEnrollmentPolicyWithResponse(ctx, name)
+	case SecretKind:
+		response, err = c.DeleteSecretWithResponse(ctx, name)
+	case TrustPolicyKind:
+		response, err = c.DeleteTrustPolicyWithResponse(ctx, name)
 	case CatalogKind:
 		response, err = c.V1Alpha1().DeleteCatalogWithResponse(ctx, name)
 	case CatalogItemKind:
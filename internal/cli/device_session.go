@@ -0,0 +1,61 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	api "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/client"
+	"github.com/gorilla/websocket"
+)
+
+// dialDeviceSession opens a websocket session with the device through the server's endpoint for the given session
+// type, negotiating the given protocol with the agent.
+func (o *GlobalOptions) dialDeviceSession(ctx context.Context, config *client.Config, deviceName, endpoint, protocol, token string) (*websocket.Conn, error) {
+	u, err := url.Parse(fmt.Sprintf("%s/ws/v1/devices/%s/%s", config.Service.Server, deviceName, endpoint))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse server URL %q: %w", config.Service.Server, err)
+	}
+	switch u.Scheme {
+	case "https":
+		u.Scheme = "wss"
+	case "http":
+		u.Scheme = "ws"
+	}
+	metadata, err := json.Marshal(&api.DeviceConsoleSessionMetadata{})
+	if err != nil {
+		return nil, err
+	}
+	query := url.Values{}
+	query.Set(api.DeviceQueryConsoleSessionMetadata, string(metadata))
+	query.Set(api.OrganizationIDQueryKey, o.GetEffectiveOrganization())
+	u.RawQuery = query.Encode()
+
+	tlsConfig, err := client.CreateTLSConfigFromConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	dialer := websocket.Dialer{
+		TLSClientConfig: tlsConfig,
+		Subprotocols:    []string{protocol},
+	}
+	header := http.Header{}
+	if token != "" {
+		header.Set("Authorization", "Bearer "+token)
+	}
+	conn, resp, err := dialer.DialContext(ctx, u.String(), header)
+	if err != nil {
+		if resp != nil {
+			defer resp.Body.Close()
+			body, _ := io.ReadAll(resp.Body)
+			return nil, fmt.Errorf("error for device %s (%s): %s", deviceName, resp.Status, strings.TrimSpace(string(body)))
+		}
+		return nil, fmt.Errorf("connecting to device %s: %w", deviceName, err)
+	}
+	return conn, nil
+}
@@ -81,6 +81,14 @@ func (f *TableFormatter) formatList(w *tabwriter.Writer, data interface{}, optio
 		return f.printEventsTable(w, data.(*apiclient.ListEventsResponse).JSON200.Items...)
 	case strings.EqualFold(options.Kind, api.AuthProviderKind):
 		return f.printAuthProvidersTable(w, data.(*apiclient.ListAuthProvidersResponse).JSON200.Items...)
+	case strings.EqualFold(options.Kind, api.ReferenceMeasurementKind):
+		return f.printReferenceMeasurementsTable(w, data.(*apiclient.ListReferenceMeasurementsResponse).JSON200.Items...)
+	case strings.EqualFold(options.Kind, api.EnrollmentPolicyKind):
+		return f.printEnrollmentPoliciesTable(w, data.(*apiclient.ListEnrollmentPoliciesResponse).JSON200.Items...)
+	case strings.EqualFold(options.Kind, api.SecretKind):
+		return f.printSecretsTable(w, data.(*apiclient.ListSecretsResponse).JSON200.Items...)
+	case strings.EqualFold(options.Kind, api.TrustPolicyKind):
+		return f.printTrustPoliciesTable(w, data.(*apiclient.ListTrustPoliciesResponse).JSON200.Items...)
 	case strings.EqualFold(options.Kind, string(imagebuilderapi.ResourceKindImageBuild)):
 		return f.printImageBuildsTable(w, options.WithExports, data.(*imagebuilderclient.ListImageBuildsResponse).JSON200.Items...)
 	case strings.EqualFold(options.Kind, string(imagebuilderapi.ResourceKindImageExport)):
@@ -141,6 +149,14 @@ func (f *TableFormatter) formatSingle(w *tabwriter.Writer, data interface{}, opt
 		return f.printCSRTable(w, *data.(*apiclient.GetCertificateSigningRequestResponse).JSON200)
 	case strings.EqualFold(options.Kind, api.AuthProviderKind):
 		return f.printAuthProvidersTable(w, *data.(*apiclient.GetAuthProviderResponse).JSON200)
+	case strings.EqualFold(options.Kind, api.ReferenceMeasurementKind):
+		return f.printReferenceMeasurementsTable(w, *data.(*apiclient.GetReferenceMeasurementResponse).JSON200)
+	case strings.EqualFold(options.Kind, api.EnrollmentPolicyKind):
+		return f.printEnrollmentPoliciesTable(w, *data.(*apiclient.GetEnrollmentPolicyResponse).JSON200)
+	case strings.EqualFold(options.Kind, api.SecretKind):
+		return f.printSecretsTable(w, *data.(*apiclient.GetSecretResponse).JSON200)
+	case strings.EqualFold(options.Kind, api.TrustPolicyKind):
+		return f.printTrustPoliciesTable(w, *data.(*apiclient.GetTrustPolicyResponse).JSON200)
 	case strings.EqualFold(options.Kind, string(imagebuilderapi.ResourceKindImageBuild)):
 		return f.printImageBuildsTable(w, options.WithExports, *data.(*imagebuilderclient.GetImageBuildResponse).JSON200)
 	case strings.EqualFold(options.Kind, string(imagebuilderapi.ResourceKindImageExport)):
@@ -526,6 +542,96 @@ func (f *TableFormatter) printAuthConfigProvidersTable(w *tabwriter.Writer, auth
 	return nil
 }
 
+func (f *TableFormatter) printReferenceMeasurementsTable(w *tabwriter.Writer, referenceMeasurements ...api.ReferenceMeasurement) error {
+	f.printHeaderRowLn(w, "NAME", "OS IMAGE", "PCRS", "QUARANTINE")
+	for _, rm := range referenceMeasurements {
+		pcrs := make([]string, 0, len(rm.Spec.Pcrs))
+		for _, pcr := range rm.Spec.Pcrs {
+			pcrs = append(pcrs, fmt.Sprintf("%d", pcr.Index))
+		}
+		f.printTableRowLn(w,
+			*rm.Metadata.Name,
+			rm.Spec.OsImage,
+			strings.Join(pcrs, ","),
+			util.BoolToStr(util.DefaultBoolIfNil(rm.Spec.QuarantineOnFailure, false), "true", "false"),
+		)
+	}
+	return nil
+}
+
+func (f *TableFormatter) printEnrollmentPoliciesTable(w *tabwriter.Writer, enrollmentPolicies ...api.EnrollmentPolicy) error {
+	f.printHeaderRowLn(w, "NAME", "RULES", "FLEET", "LABELS")
+	for _, ep := range enrollmentPolicies {
+		rules := []string{}
+		if util.DefaultBoolIfNil(ep.Spec.Match.TpmVerified, false) {
+			rules = append(rules, "tpmVerified")
+		}
+		if ep.Spec.Match.EnrollmentCertificates != nil {
+			rules = append(rules, "enrollmentCertificates")
+		}
+		if ep.Spec.Match.AllowList != nil {
+			rules = append(rules, "allowList")
+		}
+		if ep.Spec.Match.SystemInfo != nil {
+			rules = append(rules, "systemInfo")
+		}
+		fleet := NoneString
+		labels := NoneString
+		if ep.Spec.Approval != nil {
+			if ep.Spec.Approval.Fleet != nil {
+				fleet = *ep.Spec.Approval.Fleet
+			}
+			if ep.Spec.Approval.Labels != nil && len(*ep.Spec.Approval.Labels) > 0 {
+				labels = strings.Join(util.LabelMapToArray(ep.Spec.Approval.Labels), ",")
+			}
+		}
+		f.printTableRowLn(w,
+			*ep.Metadata.Name,
+			strings.Join(rules, ","),
+			fleet,
+			labels,
+		)
+	}
+	return nil
+}
+
+func (f *TableFormatter) printSecretsTable(w *tabwriter.Writer, secrets ...api.Secret) error {
+	f.printHeaderRowLn(w, "NAME", "DATA", "AGE")
+	for _, secret := range secrets {
+		age := NoneString
+		if secret.Metadata.CreationTimestamp != nil {
+			age = time.Since(*secret.Metadata.CreationTimestamp).Round(time.Second).String()
+		}
+		f.printTableRowLn(w,
+			*secret.Metadata.Name,
+			fmt.Sprintf("%d", len(secret.Spec.Data)),
+			age,
+		)
+	}
+	return nil
+}
+
+func (f *TableFormatter) printTrustPoliciesTable(w *tabwriter.Writer, trustPolicies ...api.TrustPolicy) error {
+	f.printHeaderRowLn(w, "NAME", "DEFAULT", "SCOPES", "AGE")
+	for _, tp := range trustPolicies {
+		age := NoneString
+		if tp.Metadata.CreationTimestamp != nil {
+			age = time.Since(*tp.Metadata.CreationTimestamp).Round(time.Second).String()
+		}
+		scopes := 0
+		if tp.Spec.Scopes != nil {
+			scopes = len(*tp.Spec.Scopes)
+		}
+		f.printTableRowLn(w,
+			*tp.Metadata.Name,
+			string(tp.Spec.Default.Type),
+			fmt.Sprintf("%d", scopes),
+			age,
+		)
+	}
+	return nil
+}
+
 func (f *TableFormatter) printAuthProvidersTable(w *tabwriter.Writer, authProviders ...api.AuthProvider) error {
 	f.printHeaderRowLn(w, "NAME", "TYPE", "ISSUER", "CLIENT ID", "ENABLED")
 	for _, ap := range authProviders {
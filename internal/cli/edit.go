@@ -146,7 +146,7 @@ func (o *EditOptions) Validate(args []string) error {
 
 	// Check if resource type supports editing
 	switch kind {
-	case DeviceKind, FleetKind, RepositoryKind, CertificateSigningRequestKind, AuthProviderKind:
+	case DeviceKind, FleetKind, RepositoryKind, CertificateSigningRequestKind, AuthProviderKind, ReferenceMeasurementKind, EnrollmentPolicyKind:
 		// These are supported for editing
 	default:
 		return errEditNotAllowed{kind}
@@ -490,6 +490,12 @@ func (o *EditOptions) executePatchOperation(ctx context.Context, client *apiclie
 	case AuthProviderKind:
 		response, err := client.PatchAuthProviderWithBodyWithResponse(ctx, name, contentType, reader)
 		return o.extractResponseData(response, err)
+	case ReferenceMeasurementKind:
+		response, err := client.PatchReferenceMeasurementWithBodyWithResponse(ctx, name, contentType, reader)
+		return o.extractResponseData(response, err)
+	case EnrollmentPolicyKind:
+		response, err := client.PatchEnrollmentPolicyWithBodyWithResponse(ctx, name, contentType, reader)
+		return o.extractResponseData(response, err)
 	case EnrollmentRequestKind:
 		response, err := client.PatchEnrollmentRequestWithBodyWithResponse(ctx, name, contentType, reader)
 		return o.extractResponseData(response, err)
@@ -0,0 +1,333 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	apiv1alpha1 "github.com/flightctl/flightctl/api/core/v1alpha1"
+	api "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/api/versioning"
+	"github.com/flightctl/flightctl/internal/client"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"sigs.k8s.io/yaml"
+)
+
+// exportedKinds lists the kinds that are exported, in the order in which they have to be imported so that
+// resources are created after the resources that they reference.
+var exportedKinds = []ResourceKind{
+	RepositoryKind,
+	AuthProviderKind,
+	TrustPolicyKind,
+	CatalogKind,
+	CatalogItemKind,
+	FleetKind,
+	ResourceSyncKind,
+}
+
+// apiKinds maps the exported kinds to the kind names used in manifests.
+var apiKinds = map[ResourceKind]string{
+	RepositoryKind:   api.RepositoryKind,
+	AuthProviderKind: api.AuthProviderKind,
+	TrustPolicyKind:  api.TrustPolicyKind,
+	CatalogKind:      apiv1alpha1.CatalogKind,
+	CatalogItemKind:  apiv1alpha1.CatalogItemKind,
+	FleetKind:        api.FleetKind,
+	ResourceSyncKind: api.ResourceSyncKind,
+	DeviceKind:       api.DeviceKind,
+}
+
+// managedAnnotationPrefixes are the prefixes of annotations that are set by the service and therefore not exported.
+var managedAnnotationPrefixes = []string{
+	"device-controller/",
+	"enrollment-controller/",
+	"event-controller/",
+	"fleet-controller/",
+	"auth-provider/",
+}
+
+type ExportOptions struct {
+	GlobalOptions
+
+	OutputDir      string
+	IncludeDevices bool
+}
+
+func DefaultExportOptions() *ExportOptions {
+	return &ExportOptions{
+		GlobalOptions:  DefaultGlobalOptions(),
+		OutputDir:      "",
+		IncludeDevices: false,
+	}
+}
+
+func NewCmdExport() *cobra.Command {
+	o := DefaultExportOptions()
+	cmd := &cobra.Command{
+		Use:   "export -d DIRECTORY",
+		Short: "Export the configuration of an organization as a directory of YAML manifests.",
+		Long: "Export the repositories, auth providers, catalogs, fleets and resourcesyncs of an organization as a directory of YAML manifests " +
+			"that can be imported into another flightctl instance with 'flightctl import'.\n" +
+			"Resources that are managed by a resourcesync are not exported, as the resourcesync recreates them. " +
+			"Secret values such as repository credentials are never returned by the API and are exported redacted.",
+		Example: "  flightctl export -d ./staging-export --include-devices",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.Complete(cmd, args); err != nil {
+				return err
+			}
+			if err := o.Validate(args); err != nil {
+				return err
+			}
+			ctx, cancel := o.WithTimeout(cmd.Context())
+			defer cancel()
+			return o.Run(ctx, args)
+		},
+		SilenceUsage: true,
+	}
+	o.Bind(cmd.Flags())
+	return cmd
+}
+
+func (o *ExportOptions) Bind(fs *pflag.FlagSet) {
+	o.GlobalOptions.Bind(fs)
+
+	fs.StringVarP(&o.OutputDir, "output-dir", "d", o.OutputDir, "The directory to write the manifests to. It is created if it does not exist.")
+	fs.BoolVar(&o.IncludeDevices, "include-devices", o.IncludeDevices, "Also export the names and labels of devices.")
+}
+
+func (o *ExportOptions) Complete(cmd *cobra.Command, args []string) error {
+	if err := o.GlobalOptions.Complete(cmd, args); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *ExportOptions) Validate(args []string) error {
+	if err := o.GlobalOptions.Validate(args); err != nil {
+		return err
+	}
+	if len(o.OutputDir) == 0 {
+		return fmt.Errorf("must specify -d DIRECTORY")
+	}
+	if len(args) > 0 {
+		return fmt.Errorf("unexpected arguments: %v", args)
+	}
+	return nil
+}
+
+func (o *ExportOptions) Run(ctx context.Context, args []string) error {
+	c, err := o.BuildClient()
+	if err != nil {
+		return fmt.Errorf("creating client: %w", err)
+	}
+	c.Start(ctx)
+	defer c.Stop()
+
+	kinds := slices.Clone(exportedKinds)
+	if o.IncludeDevices {
+		kinds = append(kinds, DeviceKind)
+	}
+
+	for _, kind := range kinds {
+		resources, err := listAllForExport(ctx, c, kind)
+		if err != nil {
+			return fmt.Errorf("listing %s: %w", kindToPlural[kind], err)
+		}
+		exported := 0
+		for _, resource := range resources {
+			resource, ok := sanitizeForExport(kind, resource)
+			if !ok {
+				continue
+			}
+			path, err := writeExportedResource(o.OutputDir, kind, resource)
+			if err != nil {
+				return err
+			}
+			if redacted := redactedPaths(resource); len(redacted) > 0 {
+				fmt.Fprintf(os.Stderr, "warning: %s contains redacted values that must be set before importing it into a new instance: %s\n",
+					path, strings.Join(redacted, ", "))
+			}
+			exported++
+		}
+		fmt.Printf("exported %d %s\n", exported, kindToPlural[kind])
+	}
+	return nil
+}
+
+// listAllForExport lists all resources of the kind, following continue tokens, as generic resources.
+func listAllForExport(ctx context.Context, c *client.Client, kind ResourceKind) ([]genericResource, error) {
+	var resources []genericResource
+	var continueToken *string
+	for {
+		response, err := listForExport(ctx, c, kind, continueToken)
+		if err != nil {
+			return nil, err
+		}
+		if err := validateResponse(response); err != nil {
+			return nil, err
+		}
+		body, err := responseField[[]byte](response, "Body")
+		if err != nil {
+			return nil, err
+		}
+		var list struct {
+			Metadata struct {
+				Continue *string `json:"continue,omitempty"`
+			} `json:"metadata"`
+			Items []genericResource `json:"items"`
+		}
+		if err := json.Unmarshal(body, &list); err != nil {
+			return nil, fmt.Errorf("decoding response: %w", err)
+		}
+		resources = append(resources, list.Items...)
+		if list.Metadata.Continue == nil || *list.Metadata.Continue == "" {
+			return resources, nil
+		}
+		continueToken = list.Metadata.Continue
+	}
+}
+
+func listForExport(ctx context.Context, c *client.Client, kind ResourceKind, continueToken *string) (interface{}, error) {
+	switch kind {
+	case RepositoryKind:
+		return c.ListRepositoriesWithResponse(ctx, &api.ListRepositoriesParams{Continue: continueToken})
+	case AuthProviderKind:
+		return c.ListAuthProvidersWithResponse(ctx, &api.ListAuthProvidersParams{Continue: continueToken})
+	case TrustPolicyKind:
+		return c.ListTrustPoliciesWithResponse(ctx, &api.ListTrustPoliciesParams{Continue: continueToken})
+	case CatalogKind:
+		return c.V1Alpha1().ListCatalogsWithResponse(ctx, &apiv1alpha1.ListCatalogsParams{Continue: continueToken})
+	case CatalogItemKind:
+		return c.V1Alpha1().ListAllCatalogItemsWithResponse(ctx, &apiv1alpha1.ListAllCatalogItemsParams{Continue: continueToken})
+	case FleetKind:
+		return c.ListFleetsWithResponse(ctx, &api.ListFleetsParams{Continue: continueToken})
+	case ResourceSyncKind:
+		return c.ListResourceSyncsWithResponse(ctx, &api.ListResourceSyncsParams{Continue: continueToken})
+	case DeviceKind:
+		return c.ListDevicesWithResponse(ctx, &api.ListDevicesParams{Continue: continueToken})
+	default:
+		return nil, fmt.Errorf("exporting %s is not supported", kind)
+	}
+}
+
+// sanitizeForExport strips the status and the service-managed metadata of a resource, so that it can be applied
+// to another instance. It returns false for resources that should not be exported because they are managed by
+// a resourcesync. Devices are reduced to their names and labels, as the rest is owned by fleets or the devices.
+func sanitizeForExport(kind ResourceKind, resource genericResource) (genericResource, bool) {
+	metadata, _ := resource["metadata"].(map[string]interface{})
+	if metadata == nil {
+		return nil, false
+	}
+	if owner, _ := metadata["owner"].(string); owner != "" && kind != DeviceKind {
+		return nil, false
+	}
+
+	exportedMetadata := map[string]interface{}{"name": metadata["name"]}
+	for _, field := range []string{"labels", "catalog"} {
+		if value, ok := metadata[field]; ok {
+			exportedMetadata[field] = value
+		}
+	}
+	if annotations, ok := metadata["annotations"].(map[string]interface{}); ok && kind != DeviceKind {
+		userAnnotations := map[string]interface{}{}
+		for key, value := range annotations {
+			if !hasManagedAnnotationPrefix(key) {
+				userAnnotations[key] = value
+			}
+		}
+		if len(userAnnotations) > 0 {
+			exportedMetadata["annotations"] = userAnnotations
+		}
+	}
+
+	apiVersion := versioning.QualifiedV1Beta1
+	if _, isAlpha := alphaResources[kind]; isAlpha {
+		apiVersion = versioning.QualifiedV1Alpha1
+	}
+	exported := genericResource{
+		"apiVersion": apiVersion,
+		"kind":       apiKinds[kind],
+		"metadata":   exportedMetadata,
+	}
+	if spec, ok := resource["spec"]; ok && kind != DeviceKind {
+		exported["spec"] = spec
+	}
+	return exported, true
+}
+
+func hasManagedAnnotationPrefix(key string) bool {
+	for _, prefix := range managedAnnotationPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// redactedPaths returns the paths of the values of the resource that the API redacted, sorted.
+func redactedPaths(resource genericResource) []string {
+	paths := []string{}
+	var walk func(path string, value interface{})
+	walk = func(path string, value interface{}) {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			for key, child := range v {
+				walk(strings.TrimPrefix(path+"."+key, "."), child)
+			}
+		case []interface{}:
+			for i, child := range v {
+				walk(fmt.Sprintf("%s[%d]", path, i), child)
+			}
+		case string:
+			if v == api.MaskedValuePlaceholder {
+				paths = append(paths, path)
+			}
+		}
+	}
+	walk("", map[string]interface{}(resource))
+	sort.Strings(paths)
+	return paths
+}
+
+// exportedResourcePath returns where a resource is written relative to the export directory. Catalog items are
+// grouped by catalog, as their names are only unique within a catalog.
+func exportedResourcePath(kind ResourceKind, resource genericResource) (string, error) {
+	metadata, _ := resource["metadata"].(map[string]interface{})
+	name, _ := metadata["name"].(string)
+	if name == "" {
+		return "", fmt.Errorf("%s without metadata.name", kind)
+	}
+	if kind == CatalogItemKind {
+		catalog, _ := metadata["catalog"].(string)
+		if catalog == "" {
+			return "", fmt.Errorf("catalogitem %s without metadata.catalog", name)
+		}
+		return filepath.Join(kindToPlural[kind], catalog, name+".yaml"), nil
+	}
+	return filepath.Join(kindToPlural[kind], name+".yaml"), nil
+}
+
+func writeExportedResource(dir string, kind ResourceKind, resource genericResource) (string, error) {
+	relativePath, err := exportedResourcePath(kind, resource)
+	if err != nil {
+		return "", err
+	}
+	path := filepath.Join(dir, relativePath)
+	marshalled, err := yaml.Marshal(resource)
+	if err != nil {
+		return "", fmt.Errorf("encoding %s: %w", relativePath, err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return "", fmt.Errorf("creating directory for %s: %w", relativePath, err)
+	}
+	if err := os.WriteFile(path, marshalled, 0o600); err != nil {
+		return "", fmt.Errorf("writing %s: %w", relativePath, err)
+	}
+	return path, nil
+}
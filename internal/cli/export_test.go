@@ -0,0 +1,134 @@
+package cli
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSanitizeForExport(t *testing.T) {
+	tests := []struct {
+		name     string
+		kind     ResourceKind
+		resource genericResource
+		expected genericResource
+		exported bool
+	}{
+		{
+			name: "fleet without status and managed metadata",
+			kind: FleetKind,
+			resource: genericResource{
+				"apiVersion": "flightctl.io/v1beta1",
+				"kind":       "Fleet",
+				"metadata": map[string]interface{}{
+					"name":              "fleet1",
+					"labels":            map[string]interface{}{"env": "prod"},
+					"generation":        float64(3),
+					"resourceVersion":   "42",
+					"creationTimestamp": "2025-01-01T00:00:00Z",
+					"annotations": map[string]interface{}{
+						"fleet-controller/templateVersion": "fleet1-3",
+						"team":                             "edge",
+					},
+				},
+				"spec":   map[string]interface{}{"selector": map[string]interface{}{}},
+				"status": map[string]interface{}{"conditions": []interface{}{}},
+			},
+			expected: genericResource{
+				"apiVersion": "flightctl.io/v1beta1",
+				"kind":       "Fleet",
+				"metadata": map[string]interface{}{
+					"name":        "fleet1",
+					"labels":      map[string]interface{}{"env": "prod"},
+					"annotations": map[string]interface{}{"team": "edge"},
+				},
+				"spec": map[string]interface{}{"selector": map[string]interface{}{}},
+			},
+			exported: true,
+		},
+		{
+			name: "fleet owned by a resourcesync",
+			kind: FleetKind,
+			resource: genericResource{
+				"metadata": map[string]interface{}{"name": "fleet1", "owner": "ResourceSync/rs1"},
+				"spec":     map[string]interface{}{},
+			},
+			exported: false,
+		},
+		{
+			name: "catalog item keeps its catalog",
+			kind: CatalogItemKind,
+			resource: genericResource{
+				"metadata": map[string]interface{}{"name": "redis", "catalog": "apps", "resourceVersion": "7"},
+				"spec":     map[string]interface{}{"type": "container"},
+			},
+			expected: genericResource{
+				"apiVersion": "flightctl.io/v1alpha1",
+				"kind":       "CatalogItem",
+				"metadata":   map[string]interface{}{"name": "redis", "catalog": "apps"},
+				"spec":       map[string]interface{}{"type": "container"},
+			},
+			exported: true,
+		},
+		{
+			name: "device reduced to name and labels",
+			kind: DeviceKind,
+			resource: genericResource{
+				"metadata": map[string]interface{}{
+					"name":        "dev1",
+					"owner":       "Fleet/fleet1",
+					"labels":      map[string]interface{}{"site": "a"},
+					"annotations": map[string]interface{}{"device-controller/renderedVersion": "5"},
+				},
+				"spec": map[string]interface{}{"os": map[string]interface{}{"image": "quay.io/os:v1"}},
+			},
+			expected: genericResource{
+				"apiVersion": "flightctl.io/v1beta1",
+				"kind":       "Device",
+				"metadata":   map[string]interface{}{"name": "dev1", "labels": map[string]interface{}{"site": "a"}},
+			},
+			exported: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exported, ok := sanitizeForExport(tt.kind, tt.resource)
+			require.Equal(t, tt.exported, ok)
+			if tt.exported {
+				require.Equal(t, tt.expected, exported)
+			}
+		})
+	}
+}
+
+func TestRedactedPaths(t *testing.T) {
+	resource := genericResource{
+		"metadata": map[string]interface{}{"name": "repo1"},
+		"spec": map[string]interface{}{
+			"url": "https://example.com/repo.git",
+			"httpConfig": map[string]interface{}{
+				"username": "user",
+				"password": "*****",
+			},
+			"webhook": map[string]interface{}{"secret": "*****"},
+			"items":   []interface{}{"a", "*****"},
+		},
+	}
+	require.Equal(t, []string{"spec.httpConfig.password", "spec.items[1]", "spec.webhook.secret"}, redactedPaths(resource))
+	require.Empty(t, redactedPaths(genericResource{"spec": map[string]interface{}{"url": "https://example.com"}}))
+}
+
+func TestExportedResourcePath(t *testing.T) {
+	path, err := exportedResourcePath(FleetKind, genericResource{"metadata": map[string]interface{}{"name": "fleet1"}})
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join("fleets", "fleet1.yaml"), path)
+
+	path, err = exportedResourcePath(CatalogItemKind, genericResource{"metadata": map[string]interface{}{"name": "redis", "catalog": "apps"}})
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join("catalogitems", "apps", "redis.yaml"), path)
+
+	_, err = exportedResourcePath(CatalogItemKind, genericResource{"metadata": map[string]interface{}{"name": "redis"}})
+	require.Error(t, err)
+}
@@ -28,6 +28,7 @@ const (
 	CatalogItemKind               ResourceKind = "catalogitem"
 	CertificateSigningRequestKind ResourceKind = "certificatesigningrequest"
 	DeviceKind                    ResourceKind = "device"
+	EnrollmentPolicyKind          ResourceKind = "enrollmentpolicy"
 	EnrollmentRequestKind         ResourceKind = "enrollmentrequest"
 	EventKind                     ResourceKind = "event"
 	AuthProviderKind              ResourceKind = "authprovider"
@@ -35,9 +36,12 @@ const (
 	ImageBuildKind                ResourceKind = "imagebuild"
 	ImageExportKind               ResourceKind = "imageexport"
 	OrganizationKind              ResourceKind = "organization"
+	ReferenceMeasurementKind      ResourceKind = "referencemeasurement"
 	RepositoryKind                ResourceKind = "repository"
 	ResourceSyncKind              ResourceKind = "resourcesync"
+	SecretKind                    ResourceKind = "secret"
 	TemplateVersionKind           ResourceKind = "templateversion"
+	TrustPolicyKind               ResourceKind = "trustpolicy"
 )
 
 func (r ResourceKind) String() string {
@@ -68,6 +72,7 @@ var (
 		CatalogItemKind:               {},
 		CertificateSigningRequestKind: {},
 		DeviceKind:                    {},
+		EnrollmentPolicyKind:          {},
 		EnrollmentRequestKind:         {},
 		EventKind:                     {},
 		AuthProviderKind:              {},
@@ -75,9 +80,12 @@ var (
 		ImageBuildKind:                {},
 		ImageExportKind:               {},
 		OrganizationKind:              {},
+		ReferenceMeasurementKind:      {},
 		RepositoryKind:                {},
 		ResourceSyncKind:              {},
+		SecretKind:                    {},
 		TemplateVersionKind:           {},
+		TrustPolicyKind:               {},
 	}
 
 	validResourceKinds = slices.Collect(maps.Keys(resourceKindSet))
@@ -87,6 +95,7 @@ var (
 		"catalogitems":               CatalogItemKind,
 		"certificatesigningrequests": CertificateSigningRequestKind,
 		"devices":                    DeviceKind,
+		"enrollmentpolicies":         EnrollmentPolicyKind,
 		"enrollmentrequests":         EnrollmentRequestKind,
 		"events":                     EventKind,
 		"authproviders":              AuthProviderKind,
@@ -94,9 +103,12 @@ var (
 		"imagebuilds":                ImageBuildKind,
 		"imageexports":               ImageExportKind,
 		"organizations":              OrganizationKind,
+		"referencemeasurements":      ReferenceMeasurementKind,
 		"repositories":               RepositoryKind,
 		"resourcesyncs":              ResourceSyncKind,
+		"secrets":                    SecretKind,
 		"templateversions":           TemplateVersionKind,
+		"trustpolicies":              TrustPolicyKind,
 	}
 
 	kindToPlural = map[ResourceKind]string{
@@ -104,6 +116,7 @@ var (
 		CatalogItemKind:               "catalogitems",
 		CertificateSigningRequestKind: "certificatesigningrequests",
 		DeviceKind:                    "devices",
+		EnrollmentPolicyKind:          "enrollmentpolicies",
 		EnrollmentRequestKind:         "enrollmentrequests",
 		EventKind:                     "events",
 		AuthProviderKind:              "authproviders",
@@ -111,9 +124,12 @@ var (
 		ImageBuildKind:                "imagebuilds",
 		ImageExportKind:               "imageexports",
 		OrganizationKind:              "organizations",
+		ReferenceMeasurementKind:      "referencemeasurements",
 		RepositoryKind:                "repositories",
 		ResourceSyncKind:              "resourcesyncs",
+		SecretKind:                    "secrets",
 		TemplateVersionKind:           "templateversions",
+		TrustPolicyKind:               "trustpolicies",
 	}
 
 	shortnameToKind = map[string]ResourceKind{
@@ -121,6 +137,7 @@ var (
 		"ci":   CatalogItemKind,
 		"csr":  CertificateSigningRequestKind,
 		"dev":  DeviceKind,
+		"ep":   EnrollmentPolicyKind,
 		"er":   EnrollmentRequestKind,
 		"ev":   EventKind,
 		"ap":   AuthProviderKind,
@@ -128,8 +145,10 @@ var (
 		"ib":   ImageBuildKind,
 		"ie":   ImageExportKind,
 		"org":  OrganizationKind,
+		"rm":   ReferenceMeasurementKind,
 		"repo": RepositoryKind,
 		"rs":   ResourceSyncKind,
+		"tp":   TrustPolicyKind,
 		"tv":   TemplateVersionKind,
 	}
 )
@@ -331,6 +350,14 @@ func GetSingleResource(ctx context.Context, c *client.Client, kind ResourceKind,
 		return c.GetCertificateSigningRequestWithResponse(ctx, name)
 	case AuthProviderKind:
 		return c.GetAuthProviderWithResponse(ctx, name)
+	case ReferenceMeasurementKind:
+		return c.GetReferenceMeasurementWithResponse(ctx, name)
+	case EnrollmentPolicyKind:
+		return c.GetEnrollmentPolicyWithResponse(ctx, name)
+	case SecretKind:
+		return c.GetSecretWithResponse(ctx, name)
+	case TrustPolicyKind:
+		return c.GetTrustPolicyWithResponse(ctx, name)
 	default:
 		return nil, fmt.Errorf("unsupported resource kind: %s", kind)
 	}
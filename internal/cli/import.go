@@ -0,0 +1,368 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	api "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/client"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	yamlutil "k8s.io/apimachinery/pkg/util/yaml"
+)
+
+const (
+	// ConflictSkip leaves resources that already exist unchanged.
+	ConflictSkip = "skip"
+	// ConflictOverwrite replaces resources that already exist.
+	ConflictOverwrite = "overwrite"
+	// ConflictFail aborts the import before changing anything if any resource already exists.
+	ConflictFail = "fail"
+)
+
+var legalConflictStrategies = []string{ConflictSkip, ConflictOverwrite, ConflictFail}
+
+type ImportOptions struct {
+	GlobalOptions
+
+	InputDir   string
+	OnConflict string
+	DryRun     bool
+}
+
+func DefaultImportOptions() *ImportOptions {
+	return &ImportOptions{
+		GlobalOptions: DefaultGlobalOptions(),
+		InputDir:      "",
+		OnConflict:    ConflictSkip,
+		DryRun:        false,
+	}
+}
+
+func NewCmdImport() *cobra.Command {
+	o := DefaultImportOptions()
+	cmd := &cobra.Command{
+		Use:   "import -d DIRECTORY [--on-conflict skip|overwrite|fail]",
+		Short: "Import the configuration of an organization from a directory of YAML manifests.",
+		Long: "Import the manifests written by 'flightctl export', or any other manifests of the same kinds, into the current organization.\n" +
+			"Resources are created in dependency order, so that for example repositories exist before the fleets and resourcesyncs that use them. " +
+			"Importing the same directory again does not change resources that are already up to date.\n" +
+			"Devices are not created by an import: only the labels of devices that are already enrolled are updated.",
+		Example: "  flightctl import -d ./staging-export --on-conflict overwrite",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.Complete(cmd, args); err != nil {
+				return err
+			}
+			if err := o.Validate(args); err != nil {
+				return err
+			}
+			ctx, cancel := o.WithTimeout(cmd.Context())
+			defer cancel()
+			return o.Run(ctx, args)
+		},
+		SilenceUsage: true,
+	}
+	o.Bind(cmd.Flags())
+	return cmd
+}
+
+func (o *ImportOptions) Bind(fs *pflag.FlagSet) {
+	o.GlobalOptions.Bind(fs)
+
+	fs.StringVarP(&o.InputDir, "input-dir", "d", o.InputDir, "The directory that contains the manifests to import.")
+	fs.StringVar(&o.OnConflict, "on-conflict", o.OnConflict, fmt.Sprintf("What to do with resources that already exist. One of: (%s).", strings.Join(legalConflictStrategies, ", ")))
+	fs.BoolVar(&o.DryRun, "dry-run", o.DryRun, "Only print what would be imported, without changing anything.")
+}
+
+func (o *ImportOptions) Complete(cmd *cobra.Command, args []string) error {
+	if err := o.GlobalOptions.Complete(cmd, args); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *ImportOptions) Validate(args []string) error {
+	if err := o.GlobalOptions.Validate(args); err != nil {
+		return err
+	}
+	if len(o.InputDir) == 0 {
+		return fmt.Errorf("must specify -d DIRECTORY")
+	}
+	if !slices.Contains(legalConflictStrategies, o.OnConflict) {
+		return fmt.Errorf("on-conflict must be one of (%s)", strings.Join(legalConflictStrategies, ", "))
+	}
+	if len(args) > 0 {
+		return fmt.Errorf("unexpected arguments: %v", args)
+	}
+	return nil
+}
+
+// importedResource is a manifest read from the import directory.
+type importedResource struct {
+	path     string
+	kind     ResourceKind
+	name     string
+	resource genericResource
+}
+
+func (r importedResource) String() string {
+	if r.kind == CatalogItemKind {
+		return fmt.Sprintf("%s/%s/%s", r.kind, r.catalog(), r.name)
+	}
+	return fmt.Sprintf("%s/%s", r.kind, r.name)
+}
+
+func (r importedResource) catalog() string {
+	metadata, _ := r.resource["metadata"].(map[string]interface{})
+	catalog, _ := metadata["catalog"].(string)
+	return catalog
+}
+
+func (o *ImportOptions) Run(ctx context.Context, args []string) error {
+	resources, err := readImportDirectory(o.InputDir)
+	if err != nil {
+		return err
+	}
+
+	c, err := o.BuildClient()
+	if err != nil {
+		return fmt.Errorf("creating client: %w", err)
+	}
+	c.Start(ctx)
+	defer c.Stop()
+
+	exists := make([]bool, len(resources))
+	conflicts := []string{}
+	for i, r := range resources {
+		if exists[i], err = resourceExists(ctx, c, r); err != nil {
+			return fmt.Errorf("%s: checking whether %s exists: %w", r.path, r, err)
+		}
+		if exists[i] && r.kind != DeviceKind {
+			conflicts = append(conflicts, r.String())
+		}
+	}
+	if o.OnConflict == ConflictFail && len(conflicts) > 0 {
+		return fmt.Errorf("not importing anything, as these resources already exist: %s", strings.Join(conflicts, ", "))
+	}
+
+	errs := []error{}
+	for i, r := range resources {
+		action, err := importAction(r, exists[i], o.OnConflict)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", r.path, err))
+			continue
+		}
+		if action == "" {
+			fmt.Printf("%s: %s already exists, skipped\n", r.path, r)
+			continue
+		}
+		if o.DryRun {
+			fmt.Printf("%s: %s %s (dry run only)\n", r.path, action, r)
+			continue
+		}
+		if err := importResource(ctx, c, r); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %s %s: %w", r.path, action, r, err))
+			continue
+		}
+		fmt.Printf("%s: %s %s\n", r.path, action, r)
+	}
+	return errors.Join(errs...)
+}
+
+// importAction decides what to do with an imported resource. An empty action leaves the resource unchanged.
+func importAction(r importedResource, exists bool, onConflict string) (string, error) {
+	if r.kind == DeviceKind {
+		// devices cannot be created, only the metadata of enrolled devices is updated
+		if !exists {
+			return "", fmt.Errorf("device %s is not enrolled", r.name)
+		}
+		if onConflict == ConflictSkip {
+			return "", nil
+		}
+		return "updating", nil
+	}
+	if exists {
+		if onConflict == ConflictSkip {
+			return "", nil
+		}
+		// the service keeps the current secret values when it receives redacted ones
+		return "replacing", nil
+	}
+	if redacted := redactedPaths(r.resource); len(redacted) > 0 {
+		return "", fmt.Errorf("cannot create %s with redacted values, set them in the manifest first: %s", r, strings.Join(redacted, ", "))
+	}
+	return "creating", nil
+}
+
+// readImportDirectory reads all manifests in the directory and its subdirectories and returns them in the order
+// in which they have to be imported.
+func readImportDirectory(dir string) ([]importedResource, error) {
+	if _, err := os.Stat(dir); err != nil {
+		return nil, fmt.Errorf("the path %q cannot be accessed: %w", dir, err)
+	}
+
+	resources := []importedResource{}
+	errs := []error{}
+	err := filepath.Walk(dir, func(path string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if fi.IsDir() || ignoreFile(path, fileExtensions) {
+			return nil
+		}
+		r, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer r.Close()
+		read, err := readImportManifests(path, r)
+		if err != nil {
+			errs = append(errs, err)
+			return nil
+		}
+		resources = append(resources, read...)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error walking %q: %w", dir, err)
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	order := make(map[ResourceKind]int, len(exportedKinds)+1)
+	for i, kind := range append(slices.Clone(exportedKinds), DeviceKind) {
+		order[kind] = i
+	}
+	sort.SliceStable(resources, func(i, j int) bool {
+		return order[resources[i].kind] < order[resources[j].kind]
+	})
+	return resources, nil
+}
+
+func readImportManifests(path string, r io.Reader) ([]importedResource, error) {
+	decoder := yamlutil.NewYAMLOrJSONDecoder(r, 100)
+	resources := []importedResource{}
+	for {
+		var resource genericResource
+		err := decoder.Decode(&resource)
+		if errors.Is(err, io.EOF) {
+			return resources, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		if resource == nil {
+			continue
+		}
+
+		kindLike, _ := resource["kind"].(string)
+		kind, err := ResourceKindFromString(kindLike)
+		if err != nil || (kind != DeviceKind && !slices.Contains(exportedKinds, kind)) {
+			return nil, fmt.Errorf("%s: importing resources of kind %q is not supported", path, kindLike)
+		}
+		metadata, _ := resource["metadata"].(map[string]interface{})
+		name, _ := metadata["name"].(string)
+		if name == "" {
+			return nil, fmt.Errorf("%s: metadata.name must not be empty", path)
+		}
+		if err := validateResourceAPIVersion(resource, kind); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		imported := importedResource{path: path, kind: kind, name: name, resource: resource}
+		if kind == CatalogItemKind && imported.catalog() == "" {
+			return nil, fmt.Errorf("%s: catalogitem requires metadata.catalog to specify the parent catalog", path)
+		}
+		resources = append(resources, imported)
+	}
+}
+
+func resourceExists(ctx context.Context, c *client.Client, r importedResource) (bool, error) {
+	var response interface{}
+	var err error
+	switch r.kind {
+	case RepositoryKind:
+		response, err = c.GetRepositoryWithResponse(ctx, r.name)
+	case AuthProviderKind:
+		response, err = c.GetAuthProviderWithResponse(ctx, r.name)
+	case TrustPolicyKind:
+		response, err = c.GetTrustPolicyWithResponse(ctx, r.name)
+	case CatalogKind:
+		response, err = c.V1Alpha1().GetCatalogWithResponse(ctx, r.name)
+	case CatalogItemKind:
+		response, err = c.V1Alpha1().GetCatalogItemWithResponse(ctx, r.catalog(), r.name)
+	case FleetKind:
+		response, err = c.GetFleetWithResponse(ctx, r.name, &api.GetFleetParams{})
+	case ResourceSyncKind:
+		response, err = c.GetResourceSyncWithResponse(ctx, r.name)
+	case DeviceKind:
+		response, err = c.GetDeviceWithResponse(ctx, r.name)
+	default:
+		return false, fmt.Errorf("importing %s is not supported", r.kind)
+	}
+	if err != nil {
+		return false, err
+	}
+	httpResponse, err := responseField[*http.Response](response, "HTTPResponse")
+	if err != nil {
+		return false, err
+	}
+	if httpResponse.StatusCode == http.StatusNotFound {
+		return false, nil
+	}
+	if err := validateResponse(response); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func importResource(ctx context.Context, c *client.Client, r importedResource) error {
+	if r.kind == DeviceKind {
+		return importDeviceMetadata(ctx, c, r)
+	}
+
+	buf, err := json.Marshal(r.resource)
+	if err != nil {
+		return err
+	}
+	result := applyResourceByKind(ctx, c, nil, r.kind, r.name, buf)
+	if result.err != nil {
+		return result.err
+	}
+	if result.httpResponse != nil && result.httpResponse.StatusCode != http.StatusOK && result.httpResponse.StatusCode != http.StatusCreated {
+		return fmt.Errorf("%s: %s", result.httpResponse.Status, result.message)
+	}
+	return nil
+}
+
+// importDeviceMetadata replaces the labels of an enrolled device, leaving the rest of the device unchanged.
+func importDeviceMetadata(ctx context.Context, c *client.Client, r importedResource) error {
+	metadata, _ := r.resource["metadata"].(map[string]interface{})
+	labels, _ := metadata["labels"].(map[string]interface{})
+	if labels == nil {
+		labels = map[string]interface{}{}
+	}
+	patch := []map[string]interface{}{
+		{"op": "add", "path": "/metadata/labels", "value": labels},
+	}
+	buf, err := json.Marshal(patch)
+	if err != nil {
+		return err
+	}
+
+	response, err := c.PatchDeviceWithBodyWithResponse(ctx, r.name, "application/json-patch+json", bytes.NewReader(buf))
+	if err != nil {
+		return err
+	}
+	return validateResponse(response)
+}
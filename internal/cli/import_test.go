@@ -0,0 +1,112 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadImportDirectory(t *testing.T) {
+	require := require.New(t)
+	dir := t.TempDir()
+
+	// written by a previous export
+	exported := []struct {
+		kind     ResourceKind
+		resource genericResource
+	}{
+		{FleetKind, genericResource{"apiVersion": "flightctl.io/v1beta1", "kind": "Fleet", "metadata": map[string]interface{}{"name": "fleet1"}}},
+		{ResourceSyncKind, genericResource{"apiVersion": "flightctl.io/v1beta1", "kind": "ResourceSync", "metadata": map[string]interface{}{"name": "rs1"}}},
+		{CatalogItemKind, genericResource{"apiVersion": "flightctl.io/v1alpha1", "kind": "CatalogItem", "metadata": map[string]interface{}{"name": "redis", "catalog": "apps"}}},
+		{CatalogKind, genericResource{"apiVersion": "flightctl.io/v1alpha1", "kind": "Catalog", "metadata": map[string]interface{}{"name": "apps"}}},
+		{DeviceKind, genericResource{"apiVersion": "flightctl.io/v1beta1", "kind": "Device", "metadata": map[string]interface{}{"name": "dev1"}}},
+	}
+	for _, e := range exported {
+		_, err := writeExportedResource(dir, e.kind, e.resource)
+		require.NoError(err)
+	}
+	// a hand-written manifest with several documents and a file that is not a manifest
+	require.NoError(os.WriteFile(filepath.Join(dir, "extra.yaml"), []byte(`apiVersion: flightctl.io/v1beta1
+kind: Repository
+metadata:
+  name: repo1
+---
+apiVersion: flightctl.io/v1beta1
+kind: AuthProvider
+metadata:
+  name: oidc
+`), 0o600))
+	require.NoError(os.WriteFile(filepath.Join(dir, "README.md"), []byte("# export"), 0o600))
+
+	resources, err := readImportDirectory(dir)
+	require.NoError(err)
+	names := []string{}
+	for _, r := range resources {
+		names = append(names, r.String())
+	}
+	require.Equal([]string{
+		"repository/repo1",
+		"authprovider/oidc",
+		"catalog/apps",
+		"catalogitem/apps/redis",
+		"fleet/fleet1",
+		"resourcesync/rs1",
+		"device/dev1",
+	}, names)
+}
+
+func TestReadImportDirectoryUnsupportedKind(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "er.yaml"), []byte(`apiVersion: flightctl.io/v1beta1
+kind: EnrollmentRequest
+metadata:
+  name: er1
+`), 0o600))
+
+	_, err := readImportDirectory(dir)
+	require.ErrorContains(t, err, `importing resources of kind "EnrollmentRequest" is not supported`)
+}
+
+func TestImportAction(t *testing.T) {
+	repo := importedResource{kind: RepositoryKind, name: "repo1", resource: genericResource{
+		"metadata": map[string]interface{}{"name": "repo1"},
+		"spec":     map[string]interface{}{"httpConfig": map[string]interface{}{"password": "*****"}},
+	}}
+	fleet := importedResource{kind: FleetKind, name: "fleet1", resource: genericResource{
+		"metadata": map[string]interface{}{"name": "fleet1"},
+	}}
+	device := importedResource{kind: DeviceKind, name: "dev1", resource: genericResource{
+		"metadata": map[string]interface{}{"name": "dev1"},
+	}}
+
+	tests := []struct {
+		name        string
+		resource    importedResource
+		exists      bool
+		onConflict  string
+		expected    string
+		errContains string
+	}{
+		{name: "create", resource: fleet, exists: false, onConflict: ConflictSkip, expected: "creating"},
+		{name: "skip existing", resource: fleet, exists: true, onConflict: ConflictSkip, expected: ""},
+		{name: "overwrite existing", resource: fleet, exists: true, onConflict: ConflictOverwrite, expected: "replacing"},
+		{name: "overwrite existing with redacted values", resource: repo, exists: true, onConflict: ConflictOverwrite, expected: "replacing"},
+		{name: "create with redacted values", resource: repo, exists: false, onConflict: ConflictOverwrite, errContains: "spec.httpConfig.password"},
+		{name: "device not enrolled", resource: device, exists: false, onConflict: ConflictOverwrite, errContains: "not enrolled"},
+		{name: "update enrolled device", resource: device, exists: true, onConflict: ConflictOverwrite, expected: "updating"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			action, err := importAction(tt.resource, tt.exists, tt.onConflict)
+			if tt.errContains != "" {
+				require.ErrorContains(t, err, tt.errContains)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, action)
+		})
+	}
+}
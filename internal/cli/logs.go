@@ -9,8 +9,12 @@ import (
 	"net/http"
 	"os"
 	"strings"
+	"time"
 
 	imagebuilderapi "github.com/flightctl/flightctl/api/imagebuilder/v1alpha1"
+	"github.com/flightctl/flightctl/internal/client"
+	"github.com/flightctl/flightctl/internal/console/logstream"
+	"github.com/gorilla/websocket"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 )
@@ -18,6 +22,9 @@ import (
 type LogsOptions struct {
 	GlobalOptions
 	Follow bool
+	App    string
+	Unit   string
+	Since  time.Duration
 }
 
 func DefaultLogsOptions() *LogsOptions {
@@ -32,7 +39,8 @@ func NewCmdLogs() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "logs (TYPE/NAME | TYPE NAME) [flags]",
 		Short: "Print the logs for a resource",
-		Long:  "Print the logs for a resource. Supports imagebuild and imageexport resources.",
+		Long: `Print the logs for a resource. Supports imagebuild, imageexport and device resources.
+For devices, the container logs of an application (--app) or the journal of a systemd unit (--unit) are streamed from the device.`,
 		Example: `  # Get logs for an imagebuild
   flightctl logs imagebuild/my-build
 
@@ -43,7 +51,13 @@ func NewCmdLogs() *cobra.Command {
   flightctl logs imageexport/my-export
 
   # Follow logs for an active imageexport
-  flightctl logs imageexport/my-export -f`,
+  flightctl logs imageexport/my-export -f
+
+  # Get the logs of an application on a device written in the last hour
+  flightctl logs device/my-device --app my-app --since 1h
+
+  # Follow the journal of a systemd unit on a device
+  flightctl logs device/my-device --unit crio.service -f`,
 		Args: cobra.RangeArgs(1, 2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if err := o.Complete(cmd, args); err != nil {
@@ -65,6 +79,9 @@ func NewCmdLogs() *cobra.Command {
 func (o *LogsOptions) Bind(fs *pflag.FlagSet) {
 	o.GlobalOptions.Bind(fs)
 	fs.BoolVarP(&o.Follow, "follow", "f", o.Follow, "Specify if the logs should be streamed. Follows the logs until the build completes or the command is interrupted.")
+	fs.StringVar(&o.App, "app", o.App, "Name of the application on the device whose container logs to print.")
+	fs.StringVar(&o.Unit, "unit", o.Unit, "Name of the systemd unit on the device whose journal to print.")
+	fs.DurationVar(&o.Since, "since", o.Since, "Only print device logs newer than a relative duration like 5s, 2m, or 3h.")
 }
 
 func (o *LogsOptions) Complete(cmd *cobra.Command, args []string) error {
@@ -82,9 +99,20 @@ func (o *LogsOptions) Validate(args []string) error {
 		return err
 	}
 
-	// Support imagebuild and imageexport
-	if kind != ImageBuildKind && kind != ImageExportKind {
-		return fmt.Errorf("logs command only supports imagebuild and imageexport resources, got: %s", kind)
+	switch kind {
+	case ImageBuildKind, ImageExportKind:
+		if o.App != "" || o.Unit != "" || o.Since != 0 {
+			return fmt.Errorf("--app, --unit and --since are only supported for device resources")
+		}
+	case DeviceKind:
+		if (o.App == "") == (o.Unit == "") {
+			return fmt.Errorf("exactly one of --app and --unit must be specified for device logs")
+		}
+		if o.Since < 0 {
+			return fmt.Errorf("--since must not be negative")
+		}
+	default:
+		return fmt.Errorf("logs command only supports imagebuild, imageexport and device resources, got: %s", kind)
 	}
 
 	if name == "" {
@@ -100,6 +128,10 @@ func (o *LogsOptions) Run(ctx context.Context, args []string) error {
 		return err
 	}
 
+	if kind == DeviceKind {
+		return o.runDeviceLogs(ctx, name)
+	}
+
 	// Build imagebuilder client
 	ibClient, err := o.BuildImageBuilderClient()
 	if err != nil {
@@ -217,6 +249,77 @@ func (o *LogsOptions) handleSSEStream(body io.Reader) error {
 	return nil
 }
 
+// runDeviceLogs streams the requested logs from the device through the server's log session endpoint.
+func (o *LogsOptions) runDeviceLogs(ctx context.Context, name string) error {
+	config, err := client.ParseConfigFile(o.ConfigFilePath)
+	if err != nil {
+		return fmt.Errorf("parsing config file: %w", err)
+	}
+	refresher := client.NewAccessTokenRefresher(config, o.ConfigFilePath, 8080)
+	refresher.Start(ctx)
+
+	conn, err := o.dialDeviceSession(ctx, config, name, "logs", logstream.ProtocolV1Name, refresher.GetAccessToken())
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			_ = conn.Close()
+		case <-done:
+		}
+	}()
+
+	request, err := logstream.NewRequestMessage(&logstream.Request{
+		App:          o.App,
+		Unit:         o.Unit,
+		Follow:       o.Follow,
+		SinceSeconds: int64(o.Since.Seconds()),
+	})
+	if err != nil {
+		return err
+	}
+	if err = conn.WriteMessage(websocket.BinaryMessage, request.Encode()); err != nil {
+		return fmt.Errorf("requesting logs: %w", err)
+	}
+	return copyDeviceLogs(conn, os.Stdout)
+}
+
+// copyDeviceLogs writes the log output received from the device to out until the agent signals the end of the
+// logs.
+func copyDeviceLogs(conn *websocket.Conn, out io.Writer) error {
+	for {
+		msgType, msg, err := conn.ReadMessage()
+		if err != nil {
+			return fmt.Errorf("stream closed unexpectedly: %w", err)
+		}
+		// the server signals the end of the session with an empty message
+		if msgType != websocket.BinaryMessage || len(msg) == 0 {
+			continue
+		}
+		m, err := logstream.Decode(msg)
+		if err != nil {
+			return err
+		}
+		switch m.Type {
+		case logstream.MessageData:
+			if _, err = out.Write(m.Payload); err != nil {
+				return err
+			}
+		case logstream.MessageDone:
+			return nil
+		case logstream.MessageError:
+			return fmt.Errorf("reading device logs: %s", string(m.Payload))
+		default:
+			return fmt.Errorf("unexpected message type %d", m.Type)
+		}
+	}
+}
+
 // parseResourceArgs parses resource arguments supporting both "type/name" (single arg) and "type name" (two args) formats
 func parseResourceArgs(args []string) (ResourceKind, string, error) {
 	if len(args) == 2 {
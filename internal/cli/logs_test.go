@@ -0,0 +1,86 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogsValidate(t *testing.T) {
+	// Create a minimal config file so GlobalOptions.Validate doesn't fail on missing login
+	configFile := filepath.Join(t.TempDir(), "client.yaml")
+	require.NoError(t, os.WriteFile(configFile, []byte("{}"), 0600))
+
+	tests := []struct {
+		name          string
+		args          []string
+		app           string
+		unit          string
+		since         time.Duration
+		errorContains string
+	}{
+		{
+			name: "imagebuild",
+			args: []string{"imagebuild/my-build"},
+		},
+		{
+			name:          "imagebuild with device flags",
+			args:          []string{"imagebuild/my-build"},
+			unit:          "crio.service",
+			errorContains: "only supported for device resources",
+		},
+		{
+			name:  "device application",
+			args:  []string{"device/my-device"},
+			app:   "my-app",
+			since: time.Hour,
+		},
+		{
+			name: "device unit",
+			args: []string{"device", "my-device"},
+			unit: "crio.service",
+		},
+		{
+			name:          "device without source",
+			args:          []string{"device/my-device"},
+			errorContains: "exactly one of --app and --unit",
+		},
+		{
+			name:          "device with both sources",
+			args:          []string{"device/my-device"},
+			app:           "my-app",
+			unit:          "crio.service",
+			errorContains: "exactly one of --app and --unit",
+		},
+		{
+			name:          "negative since",
+			args:          []string{"device/my-device"},
+			app:           "my-app",
+			since:         -time.Minute,
+			errorContains: "--since must not be negative",
+		},
+		{
+			name:          "unsupported kind",
+			args:          []string{"fleet/my-fleet"},
+			errorContains: "only supports imagebuild, imageexport and device resources",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := DefaultLogsOptions()
+			o.ConfigFilePath = configFile
+			o.App = tt.app
+			o.Unit = tt.unit
+			o.Since = tt.since
+			err := o.Validate(tt.args)
+			if tt.errorContains != "" {
+				require.ErrorContains(t, err, tt.errorContains)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
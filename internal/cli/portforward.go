@@ -0,0 +1,296 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/flightctl/flightctl/internal/client"
+	"github.com/flightctl/flightctl/internal/console/portforward"
+	"github.com/gorilla/websocket"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+type PortForwardOptions struct {
+	GlobalOptions
+	Address string
+}
+
+// portMapping forwards connections accepted on a local port to a port on the device's loopback interface.
+type portMapping struct {
+	local  uint16
+	remote uint16
+}
+
+func DefaultPortForwardOptions() *PortForwardOptions {
+	return &PortForwardOptions{
+		GlobalOptions: DefaultGlobalOptions(),
+		Address:       "localhost",
+	}
+}
+
+func NewCmdPortForward() *cobra.Command {
+	o := DefaultPortForwardOptions()
+	cmd := &cobra.Command{
+		Use:   "port-forward device/NAME [LOCAL_PORT:]REMOTE_PORT [...[LOCAL_PORT_N:]REMOTE_PORT_N]",
+		Short: "Forward one or more local ports to ports on the loopback interface of a device.",
+		Long: "Forward one or more local ports to ports on the loopback interface of a device. Connections are " +
+			"tunneled through the server, so the device does not need to be reachable from the local machine.",
+		Example: `  # Listen on port 8080 locally, forwarding to port 80 on the device
+  flightctl port-forward device/my-device 8080:80
+
+  # Listen on ports 5432 and 9090 locally, forwarding to the same ports on the device
+  flightctl port-forward device/my-device 5432 9090`,
+		Args: cobra.MinimumNArgs(2),
+		ValidArgsFunction: KindNameAutocomplete{
+			Options:            o,
+			AllowMultipleNames: false,
+			AllowedKinds:       []ResourceKind{DeviceKind},
+		}.ValidArgsFunction,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.Complete(cmd, args); err != nil {
+				return err
+			}
+			if err := o.Validate(args); err != nil {
+				return err
+			}
+			return o.Run(cmd.Context(), args)
+		},
+		SilenceUsage: true,
+	}
+	o.Bind(cmd.Flags())
+	return cmd
+}
+
+func (o *PortForwardOptions) Bind(fs *pflag.FlagSet) {
+	o.GlobalOptions.Bind(fs)
+	fs.StringVar(&o.Address, "address", o.Address, "Local address to listen on.")
+}
+
+func (o *PortForwardOptions) Complete(cmd *cobra.Command, args []string) error {
+	return o.GlobalOptions.Complete(cmd, args)
+}
+
+func (o *PortForwardOptions) Validate(args []string) error {
+	if err := o.GlobalOptions.Validate(args); err != nil {
+		return err
+	}
+	kind, _, err := parseAndValidateKindNameFromArgsSingle(args[:1])
+	if err != nil {
+		return err
+	}
+	if kind != DeviceKind {
+		return fmt.Errorf("only devices support port forwarding")
+	}
+	if _, err := parsePortMappings(args[1:]); err != nil {
+		return err
+	}
+	return nil
+}
+
+// parsePortMappings parses port mappings of the form "LOCAL:REMOTE" or "PORT".
+func parsePortMappings(specs []string) ([]portMapping, error) {
+	ret := make([]portMapping, 0, len(specs))
+	for _, spec := range specs {
+		localStr, remoteStr, found := strings.Cut(spec, ":")
+		if !found {
+			localStr = spec
+			remoteStr = spec
+		}
+		local, err := portforward.ParsePort(localStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid port mapping %q: %w", spec, err)
+		}
+		remote, err := portforward.ParsePort(remoteStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid port mapping %q: %w", spec, err)
+		}
+		ret = append(ret, portMapping{local: local, remote: remote})
+	}
+	return ret, nil
+}
+
+func (o *PortForwardOptions) Run(ctx context.Context, args []string) error {
+	config, err := client.ParseConfigFile(o.ConfigFilePath)
+	if err != nil {
+		return fmt.Errorf("parsing config file: %w", err)
+	}
+
+	_, name, err := parseAndValidateKindNameFromArgsSingle(args[:1])
+	if err != nil {
+		return err
+	}
+	mappings, err := parsePortMappings(args[1:])
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Listen before connecting to the device so that busy local ports are reported right away
+	listeners := make([]net.Listener, 0, len(mappings))
+	defer func() {
+		for _, l := range listeners {
+			_ = l.Close()
+		}
+	}()
+	for _, m := range mappings {
+		l, err := net.Listen("tcp", net.JoinHostPort(o.Address, strconv.Itoa(int(m.local))))
+		if err != nil {
+			return fmt.Errorf("listening on port %d: %w", m.local, err)
+		}
+		listeners = append(listeners, l)
+	}
+
+	refresher := client.NewAccessTokenRefresher(config, o.ConfigFilePath, 8080)
+	refresher.Start(ctx)
+	conn, err := o.dialDeviceSession(ctx, config, name, "portforward", portforward.ProtocolV1Name, refresher.GetAccessToken())
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	f := newPortForwardClient(conn)
+	for i, m := range mappings {
+		fmt.Printf("Forwarding from %s -> %d\n", listeners[i].Addr().String(), m.remote)
+		go f.serve(listeners[i], m.remote)
+	}
+
+	go func() {
+		<-ctx.Done()
+		_ = conn.Close()
+	}()
+	err = f.run()
+	if ctx.Err() != nil {
+		return nil
+	}
+	return err
+}
+
+// portForwardClient multiplexes local connections over a single websocket connection to the device.
+type portForwardClient struct {
+	ws      *websocket.Conn
+	writeMu sync.Mutex
+	nextID  atomic.Uint32
+	mu      sync.Mutex
+	conns   map[uint32]net.Conn
+}
+
+func newPortForwardClient(ws *websocket.Conn) *portForwardClient {
+	return &portForwardClient{
+		ws:    ws,
+		conns: make(map[uint32]net.Conn),
+	}
+}
+
+func (c *portForwardClient) send(f portforward.Frame) error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	return c.ws.WriteMessage(websocket.BinaryMessage, f.Encode())
+}
+
+func (c *portForwardClient) remove(id uint32) net.Conn {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	conn := c.conns[id]
+	if conn != nil {
+		delete(c.conns, id)
+		_ = conn.Close()
+	}
+	return conn
+}
+
+// serve accepts local connections and forwards each of them to the remote port.
+func (c *portForwardClient) serve(l net.Listener, remotePort uint16) {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		id := c.nextID.Add(1)
+		c.mu.Lock()
+		c.conns[id] = conn
+		c.mu.Unlock()
+		if err := c.send(portforward.NewConnectFrame(id, remotePort)); err != nil {
+			c.remove(id)
+			continue
+		}
+		fmt.Printf("Handling connection for %d\n", remotePort)
+		go c.copyToRemote(id, conn)
+	}
+}
+
+func (c *portForwardClient) copyToRemote(id uint32, conn net.Conn) {
+	buffer := make([]byte, 32*1024)
+	for {
+		n, err := conn.Read(buffer)
+		if n > 0 {
+			if sendErr := c.send(portforward.Frame{Type: portforward.FrameData, ConnectionID: id, Payload: buffer[:n]}); sendErr != nil {
+				c.remove(id)
+				return
+			}
+		}
+		if err != nil {
+			break
+		}
+	}
+	if c.remove(id) != nil {
+		// the local side closed the connection
+		_ = c.send(portforward.Frame{Type: portforward.FrameClose, ConnectionID: id})
+	}
+}
+
+// run dispatches frames received from the device until the websocket connection is closed.
+func (c *portForwardClient) run() error {
+	defer func() {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		for id, conn := range c.conns {
+			_ = conn.Close()
+			delete(c.conns, id)
+		}
+	}()
+	for {
+		msgType, msg, err := c.ws.ReadMessage()
+		if err != nil {
+			if websocket.IsCloseError(err, websocket.CloseNormalClosure) {
+				return errors.New("the device closed the port forwarding session")
+			}
+			return fmt.Errorf("lost connection to the device: %w", err)
+		}
+		if msgType != websocket.BinaryMessage || len(msg) == 0 {
+			continue
+		}
+		f, err := portforward.Decode(msg)
+		if err != nil {
+			return err
+		}
+		switch f.Type {
+		case portforward.FrameData:
+			c.mu.Lock()
+			conn := c.conns[f.ConnectionID]
+			c.mu.Unlock()
+			if conn == nil {
+				continue
+			}
+			if _, err := conn.Write(f.Payload); err != nil {
+				if c.remove(f.ConnectionID) != nil {
+					_ = c.send(portforward.Frame{Type: portforward.FrameClose, ConnectionID: f.ConnectionID})
+				}
+			}
+		case portforward.FrameError:
+			fmt.Fprintf(os.Stderr, "Error forwarding connection: %s\n", string(f.Payload))
+			c.remove(f.ConnectionID)
+		case portforward.FrameClose:
+			c.remove(f.ConnectionID)
+		}
+	}
+}
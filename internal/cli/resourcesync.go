@@ -0,0 +1,239 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	api "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/cli/display"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/yaml"
+)
+
+var legalPreviewOutputTypes = []string{string(display.JSONFormat), string(display.YAMLFormat)}
+
+type ResourceSyncPreviewOptions struct {
+	GlobalOptions
+
+	Revision string
+	Output   string
+}
+
+func DefaultResourceSyncPreviewOptions() *ResourceSyncPreviewOptions {
+	return &ResourceSyncPreviewOptions{
+		GlobalOptions: DefaultGlobalOptions(),
+	}
+}
+
+func NewCmdResourceSync() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resourcesync preview NAME [flags]",
+		Short: "Work with resourcesyncs, e.g. preview what syncing a resourcesync would change with 'resourcesync preview'.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+	cmd.AddCommand(newCmdResourceSyncPreview())
+	return cmd
+}
+
+func newCmdResourceSyncPreview() *cobra.Command {
+	o := DefaultResourceSyncPreviewOptions()
+	cmd := &cobra.Command{
+		Use:   "preview NAME [--revision REVISION]",
+		Short: "Show the fleets and catalogs that syncing a resourcesync would create, update or delete, without applying them.",
+		Long: "Show the fleets and catalogs that syncing a resourcesync would create, update or delete, without applying them.\n" +
+			"Exits with an error if the sync would fail, so that it can be used to check changes before merging them.",
+		Example: "  flightctl resourcesync preview my-sync --revision feature-branch",
+		Args:    cobra.ExactArgs(1),
+		ValidArgsFunction: KindNameAutocomplete{
+			Options:            o,
+			AllowMultipleNames: false,
+			AllowedKinds:       []ResourceKind{ResourceSyncKind},
+		}.ValidArgsFunction,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.Complete(cmd, args); err != nil {
+				return err
+			}
+			if err := o.Validate(args); err != nil {
+				return err
+			}
+			ctx, cancel := o.WithTimeout(cmd.Context())
+			defer cancel()
+			return o.Run(ctx, args)
+		},
+		SilenceUsage: true,
+	}
+	o.Bind(cmd.Flags())
+	return cmd
+}
+
+func (o *ResourceSyncPreviewOptions) Bind(fs *pflag.FlagSet) {
+	o.GlobalOptions.Bind(fs)
+
+	fs.StringVarP(&o.Revision, "revision", "r", o.Revision, "Revision of the repository to preview. Defaults to the target revision of the resourcesync.")
+	fs.StringVarP(&o.Output, "output", "o", o.Output, fmt.Sprintf("Output format. One of: (%s).", strings.Join(legalPreviewOutputTypes, ", ")))
+}
+
+func (o *ResourceSyncPreviewOptions) Complete(cmd *cobra.Command, args []string) error {
+	if err := o.GlobalOptions.Complete(cmd, args); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *ResourceSyncPreviewOptions) Validate(args []string) error {
+	if err := o.GlobalOptions.Validate(args); err != nil {
+		return err
+	}
+	if _, err := resourceSyncNameFromArg(args[0]); err != nil {
+		return err
+	}
+	if len(o.Output) > 0 && !slices.Contains(legalPreviewOutputTypes, o.Output) {
+		return fmt.Errorf("output format must be one of (%s)", strings.Join(legalPreviewOutputTypes, ", "))
+	}
+	return nil
+}
+
+func (o *ResourceSyncPreviewOptions) Run(ctx context.Context, args []string) error {
+	c, err := o.BuildClient()
+	if err != nil {
+		return fmt.Errorf("creating client: %w", err)
+	}
+	c.Start(ctx)
+	defer c.Stop()
+
+	name, err := resourceSyncNameFromArg(args[0])
+	if err != nil {
+		return err
+	}
+
+	params := &api.RequestResourceSyncPreviewParams{}
+	if o.Revision != "" {
+		params.Revision = lo.ToPtr(o.Revision)
+	}
+	requestResponse, err := c.RequestResourceSyncPreviewWithResponse(ctx, name, params)
+	if err != nil {
+		return fmt.Errorf("requesting preview of resourcesync %s: %w", name, err)
+	}
+	if err := validateHttpResponse(requestResponse.Body, requestResponse.StatusCode(), http.StatusCreated); err != nil {
+		return fmt.Errorf("requesting preview of resourcesync %s: %w", name, err)
+	}
+	if requestResponse.JSON201 == nil {
+		return fmt.Errorf("requesting preview of resourcesync %s: empty response", name)
+	}
+	requestId := requestResponse.JSON201.RequestId
+
+	// the preview is computed by the workers, so poll until it has been stored
+	var preview *api.ResourceSyncPreview
+	err = wait.PollUntilContextTimeout(ctx, time.Second, 2*time.Minute, false, func(ctx context.Context) (bool, error) {
+		response, err := c.GetResourceSyncPreviewWithResponse(ctx, name, requestId)
+		if err != nil {
+			return false, err
+		}
+		if response.StatusCode() == http.StatusNotFound {
+			return false, nil
+		}
+		if err := validateHttpResponse(response.Body, response.StatusCode(), http.StatusOK); err != nil {
+			return false, err
+		}
+		if response.JSON200 == nil {
+			return false, fmt.Errorf("empty response")
+		}
+		preview = response.JSON200
+		return true, nil
+	})
+	switch {
+	case err == nil:
+	case wait.Interrupted(err):
+		return fmt.Errorf("timeout waiting for preview of resourcesync %s", name)
+	default:
+		return fmt.Errorf("previewing resourcesync %s: %w", name, err)
+	}
+
+	switch o.Output {
+	case string(display.YAMLFormat):
+		marshalled, err := yaml.Marshal(preview)
+		if err != nil {
+			return err
+		}
+		fmt.Print(string(marshalled))
+	case string(display.JSONFormat):
+		marshalled, err := json.MarshalIndent(preview, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(marshalled))
+	default:
+		if err := printResourceSyncPreview(os.Stdout, name, preview); err != nil {
+			return err
+		}
+	}
+
+	if len(preview.Errors) > 0 {
+		return fmt.Errorf("syncing resourcesync %s would fail with %d error(s)", name, len(preview.Errors))
+	}
+	return nil
+}
+
+// resourceSyncNameFromArg accepts both NAME and resourcesync/NAME
+func resourceSyncNameFromArg(arg string) (string, error) {
+	kindLike, name, found := strings.Cut(arg, "/")
+	if !found {
+		return arg, nil
+	}
+	kind, err := ResourceKindFromString(kindLike)
+	if err != nil {
+		return "", err
+	}
+	if kind != ResourceSyncKind {
+		return "", fmt.Errorf("kind must be ResourceSync")
+	}
+	if name == "" {
+		return "", fmt.Errorf("specify a specific resourcesync to preview")
+	}
+	return name, nil
+}
+
+func printResourceSyncPreview(out io.Writer, name string, preview *api.ResourceSyncPreview) error {
+	revision := preview.Revision
+	if preview.Commit != nil {
+		revision = fmt.Sprintf("%s (%s)", revision, *preview.Commit)
+	}
+	fmt.Fprintf(out, "Preview of resourcesync %s at revision %s\n\n", name, revision)
+
+	if len(preview.Changes) == 0 {
+		fmt.Fprintln(out, "No changes.")
+	} else {
+		w := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+		fmt.Fprintln(w, "ACTION\tKIND\tNAME")
+		for _, change := range preview.Changes {
+			fmt.Fprintf(w, "%s\t%s\t%s\n", strings.ToUpper(string(change.Action)), change.Kind, change.Name)
+			for _, diff := range lo.FromPtr(change.Diff) {
+				fmt.Fprintf(w, "\t\t  %s: %s -> %s\n", diff.Path, lo.FromPtrOr(diff.Old, "<none>"), lo.FromPtrOr(diff.New, "<none>"))
+			}
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	}
+
+	if len(preview.Errors) > 0 {
+		fmt.Fprintln(out, "\nErrors:")
+		for _, e := range preview.Errors {
+			fmt.Fprintf(out, "  %s\n", e)
+		}
+	}
+	return nil
+}
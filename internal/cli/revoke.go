@@ -0,0 +1,134 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	api "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+var allowedRevocationReasons = []string{
+	string(api.CertificateRevocationReasonUnspecified),
+	string(api.CertificateRevocationReasonKeyCompromise),
+	string(api.CertificateRevocationReasonSuperseded),
+	string(api.CertificateRevocationReasonCessationOfOperation),
+}
+
+type RevokeOptions struct {
+	GlobalOptions
+	Reason string
+}
+
+func DefaultRevokeOptions() *RevokeOptions {
+	return &RevokeOptions{
+		GlobalOptions: DefaultGlobalOptions(),
+		Reason:        string(api.CertificateRevocationReasonUnspecified),
+	}
+}
+
+func NewCmdRevoke() *cobra.Command {
+	o := DefaultRevokeOptions()
+	cmd := &cobra.Command{
+		Use:   "revoke device/NAME",
+		Short: "Revoke the management certificates of a device.",
+		Args:  cobra.MinimumNArgs(1),
+		ValidArgsFunction: KindNameAutocomplete{
+			Options:            o,
+			AllowMultipleNames: false,
+			AllowedKinds:       []ResourceKind{DeviceKind},
+		}.ValidArgsFunction,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.Complete(cmd, args); err != nil {
+				return err
+			}
+			if err := o.Validate(args); err != nil {
+				return err
+			}
+			ctx, cancel := o.WithTimeout(cmd.Context())
+			defer cancel()
+			return o.Run(ctx, args)
+		},
+		SilenceUsage: true,
+	}
+	o.Bind(cmd.Flags())
+	return cmd
+}
+
+func (o *RevokeOptions) Bind(fs *pflag.FlagSet) {
+	o.GlobalOptions.Bind(fs)
+	fs.StringVarP(&o.Reason, "reason", "r", o.Reason, fmt.Sprintf("Reason for the revocation: (%s)", strings.Join(allowedRevocationReasons, ", ")))
+}
+
+func (o *RevokeOptions) Complete(cmd *cobra.Command, args []string) error {
+	if err := o.GlobalOptions.Complete(cmd, args); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *RevokeOptions) Validate(args []string) error {
+	if err := o.GlobalOptions.Validate(args); err != nil {
+		return err
+	}
+
+	kind, name, err := parseAndValidateKindNameFromArgsSingle(args)
+	if err != nil {
+		return err
+	}
+
+	if kind != DeviceKind {
+		return fmt.Errorf("kind must be Device")
+	}
+
+	if len(name) == 0 {
+		return fmt.Errorf("specify a specific device to revoke")
+	}
+
+	if !slices.Contains(allowedRevocationReasons, o.Reason) {
+		return fmt.Errorf("revocation reason must be one of: (%s)", strings.Join(allowedRevocationReasons, ", "))
+	}
+
+	return nil
+}
+
+func (o *RevokeOptions) Run(ctx context.Context, args []string) error {
+	c, err := o.BuildClient()
+	if err != nil {
+		return fmt.Errorf("creating client: %w", err)
+	}
+	c.Start(ctx)
+	defer c.Stop()
+
+	_, name, err := parseAndValidateKindNameFromArgsSingle(args)
+	if err != nil {
+		return err
+	}
+
+	body := api.DeviceRevocation{Reason: api.CertificateRevocationReason(o.Reason)}
+	response, err := c.RevokeDeviceCertificatesWithResponse(ctx, name, body)
+	if err != nil {
+		return fmt.Errorf("revoking certificates of device %s: %w", name, err)
+	}
+
+	if response.HTTPResponse != nil {
+		if response.HTTPResponse.StatusCode != http.StatusOK {
+			return fmt.Errorf("unsuccessful revoking device certificates request %s: %s", name, string(response.Body))
+		}
+	}
+
+	if response.JSON200 == nil || len(response.JSON200.Certificates) == 0 {
+		fmt.Printf("No unexpired certificates to revoke for device: %s\n", name)
+		return nil
+	}
+	for _, cert := range response.JSON200.Certificates {
+		fmt.Printf("Revoked certificate %s of device %s (not after %s)\n", cert.SerialNumber, name, cert.NotAfter.Format(time.RFC3339))
+	}
+	return nil
+}
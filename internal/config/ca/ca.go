@@ -9,6 +9,8 @@ type CAIdType int
 const (
 	InternalCA CAIdType = iota + 1
 	AsyncInternalCA
+	// PKCS11CA signs with a key held by a PKCS#11 token such as an HSM
+	PKCS11CA
 )
 
 type InternalCfg struct {
@@ -21,6 +23,24 @@ type InternalCfg struct {
 	CertStore        string `json:"certStore,omitempty"`
 }
 
+// PKCS11Cfg selects the CA signing key on a PKCS#11 token. Only the CA certificate is kept
+// in the cert store; the private key never leaves the token.
+type PKCS11Cfg struct {
+	// ModulePath is the path of the PKCS#11 library provided by the HSM vendor
+	ModulePath string `json:"modulePath,omitempty"`
+	// TokenLabel selects the token holding the key; the first token with a matching label is used
+	TokenLabel string `json:"tokenLabel,omitempty"`
+	// PinFile is the file holding the user PIN of the token
+	PinFile string `json:"pinFile,omitempty"`
+	// KeyLabel is the CKA_LABEL of the CA private key
+	KeyLabel         string `json:"keyLabel,omitempty"`
+	CertFile         string `json:"certFile,omitempty"`
+	CABundleFile     string `json:"caBundleFile,omitempty"`
+	SignerCertName   string `json:"signerCertName,omitempty"`
+	CertValidityDays int    `json:"certValidityDays,omitempty"`
+	CertStore        string `json:"certStore,omitempty"`
+}
+
 type Config struct {
 	CAType                            CAIdType     `json:"type,omitempty"`
 	AdminCommonName                   string       `json:"adminCommonName,omitempty"`
@@ -35,6 +55,7 @@ type Config struct {
 	ClientBootstrapValidityDays       int          `json:"clientBootstrapValidityDays,omitempty"`
 	DeviceCommonNamePrefix            string       `json:"deviceCommonNamePrefix,omitempty"`
 	InternalConfig                    *InternalCfg `json:"internalConfig,omitempty"`
+	PKCS11Config                      *PKCS11Cfg   `json:"pkcs11Config,omitempty"`
 	ServerCertValidityDays            int          `json:"serverCertValidityDays,omitempty"`
 	ExtraAllowedPrefixes              []string     `json:"extraAllowedPrefixes,omitempty"`
 }
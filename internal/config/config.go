@@ -12,6 +12,7 @@ import (
 	api "github.com/flightctl/flightctl/api/core/v1beta1"
 	authprovider "github.com/flightctl/flightctl/internal/auth/provider"
 	"github.com/flightctl/flightctl/internal/config/ca"
+	"github.com/flightctl/flightctl/internal/crypto"
 	"github.com/flightctl/flightctl/internal/org"
 	"github.com/flightctl/flightctl/internal/util"
 	"sigs.k8s.io/yaml"
@@ -37,6 +38,7 @@ type Config struct {
 	Periodic            *periodicConfig            `json:"periodic,omitempty"`
 	Organizations       *organizationsConfig       `json:"organizations,omitempty"`
 	TelemetryGateway    *telemetryGatewayConfig    `json:"telemetrygateway,omitempty"`
+	Secrets             *secretsConfig             `json:"secrets,omitempty"`
 }
 
 // CryptoPolicyConfig contains cryptographic policy configuration for all protocols.
@@ -425,7 +427,8 @@ type periodicTaskConfig struct {
 }
 
 type periodicTasksConfig struct {
-	ResourceSync periodicTaskConfig `json:"resourceSync,omitempty"`
+	ResourceSync     periodicTaskConfig `json:"resourceSync,omitempty"`
+	RepositoryTester periodicTaskConfig `json:"repositoryTester,omitempty"`
 }
 
 type periodicConfig struct {
@@ -437,6 +440,21 @@ type organizationsConfig struct {
 	Enabled bool `json:"enabled,omitempty"`
 }
 
+type secretsConfig struct {
+	// KeyEncryptionKey is the secret that the key encrypting the data of Secret resources is derived from.
+	// Secret resources cannot be used if it is not set. Changing it makes existing Secret resources unreadable.
+	KeyEncryptionKey api.SecureString `json:"keyEncryptionKey,omitempty"`
+}
+
+// SecretEnvelope returns the envelope that encrypts the data of Secret resources, or nil if no key encryption key
+// is configured.
+func (c *Config) SecretEnvelope() (*crypto.Envelope, error) {
+	if c.Secrets == nil || c.Secrets.KeyEncryptionKey == "" {
+		return nil, nil
+	}
+	return crypto.NewEnvelope(c.Secrets.KeyEncryptionKey.Value())
+}
+
 type telemetryGatewayConfig struct {
 	LogLevel string                    `json:"logLevel,omitempty"`
 	TLS      telemetryGatewayTLSConfig `json:"tls,omitempty"`
@@ -743,6 +761,7 @@ func Load(cfgFile string) (*Config, error) {
 	if err := applyAuthDefaults(c); err != nil {
 		return nil, fmt.Errorf("applying auth defaults: %w", err)
 	}
+	applyCADefaults(c)
 
 	return c, nil
 }
@@ -763,6 +782,12 @@ func applyEnvVarOverrides(c *Config) {
 	if dbMigrationPass := os.Getenv("DB_MIGRATION_PASSWORD"); dbMigrationPass != "" {
 		c.Database.MigrationPassword = api.SecureString(dbMigrationPass)
 	}
+	if kek := os.Getenv("SECRETS_KEK"); kek != "" {
+		if c.Secrets == nil {
+			c.Secrets = &secretsConfig{}
+		}
+		c.Secrets.KeyEncryptionKey = api.SecureString(kek)
+	}
 	// CRYPTO_FORCE_FIPS environment variable sets the global crypto policy FIPS mode.
 	// This overrides auto-detection and applies to all cryptographic protocols.
 	// Valid values: "true", "1" (enable), "false", "0" (disable)
@@ -788,6 +813,25 @@ func applyEnvVarOverrides(c *Config) {
 	}
 }
 
+// applyCADefaults fills in the settings of the PKCS#11 CA that are shared with the file-based CA
+// with the defaults of the latter.
+func applyCADefaults(c *Config) {
+	if c.CA == nil || c.CA.PKCS11Config == nil {
+		return
+	}
+	p11 := c.CA.PKCS11Config
+	defaults := ca.NewDefault(CertificateDir()).InternalConfig
+	if p11.SignerCertName == "" {
+		p11.SignerCertName = defaults.SignerCertName
+	}
+	if p11.CertValidityDays == 0 {
+		p11.CertValidityDays = defaults.CertValidityDays
+	}
+	if p11.CertStore == "" {
+		p11.CertStore = defaults.CertStore
+	}
+}
+
 func applyAuthDefaults(c *Config) error {
 	if c.Auth == nil {
 		return nil
@@ -962,6 +1006,10 @@ func Validate(cfg *Config) error {
 		}
 	}
 
+	if cfg.Secrets != nil && cfg.Secrets.KeyEncryptionKey != "" && len(cfg.Secrets.KeyEncryptionKey) < crypto.MinKeyEncryptionKeyLength {
+		return fmt.Errorf("secrets.keyEncryptionKey must be at least %d characters", crypto.MinKeyEncryptionKeyLength)
+	}
+
 	if cfg.Service != nil && cfg.Service.HealthChecks != nil && cfg.Service.HealthChecks.Enabled {
 		hc := cfg.Service.HealthChecks
 		if strings.TrimSpace(hc.ReadinessPath) == "" {
@@ -1012,6 +1060,28 @@ func Validate(cfg *Config) error {
 		}
 	}
 
+	if cfg.CA != nil && cfg.CA.CAType == ca.PKCS11CA {
+		p11 := cfg.CA.PKCS11Config
+		if p11 == nil {
+			return fmt.Errorf("ca.pkcs11Config must be set for the PKCS#11 CA")
+		}
+		if strings.TrimSpace(p11.ModulePath) == "" {
+			return fmt.Errorf("ca.pkcs11Config.modulePath must be non-empty")
+		}
+		if strings.TrimSpace(p11.KeyLabel) == "" {
+			return fmt.Errorf("ca.pkcs11Config.keyLabel must be non-empty")
+		}
+		if strings.TrimSpace(p11.CertFile) == "" {
+			return fmt.Errorf("ca.pkcs11Config.certFile must be non-empty")
+		}
+		if strings.TrimSpace(p11.SignerCertName) == "" {
+			return fmt.Errorf("ca.pkcs11Config.signerCertName must be non-empty")
+		}
+		if p11.CertValidityDays <= 0 {
+			return fmt.Errorf("ca.pkcs11Config.certValidityDays must be greater than 0")
+		}
+	}
+
 	// Validate OIDC and OAuth2 provider role assignments
 	if cfg.Auth != nil {
 		if cfg.Auth.OIDC != nil {
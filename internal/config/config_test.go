@@ -1,11 +1,14 @@
 package config
 
 import (
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
 	"github.com/flightctl/flightctl/internal/domain"
 	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
 )
 
 func TestConfig_String_ObfuscatesSensitiveData(t *testing.T) {
@@ -200,3 +203,28 @@ func TestConfig_String_HandlesEmptyClientSecrets(t *testing.T) {
 		t.Error("Should handle empty client secrets gracefully")
 	}
 }
+
+func TestLoad_AppliesPKCS11CADefaults(t *testing.T) {
+	cfgFile := filepath.Join(t.TempDir(), "config.yaml")
+	contents := `ca:
+  type: 3
+  pkcs11Config:
+    modulePath: /usr/lib64/pkcs11/libsofthsm2.so
+    keyLabel: flightctl-client-signer
+    certFile: client-signer.crt
+`
+	require.NoError(t, os.WriteFile(cfgFile, []byte(contents), 0600))
+
+	cfg, err := Load(cfgFile)
+	require.NoError(t, err)
+	require.Equal(t, "client-signer", cfg.CA.PKCS11Config.SignerCertName)
+	require.Equal(t, 3650, cfg.CA.PKCS11Config.CertValidityDays)
+	require.Equal(t, CertificateDir(), cfg.CA.PKCS11Config.CertStore)
+	require.NoError(t, Validate(cfg))
+
+	cfg.CA.PKCS11Config.CertValidityDays = -1
+	require.ErrorContains(t, Validate(cfg), "certValidityDays")
+	cfg.CA.PKCS11Config.CertValidityDays = 365
+	cfg.CA.PKCS11Config.SignerCertName = " "
+	require.ErrorContains(t, Validate(cfg), "signerCertName")
+}
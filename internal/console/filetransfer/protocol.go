@@ -0,0 +1,220 @@
+package filetransfer
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ProtocolV1Name is the websocket subprotocol negotiated between the client and the agent for file transfer
+// sessions.  A console session negotiating this protocol copies a single file instead of running a shell.
+const ProtocolV1Name = "v1.filetransfer.flightctl.io"
+
+// ChunkSize is the maximal size of the payload of a data message.
+const ChunkSize = 32 * 1024
+
+// PartialSuffix is appended to the name of a file while it is being received, so that an interrupted transfer
+// can be resumed and an incomplete file is never mistaken for the complete one.
+const PartialSuffix = ".flightctl-part"
+
+// PartialInfoSuffix is appended to the name of a partially received file to name the file recording the source it
+// is received from. A transfer is only resumed if it copies the same source.
+const PartialInfoSuffix = PartialSuffix + ".info"
+
+type MessageType byte
+
+const (
+	// MessageRequest is sent by the client to start the transfer. The payload is a JSON encoded Request.
+	MessageRequest MessageType = 0
+	// MessageInfo is sent by the agent in response to the request. The payload is a JSON encoded Info.
+	MessageInfo MessageType = 1
+	// MessageData carries a chunk of the file content.
+	MessageData MessageType = 2
+	// MessageDone is sent by the sender of the file after the last chunk. The payload is a JSON encoded Done.
+	// For uploads, the agent acknowledges a verified file with a MessageDone of its own.
+	MessageDone MessageType = 3
+	// MessageError reports a failure of the transfer. The payload is a human-readable message.
+	MessageError MessageType = 4
+)
+
+type Operation string
+
+const (
+	// OperationDownload copies a file from the device.
+	OperationDownload Operation = "download"
+	// OperationUpload copies a file to the device.
+	OperationUpload Operation = "upload"
+)
+
+// Request describes the transfer requested by the client.
+type Request struct {
+	Operation Operation `json:"operation"`
+	// Path is the absolute path of the file on the device. For uploads, it may name an existing directory, in
+	// which case the file is created in it using Name.
+	Path string `json:"path"`
+	// Name is the base name of the uploaded file.
+	Name string `json:"name,omitempty"`
+	// Offset is the number of bytes the client already received in an earlier, interrupted download.
+	Offset int64 `json:"offset,omitempty"`
+	// Size is the total size of the uploaded file.
+	Size int64 `json:"size,omitempty"`
+	// Mode holds the permission bits of the uploaded file.
+	Mode uint32 `json:"mode,omitempty"`
+	// SHA256 is the hex encoded checksum of the complete uploaded file. For downloads, it is the checksum of the
+	// file that the first Offset bytes were received from, so that the agent can verify that the file is unchanged.
+	SHA256 string `json:"sha256,omitempty"`
+}
+
+// Info is the agent's response to a request.
+type Info struct {
+	// Offset is the position from which the content is transferred. For downloads, it is the requested offset
+	// if it is still valid and the file is unchanged, and zero otherwise. For uploads, it is the number of bytes the agent already received
+	// in an earlier, interrupted upload.
+	Offset int64 `json:"offset"`
+	// Size is the total size of the downloaded file.
+	Size int64 `json:"size,omitempty"`
+	// Mode holds the permission bits of the downloaded file.
+	Mode uint32 `json:"mode,omitempty"`
+	// SHA256 is the hex encoded checksum of the complete downloaded file.
+	SHA256 string `json:"sha256,omitempty"`
+}
+
+// Done completes the transfer of the content.
+type Done struct {
+	// SHA256 is the hex encoded checksum of the complete uploaded file.
+	SHA256 string `json:"sha256,omitempty"`
+}
+
+// PartialInfo identifies the source of a partially received file.
+type PartialInfo struct {
+	// Size is the total size of the source file.
+	Size int64 `json:"size"`
+	// SHA256 is the hex encoded checksum of the source file.
+	SHA256 string `json:"sha256"`
+}
+
+// PartialInfoPath returns the path of the file recording the source of the partially received file.
+func PartialInfoPath(partPath string) string {
+	return strings.TrimSuffix(partPath, PartialSuffix) + PartialInfoSuffix
+}
+
+// ReadPartialInfo returns the recorded source of the partially received file, or nil if there is none.
+func ReadPartialInfo(partPath string) *PartialInfo {
+	b, err := os.ReadFile(PartialInfoPath(partPath))
+	if err != nil {
+		return nil
+	}
+	var info PartialInfo
+	if err = json.Unmarshal(b, &info); err != nil || info.SHA256 == "" {
+		return nil
+	}
+	return &info
+}
+
+// WritePartialInfo records the source of the partially received file. The record is written to a temporary
+// file that is renamed into place, so that a symbolic link at its path is replaced rather than followed.
+func WritePartialInfo(partPath string, info PartialInfo) error {
+	b, err := json.Marshal(info)
+	if err != nil {
+		return err
+	}
+	infoPath := PartialInfoPath(partPath)
+	tmp, err := os.CreateTemp(filepath.Dir(infoPath), filepath.Base(infoPath)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err = tmp.Write(b); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), infoPath)
+}
+
+// RemovePartial removes the partially received file along with its recorded source.
+func RemovePartial(partPath string) {
+	_ = os.Remove(partPath)
+	_ = os.Remove(PartialInfoPath(partPath))
+}
+
+// ResumeOffset returns the number of bytes of the partially received file that can be kept when receiving the
+// given source. The partial file is only resumed if it was received from the same source.
+func ResumeOffset(partPath string, source PartialInfo) int64 {
+	recorded := ReadPartialInfo(partPath)
+	if recorded == nil || *recorded != source {
+		return 0
+	}
+	info, err := os.Stat(partPath)
+	if err != nil || !info.Mode().IsRegular() || info.Size() > source.Size {
+		return 0
+	}
+	return info.Size()
+}
+
+// Message is a single message of a file transfer session. Every message sent over the session stream
+// contains exactly one message.
+type Message struct {
+	Type    MessageType
+	Payload []byte
+}
+
+// Encode serializes the message as [type][payload].
+func (m Message) Encode() []byte {
+	return append([]byte{byte(m.Type)}, m.Payload...)
+}
+
+// Decode parses a message produced by Encode.
+func Decode(b []byte) (Message, error) {
+	if len(b) == 0 {
+		return Message{}, fmt.Errorf("empty message")
+	}
+	m := Message{Type: MessageType(b[0]), Payload: b[1:]}
+	if m.Type > MessageError {
+		return Message{}, fmt.Errorf("unknown message type %d", m.Type)
+	}
+	return m, nil
+}
+
+// NewMessage creates a message with a JSON encoded payload.
+func NewMessage(t MessageType, v any) (Message, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return Message{}, err
+	}
+	return Message{Type: t, Payload: b}, nil
+}
+
+// NewErrorMessage creates a message reporting the error.
+func NewErrorMessage(err error) Message {
+	return Message{Type: MessageError, Payload: []byte(err.Error())}
+}
+
+// Unmarshal decodes the JSON payload of the message into v.
+func (m Message) Unmarshal(v any) error {
+	if err := json.Unmarshal(m.Payload, v); err != nil {
+		return fmt.Errorf("invalid message payload: %w", err)
+	}
+	return nil
+}
+
+// FileSHA256 returns the hex encoded checksum of the file's content.
+func FileSHA256(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
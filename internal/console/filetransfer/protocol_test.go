@@ -0,0 +1,110 @@
+package filetransfer
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMessageEncodeDecode(t *testing.T) {
+	tests := []struct {
+		name    string
+		message Message
+	}{
+		{
+			name:    "data",
+			message: Message{Type: MessageData, Payload: []byte("content")},
+		},
+		{
+			name:    "empty payload",
+			message: Message{Type: MessageDone, Payload: []byte{}},
+		},
+		{
+			name:    "error",
+			message: NewErrorMessage(errors.New("failed")),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			decoded, err := Decode(tt.message.Encode())
+			require.NoError(t, err)
+			require.Equal(t, tt.message, decoded)
+		})
+	}
+}
+
+func TestDecodeInvalid(t *testing.T) {
+	_, err := Decode(nil)
+	require.Error(t, err)
+	_, err = Decode([]byte{byte(MessageError) + 1})
+	require.Error(t, err)
+}
+
+func TestNewMessage(t *testing.T) {
+	m, err := NewMessage(MessageRequest, &Request{Operation: OperationDownload, Path: "/etc/hosts", Offset: 10})
+	require.NoError(t, err)
+
+	var req Request
+	require.NoError(t, m.Unmarshal(&req))
+	require.Equal(t, Request{Operation: OperationDownload, Path: "/etc/hosts", Offset: 10}, req)
+	require.Error(t, Message{Type: MessageRequest, Payload: []byte("{")}.Unmarshal(&req))
+}
+
+func TestResumeOffset(t *testing.T) {
+	source := PartialInfo{Size: 10, SHA256: "abc"}
+	tests := []struct {
+		name     string
+		partial  []byte
+		recorded *PartialInfo
+		expected int64
+	}{
+		{
+			name:     "same source",
+			partial:  []byte("0123"),
+			recorded: &source,
+			expected: 4,
+		},
+		{
+			name:    "no recorded source",
+			partial: []byte("0123"),
+		},
+		{
+			name:     "different checksum",
+			partial:  []byte("0123"),
+			recorded: &PartialInfo{Size: 10, SHA256: "def"},
+		},
+		{
+			name:     "different size",
+			partial:  []byte("0123"),
+			recorded: &PartialInfo{Size: 11, SHA256: "abc"},
+		},
+		{
+			name:     "larger than the source",
+			partial:  []byte("0123456789a"),
+			recorded: &source,
+		},
+		{
+			name:     "no partial file",
+			recorded: &source,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			partPath := filepath.Join(t.TempDir(), "file"+PartialSuffix)
+			if tt.partial != nil {
+				require.NoError(t, os.WriteFile(partPath, tt.partial, 0600))
+			}
+			if tt.recorded != nil {
+				require.NoError(t, WritePartialInfo(partPath, *tt.recorded))
+			}
+			require.Equal(t, tt.expected, ResumeOffset(partPath, source))
+
+			RemovePartial(partPath)
+			require.NoFileExists(t, partPath)
+			require.Nil(t, ReadPartialInfo(partPath))
+		})
+	}
+}
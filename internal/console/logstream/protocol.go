@@ -0,0 +1,101 @@
+package logstream
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// ProtocolV1Name is the websocket subprotocol negotiated between the client and the agent for log sessions.
+// A console session negotiating this protocol streams the logs of an application or a systemd unit instead of
+// running a shell.
+const ProtocolV1Name = "v1.logs.flightctl.io"
+
+// ChunkSize is the maximal size of the payload of a data message.
+const ChunkSize = 32 * 1024
+
+type MessageType byte
+
+const (
+	// MessageRequest is sent by the client to start streaming. The payload is a JSON encoded Request.
+	MessageRequest MessageType = 0
+	// MessageData carries a chunk of the log output.
+	MessageData MessageType = 1
+	// MessageDone is sent by the agent after the last chunk, once the log reader has exited.
+	MessageDone MessageType = 2
+	// MessageError reports a failure of the session. The payload is a human-readable message.
+	MessageError MessageType = 3
+)
+
+// Request describes the logs requested by the client. Exactly one of App and Unit must be set.
+type Request struct {
+	// App is the name of the application whose container logs are streamed.
+	App string `json:"app,omitempty"`
+	// Unit is the name of the systemd unit whose journal is streamed.
+	Unit string `json:"unit,omitempty"`
+	// Follow keeps streaming new log lines until the client closes the session.
+	Follow bool `json:"follow,omitempty"`
+	// SinceSeconds limits the logs to those written in the given number of seconds before the request. It is
+	// relative so that the result does not depend on the clocks of the client and the device being in sync.
+	SinceSeconds int64 `json:"sinceSeconds,omitempty"`
+}
+
+// Validate checks that the request names exactly one log source.
+func (r *Request) Validate() error {
+	if (r.App == "") == (r.Unit == "") {
+		return fmt.Errorf("exactly one of app and unit must be specified")
+	}
+	if strings.HasPrefix(r.App, "-") || strings.HasPrefix(r.Unit, "-") {
+		return fmt.Errorf("invalid log source %q", r.App+r.Unit)
+	}
+	if r.SinceSeconds < 0 {
+		return fmt.Errorf("sinceSeconds must not be negative")
+	}
+	return nil
+}
+
+// Message is a single message of a log session. Every message sent over the session stream contains exactly
+// one message.
+type Message struct {
+	Type    MessageType
+	Payload []byte
+}
+
+// Encode serializes the message as [type][payload].
+func (m Message) Encode() []byte {
+	return append([]byte{byte(m.Type)}, m.Payload...)
+}
+
+// Decode parses a message produced by Encode.
+func Decode(b []byte) (Message, error) {
+	if len(b) == 0 {
+		return Message{}, fmt.Errorf("empty message")
+	}
+	m := Message{Type: MessageType(b[0]), Payload: b[1:]}
+	if m.Type > MessageError {
+		return Message{}, fmt.Errorf("unknown message type %d", m.Type)
+	}
+	return m, nil
+}
+
+// NewRequestMessage creates the message starting a log session.
+func NewRequestMessage(r *Request) (Message, error) {
+	b, err := json.Marshal(r)
+	if err != nil {
+		return Message{}, err
+	}
+	return Message{Type: MessageRequest, Payload: b}, nil
+}
+
+// NewErrorMessage creates a message reporting the error.
+func NewErrorMessage(err error) Message {
+	return Message{Type: MessageError, Payload: []byte(err.Error())}
+}
+
+// Unmarshal decodes the JSON payload of the message into v.
+func (m Message) Unmarshal(v any) error {
+	if err := json.Unmarshal(m.Payload, v); err != nil {
+		return fmt.Errorf("invalid message payload: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package logstream
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMessageEncodeDecode(t *testing.T) {
+	tests := []struct {
+		name    string
+		message Message
+	}{
+		{
+			name:    "data",
+			message: Message{Type: MessageData, Payload: []byte("line\n")},
+		},
+		{
+			name:    "empty payload",
+			message: Message{Type: MessageDone, Payload: []byte{}},
+		},
+		{
+			name:    "error",
+			message: NewErrorMessage(errors.New("failed")),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			decoded, err := Decode(tt.message.Encode())
+			require.NoError(t, err)
+			require.Equal(t, tt.message, decoded)
+		})
+	}
+}
+
+func TestDecodeInvalid(t *testing.T) {
+	_, err := Decode(nil)
+	require.Error(t, err)
+	_, err = Decode([]byte{byte(MessageError) + 1})
+	require.Error(t, err)
+}
+
+func TestRequest(t *testing.T) {
+	m, err := NewRequestMessage(&Request{App: "web", Follow: true, SinceSeconds: 600})
+	require.NoError(t, err)
+	require.Equal(t, MessageRequest, m.Type)
+
+	var req Request
+	require.NoError(t, m.Unmarshal(&req))
+	require.Equal(t, Request{App: "web", Follow: true, SinceSeconds: 600}, req)
+	require.NoError(t, req.Validate())
+
+	require.Error(t, (&Request{}).Validate())
+	require.Error(t, (&Request{App: "web", Unit: "web.service"}).Validate())
+	require.Error(t, (&Request{Unit: "web.service", SinceSeconds: -1}).Validate())
+	require.Error(t, (&Request{Unit: "--system"}).Validate())
+	require.Error(t, Message{Type: MessageRequest, Payload: []byte("{")}.Unmarshal(&req))
+}
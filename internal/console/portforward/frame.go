@@ -0,0 +1,84 @@
+package portforward
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strconv"
+)
+
+// ProtocolV1Name is the websocket subprotocol negotiated between the client and the agent for port forwarding
+// sessions.  A console session negotiating this protocol carries multiplexed TCP connections instead of a PTY.
+const ProtocolV1Name = "v1.portforward.flightctl.io"
+
+type FrameType byte
+
+const (
+	// FrameConnect asks the agent to open a connection. The payload is the remote port as a big-endian uint16.
+	FrameConnect FrameType = 0
+	// FrameData carries bytes of an open connection in either direction.
+	FrameData FrameType = 1
+	// FrameClose signals that the sender has closed the connection.
+	FrameClose FrameType = 2
+	// FrameError reports a failure of the connection. The payload is a human-readable message.
+	FrameError FrameType = 3
+)
+
+// headerLen is the length of the frame type and connection ID that precede the payload.
+const headerLen = 5
+
+// Frame is a single message of a port forwarding session. Every message sent over the session stream
+// contains exactly one frame.
+type Frame struct {
+	Type         FrameType
+	ConnectionID uint32
+	Payload      []byte
+}
+
+// Encode serializes the frame as [type][4-byte big-endian connection ID][payload].
+func (f Frame) Encode() []byte {
+	ret := make([]byte, headerLen+len(f.Payload))
+	ret[0] = byte(f.Type)
+	binary.BigEndian.PutUint32(ret[1:headerLen], f.ConnectionID)
+	copy(ret[headerLen:], f.Payload)
+	return ret
+}
+
+// Decode parses a frame produced by Encode.
+func Decode(b []byte) (Frame, error) {
+	if len(b) < headerLen {
+		return Frame{}, fmt.Errorf("frame too short: %d bytes", len(b))
+	}
+	f := Frame{
+		Type:         FrameType(b[0]),
+		ConnectionID: binary.BigEndian.Uint32(b[1:headerLen]),
+		Payload:      b[headerLen:],
+	}
+	if f.Type > FrameError {
+		return Frame{}, fmt.Errorf("unknown frame type %d", f.Type)
+	}
+	return f, nil
+}
+
+// NewConnectFrame creates a frame requesting a connection to the given remote port.
+func NewConnectFrame(id uint32, port uint16) Frame {
+	payload := make([]byte, 2)
+	binary.BigEndian.PutUint16(payload, port)
+	return Frame{Type: FrameConnect, ConnectionID: id, Payload: payload}
+}
+
+// Port returns the remote port requested by a connect frame.
+func (f Frame) Port() (uint16, error) {
+	if f.Type != FrameConnect || len(f.Payload) != 2 {
+		return 0, fmt.Errorf("not a valid connect frame")
+	}
+	return binary.BigEndian.Uint16(f.Payload), nil
+}
+
+// ParsePort parses a TCP port number in the range 1-65535.
+func ParsePort(s string) (uint16, error) {
+	port, err := strconv.ParseUint(s, 10, 16)
+	if err != nil || port == 0 {
+		return 0, fmt.Errorf("invalid port %q", s)
+	}
+	return uint16(port), nil
+}
@@ -0,0 +1,69 @@
+package portforward
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFrameRoundTrip(t *testing.T) {
+	require := require.New(t)
+
+	f, err := Decode(Frame{Type: FrameData, ConnectionID: 0x01020304, Payload: []byte("hello")}.Encode())
+	require.NoError(err)
+	require.Equal(FrameData, f.Type)
+	require.Equal(uint32(0x01020304), f.ConnectionID)
+	require.Equal([]byte("hello"), f.Payload)
+
+	f, err = Decode(NewConnectFrame(5, 8080).Encode())
+	require.NoError(err)
+	port, err := f.Port()
+	require.NoError(err)
+	require.Equal(uint16(8080), port)
+
+	_, err = Frame{Type: FrameData}.Port()
+	require.Error(err)
+}
+
+func TestDecodeInvalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+	}{
+		{name: "empty", input: nil},
+		{name: "short header", input: []byte{byte(FrameData), 0, 0}},
+		{name: "unknown type", input: []byte{42, 0, 0, 0, 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Decode(tt.input)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestParsePort(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected uint16
+		wantErr  bool
+	}{
+		{input: "80", expected: 80},
+		{input: "65535", expected: 65535},
+		{input: "0", wantErr: true},
+		{input: "65536", wantErr: true},
+		{input: "http", wantErr: true},
+		{input: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			port, err := ParsePort(tt.input)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, port)
+		})
+	}
+}
@@ -31,6 +31,10 @@ const (
 	// device's OS image and configuration have been rolled back to the
 	// pre-update version and have been activated
 	UpdateStateError UpdateState = "Error"
+	// The health checks of the desired spec failed after it was applied and the
+	// agent will not retry. The device's OS image and configuration have been
+	// rolled back to the pre-update version and have been activated.
+	UpdateStateHealthCheckFailed UpdateState = "HealthCheckFailed"
 	// The agent has detected an error and is rolling back to the pre-update OS
 	// image and configuration.
 	UpdateStateRollingBack UpdateState = "RollingBack"
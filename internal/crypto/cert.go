@@ -15,6 +15,7 @@ import (
 	"github.com/flightctl/flightctl/internal/crypto/signer"
 	fccrypto "github.com/flightctl/flightctl/pkg/crypto"
 	oscrypto "github.com/openshift/library-go/pkg/crypto"
+	"golang.org/x/crypto/ocsp"
 	"k8s.io/apimachinery/pkg/util/sets"
 )
 
@@ -23,12 +24,15 @@ type CertOption = func(*x509.Certificate) error
 type CABackend interface {
 	IssueRequestedCertificateAsX509(ctx context.Context, csr *x509.CertificateRequest, expirySeconds int, usage []x509.ExtKeyUsage, opts ...CertOption) (*x509.Certificate, error)
 	GetCABundleX509() []*x509.Certificate
+	CreateRevocationList(template *x509.RevocationList) ([]byte, error)
+	CreateOCSPResponse(template ocsp.Response) ([]byte, error)
 }
 
 type CAClient struct {
 	caBackend CABackend
 	Cfg       *ca.Config
 	signers   *signer.CASigners
+	revoked   *revocationList
 }
 
 func (caClient *CAClient) Config() *ca.Config {
@@ -39,6 +43,7 @@ func NewCAClient(cfg *ca.Config, caBackend CABackend) *CAClient {
 	ca := &CAClient{
 		caBackend: caBackend,
 		Cfg:       cfg,
+		revoked:   &revocationList{},
 	}
 	ca.signers = signer.NewCASigners(ca)
 	return ca
@@ -49,19 +54,32 @@ func NewCAClient(cfg *ca.Config, caBackend CABackend) *CAClient {
 // was it loaded or generated and a nil error.
 // In case of errors a non-nil error is returned.
 func EnsureCA(cfg *ca.Config) (*CAClient, bool, error) {
-	caBackend, fresh, err := ensureInternalCA(cfg)
+	ensure := ensureInternalCA
+	if cfg.CAType == ca.PKCS11CA {
+		ensure = ensurePKCS11CA
+	}
+	caBackend, fresh, err := ensure(cfg)
 	if err != nil {
 		return nil, fresh, err
 	}
 	ca := &CAClient{
 		caBackend: caBackend,
 		Cfg:       cfg,
+		revoked:   &revocationList{},
 	}
 
 	ca.signers = signer.NewCASigners(ca)
 	return ca, fresh, nil
 }
 
+// LoadCA loads the CA backend selected by the configuration.
+func LoadCA(cfg *ca.Config) (CABackend, error) {
+	if cfg.CAType == ca.PKCS11CA {
+		return loadPKCS11CA(cfg)
+	}
+	return LoadInternalCA(cfg)
+}
+
 func (caClient *CAClient) GetSigner(name string) signer.Signer {
 	return caClient.signers.GetSigner(name)
 }
@@ -266,8 +284,7 @@ func (caClient *CAClient) GetCABundleX509() []*x509.Certificate {
 
 func (caClient *CAClient) GetCABundle() ([]byte, error) {
 	// If CABundleFile is configured, read it directly
-	if caClient.Cfg.InternalConfig.CABundleFile != "" {
-		caBundlePath := CertStorePath(caClient.Cfg.InternalConfig.CABundleFile, caClient.Cfg.InternalConfig.CertStore)
+	if caBundlePath := caBundleFilePath(caClient.Cfg); caBundlePath != "" {
 		caBundleBytes, err := os.ReadFile(caBundlePath)
 		if err != nil {
 			return nil, fmt.Errorf("reading ca-bundle from %s: %w", caBundlePath, err)
@@ -279,6 +296,19 @@ func (caClient *CAClient) GetCABundle() ([]byte, error) {
 	return oscrypto.EncodeCertificates(certs...)
 }
 
+func caBundleFilePath(cfg *ca.Config) string {
+	if cfg.CAType == ca.PKCS11CA {
+		if cfg.PKCS11Config == nil || cfg.PKCS11Config.CABundleFile == "" {
+			return ""
+		}
+		return CertStorePath(cfg.PKCS11Config.CABundleFile, cfg.PKCS11Config.CertStore)
+	}
+	if cfg.InternalConfig == nil || cfg.InternalConfig.CABundleFile == "" {
+		return ""
+	}
+	return CertStorePath(cfg.InternalConfig.CABundleFile, cfg.InternalConfig.CertStore)
+}
+
 // CanReadCertAndKey checks if both the certificate and key files exist and are readable.
 // Returns true if both files are accessible, false if neither exists, and an error if one is missing.
 func CanReadCertAndKey(certPath, keyPath string) (bool, error) {
@@ -0,0 +1,148 @@
+package crypto
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hkdf"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+)
+
+const (
+	// MinKeyEncryptionKeyLength is the minimum length of the secret that key encryption keys are derived from.
+	MinKeyEncryptionKeyLength = 16
+
+	envelopeKeyLength = 32 // AES-256
+
+	keyEncryptionKeyInfo = "flightctl envelope key encryption key"
+	digestKeyInfo        = "flightctl envelope digest key"
+)
+
+var ErrKeyEncryptionKeyMismatch = errors.New("data was encrypted with a different key encryption key")
+
+// SealedData is envelope-encrypted data: the data is encrypted with a random data encryption key, which is itself
+// encrypted with a key encryption key.
+type SealedData struct {
+	// KeyID identifies the key encryption key that encrypted the data encryption key.
+	KeyID string
+	// EncryptedKey is the nonce followed by the encrypted data encryption key.
+	EncryptedKey []byte
+	// Ciphertext is the nonce followed by the encrypted data.
+	Ciphertext []byte
+}
+
+// Envelope envelope-encrypts data with AES-256-GCM. Every call to Seal generates a new data encryption key, so that
+// the key encryption key only ever encrypts random keys.
+type Envelope struct {
+	kek       []byte
+	digestKey []byte
+	keyID     string
+}
+
+// NewEnvelope creates an Envelope whose key encryption key is derived from the given secret.
+func NewEnvelope(secret string) (*Envelope, error) {
+	if len(secret) < MinKeyEncryptionKeyLength {
+		return nil, fmt.Errorf("key encryption key must be at least %d characters", MinKeyEncryptionKeyLength)
+	}
+	kek, err := hkdf.Key(sha256.New, []byte(secret), nil, keyEncryptionKeyInfo, envelopeKeyLength)
+	if err != nil {
+		return nil, fmt.Errorf("deriving key encryption key: %w", err)
+	}
+	digestKey, err := hkdf.Key(sha256.New, []byte(secret), nil, digestKeyInfo, envelopeKeyLength)
+	if err != nil {
+		return nil, fmt.Errorf("deriving digest key: %w", err)
+	}
+	id := sha256.Sum256(kek)
+	return &Envelope{
+		kek:       kek,
+		digestKey: digestKey,
+		keyID:     hex.EncodeToString(id[:8]),
+	}, nil
+}
+
+// KeyID identifies the key encryption key of the envelope without revealing it.
+func (e *Envelope) KeyID() string {
+	return e.keyID
+}
+
+// Seal encrypts the plaintext. The additional data is authenticated but not encrypted, and must be passed to Open
+// unchanged.
+func (e *Envelope) Seal(plaintext, additionalData []byte) (*SealedData, error) {
+	dek := make([]byte, envelopeKeyLength)
+	if _, err := rand.Read(dek); err != nil {
+		return nil, fmt.Errorf("generating data encryption key: %w", err)
+	}
+	ciphertext, err := seal(dek, plaintext, additionalData)
+	if err != nil {
+		return nil, fmt.Errorf("encrypting data: %w", err)
+	}
+	encryptedKey, err := seal(e.kek, dek, []byte(e.keyID))
+	if err != nil {
+		return nil, fmt.Errorf("encrypting data encryption key: %w", err)
+	}
+	return &SealedData{
+		KeyID:        e.keyID,
+		EncryptedKey: encryptedKey,
+		Ciphertext:   ciphertext,
+	}, nil
+}
+
+// Open decrypts sealed data that was encrypted with the same key encryption key and additional data.
+func (e *Envelope) Open(sealed *SealedData, additionalData []byte) ([]byte, error) {
+	if sealed.KeyID != e.keyID {
+		return nil, fmt.Errorf("%w: key %q instead of %q", ErrKeyEncryptionKeyMismatch, sealed.KeyID, e.keyID)
+	}
+	dek, err := open(e.kek, sealed.EncryptedKey, []byte(e.keyID))
+	if err != nil {
+		return nil, fmt.Errorf("decrypting data encryption key: %w", err)
+	}
+	plaintext, err := open(dek, sealed.Ciphertext, additionalData)
+	if err != nil {
+		return nil, fmt.Errorf("decrypting data: %w", err)
+	}
+	return plaintext, nil
+}
+
+// Digest returns a keyed digest of the data, which allows detecting changes of sealed data without decrypting it
+// and without revealing anything about the data to whoever does not have the key encryption key.
+func (e *Envelope) Digest(data []byte) []byte {
+	mac := hmac.New(sha256.New, e.digestKey)
+	mac.Write(data)
+	return mac.Sum(nil)
+}
+
+func seal(key, plaintext, additionalData []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return gcm.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+func open(key, ciphertext, additionalData []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(ciphertext) < gcm.NonceSize() {
+		return nil, errors.New("ciphertext too short")
+	}
+	nonce, ciphertext := ciphertext[:gcm.NonceSize()], ciphertext[gcm.NonceSize():]
+	return gcm.Open(nil, nonce, ciphertext, additionalData)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,54 @@
+package crypto
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvelope(t *testing.T) {
+	require := require.New(t)
+
+	_, err := NewEnvelope("too-short")
+	require.Error(err)
+
+	envelope, err := NewEnvelope("correct-horse-battery-staple")
+	require.NoError(err)
+
+	plaintext := []byte(`{"token":"s3cr3t"}`)
+	sealed, err := envelope.Seal(plaintext, []byte("registry"))
+	require.NoError(err)
+	require.Equal(envelope.KeyID(), sealed.KeyID)
+	require.NotContains(string(sealed.Ciphertext), "s3cr3t")
+
+	opened, err := envelope.Open(sealed, []byte("registry"))
+	require.NoError(err)
+	require.Equal(plaintext, opened)
+
+	// every seal uses a fresh data encryption key
+	resealed, err := envelope.Seal(plaintext, []byte("registry"))
+	require.NoError(err)
+	require.NotEqual(sealed.EncryptedKey, resealed.EncryptedKey)
+	require.NotEqual(sealed.Ciphertext, resealed.Ciphertext)
+	require.Equal(envelope.Digest(plaintext), envelope.Digest(plaintext))
+	require.NotEqual(envelope.Digest(plaintext), envelope.Digest([]byte(`{"token":"other"}`)))
+
+	// the additional data is authenticated
+	_, err = envelope.Open(sealed, []byte("other"))
+	require.Error(err)
+
+	// tampering is detected
+	tampered := *sealed
+	tampered.Ciphertext = append([]byte{}, sealed.Ciphertext...)
+	tampered.Ciphertext[len(tampered.Ciphertext)-1] ^= 0xff
+	_, err = envelope.Open(&tampered, []byte("registry"))
+	require.Error(err)
+
+	// data sealed with another key encryption key is rejected
+	other, err := NewEnvelope("another-long-enough-secret")
+	require.NoError(err)
+	_, err = other.Open(sealed, []byte("registry"))
+	require.True(errors.Is(err, ErrKeyEncryptionKeyMismatch))
+	require.NotEqual(envelope.Digest(plaintext), other.Digest(plaintext))
+}
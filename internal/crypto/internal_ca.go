@@ -15,6 +15,7 @@ import (
 	"github.com/flightctl/flightctl/internal/config/ca"
 	fccrypto "github.com/flightctl/flightctl/pkg/crypto"
 	oscrypto "github.com/openshift/library-go/pkg/crypto"
+	"golang.org/x/crypto/ocsp"
 )
 
 type internalCA struct {
@@ -135,7 +136,7 @@ func makeSelfSignedCAConfig(subject pkix.Name, caLifetime time.Duration, serial
 
 		SerialNumber: big.NewInt(serial),
 
-		KeyUsage:              x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		KeyUsage:              x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
 		BasicConstraintsValid: true,
 		IsCA:                  true,
 
@@ -217,3 +218,22 @@ func (caBackend *internalCA) IssueRequestedCertificateAsX509(ctx context.Context
 func (caBackend *internalCA) GetCABundleX509() []*x509.Certificate {
 	return caBackend.Config.Certs
 }
+
+func (caBackend *internalCA) CreateRevocationList(template *x509.RevocationList) ([]byte, error) {
+	issuerKey, ok := caBackend.Config.Key.(crypto.Signer)
+	if !ok {
+		return nil, errors.New("CA key does not support signing")
+	}
+	return x509.CreateRevocationList(rand.Reader, template, caBackend.Config.Certs[0], issuerKey)
+}
+
+// CreateOCSPResponse signs an OCSP response directly with the CA key, so no delegated
+// responder certificate is needed.
+func (caBackend *internalCA) CreateOCSPResponse(template ocsp.Response) ([]byte, error) {
+	issuerKey, ok := caBackend.Config.Key.(crypto.Signer)
+	if !ok {
+		return nil, errors.New("CA key does not support signing")
+	}
+	issuer := caBackend.Config.Certs[0]
+	return ocsp.CreateResponse(issuer, issuer, template, issuerKey)
+}
@@ -0,0 +1,367 @@
+//go:build cgo
+
+package crypto
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/config/ca"
+	fccrypto "github.com/flightctl/flightctl/pkg/crypto"
+	"github.com/miekg/pkcs11"
+	oscrypto "github.com/openshift/library-go/pkg/crypto"
+)
+
+var oidPublicKeyECDSA = asn1.ObjectIdentifier{1, 2, 840, 10045, 2, 1}
+
+// DigestInfo prefixes for RSASSA-PKCS1-v1_5 (RFC 8017, section 9.2), as CKM_RSA_PKCS expects
+// the caller to encode the digest.
+var pkcs1DigestInfoPrefixes = map[crypto.Hash][]byte{
+	crypto.SHA256: {0x30, 0x31, 0x30, 0x0d, 0x06, 0x09, 0x60, 0x86, 0x48, 0x01, 0x65, 0x03, 0x04, 0x02, 0x01, 0x05, 0x00, 0x04, 0x20},
+	crypto.SHA384: {0x30, 0x41, 0x30, 0x0d, 0x06, 0x09, 0x60, 0x86, 0x48, 0x01, 0x65, 0x03, 0x04, 0x02, 0x02, 0x05, 0x00, 0x04, 0x30},
+	crypto.SHA512: {0x30, 0x51, 0x30, 0x0d, 0x06, 0x09, 0x60, 0x86, 0x48, 0x01, 0x65, 0x03, 0x04, 0x02, 0x03, 0x05, 0x00, 0x04, 0x40},
+}
+
+// ensurePKCS11CA connects to the CA key on the token and self-signs a CA certificate
+// with it if none has been stored yet.
+func ensurePKCS11CA(cfg *ca.Config) (CABackend, bool, error) {
+	p11Cfg, err := pkcs11Config(cfg)
+	if err != nil {
+		return nil, false, err
+	}
+	certFile := CertStorePath(p11Cfg.CertFile, p11Cfg.CertStore)
+	if _, err := os.Stat(certFile); err == nil {
+		backend, err := loadPKCS11CA(cfg)
+		return backend, false, err
+	}
+
+	signer, err := openPKCS11Signer(p11Cfg)
+	if err != nil {
+		return nil, false, err
+	}
+	caCert, err := makeSelfSignedPKCS11CACert(signer, p11Cfg)
+	if err != nil {
+		return nil, false, err
+	}
+	certBytes, err := oscrypto.EncodeCertificates(caCert)
+	if err != nil {
+		return nil, false, fmt.Errorf("encoding CA certificate: %w", err)
+	}
+	if err := os.WriteFile(certFile, certBytes, 0600); err != nil {
+		return nil, false, fmt.Errorf("writing CA certificate to %s: %w", certFile, err)
+	}
+	if p11Cfg.CABundleFile != "" {
+		caBundleFile := CertStorePath(p11Cfg.CABundleFile, p11Cfg.CertStore)
+		if err := os.WriteFile(caBundleFile, certBytes, 0600); err != nil {
+			return nil, false, fmt.Errorf("writing CA bundle to %s: %w", caBundleFile, err)
+		}
+	}
+
+	return newPKCS11CA(signer, []*x509.Certificate{caCert}), true, nil
+}
+
+// loadPKCS11CA connects to the CA key on the token and pairs it with the stored CA certificate.
+func loadPKCS11CA(cfg *ca.Config) (CABackend, error) {
+	p11Cfg, err := pkcs11Config(cfg)
+	if err != nil {
+		return nil, err
+	}
+	certFile := CertStorePath(p11Cfg.CertFile, p11Cfg.CertStore)
+	certPEM, err := os.ReadFile(certFile)
+	if err != nil {
+		return nil, fmt.Errorf("reading CA certificate from %s: %w", certFile, err)
+	}
+	certs, err := oscrypto.CertsFromPEM(certPEM)
+	if err != nil {
+		return nil, fmt.Errorf("parsing CA certificate %s: %w", certFile, err)
+	}
+
+	signer, err := openPKCS11Signer(p11Cfg)
+	if err != nil {
+		return nil, err
+	}
+	certKey, ok := certs[0].PublicKey.(interface{ Equal(crypto.PublicKey) bool })
+	if !ok || !certKey.Equal(signer.Public()) {
+		return nil, fmt.Errorf("CA certificate %s does not match key %q on the token", certFile, p11Cfg.KeyLabel)
+	}
+	return newPKCS11CA(signer, certs), nil
+}
+
+// newPKCS11CA reuses the internal CA with the token key in place of a key file, so issued
+// certificates are identical regardless of where the key is held.
+func newPKCS11CA(signer *pkcs11Signer, certs []*x509.Certificate) *internalCA {
+	return &internalCA{
+		Config:          &TLSCertificateConfig{Certs: certs, Key: signer},
+		SerialGenerator: &oscrypto.RandomSerialGenerator{},
+	}
+}
+
+func makeSelfSignedPKCS11CACert(signer *pkcs11Signer, cfg *ca.PKCS11Cfg) (*x509.Certificate, error) {
+	publicKeyHash, err := fccrypto.HashPublicKey(signer.Public())
+	if err != nil {
+		return nil, fmt.Errorf("hashing CA public key: %w", err)
+	}
+	serial, err := (&oscrypto.RandomSerialGenerator{}).Next(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	template := &x509.Certificate{
+		Subject: pkix.Name{CommonName: cfg.SignerCertName},
+
+		NotBefore: now.Add(-1 * time.Second),
+		NotAfter:  now.Add(time.Duration(cfg.CertValidityDays) * 24 * time.Hour),
+
+		SerialNumber: big.NewInt(serial),
+
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+
+		AuthorityKeyId: publicKeyHash,
+		SubjectKeyId:   publicKeyHash,
+	}
+	return signCertificate(template, signer.Public(), template, signer)
+}
+
+func pkcs11Config(cfg *ca.Config) (*ca.PKCS11Cfg, error) {
+	p11Cfg := cfg.PKCS11Config
+	if p11Cfg == nil {
+		return nil, errors.New("PKCS#11 CA selected but pkcs11Config is not set")
+	}
+	if p11Cfg.ModulePath == "" || p11Cfg.KeyLabel == "" || p11Cfg.CertFile == "" {
+		return nil, errors.New("pkcs11Config requires modulePath, keyLabel and certFile")
+	}
+	if p11Cfg.SignerCertName == "" || p11Cfg.CertValidityDays <= 0 {
+		return nil, errors.New("pkcs11Config requires signerCertName and a positive certValidityDays")
+	}
+	return p11Cfg, nil
+}
+
+// pkcs11Signer is a crypto.Signer backed by a private key on a PKCS#11 token.
+type pkcs11Signer struct {
+	// PKCS#11 sessions must not be used concurrently
+	mu      sync.Mutex
+	ctx     *pkcs11.Ctx
+	session pkcs11.SessionHandle
+	key     pkcs11.ObjectHandle
+	public  crypto.PublicKey
+}
+
+var _ crypto.Signer = (*pkcs11Signer)(nil)
+
+func openPKCS11Signer(cfg *ca.PKCS11Cfg) (*pkcs11Signer, error) {
+	p := pkcs11.New(cfg.ModulePath)
+	if p == nil {
+		return nil, fmt.Errorf("loading PKCS#11 module %s", cfg.ModulePath)
+	}
+	if err := p.Initialize(); err != nil && !errors.Is(err, pkcs11.Error(pkcs11.CKR_CRYPTOKI_ALREADY_INITIALIZED)) {
+		return nil, fmt.Errorf("initializing PKCS#11 module: %w", err)
+	}
+
+	slot, err := findPKCS11Slot(p, cfg.TokenLabel)
+	if err != nil {
+		return nil, err
+	}
+	session, err := p.OpenSession(slot, pkcs11.CKF_SERIAL_SESSION)
+	if err != nil {
+		return nil, fmt.Errorf("opening PKCS#11 session: %w", err)
+	}
+
+	if cfg.PinFile != "" {
+		pin, err := os.ReadFile(cfg.PinFile)
+		if err != nil {
+			return nil, fmt.Errorf("reading PKCS#11 PIN file: %w", err)
+		}
+		err = p.Login(session, pkcs11.CKU_USER, strings.TrimSpace(string(pin)))
+		if err != nil && !errors.Is(err, pkcs11.Error(pkcs11.CKR_USER_ALREADY_LOGGED_IN)) {
+			return nil, fmt.Errorf("logging in to PKCS#11 token: %w", err)
+		}
+	}
+
+	key, err := findPKCS11Object(p, session, pkcs11.CKO_PRIVATE_KEY, cfg.KeyLabel)
+	if err != nil {
+		return nil, err
+	}
+	pubKey, err := findPKCS11Object(p, session, pkcs11.CKO_PUBLIC_KEY, cfg.KeyLabel)
+	if err != nil {
+		return nil, err
+	}
+	public, err := pkcs11PublicKey(p, session, pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pkcs11Signer{ctx: p, session: session, key: key, public: public}, nil
+}
+
+func findPKCS11Slot(p *pkcs11.Ctx, tokenLabel string) (uint, error) {
+	slots, err := p.GetSlotList(true)
+	if err != nil {
+		return 0, fmt.Errorf("listing PKCS#11 slots: %w", err)
+	}
+	for _, slot := range slots {
+		info, err := p.GetTokenInfo(slot)
+		if err != nil {
+			return 0, fmt.Errorf("getting PKCS#11 token info: %w", err)
+		}
+		if tokenLabel == "" || strings.TrimSpace(info.Label) == tokenLabel {
+			return slot, nil
+		}
+	}
+	return 0, fmt.Errorf("PKCS#11 token %q not found", tokenLabel)
+}
+
+func findPKCS11Object(p *pkcs11.Ctx, session pkcs11.SessionHandle, class uint, label string) (pkcs11.ObjectHandle, error) {
+	template := []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, class),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, label),
+	}
+	if err := p.FindObjectsInit(session, template); err != nil {
+		return 0, fmt.Errorf("searching PKCS#11 objects: %w", err)
+	}
+	objects, _, err := p.FindObjects(session, 2)
+	if finalErr := p.FindObjectsFinal(session); err == nil {
+		err = finalErr
+	}
+	if err != nil {
+		return 0, fmt.Errorf("searching PKCS#11 objects: %w", err)
+	}
+
+	kind := "private"
+	if class == pkcs11.CKO_PUBLIC_KEY {
+		kind = "public"
+	}
+	switch len(objects) {
+	case 0:
+		return 0, fmt.Errorf("%s key %q not found on the PKCS#11 token", kind, label)
+	case 1:
+		return objects[0], nil
+	default:
+		return 0, fmt.Errorf("multiple %s keys labeled %q found on the PKCS#11 token", kind, label)
+	}
+}
+
+func pkcs11PublicKey(p *pkcs11.Ctx, session pkcs11.SessionHandle, object pkcs11.ObjectHandle) (crypto.PublicKey, error) {
+	attrs, err := p.GetAttributeValue(session, object, []*pkcs11.Attribute{pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, nil)})
+	if err != nil {
+		return nil, fmt.Errorf("reading PKCS#11 key type: %w", err)
+	}
+	keyType, err := pkcs11Ulong(attrs[0].Value)
+	if err != nil {
+		return nil, fmt.Errorf("reading PKCS#11 key type: %w", err)
+	}
+
+	switch keyType {
+	case pkcs11.CKK_EC:
+		attrs, err := p.GetAttributeValue(session, object, []*pkcs11.Attribute{
+			pkcs11.NewAttribute(pkcs11.CKA_EC_PARAMS, nil),
+			pkcs11.NewAttribute(pkcs11.CKA_EC_POINT, nil),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("reading PKCS#11 EC public key: %w", err)
+		}
+		// CKA_EC_POINT holds the DER encoding of the point as an OCTET STRING
+		point := attrs[1].Value
+		var unwrapped []byte
+		if rest, err := asn1.Unmarshal(point, &unwrapped); err == nil && len(rest) == 0 {
+			point = unwrapped
+		}
+		spki, err := asn1.Marshal(struct {
+			Algorithm pkix.AlgorithmIdentifier
+			PublicKey asn1.BitString
+		}{
+			Algorithm: pkix.AlgorithmIdentifier{Algorithm: oidPublicKeyECDSA, Parameters: asn1.RawValue{FullBytes: attrs[0].Value}},
+			PublicKey: asn1.BitString{Bytes: point, BitLength: 8 * len(point)},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("encoding PKCS#11 EC public key: %w", err)
+		}
+		return x509.ParsePKIXPublicKey(spki)
+	case pkcs11.CKK_RSA:
+		attrs, err := p.GetAttributeValue(session, object, []*pkcs11.Attribute{
+			pkcs11.NewAttribute(pkcs11.CKA_MODULUS, nil),
+			pkcs11.NewAttribute(pkcs11.CKA_PUBLIC_EXPONENT, nil),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("reading PKCS#11 RSA public key: %w", err)
+		}
+		return &rsa.PublicKey{
+			N: new(big.Int).SetBytes(attrs[0].Value),
+			E: int(new(big.Int).SetBytes(attrs[1].Value).Int64()),
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported PKCS#11 key type: 0x%x", keyType)
+	}
+}
+
+// pkcs11Ulong decodes a CK_ULONG attribute value, which is in host byte order.
+func pkcs11Ulong(value []byte) (uint64, error) {
+	switch len(value) {
+	case 8:
+		return binary.NativeEndian.Uint64(value), nil
+	case 4:
+		return uint64(binary.NativeEndian.Uint32(value)), nil
+	default:
+		return 0, fmt.Errorf("unexpected CK_ULONG size %d", len(value))
+	}
+}
+
+func (s *pkcs11Signer) Public() crypto.PublicKey {
+	return s.public
+}
+
+func (s *pkcs11Signer) Sign(_ io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	var mechanism uint
+	data := digest
+	switch s.public.(type) {
+	case *ecdsa.PublicKey:
+		mechanism = pkcs11.CKM_ECDSA
+	case *rsa.PublicKey:
+		if _, ok := opts.(*rsa.PSSOptions); ok {
+			return nil, errors.New("RSA-PSS signatures are not supported by the PKCS#11 CA")
+		}
+		prefix, ok := pkcs1DigestInfoPrefixes[opts.HashFunc()]
+		if !ok {
+			return nil, fmt.Errorf("unsupported hash for RSA signatures: %v", opts.HashFunc())
+		}
+		mechanism = pkcs11.CKM_RSA_PKCS
+		data = append(append([]byte{}, prefix...), digest...)
+	default:
+		return nil, fmt.Errorf("unsupported PKCS#11 key type %T", s.public)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.ctx.SignInit(s.session, []*pkcs11.Mechanism{pkcs11.NewMechanism(mechanism, nil)}, s.key); err != nil {
+		return nil, fmt.Errorf("initializing PKCS#11 signature: %w", err)
+	}
+	signature, err := s.ctx.Sign(s.session, data)
+	if err != nil {
+		return nil, fmt.Errorf("signing with PKCS#11 key: %w", err)
+	}
+
+	if mechanism == pkcs11.CKM_ECDSA {
+		// CKM_ECDSA returns r || s, x509 expects an ASN.1 ECDSA-Sig-Value
+		half := len(signature) / 2
+		return asn1.Marshal(struct{ R, S *big.Int }{
+			R: new(big.Int).SetBytes(signature[:half]),
+			S: new(big.Int).SetBytes(signature[half:]),
+		})
+	}
+	return signature, nil
+}
@@ -0,0 +1,20 @@
+//go:build !cgo
+
+package crypto
+
+import (
+	"errors"
+
+	"github.com/flightctl/flightctl/internal/config/ca"
+)
+
+// PKCS#11 modules are shared libraries, so binaries built without cgo cannot load them.
+var errPKCS11Unsupported = errors.New("PKCS#11 CA support requires a build with cgo enabled")
+
+func ensurePKCS11CA(_ *ca.Config) (CABackend, bool, error) {
+	return nil, false, errPKCS11Unsupported
+}
+
+func loadPKCS11CA(_ *ca.Config) (CABackend, error) {
+	return nil, errPKCS11Unsupported
+}
@@ -0,0 +1,181 @@
+//go:build cgo
+
+package crypto
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/config/ca"
+	"github.com/flightctl/flightctl/internal/crypto/signer"
+	"github.com/miekg/pkcs11"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	softHSMTokenLabel = "flightctl-test"
+	softHSMKeyLabel   = "flightctl-ca"
+	softHSMPin        = "1234"
+)
+
+var softHSMModulePaths = []string{
+	"/usr/lib64/pkcs11/libsofthsm2.so",
+	"/usr/lib/softhsm/libsofthsm2.so",
+	"/usr/lib/x86_64-linux-gnu/softhsm/libsofthsm2.so",
+	"/usr/local/lib/softhsm/libsofthsm2.so",
+}
+
+// softHSMModule returns the SoftHSM module to test against, which can be overridden with SOFTHSM2_MODULE.
+func softHSMModule(t *testing.T) string {
+	if module := os.Getenv("SOFTHSM2_MODULE"); module != "" {
+		return module
+	}
+	for _, module := range softHSMModulePaths {
+		if _, err := os.Stat(module); err == nil {
+			return module
+		}
+	}
+	t.Skip("SoftHSM is not installed")
+	return ""
+}
+
+// setupSoftHSMToken initializes a fresh SoftHSM token holding an ECDSA P-256 key pair.
+func setupSoftHSMToken(t *testing.T, module string) {
+	dir := t.TempDir()
+	tokenDir := filepath.Join(dir, "tokens")
+	require.NoError(t, os.Mkdir(tokenDir, 0700))
+	conf := filepath.Join(dir, "softhsm2.conf")
+	require.NoError(t, os.WriteFile(conf, []byte(fmt.Sprintf("directories.tokendir = %s\n", tokenDir)), 0600))
+	t.Setenv("SOFTHSM2_CONF", conf)
+
+	p := pkcs11.New(module)
+	require.NotNil(t, p)
+	require.NoError(t, p.Initialize())
+	defer func() {
+		_ = p.Finalize()
+		p.Destroy()
+	}()
+
+	slots, err := p.GetSlotList(false)
+	require.NoError(t, err)
+	require.NotEmpty(t, slots)
+	require.NoError(t, p.InitToken(slots[0], softHSMPin, softHSMTokenLabel))
+
+	// SoftHSM moves an initialized token to a new slot
+	slots, err = p.GetSlotList(true)
+	require.NoError(t, err)
+	slot, err := findPKCS11Slot(p, softHSMTokenLabel)
+	require.NoError(t, err)
+	require.Contains(t, slots, slot)
+
+	session, err := p.OpenSession(slot, pkcs11.CKF_SERIAL_SESSION|pkcs11.CKF_RW_SESSION)
+	require.NoError(t, err)
+	require.NoError(t, p.Login(session, pkcs11.CKU_SO, softHSMPin))
+	require.NoError(t, p.InitPIN(session, softHSMPin))
+	require.NoError(t, p.Logout(session))
+	require.NoError(t, p.Login(session, pkcs11.CKU_USER, softHSMPin))
+
+	curve, err := asn1.Marshal(asn1.ObjectIdentifier{1, 2, 840, 10045, 3, 1, 7})
+	require.NoError(t, err)
+	_, _, err = p.GenerateKeyPair(session,
+		[]*pkcs11.Mechanism{pkcs11.NewMechanism(pkcs11.CKM_EC_KEY_PAIR_GEN, nil)},
+		[]*pkcs11.Attribute{
+			pkcs11.NewAttribute(pkcs11.CKA_TOKEN, true),
+			pkcs11.NewAttribute(pkcs11.CKA_VERIFY, true),
+			pkcs11.NewAttribute(pkcs11.CKA_EC_PARAMS, curve),
+			pkcs11.NewAttribute(pkcs11.CKA_LABEL, softHSMKeyLabel),
+		},
+		[]*pkcs11.Attribute{
+			pkcs11.NewAttribute(pkcs11.CKA_TOKEN, true),
+			pkcs11.NewAttribute(pkcs11.CKA_PRIVATE, true),
+			pkcs11.NewAttribute(pkcs11.CKA_SENSITIVE, true),
+			pkcs11.NewAttribute(pkcs11.CKA_EXTRACTABLE, false),
+			pkcs11.NewAttribute(pkcs11.CKA_SIGN, true),
+			pkcs11.NewAttribute(pkcs11.CKA_LABEL, softHSMKeyLabel),
+		})
+	require.NoError(t, err)
+}
+
+func TestPKCS11CA(t *testing.T) {
+	module := softHSMModule(t)
+	setupSoftHSMToken(t, module)
+
+	certStore := t.TempDir()
+	pinFile := filepath.Join(certStore, "pin")
+	require.NoError(t, os.WriteFile(pinFile, []byte(softHSMPin+"\n"), 0600))
+
+	cfg := ca.NewDefault(certStore)
+	cfg.CAType = ca.PKCS11CA
+	cfg.PKCS11Config = &ca.PKCS11Cfg{
+		ModulePath:       module,
+		TokenLabel:       softHSMTokenLabel,
+		PinFile:          pinFile,
+		KeyLabel:         softHSMKeyLabel,
+		CertFile:         "ca.crt",
+		SignerCertName:   "flightctl-hsm-ca",
+		CertValidityDays: 365,
+		CertStore:        certStore,
+	}
+
+	caClient, fresh, err := EnsureCA(cfg)
+	require.NoError(t, err)
+	require.True(t, fresh)
+	caCert := caClient.GetCABundleX509()[0]
+	require.True(t, caCert.IsCA)
+	require.NoError(t, caCert.CheckSignatureFrom(caCert))
+
+	// the stored certificate is picked up on the next start
+	backend, err := LoadCA(cfg)
+	require.NoError(t, err)
+	require.True(t, backend.GetCABundleX509()[0].Equal(caCert))
+
+	t.Run("issues certificates through the signer chain", func(t *testing.T) {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		csrDER, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{Subject: pkix.Name{CommonName: "svc-test"}}, key)
+		require.NoError(t, err)
+		csr, err := x509.ParseCertificateRequest(csrDER)
+		require.NoError(t, err)
+
+		req, err := signer.NewSignRequest(cfg.ServerSvcSignerName, *csr)
+		require.NoError(t, err)
+		cert, err := signer.Sign(context.Background(), caClient, req)
+		require.NoError(t, err)
+		require.NoError(t, cert.CheckSignatureFrom(caCert))
+		name, err := signer.GetSignerNameExtension(cert)
+		require.NoError(t, err)
+		require.Equal(t, cfg.ServerSvcSignerName, name)
+	})
+
+	t.Run("signs revocation lists", func(t *testing.T) {
+		der, err := caClient.CreateCRL(context.Background())
+		require.NoError(t, err)
+		crl, err := x509.ParseRevocationList(der)
+		require.NoError(t, err)
+		require.NoError(t, crl.CheckSignatureFrom(caCert))
+	})
+
+	t.Run("rejects a certificate that does not match the token key", func(t *testing.T) {
+		other, _, err := EnsureCA(ca.NewDefault(t.TempDir()))
+		require.NoError(t, err)
+		certBytes, err := other.GetCABundle()
+		require.NoError(t, err)
+		mismatched := *cfg.PKCS11Config
+		mismatched.CertFile = "other-ca.crt"
+		require.NoError(t, os.WriteFile(filepath.Join(certStore, mismatched.CertFile), certBytes, 0600))
+
+		mismatchedCfg := *cfg
+		mismatchedCfg.PKCS11Config = &mismatched
+		_, err = LoadCA(&mismatchedCfg)
+		require.ErrorContains(t, err, "does not match")
+	})
+}
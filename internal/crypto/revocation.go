@@ -0,0 +1,278 @@
+package crypto
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/ocsp"
+)
+
+const (
+	// revocationListRefreshInterval bounds how long a revocation made by another API server
+	// instance may go unnoticed by this one.
+	revocationListRefreshInterval = 30 * time.Second
+
+	// RevocationListValidity is the period after which relying parties should fetch a fresh CRL
+	// or OCSP response.
+	RevocationListValidity = 1 * time.Hour
+)
+
+// ErrRevocationListUnavailable is returned when the revocation status of a certificate cannot be
+// determined because the revocation list has never been loaded. Callers must not treat the
+// certificate as valid.
+var ErrRevocationListUnavailable = errors.New("certificate revocation list is unavailable")
+
+// RevocationStore persists the revocation list of the CA.
+type RevocationStore interface {
+	Create(ctx context.Context, orgId uuid.UUID, deviceName string, revoked []domain.RevokedCertificate) error
+	List(ctx context.Context) ([]domain.RevokedCertificate, error)
+}
+
+// revocationList caches the persisted revocations so that checking a client certificate
+// does not require a database round trip on every request.
+type revocationList struct {
+	mu          sync.RWMutex
+	store       RevocationStore
+	revoked     map[string]domain.RevokedCertificate
+	refreshedAt time.Time
+}
+
+// SetRevocationStore enables certificate revocation. Until it is called no certificate is
+// considered revoked and revoking certificates fails.
+func (caClient *CAClient) SetRevocationStore(store RevocationStore) {
+	caClient.revoked.mu.Lock()
+	defer caClient.revoked.mu.Unlock()
+	caClient.revoked.store = store
+	caClient.revoked.revoked = nil
+	caClient.revoked.refreshedAt = time.Time{}
+}
+
+// RevokeCertificates adds the certificates of a device to the revocation list.
+// Certificates that have already expired are skipped since they are rejected anyway.
+func (caClient *CAClient) RevokeCertificates(ctx context.Context, orgId uuid.UUID, deviceName string, certs []*x509.Certificate, reason domain.CertificateRevocationReason) ([]domain.RevokedCertificate, error) {
+	l := caClient.revoked
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.store == nil {
+		return nil, errors.New("certificate revocation is not enabled")
+	}
+
+	now := time.Now().UTC()
+	revoked := []domain.RevokedCertificate{}
+	for _, cert := range certs {
+		if !cert.NotAfter.After(now) {
+			continue
+		}
+		revoked = append(revoked, domain.RevokedCertificate{
+			SerialNumber: serialNumberString(cert.SerialNumber),
+			Reason:       reason,
+			RevokedAt:    now,
+			NotAfter:     cert.NotAfter.UTC(),
+		})
+	}
+	if err := l.store.Create(ctx, orgId, deviceName, revoked); err != nil {
+		return nil, fmt.Errorf("storing revoked certificates: %w", err)
+	}
+
+	if l.revoked != nil {
+		for _, r := range revoked {
+			if _, exists := l.revoked[r.SerialNumber]; !exists {
+				l.revoked[r.SerialNumber] = r
+			}
+		}
+	}
+	return revoked, nil
+}
+
+// IsRevoked reports whether the certificate is on the revocation list. If the list cannot
+// be refreshed the last known list is used and the refresh error is returned alongside.
+// If no list has been loaded yet, an error wrapping ErrRevocationListUnavailable is returned.
+func (caClient *CAClient) IsRevoked(ctx context.Context, cert *x509.Certificate) (bool, error) {
+	_, revoked, err := caClient.revocationStatus(ctx, cert.SerialNumber)
+	return revoked, err
+}
+
+func (caClient *CAClient) revocationStatus(ctx context.Context, serial *big.Int) (domain.RevokedCertificate, bool, error) {
+	l := caClient.revoked
+	l.mu.RLock()
+	stale := l.store != nil && time.Since(l.refreshedAt) > revocationListRefreshInterval
+	l.mu.RUnlock()
+
+	var refreshErr error
+	if stale {
+		refreshErr = l.refresh(ctx)
+	}
+
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	if l.store != nil && l.revoked == nil {
+		if refreshErr == nil {
+			return domain.RevokedCertificate{}, false, ErrRevocationListUnavailable
+		}
+		return domain.RevokedCertificate{}, false, fmt.Errorf("%w: %w", ErrRevocationListUnavailable, refreshErr)
+	}
+	entry, revoked := l.revoked[serialNumberString(serial)]
+	if revoked && !entry.NotAfter.After(time.Now()) {
+		revoked = false
+	}
+	return entry, revoked, refreshErr
+}
+
+func (l *revocationList) refresh(ctx context.Context) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	// another caller may have refreshed the list while waiting for the lock
+	if l.store == nil || time.Since(l.refreshedAt) <= revocationListRefreshInterval {
+		return nil
+	}
+	list, err := l.store.List(ctx)
+	if err != nil {
+		return fmt.Errorf("listing revoked certificates: %w", err)
+	}
+	revoked := make(map[string]domain.RevokedCertificate, len(list))
+	for _, r := range list {
+		revoked[r.SerialNumber] = r
+	}
+	l.revoked = revoked
+	l.refreshedAt = time.Now()
+	return nil
+}
+
+// CreateCRL returns the DER encoded certificate revocation list signed by the CA.
+func (caClient *CAClient) CreateCRL(ctx context.Context) ([]byte, error) {
+	l := caClient.revoked
+	l.mu.RLock()
+	store := l.store
+	l.mu.RUnlock()
+
+	var list []domain.RevokedCertificate
+	if store != nil {
+		var err error
+		if list, err = store.List(ctx); err != nil {
+			return nil, fmt.Errorf("listing revoked certificates: %w", err)
+		}
+	}
+
+	entries := make([]x509.RevocationListEntry, 0, len(list))
+	for _, r := range list {
+		serial, ok := new(big.Int).SetString(r.SerialNumber, 16)
+		if !ok {
+			return nil, fmt.Errorf("invalid serial number in revocation list: %q", r.SerialNumber)
+		}
+		entries = append(entries, x509.RevocationListEntry{
+			SerialNumber:   serial,
+			RevocationTime: r.RevokedAt,
+			ReasonCode:     reasonCode(r.Reason),
+		})
+	}
+
+	now := time.Now()
+	template := &x509.RevocationList{
+		RevokedCertificateEntries: entries,
+		// a timestamp based number is monotonically increasing across API server instances
+		Number:     big.NewInt(now.UnixNano()),
+		ThisUpdate: now,
+		NextUpdate: now.Add(RevocationListValidity),
+	}
+	crl, err := caClient.caBackend.CreateRevocationList(template)
+	if err != nil {
+		return nil, fmt.Errorf("signing revocation list: %w", err)
+	}
+	return crl, nil
+}
+
+// CreateOCSPResponse answers a DER encoded OCSP request for a certificate issued by the CA.
+func (caClient *CAClient) CreateOCSPResponse(ctx context.Context, request []byte) ([]byte, error) {
+	req, err := ocsp.ParseRequest(request)
+	if err != nil {
+		return ocsp.MalformedRequestErrorResponse, nil
+	}
+
+	issuer := caClient.caBackend.GetCABundleX509()[0]
+	issued, err := isIssuedBy(req, issuer)
+	if err != nil {
+		return nil, err
+	}
+	if !issued {
+		return ocsp.UnauthorizedErrorResponse, nil
+	}
+
+	now := time.Now()
+	template := ocsp.Response{
+		Status:       ocsp.Good,
+		SerialNumber: req.SerialNumber,
+		IssuerHash:   req.HashAlgorithm,
+		ThisUpdate:   now,
+		NextUpdate:   now.Add(RevocationListValidity),
+	}
+	entry, revoked, err := caClient.revocationStatus(ctx, req.SerialNumber)
+	if err != nil {
+		// answering "good" for a certificate that may have been revoked is not acceptable
+		return ocsp.InternalErrorErrorResponse, nil
+	}
+	if revoked {
+		template.Status = ocsp.Revoked
+		template.RevokedAt = entry.RevokedAt
+		template.RevocationReason = reasonCode(entry.Reason)
+	}
+
+	resp, err := caClient.caBackend.CreateOCSPResponse(template)
+	if err != nil {
+		return nil, fmt.Errorf("signing OCSP response: %w", err)
+	}
+	return resp, nil
+}
+
+// isIssuedBy compares the issuer name and key hashes of an OCSP request with the CA certificate.
+func isIssuedBy(req *ocsp.Request, issuer *x509.Certificate) (bool, error) {
+	if !req.HashAlgorithm.Available() {
+		return false, nil
+	}
+	var spki struct {
+		Algorithm pkix.AlgorithmIdentifier
+		PublicKey asn1.BitString
+	}
+	if _, err := asn1.Unmarshal(issuer.RawSubjectPublicKeyInfo, &spki); err != nil {
+		return false, fmt.Errorf("parsing CA public key: %w", err)
+	}
+	keyHash := hashOf(req.HashAlgorithm, spki.PublicKey.RightAlign())
+	nameHash := hashOf(req.HashAlgorithm, issuer.RawSubject)
+	return bytes.Equal(keyHash, req.IssuerKeyHash) && bytes.Equal(nameHash, req.IssuerNameHash), nil
+}
+
+func hashOf(hash crypto.Hash, data []byte) []byte {
+	h := hash.New()
+	h.Write(data)
+	return h.Sum(nil)
+}
+
+// reasonCode maps a revocation reason to its RFC 5280 CRLReason code.
+func reasonCode(reason domain.CertificateRevocationReason) int {
+	switch reason {
+	case domain.CertificateRevocationReasonKeyCompromise:
+		return ocsp.KeyCompromise
+	case domain.CertificateRevocationReasonSuperseded:
+		return ocsp.Superseded
+	case domain.CertificateRevocationReasonCessationOfOperation:
+		return ocsp.CessationOfOperation
+	default:
+		return ocsp.Unspecified
+	}
+}
+
+func serialNumberString(serial *big.Int) string {
+	return serial.Text(16)
+}
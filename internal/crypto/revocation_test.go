@@ -0,0 +1,162 @@
+package crypto
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/config/ca"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ocsp"
+)
+
+type fakeRevocationStore struct {
+	revoked []domain.RevokedCertificate
+	listErr error
+}
+
+func (s *fakeRevocationStore) Create(_ context.Context, _ uuid.UUID, _ string, revoked []domain.RevokedCertificate) error {
+	s.revoked = append(s.revoked, revoked...)
+	return nil
+}
+
+func (s *fakeRevocationStore) List(_ context.Context) ([]domain.RevokedCertificate, error) {
+	return s.revoked, s.listErr
+}
+
+func newTestCAClient(t *testing.T) *CAClient {
+	cfg := ca.NewDefault(t.TempDir())
+	caClient, _, err := EnsureCA(cfg)
+	require.NoError(t, err)
+	return caClient
+}
+
+func issueTestCertificate(t *testing.T, caClient *CAClient, commonName string) *x509.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	csrDER, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{Subject: pkix.Name{CommonName: commonName}}, key)
+	require.NoError(t, err)
+	csr, err := x509.ParseCertificateRequest(csrDER)
+	require.NoError(t, err)
+	cert, err := caClient.IssueRequestedClientCertificate(context.Background(), csr, 3600)
+	require.NoError(t, err)
+	return cert
+}
+
+func TestRevokeCertificates(t *testing.T) {
+	ctx := context.Background()
+	caClient := newTestCAClient(t)
+	revokedCert := issueTestCertificate(t, caClient, "revoked-device")
+	validCert := issueTestCertificate(t, caClient, "valid-device")
+
+	_, err := caClient.RevokeCertificates(ctx, uuid.New(), "revoked-device", []*x509.Certificate{revokedCert}, domain.CertificateRevocationReasonKeyCompromise)
+	require.ErrorContains(t, err, "not enabled")
+
+	store := &fakeRevocationStore{}
+	caClient.SetRevocationStore(store)
+	revoked, err := caClient.RevokeCertificates(ctx, uuid.New(), "revoked-device", []*x509.Certificate{revokedCert}, domain.CertificateRevocationReasonKeyCompromise)
+	require.NoError(t, err)
+	require.Len(t, revoked, 1)
+	require.Equal(t, revokedCert.SerialNumber.Text(16), revoked[0].SerialNumber)
+
+	isRevoked, err := caClient.IsRevoked(ctx, revokedCert)
+	require.NoError(t, err)
+	require.True(t, isRevoked)
+	isRevoked, err = caClient.IsRevoked(ctx, validCert)
+	require.NoError(t, err)
+	require.False(t, isRevoked)
+
+	t.Run("CRL lists revoked certificates", func(t *testing.T) {
+		der, err := caClient.CreateCRL(ctx)
+		require.NoError(t, err)
+		crl, err := x509.ParseRevocationList(der)
+		require.NoError(t, err)
+		require.NoError(t, crl.CheckSignatureFrom(caClient.GetCABundleX509()[0]))
+		require.Len(t, crl.RevokedCertificateEntries, 1)
+		require.Equal(t, 0, crl.RevokedCertificateEntries[0].SerialNumber.Cmp(revokedCert.SerialNumber))
+		require.Equal(t, ocsp.KeyCompromise, crl.RevokedCertificateEntries[0].ReasonCode)
+	})
+
+	t.Run("OCSP responder reports certificate status", func(t *testing.T) {
+		issuer := caClient.GetCABundleX509()[0]
+		for _, tc := range []struct {
+			cert   *x509.Certificate
+			status int
+		}{
+			{cert: revokedCert, status: ocsp.Revoked},
+			{cert: validCert, status: ocsp.Good},
+		} {
+			req, err := ocsp.CreateRequest(tc.cert, issuer, nil)
+			require.NoError(t, err)
+			der, err := caClient.CreateOCSPResponse(ctx, req)
+			require.NoError(t, err)
+			resp, err := ocsp.ParseResponseForCert(der, tc.cert, issuer)
+			require.NoError(t, err)
+			require.Equal(t, tc.status, resp.Status)
+		}
+	})
+
+	t.Run("OCSP responder rejects foreign issuers", func(t *testing.T) {
+		other := newTestCAClient(t)
+		foreignCert := issueTestCertificate(t, other, "foreign-device")
+		req, err := ocsp.CreateRequest(foreignCert, other.GetCABundleX509()[0], nil)
+		require.NoError(t, err)
+		der, err := caClient.CreateOCSPResponse(ctx, req)
+		require.NoError(t, err)
+		require.Equal(t, ocsp.UnauthorizedErrorResponse, der)
+	})
+}
+
+func TestIsRevokedUsesLastKnownListOnRefreshFailure(t *testing.T) {
+	ctx := context.Background()
+	caClient := newTestCAClient(t)
+	cert := issueTestCertificate(t, caClient, "device")
+
+	store := &fakeRevocationStore{}
+	caClient.SetRevocationStore(store)
+	_, err := caClient.RevokeCertificates(ctx, uuid.New(), "device", []*x509.Certificate{cert}, domain.CertificateRevocationReasonUnspecified)
+	require.NoError(t, err)
+	isRevoked, err := caClient.IsRevoked(ctx, cert)
+	require.NoError(t, err)
+	require.True(t, isRevoked)
+
+	// force a refresh that fails
+	caClient.revoked.refreshedAt = caClient.revoked.refreshedAt.Add(-2 * revocationListRefreshInterval)
+	store.listErr = errors.New("database unavailable")
+	isRevoked, err = caClient.IsRevoked(ctx, cert)
+	require.Error(t, err)
+	require.True(t, isRevoked)
+}
+
+func TestIsRevokedFailsClosedWithoutRevocationList(t *testing.T) {
+	ctx := context.Background()
+	caClient := newTestCAClient(t)
+	cert := issueTestCertificate(t, caClient, "device")
+
+	store := &fakeRevocationStore{listErr: errors.New("database unavailable")}
+	caClient.SetRevocationStore(store)
+	_, err := caClient.IsRevoked(ctx, cert)
+	require.ErrorIs(t, err, ErrRevocationListUnavailable)
+	require.ErrorContains(t, err, "database unavailable")
+
+	issuer := caClient.GetCABundleX509()[0]
+	req, err := ocsp.CreateRequest(cert, issuer, nil)
+	require.NoError(t, err)
+	der, err := caClient.CreateOCSPResponse(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, ocsp.InternalErrorErrorResponse, der)
+
+	// the list is used once it has been loaded
+	store.listErr = nil
+	caClient.revoked.refreshedAt = caClient.revoked.refreshedAt.Add(-2 * revocationListRefreshInterval)
+	isRevoked, err := caClient.IsRevoked(ctx, cert)
+	require.NoError(t, err)
+	require.False(t, isRevoked)
+}
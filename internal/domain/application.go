@@ -21,6 +21,16 @@ const (
 	InlineApplicationProviderType = v1beta1.InlineApplicationProviderType
 )
 
+// ========== Application Dependency Types ==========
+
+type ApplicationDependency = v1beta1.ApplicationDependency
+type ApplicationDependencyCondition = v1beta1.ApplicationDependencyCondition
+
+const (
+	ApplicationDependencyConditionHealthy = v1beta1.ApplicationDependencyConditionHealthy
+	ApplicationDependencyConditionStarted = v1beta1.ApplicationDependencyConditionStarted
+)
+
 // ========== Application Content ==========
 
 type ApplicationContent = v1beta1.ApplicationContent
@@ -52,6 +62,7 @@ const (
 
 const (
 	ApplicationVolumeReclaimPolicyRetain = v1beta1.Retain
+	ApplicationVolumeReclaimPolicyDelete = v1beta1.Delete
 
 	// Direct alias for compatibility
 	Retain = v1beta1.Retain
@@ -8,3 +8,15 @@ type CertificateSigningRequest = v1beta1.CertificateSigningRequest
 type CertificateSigningRequestList = v1beta1.CertificateSigningRequestList
 type CertificateSigningRequestSpec = v1beta1.CertificateSigningRequestSpec
 type CertificateSigningRequestStatus = v1beta1.CertificateSigningRequestStatus
+
+// ========== Revocation Types ==========
+
+type RevokedCertificate = v1beta1.RevokedCertificate
+type CertificateRevocationReason = v1beta1.CertificateRevocationReason
+
+const (
+	CertificateRevocationReasonCessationOfOperation = v1beta1.CertificateRevocationReasonCessationOfOperation
+	CertificateRevocationReasonKeyCompromise        = v1beta1.CertificateRevocationReasonKeyCompromise
+	CertificateRevocationReasonSuperseded           = v1beta1.CertificateRevocationReasonSuperseded
+	CertificateRevocationReasonUnspecified          = v1beta1.CertificateRevocationReasonUnspecified
+)
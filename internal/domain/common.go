@@ -50,6 +50,7 @@ var (
 	IsStatusConditionFalse           = v1beta1.IsStatusConditionFalse
 	SetStatusConditionByError        = v1beta1.SetStatusConditionByError
 	IsStatusConditionPresentAndEqual = v1beta1.IsStatusConditionPresentAndEqual
+	IsUpdateFailed                   = v1beta1.IsUpdateFailed
 )
 
 // ========== Selectors ==========
@@ -89,10 +90,14 @@ const (
 	ResourceKindCertificateSigningRequest              = v1beta1.ResourceKindCertificateSigningRequest
 	ResourceKindDevice                                 = v1beta1.ResourceKindDevice
 	ResourceKindEnrollmentRequest                      = v1beta1.ResourceKindEnrollmentRequest
+	ResourceKindEnrollmentPolicy                       = v1beta1.ResourceKindEnrollmentPolicy
 	ResourceKindFleet                                  = v1beta1.ResourceKindFleet
+	ResourceKindReferenceMeasurement                   = v1beta1.ResourceKindReferenceMeasurement
 	ResourceKindRepository                             = v1beta1.ResourceKindRepository
 	ResourceKindResourceSync                           = v1beta1.ResourceKindResourceSync
+	ResourceKindSecret                                 = v1beta1.ResourceKindSecret
 	ResourceKindTemplateVersion                        = v1beta1.ResourceKindTemplateVersion
+	ResourceKindTrustPolicy                            = v1beta1.ResourceKindTrustPolicy
 )
 
 // ========== Interfaces ==========
@@ -9,6 +9,7 @@ type GitConfigProviderSpec = v1beta1.GitConfigProviderSpec
 type HttpConfigProviderSpec = v1beta1.HttpConfigProviderSpec
 type InlineConfigProviderSpec = v1beta1.InlineConfigProviderSpec
 type KubernetesSecretProviderSpec = v1beta1.KubernetesSecretProviderSpec
+type SecretProviderSpec = v1beta1.SecretProviderSpec
 
 // ConfigProviderType discriminator type
 type ConfigProviderType = v1beta1.ConfigProviderType
@@ -18,6 +19,7 @@ const (
 	HttpConfigProviderType       = v1beta1.HttpConfigProviderType
 	InlineConfigProviderType     = v1beta1.InlineConfigProviderType
 	KubernetesSecretProviderType = v1beta1.KubernetesSecretProviderType
+	SecretProviderType           = v1beta1.SecretProviderType
 )
 
 // ========== File Types ==========
@@ -41,6 +43,7 @@ type EncodingType = v1beta1.EncodingType
 
 const (
 	EncodingBase64 = v1beta1.EncodingBase64
+	EncodingJWE    = v1beta1.EncodingJWE
 	EncodingPlain  = v1beta1.EncodingPlain
 )
 
@@ -48,6 +51,11 @@ const (
 
 type HookAction = v1beta1.HookAction
 type HookActionRun = v1beta1.HookActionRun
+type HookActionSystemd = v1beta1.HookActionSystemd
+type HookActionSystemdOperation = v1beta1.HookActionSystemdOperation
+type HookActionRestartApplication = v1beta1.HookActionRestartApplication
+type HookActionWaitForHttp = v1beta1.HookActionWaitForHttp
+type HookActionWaitForTcp = v1beta1.HookActionWaitForTcp
 type HookCondition = v1beta1.HookCondition
 type HookConditionExpression = v1beta1.HookConditionExpression
 type HookConditionPathOp = v1beta1.HookConditionPathOp
@@ -56,7 +64,18 @@ type HookConditionPathOp = v1beta1.HookConditionPathOp
 type HookActionType = v1beta1.HookActionType
 
 const (
-	HookActionTypeRun = v1beta1.HookActionTypeRun
+	HookActionTypeRun                = v1beta1.HookActionTypeRun
+	HookActionTypeSystemd            = v1beta1.HookActionTypeSystemd
+	HookActionTypeRestartApplication = v1beta1.HookActionTypeRestartApplication
+	HookActionTypeWaitForHttp        = v1beta1.HookActionTypeWaitForHttp
+	HookActionTypeWaitForTcp         = v1beta1.HookActionTypeWaitForTcp
+)
+
+const (
+	HookActionSystemdOperationStart   = v1beta1.HookActionSystemdOperationStart
+	HookActionSystemdOperationStop    = v1beta1.HookActionSystemdOperationStop
+	HookActionSystemdOperationRestart = v1beta1.HookActionSystemdOperationRestart
+	HookActionSystemdOperationReload  = v1beta1.HookActionSystemdOperationReload
 )
 
 // HookConditionType discriminator
@@ -74,6 +93,11 @@ type ResourceMonitorSpec = v1beta1.ResourceMonitorSpec
 type CpuResourceMonitorSpec = v1beta1.CpuResourceMonitorSpec
 type MemoryResourceMonitorSpec = v1beta1.MemoryResourceMonitorSpec
 type DiskResourceMonitorSpec = v1beta1.DiskResourceMonitorSpec
+type InodeResourceMonitorSpec = v1beta1.InodeResourceMonitorSpec
+type TemperatureResourceMonitorSpec = v1beta1.TemperatureResourceMonitorSpec
+type NetworkResourceMonitorSpec = v1beta1.NetworkResourceMonitorSpec
+type NetworkResourceMonitorMetric = v1beta1.NetworkResourceMonitorMetric
+type ApplicationResourceMonitorSpec = v1beta1.ApplicationResourceMonitorSpec
 type ResourceAlertRule = v1beta1.ResourceAlertRule
 type ResourceAlertSeverityType = v1beta1.ResourceAlertSeverityType
 
@@ -88,6 +112,11 @@ const (
 	ResourceAlertSeverityTypeWarning  = v1beta1.ResourceAlertSeverityTypeWarning
 )
 
+const (
+	NetworkResourceMonitorMetricErrors     = v1beta1.NetworkResourceMonitorMetricErrors
+	NetworkResourceMonitorMetricThroughput = v1beta1.NetworkResourceMonitorMetricThroughput
+)
+
 // ========== Systemd Types ==========
 
 type SystemdUnitStatus = v1beta1.SystemdUnitStatus
@@ -147,3 +176,5 @@ type Version = v1beta1.Version
 // ========== Secure String ==========
 
 type SecureString = v1beta1.SecureString
+
+const RedactedPlaceholder = v1beta1.RedactedPlaceholder
@@ -34,8 +34,15 @@ const (
 	DeviceAnnotationTemplateVersion         = v1beta1.DeviceAnnotationTemplateVersion
 	DeviceAnnotationRenderedTemplateVersion = v1beta1.DeviceAnnotationRenderedTemplateVersion
 	DeviceAnnotationRenderedSpecHash        = v1beta1.DeviceAnnotationRenderedSpecHash
+	DeviceAnnotationRenderedOsImage         = v1beta1.DeviceAnnotationRenderedOsImage
 	DeviceAnnotationSelectedForRollout      = v1beta1.DeviceAnnotationSelectedForRollout
 	DeviceAnnotationLastRolloutError        = v1beta1.DeviceAnnotationLastRolloutError
+	DeviceAnnotationQuarantined             = v1beta1.DeviceAnnotationQuarantined
+)
+
+// EnrollmentRequest annotation keys
+const (
+	EnrollmentRequestAnnotationEnrollmentCertificate = v1beta1.EnrollmentRequestAnnotationEnrollmentCertificate
 )
 
 const DeviceDisconnectedTimeout = v1beta1.DeviceDisconnectedTimeout
@@ -66,6 +73,8 @@ const (
 	FleetAnnotationRolloutApprovalMethod       = v1beta1.FleetAnnotationRolloutApprovalMethod
 	FleetAnnotationLastBatchCompletionReport   = v1beta1.FleetAnnotationLastBatchCompletionReport
 	FleetAnnotationDeviceSelectionConfigDigest = v1beta1.FleetAnnotationDeviceSelectionConfigDigest
+	FleetAnnotationLastBatchCompletionTime     = v1beta1.FleetAnnotationLastBatchCompletionTime
+	FleetAnnotationPreviousTemplateVersion     = v1beta1.FleetAnnotationPreviousTemplateVersion
 )
 
 // ========== Event ==========
@@ -102,6 +111,22 @@ const (
 	AuthConfigKind       = v1beta1.AuthConfigKind
 )
 
+// ========== EnrollmentPolicy ==========
+
+const (
+	EnrollmentPolicyAPIVersion = v1beta1.EnrollmentPolicyAPIVersion
+	EnrollmentPolicyKind       = v1beta1.EnrollmentPolicyKind
+	EnrollmentPolicyListKind   = v1beta1.EnrollmentPolicyListKind
+)
+
+// ========== ReferenceMeasurement ==========
+
+const (
+	ReferenceMeasurementAPIVersion = v1beta1.ReferenceMeasurementAPIVersion
+	ReferenceMeasurementKind       = v1beta1.ReferenceMeasurementKind
+	ReferenceMeasurementListKind   = v1beta1.ReferenceMeasurementListKind
+)
+
 // ========== ResourceSync ==========
 
 type ResourceSyncType = v1beta1.ResourceSyncType
@@ -114,6 +139,30 @@ const (
 	ResourceSyncTypeCatalog = v1beta1.ResourceSyncTypeCatalog
 )
 
+// ========== Secret ==========
+
+const (
+	SecretAPIVersion = v1beta1.SecretAPIVersion
+	SecretKind       = v1beta1.SecretKind
+	SecretListKind   = v1beta1.SecretListKind
+)
+
+// ========== TrustPolicy ==========
+
+const (
+	TrustPolicyAPIVersion = v1beta1.TrustPolicyAPIVersion
+	TrustPolicyKind       = v1beta1.TrustPolicyKind
+	TrustPolicyListKind   = v1beta1.TrustPolicyListKind
+
+	TrustRequirementTypeAccept         = v1beta1.TrustRequirementTypeAccept
+	TrustRequirementTypeReject         = v1beta1.TrustRequirementTypeReject
+	TrustRequirementTypeSigstoreSigned = v1beta1.TrustRequirementTypeSigstoreSigned
+	TrustRequirementTypeSignedBy       = v1beta1.TrustRequirementTypeSignedBy
+
+	// DefaultTrustPolicyName is the TrustPolicy that applies to devices whose spec references none
+	DefaultTrustPolicyName = "default"
+)
+
 // ========== Catalog ==========
 
 const (
@@ -174,15 +223,16 @@ const (
 type UpdateState = v1beta1.UpdateState
 
 const (
-	UpdateStatePreparing      = v1beta1.UpdateStatePreparing
-	UpdateStateReadyToUpdate  = v1beta1.UpdateStateReadyToUpdate
-	UpdateStateApplyingUpdate = v1beta1.UpdateStateApplyingUpdate
-	UpdateStateRebooting      = v1beta1.UpdateStateRebooting
-	UpdateStateUpdated        = v1beta1.UpdateStateUpdated
-	UpdateStateCanceled       = v1beta1.UpdateStateCanceled
-	UpdateStateError          = v1beta1.UpdateStateError
-	UpdateStateRollingBack    = v1beta1.UpdateStateRollingBack
-	UpdateStateRetrying       = v1beta1.UpdateStateRetrying
+	UpdateStatePreparing         = v1beta1.UpdateStatePreparing
+	UpdateStateReadyToUpdate     = v1beta1.UpdateStateReadyToUpdate
+	UpdateStateApplyingUpdate    = v1beta1.UpdateStateApplyingUpdate
+	UpdateStateRebooting         = v1beta1.UpdateStateRebooting
+	UpdateStateUpdated           = v1beta1.UpdateStateUpdated
+	UpdateStateCanceled          = v1beta1.UpdateStateCanceled
+	UpdateStateError             = v1beta1.UpdateStateError
+	UpdateStateHealthCheckFailed = v1beta1.UpdateStateHealthCheckFailed
+	UpdateStateRollingBack       = v1beta1.UpdateStateRollingBack
+	UpdateStateRetrying          = v1beta1.UpdateStateRetrying
 )
 
 // ========== Decommission State ==========
@@ -198,10 +248,11 @@ const (
 // ========== Rollout Reasons ==========
 
 const (
-	RolloutInactiveReason  = v1beta1.RolloutInactiveReason
-	RolloutActiveReason    = v1beta1.RolloutActiveReason
-	RolloutSuspendedReason = v1beta1.RolloutSuspendedReason
-	RolloutWaitingReason   = v1beta1.RolloutWaitingReason
+	RolloutInactiveReason   = v1beta1.RolloutInactiveReason
+	RolloutActiveReason     = v1beta1.RolloutActiveReason
+	RolloutSuspendedReason  = v1beta1.RolloutSuspendedReason
+	RolloutWaitingReason    = v1beta1.RolloutWaitingReason
+	RolloutRolledBackReason = v1beta1.RolloutRolledBackReason
 )
 
 // ========== Batch Names ==========
@@ -226,7 +277,10 @@ const (
 
 // ========== ResourceSync Reasons ==========
 
-const ResourceSyncNewHashDetectedReason = v1beta1.ResourceSyncNewHashDetectedReason
+const (
+	ResourceSyncNewHashDetectedReason = v1beta1.ResourceSyncNewHashDetectedReason
+	ResourceSyncDryRunReason          = v1beta1.ResourceSyncDryRunReason
+)
 
 // ========== Device Text ==========
 
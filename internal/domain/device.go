@@ -33,11 +33,16 @@ type CustomDeviceInfo = v1beta1.CustomDeviceInfo
 
 type DeviceOsSpec = v1beta1.DeviceOsSpec
 type DeviceUpdatePolicySpec = v1beta1.DeviceUpdatePolicySpec
+type DeviceHealthChecks = v1beta1.DeviceHealthChecks
+type HealthProbe = v1beta1.HealthProbe
+type DeviceTrustPolicy = v1beta1.DeviceTrustPolicy
 
 // ========== Operations ==========
 
 type DeviceConsole = v1beta1.DeviceConsole
 type DeviceDecommission = v1beta1.DeviceDecommission
+type DeviceRevocation = v1beta1.DeviceRevocation
+type DeviceRevocationResult = v1beta1.DeviceRevocationResult
 type DeviceResumeRequest = v1beta1.DeviceResumeRequest
 type DeviceResumeResponse = v1beta1.DeviceResumeResponse
 
@@ -0,0 +1,12 @@
+package domain
+
+import v1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
+
+// ========== Resource Types ==========
+
+type EnrollmentPolicy = v1beta1.EnrollmentPolicy
+type EnrollmentPolicyList = v1beta1.EnrollmentPolicyList
+type EnrollmentPolicySpec = v1beta1.EnrollmentPolicySpec
+type EnrollmentPolicyMatch = v1beta1.EnrollmentPolicyMatch
+type EnrollmentPolicyAllowList = v1beta1.EnrollmentPolicyAllowList
+type EnrollmentPolicyApproval = v1beta1.EnrollmentPolicyApproval
@@ -17,6 +17,18 @@ type EventList = v1beta1.EventList
 type EventSource = v1beta1.EventSource
 type EventDetails = v1beta1.EventDetails
 
+// ========== Watch Types ==========
+
+type WatchEvent = v1beta1.WatchEvent
+type WatchEventType = v1beta1.WatchEventType
+
+const (
+	WatchEventAdded    = v1beta1.WatchEventAdded
+	WatchEventModified = v1beta1.WatchEventModified
+	WatchEventDeleted  = v1beta1.WatchEventDeleted
+	WatchEventBookmark = v1beta1.WatchEventBookmark
+)
+
 // ========== Event Enums ==========
 
 type EventReason = v1beta1.EventReason
@@ -37,6 +49,9 @@ const (
 	EventReasonDeviceApplicationDegraded       = v1beta1.EventReasonDeviceApplicationDegraded
 	EventReasonDeviceApplicationError          = v1beta1.EventReasonDeviceApplicationError
 	EventReasonDeviceApplicationHealthy        = v1beta1.EventReasonDeviceApplicationHealthy
+	EventReasonDeviceApplicationUsageCritical  = v1beta1.EventReasonDeviceApplicationUsageCritical
+	EventReasonDeviceApplicationUsageNormal    = v1beta1.EventReasonDeviceApplicationUsageNormal
+	EventReasonDeviceApplicationUsageWarning   = v1beta1.EventReasonDeviceApplicationUsageWarning
 	EventReasonDeviceCPUCritical               = v1beta1.EventReasonDeviceCPUCritical
 	EventReasonDeviceCPUNormal                 = v1beta1.EventReasonDeviceCPUNormal
 	EventReasonDeviceCPUWarning                = v1beta1.EventReasonDeviceCPUWarning
@@ -52,14 +67,26 @@ const (
 	EventReasonDeviceDiskCritical              = v1beta1.EventReasonDeviceDiskCritical
 	EventReasonDeviceDiskNormal                = v1beta1.EventReasonDeviceDiskNormal
 	EventReasonDeviceDiskWarning               = v1beta1.EventReasonDeviceDiskWarning
+	EventReasonDeviceEncryptionKeyUpdated      = v1beta1.EventReasonDeviceEncryptionKeyUpdated
+	EventReasonDeviceInodeCritical             = v1beta1.EventReasonDeviceInodeCritical
+	EventReasonDeviceInodeNormal               = v1beta1.EventReasonDeviceInodeNormal
+	EventReasonDeviceInodeWarning              = v1beta1.EventReasonDeviceInodeWarning
+	EventReasonDeviceIntegrityFailed           = v1beta1.EventReasonDeviceIntegrityFailed
+	EventReasonDeviceIntegrityVerified         = v1beta1.EventReasonDeviceIntegrityVerified
 	EventReasonDeviceIsRebooting               = v1beta1.EventReasonDeviceIsRebooting
 	EventReasonDeviceMemoryCritical            = v1beta1.EventReasonDeviceMemoryCritical
 	EventReasonDeviceMemoryNormal              = v1beta1.EventReasonDeviceMemoryNormal
 	EventReasonDeviceMemoryWarning             = v1beta1.EventReasonDeviceMemoryWarning
 	EventReasonDeviceMultipleOwnersDetected    = v1beta1.EventReasonDeviceMultipleOwnersDetected
 	EventReasonDeviceMultipleOwnersResolved    = v1beta1.EventReasonDeviceMultipleOwnersResolved
+	EventReasonDeviceNetworkCritical           = v1beta1.EventReasonDeviceNetworkCritical
+	EventReasonDeviceNetworkNormal             = v1beta1.EventReasonDeviceNetworkNormal
+	EventReasonDeviceNetworkWarning            = v1beta1.EventReasonDeviceNetworkWarning
 	EventReasonDeviceSpecInvalid               = v1beta1.EventReasonDeviceSpecInvalid
 	EventReasonDeviceSpecValid                 = v1beta1.EventReasonDeviceSpecValid
+	EventReasonDeviceTemperatureCritical       = v1beta1.EventReasonDeviceTemperatureCritical
+	EventReasonDeviceTemperatureNormal         = v1beta1.EventReasonDeviceTemperatureNormal
+	EventReasonDeviceTemperatureWarning        = v1beta1.EventReasonDeviceTemperatureWarning
 	EventReasonDeviceUpdateFailed              = v1beta1.EventReasonDeviceUpdateFailed
 	EventReasonEnrollmentRequestApprovalFailed = v1beta1.EventReasonEnrollmentRequestApprovalFailed
 	EventReasonEnrollmentRequestApproved       = v1beta1.EventReasonEnrollmentRequestApproved
@@ -70,6 +97,7 @@ const (
 	EventReasonFleetRolloutCreated             = v1beta1.EventReasonFleetRolloutCreated
 	EventReasonFleetRolloutDeviceSelected      = v1beta1.EventReasonFleetRolloutDeviceSelected
 	EventReasonFleetRolloutFailed              = v1beta1.EventReasonFleetRolloutFailed
+	EventReasonFleetRolloutRolledBack          = v1beta1.EventReasonFleetRolloutRolledBack
 	EventReasonFleetRolloutStarted             = v1beta1.EventReasonFleetRolloutStarted
 	EventReasonFleetValid                      = v1beta1.EventReasonFleetValid
 	EventReasonInternalTaskFailed              = v1beta1.EventReasonInternalTaskFailed
@@ -77,6 +105,7 @@ const (
 	EventReasonReferencedRepositoryUpdated     = v1beta1.EventReasonReferencedRepositoryUpdated
 	EventReasonRepositoryAccessible            = v1beta1.EventReasonRepositoryAccessible
 	EventReasonRepositoryInaccessible          = v1beta1.EventReasonRepositoryInaccessible
+	EventReasonRepositoryPushReceived          = v1beta1.EventReasonRepositoryPushReceived
 	EventReasonResourceCreated                 = v1beta1.EventReasonResourceCreated
 	EventReasonResourceCreationFailed          = v1beta1.EventReasonResourceCreationFailed
 	EventReasonResourceDeleted                 = v1beta1.EventReasonResourceDeleted
@@ -86,6 +115,7 @@ const (
 	EventReasonResourceSyncInaccessible        = v1beta1.EventReasonResourceSyncInaccessible
 	EventReasonResourceSyncParsed              = v1beta1.EventReasonResourceSyncParsed
 	EventReasonResourceSyncParsingFailed       = v1beta1.EventReasonResourceSyncParsingFailed
+	EventReasonResourceSyncPreviewRequested    = v1beta1.EventReasonResourceSyncPreviewRequested
 	EventReasonResourceSyncSyncFailed          = v1beta1.EventReasonResourceSyncSyncFailed
 	EventReasonResourceSyncSynced              = v1beta1.EventReasonResourceSyncSynced
 	EventReasonResourceUpdateFailed            = v1beta1.EventReasonResourceUpdateFailed
@@ -101,6 +131,10 @@ type InternalTaskPermanentlyFailedDetails = v1beta1.InternalTaskPermanentlyFaile
 type InternalTaskPermanentlyFailedDetailsDetailType = v1beta1.InternalTaskPermanentlyFailedDetailsDetailType
 type ReferencedRepositoryUpdatedDetails = v1beta1.ReferencedRepositoryUpdatedDetails
 type ReferencedRepositoryUpdatedDetailsDetailType = v1beta1.ReferencedRepositoryUpdatedDetailsDetailType
+type RepositoryPushReceivedDetails = v1beta1.RepositoryPushReceivedDetails
+type RepositoryPushReceivedDetailsDetailType = v1beta1.RepositoryPushReceivedDetailsDetailType
+type ResourceSyncPreviewRequestedDetails = v1beta1.ResourceSyncPreviewRequestedDetails
+type ResourceSyncPreviewRequestedDetailsDetailType = v1beta1.ResourceSyncPreviewRequestedDetailsDetailType
 type ResourceUpdatedDetails = v1beta1.ResourceUpdatedDetails
 type ResourceUpdatedDetailsDetailType = v1beta1.ResourceUpdatedDetailsDetailType
 type ResourceUpdatedDetailsUpdatedFields = v1beta1.ResourceUpdatedDetailsUpdatedFields
@@ -109,21 +143,27 @@ const (
 	InternalTaskFailed            = v1beta1.InternalTaskFailed
 	InternalTaskPermanentlyFailed = v1beta1.InternalTaskPermanentlyFailed
 	ReferencedRepositoryUpdated   = v1beta1.ReferencedRepositoryUpdated
+	RepositoryPushReceived        = v1beta1.RepositoryPushReceived
+	ResourceSyncPreviewRequested  = v1beta1.ResourceSyncPreviewRequested
 	ResourceUpdated               = v1beta1.ResourceUpdated
 
 	// Updated field constants with prefix (descriptive)
-	UpdatedFieldLabels       = v1beta1.Labels
-	UpdatedFieldOwner        = v1beta1.Owner
-	UpdatedFieldSpec         = v1beta1.Spec
-	UpdatedFieldSpecSelector = v1beta1.SpecSelector
-	UpdatedFieldSpecTemplate = v1beta1.SpecTemplate
+	UpdatedFieldLabels        = v1beta1.Labels
+	UpdatedFieldOwner         = v1beta1.Owner
+	UpdatedFieldSpec          = v1beta1.Spec
+	UpdatedFieldSpecSelector  = v1beta1.SpecSelector
+	UpdatedFieldSpecTemplate  = v1beta1.SpecTemplate
+	UpdatedFieldSpecVariables = v1beta1.SpecVariables
+	UpdatedFieldSystemInfo    = v1beta1.StatusSystemInfo
 
 	// Direct aliases for compatibility
-	Labels       = v1beta1.Labels
-	Owner        = v1beta1.Owner
-	Spec         = v1beta1.Spec
-	SpecSelector = v1beta1.SpecSelector
-	SpecTemplate = v1beta1.SpecTemplate
+	Labels        = v1beta1.Labels
+	Owner         = v1beta1.Owner
+	Spec          = v1beta1.Spec
+	SpecSelector  = v1beta1.SpecSelector
+	SpecTemplate  = v1beta1.SpecTemplate
+	SpecVariables = v1beta1.SpecVariables
+	SystemInfo    = v1beta1.StatusSystemInfo
 )
 
 // ========== Utility Functions ==========
@@ -143,8 +183,17 @@ var warningReasons = map[EventReason]struct{}{
 	EventReasonDeviceMemoryWarning:             {},
 	EventReasonDeviceDiskCritical:              {},
 	EventReasonDeviceDiskWarning:               {},
+	EventReasonDeviceInodeCritical:             {},
+	EventReasonDeviceInodeWarning:              {},
+	EventReasonDeviceTemperatureCritical:       {},
+	EventReasonDeviceTemperatureWarning:        {},
+	EventReasonDeviceNetworkCritical:           {},
+	EventReasonDeviceNetworkWarning:            {},
+	EventReasonDeviceApplicationUsageCritical:  {},
+	EventReasonDeviceApplicationUsageWarning:   {},
 	EventReasonDeviceDisconnected:              {},
 	EventReasonDeviceConflictPaused:            {},
+	EventReasonDeviceIntegrityFailed:           {},
 	EventReasonDeviceSpecInvalid:               {},
 	EventReasonFleetInvalid:                    {},
 	EventReasonDeviceMultipleOwnersDetected:    {},
@@ -155,6 +204,7 @@ var warningReasons = map[EventReason]struct{}{
 	EventReasonResourceSyncParsingFailed:       {},
 	EventReasonResourceSyncSyncFailed:          {},
 	EventReasonFleetRolloutFailed:              {},
+	EventReasonFleetRolloutRolledBack:          {},
 }
 
 // GetEventType determines the event type based on the event reason
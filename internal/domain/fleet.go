@@ -15,8 +15,14 @@ type RolloutPolicy = v1beta1.RolloutPolicy
 type RolloutDeviceSelection = v1beta1.RolloutDeviceSelection
 type RolloutStrategy = v1beta1.RolloutStrategy
 type FleetRolloutStatus = v1beta1.FleetRolloutStatus
+type FleetRolloutRollback = v1beta1.FleetRolloutRollback
+type RolloutFailurePolicy = v1beta1.RolloutFailurePolicy
+type RolloutWindow = v1beta1.RolloutWindow
+type RolloutBlackout = v1beta1.RolloutBlackout
 type Batch = v1beta1.Batch
 type BatchSequence = v1beta1.BatchSequence
+type Canary = v1beta1.Canary
+type LabelWaves = v1beta1.LabelWaves
 type Batch_Limit = v1beta1.Batch_Limit
 type BatchLimit1 = v1beta1.BatchLimit1
 type DisruptionBudget = v1beta1.DisruptionBudget
@@ -25,6 +31,15 @@ type DisruptionBudget = v1beta1.DisruptionBudget
 
 const (
 	RolloutStrategyBatchSequence = v1beta1.RolloutStrategyBatchSequence
+	RolloutStrategyCanary        = v1beta1.RolloutStrategyCanary
+	RolloutStrategyLabelWaves    = v1beta1.RolloutStrategyLabelWaves
+)
+
+// ========== Rollout Failure Policy Constants ==========
+
+const (
+	RolloutFailurePolicySuspend  = v1beta1.RolloutFailurePolicySuspend
+	RolloutFailurePolicyRollback = v1beta1.RolloutFailurePolicyRollback
 )
 
 // ========== Fleet Event Details Types ==========
@@ -39,6 +54,8 @@ type FleetRolloutDeviceSelectedDetails = v1beta1.FleetRolloutDeviceSelectedDetai
 type FleetRolloutDeviceSelectedDetailsDetailType = v1beta1.FleetRolloutDeviceSelectedDetailsDetailType
 type FleetRolloutFailedDetails = v1beta1.FleetRolloutFailedDetails
 type FleetRolloutFailedDetailsDetailType = v1beta1.FleetRolloutFailedDetailsDetailType
+type FleetRolloutRolledBackDetails = v1beta1.FleetRolloutRolledBackDetails
+type FleetRolloutRolledBackDetailsDetailType = v1beta1.FleetRolloutRolledBackDetailsDetailType
 type FleetRolloutStartedDetails = v1beta1.FleetRolloutStartedDetails
 type FleetRolloutStartedDetailsDetailType = v1beta1.FleetRolloutStartedDetailsDetailType
 type FleetRolloutStartedDetailsRolloutStrategy = v1beta1.FleetRolloutStartedDetailsRolloutStrategy
@@ -49,6 +66,7 @@ const (
 	FleetRolloutCompleted       = v1beta1.FleetRolloutCompleted
 	FleetRolloutDeviceSelected  = v1beta1.FleetRolloutDeviceSelected
 	FleetRolloutFailed          = v1beta1.FleetRolloutFailed
+	FleetRolloutRolledBack      = v1beta1.FleetRolloutRolledBack
 	FleetRolloutStarted         = v1beta1.FleetRolloutStarted
 	FleetRolloutStrategyBatched = v1beta1.Batched
 	FleetRolloutStrategyNone    = v1beta1.None
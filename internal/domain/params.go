@@ -8,19 +8,24 @@ type ListAuthProvidersParams = v1beta1.ListAuthProvidersParams
 type ListCertificateSigningRequestsParams = v1beta1.ListCertificateSigningRequestsParams
 type ListDevicesParams = v1beta1.ListDevicesParams
 type ListEnrollmentRequestsParams = v1beta1.ListEnrollmentRequestsParams
+type ListEnrollmentPoliciesParams = v1beta1.ListEnrollmentPoliciesParams
 type ListEventsParams = v1beta1.ListEventsParams
 type ListFleetsParams = v1beta1.ListFleetsParams
 type ListLabelsParams = v1beta1.ListLabelsParams
 type ListOrganizationsParams = v1beta1.ListOrganizationsParams
+type ListReferenceMeasurementsParams = v1beta1.ListReferenceMeasurementsParams
 type ListRepositoriesParams = v1beta1.ListRepositoriesParams
 type ListResourceSyncsParams = v1beta1.ListResourceSyncsParams
+type ListSecretsParams = v1beta1.ListSecretsParams
 type ListTemplateVersionsParams = v1beta1.ListTemplateVersionsParams
+type ListTrustPoliciesParams = v1beta1.ListTrustPoliciesParams
 
 // ========== Get Params ==========
 
 type GetEnrollmentConfigParams = v1beta1.GetEnrollmentConfigParams
 type GetFleetParams = v1beta1.GetFleetParams
 type GetRenderedDeviceParams = v1beta1.GetRenderedDeviceParams
+type RequestResourceSyncPreviewParams = v1beta1.RequestResourceSyncPreviewParams
 
 // ========== Order Types ==========
 
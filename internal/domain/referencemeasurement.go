@@ -0,0 +1,16 @@
+package domain
+
+import v1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
+
+// ========== Resource Types ==========
+
+type ReferenceMeasurement = v1beta1.ReferenceMeasurement
+type ReferenceMeasurementList = v1beta1.ReferenceMeasurementList
+type ReferenceMeasurementSpec = v1beta1.ReferenceMeasurementSpec
+type PcrReference = v1beta1.PcrReference
+
+// ========== Attestation Types ==========
+
+type AttestationChallenge = v1beta1.AttestationChallenge
+type AttestationReport = v1beta1.AttestationReport
+type PcrValue = v1beta1.PcrValue
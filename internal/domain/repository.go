@@ -16,6 +16,7 @@ type OciRepoSpec = v1beta1.OciRepoSpec
 type HttpRepoSpec = v1beta1.HttpRepoSpec
 type SshConfig = v1beta1.SshConfig
 type HttpConfig = v1beta1.HttpConfig
+type RepositoryWebhookConfig = v1beta1.RepositoryWebhookConfig
 
 // ========== OCI Auth Types ==========
 
@@ -8,6 +8,17 @@ type ResourceSync = v1beta1.ResourceSync
 type ResourceSyncList = v1beta1.ResourceSyncList
 type ResourceSyncSpec = v1beta1.ResourceSyncSpec
 type ResourceSyncStatus = v1beta1.ResourceSyncStatus
+type ResourceSyncPreview = v1beta1.ResourceSyncPreview
+type ResourceSyncPreviewRequest = v1beta1.ResourceSyncPreviewRequest
+type ResourceSyncChange = v1beta1.ResourceSyncChange
+type ResourceSyncChangeAction = v1beta1.ResourceSyncChangeAction
+type ResourceSyncFieldDiff = v1beta1.ResourceSyncFieldDiff
+
+const (
+	ResourceSyncChangeActionCreate = v1beta1.ResourceSyncChangeActionCreate
+	ResourceSyncChangeActionUpdate = v1beta1.ResourceSyncChangeActionUpdate
+	ResourceSyncChangeActionDelete = v1beta1.ResourceSyncChangeActionDelete
+)
 
 // ========== Event Details ==========
 
@@ -0,0 +1,12 @@
+package domain
+
+import v1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
+
+// ========== Resource Types ==========
+
+type TrustPolicy = v1beta1.TrustPolicy
+type TrustPolicyList = v1beta1.TrustPolicyList
+type TrustPolicySpec = v1beta1.TrustPolicySpec
+type TrustPolicyScope = v1beta1.TrustPolicyScope
+type TrustRequirement = v1beta1.TrustRequirement
+type TrustRequirementType = v1beta1.TrustRequirementType
@@ -56,6 +56,9 @@ var (
 	ErrInvalidOrgID          = errors.New("invalid organization ID format")
 	ErrNotOrgMember          = errors.New("access denied to organization")
 
+	// secret
+	ErrSecretEncryptionNotConfigured = errors.New("secrets are not available: no key encryption key is configured")
+
 	// authprovider
 	ErrDuplicateOIDCProvider   = errors.New("an OIDC auth provider with the same issuer and clientId already exists")
 	ErrDuplicateOAuth2Provider = errors.New("an OAuth2 auth provider with the same userinfoUrl and clientId already exists")
@@ -553,10 +553,18 @@ func (s *DummyKVStore) StreamAdd(ctx context.Context, key string, value []byte)
 	return "0-0", nil
 }
 
+func (s *DummyKVStore) StreamAddWithMaxLen(ctx context.Context, key string, value []byte, maxLen int64) (string, error) {
+	return s.StreamAdd(ctx, key, value)
+}
+
 func (s *DummyKVStore) SetExpire(ctx context.Context, key string, expiration time.Duration) error {
 	return nil
 }
 
+func (s *DummyKVStore) StreamInfo(ctx context.Context, key string) (kvstore.StreamInfo, error) {
+	return kvstore.StreamInfo{}, nil
+}
+
 func (s *DummyKVStore) StreamRange(ctx context.Context, key string, start, stop string) ([]kvstore.StreamEntry, error) {
 	return nil, nil
 }
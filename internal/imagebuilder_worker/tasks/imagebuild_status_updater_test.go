@@ -135,6 +135,10 @@ func (m *mockKVStore) StreamAdd(ctx context.Context, key string, value []byte) (
 	return "0-0", nil
 }
 
+func (m *mockKVStore) StreamAddWithMaxLen(ctx context.Context, key string, value []byte, maxLen int64) (string, error) {
+	return m.StreamAdd(ctx, key, value)
+}
+
 func (m *mockKVStore) SetExpire(ctx context.Context, key string, expiration time.Duration) error {
 	m.mu.Lock()
 	m.setExpireCalls++
@@ -176,6 +180,9 @@ func (m *mockKVStore) GetOrSetNX(ctx context.Context, key string, value []byte)
 func (m *mockKVStore) DeleteKeysForTemplateVersion(ctx context.Context, key string) error { return nil }
 func (m *mockKVStore) DeleteAllKeys(ctx context.Context) error                            { return nil }
 func (m *mockKVStore) PrintAllKeys(ctx context.Context)                                   {}
+func (m *mockKVStore) StreamInfo(ctx context.Context, key string) (kvstore.StreamInfo, error) {
+	return kvstore.StreamInfo{}, nil
+}
 func (m *mockKVStore) StreamRange(ctx context.Context, key string, start, stop string) ([]kvstore.StreamEntry, error) {
 	return nil, nil
 }
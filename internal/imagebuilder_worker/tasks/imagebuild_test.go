@@ -38,6 +38,11 @@ func (m *mockStore) CertificateSigningRequest() store.CertificateSigningRequest
 func (m *mockStore) Fleet() store.Fleet                                         { return nil }
 func (m *mockStore) TemplateVersion() store.TemplateVersion                     { return nil }
 func (m *mockStore) ResourceSync() store.ResourceSync                           { return nil }
+func (m *mockStore) CertificateRevocation() store.CertificateRevocation         { return nil }
+func (m *mockStore) ReferenceMeasurement() store.ReferenceMeasurement           { return nil }
+func (m *mockStore) EnrollmentPolicy() store.EnrollmentPolicy                   { return nil }
+func (m *mockStore) Secret() store.Secret                                       { return nil }
+func (m *mockStore) TrustPolicy() store.TrustPolicy                             { return nil }
 func (m *mockStore) Event() store.Event                                         { return nil }
 func (m *mockStore) Checkpoint() store.Checkpoint                               { return nil }
 func (m *mockStore) Organization() store.Organization                           { return nil }
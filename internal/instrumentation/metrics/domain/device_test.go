@@ -49,6 +49,26 @@ func (m *MockStore) ResourceSync() store.ResourceSync {
 	return nil
 }
 
+func (m *MockStore) CertificateRevocation() store.CertificateRevocation {
+	return nil
+}
+
+func (m *MockStore) ReferenceMeasurement() store.ReferenceMeasurement {
+	return nil
+}
+
+func (m *MockStore) EnrollmentPolicy() store.EnrollmentPolicy {
+	return nil
+}
+
+func (m *MockStore) Secret() store.Secret {
+	return nil
+}
+
+func (m *MockStore) TrustPolicy() store.TrustPolicy {
+	return nil
+}
+
 func (m *MockStore) Event() store.Event {
 	return nil
 }
@@ -144,7 +164,7 @@ func (m *MockDevice) GetRendered(ctx context.Context, orgId uuid.UUID, name stri
 func (m *MockDevice) UpdateAnnotations(ctx context.Context, orgId uuid.UUID, name string, annotations map[string]string, deleteKeys []string) error {
 	return nil
 }
-func (m *MockDevice) UpdateRendered(ctx context.Context, orgId uuid.UUID, name, renderedConfig, renderedApplications, specHash string) (string, error) {
+func (m *MockDevice) UpdateRendered(ctx context.Context, orgId uuid.UUID, name, renderedConfig, renderedApplications, renderedTrustPolicy, specHash string) (string, error) {
 	return "", nil
 }
 func (m *MockDevice) SetServiceConditions(ctx context.Context, orgId uuid.UUID, name string, conditions []domain.Condition, callback store.ServiceConditionsCallback) error {
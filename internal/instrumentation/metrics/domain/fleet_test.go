@@ -78,6 +78,10 @@ func (m *MockFleetStore) UpdateStatus(ctx context.Context, orgId uuid.UUID, flee
 	return nil, nil
 }
 
+func (m *MockFleetStore) UpdateRolloutStatus(ctx context.Context, orgId uuid.UUID, name string, rollout *domain.FleetRolloutStatus) error {
+	return nil
+}
+
 func (m *MockFleetStore) ListRolloutDeviceSelection(ctx context.Context, orgId uuid.UUID) (*domain.FleetList, error) {
 	return nil, nil
 }
@@ -138,6 +142,26 @@ func (m *MockFleetStoreWrapper) ResourceSync() store.ResourceSync {
 	return nil
 }
 
+func (m *MockFleetStoreWrapper) CertificateRevocation() store.CertificateRevocation {
+	return nil
+}
+
+func (m *MockFleetStoreWrapper) ReferenceMeasurement() store.ReferenceMeasurement {
+	return nil
+}
+
+func (m *MockFleetStoreWrapper) EnrollmentPolicy() store.EnrollmentPolicy {
+	return nil
+}
+
+func (m *MockFleetStoreWrapper) Secret() store.Secret {
+	return nil
+}
+
+func (m *MockFleetStoreWrapper) TrustPolicy() store.TrustPolicy {
+	return nil
+}
+
 func (m *MockFleetStoreWrapper) EnrollmentRequest() store.EnrollmentRequest {
 	return nil
 }
@@ -32,6 +32,11 @@ func (m *MockRepositoryStore) CertificateSigningRequest() store.CertificateSigni
 func (m *MockRepositoryStore) Fleet() store.Fleet                                         { return nil }
 func (m *MockRepositoryStore) TemplateVersion() store.TemplateVersion                     { return nil }
 func (m *MockRepositoryStore) ResourceSync() store.ResourceSync                           { return nil }
+func (m *MockRepositoryStore) CertificateRevocation() store.CertificateRevocation         { return nil }
+func (m *MockRepositoryStore) ReferenceMeasurement() store.ReferenceMeasurement           { return nil }
+func (m *MockRepositoryStore) EnrollmentPolicy() store.EnrollmentPolicy                   { return nil }
+func (m *MockRepositoryStore) Secret() store.Secret                                       { return nil }
+func (m *MockRepositoryStore) TrustPolicy() store.TrustPolicy                             { return nil }
 func (m *MockRepositoryStore) Event() store.Event                                         { return nil }
 func (m *MockRepositoryStore) Checkpoint() store.Checkpoint                               { return nil }
 func (m *MockRepositoryStore) Organization() store.Organization                           { return nil }
@@ -65,6 +65,17 @@ func (k *K8sSecretKey) ComposeKey() string {
 	return fmt.Sprintf("v1/%s/%s/%s/k8ssecret-data/%s/%s", k.OrgID, k.Fleet, k.TemplateVersion, k.Namespace, k.Name)
 }
 
+type SecretKey struct {
+	OrgID           uuid.UUID
+	Fleet           string
+	TemplateVersion string
+	Name            string
+}
+
+func (k *SecretKey) ComposeKey() string {
+	return fmt.Sprintf("v1/%s/%s/%s/secret-data/%s", k.OrgID, k.Fleet, k.TemplateVersion, k.Name)
+}
+
 type HttpKey struct {
 	OrgID           uuid.UUID
 	Fleet           string
@@ -94,3 +105,31 @@ type AwaitingReconnectionKey struct {
 func (a *AwaitingReconnectionKey) ComposeKey() string {
 	return fmt.Sprintf("v1/%s/device/%s/awaiting-reconnect", a.OrgID, a.DeviceName)
 }
+
+type AttestationNonceKey struct {
+	OrgID      uuid.UUID
+	DeviceName string
+}
+
+func (a *AttestationNonceKey) ComposeKey() string {
+	return fmt.Sprintf("v1/%s/device/%s/attestation-nonce", a.OrgID, a.DeviceName)
+}
+
+type ResourceSyncPreviewKey struct {
+	OrgID            uuid.UUID
+	ResourceSyncName string
+	RequestID        string
+}
+
+func (r *ResourceSyncPreviewKey) ComposeKey() string {
+	return fmt.Sprintf("v1/%s/resourcesync/%s/preview/%s", r.OrgID, r.ResourceSyncName, r.RequestID)
+}
+
+type WatchStreamKey struct {
+	OrgID uuid.UUID
+	Kind  string
+}
+
+func (w *WatchStreamKey) ComposeKey() string {
+	return fmt.Sprintf("v1/%s/watch/%s", w.OrgID, w.Kind)
+}
@@ -3,6 +3,7 @@ package kvstore
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/flightctl/flightctl/internal/domain"
@@ -18,6 +19,16 @@ type StreamEntry struct {
 	Value []byte
 }
 
+// StreamInfo describes the entries retained in a Redis stream
+type StreamInfo struct {
+	// Length is the number of entries in the stream
+	Length int64
+	// EntriesAdded is the number of entries ever added to the stream, including trimmed ones
+	EntriesAdded int64
+	// FirstID is the ID of the oldest entry in the stream, empty if the stream is empty
+	FirstID string
+}
+
 type KVStore interface {
 	Close()
 	SetNX(ctx context.Context, key string, value []byte) (bool, error)
@@ -29,8 +40,10 @@ type KVStore interface {
 	PrintAllKeys(ctx context.Context) // For debugging
 	// Stream operations for log streaming
 	StreamAdd(ctx context.Context, key string, value []byte) (string, error)
+	StreamAddWithMaxLen(ctx context.Context, key string, value []byte, maxLen int64) (string, error)
 	StreamRange(ctx context.Context, key string, start, stop string) ([]StreamEntry, error)
 	StreamRead(ctx context.Context, key string, lastID string, block time.Duration, count int64) ([]StreamEntry, error)
+	StreamInfo(ctx context.Context, key string) (StreamInfo, error)
 	SetExpire(ctx context.Context, key string, expiration time.Duration) error
 	Delete(ctx context.Context, key string) error
 }
@@ -207,6 +220,24 @@ func (s *kvStore) StreamAdd(ctx context.Context, key string, value []byte) (stri
 	return id, nil
 }
 
+// StreamAddWithMaxLen adds a value to a Redis stream and returns the message ID.
+// The stream is approximately trimmed to maxLen entries, dropping the oldest ones.
+func (s *kvStore) StreamAddWithMaxLen(ctx context.Context, key string, value []byte, maxLen int64) (string, error) {
+	id, err := s.client.XAdd(ctx, &redis.XAddArgs{
+		Stream: key,
+		MaxLen: maxLen,
+		Approx: true,
+		ID:     "*", // Auto-generate ID
+		Values: map[string]interface{}{
+			"log": value,
+		},
+	}).Result()
+	if err != nil {
+		return "", fmt.Errorf("failed to add to stream: %w", err)
+	}
+	return id, nil
+}
+
 // StreamRange returns a range of entries from a Redis stream
 // start and stop can be "-" (beginning), "+" (end), or specific message IDs
 func (s *kvStore) StreamRange(ctx context.Context, key string, start, stop string) ([]StreamEntry, error) {
@@ -292,6 +323,23 @@ func (s *kvStore) StreamRead(ctx context.Context, key string, lastID string, blo
 	return result, nil
 }
 
+// StreamInfo returns information about the entries retained in a Redis stream.
+// A stream that does not exist is reported as empty.
+func (s *kvStore) StreamInfo(ctx context.Context, key string) (StreamInfo, error) {
+	info, err := s.client.XInfoStream(ctx, key).Result()
+	if err != nil {
+		if err == redis.Nil || strings.Contains(err.Error(), "no such key") {
+			return StreamInfo{}, nil
+		}
+		return StreamInfo{}, fmt.Errorf("failed to get stream info: %w", err)
+	}
+	return StreamInfo{
+		Length:       info.Length,
+		EntriesAdded: info.EntriesAdded,
+		FirstID:      info.FirstEntry.ID,
+	}, nil
+}
+
 // SetExpire sets an expiration time on a key
 func (s *kvStore) SetExpire(ctx context.Context, key string, expiration time.Duration) error {
 	if err := s.client.Expire(ctx, key, expiration).Err(); err != nil {
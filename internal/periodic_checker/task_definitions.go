@@ -69,6 +69,11 @@ func MergeTasksWithConfig(cfg *config.Config) map[PeriodicTaskType]PeriodicTaskM
 		meta.Interval = time.Duration(tasks.ResourceSync.Schedule.Interval)
 		merged[PeriodicTaskTypeResourceSync] = meta
 	}
+	if tasks.RepositoryTester.Schedule.Interval > 0 {
+		meta := merged[PeriodicTaskTypeRepositoryTester]
+		meta.Interval = time.Duration(tasks.RepositoryTester.Schedule.Interval)
+		merged[PeriodicTaskTypeRepositoryTester] = meta
+	}
 
 	return merged
 }
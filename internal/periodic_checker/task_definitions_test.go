@@ -11,26 +11,31 @@ import (
 
 func TestMergeTasksWithConfig(t *testing.T) {
 	defaultResourceSyncInterval := periodicTasks[PeriodicTaskTypeResourceSync].Interval
+	defaultRepositoryTesterInterval := periodicTasks[PeriodicTaskTypeRepositoryTester].Interval
 
 	tests := []struct {
-		name                         string
-		configJSON                   string
-		expectedResourceSyncInterval time.Duration
+		name                             string
+		configJSON                       string
+		expectedResourceSyncInterval     time.Duration
+		expectedRepositoryTesterInterval time.Duration
 	}{
 		{
-			name:                         "nil config returns defaults",
-			configJSON:                   "",
-			expectedResourceSyncInterval: defaultResourceSyncInterval,
+			name:                             "nil config returns defaults",
+			configJSON:                       "",
+			expectedResourceSyncInterval:     defaultResourceSyncInterval,
+			expectedRepositoryTesterInterval: defaultRepositoryTesterInterval,
 		},
 		{
-			name:                         "empty config returns defaults",
-			configJSON:                   `{}`,
-			expectedResourceSyncInterval: defaultResourceSyncInterval,
+			name:                             "empty config returns defaults",
+			configJSON:                       `{}`,
+			expectedResourceSyncInterval:     defaultResourceSyncInterval,
+			expectedRepositoryTesterInterval: defaultRepositoryTesterInterval,
 		},
 		{
-			name:                         "nil periodic config returns defaults",
-			configJSON:                   `{"periodic": null}`,
-			expectedResourceSyncInterval: defaultResourceSyncInterval,
+			name:                             "nil periodic config returns defaults",
+			configJSON:                       `{"periodic": null}`,
+			expectedResourceSyncInterval:     defaultResourceSyncInterval,
+			expectedRepositoryTesterInterval: defaultRepositoryTesterInterval,
 		},
 		{
 			name: "zero interval returns defaults",
@@ -45,7 +50,8 @@ func TestMergeTasksWithConfig(t *testing.T) {
 					}
 				}
 			}`,
-			expectedResourceSyncInterval: defaultResourceSyncInterval,
+			expectedResourceSyncInterval:     defaultResourceSyncInterval,
+			expectedRepositoryTesterInterval: defaultRepositoryTesterInterval,
 		},
 		{
 			name: "custom interval overrides default",
@@ -60,7 +66,29 @@ func TestMergeTasksWithConfig(t *testing.T) {
 					}
 				}
 			}`,
-			expectedResourceSyncInterval: 7 * time.Minute,
+			expectedResourceSyncInterval:     7 * time.Minute,
+			expectedRepositoryTesterInterval: defaultRepositoryTesterInterval,
+		},
+		{
+			name: "polling can be reduced to a fallback for webhooks",
+			configJSON: `{
+				"periodic": {
+					"tasks": {
+						"resourceSync": {
+							"schedule": {
+								"interval": "30m"
+							}
+						},
+						"repositoryTester": {
+							"schedule": {
+								"interval": "1h"
+							}
+						}
+					}
+				}
+			}`,
+			expectedResourceSyncInterval:     30 * time.Minute,
+			expectedRepositoryTesterInterval: time.Hour,
 		},
 	}
 
@@ -78,11 +106,12 @@ func TestMergeTasksWithConfig(t *testing.T) {
 			require.NotNil(t, result)
 			require.Contains(t, result, PeriodicTaskTypeResourceSync)
 			require.Equal(t, tt.expectedResourceSyncInterval, result[PeriodicTaskTypeResourceSync].Interval)
+			require.Equal(t, tt.expectedRepositoryTesterInterval, result[PeriodicTaskTypeRepositoryTester].Interval)
 
 			// Double check that other tasks are preserved with defaults
 			for taskType, defaultMeta := range periodicTasks {
 				require.Contains(t, result, taskType)
-				if taskType != PeriodicTaskTypeResourceSync {
+				if taskType != PeriodicTaskTypeResourceSync && taskType != PeriodicTaskTypeRepositoryTester {
 					require.Equal(t, defaultMeta.Interval, result[taskType].Interval)
 				}
 			}
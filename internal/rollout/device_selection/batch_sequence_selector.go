@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/rollout"
 	"github.com/flightctl/flightctl/internal/service"
 	"github.com/flightctl/flightctl/internal/store/selector"
 	"github.com/flightctl/flightctl/internal/util"
@@ -91,9 +92,15 @@ func (q *querySelectorParts) withoutDisconnected() *querySelectorParts {
 	return q
 }
 
-func newBatchSequenceSelector(sequence domain.BatchSequence, updateTimeout time.Duration, serviceHandler service.Service, orgId uuid.UUID, fleet *domain.Fleet, templateVersionName string, log logrus.FieldLogger) RolloutDeviceSelector {
+// newBatchSequenceSelector creates a selector rolling out the batch sequence.  The definition is the device selection
+// the sequence was derived from, and the bake time is the time to wait after a batch completed before approving the next one.
+// A batch limited by a percentage selects at least minBatchSize devices that have not been rolled out yet.
+func newBatchSequenceSelector(sequence domain.BatchSequence, definition any, bakeTime time.Duration, minBatchSize int, updateTimeout time.Duration, serviceHandler service.Service, orgId uuid.UUID, fleet *domain.Fleet, templateVersionName string, log logrus.FieldLogger) RolloutDeviceSelector {
 	return &batchSequenceSelector{
 		BatchSequence:       sequence,
+		definition:          definition,
+		bakeTime:            bakeTime,
+		minBatchSize:        minBatchSize,
 		serviceHandler:      serviceHandler,
 		orgId:               orgId,
 		fleetName:           lo.FromPtr(fleet.Metadata.Name),
@@ -106,6 +113,9 @@ func newBatchSequenceSelector(sequence domain.BatchSequence, updateTimeout time.
 
 type batchSequenceSelector struct {
 	domain.BatchSequence
+	definition          any
+	bakeTime            time.Duration
+	minBatchSize        int
 	serviceHandler      service.Service
 	orgId               uuid.UUID
 	fleet               *domain.Fleet
@@ -131,7 +141,7 @@ func (b *batchSequenceSelector) getPreviousBatchSequenceDigest() (string, bool)
 }
 
 func (b *batchSequenceSelector) batchSequenceDigest() (string, error) {
-	marshalled, err := json.Marshal(&b.BatchSequence)
+	marshalled, err := json.Marshal(b.definition)
 	if err != nil {
 		return "", err
 	}
@@ -160,7 +170,58 @@ func (b *batchSequenceSelector) OnNewRollout(ctx context.Context) error {
 		domain.FleetAnnotationDeployingTemplateVersion:    b.templateVersionName,
 		domain.FleetAnnotationDeviceSelectionConfigDigest: batchSequenceDigest,
 	}
-	return service.ApiStatusToErr(b.serviceHandler.UpdateFleetAnnotations(ctx, b.orgId, b.fleetName, annotations, nil))
+	var deleteKeys []string
+	if b.IsRolloutNew() {
+		previousTemplateVersion, err := b.previousTemplateVersion(ctx)
+		if err != nil {
+			return err
+		}
+		if previousTemplateVersion != "" {
+			annotations[domain.FleetAnnotationPreviousTemplateVersion] = previousTemplateVersion
+		} else {
+			deleteKeys = append(deleteKeys, domain.FleetAnnotationPreviousTemplateVersion)
+		}
+	}
+	return service.ApiStatusToErr(b.serviceHandler.UpdateFleetAnnotations(ctx, b.orgId, b.fleetName, annotations, deleteKeys))
+}
+
+// previousTemplateVersion returns the template version that was deployed before the new rollout.  This is the template
+// version of the previous rollout, or the one its devices were reverted to if it was rolled back.  If the fleet had no
+// rollout before, it is the newest template version created before the current one.
+func (b *batchSequenceSelector) previousTemplateVersion(ctx context.Context) (string, error) {
+	if deploying, exists := b.fleet.GetAnnotation(domain.FleetAnnotationDeployingTemplateVersion); exists {
+		if restored, rolledBack := rollout.RestoredTemplateVersion(b.fleet, deploying); rolledBack {
+			return restored, nil
+		}
+		return deploying, nil
+	}
+
+	current, status := b.serviceHandler.GetTemplateVersion(ctx, b.orgId, b.fleetName, b.templateVersionName)
+	if status.Code != http.StatusOK {
+		return "", service.ApiStatusToErr(status)
+	}
+	var (
+		previous     string
+		previousTime time.Time
+	)
+	params := domain.ListTemplateVersionsParams{}
+	for {
+		templateVersions, status := b.serviceHandler.ListTemplateVersions(ctx, b.orgId, b.fleetName, params)
+		if status.Code != http.StatusOK {
+			return "", service.ApiStatusToErr(status)
+		}
+		for _, tv := range templateVersions.Items {
+			created := lo.FromPtr(tv.Metadata.CreationTimestamp)
+			if created.Before(lo.FromPtr(current.Metadata.CreationTimestamp)) && created.After(previousTime) {
+				previous = lo.FromPtr(tv.Metadata.Name)
+				previousTime = created
+			}
+		}
+		if templateVersions.Metadata.Continue == nil {
+			return previous, nil
+		}
+		params.Continue = templateVersions.Metadata.Continue
+	}
 }
 
 func (b *batchSequenceSelector) getCurrentBatch(ctx context.Context) (int, error) {
@@ -229,6 +290,13 @@ func (b *batchSequenceSelector) Advance(ctx context.Context) error {
 		return err
 	}
 
+	// Save the completion time of the current batch, from which the bake time is measured
+	if b.bakeTime > 0 {
+		if err = b.setLastBatchCompletionTime(ctx, time.Now()); err != nil {
+			return fmt.Errorf("failed to set last batch completion time: %w", err)
+		}
+	}
+
 	// Save the new batch number in the database
 	if err = b.setCurrentBatch(ctx, nextBatch); err != nil {
 		return fmt.Errorf("failed to set current batch: %w", err)
@@ -248,6 +316,13 @@ func (b *batchSequenceSelector) Advance(ctx context.Context) error {
 	return nil
 }
 
+func (b *batchSequenceSelector) setLastBatchCompletionTime(ctx context.Context, completionTime time.Time) error {
+	annotations := map[string]string{
+		domain.FleetAnnotationLastBatchCompletionTime: completionTime.UTC().Format(time.RFC3339),
+	}
+	return service.ApiStatusToErr(b.serviceHandler.UpdateFleetAnnotations(ctx, b.orgId, b.fleetName, annotations, nil))
+}
+
 func (b *batchSequenceSelector) clearApproval(ctx context.Context) error {
 	return service.ApiStatusToErr(b.serviceHandler.UpdateFleetAnnotations(ctx, b.orgId, b.fleetName, make(map[string]string), []string{domain.FleetAnnotationRolloutApproved}))
 }
@@ -260,7 +335,7 @@ func (b *batchSequenceSelector) resetApprovalAndCompletionReport(ctx context.Con
 		annotations[domain.FleetAnnotationRolloutApprovalMethod] = "automatic"
 	}
 	return service.ApiStatusToErr(b.serviceHandler.UpdateFleetAnnotations(ctx, b.orgId, b.fleetName, annotations, []string{
-		domain.FleetAnnotationRolloutApproved, domain.FleetAnnotationLastBatchCompletionReport}))
+		domain.FleetAnnotationRolloutApproved, domain.FleetAnnotationLastBatchCompletionReport, domain.FleetAnnotationLastBatchCompletionTime}))
 }
 
 func (b *batchSequenceSelector) batchName(currentBatch int) string {
@@ -300,6 +375,8 @@ func (b *batchSequenceSelector) currentSelection(ctx context.Context, currentBat
 		templateVersionName: b.templateVersionName,
 		fleet:               fleet,
 		updateTimeout:       b.updateTimeout,
+		bakeTime:            b.bakeTime,
+		minBatchSize:        b.minBatchSize,
 		log:                 b.log,
 		conditionEmitter:    newConditionEmitter(b.orgId, b.fleetName, batchName, b.serviceHandler),
 	}, nil
@@ -338,6 +415,8 @@ type batchSelection struct {
 	templateVersionName string
 	fleet               *domain.Fleet
 	updateTimeout       time.Duration
+	bakeTime            time.Duration
+	minBatchSize        int
 	conditionEmitter    *conditionEmitter
 	log                 logrus.FieldLogger
 }
@@ -432,8 +511,94 @@ func (b *batchSelection) MayApproveAutomatically() (bool, error) {
 	if !exists {
 		return true, nil
 	}
+	if lastSuccessPercentage < successThreshold {
+		return false, nil
+	}
+	bakeEnd, exists, err := b.bakeEnd()
+	if err != nil || !exists {
+		return false, err
+	}
+	return !time.Now().Before(bakeEnd), nil
+}
 
-	return lastSuccessPercentage >= successThreshold, nil
+// bakeEnd returns the time until which the previous batch bakes before the current batch may be approved automatically
+func (b *batchSelection) bakeEnd() (time.Time, bool, error) {
+	if b.bakeTime <= 0 || b.batchNum <= 0 {
+		return time.Time{}, true, nil
+	}
+	completionTimeStr, exists := b.fleet.GetAnnotation(domain.FleetAnnotationLastBatchCompletionTime)
+	if !exists {
+		return time.Time{}, true, nil
+	}
+	completionTime, err := time.Parse(time.RFC3339, completionTimeStr)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("failed to parse last batch completion time: %w", err)
+	}
+	return completionTime.Add(b.bakeTime), true, nil
+}
+
+// A batch has failed if the success percentage of the last completed batch is below the success threshold, regardless
+// of how batches are approved
+func (b *batchSelection) IsFailed() (bool, error) {
+	if b.batchNum == -1 {
+		return false, nil
+	}
+	successThreshold, err := b.getSuccessThreshold()
+	if err != nil {
+		return false, err
+	}
+	lastSuccessPercentage, exists, err := b.getLastSuccessPercentage()
+	if err != nil || !exists {
+		return false, err
+	}
+	return lastSuccessPercentage < successThreshold, nil
+}
+
+// Rollback reverts the devices that were updated in the rollout to the template version deployed before it.  It returns
+// false if there is no template version to roll back to.
+func (b *batchSelection) Rollback(ctx context.Context) (bool, error) {
+	b.log.Infof("%v/%s:In Rollback", b.orgId, b.fleetName)
+	previousTemplateVersion, exists := b.fleet.GetAnnotation(domain.FleetAnnotationPreviousTemplateVersion)
+	if !exists || previousTemplateVersion == b.templateVersionName {
+		return false, nil
+	}
+	if _, status := b.serviceHandler.GetTemplateVersion(ctx, b.orgId, b.fleetName, previousTemplateVersion); status.Code != http.StatusOK {
+		if status.Code == http.StatusNotFound {
+			return false, nil
+		}
+		return false, service.ApiStatusToErr(status)
+	}
+	report, exists, err := b.getLastCompletionReport()
+	if err != nil {
+		return false, fmt.Errorf("failed to get last completion report: %w", err)
+	}
+	if !exists {
+		return false, fmt.Errorf("last completion report doesn't exist")
+	}
+	successThreshold, err := b.getSuccessThreshold()
+	if err != nil {
+		return false, fmt.Errorf("failed to get succuess threshold: %w", err)
+	}
+	message := failureMessage(successThreshold, report)
+
+	var rolloutStatus domain.FleetRolloutStatus
+	if b.fleet.Status != nil && b.fleet.Status.Rollout != nil {
+		rolloutStatus = *b.fleet.Status.Rollout
+	}
+	rolloutStatus.Rollback = &domain.FleetRolloutRollback{
+		TemplateVersion:         b.templateVersionName,
+		RestoredTemplateVersion: previousTemplateVersion,
+		Message:                 &message,
+	}
+	if err = service.ApiStatusToErr(b.serviceHandler.UpdateFleetRolloutStatus(ctx, b.orgId, b.fleetName, &rolloutStatus)); err != nil {
+		return false, fmt.Errorf("failed to update rollout status: %w", err)
+	}
+	if err = b.unmark(ctx); err != nil {
+		return false, err
+	}
+
+	// Setting the condition emits the rollback event, which sends the devices to be reverted
+	return true, b.conditionEmitter.rolledBack(ctx, previousTemplateVersion, message)
 }
 
 func (b *batchSelection) Approve(ctx context.Context) error {
@@ -451,7 +616,7 @@ func (b *batchSelection) isUpdateCompletedSuccessfully(c domain.DeviceCompletion
 }
 
 func (b *batchSelection) isFailed(c domain.DeviceCompletionCount) bool {
-	return c.SameTemplateVersion && c.UpdatingReason == domain.UpdateStateError
+	return c.SameTemplateVersion && domain.IsUpdateFailed(string(c.UpdatingReason))
 }
 
 func (b *batchSelection) isTimedOut(c domain.DeviceCompletionCount) bool {
@@ -472,7 +637,7 @@ func (b *batchSelection) IsComplete(ctx context.Context) (bool, error) {
 
 	// A device is counted as completed if it has completed successfully or, it is in error state or its update is timed out
 	complete := lo.Sum(lo.Map(counts, func(c domain.DeviceCompletionCount, _ int) int64 {
-		return lo.Ternary(b.isUpdateCompletedSuccessfully(c) || c.SameTemplateVersion && (c.UpdatingReason == domain.UpdateStateError || c.UpdateTimedOut), c.Count, 0)
+		return lo.Ternary(b.isUpdateCompletedSuccessfully(c) || c.SameTemplateVersion && (domain.IsUpdateFailed(string(c.UpdatingReason)) || c.UpdateTimedOut), c.Count, 0)
 	}))
 	return total == complete, nil
 }
@@ -518,7 +683,15 @@ func (b *batchSelection) SetCompletionReport(ctx context.Context) error {
 	annotations := map[string]string{
 		domain.FleetAnnotationLastBatchCompletionReport: outStr,
 	}
-	return service.ApiStatusToErr(b.serviceHandler.UpdateFleetAnnotations(ctx, b.orgId, b.fleetName, annotations, nil))
+	if err = service.ApiStatusToErr(b.serviceHandler.UpdateFleetAnnotations(ctx, b.orgId, b.fleetName, annotations, nil)); err != nil {
+		return err
+	}
+	// the report of the last batch is checked against its success threshold before the rollout finishes
+	if b.fleet.Metadata.Annotations == nil {
+		b.fleet.Metadata.Annotations = &map[string]string{}
+	}
+	(*b.fleet.Metadata.Annotations)[domain.FleetAnnotationLastBatchCompletionReport] = outStr
+	return nil
 }
 
 func (b *batchSelection) batchCounts(ctx context.Context) (int, int, error) {
@@ -564,6 +737,10 @@ func (b *batchSelection) calculateLimit(ctx context.Context) (*int, error) {
 		return nil, err
 	}
 	res := int(math.Round(float64(total)*float64(percentage)/100.0)) - rolledOut
+	if res < b.minBatchSize {
+		// Small fleets would otherwise get empty batches that bake without updating any device
+		res = min(b.minBatchSize, total-rolledOut)
+	}
 	return &res, nil
 }
 
@@ -626,12 +803,23 @@ func (b *batchSelection) OnSuspended(ctx context.Context) error {
 		if err != nil {
 			return fmt.Errorf("failed to get succuess threshold: %w", err)
 		}
+		if int(report.SuccessPercentage) >= successThreshold {
+			bakeEnd, _, err := b.bakeEnd()
+			if err != nil {
+				return err
+			}
+			return b.conditionEmitter.baking(ctx, bakeEnd)
+		}
 		return b.conditionEmitter.suspended(ctx, successThreshold, report)
 	} else {
 		return b.conditionEmitter.waiting(ctx)
 	}
 }
 
+func (b *batchSelection) OnWaitingForWindow(ctx context.Context, nextWindow time.Time) error {
+	return b.conditionEmitter.waitingForWindow(ctx, nextWindow)
+}
+
 func (b *batchSelection) OnFinish(ctx context.Context) error {
 	return b.conditionEmitter.inactive(ctx)
 }
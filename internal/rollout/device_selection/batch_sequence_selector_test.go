@@ -0,0 +1,245 @@
+package device_selection
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/rollout"
+	"github.com/flightctl/flightctl/internal/service"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func newBakingBatchSelection(t *testing.T, batchNum int, bakeTime time.Duration, successPercentage int64, completionTime *time.Time) *batchSelection {
+	t.Helper()
+	report, err := json.Marshal(domain.RolloutBatchCompletionReport{BatchName: "batch 1", SuccessPercentage: successPercentage})
+	require.NoError(t, err)
+	annotations := map[string]string{
+		domain.FleetAnnotationRolloutApprovalMethod:     "automatic",
+		domain.FleetAnnotationLastBatchCompletionReport: string(report),
+	}
+	if completionTime != nil {
+		annotations[domain.FleetAnnotationLastBatchCompletionTime] = completionTime.UTC().Format(time.RFC3339)
+	}
+	return &batchSelection{
+		batchNum: batchNum,
+		bakeTime: bakeTime,
+		fleet: &domain.Fleet{
+			Metadata: domain.ObjectMeta{Annotations: &annotations},
+			Spec:     domain.FleetSpec{RolloutPolicy: &domain.RolloutPolicy{}},
+		},
+	}
+}
+
+func TestMayApproveAutomaticallyBakeTime(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name              string
+		batchNum          int
+		bakeTime          time.Duration
+		successPercentage int64
+		completionTime    *time.Time
+		expectedApproval  bool
+		expectedBakeEnd   time.Time
+	}{
+		{
+			name:              "within the bake time",
+			batchNum:          1,
+			bakeTime:          time.Hour,
+			successPercentage: 100,
+			completionTime:    lo.ToPtr(now.Add(-10 * time.Minute)),
+			expectedApproval:  false,
+			expectedBakeEnd:   now.Add(50 * time.Minute),
+		},
+		{
+			name:              "after the bake time",
+			batchNum:          1,
+			bakeTime:          time.Hour,
+			successPercentage: 100,
+			completionTime:    lo.ToPtr(now.Add(-2 * time.Hour)),
+			expectedApproval:  true,
+			expectedBakeEnd:   now.Add(-time.Hour),
+		},
+		{
+			name:              "without bake time",
+			batchNum:          1,
+			successPercentage: 100,
+			completionTime:    lo.ToPtr(now),
+			expectedApproval:  true,
+		},
+		{
+			name:              "first batch does not bake",
+			batchNum:          0,
+			bakeTime:          time.Hour,
+			successPercentage: 100,
+			completionTime:    lo.ToPtr(now),
+			expectedApproval:  true,
+		},
+		{
+			name:              "unknown completion time",
+			batchNum:          1,
+			bakeTime:          time.Hour,
+			successPercentage: 100,
+			expectedApproval:  true,
+		},
+		{
+			name:              "below the success threshold after the bake time",
+			batchNum:          1,
+			bakeTime:          time.Hour,
+			successPercentage: 50,
+			completionTime:    lo.ToPtr(now.Add(-2 * time.Hour)),
+			expectedApproval:  false,
+			expectedBakeEnd:   now.Add(-time.Hour),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newBakingBatchSelection(t, tt.batchNum, tt.bakeTime, tt.successPercentage, tt.completionTime)
+
+			bakeEnd, exists, err := b.bakeEnd()
+			require.NoError(t, err)
+			require.True(t, exists)
+			require.WithinDuration(t, tt.expectedBakeEnd, bakeEnd, time.Second)
+
+			approved, err := b.MayApproveAutomatically()
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedApproval, approved)
+		})
+	}
+}
+
+func TestBakeEndInvalidCompletionTime(t *testing.T) {
+	b := newBakingBatchSelection(t, 1, time.Hour, 100, nil)
+	(*b.fleet.Metadata.Annotations)[domain.FleetAnnotationLastBatchCompletionTime] = "yesterday"
+
+	_, _, err := b.bakeEnd()
+	require.Error(t, err)
+	_, err = b.MayApproveAutomatically()
+	require.Error(t, err)
+}
+
+func TestCanaryBatchLimitSmallFleet(t *testing.T) {
+	sequence, _, err := rollout.CanaryBatchSequence(domain.Canary{})
+	require.NoError(t, err)
+	batches := lo.FromPtr(sequence.Sequence)
+
+	// each step of a canary rollout of a 10-device fleet updates at least one more device
+	tests := []struct {
+		name          string
+		batchNum      int
+		rolledOut     int64
+		expectedLimit int
+	}{
+		{name: "1% of 10 devices", batchNum: 0, rolledOut: 0, expectedLimit: 1},
+		{name: "5% of 10 devices", batchNum: 1, rolledOut: 1, expectedLimit: 1},
+		{name: "25% of 10 devices", batchNum: 2, rolledOut: 2, expectedLimit: 1},
+		{name: "all devices rolled out", batchNum: 2, rolledOut: 10, expectedLimit: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockService := service.NewMockService(ctrl)
+			total := mockService.EXPECT().CountDevices(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(int64(10), domain.StatusOK()).Times(1)
+			mockService.EXPECT().CountDevices(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(tt.rolledOut, domain.StatusOK()).Times(1).After(total)
+
+			b := &batchSelection{
+				batch:               &batches[tt.batchNum],
+				batchNum:            tt.batchNum,
+				serviceHandler:      mockService,
+				orgId:               uuid.New(),
+				fleetName:           "fleet",
+				templateVersionName: "tv",
+				minBatchSize:        1,
+			}
+			limit, err := b.calculateLimit(context.Background())
+			require.NoError(t, err)
+			require.NotNil(t, limit)
+			require.Equal(t, tt.expectedLimit, *limit)
+		})
+	}
+}
+
+func TestIsFailedManualApproval(t *testing.T) {
+	b := newBakingBatchSelection(t, 1, 0, 50, nil)
+	(*b.fleet.Metadata.Annotations)[domain.FleetAnnotationRolloutApprovalMethod] = "manual"
+
+	failed, err := b.IsFailed()
+	require.NoError(t, err)
+	require.True(t, failed)
+}
+
+func TestSetCompletionReportOfLastBatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockService := service.NewMockService(ctrl)
+	b := newBakingBatchSelection(t, 2, 0, 100, nil)
+	b.serviceHandler = mockService
+	b.orgId = uuid.New()
+	b.fleetName = "fleet"
+	b.templateVersionName = "tv"
+	b.log = logrus.New()
+
+	// half of the devices of the last batch failed to update or failed their health checks
+	mockService.EXPECT().GetDeviceCompletionCounts(gomock.Any(), b.orgId, gomock.Any(), "tv", gomock.Any()).Return([]domain.DeviceCompletionCount{
+		{Count: 5, SameTemplateVersion: true, SameRenderedVersion: true},
+		{Count: 2, SameTemplateVersion: true, UpdatingReason: domain.UpdateStateError},
+		{Count: 3, SameTemplateVersion: true, UpdatingReason: domain.UpdateStateHealthCheckFailed},
+	}, domain.StatusOK())
+	mockService.EXPECT().UpdateFleetAnnotations(gomock.Any(), b.orgId, "fleet", gomock.Any(), nil).Return(domain.StatusOK())
+
+	require.NoError(t, b.SetCompletionReport(context.Background()))
+
+	// the rollout is checked against the report of the last batch before it finishes
+	failed, err := b.IsFailed()
+	require.NoError(t, err)
+	require.True(t, failed)
+}
+
+// fakeSelection is a selection whose last completed batch failed or succeeded
+type fakeSelection struct {
+	Selection
+	failed     bool
+	rolledBack bool
+}
+
+func (s *fakeSelection) IsFailed() (bool, error) {
+	return s.failed, nil
+}
+
+func (s *fakeSelection) Rollback(ctx context.Context) (bool, error) {
+	s.rolledBack = true
+	return true, nil
+}
+
+func TestRollBackIfFailed(t *testing.T) {
+	tests := []struct {
+		name               string
+		onFailure          *domain.RolloutFailurePolicy
+		failed             bool
+		expectedRolledBack bool
+	}{
+		{name: "failed batch with rollback policy", onFailure: lo.ToPtr(domain.RolloutFailurePolicyRollback), failed: true, expectedRolledBack: true},
+		{name: "successful batch with rollback policy", onFailure: lo.ToPtr(domain.RolloutFailurePolicyRollback)},
+		{name: "failed batch without rollback policy", failed: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &reconciler{log: logrus.New()}
+			fleet := domain.Fleet{
+				Metadata: domain.ObjectMeta{Name: lo.ToPtr("fleet")},
+				Spec:     domain.FleetSpec{RolloutPolicy: &domain.RolloutPolicy{OnFailure: tt.onFailure}},
+			}
+			selection := &fakeSelection{failed: tt.failed}
+
+			rolledBack, err := r.rollBackIfFailed(context.Background(), uuid.New(), fleet, selection)
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedRolledBack, rolledBack)
+			require.Equal(t, tt.expectedRolledBack, selection.rolledBack)
+		})
+	}
+}
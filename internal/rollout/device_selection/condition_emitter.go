@@ -3,6 +3,7 @@ package device_selection
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/flightctl/flightctl/internal/domain"
 	"github.com/flightctl/flightctl/internal/service"
@@ -54,12 +55,24 @@ func (c *conditionEmitter) active(ctx context.Context) error {
 	))
 }
 
+func failureMessage(threshold int, completionReport domain.RolloutBatchCompletionReport) string {
+	return fmt.Sprintf("%s failed: %d%% of batch devices were updated successfully, while success threshold was set to %d%%; Breakdown: total=%d successful=%d failed=%d timed out=%d",
+		completionReport.BatchName, completionReport.SuccessPercentage, threshold, completionReport.Total, completionReport.Successful, completionReport.Failed, completionReport.TimedOut)
+}
+
 func (c *conditionEmitter) suspended(ctx context.Context, threshold int, completionReport domain.RolloutBatchCompletionReport) error {
 	return c.save(ctx, c.create(
 		domain.ConditionStatusFalse,
 		domain.RolloutSuspendedReason,
-		fmt.Sprintf("%s failed: %d%% of batch devices were updated successfully, while success threshold was set to %d%%; Breakdown: total=%d successful=%d failed=%d timed out=%d",
-			completionReport.BatchName, completionReport.SuccessPercentage, threshold, completionReport.Total, completionReport.Successful, completionReport.Failed, completionReport.TimedOut),
+		failureMessage(threshold, completionReport),
+	))
+}
+
+func (c *conditionEmitter) rolledBack(ctx context.Context, restoredTemplateVersion, failure string) error {
+	return c.save(ctx, c.create(
+		domain.ConditionStatusFalse,
+		domain.RolloutRolledBackReason,
+		fmt.Sprintf("Rolled back to template version %s: %s", restoredTemplateVersion, failure),
 	))
 }
 
@@ -70,3 +83,23 @@ func (c *conditionEmitter) waiting(ctx context.Context) error {
 		fmt.Sprintf("Waiting for %s to be approved", c.batchName),
 	))
 }
+
+func (c *conditionEmitter) baking(ctx context.Context, bakeEnd time.Time) error {
+	return c.save(ctx, c.create(
+		domain.ConditionStatusFalse,
+		domain.RolloutWaitingReason,
+		fmt.Sprintf("Waiting for the bake time to end at %s before rolling out %s", bakeEnd.UTC().Format(time.RFC3339), c.batchName),
+	))
+}
+
+func (c *conditionEmitter) waitingForWindow(ctx context.Context, nextWindow time.Time) error {
+	message := fmt.Sprintf("Waiting for a rollout window to roll out %s; there is no upcoming rollout window", c.batchName)
+	if !nextWindow.IsZero() {
+		message = fmt.Sprintf("Waiting for a rollout window to roll out %s; the next window starts at %s", c.batchName, nextWindow.UTC().Format(time.RFC3339))
+	}
+	return c.save(ctx, c.create(
+		domain.ConditionStatusFalse,
+		domain.RolloutWaitingReason,
+		message,
+	))
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/rollout"
 	"github.com/flightctl/flightctl/internal/service"
 	"github.com/google/uuid"
 	"github.com/samber/lo"
@@ -30,10 +31,13 @@ type Selection interface {
 	IsApproved() bool
 	IsRolledOut(ctx context.Context) (bool, error)
 	MayApproveAutomatically() (bool, error)
+	IsFailed() (bool, error)
+	Rollback(ctx context.Context) (bool, error)
 	IsComplete(ctx context.Context) (bool, error)
 	SetCompletionReport(ctx context.Context) error
 	OnRollout(ctx context.Context) error
 	OnSuspended(ctx context.Context) error
+	OnWaitingForWindow(ctx context.Context, nextWindow time.Time) error
 	OnFinish(ctx context.Context) error
 }
 
@@ -64,7 +68,20 @@ func NewRolloutDeviceSelector(deviceSelection *domain.RolloutDeviceSelection, de
 	}
 	switch v := selectorInterface.(type) {
 	case domain.BatchSequence:
-		return newBatchSequenceSelector(v, updateTimeout, serviceHandler, orgId, fleet, templateVersionName, log), nil
+		return newBatchSequenceSelector(v, &v, 0, 0, updateTimeout, serviceHandler, orgId, fleet, templateVersionName, log), nil
+	case domain.Canary:
+		sequence, bakeTime, err := rollout.CanaryBatchSequence(v)
+		if err != nil {
+			return nil, err
+		}
+		// every canary step updates at least one more device
+		return newBatchSequenceSelector(sequence, &v, bakeTime, 1, updateTimeout, serviceHandler, orgId, fleet, templateVersionName, log), nil
+	case domain.LabelWaves:
+		sequence, bakeTime, err := rollout.LabelWavesBatchSequence(v)
+		if err != nil {
+			return nil, err
+		}
+		return newBatchSequenceSelector(sequence, &v, bakeTime, 0, updateTimeout, serviceHandler, orgId, fleet, templateVersionName, log), nil
 	default:
 		return nil, fmt.Errorf("unexpected selector %T", selectorInterface)
 	}
@@ -79,6 +96,8 @@ func cleanupRollout(ctx context.Context, orgId uuid.UUID, fleet *domain.Fleet, s
 		domain.FleetAnnotationRolloutApprovalMethod,
 		domain.FleetAnnotationDeployingTemplateVersion,
 		domain.FleetAnnotationDeviceSelectionConfigDigest,
+		domain.FleetAnnotationLastBatchCompletionTime,
+		domain.FleetAnnotationPreviousTemplateVersion,
 	}
 	if lo.NoneBy(annotationsToDelete, func(ann string) bool {
 		return lo.HasKey(lo.CoalesceMapOrEmpty(lo.FromPtr(fleet.Metadata.Annotations)), ann)
@@ -3,8 +3,10 @@ package device_selection
 import (
 	"context"
 	"net/http"
+	"time"
 
 	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/rollout"
 	"github.com/flightctl/flightctl/internal/service"
 	"github.com/flightctl/flightctl/internal/service/common"
 	"github.com/flightctl/flightctl/internal/util"
@@ -84,6 +86,10 @@ func (r *reconciler) reconcileFleet(ctx context.Context, orgId uuid.UUID, fleet
 		r.log.Warnf("No template version for fleet %v/%s", orgId, fleetName)
 		return
 	}
+	if restored, rolledBack := rollout.RestoredTemplateVersion(&fleet, templateVersionName); rolledBack {
+		r.log.Debugf("Rollout of template version %s for fleet %v/%s was rolled back to %s", templateVersionName, orgId, fleetName, restored)
+		return
+	}
 	selector, err := NewRolloutDeviceSelector(fleet.Spec.RolloutPolicy.DeviceSelection, fleet.Spec.RolloutPolicy.DefaultUpdateTimeout, r.serviceHandler, orgId, &fleet, templateVersionName, r.log)
 	if err != nil {
 		r.log.WithError(err).Errorf("%v/%s: NewRolloutDeviceSelector", orgId, fleetName)
@@ -128,6 +134,14 @@ func (r *reconciler) reconcileFleet(ctx context.Context, orgId uuid.UUID, fleet
 					break
 				}
 			} else {
+				rolledBack, err := r.rollBackIfFailed(ctx, orgId, fleet, selection)
+				if err != nil {
+					r.log.WithError(err).Errorf("%v/%s: RollBackIfFailed", orgId, fleetName)
+					break
+				}
+				if rolledBack {
+					break
+				}
 				if err = selection.OnSuspended(ctx); err != nil {
 					r.log.WithError(err).Errorf("%v/%s: OnSuspended", orgId, fleetName)
 				}
@@ -142,6 +156,18 @@ func (r *reconciler) reconcileFleet(ctx context.Context, orgId uuid.UUID, fleet
 			break
 		}
 		if !isRolledOut {
+			// Batches are only dispatched inside the rollout windows of the fleet
+			permitted, nextWindow, err := rollout.IsDispatchPermitted(fleet.Spec.RolloutPolicy, time.Now())
+			if err != nil {
+				r.log.WithError(err).Errorf("%v/%s: IsDispatchPermitted", orgId, fleetName)
+				break
+			}
+			if !permitted {
+				if err = selection.OnWaitingForWindow(ctx, nextWindow); err != nil {
+					r.log.WithError(err).Errorf("%v/%s: OnWaitingForWindow", orgId, fleetName)
+				}
+				break
+			}
 			if err = selection.OnRollout(ctx); err != nil {
 				r.log.WithError(err).Errorf("%v/%s: OnRollout", orgId, fleetName)
 			}
@@ -170,6 +196,15 @@ func (r *reconciler) reconcileFleet(ctx context.Context, orgId uuid.UUID, fleet
 			break
 		}
 		if !hasMoreSelections {
+			// No later batch checks the last one, so it is rolled back here if it failed
+			rolledBack, err := r.rollBackIfFailed(ctx, orgId, fleet, selection)
+			if err != nil {
+				r.log.WithError(err).Errorf("%v/%s: RollBackIfFailed", orgId, fleetName)
+				break
+			}
+			if rolledBack {
+				break
+			}
 			if err = selection.OnFinish(ctx); err != nil {
 				r.log.WithError(err).Errorf("%v/%s: OnFinish", orgId, fleetName)
 			}
@@ -184,6 +219,26 @@ func (r *reconciler) reconcileFleet(ctx context.Context, orgId uuid.UUID, fleet
 	}
 }
 
+// rollBackIfFailed rolls the fleet back to the template version deployed before the rollout if its rollout policy
+// asks for it and the last completed batch is below its success threshold. It returns true if the fleet was rolled back.
+func (r *reconciler) rollBackIfFailed(ctx context.Context, orgId uuid.UUID, fleet domain.Fleet, selection Selection) (bool, error) {
+	if fleet.Spec.RolloutPolicy.OnFailure == nil || *fleet.Spec.RolloutPolicy.OnFailure != domain.RolloutFailurePolicyRollback {
+		return false, nil
+	}
+	failed, err := selection.IsFailed()
+	if err != nil || !failed {
+		return false, err
+	}
+	rolledBack, err := selection.Rollback(ctx)
+	if err != nil {
+		return false, err
+	}
+	if !rolledBack {
+		r.log.Warnf("%v/%s: No previous template version to roll back to", orgId, lo.FromPtr(fleet.Metadata.Name))
+	}
+	return rolledBack, nil
+}
+
 func (r *reconciler) Reconcile(ctx context.Context, orgID uuid.UUID) {
 	fleetList, status := r.serviceHandler.ListFleetRolloutDeviceSelection(ctx, orgID)
 	if status.Code != http.StatusOK {
@@ -0,0 +1,76 @@
+package rollout
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/samber/lo"
+)
+
+const DefaultCanaryBakeTime = time.Hour
+
+// DefaultCanarySteps are the cumulative shares of the fleet that a canary rollout is promoted through
+var DefaultCanarySteps = []domain.Percentage{"1%", "5%", "25%", "100%"}
+
+// parseBakeTime returns the bake time, or the default if it is not set
+func parseBakeTime(bakeTime *string, defaultBakeTime time.Duration) (time.Duration, error) {
+	if bakeTime == nil {
+		return defaultBakeTime, nil
+	}
+	d, err := time.ParseDuration(*bakeTime)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse bake time %s: %w", *bakeTime, err)
+	}
+	return d, nil
+}
+
+// CanaryBatchSequence converts the canary steps to a batch sequence.  Each step is a batch whose limit is the cumulative
+// share of the fleet.  A step of 100% is left to the final implicit batch, which updates all the remaining devices.
+func CanaryBatchSequence(canary domain.Canary) (domain.BatchSequence, time.Duration, error) {
+	bakeTime, err := parseBakeTime(canary.BakeTime, DefaultCanaryBakeTime)
+	if err != nil {
+		return domain.BatchSequence{}, 0, err
+	}
+	steps := lo.FromPtrOr(canary.Steps, DefaultCanarySteps)
+	var sequence []domain.Batch
+	for _, step := range steps {
+		percentage, err := util.PercentageAsInt(step)
+		if err != nil {
+			return domain.BatchSequence{}, 0, err
+		}
+		if percentage >= 100 {
+			break
+		}
+		var limit domain.Batch_Limit
+		if err = limit.FromPercentage(step); err != nil {
+			return domain.BatchSequence{}, 0, err
+		}
+		sequence = append(sequence, domain.Batch{Limit: &limit})
+	}
+	return domain.BatchSequence{
+		Strategy: domain.RolloutStrategyBatchSequence,
+		Sequence: &sequence,
+	}, bakeTime, nil
+}
+
+// LabelWavesBatchSequence converts the label waves to a batch sequence.  Each wave is a batch selecting the devices
+// labeled with its value.  Devices that do not belong to any wave are updated in the final implicit batch.
+func LabelWavesBatchSequence(waves domain.LabelWaves) (domain.BatchSequence, time.Duration, error) {
+	bakeTime, err := parseBakeTime(waves.BakeTime, 0)
+	if err != nil {
+		return domain.BatchSequence{}, 0, err
+	}
+	sequence := lo.Map(waves.Values, func(value string, _ int) domain.Batch {
+		return domain.Batch{
+			Selector: &domain.LabelSelector{
+				MatchLabels: &map[string]string{waves.LabelKey: value},
+			},
+		}
+	})
+	return domain.BatchSequence{
+		Strategy: domain.RolloutStrategyBatchSequence,
+		Sequence: &sequence,
+	}, bakeTime, nil
+}
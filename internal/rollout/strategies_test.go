@@ -0,0 +1,132 @@
+package rollout
+
+import (
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func batchLimits(t *testing.T, sequence domain.BatchSequence) []domain.Percentage {
+	t.Helper()
+	return lo.Map(lo.FromPtr(sequence.Sequence), func(batch domain.Batch, _ int) domain.Percentage {
+		require.NotNil(t, batch.Limit)
+		require.Nil(t, batch.Selector)
+		percentage, err := batch.Limit.AsPercentage()
+		require.NoError(t, err)
+		return percentage
+	})
+}
+
+func TestCanaryBatchSequence(t *testing.T) {
+	tests := []struct {
+		name             string
+		canary           domain.Canary
+		expectedLimits   []domain.Percentage
+		expectedBakeTime time.Duration
+		expectErr        bool
+	}{
+		{
+			name:             "default steps and bake time",
+			canary:           domain.Canary{},
+			expectedLimits:   []domain.Percentage{"1%", "5%", "25%"},
+			expectedBakeTime: DefaultCanaryBakeTime,
+		},
+		{
+			name:             "custom steps without 100%",
+			canary:           domain.Canary{Steps: &[]domain.Percentage{"10%", "50%"}, BakeTime: lo.ToPtr("30m")},
+			expectedLimits:   []domain.Percentage{"10%", "50%"},
+			expectedBakeTime: 30 * time.Minute,
+		},
+		{
+			name:             "steps after 100% are ignored",
+			canary:           domain.Canary{Steps: &[]domain.Percentage{"20%", "100%", "50%"}, BakeTime: lo.ToPtr("0s")},
+			expectedLimits:   []domain.Percentage{"20%"},
+			expectedBakeTime: 0,
+		},
+		{
+			name:             "only 100% leaves the final batch",
+			canary:           domain.Canary{Steps: &[]domain.Percentage{"100%"}},
+			expectedLimits:   []domain.Percentage{},
+			expectedBakeTime: DefaultCanaryBakeTime,
+		},
+		{
+			name:      "invalid step",
+			canary:    domain.Canary{Steps: &[]domain.Percentage{"ten"}},
+			expectErr: true,
+		},
+		{
+			name:      "invalid bake time",
+			canary:    domain.Canary{BakeTime: lo.ToPtr("soon")},
+			expectErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sequence, bakeTime, err := CanaryBatchSequence(tt.canary)
+			if tt.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, domain.RolloutStrategyBatchSequence, sequence.Strategy)
+			require.Equal(t, tt.expectedLimits, batchLimits(t, sequence))
+			require.Equal(t, tt.expectedBakeTime, bakeTime)
+		})
+	}
+}
+
+func TestLabelWavesBatchSequence(t *testing.T) {
+	tests := []struct {
+		name             string
+		waves            domain.LabelWaves
+		expectedValues   []string
+		expectedBakeTime time.Duration
+		expectErr        bool
+	}{
+		{
+			name:             "one batch per wave in order",
+			waves:            domain.LabelWaves{LabelKey: "ring", Values: []string{"canary", "early", "main"}, BakeTime: lo.ToPtr("2h")},
+			expectedValues:   []string{"canary", "early", "main"},
+			expectedBakeTime: 2 * time.Hour,
+		},
+		{
+			name:             "no bake time by default",
+			waves:            domain.LabelWaves{LabelKey: "ring", Values: []string{"canary"}},
+			expectedValues:   []string{"canary"},
+			expectedBakeTime: 0,
+		},
+		{
+			name:             "no waves leaves the final batch",
+			waves:            domain.LabelWaves{LabelKey: "ring", Values: []string{}},
+			expectedValues:   []string{},
+			expectedBakeTime: 0,
+		},
+		{
+			name:      "invalid bake time",
+			waves:     domain.LabelWaves{LabelKey: "ring", Values: []string{"canary"}, BakeTime: lo.ToPtr("1d")},
+			expectErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sequence, bakeTime, err := LabelWavesBatchSequence(tt.waves)
+			if tt.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, domain.RolloutStrategyBatchSequence, sequence.Strategy)
+			values := lo.Map(lo.FromPtr(sequence.Sequence), func(batch domain.Batch, _ int) string {
+				require.Nil(t, batch.Limit)
+				require.NotNil(t, batch.Selector)
+				require.Len(t, lo.FromPtr(batch.Selector.MatchLabels), 1)
+				return lo.FromPtr(batch.Selector.MatchLabels)[tt.waves.LabelKey]
+			})
+			require.Equal(t, tt.expectedValues, values)
+			require.Equal(t, tt.expectedBakeTime, bakeTime)
+		})
+	}
+}
@@ -60,7 +60,32 @@ func ProgressStage(fleet *domain.Fleet) (Stage, error) {
 	switch value := intf.(type) {
 	case domain.BatchSequence:
 		return batchSequenceProgressStage(fleet, value)
+	case domain.Canary:
+		sequence, _, err := CanaryBatchSequence(value)
+		if err != nil {
+			return Inactive, err
+		}
+		return batchSequenceProgressStage(fleet, sequence)
+	case domain.LabelWaves:
+		sequence, _, err := LabelWavesBatchSequence(value)
+		if err != nil {
+			return Inactive, err
+		}
+		return batchSequenceProgressStage(fleet, sequence)
 	default:
 		return Inactive, fmt.Errorf("unexpected type for device selection %T", intf)
 	}
 }
+
+// RestoredTemplateVersion returns the template version that the fleet's devices were reverted to, if the rollout of the
+// given template version was rolled back
+func RestoredTemplateVersion(fleet *domain.Fleet, templateVersionName string) (string, bool) {
+	if fleet.Status == nil || fleet.Status.Rollout == nil || fleet.Status.Rollout.Rollback == nil {
+		return "", false
+	}
+	rollback := fleet.Status.Rollout.Rollback
+	if rollback.TemplateVersion != templateVersionName {
+		return "", false
+	}
+	return rollback.RestoredTemplateVersion, true
+}
@@ -0,0 +1,93 @@
+package rollout
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/robfig/cron/v3"
+	"github.com/samber/lo"
+)
+
+// maxWindowSearchSteps bounds the search for the next permitted time, e.g. when blackouts and windows never overlap
+const maxWindowSearchSteps = 1000
+
+type rolloutWindow struct {
+	schedule cron.Schedule
+	duration time.Duration
+	location *time.Location
+}
+
+// parseRolloutWindow parses the window.  Windows without a time zone are evaluated in UTC, so that they do not depend
+// on the time zone of the host running the service.
+func parseRolloutWindow(w domain.RolloutWindow) (*rolloutWindow, error) {
+	location := time.UTC
+	if w.TimeZone != nil {
+		loc, err := time.LoadLocation(*w.TimeZone)
+		if err != nil {
+			return nil, fmt.Errorf("invalid time zone: %w", err)
+		}
+		location = loc
+	}
+	parser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+	schedule, err := parser.Parse(w.At)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cron expression: %w", err)
+	}
+	duration, err := time.ParseDuration(w.Duration)
+	if err != nil {
+		return nil, fmt.Errorf("invalid window duration: %w", err)
+	}
+	return &rolloutWindow{schedule: schedule, duration: duration, location: location}, nil
+}
+
+// isOpen returns true if the window was started within its duration before t
+func (w *rolloutWindow) isOpen(t time.Time) bool {
+	start := w.schedule.Next(t.In(w.location).Add(-w.duration))
+	return !start.IsZero() && !start.After(t)
+}
+
+// nextStart returns the next time the window starts after t, or the zero time if it never starts again
+func (w *rolloutWindow) nextStart(t time.Time) time.Time {
+	return w.schedule.Next(t.In(w.location))
+}
+
+// IsDispatchPermitted returns whether the rollout policy permits dispatching batches at the given time.  If it does not,
+// it also returns the next time it does, or the zero time if there is none.
+func IsDispatchPermitted(policy *domain.RolloutPolicy, now time.Time) (bool, time.Time, error) {
+	if policy == nil || (len(lo.FromPtr(policy.Windows)) == 0 && len(lo.FromPtr(policy.Blackouts)) == 0) {
+		return true, time.Time{}, nil
+	}
+	windows := make([]*rolloutWindow, 0, len(lo.FromPtr(policy.Windows)))
+	for _, w := range lo.FromPtr(policy.Windows) {
+		window, err := parseRolloutWindow(w)
+		if err != nil {
+			return false, time.Time{}, err
+		}
+		windows = append(windows, window)
+	}
+	blackouts := lo.FromPtr(policy.Blackouts)
+
+	t := now
+	for i := 0; i < maxWindowSearchSteps; i++ {
+		if blackout, found := lo.Find(blackouts, func(b domain.RolloutBlackout) bool {
+			return !t.Before(b.Start) && t.Before(b.End)
+		}); found {
+			t = blackout.End
+			continue
+		}
+		if len(windows) == 0 || lo.SomeBy(windows, func(w *rolloutWindow) bool { return w.isOpen(t) }) {
+			if t.Equal(now) {
+				return true, time.Time{}, nil
+			}
+			return false, t, nil
+		}
+		next := lo.Filter(lo.Map(windows, func(w *rolloutWindow, _ int) time.Time { return w.nextStart(t) }),
+			func(start time.Time, _ int) bool { return !start.IsZero() })
+		if len(next) == 0 {
+			return false, time.Time{}, nil
+		}
+		t = lo.MinBy(next, func(a, b time.Time) bool { return a.Before(b) })
+	}
+	return false, time.Time{}, nil
+}
@@ -0,0 +1,108 @@
+package rollout
+
+import (
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsDispatchPermitted(t *testing.T) {
+	// Nightly window from 22:00 to 02:00 UTC
+	nightly := domain.RolloutWindow{At: "0 22 * * *", Duration: "4h", TimeZone: lo.ToPtr("UTC")}
+	freeze := domain.RolloutBlackout{
+		Name:  lo.ToPtr("holiday freeze"),
+		Start: time.Date(2025, 12, 20, 0, 0, 0, 0, time.UTC),
+		End:   time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+
+	tests := []struct {
+		name              string
+		policy            *domain.RolloutPolicy
+		now               time.Time
+		expectedPermitted bool
+		expectedNext      time.Time
+	}{
+		{
+			name:              "no windows or blackouts",
+			policy:            &domain.RolloutPolicy{},
+			now:               time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC),
+			expectedPermitted: true,
+		},
+		{
+			name:              "inside window",
+			policy:            &domain.RolloutPolicy{Windows: &[]domain.RolloutWindow{nightly}},
+			now:               time.Date(2025, 6, 2, 1, 0, 0, 0, time.UTC),
+			expectedPermitted: true,
+		},
+		{
+			name:         "outside window",
+			policy:       &domain.RolloutPolicy{Windows: &[]domain.RolloutWindow{nightly}},
+			now:          time.Date(2025, 6, 2, 12, 0, 0, 0, time.UTC),
+			expectedNext: time.Date(2025, 6, 2, 22, 0, 0, 0, time.UTC),
+		},
+		{
+			name:         "window closes at its end",
+			policy:       &domain.RolloutPolicy{Windows: &[]domain.RolloutWindow{nightly}},
+			now:          time.Date(2025, 6, 2, 2, 0, 0, 0, time.UTC),
+			expectedNext: time.Date(2025, 6, 2, 22, 0, 0, 0, time.UTC),
+		},
+		{
+			name:         "inside blackout without windows",
+			policy:       &domain.RolloutPolicy{Blackouts: &[]domain.RolloutBlackout{freeze}},
+			now:          time.Date(2025, 12, 24, 12, 0, 0, 0, time.UTC),
+			expectedNext: freeze.End,
+		},
+		{
+			name: "window inside blackout",
+			policy: &domain.RolloutPolicy{
+				Windows: &[]domain.RolloutWindow{nightly},
+				Blackouts: &[]domain.RolloutBlackout{{
+					Start: freeze.Start,
+					End:   time.Date(2026, 1, 2, 12, 0, 0, 0, time.UTC),
+				}},
+			},
+			now:          time.Date(2025, 12, 24, 23, 0, 0, 0, time.UTC),
+			expectedNext: time.Date(2026, 1, 2, 22, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "window open when blackout ends",
+			policy: &domain.RolloutPolicy{
+				Windows:   &[]domain.RolloutWindow{nightly},
+				Blackouts: &[]domain.RolloutBlackout{freeze},
+			},
+			now:          time.Date(2026, 1, 1, 23, 0, 0, 0, time.UTC),
+			expectedNext: freeze.End,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			permitted, next, err := IsDispatchPermitted(tt.policy, tt.now)
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedPermitted, permitted)
+			require.True(t, tt.expectedNext.Equal(next), "expected next window at %s, got %s", tt.expectedNext, next)
+		})
+	}
+}
+
+func TestRolloutWindowDefaultsToUTC(t *testing.T) {
+	original := time.Local
+	defer func() { time.Local = original }()
+	var err error
+	time.Local, err = time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	// Nightly window from 22:00 to 02:00 without a time zone
+	policy := &domain.RolloutPolicy{Windows: &[]domain.RolloutWindow{{At: "0 22 * * *", Duration: "4h"}}}
+
+	permitted, _, err := IsDispatchPermitted(policy, time.Date(2025, 6, 2, 1, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.True(t, permitted)
+
+	permitted, next, err := IsDispatchPermitted(policy, time.Date(2025, 6, 2, 12, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.False(t, permitted)
+	require.True(t, next.Equal(time.Date(2025, 6, 2, 22, 0, 0, 0, time.UTC)))
+}
@@ -31,6 +31,18 @@ const (
 	DiskIsCritical                    = "Disk utilization has reached a critical level."
 	DiskIsWarning                     = "Disk utilization has reached a warning level."
 	DiskIsNormal                      = "Disk utilization has returned to normal."
+	InodeIsCritical                   = "Inode utilization has reached a critical level."
+	InodeIsWarning                    = "Inode utilization has reached a warning level."
+	InodeIsNormal                     = "Inode utilization has returned to normal."
+	TemperatureIsCritical             = "Temperature has reached a critical level."
+	TemperatureIsWarning              = "Temperature has reached a warning level."
+	TemperatureIsNormal               = "Temperature has returned to normal."
+	NetworkIsCritical                 = "Network errors or utilization have reached a critical level."
+	NetworkIsWarning                  = "Network errors or utilization have reached a warning level."
+	NetworkIsNormal                   = "Network errors and utilization have returned to normal."
+	ApplicationUsageIsCritical        = "Application resource utilization has reached a critical level."
+	ApplicationUsageIsWarning         = "Application resource utilization has reached a warning level."
+	ApplicationUsageIsNormal          = "Application resource utilization has returned to normal."
 )
 
 type DeviceSuccessEvent func(ctx context.Context, created bool, resourceKind domain.ResourceKind, resourceName string, updateDetails *domain.ResourceUpdatedDetailsUpdatedFields, log logrus.FieldLogger) *domain.Event
@@ -64,6 +76,30 @@ var (
 		domain.DeviceResourceStatusWarning:  ResourceUpdate{Reason: domain.EventReasonDeviceDiskWarning, Details: DiskIsWarning},
 		domain.DeviceResourceStatusHealthy:  ResourceUpdate{Reason: domain.EventReasonDeviceDiskNormal, Details: DiskIsNormal},
 	}
+
+	inodeStatus = statusType{
+		domain.DeviceResourceStatusCritical: ResourceUpdate{Reason: domain.EventReasonDeviceInodeCritical, Details: InodeIsCritical},
+		domain.DeviceResourceStatusWarning:  ResourceUpdate{Reason: domain.EventReasonDeviceInodeWarning, Details: InodeIsWarning},
+		domain.DeviceResourceStatusHealthy:  ResourceUpdate{Reason: domain.EventReasonDeviceInodeNormal, Details: InodeIsNormal},
+	}
+
+	temperatureStatus = statusType{
+		domain.DeviceResourceStatusCritical: ResourceUpdate{Reason: domain.EventReasonDeviceTemperatureCritical, Details: TemperatureIsCritical},
+		domain.DeviceResourceStatusWarning:  ResourceUpdate{Reason: domain.EventReasonDeviceTemperatureWarning, Details: TemperatureIsWarning},
+		domain.DeviceResourceStatusHealthy:  ResourceUpdate{Reason: domain.EventReasonDeviceTemperatureNormal, Details: TemperatureIsNormal},
+	}
+
+	networkStatus = statusType{
+		domain.DeviceResourceStatusCritical: ResourceUpdate{Reason: domain.EventReasonDeviceNetworkCritical, Details: NetworkIsCritical},
+		domain.DeviceResourceStatusWarning:  ResourceUpdate{Reason: domain.EventReasonDeviceNetworkWarning, Details: NetworkIsWarning},
+		domain.DeviceResourceStatusHealthy:  ResourceUpdate{Reason: domain.EventReasonDeviceNetworkNormal, Details: NetworkIsNormal},
+	}
+
+	applicationUsageStatus = statusType{
+		domain.DeviceResourceStatusCritical: ResourceUpdate{Reason: domain.EventReasonDeviceApplicationUsageCritical, Details: ApplicationUsageIsCritical},
+		domain.DeviceResourceStatusWarning:  ResourceUpdate{Reason: domain.EventReasonDeviceApplicationUsageWarning, Details: ApplicationUsageIsWarning},
+		domain.DeviceResourceStatusHealthy:  ResourceUpdate{Reason: domain.EventReasonDeviceApplicationUsageNormal, Details: ApplicationUsageIsNormal},
+	}
 )
 
 func UpdateServiceSideStatus(ctx context.Context, orgId uuid.UUID, device *domain.Device, st store.Store, log logrus.FieldLogger) bool {
@@ -109,6 +145,16 @@ func resourcesDisk(disk domain.DeviceResourceStatusType, resourceErrors *[]strin
 	}
 }
 
+// resourcesOptional adds the status of a resource that is only reported by agents supporting its monitor.
+func resourcesOptional(status *domain.DeviceResourceStatusType, statusMap statusType, resourceErrors *[]string, resourceDegradations *[]string) {
+	switch lo.FromPtr(status) {
+	case domain.DeviceResourceStatusCritical:
+		*resourceErrors = append(*resourceErrors, statusMap[domain.DeviceResourceStatusCritical].Details)
+	case domain.DeviceResourceStatusWarning:
+		*resourceDegradations = append(*resourceDegradations, statusMap[domain.DeviceResourceStatusWarning].Details)
+	}
+}
+
 func updateServerSideDeviceStatus(device *domain.Device) bool {
 	lastDeviceStatus := device.Status.Summary.Status
 
@@ -146,6 +192,10 @@ func updateServerSideDeviceStatus(device *domain.Device) bool {
 	resourcesCpu(device.Status.Resources.Cpu, &resourceErrors, &resourceDegradations)
 	resourcesMemory(device.Status.Resources.Memory, &resourceErrors, &resourceDegradations)
 	resourcesDisk(device.Status.Resources.Disk, &resourceErrors, &resourceDegradations)
+	resourcesOptional(device.Status.Resources.Inode, inodeStatus, &resourceErrors, &resourceDegradations)
+	resourcesOptional(device.Status.Resources.Temperature, temperatureStatus, &resourceErrors, &resourceDegradations)
+	resourcesOptional(device.Status.Resources.Network, networkStatus, &resourceErrors, &resourceDegradations)
+	resourcesOptional(device.Status.Resources.Application, applicationUsageStatus, &resourceErrors, &resourceDegradations)
 
 	switch {
 	case len(resourceErrors) > 0:
@@ -217,7 +267,7 @@ func updateServerSideDeviceUpdatedStatus(device *domain.Device, ctx context.Cont
 
 		// Prefer update condition error if available
 		if updateCondition := domain.FindStatusCondition(device.Status.Conditions, domain.ConditionTypeDeviceUpdating); updateCondition != nil {
-			if updateCondition.Reason == string(domain.UpdateStateError) && updateCondition.Message != "" {
+			if domain.IsUpdateFailed(updateCondition.Reason) && updateCondition.Message != "" {
 				errorMessage = fmt.Sprintf("%s: %s", baseMessage, updateCondition.Message)
 			}
 		}
@@ -250,7 +300,7 @@ func updateServerSideDeviceUpdatedStatus(device *domain.Device, ctx context.Cont
 			var errorMessage string
 			baseMessage := "Device could not be updated to the fleet's latest device spec"
 			if updateCondition := domain.FindStatusCondition(device.Status.Conditions, domain.ConditionTypeDeviceUpdating); updateCondition != nil {
-				if updateCondition.Reason == string(domain.UpdateStateError) {
+				if domain.IsUpdateFailed(updateCondition.Reason) {
 					errorMessage = fmt.Sprintf("%s: %s", baseMessage, updateCondition.Message)
 				}
 			} else if device.Metadata.Annotations != nil {
@@ -318,9 +368,7 @@ func KeepDBDeviceStatus(device, dbDevice *domain.Device) {
 	if device.Status.ApplicationsSummary.Status == domain.ApplicationsSummaryStatusUnknown {
 		device.Status.ApplicationsSummary.Status = dbDevice.Status.ApplicationsSummary.Status
 	}
-	if device.Status.Integrity.Status == domain.DeviceIntegrityStatusUnknown {
-		device.Status.Integrity = dbDevice.Status.Integrity
-	}
+	KeepDBDeviceIntegrity(device, dbDevice)
 
 	// Preserve service-side statuses that should take precedence over agent-reported status
 	// These statuses are set by the service based on annotations and should not be overwritten
@@ -331,6 +379,18 @@ func KeepDBDeviceStatus(device, dbDevice *domain.Device) {
 	}
 }
 
+// KeepDBDeviceIntegrity preserves the integrity status computed by the service. Once the device has been
+// attested, the attestation result and the summary derived from it are owned by the service, so that a
+// device cannot clear a failed attestation by reporting its own integrity status.
+func KeepDBDeviceIntegrity(device, dbDevice *domain.Device) {
+	if device.Status == nil || dbDevice.Status == nil {
+		return
+	}
+	if device.Status.Integrity.Status == domain.DeviceIntegrityStatusUnknown || dbDevice.Status.Integrity.Attestation != nil {
+		device.Status.Integrity = dbDevice.Status.Integrity
+	}
+}
+
 func ComputeDeviceStatusChanges(ctx context.Context, oldDevice, newDevice *domain.Device, orgId uuid.UUID, st store.Store) ResourceUpdates {
 	resourceUpdates := make(ResourceUpdates, 0, 6)
 
@@ -365,7 +425,7 @@ func ComputeDeviceStatusChanges(ctx context.Context, oldDevice, newDevice *domai
 		case newDevice.Status.Updated.Status == domain.DeviceUpdatedStatusOutOfDate:
 			// Check if there's an update error condition
 			if updateCondition := domain.FindStatusCondition(newDevice.Status.Conditions, domain.ConditionTypeDeviceUpdating); updateCondition != nil {
-				if updateCondition.Reason == string(domain.UpdateStateError) && updateCondition.Message != "" {
+				if domain.IsUpdateFailed(updateCondition.Reason) && updateCondition.Message != "" {
 					status = domain.EventReasonDeviceUpdateFailed
 				} else {
 					status = domain.EventReasonDeviceContentOutOfDate
@@ -400,6 +460,21 @@ func ComputeDeviceStatusChanges(ctx context.Context, oldDevice, newDevice *domai
 		}
 	}
 
+	if hasStatusChanged(oldDevice, newDevice, domain.DeviceIntegrityStatusUnknown, func(d *domain.Device) domain.DeviceIntegrityStatusSummaryType { return d.Status.Integrity.Status }) {
+		switch newDevice.Status.Integrity.Status {
+		case domain.DeviceIntegrityStatusFailed:
+			resourceUpdates = append(resourceUpdates, ResourceUpdate{Reason: domain.EventReasonDeviceIntegrityFailed, Details: lo.FromPtr(newDevice.Status.Integrity.Info)})
+		case domain.DeviceIntegrityStatusVerified:
+			resourceUpdates = append(resourceUpdates, ResourceUpdate{Reason: domain.EventReasonDeviceIntegrityVerified, Details: lo.FromPtr(newDevice.Status.Integrity.Info)})
+		}
+	}
+
+	// A new encryption key requires re-rendering so that sensitive content is encrypted to it
+	if hasStatusChanged(oldDevice, newDevice, "", func(d *domain.Device) string { return lo.FromPtr(d.Status.Config.EncryptionKey) }) &&
+		lo.FromPtr(newDevice.Status.Config.EncryptionKey) != "" {
+		resourceUpdates = append(resourceUpdates, ResourceUpdate{Reason: domain.EventReasonDeviceEncryptionKeyUpdated, Details: "Device reported a new encryption key."})
+	}
+
 	resourceChecks := []struct {
 		statusMap statusType
 		getter    func(*domain.Device) domain.DeviceResourceStatusType
@@ -407,6 +482,10 @@ func ComputeDeviceStatusChanges(ctx context.Context, oldDevice, newDevice *domai
 		{cpuStatus, func(d *domain.Device) domain.DeviceResourceStatusType { return d.Status.Resources.Cpu }},
 		{memoryStatus, func(d *domain.Device) domain.DeviceResourceStatusType { return d.Status.Resources.Memory }},
 		{diskStatus, func(d *domain.Device) domain.DeviceResourceStatusType { return d.Status.Resources.Disk }},
+		{inodeStatus, optionalResourceStatus(func(r *domain.DeviceResourceStatus) *domain.DeviceResourceStatusType { return r.Inode })},
+		{temperatureStatus, optionalResourceStatus(func(r *domain.DeviceResourceStatus) *domain.DeviceResourceStatusType { return r.Temperature })},
+		{networkStatus, optionalResourceStatus(func(r *domain.DeviceResourceStatus) *domain.DeviceResourceStatusType { return r.Network })},
+		{applicationUsageStatus, optionalResourceStatus(func(r *domain.DeviceResourceStatus) *domain.DeviceResourceStatusType { return r.Application })},
 	}
 	for _, check := range resourceChecks {
 		checkResourceStatus(oldDevice, newDevice, check.statusMap, check.getter, &resourceUpdates)
@@ -415,6 +494,14 @@ func ComputeDeviceStatusChanges(ctx context.Context, oldDevice, newDevice *domai
 	return resourceUpdates
 }
 
+// optionalResourceStatus returns a getter for a resource status that is not reported by older agents, treating a
+// missing status as unknown.
+func optionalResourceStatus(field func(*domain.DeviceResourceStatus) *domain.DeviceResourceStatusType) func(*domain.Device) domain.DeviceResourceStatusType {
+	return func(d *domain.Device) domain.DeviceResourceStatusType {
+		return lo.FromPtrOr(field(&d.Status.Resources), domain.DeviceResourceStatusUnknown)
+	}
+}
+
 func hasStatusChanged[T comparable](oldDevice *domain.Device, newDevice *domain.Device, defaultValue T, getter func(*domain.Device) T) bool {
 	newStatus := getter(newDevice)
 	if oldDevice != nil && oldDevice.Status != nil {
@@ -121,6 +121,130 @@ func TestComputeDeviceStatusChanges_StatusTransition(t *testing.T) {
 	assert.Contains(t, updates[0].Details, "update failed")
 }
 
+func TestComputeDeviceStatusChanges_OptionalResources(t *testing.T) {
+	ctx := context.Background()
+	orgId := uuid.New()
+
+	deviceWithResources := func(resources domain.DeviceResourceStatus) *domain.Device {
+		return &domain.Device{
+			Metadata: domain.ObjectMeta{
+				Name: lo.ToPtr("test-device"),
+			},
+			Status: &domain.DeviceStatus{
+				Resources: resources,
+			},
+		}
+	}
+
+	tests := []struct {
+		name            string
+		oldResources    domain.DeviceResourceStatus
+		newResources    domain.DeviceResourceStatus
+		expectedReasons []domain.EventReason
+	}{
+		{
+			name:            "not reported by the agent",
+			oldResources:    domain.DeviceResourceStatus{},
+			newResources:    domain.DeviceResourceStatus{},
+			expectedReasons: nil,
+		},
+		{
+			name:            "reported healthy for the first time",
+			oldResources:    domain.DeviceResourceStatus{},
+			newResources:    domain.DeviceResourceStatus{Temperature: lo.ToPtr(domain.DeviceResourceStatusHealthy)},
+			expectedReasons: nil,
+		},
+		{
+			name:            "temperature becomes critical",
+			oldResources:    domain.DeviceResourceStatus{},
+			newResources:    domain.DeviceResourceStatus{Temperature: lo.ToPtr(domain.DeviceResourceStatusCritical)},
+			expectedReasons: []domain.EventReason{domain.EventReasonDeviceTemperatureCritical},
+		},
+		{
+			name:         "inode and application usage warnings",
+			oldResources: domain.DeviceResourceStatus{Inode: lo.ToPtr(domain.DeviceResourceStatusHealthy)},
+			newResources: domain.DeviceResourceStatus{
+				Inode:       lo.ToPtr(domain.DeviceResourceStatusWarning),
+				Application: lo.ToPtr(domain.DeviceResourceStatusWarning),
+			},
+			expectedReasons: []domain.EventReason{domain.EventReasonDeviceInodeWarning, domain.EventReasonDeviceApplicationUsageWarning},
+		},
+		{
+			name:            "network returns to normal",
+			oldResources:    domain.DeviceResourceStatus{Network: lo.ToPtr(domain.DeviceResourceStatusCritical)},
+			newResources:    domain.DeviceResourceStatus{Network: lo.ToPtr(domain.DeviceResourceStatusHealthy)},
+			expectedReasons: []domain.EventReason{domain.EventReasonDeviceNetworkNormal},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updates := ComputeDeviceStatusChanges(ctx, deviceWithResources(tt.oldResources), deviceWithResources(tt.newResources), orgId, nil)
+			var reasons []domain.EventReason
+			for _, update := range updates {
+				reasons = append(reasons, update.Reason)
+			}
+			assert.Equal(t, tt.expectedReasons, reasons)
+		})
+	}
+}
+
+func TestComputeDeviceStatusChanges_EncryptionKey(t *testing.T) {
+	ctx := context.Background()
+	orgId := uuid.New()
+
+	deviceWithKey := func(key *string) *domain.Device {
+		return &domain.Device{
+			Metadata: domain.ObjectMeta{
+				Name: lo.ToPtr("test-device"),
+			},
+			Status: &domain.DeviceStatus{
+				Config: domain.DeviceConfigStatus{EncryptionKey: key},
+			},
+		}
+	}
+
+	tests := []struct {
+		name            string
+		oldKey          *string
+		newKey          *string
+		expectedReasons []domain.EventReason
+	}{
+		{
+			name:            "not reported by the agent",
+			expectedReasons: nil,
+		},
+		{
+			name:            "reported for the first time",
+			newKey:          lo.ToPtr("key-1"),
+			expectedReasons: []domain.EventReason{domain.EventReasonDeviceEncryptionKeyUpdated},
+		},
+		{
+			name:            "unchanged",
+			oldKey:          lo.ToPtr("key-1"),
+			newKey:          lo.ToPtr("key-1"),
+			expectedReasons: nil,
+		},
+		{
+			name:            "rotated",
+			oldKey:          lo.ToPtr("key-1"),
+			newKey:          lo.ToPtr("key-2"),
+			expectedReasons: []domain.EventReason{domain.EventReasonDeviceEncryptionKeyUpdated},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updates := ComputeDeviceStatusChanges(ctx, deviceWithKey(tt.oldKey), deviceWithKey(tt.newKey), orgId, nil)
+			var reasons []domain.EventReason
+			for _, update := range updates {
+				reasons = append(reasons, update.Reason)
+			}
+			assert.Equal(t, tt.expectedReasons, reasons)
+		})
+	}
+}
+
 func TestUpdateServerSideDeviceStatus_PostRestoreState(t *testing.T) {
 	// This test validates the critical post-restore state where ALL three conditions must be true:
 	// 1. awaitingReconnect annotation = "true"
@@ -551,6 +551,31 @@ func GetFleetRolloutFailedEvent(ctx context.Context, name string, deployingTempl
 	})
 }
 
+// GetFleetRolloutRolledBackEvent creates an event for fleet rollout rollback
+func GetFleetRolloutRolledBackEvent(ctx context.Context, name string, rollback *domain.FleetRolloutRollback) *domain.Event {
+	details := domain.FleetRolloutRolledBackDetails{
+		DetailType:              domain.FleetRolloutRolledBack,
+		TemplateVersion:         rollback.TemplateVersion,
+		RestoredTemplateVersion: rollback.RestoredTemplateVersion,
+	}
+	eventDetails := domain.EventDetails{}
+	if err := eventDetails.FromFleetRolloutRolledBackDetails(details); err != nil {
+		// If serialization fails, return nil rather than panicking
+		return nil
+	}
+	message := fmt.Sprintf("Fleet rollout of template version %s was rolled back to template version %s.", rollback.TemplateVersion, rollback.RestoredTemplateVersion)
+	if lo.FromPtr(rollback.Message) != "" {
+		message = fmt.Sprintf("%s Reason: %s", message, *rollback.Message)
+	}
+	return getBaseEvent(ctx, resourceEvent{
+		resourceKind: domain.FleetKind,
+		resourceName: name,
+		reason:       domain.EventReasonFleetRolloutRolledBack,
+		message:      message,
+		details:      &eventDetails,
+	})
+}
+
 // GetRepositoryAccessibleEvent creates an event for repository accessibility
 func GetRepositoryAccessibleEvent(ctx context.Context, name string) *domain.Event {
 	return getBaseEvent(ctx, resourceEvent{
@@ -593,6 +618,47 @@ func GetReferencedRepositoryUpdatedEvent(ctx context.Context, kind domain.Resour
 	})
 }
 
+// GetResourceSyncPreviewRequestedEvent creates an event for a requested preview of a ResourceSync
+func GetResourceSyncPreviewRequestedEvent(ctx context.Context, resourceSyncName string, requestId string, revision string) *domain.Event {
+	details := domain.ResourceSyncPreviewRequestedDetails{
+		DetailType: domain.ResourceSyncPreviewRequested,
+		RequestId:  requestId,
+		Revision:   revision,
+	}
+	eventDetails := domain.EventDetails{}
+	if err := eventDetails.FromResourceSyncPreviewRequestedDetails(details); err != nil {
+		// If serialization fails, return nil rather than panicking
+		return nil
+	}
+	return getBaseEvent(ctx, resourceEvent{
+		resourceKind: domain.ResourceSyncKind,
+		resourceName: resourceSyncName,
+		reason:       domain.EventReasonResourceSyncPreviewRequested,
+		message:      fmt.Sprintf("Preview of ResourceSync %s at revision %s requested.", resourceSyncName, revision),
+		details:      &eventDetails,
+	})
+}
+
+// GetRepositoryPushReceivedEvent creates an event for a push to a repository that its Git server notified us of
+func GetRepositoryPushReceivedEvent(ctx context.Context, repositoryName string, revisions []string) *domain.Event {
+	details := domain.RepositoryPushReceivedDetails{
+		DetailType: domain.RepositoryPushReceived,
+		Revisions:  revisions,
+	}
+	eventDetails := domain.EventDetails{}
+	if err := eventDetails.FromRepositoryPushReceivedDetails(details); err != nil {
+		// If serialization fails, return nil rather than panicking
+		return nil
+	}
+	return getBaseEvent(ctx, resourceEvent{
+		resourceKind: domain.RepositoryKind,
+		resourceName: repositoryName,
+		reason:       domain.EventReasonRepositoryPushReceived,
+		message:      fmt.Sprintf("Repository %s received a push.", repositoryName),
+		details:      &eventDetails,
+	})
+}
+
 // GetSystemRestoredEvent creates an event for system restoration completion
 // Associates the event with a system-level resource using the System kind
 func GetSystemRestoredEvent(ctx context.Context, devicesUpdated int64) *domain.Event {
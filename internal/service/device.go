@@ -19,6 +19,7 @@ import (
 	"github.com/flightctl/flightctl/internal/store/model"
 	"github.com/flightctl/flightctl/internal/store/selector"
 	"github.com/flightctl/flightctl/internal/util/validation"
+	fccrypto "github.com/flightctl/flightctl/pkg/crypto"
 	"github.com/google/uuid"
 	"github.com/samber/lo"
 )
@@ -243,9 +244,23 @@ func (h *ServiceHandler) GetDeviceLastSeen(ctx context.Context, orgId uuid.UUID,
 func validateDeviceStatus(d *domain.Device) []error {
 	allErrs := append([]error{}, validation.ValidateResourceName(d.Metadata.Name)...)
 	// TODO: implement validation of agent's status updates
+	if d.Status != nil && d.Status.Config.EncryptionKey != nil {
+		if err := validateEncryptionKey(*d.Status.Config.EncryptionKey); err != nil {
+			allErrs = append(allErrs, fmt.Errorf("invalid status.config.encryptionKey: %w", err))
+		}
+	}
 	return allErrs
 }
 
+func validateEncryptionKey(keyPEM string) error {
+	publicKey, err := fccrypto.ParsePublicKeyPEM([]byte(keyPEM))
+	if err != nil {
+		return err
+	}
+	_, err = fccrypto.ECDHPublicKey(publicKey)
+	return err
+}
+
 func (h *ServiceHandler) ReplaceDeviceStatus(ctx context.Context, orgId uuid.UUID, name string, incomingDevice domain.Device) (*domain.Device, domain.Status) {
 	if errs := validateDeviceStatus(&incomingDevice); len(errs) > 0 {
 		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
@@ -282,6 +297,9 @@ func (h *ServiceHandler) ReplaceDeviceStatus(ctx context.Context, orgId uuid.UUI
 	_ = common.UpdateServiceSideStatus(ctx, orgId, deviceToStore, h.store, h.log)
 
 	result, err := h.store.Device().UpdateStatus(ctx, orgId, deviceToStore, h.callbackDeviceUpdated)
+	if err == nil {
+		h.revokeDecommissionedDevice(ctx, orgId, originalDevice, result)
+	}
 	return result, StoreErrorToApiStatus(err, false, domain.DeviceKind, &name)
 }
 
@@ -320,6 +338,7 @@ func (h *ServiceHandler) PatchDeviceStatus(ctx context.Context, orgId uuid.UUID,
 	NilOutManagedObjectMetaProperties(&newObj.Metadata)
 	newObj.Metadata.ResourceVersion = nil
 
+	common.KeepDBDeviceIntegrity(newObj, currentObj)
 	_ = common.UpdateServiceSideStatus(ctx, orgId, newObj, h.store, h.log)
 
 	result, err := h.store.Device().Update(ctx, orgId, newObj, nil, true, DeviceVerificationCallback, h.callbackDeviceUpdated)
@@ -376,9 +395,44 @@ func (h *ServiceHandler) GetRenderedDevice(ctx context.Context, orgId uuid.UUID,
 			h.log.Errorf("GetRenderedDevice %s/%s: failed to set rendered version in kvstore: %v", orgId, name, err)
 		}
 	}
+	if !isAgent {
+		if err = redactRenderedSecretResources(result); err != nil {
+			h.log.Errorf("GetRenderedDevice %s/%s: failed to redact secrets: %v", orgId, name, err)
+			return nil, StoreErrorToApiStatus(err, false, domain.DeviceKind, &name)
+		}
+	}
 	return result, StoreErrorToApiStatus(err, false, domain.DeviceKind, &name)
 }
 
+// redactRenderedSecretResources redacts the contents of rendered files that are written from Secret resources, so
+// that only the device itself can read them. The files are marked when the device is rendered, so that files of a
+// Secret resource that was since removed from the device spec are still redacted.
+func redactRenderedSecretResources(rendered *domain.Device) error {
+	if rendered.Spec == nil || rendered.Spec.Config == nil {
+		return nil
+	}
+
+	for i, provider := range *rendered.Spec.Config {
+		if providerType, err := provider.Type(); err != nil || providerType != domain.InlineConfigProviderType {
+			continue
+		}
+		inlineSpec, err := provider.AsInlineConfigProviderSpec()
+		if err != nil {
+			return fmt.Errorf("failed getting rendered config as InlineConfigProviderSpec: %w", err)
+		}
+		for j := range inlineSpec.Inline {
+			if lo.FromPtr(inlineSpec.Inline[j].Sensitive) {
+				inlineSpec.Inline[j].Content = domain.RedactedPlaceholder
+				inlineSpec.Inline[j].ContentEncoding = lo.ToPtr(domain.EncodingPlain)
+			}
+		}
+		if err := (*rendered.Spec.Config)[i].FromInlineConfigProviderSpec(inlineSpec); err != nil {
+			return fmt.Errorf("failed redacting rendered config: %w", err)
+		}
+	}
+	return nil
+}
+
 // Only metadata.labels and spec can be patched. If we try to patch other fields, HTTP 400 Bad Request is returned.
 func (h *ServiceHandler) PatchDevice(ctx context.Context, orgId uuid.UUID, name string, patch domain.PatchRequest) (*domain.Device, domain.Status) {
 	currentObj, err := h.store.Device().Get(ctx, orgId, name)
@@ -414,6 +468,7 @@ func (h *ServiceHandler) PatchDevice(ctx context.Context, orgId uuid.UUID, name
 	NilOutManagedObjectMetaProperties(&newObj.Metadata)
 	newObj.Metadata.ResourceVersion = nil
 
+	common.KeepDBDeviceIntegrity(newObj, currentObj)
 	_ = common.UpdateServiceSideStatus(ctx, orgId, newObj, h.store, h.log)
 
 	result, err := h.store.Device().Update(ctx, orgId, newObj, nil, true, DeviceVerificationCallback, h.callbackDeviceUpdated)
@@ -449,8 +504,8 @@ func (h *ServiceHandler) UpdateDeviceAnnotations(ctx context.Context, orgId uuid
 	return StoreErrorToApiStatus(err, false, domain.DeviceKind, &name)
 }
 
-func (h *ServiceHandler) UpdateRenderedDevice(ctx context.Context, orgId uuid.UUID, name, renderedConfig, renderedApplications, specHash string) domain.Status {
-	renderedVersion, err := h.store.Device().UpdateRendered(ctx, orgId, name, renderedConfig, renderedApplications, specHash)
+func (h *ServiceHandler) UpdateRenderedDevice(ctx context.Context, orgId uuid.UUID, name, renderedConfig, renderedApplications, renderedTrustPolicy, specHash string) domain.Status {
+	renderedVersion, err := h.store.Device().UpdateRendered(ctx, orgId, name, renderedConfig, renderedApplications, renderedTrustPolicy, specHash)
 	if err != nil {
 		h.log.Errorf("Failed to update rendered device %s/%s: %v", orgId, name, err)
 		return StoreErrorToApiStatus(err, false, domain.DeviceKind, &name)
@@ -383,3 +383,47 @@ func TestDeviceDisconnected(t *testing.T) {
 	require.Equal(true, changed)
 	require.Equal(device.Status.Summary.Status, domain.DeviceSummaryStatusUnknown)
 }
+
+func TestDeviceStatusKeepsFailedAttestation(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	testOrgId := uuid.New()
+	serviceHandler := serviceHandler()
+
+	device := prepareDevice(testOrgId, "foo")
+	device.Status.Integrity = domain.DeviceIntegrityStatus{
+		Status: domain.DeviceIntegrityStatusFailed,
+		Info:   lo.ToPtr("PCR 7 does not match reference measurement ref"),
+		Attestation: &domain.DeviceIntegrityCheckStatus{
+			Status: domain.DeviceIntegrityCheckStatusFailed,
+			Info:   lo.ToPtr("PCR 7 does not match reference measurement ref"),
+		},
+	}
+	_, err := serviceHandler.store.Device().Create(ctx, testOrgId, device, nil)
+	require.NoError(err)
+	expected := device.Status.Integrity
+
+	// the agent reports a verified integrity status of its own
+	verified := domain.DeviceIntegrityStatus{
+		Status: domain.DeviceIntegrityStatusVerified,
+		Attestation: &domain.DeviceIntegrityCheckStatus{
+			Status: domain.DeviceIntegrityCheckStatusVerified,
+		},
+	}
+	incoming := prepareDevice(testOrgId, "foo")
+	incoming.Status.Integrity = verified
+	result, status := serviceHandler.ReplaceDeviceStatus(ctx, testOrgId, "foo", *incoming)
+	require.Equal(statusSuccessCode, status.Code)
+	require.Equal(expected, result.Status.Integrity)
+
+	var value interface{} = verified
+	result, status = serviceHandler.PatchDeviceStatus(ctx, testOrgId, "foo", domain.PatchRequest{
+		{Op: "replace", Path: "/status/integrity", Value: &value},
+	})
+	require.Equal(statusSuccessCode, status.Code)
+	require.Equal(expected, result.Status.Integrity)
+
+	stored, err := serviceHandler.store.Device().Get(ctx, testOrgId, "foo")
+	require.NoError(err)
+	require.Equal(expected, stored.Status.Integrity)
+}
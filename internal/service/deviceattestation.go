@@ -0,0 +1,342 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/kvstore"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/store/selector"
+	"github.com/flightctl/flightctl/internal/tpm"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+const (
+	attestationNonceSize    = 32
+	attestationChallengeTTL = 5 * time.Minute
+)
+
+// defaultAttestationPCRs are quoted when no reference measurement applies to the device,
+// covering the firmware and boot loader measurements.
+var defaultAttestationPCRs = []int{0, 1, 2, 3, 4, 5, 6, 7}
+
+func (h *ServiceHandler) CreateDeviceAttestationChallenge(ctx context.Context, orgId uuid.UUID, name string) (*domain.AttestationChallenge, domain.Status) {
+	device, err := h.store.Device().Get(ctx, orgId, name)
+	if err != nil {
+		return nil, StoreErrorToApiStatus(err, false, domain.DeviceKind, &name)
+	}
+
+	references, err := h.listDeviceReferenceMeasurements(ctx, orgId, device)
+	if err != nil {
+		return nil, domain.StatusInternalServerError(err.Error())
+	}
+
+	nonce := make([]byte, attestationNonceSize)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, domain.StatusInternalServerError(fmt.Sprintf("generating nonce: %v", err))
+	}
+
+	// a new challenge replaces any outstanding one
+	key := kvstore.AttestationNonceKey{OrgID: orgId, DeviceName: name}
+	keyStr := key.ComposeKey()
+	if err := h.kvStore.Delete(ctx, keyStr); err != nil {
+		return nil, domain.StatusInternalServerError(fmt.Sprintf("resetting attestation challenge: %v", err))
+	}
+	if _, err := h.kvStore.SetNX(ctx, keyStr, nonce); err != nil {
+		return nil, domain.StatusInternalServerError(fmt.Sprintf("storing attestation challenge: %v", err))
+	}
+	if err := h.kvStore.SetExpire(ctx, keyStr, attestationChallengeTTL); err != nil {
+		return nil, domain.StatusInternalServerError(fmt.Sprintf("storing attestation challenge: %v", err))
+	}
+
+	pcrs := defaultAttestationPCRs
+	if len(references) > 0 {
+		pcrs = referencedPCRs(references)
+	}
+
+	return &domain.AttestationChallenge{
+		Nonce: nonce,
+		Pcrs:  pcrs,
+	}, domain.StatusCreated()
+}
+
+func (h *ServiceHandler) ReplaceDeviceAttestation(ctx context.Context, orgId uuid.UUID, name string, report domain.AttestationReport) (*domain.DeviceIntegrityStatus, domain.Status) {
+	// a challenge can only be answered once
+	key := kvstore.AttestationNonceKey{OrgID: orgId, DeviceName: name}
+	keyStr := key.ComposeKey()
+	expectedNonce, err := h.kvStore.Get(ctx, keyStr)
+	if err != nil {
+		return nil, domain.StatusInternalServerError(fmt.Sprintf("reading attestation challenge: %v", err))
+	}
+	if expectedNonce == nil {
+		return nil, domain.StatusBadRequest("no outstanding attestation challenge for device")
+	}
+	if err := h.kvStore.Delete(ctx, keyStr); err != nil {
+		return nil, domain.StatusInternalServerError(fmt.Sprintf("consuming attestation challenge: %v", err))
+	}
+	if !bytes.Equal(expectedNonce, report.Nonce) {
+		return nil, domain.StatusBadRequest("attestation nonce does not match the outstanding challenge")
+	}
+
+	device, err := h.store.Device().GetWithTimestamp(ctx, orgId, name)
+	if err != nil {
+		return nil, StoreErrorToApiStatus(err, false, domain.DeviceKind, &name)
+	}
+	if device.Status == nil {
+		device.Status = lo.ToPtr(domain.NewDeviceStatus())
+	}
+
+	references, err := h.listDeviceReferenceMeasurements(ctx, orgId, device)
+	if err != nil {
+		return nil, domain.StatusInternalServerError(err.Error())
+	}
+	// once an organization defines reference measurements its devices are expected to attest
+	expected := len(references) > 0
+	if !expected {
+		allReferences, err := h.listReferenceMeasurements(ctx, orgId, "")
+		if err != nil {
+			return nil, domain.StatusInternalServerError(err.Error())
+		}
+		expected = len(allReferences) > 0
+	}
+
+	attestation := h.verifyDeviceAttestation(ctx, orgId, name, device, report, references, expected)
+	integrity := &device.Status.Integrity
+	integrity.Attestation = attestation
+	integrity.LastVerified = lo.ToPtr(time.Now())
+	summarizeDeviceIntegrity(integrity)
+
+	// quarantined devices are not sent new configuration until they attest successfully again.
+	// The annotation is updated before the status so that the resulting event re-renders a released device.
+	// Only the reference measurements for the device's own OS image decide whether it is quarantined.
+	quarantine := attestation.Status == domain.DeviceIntegrityCheckStatusFailed &&
+		lo.SomeBy(references, func(r domain.ReferenceMeasurement) bool { return lo.FromPtr(r.Spec.QuarantineOnFailure) })
+	_, quarantined := lo.FromPtr(device.Metadata.Annotations)[domain.DeviceAnnotationQuarantined]
+	switch {
+	case quarantine && !quarantined:
+		err = h.store.Device().UpdateAnnotations(ctx, orgId, name, map[string]string{domain.DeviceAnnotationQuarantined: "true"}, nil)
+	case attestation.Status == domain.DeviceIntegrityCheckStatusVerified && quarantined:
+		err = h.store.Device().UpdateAnnotations(ctx, orgId, name, nil, []string{domain.DeviceAnnotationQuarantined})
+	}
+	if err != nil {
+		return nil, StoreErrorToApiStatus(err, false, domain.DeviceKind, &name)
+	}
+
+	if _, err := h.store.Device().UpdateStatus(ctx, orgId, device, h.callbackDeviceUpdated); err != nil {
+		return nil, StoreErrorToApiStatus(err, false, domain.DeviceKind, &name)
+	}
+
+	return integrity, domain.StatusOK()
+}
+
+// verifyDeviceAttestation checks the quote against the device's attestation key, the event log against
+// the quoted PCRs and finally the quoted PCRs against the reference measurements for the device's OS image.
+// If the device is expected to attest, an OS image without reference measurements fails the attestation.
+// While the device is updated to a new OS image, a mismatch with the reference measurements is not a failure.
+func (h *ServiceHandler) verifyDeviceAttestation(ctx context.Context, orgId uuid.UUID, name string, device *domain.Device, report domain.AttestationReport, references []domain.ReferenceMeasurement, expected bool) *domain.DeviceIntegrityCheckStatus {
+	failed := func(format string, args ...any) *domain.DeviceIntegrityCheckStatus {
+		return &domain.DeviceIntegrityCheckStatus{
+			Status: domain.DeviceIntegrityCheckStatusFailed,
+			Info:   lo.ToPtr(fmt.Sprintf(format, args...)),
+		}
+	}
+
+	attestPub, err := h.getDeviceAttestationKey(ctx, orgId, name)
+	if err != nil {
+		return failed("Attestation key unavailable: %v", err)
+	}
+
+	pcrs := make(map[int][]byte, len(report.Pcrs))
+	for _, pcr := range report.Pcrs {
+		value, err := hex.DecodeString(pcr.Digest)
+		if err != nil {
+			return failed("Invalid value reported for PCR %d", pcr.Index)
+		}
+		pcrs[pcr.Index] = value
+	}
+
+	if err := tpm.VerifyQuote(attestPub, report.Quote, report.Signature, report.Nonce, pcrs); err != nil {
+		return failed("Quote verification failed: %v", err)
+	}
+
+	if report.EventLog != nil {
+		replayed, err := tpm.ReplayEventLog(*report.EventLog)
+		if err != nil {
+			return failed("Event log is invalid: %v", err)
+		}
+		for index, value := range replayed {
+			if quoted, ok := pcrs[index]; ok && !bytes.Equal(quoted, value) {
+				return failed("Event log does not match quoted PCR %d", index)
+			}
+		}
+	}
+
+	measured := compareReferenceMeasurements(device, pcrs, references, expected)
+	if measured.Status == domain.DeviceIntegrityCheckStatusFailed && osUpdateInProgress(device) {
+		return &domain.DeviceIntegrityCheckStatus{
+			Status: domain.DeviceIntegrityCheckStatusUnknown,
+			Info:   lo.ToPtr(fmt.Sprintf("OS update to %q in progress: %s", device.Spec.Os.Image, lo.FromPtr(measured.Info))),
+		}
+	}
+	return measured
+}
+
+// compareReferenceMeasurements checks the quoted PCRs against the reference measurements for the device's OS image.
+func compareReferenceMeasurements(device *domain.Device, pcrs map[int][]byte, references []domain.ReferenceMeasurement, expected bool) *domain.DeviceIntegrityCheckStatus {
+	failed := func(format string, args ...any) *domain.DeviceIntegrityCheckStatus {
+		return &domain.DeviceIntegrityCheckStatus{
+			Status: domain.DeviceIntegrityCheckStatusFailed,
+			Info:   lo.ToPtr(fmt.Sprintf(format, args...)),
+		}
+	}
+
+	if len(references) == 0 && expected {
+		return failed("No reference measurements defined for OS image %q", deviceOsImage(device))
+	}
+	if len(references) == 0 {
+		return &domain.DeviceIntegrityCheckStatus{
+			Status: domain.DeviceIntegrityCheckStatusUnknown,
+			Info:   lo.ToPtr(fmt.Sprintf("No reference measurements defined for OS image %q", deviceOsImage(device))),
+		}
+	}
+
+	for _, reference := range references {
+		for _, expected := range reference.Spec.Pcrs {
+			value, ok := pcrs[expected.Index]
+			if !ok {
+				return failed("PCR %d required by reference measurement %s was not quoted", expected.Index, lo.FromPtr(reference.Metadata.Name))
+			}
+			if !slices.ContainsFunc(expected.Digests, func(digest string) bool { return strings.EqualFold(digest, hex.EncodeToString(value)) }) {
+				return failed("PCR %d does not match reference measurement %s", expected.Index, lo.FromPtr(reference.Metadata.Name))
+			}
+		}
+	}
+
+	return &domain.DeviceIntegrityCheckStatus{
+		Status: domain.DeviceIntegrityCheckStatusVerified,
+		Info:   lo.ToPtr("PCR quote matches reference measurements"),
+	}
+}
+
+// getDeviceAttestationKey returns the attestation key the device enrolled with.
+func (h *ServiceHandler) getDeviceAttestationKey(ctx context.Context, orgId uuid.UUID, name string) ([]byte, error) {
+	// TODO this should be retrieved from the device rather than from the ER
+	er, err := h.store.EnrollmentRequest().Get(ctx, orgId, name)
+	if err != nil {
+		return nil, fmt.Errorf("enrollment request not found")
+	}
+	if er.Status == nil || !domain.IsStatusConditionTrue(er.Status.Conditions, domain.ConditionTypeEnrollmentRequestTPMVerified) {
+		return nil, fmt.Errorf("device did not enroll with a verified TPM")
+	}
+	erBytes, isTPM := tpm.ParseTCGCSRBytes(er.Spec.Csr)
+	if !isTPM {
+		return nil, fmt.Errorf("device did not enroll with a TPM")
+	}
+	parsed, err := tpm.ParseTCGCSR(erBytes)
+	if err != nil {
+		return nil, fmt.Errorf("parsing enrollment CSR: %w", err)
+	}
+	return parsed.CSRContents.Payload.AttestPub, nil
+}
+
+// listDeviceReferenceMeasurements returns the reference measurements for the OS image the device attests against.
+func (h *ServiceHandler) listDeviceReferenceMeasurements(ctx context.Context, orgId uuid.UUID, device *domain.Device) ([]domain.ReferenceMeasurement, error) {
+	image := deviceOsImage(device)
+	if image == "" {
+		return nil, nil
+	}
+	return h.listReferenceMeasurements(ctx, orgId, image)
+}
+
+// listReferenceMeasurements returns the reference measurements for the OS image, or all of them if no image is given.
+func (h *ServiceHandler) listReferenceMeasurements(ctx context.Context, orgId uuid.UUID, image string) ([]domain.ReferenceMeasurement, error) {
+	listParams := store.ListParams{}
+	if image != "" {
+		fieldSelector, err := selector.NewFieldSelectorFromMap(map[string]string{"spec.osImage": image})
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse field selector: %w", err)
+		}
+		listParams.FieldSelector = fieldSelector
+	}
+	references, err := h.store.ReferenceMeasurement().List(ctx, orgId, listParams)
+	if err != nil {
+		return nil, fmt.Errorf("listing reference measurements: %w", err)
+	}
+	return references.Items, nil
+}
+
+// deviceOsImage returns the OS image the device attests against. The image the device reports it booted is
+// only accepted if it is the image in the spec or the image last rendered for the device, since a tampered
+// device could report an image for which no reference measurements are defined. Otherwise the image in the
+// spec is used.
+func deviceOsImage(device *domain.Device) string {
+	specImage := deviceSpecOsImage(device)
+	bootedImage := deviceBootedOsImage(device)
+	if bootedImage == "" {
+		return specImage
+	}
+	renderedImage := lo.FromPtr(device.Metadata.Annotations)[domain.DeviceAnnotationRenderedOsImage]
+	if bootedImage == specImage || bootedImage == renderedImage {
+		return bootedImage
+	}
+	return specImage
+}
+
+// osUpdateInProgress returns whether the device has not booted the OS image in its spec yet.
+func osUpdateInProgress(device *domain.Device) bool {
+	specImage := deviceSpecOsImage(device)
+	return specImage != "" && deviceBootedOsImage(device) != specImage
+}
+
+func deviceSpecOsImage(device *domain.Device) string {
+	if device.Spec == nil || device.Spec.Os == nil {
+		return ""
+	}
+	return device.Spec.Os.Image
+}
+
+func deviceBootedOsImage(device *domain.Device) string {
+	if device.Status == nil {
+		return ""
+	}
+	return device.Status.Os.Image
+}
+
+// referencedPCRs returns the sorted set of PCR indexes used by the reference measurements.
+func referencedPCRs(references []domain.ReferenceMeasurement) []int {
+	var pcrs []int
+	for _, reference := range references {
+		for _, pcr := range reference.Spec.Pcrs {
+			pcrs = append(pcrs, pcr.Index)
+		}
+	}
+	pcrs = lo.Uniq(pcrs)
+	slices.Sort(pcrs)
+	return pcrs
+}
+
+// summarizeDeviceIntegrity derives the overall integrity status from the individual checks.
+func summarizeDeviceIntegrity(integrity *domain.DeviceIntegrityStatus) {
+	checks := []*domain.DeviceIntegrityCheckStatus{integrity.DeviceIdentity, integrity.Tpm, integrity.Attestation}
+	for _, check := range checks {
+		if check != nil && check.Status == domain.DeviceIntegrityCheckStatusFailed {
+			integrity.Status = domain.DeviceIntegrityStatusFailed
+			integrity.Info = check.Info
+			return
+		}
+	}
+	if lo.SomeBy(checks, func(check *domain.DeviceIntegrityCheckStatus) bool {
+		return check != nil && check.Status == domain.DeviceIntegrityCheckStatusVerified
+	}) {
+		integrity.Status = domain.DeviceIntegrityStatusVerified
+		integrity.Info = lo.ToPtr("All integrity checks completed successfully")
+	}
+}
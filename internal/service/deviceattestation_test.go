@@ -0,0 +1,70 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeviceOsImage(t *testing.T) {
+	newDevice := func(specImage, renderedImage, bootedImage string) *domain.Device {
+		device := &domain.Device{
+			Metadata: domain.ObjectMeta{Annotations: &map[string]string{}},
+			Spec:     &domain.DeviceSpec{},
+			Status:   lo.ToPtr(domain.NewDeviceStatus()),
+		}
+		if specImage != "" {
+			device.Spec.Os = &domain.DeviceOsSpec{Image: specImage}
+		}
+		if renderedImage != "" {
+			(*device.Metadata.Annotations)[domain.DeviceAnnotationRenderedOsImage] = renderedImage
+		}
+		device.Status.Os.Image = bootedImage
+		return device
+	}
+
+	tests := []struct {
+		name             string
+		device           *domain.Device
+		expectedImage    string
+		expectedUpdating bool
+	}{
+		{
+			name:          "booted the spec image",
+			device:        newDevice("os:v2", "os:v2", "os:v2"),
+			expectedImage: "os:v2",
+		},
+		{
+			name:             "spec updated before the device was rendered",
+			device:           newDevice("os:v2", "os:v1", "os:v1"),
+			expectedImage:    "os:v1",
+			expectedUpdating: true,
+		},
+		{
+			name:             "rendered but not booted yet",
+			device:           newDevice("os:v2", "os:v2", "os:v1"),
+			expectedImage:    "os:v2",
+			expectedUpdating: true,
+		},
+		{
+			name:             "reports an image it was never sent",
+			device:           newDevice("os:v2", "os:v2", "os:other"),
+			expectedImage:    "os:v2",
+			expectedUpdating: true,
+		},
+		{
+			name:          "no OS in the spec",
+			device:        newDevice("", "", "os:v1"),
+			expectedImage: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expectedImage, deviceOsImage(tt.device))
+			require.Equal(t, tt.expectedUpdating, osUpdateInProgress(tt.device))
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+
+	"github.com/flightctl/flightctl/internal/crypto/signer"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/store/selector"
+	"github.com/flightctl/flightctl/internal/util"
+	fccrypto "github.com/flightctl/flightctl/pkg/crypto"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+const revocationListPageSize = 1000
+
+func (h *ServiceHandler) RevokeDeviceCertificates(ctx context.Context, orgId uuid.UUID, name string, revocation domain.DeviceRevocation) (*domain.DeviceRevocationResult, domain.Status) {
+	if _, err := h.store.Device().Get(ctx, orgId, name); err != nil {
+		return nil, StoreErrorToApiStatus(err, false, domain.DeviceKind, &name)
+	}
+
+	revoked, err := h.revokeDeviceCertificates(ctx, orgId, name, revocation.Reason)
+	if err != nil {
+		return nil, domain.StatusInternalServerError(err.Error())
+	}
+	return &domain.DeviceRevocationResult{Certificates: revoked}, domain.StatusOK()
+}
+
+func (h *ServiceHandler) revokeDeviceCertificates(ctx context.Context, orgId uuid.UUID, name string, reason domain.CertificateRevocationReason) ([]domain.RevokedCertificate, error) {
+	if h.ca == nil {
+		return nil, errors.New("certificate revocation is not enabled")
+	}
+	certs, err := h.listDeviceManagementCertificates(ctx, orgId, name)
+	if err != nil {
+		return nil, err
+	}
+	revoked, err := h.ca.RevokeCertificates(ctx, orgId, name, certs, reason)
+	if err != nil {
+		return nil, fmt.Errorf("revoking certificates of device %s: %w", name, err)
+	}
+	return revoked, nil
+}
+
+// listDeviceManagementCertificates returns every management certificate issued to a device:
+// the one issued on enrollment and those issued by renewals.
+func (h *ServiceHandler) listDeviceManagementCertificates(ctx context.Context, orgId uuid.UUID, name string) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+
+	er, err := h.store.EnrollmentRequest().Get(ctx, orgId, name)
+	if err != nil && !errors.Is(err, flterrors.ErrResourceNotFound) {
+		return nil, fmt.Errorf("getting enrollment request: %w", err)
+	}
+	if er != nil && er.Status != nil && er.Status.Certificate != nil {
+		cert, err := fccrypto.ParseCertificatePEM([]byte(*er.Status.Certificate))
+		if err != nil {
+			return nil, fmt.Errorf("parsing certificate of enrollment request %s: %w", name, err)
+		}
+		certs = append(certs, cert)
+	}
+
+	// management certificates are renewed through the agent API, which records the device as owner of the CSR
+	fieldSelector, err := selector.NewFieldSelectorFromMap(map[string]string{"metadata.owner": util.ResourceOwner(domain.DeviceKind, name)})
+	if err != nil {
+		return nil, fmt.Errorf("creating field selector: %w", err)
+	}
+	cfg := h.ca.Cfg
+	listParams := store.ListParams{Limit: revocationListPageSize, FieldSelector: fieldSelector}
+	for {
+		csrs, err := h.store.CertificateSigningRequest().List(ctx, orgId, listParams)
+		if err != nil {
+			return nil, fmt.Errorf("listing certificate signing requests: %w", err)
+		}
+		for _, csr := range csrs.Items {
+			if csr.Spec.SignerName != cfg.DeviceManagementSignerName && csr.Spec.SignerName != cfg.DeviceManagementRenewalSignerName {
+				continue
+			}
+			if csr.Status == nil || csr.Status.Certificate == nil {
+				continue
+			}
+			cert, err := fccrypto.ParseCertificatePEM(*csr.Status.Certificate)
+			if err != nil {
+				h.log.Warnf("Skipping unparsable certificate of CSR %s/%s: %v", orgId, lo.FromPtr(csr.Metadata.Name), err)
+				continue
+			}
+			fingerprint, err := signer.DeviceFingerprintFromCN(cfg, cert.Subject.CommonName)
+			if err != nil || fingerprint != name {
+				continue
+			}
+			certs = append(certs, cert)
+		}
+
+		if csrs.Metadata.Continue == nil {
+			break
+		}
+		if listParams.Continue, err = store.ParseContinueString(csrs.Metadata.Continue); err != nil {
+			return nil, fmt.Errorf("parsing continue token: %w", err)
+		}
+	}
+
+	return certs, nil
+}
+
+// revokeDecommissionedDevice revokes the certificates of a device once the agent has reported the end of
+// its decommissioning. Revoking earlier would lock the agent out before it received the decommission request.
+func (h *ServiceHandler) revokeDecommissionedDevice(ctx context.Context, orgId uuid.UUID, original, updated *domain.Device) {
+	if !isDecommissioned(updated) || isDecommissioned(original) {
+		return
+	}
+	name := lo.FromPtr(updated.Metadata.Name)
+	revoked, err := h.revokeDeviceCertificates(ctx, orgId, name, domain.CertificateRevocationReasonCessationOfOperation)
+	if err != nil {
+		h.log.Errorf("Failed to revoke certificates of decommissioned device %s/%s: %v", orgId, name, err)
+		return
+	}
+	h.log.Infof("Revoked %d certificates of decommissioned device %s/%s", len(revoked), orgId, name)
+}
+
+func isDecommissioned(device *domain.Device) bool {
+	return device != nil && device.Status != nil && device.Status.Lifecycle.Status == domain.DeviceLifecycleStatusDecommissioned
+}
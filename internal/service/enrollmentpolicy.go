@@ -0,0 +1,329 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/config/ca"
+	"github.com/flightctl/flightctl/internal/consts"
+	"github.com/flightctl/flightctl/internal/crypto/signer"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/service/common"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/store/selector"
+	"github.com/flightctl/flightctl/internal/tpm"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+func (h *ServiceHandler) CreateEnrollmentPolicy(ctx context.Context, orgId uuid.UUID, ep domain.EnrollmentPolicy) (*domain.EnrollmentPolicy, domain.Status) {
+	// don't set fields that are managed by the service
+	NilOutManagedObjectMetaProperties(&ep.Metadata)
+
+	if errs := ep.Validate(); len(errs) > 0 {
+		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
+	}
+
+	result, err := h.store.EnrollmentPolicy().Create(ctx, orgId, &ep, h.callbackEnrollmentPolicyUpdated)
+	return result, StoreErrorToApiStatus(err, true, domain.EnrollmentPolicyKind, ep.Metadata.Name)
+}
+
+func (h *ServiceHandler) ListEnrollmentPolicies(ctx context.Context, orgId uuid.UUID, params domain.ListEnrollmentPoliciesParams) (*domain.EnrollmentPolicyList, domain.Status) {
+	listParams, status := prepareListParams(params.Continue, params.LabelSelector, params.FieldSelector, params.Limit)
+	if status != domain.StatusOK() {
+		return nil, status
+	}
+
+	result, err := h.store.EnrollmentPolicy().List(ctx, orgId, *listParams)
+	if err == nil {
+		return result, domain.StatusOK()
+	}
+
+	var se *selector.SelectorError
+
+	switch {
+	case selector.AsSelectorError(err, &se):
+		return nil, domain.StatusBadRequest(se.Error())
+	default:
+		return nil, domain.StatusInternalServerError(err.Error())
+	}
+}
+
+func (h *ServiceHandler) GetEnrollmentPolicy(ctx context.Context, orgId uuid.UUID, name string) (*domain.EnrollmentPolicy, domain.Status) {
+	result, err := h.store.EnrollmentPolicy().Get(ctx, orgId, name)
+	return result, StoreErrorToApiStatus(err, false, domain.EnrollmentPolicyKind, &name)
+}
+
+func (h *ServiceHandler) ReplaceEnrollmentPolicy(ctx context.Context, orgId uuid.UUID, name string, ep domain.EnrollmentPolicy) (*domain.EnrollmentPolicy, domain.Status) {
+	// don't overwrite fields that are managed by the service
+	if !IsInternalRequest(ctx) {
+		NilOutManagedObjectMetaProperties(&ep.Metadata)
+	}
+	if errs := ep.Validate(); len(errs) > 0 {
+		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
+	}
+	if name != *ep.Metadata.Name {
+		return nil, domain.StatusBadRequest("resource name specified in metadata does not match name in path")
+	}
+
+	result, created, err := h.store.EnrollmentPolicy().CreateOrUpdate(ctx, orgId, &ep, h.callbackEnrollmentPolicyUpdated)
+	return result, StoreErrorToApiStatus(err, created, domain.EnrollmentPolicyKind, &name)
+}
+
+func (h *ServiceHandler) DeleteEnrollmentPolicy(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	err := h.store.EnrollmentPolicy().Delete(ctx, orgId, name, h.callbackEnrollmentPolicyDeleted)
+	return StoreErrorToApiStatus(err, false, domain.EnrollmentPolicyKind, &name)
+}
+
+// Only metadata.labels and spec can be patched. If we try to patch other fields, HTTP 400 Bad Request is returned.
+func (h *ServiceHandler) PatchEnrollmentPolicy(ctx context.Context, orgId uuid.UUID, name string, patch domain.PatchRequest) (*domain.EnrollmentPolicy, domain.Status) {
+	currentObj, err := h.store.EnrollmentPolicy().Get(ctx, orgId, name)
+	if err != nil {
+		return nil, StoreErrorToApiStatus(err, false, domain.EnrollmentPolicyKind, &name)
+	}
+
+	newObj := &domain.EnrollmentPolicy{}
+	err = ApplyJSONPatch(ctx, currentObj, newObj, patch, "/enrollmentpolicies/"+name)
+	if err != nil {
+		return nil, domain.StatusBadRequest(err.Error())
+	}
+
+	if errs := newObj.Validate(); len(errs) > 0 {
+		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
+	}
+	if errs := currentObj.ValidateUpdate(newObj); len(errs) > 0 {
+		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
+	}
+
+	NilOutManagedObjectMetaProperties(&newObj.Metadata)
+	newObj.Metadata.ResourceVersion = nil
+	result, err := h.store.EnrollmentPolicy().Update(ctx, orgId, newObj, h.callbackEnrollmentPolicyUpdated)
+	return result, StoreErrorToApiStatus(err, false, domain.EnrollmentPolicyKind, &name)
+}
+
+// callbackEnrollmentPolicyUpdated is the enrollment policy-specific callback that handles enrollment policy events
+func (h *ServiceHandler) callbackEnrollmentPolicyUpdated(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	h.eventHandler.HandleEnrollmentPolicyUpdatedEvents(ctx, resourceKind, orgId, name, oldResource, newResource, created, err)
+}
+
+// callbackEnrollmentPolicyDeleted is the enrollment policy-specific callback that handles enrollment policy deletion events
+func (h *ServiceHandler) callbackEnrollmentPolicyDeleted(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	h.eventHandler.HandleGenericResourceDeletedEvents(ctx, resourceKind, orgId, name, oldResource, newResource, created, err)
+}
+
+const (
+	enrollmentRequestAutoApprovedReason = "AutoApproved"
+	enrollmentPolicyListPageSize        = 1000
+)
+
+// enrollmentRequestFacts are the properties of an enrollment request that enrollment policies match against.
+type enrollmentRequestFacts struct {
+	tpmVerified           bool
+	enrollmentCertificate string
+	productSerial         string
+	productUUID           string
+	systemInfo            domain.DeviceSystemInfo
+}
+
+func newEnrollmentRequestFacts(er *domain.EnrollmentRequest) enrollmentRequestFacts {
+	facts := enrollmentRequestFacts{
+		tpmVerified:           er.Status != nil && domain.IsStatusConditionTrue(er.Status.Conditions, domain.ConditionTypeEnrollmentRequestTPMVerified),
+		enrollmentCertificate: lo.FromPtr(er.Metadata.Annotations)[domain.EnrollmentRequestAnnotationEnrollmentCertificate],
+	}
+	if er.Spec.DeviceStatus != nil {
+		facts.systemInfo = er.Spec.DeviceStatus.SystemInfo
+	}
+	facts.productSerial = facts.systemInfo.AdditionalProperties[productSerialSystemInfoKey]
+	facts.productUUID = facts.systemInfo.AdditionalProperties[productUUIDSystemInfoKey]
+
+	// the serial number embedded in a TCG CSR comes from the TPM-backed identity and is preferred over the reported one
+	if csrBytes, isTPM := tpm.ParseTCGCSRBytes(er.Spec.Csr); isTPM {
+		if parsed, err := tpm.ParseTCGCSR(csrBytes); err == nil && len(parsed.CSRContents.Payload.ProdSerial) > 0 {
+			facts.productSerial = string(parsed.CSRContents.Payload.ProdSerial)
+		}
+	}
+	return facts
+}
+
+const (
+	productSerialSystemInfoKey = "productSerial"
+	productUUIDSystemInfoKey   = "productUuid"
+	customInfoSystemInfoPrefix = "customInfo."
+)
+
+// systemInfoField returns a field of the system information by the name it has in the API.
+// Custom information is addressed as customInfo.<name>.
+func systemInfoField(info domain.DeviceSystemInfo, key string) (string, bool) {
+	switch key {
+	case "agentVersion":
+		return info.AgentVersion, true
+	case "architecture":
+		return info.Architecture, true
+	case "bootID":
+		return info.BootID, true
+	case "operatingSystem":
+		return info.OperatingSystem, true
+	}
+	if name, ok := strings.CutPrefix(key, customInfoSystemInfoPrefix); ok {
+		value, found := lo.FromPtr(info.CustomInfo)[name]
+		return value, found
+	}
+	value, found := info.AdditionalProperties[key]
+	return value, found
+}
+
+// matchEnrollmentPolicy reports whether an enrollment request satisfies every rule of a policy.
+// If it does not, the returned string names the first rule that failed.
+func matchEnrollmentPolicy(cfg *ca.Config, match domain.EnrollmentPolicyMatch, facts enrollmentRequestFacts) (bool, string) {
+	if lo.FromPtr(match.TpmVerified) && !facts.tpmVerified {
+		return false, "TPM not verified"
+	}
+	if match.EnrollmentCertificates != nil {
+		if !lo.ContainsBy(*match.EnrollmentCertificates, func(name string) bool {
+			return facts.enrollmentCertificate != "" && signer.BootstrapCNFromName(cfg, name) == facts.enrollmentCertificate
+		}) {
+			return false, "enrollment certificate not allowed"
+		}
+	}
+	if match.AllowList != nil {
+		serialAllowed := facts.productSerial != "" && lo.Contains(lo.FromPtr(match.AllowList.ProductSerials), facts.productSerial)
+		uuidAllowed := facts.productUUID != "" && lo.Contains(lo.FromPtr(match.AllowList.ProductUuids), facts.productUUID)
+		if !serialAllowed && !uuidAllowed {
+			return false, "product serial and UUID not in allow list"
+		}
+	}
+	for key, expected := range lo.FromPtr(match.SystemInfo) {
+		if value, found := systemInfoField(facts.systemInfo, key); !found || value != expected {
+			return false, fmt.Sprintf("system info %s does not match", key)
+		}
+	}
+	return true, ""
+}
+
+// listEnrollmentPolicies returns all enrollment policies of an organization ordered by name.
+func (h *ServiceHandler) listEnrollmentPolicies(ctx context.Context, orgId uuid.UUID) ([]domain.EnrollmentPolicy, error) {
+	var policies []domain.EnrollmentPolicy
+	listParams := store.ListParams{Limit: enrollmentPolicyListPageSize}
+	for {
+		list, err := h.store.EnrollmentPolicy().List(ctx, orgId, listParams)
+		if err != nil {
+			return nil, fmt.Errorf("listing enrollment policies: %w", err)
+		}
+		policies = append(policies, list.Items...)
+		if list.Metadata.Continue == nil {
+			break
+		}
+		if listParams.Continue, err = store.ParseContinueString(list.Metadata.Continue); err != nil {
+			return nil, fmt.Errorf("parsing continue token: %w", err)
+		}
+	}
+	sort.Slice(policies, func(i, j int) bool {
+		return lo.FromPtr(policies[i].Metadata.Name) < lo.FromPtr(policies[j].Metadata.Name)
+	})
+	return policies, nil
+}
+
+// autoApproveEnrollmentRequest approves an enrollment request on behalf of the first enrollment policy, by name,
+// that it matches. It returns the approved enrollment request, or nil if no policy approved it.
+func (h *ServiceHandler) autoApproveEnrollmentRequest(ctx context.Context, orgId uuid.UUID, er *domain.EnrollmentRequest) *domain.EnrollmentRequest {
+	if er == nil || er.Status == nil || domain.IsStatusConditionTrue(er.Status.Conditions, domain.ConditionTypeEnrollmentRequestApproved) {
+		return nil
+	}
+	name := lo.FromPtr(er.Metadata.Name)
+
+	policies, err := h.listEnrollmentPolicies(ctx, orgId)
+	if err != nil {
+		h.log.Errorf("Failed to evaluate enrollment policies for enrollment request %s/%s: %v", orgId, name, err)
+		return nil
+	}
+	if len(policies) == 0 {
+		return nil
+	}
+
+	facts := newEnrollmentRequestFacts(er)
+	for i := range policies {
+		policy := &policies[i]
+		policyName := lo.FromPtr(policy.Metadata.Name)
+		matched, reason := matchEnrollmentPolicy(h.ca.Cfg, policy.Spec.Match, facts)
+		if !matched {
+			h.log.Debugf("Enrollment policy %s does not match enrollment request %s/%s: %s", policyName, orgId, name, reason)
+			continue
+		}
+		return h.approveEnrollmentRequestByPolicy(ctx, orgId, er, policy)
+	}
+	return nil
+}
+
+// approveEnrollmentRequestByPolicy approves an enrollment request with the labels and owner fleet of a policy.
+// The policy is recorded as the approver and as the actor of the resulting events.
+func (h *ServiceHandler) approveEnrollmentRequestByPolicy(ctx context.Context, orgId uuid.UUID, er *domain.EnrollmentRequest, policy *domain.EnrollmentPolicy) *domain.EnrollmentRequest {
+	name := lo.FromPtr(er.Metadata.Name)
+	approvedBy := fmt.Sprintf("%s:%s", strings.ToLower(domain.EnrollmentPolicyKind), lo.FromPtr(policy.Metadata.Name))
+	ctx = context.WithValue(ctx, consts.EventActorCtxKey, approvedBy)
+
+	labels := map[string]string{}
+	var owner *string
+	if policy.Spec.Approval != nil {
+		for k, v := range lo.FromPtr(policy.Spec.Approval.Labels) {
+			labels[k] = v
+		}
+		if fleetName := lo.FromPtr(policy.Spec.Approval.Fleet); fleetName != "" {
+			fleet, err := h.store.Fleet().Get(ctx, orgId, fleetName)
+			if err != nil {
+				status := StoreErrorToApiStatus(err, false, domain.FleetKind, &fleetName)
+				h.CreateEvent(ctx, orgId, common.GetEnrollmentRequestApprovalFailedEvent(ctx, name, status, h.log))
+				return nil
+			}
+			// the fleet's selector must keep matching the device, or fleet selection would remove the owner
+			if fleet.Spec.Selector != nil {
+				for k, v := range lo.FromPtr(fleet.Spec.Selector.MatchLabels) {
+					labels[k] = v
+				}
+			}
+			owner = util.SetResourceOwner(domain.FleetKind, fleetName)
+		}
+	}
+
+	approval := domain.EnrollmentRequestApprovalStatus{
+		Approved:   true,
+		Labels:     &labels,
+		ApprovedAt: time.Now(),
+		ApprovedBy: approvedBy,
+	}
+	if status := h.signAndEnrollDevice(ctx, orgId, er, &approval, enrollmentRequestAutoApprovedReason, owner); status.Code != http.StatusOK {
+		h.log.Errorf("Enrollment policy %s failed to approve enrollment request %s/%s: %s", lo.FromPtr(policy.Metadata.Name), orgId, name, status.Message)
+		return nil
+	}
+
+	result, err := h.store.EnrollmentRequest().UpdateStatus(ctx, orgId, er, h.callbackEnrollmentRequestApproved)
+	if err != nil {
+		h.log.Errorf("Failed to record approval of enrollment request %s/%s by enrollment policy %s: %v", orgId, name, lo.FromPtr(policy.Metadata.Name), err)
+		return nil
+	}
+	h.log.Infof("Enrollment request %s/%s approved by enrollment policy %s", orgId, name, lo.FromPtr(policy.Metadata.Name))
+	return result
+}
+
+// recordEnrollmentCertificate records on an enrollment request the enrollment certificate it is submitted with,
+// so that enrollment policies can match it after the request was created.
+func (h *ServiceHandler) recordEnrollmentCertificate(ctx context.Context, er *domain.EnrollmentRequest) {
+	annotations := lo.FromPtr(er.Metadata.Annotations)
+	delete(annotations, domain.EnrollmentRequestAnnotationEnrollmentCertificate)
+
+	peerSigner := h.ca.PeerCertificateSignerFromCtx(ctx)
+	if peerSigner != nil && peerSigner.Name() == h.ca.Cfg.DeviceEnrollmentSignerName {
+		if peerCertificate, err := h.ca.PeerCertificateFromCtx(ctx); err == nil {
+			annotations = util.EnsureMap(annotations)
+			annotations[domain.EnrollmentRequestAnnotationEnrollmentCertificate] = peerCertificate.Subject.CommonName
+		}
+	}
+	if annotations != nil {
+		er.Metadata.Annotations = &annotations
+	}
+}
@@ -0,0 +1,153 @@
+package service
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/config/ca"
+	"github.com/flightctl/flightctl/internal/crypto"
+	"github.com/flightctl/flightctl/internal/crypto/signer"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchEnrollmentPolicy(t *testing.T) {
+	cfg := ca.NewDefault(t.TempDir())
+	facts := enrollmentRequestFacts{
+		tpmVerified:           true,
+		enrollmentCertificate: signer.BootstrapCNFromName(cfg, "line-1"),
+		productSerial:         "SN1",
+		productUUID:           "uuid-1",
+		systemInfo: domain.DeviceSystemInfo{
+			Architecture:         "amd64",
+			CustomInfo:           &domain.CustomDeviceInfo{"site": "factory-1"},
+			AdditionalProperties: map[string]string{"productName": "gateway"},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		match   domain.EnrollmentPolicyMatch
+		facts   enrollmentRequestFacts
+		matched bool
+	}{
+		{name: "all rules match", matched: true, facts: facts, match: domain.EnrollmentPolicyMatch{
+			TpmVerified:            lo.ToPtr(true),
+			EnrollmentCertificates: &[]string{"line-2", "line-1"},
+			AllowList:              &domain.EnrollmentPolicyAllowList{ProductSerials: &[]string{"SN1"}},
+			SystemInfo:             &map[string]string{"architecture": "amd64", "productName": "gateway", "customInfo.site": "factory-1"},
+		}},
+		{name: "TPM not verified", facts: enrollmentRequestFacts{}, match: domain.EnrollmentPolicyMatch{TpmVerified: lo.ToPtr(true)}},
+		{name: "other enrollment certificate", facts: facts, match: domain.EnrollmentPolicyMatch{EnrollmentCertificates: &[]string{"line-2"}}},
+		{name: "no enrollment certificate", facts: enrollmentRequestFacts{}, match: domain.EnrollmentPolicyMatch{EnrollmentCertificates: &[]string{"line-1"}}},
+		{name: "UUID allowed", matched: true, facts: facts, match: domain.EnrollmentPolicyMatch{AllowList: &domain.EnrollmentPolicyAllowList{ProductUuids: &[]string{"uuid-1"}}}},
+		{name: "not in allow list", facts: facts, match: domain.EnrollmentPolicyMatch{AllowList: &domain.EnrollmentPolicyAllowList{ProductSerials: &[]string{"SN2"}, ProductUuids: &[]string{"uuid-2"}}}},
+		{name: "empty serial is not allowed", facts: enrollmentRequestFacts{}, match: domain.EnrollmentPolicyMatch{AllowList: &domain.EnrollmentPolicyAllowList{ProductSerials: &[]string{""}}}},
+		{name: "system info differs", facts: facts, match: domain.EnrollmentPolicyMatch{SystemInfo: &map[string]string{"architecture": "arm64"}}},
+		{name: "system info not reported", facts: facts, match: domain.EnrollmentPolicyMatch{SystemInfo: &map[string]string{"productSerial": ""}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matched, reason := matchEnrollmentPolicy(cfg, tt.match, tt.facts)
+			require.Equal(t, tt.matched, matched)
+			if !matched {
+				require.NotEmpty(t, reason)
+			}
+		})
+	}
+}
+
+func createTestEnrollmentRequestCSR(t *testing.T, name string) string {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	csrTemplate := x509.CertificateRequest{
+		Subject:            pkix.Name{CommonName: name},
+		SignatureAlgorithm: x509.ECDSAWithSHA256,
+	}
+	csrBytes, err := x509.CreateCertificateRequest(rand.Reader, &csrTemplate, privateKey)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csrBytes}))
+}
+
+func TestCreateEnrollmentRequestApprovedByPolicy(t *testing.T) {
+	require := require.New(t)
+	caClient, _, err := crypto.EnsureCA(ca.NewDefault(t.TempDir()))
+	require.NoError(err)
+	testStore := &TestStore{}
+	serviceHandler, ctx := newTestServiceHandler(t, testStore, caClient)
+	orgId := store.NullOrgId
+
+	_, err = testStore.Fleet().Create(ctx, orgId, &domain.Fleet{
+		Metadata: domain.ObjectMeta{Name: lo.ToPtr("gateways")},
+		Spec: domain.FleetSpec{
+			Selector: &domain.LabelSelector{MatchLabels: &map[string]string{"fleet": "gateways"}},
+		},
+	}, nil)
+	require.NoError(err)
+	_, err = testStore.EnrollmentPolicy().Create(ctx, orgId, &domain.EnrollmentPolicy{
+		Metadata: domain.ObjectMeta{Name: lo.ToPtr("factory")},
+		Spec: domain.EnrollmentPolicySpec{
+			Match: domain.EnrollmentPolicyMatch{SystemInfo: &map[string]string{"architecture": "amd64"}},
+			Approval: &domain.EnrollmentPolicyApproval{
+				Labels: &map[string]string{"site": "factory-1"},
+				Fleet:  lo.ToPtr("gateways"),
+			},
+		},
+	}, nil)
+	require.NoError(err)
+
+	newEnrollmentRequest := func(name, architecture string) domain.EnrollmentRequest {
+		deviceStatus := domain.NewDeviceStatus()
+		deviceStatus.SystemInfo.Architecture = architecture
+		return domain.EnrollmentRequest{
+			ApiVersion: "v1beta1",
+			Kind:       domain.EnrollmentRequestKind,
+			Metadata:   domain.ObjectMeta{Name: lo.ToPtr(name)},
+			Spec: domain.EnrollmentRequestSpec{
+				Csr:          createTestEnrollmentRequestCSR(t, name),
+				DeviceStatus: &deviceStatus,
+				Labels:       &map[string]string{"site": "requested", "model": "x1"},
+			},
+		}
+	}
+
+	t.Run("matching request is approved", func(t *testing.T) {
+		name := "matching-device-fingerprint"
+		er, status := serviceHandler.CreateEnrollmentRequest(ctx, orgId, newEnrollmentRequest(name, "amd64"))
+		require.Equal(domain.StatusCreated(), status)
+		require.True(domain.IsStatusConditionTrue(er.Status.Conditions, domain.ConditionTypeEnrollmentRequestApproved))
+		require.NotNil(er.Status.Certificate)
+		require.Equal("enrollmentpolicy:factory", er.Status.Approval.ApprovedBy)
+
+		device, err := testStore.Device().Get(ctx, orgId, name)
+		require.NoError(err)
+		require.Equal(util.SetResourceOwner(domain.FleetKind, "gateways"), device.Metadata.Owner)
+		require.Equal(map[string]string{"site": "factory-1", "fleet": "gateways", "model": "x1"}, lo.FromPtr(device.Metadata.Labels))
+
+		events, err := testStore.Event().List(ctx, orgId, store.ListParams{})
+		require.NoError(err)
+		approved := lo.Filter(events.Items, func(e domain.Event, _ int) bool {
+			return e.Reason == domain.EventReasonEnrollmentRequestApproved && e.InvolvedObject.Name == name
+		})
+		require.Len(approved, 1)
+		require.Equal("enrollmentpolicy:factory", approved[0].Actor)
+	})
+
+	t.Run("request that does not match waits for approval", func(t *testing.T) {
+		name := "other-device-fingerprint"
+		er, status := serviceHandler.CreateEnrollmentRequest(ctx, orgId, newEnrollmentRequest(name, "arm64"))
+		require.Equal(domain.StatusCreated(), status)
+		require.False(domain.IsStatusConditionTrue(er.Status.Conditions, domain.ConditionTypeEnrollmentRequestApproved))
+
+		_, err := testStore.Device().Get(ctx, orgId, name)
+		require.Error(err)
+	})
+}
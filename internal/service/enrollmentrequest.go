@@ -6,6 +6,7 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"net/http"
 	"strings"
 	"time"
 
@@ -25,6 +26,8 @@ import (
 	"github.com/samber/lo"
 )
 
+const enrollmentRequestManuallyApprovedReason = "ManuallyApproved"
+
 // getTPMCAPool loads the TPM CA certificates from configured paths
 func (h *ServiceHandler) getTPMCAPool() *x509.CertPool {
 	if len(h.tpmCAPaths) == 0 {
@@ -64,7 +67,7 @@ func (h *ServiceHandler) verifyTPMEnrollmentRequest(er *domain.EnrollmentRequest
 	return nil
 }
 
-func approveAndSignEnrollmentRequest(ctx context.Context, ca *crypto.CAClient, enrollmentRequest *domain.EnrollmentRequest, approval *domain.EnrollmentRequestApprovalStatus) error {
+func approveAndSignEnrollmentRequest(ctx context.Context, ca *crypto.CAClient, enrollmentRequest *domain.EnrollmentRequest, approval *domain.EnrollmentRequestApprovalStatus, reason string) error {
 	if enrollmentRequest == nil {
 		return errors.New("approveAndSignEnrollmentRequest: enrollmentRequest is nil")
 	}
@@ -104,7 +107,7 @@ func approveAndSignEnrollmentRequest(ctx context.Context, ca *crypto.CAClient, e
 	condition := domain.Condition{
 		Type:    domain.ConditionTypeEnrollmentRequestApproved,
 		Status:  domain.ConditionStatusTrue,
-		Reason:  "ManuallyApproved",
+		Reason:  reason,
 		Message: "Approved by " + approval.ApprovedBy,
 	}
 	domain.SetStatusCondition(&enrollmentRequest.Status.Conditions, condition)
@@ -120,7 +123,7 @@ func addStatusIfNeeded(enrollmentRequest *domain.EnrollmentRequest) {
 	}
 }
 
-func (h *ServiceHandler) createDeviceFromEnrollmentRequest(ctx context.Context, orgId uuid.UUID, enrollmentRequest *domain.EnrollmentRequest) error {
+func (h *ServiceHandler) createDeviceFromEnrollmentRequest(ctx context.Context, orgId uuid.UUID, enrollmentRequest *domain.EnrollmentRequest, owner *string) error {
 	deviceStatus := domain.NewDeviceStatus()
 	deviceStatus.Lifecycle = domain.DeviceLifecycleStatus{Status: "Enrolled"}
 
@@ -208,7 +211,8 @@ func (h *ServiceHandler) createDeviceFromEnrollmentRequest(ctx context.Context,
 	name := lo.FromPtr(enrollmentRequest.Metadata.Name)
 	apiResource := &domain.Device{
 		Metadata: domain.ObjectMeta{
-			Name: &name,
+			Name:  &name,
+			Owner: owner,
 		},
 		Status: &deviceStatus,
 	}
@@ -294,6 +298,7 @@ func (h *ServiceHandler) CreateEnrollmentRequest(ctx context.Context, orgId uuid
 			return nil, domain.StatusBadRequest(err.Error())
 		}
 	}
+	h.recordEnrollmentCertificate(ctx, &er)
 	if _, isAgent := ctx.Value(consts.AgentCtxKey).(string); isAgent {
 		if h.agentGate.Acquire(ctx, 1) == nil {
 			defer h.agentGate.Release(1)
@@ -302,7 +307,13 @@ func (h *ServiceHandler) CreateEnrollmentRequest(ctx context.Context, orgId uuid
 
 	// Use fromAPI=false for internal requests to preserve annotations
 	result, err := h.store.EnrollmentRequest().CreateWithFromAPI(ctx, orgId, &er, false, h.callbackEnrollmentRequestUpdated)
-	return result, StoreErrorToApiStatus(err, true, domain.EnrollmentRequestKind, er.Metadata.Name)
+	if err != nil {
+		return result, StoreErrorToApiStatus(err, true, domain.EnrollmentRequestKind, er.Metadata.Name)
+	}
+	if approved := h.autoApproveEnrollmentRequest(ctx, orgId, result); approved != nil {
+		result = approved
+	}
+	return result, domain.StatusCreated()
 }
 
 func (h *ServiceHandler) ListEnrollmentRequests(ctx context.Context, orgId uuid.UUID, params domain.ListEnrollmentRequestsParams) (*domain.EnrollmentRequestList, domain.Status) {
@@ -466,17 +477,7 @@ func (h *ServiceHandler) ApproveEnrollmentRequest(ctx context.Context, orgId uui
 		}
 		approvalStatusToReturn = &approvalStatus
 
-		err = approveAndSignEnrollmentRequest(ctx, h.ca, enrollmentReq, &approvalStatus)
-		if err != nil {
-			status := domain.StatusBadRequest(fmt.Sprintf("Error approving and signing enrollment request: %v", err.Error()))
-			h.CreateEvent(ctx, orgId, common.GetEnrollmentRequestApprovalFailedEvent(ctx, name, status, h.log))
-			return nil, status
-		}
-
-		// in case of error we return 500 as it will be caused by creating device in db and not by problem with enrollment request
-		if err := h.createDeviceFromEnrollmentRequest(ctx, orgId, enrollmentReq); err != nil {
-			status := domain.StatusInternalServerError(fmt.Sprintf("error creating device from enrollment request: %v", err))
-			h.CreateEvent(ctx, orgId, common.GetEnrollmentRequestApprovalFailedEvent(ctx, name, status, h.log))
+		if status := h.signAndEnrollDevice(ctx, orgId, enrollmentReq, &approvalStatus, enrollmentRequestManuallyApprovedReason, nil); status.Code != http.StatusOK {
 			return nil, status
 		}
 	}
@@ -486,11 +487,39 @@ func (h *ServiceHandler) ApproveEnrollmentRequest(ctx context.Context, orgId uui
 	return approvalStatusToReturn, StoreErrorToApiStatus(err, false, domain.EnrollmentRequestKind, &name)
 }
 
+// signAndEnrollDevice signs the certificate of an enrollment request that is being approved and creates its device.
+// Failures are reported with an approval failed event.
+func (h *ServiceHandler) signAndEnrollDevice(ctx context.Context, orgId uuid.UUID, enrollmentReq *domain.EnrollmentRequest, approval *domain.EnrollmentRequestApprovalStatus, reason string, owner *string) domain.Status {
+	name := lo.FromPtr(enrollmentReq.Metadata.Name)
+	if err := approveAndSignEnrollmentRequest(ctx, h.ca, enrollmentReq, approval, reason); err != nil {
+		status := domain.StatusBadRequest(fmt.Sprintf("Error approving and signing enrollment request: %v", err.Error()))
+		h.CreateEvent(ctx, orgId, common.GetEnrollmentRequestApprovalFailedEvent(ctx, name, status, h.log))
+		return status
+	}
+
+	// in case of error we return 500 as it will be caused by creating device in db and not by problem with enrollment request
+	if err := h.createDeviceFromEnrollmentRequest(ctx, orgId, enrollmentReq, owner); err != nil {
+		status := domain.StatusInternalServerError(fmt.Sprintf("error creating device from enrollment request: %v", err))
+		h.CreateEvent(ctx, orgId, common.GetEnrollmentRequestApprovalFailedEvent(ctx, name, status, h.log))
+		return status
+	}
+	return domain.StatusOK()
+}
+
 func (h *ServiceHandler) ReplaceEnrollmentRequestStatus(ctx context.Context, orgId uuid.UUID, name string, er domain.EnrollmentRequest) (*domain.EnrollmentRequest, domain.Status) {
 	addStatusIfNeeded(&er)
 
 	result, err := h.store.EnrollmentRequest().UpdateStatus(ctx, orgId, &er, h.callbackEnrollmentRequestUpdated)
-	return result, StoreErrorToApiStatus(err, false, domain.EnrollmentRequestKind, &name)
+	if err != nil {
+		return result, StoreErrorToApiStatus(err, false, domain.EnrollmentRequestKind, &name)
+	}
+	// a request waiting for the TPM challenge is evaluated again once the challenge succeeds
+	if result.Status != nil && domain.IsStatusConditionTrue(result.Status.Conditions, domain.ConditionTypeEnrollmentRequestTPMVerified) {
+		if approved := h.autoApproveEnrollmentRequest(ctx, orgId, result); approved != nil {
+			result = approved
+		}
+	}
+	return result, domain.StatusOK()
 }
 
 func newSignRequestFromEnrollment(cfg *ca.Config, er *domain.EnrollmentRequest) (signer.SignRequest, bool, error) {
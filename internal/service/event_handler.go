@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"maps"
 	"reflect"
 
 	"github.com/flightctl/flightctl/internal/consts"
@@ -20,6 +21,7 @@ type EventHandler struct {
 	store        store.Store
 	workerClient worker_client.WorkerClient
 	log          logrus.FieldLogger
+	watch        *watchPublisher
 }
 
 // NewEventHandler creates a new EventHandler instance
@@ -47,6 +49,8 @@ func (h *EventHandler) CreateEvent(ctx context.Context, orgId uuid.UUID, event *
 	if h.workerClient != nil {
 		h.workerClient.EmitEvent(ctx, orgId, event)
 	}
+
+	h.watch.publish(ctx, orgId, domain.EventKind, domain.WatchEventAdded, event, nil)
 }
 
 //////////////////////////////////////////////////////
@@ -54,12 +58,13 @@ func (h *EventHandler) CreateEvent(ctx context.Context, orgId uuid.UUID, event *
 //////////////////////////////////////////////////////
 
 // HandleGenericResourceDeletedEvents handles generic resource deletion event emission logic
-func (h *EventHandler) HandleGenericResourceDeletedEvents(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, _, _ interface{}, created bool, err error) {
+func (h *EventHandler) HandleGenericResourceDeletedEvents(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, _ interface{}, created bool, err error) {
 	if err != nil {
 		status := StoreErrorToApiStatus(err, created, string(resourceKind), &name)
 		h.CreateEvent(ctx, orgId, common.GetResourceDeletedFailureEvent(ctx, resourceKind, name, status))
 	} else {
 		h.CreateEvent(ctx, orgId, common.GetResourceDeletedSuccessEvent(ctx, resourceKind, name))
+		h.watch.publishDeleted(ctx, orgId, resourceKind, name, watchDeletedLabels(oldResource))
 	}
 }
 
@@ -81,6 +86,13 @@ func (h *EventHandler) HandleDeviceUpdatedEvents(ctx context.Context, resourceKi
 	if oldDevice, newDevice, ok = castResources[domain.Device](oldResource, newResource); !ok {
 		return
 	}
+	if newDevice != nil {
+		var oldLabels *map[string]string
+		if oldDevice != nil {
+			oldLabels = watchOldLabels(&oldDevice.Metadata)
+		}
+		h.watch.publish(ctx, orgId, domain.DeviceKind, lo.Ternary(created, domain.WatchEventAdded, domain.WatchEventModified), newDevice, oldLabels)
+	}
 
 	// Only generate status change events when the device is not being created
 	if !created {
@@ -106,6 +118,17 @@ func (h *EventHandler) HandleDeviceUpdatedEvents(ctx context.Context, resourceKi
 		h.CreateEvent(ctx, orgId, common.GetResourceCreatedOrUpdatedSuccessEvent(ctx, true, domain.DeviceKind, name, nil, h.log, nil))
 	} else {
 		updateDetails := h.computeResourceUpdatedDetails(oldDevice.Metadata, newDevice.Metadata)
+		// Variables are referenced by fleet template parameters, so report them separately from the rest of the spec
+		if updateDetails != nil && lo.Contains(updateDetails.UpdatedFields, domain.Spec) && !maps.Equal(deviceVariables(oldDevice), deviceVariables(newDevice)) {
+			updateDetails.UpdatedFields = append(updateDetails.UpdatedFields, domain.SpecVariables)
+		}
+		// The system info is referenced by fleet template parameters as well, which only matters for devices owned by a fleet
+		if lo.FromPtr(newDevice.Metadata.Owner) != "" && systemInfoChanged(oldDevice, newDevice) {
+			if updateDetails == nil {
+				updateDetails = &domain.ResourceUpdatedDetails{UpdatedFields: []domain.ResourceUpdatedDetailsUpdatedFields{}}
+			}
+			updateDetails.UpdatedFields = append(updateDetails.UpdatedFields, domain.SystemInfo)
+		}
 		// Generate ResourceUpdated event if there are spec changes or status changes
 		if updateDetails != nil {
 			annotations := map[string]string{}
@@ -120,12 +143,19 @@ func (h *EventHandler) HandleDeviceUpdatedEvents(ctx context.Context, resourceKi
 }
 
 // HandleDeviceDecommissionEvents handles device decommission event emission logic
-func (h *EventHandler) HandleDeviceDecommissionEvents(ctx context.Context, _ domain.ResourceKind, orgId uuid.UUID, name string, _, _ interface{}, created bool, err error) {
+func (h *EventHandler) HandleDeviceDecommissionEvents(ctx context.Context, _ domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
 	if err != nil {
 		status := StoreErrorToApiStatus(err, created, domain.DeviceKind, &name)
 		h.CreateEvent(ctx, orgId, common.GetDeviceDecommissionedFailureEvent(ctx, created, domain.DeviceKind, name, status))
 	} else {
 		h.CreateEvent(ctx, orgId, common.GetDeviceDecommissionedSuccessEvent(ctx, created, domain.DeviceKind, name, nil, nil))
+		if oldDevice, newDevice, ok := castResources[domain.Device](oldResource, newResource); ok && newDevice != nil {
+			var oldLabels *map[string]string
+			if oldDevice != nil {
+				oldLabels = watchOldLabels(&oldDevice.Metadata)
+			}
+			h.watch.publish(ctx, orgId, domain.DeviceKind, domain.WatchEventModified, newDevice, oldLabels)
+		}
 	}
 }
 
@@ -178,6 +208,13 @@ func (h *EventHandler) HandleFleetUpdatedEvents(ctx context.Context, resourceKin
 			}
 		}
 		event = common.GetResourceCreatedOrUpdatedSuccessEvent(ctx, created, domain.FleetKind, name, updateDetails, h.log, nil)
+		if newFleet != nil {
+			var oldLabels *map[string]string
+			if oldFleet != nil {
+				oldLabels = watchOldLabels(&oldFleet.Metadata)
+			}
+			h.watch.publish(ctx, orgId, domain.FleetKind, lo.Ternary(created, domain.WatchEventAdded, domain.WatchEventModified), newFleet, oldLabels)
+		}
 	}
 
 	// Emit a created/updated event (if nil, no event is emitted)
@@ -201,6 +238,7 @@ func (h *EventHandler) HandleFleetUpdatedEvents(ctx context.Context, resourceKin
 	h.emitFleetRolloutBatchCompletedEvent(ctx, orgId, name, deployingTemplateVersion, oldFleet, newFleet)
 	h.emitFleetRolloutCompletedEvent(ctx, orgId, name, deployingTemplateVersion, oldFleet, newFleet)
 	h.emitFleetRolloutFailedEvent(ctx, orgId, name, deployingTemplateVersion, oldFleet, newFleet)
+	h.emitFleetRolloutRolledBackEvent(ctx, orgId, name, oldFleet, newFleet)
 }
 
 func (h *EventHandler) emitFleetRolloutNewEvent(ctx context.Context, orgId uuid.UUID, name string, deployingTemplateVersion string, oldFleet, newFleet *domain.Fleet) {
@@ -305,6 +343,26 @@ func (h *EventHandler) emitFleetRolloutFailedEvent(ctx context.Context, orgId uu
 	h.CreateEvent(ctx, orgId, common.GetFleetRolloutFailedEvent(ctx, name, deployingTemplateVersion, newCondition.Message))
 }
 
+func (h *EventHandler) emitFleetRolloutRolledBackEvent(ctx context.Context, orgId uuid.UUID, name string, oldFleet, newFleet *domain.Fleet) {
+	if newFleet == nil || newFleet.Status == nil || newFleet.Status.Rollout == nil || newFleet.Status.Rollout.Rollback == nil {
+		return
+	}
+	newCondition := domain.FindStatusCondition(newFleet.Status.Conditions, domain.ConditionTypeFleetRolloutInProgress)
+	if newCondition == nil || newCondition.Reason != domain.RolloutRolledBackReason {
+		return
+	}
+	var oldConditions []domain.Condition
+	if oldFleet != nil && oldFleet.Status != nil {
+		oldConditions = oldFleet.Status.Conditions
+	}
+	oldCondition := domain.FindStatusCondition(oldConditions, domain.ConditionTypeFleetRolloutInProgress)
+	if oldCondition != nil && oldCondition.Reason == domain.RolloutRolledBackReason {
+		return
+	}
+
+	h.CreateEvent(ctx, orgId, common.GetFleetRolloutRolledBackEvent(ctx, name, newFleet.Status.Rollout.Rollback))
+}
+
 //////////////////////////////////////////////////////
 //                    Repository Events             //
 //////////////////////////////////////////////////////
@@ -536,6 +594,90 @@ func (h *EventHandler) HandleCatalogUpdatedEvents(ctx context.Context, resourceK
 	}
 }
 
+// HandleReferenceMeasurementUpdatedEvents handles reference measurement update event emission logic
+func (h *EventHandler) HandleReferenceMeasurementUpdatedEvents(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	if err != nil {
+		status := StoreErrorToApiStatus(err, created, string(resourceKind), &name)
+		h.CreateEvent(ctx, orgId, common.GetResourceCreatedOrUpdatedFailureEvent(ctx, created, resourceKind, name, status, nil))
+	} else {
+		// Compute ResourceUpdatedDetails for updates
+		var updateDetails *domain.ResourceUpdatedDetails
+		if !created {
+			var (
+				oldReferenceMeasurement, newReferenceMeasurement *domain.ReferenceMeasurement
+				ok                                               bool
+			)
+			if oldReferenceMeasurement, newReferenceMeasurement, ok = castResources[domain.ReferenceMeasurement](oldResource, newResource); ok && oldReferenceMeasurement != nil && newReferenceMeasurement != nil {
+				updateDetails = h.computeResourceUpdatedDetails(oldReferenceMeasurement.Metadata, newReferenceMeasurement.Metadata)
+			}
+		}
+		h.CreateEvent(ctx, orgId, common.GetResourceCreatedOrUpdatedSuccessEvent(ctx, created, resourceKind, name, updateDetails, h.log, nil))
+	}
+}
+
+// HandleEnrollmentPolicyUpdatedEvents handles enrollment policy update event emission logic
+func (h *EventHandler) HandleEnrollmentPolicyUpdatedEvents(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	if err != nil {
+		status := StoreErrorToApiStatus(err, created, string(resourceKind), &name)
+		h.CreateEvent(ctx, orgId, common.GetResourceCreatedOrUpdatedFailureEvent(ctx, created, resourceKind, name, status, nil))
+	} else {
+		// Compute ResourceUpdatedDetails for updates
+		var updateDetails *domain.ResourceUpdatedDetails
+		if !created {
+			var (
+				oldEnrollmentPolicy, newEnrollmentPolicy *domain.EnrollmentPolicy
+				ok                                       bool
+			)
+			if oldEnrollmentPolicy, newEnrollmentPolicy, ok = castResources[domain.EnrollmentPolicy](oldResource, newResource); ok && oldEnrollmentPolicy != nil && newEnrollmentPolicy != nil {
+				updateDetails = h.computeResourceUpdatedDetails(oldEnrollmentPolicy.Metadata, newEnrollmentPolicy.Metadata)
+			}
+		}
+		h.CreateEvent(ctx, orgId, common.GetResourceCreatedOrUpdatedSuccessEvent(ctx, created, resourceKind, name, updateDetails, h.log, nil))
+	}
+}
+
+// HandleSecretUpdatedEvents handles secret update event emission logic
+func (h *EventHandler) HandleSecretUpdatedEvents(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	if err != nil {
+		status := StoreErrorToApiStatus(err, created, string(resourceKind), &name)
+		h.CreateEvent(ctx, orgId, common.GetResourceCreatedOrUpdatedFailureEvent(ctx, created, resourceKind, name, status, nil))
+	} else {
+		// Compute ResourceUpdatedDetails for updates
+		var updateDetails *domain.ResourceUpdatedDetails
+		if !created {
+			var (
+				oldSecret, newSecret *domain.Secret
+				ok                   bool
+			)
+			if oldSecret, newSecret, ok = castResources[domain.Secret](oldResource, newResource); ok && oldSecret != nil && newSecret != nil {
+				updateDetails = h.computeResourceUpdatedDetails(oldSecret.Metadata, newSecret.Metadata)
+			}
+		}
+		h.CreateEvent(ctx, orgId, common.GetResourceCreatedOrUpdatedSuccessEvent(ctx, created, resourceKind, name, updateDetails, h.log, nil))
+	}
+}
+
+// HandleTrustPolicyUpdatedEvents handles trust policy update event emission logic
+func (h *EventHandler) HandleTrustPolicyUpdatedEvents(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	if err != nil {
+		status := StoreErrorToApiStatus(err, created, string(resourceKind), &name)
+		h.CreateEvent(ctx, orgId, common.GetResourceCreatedOrUpdatedFailureEvent(ctx, created, resourceKind, name, status, nil))
+	} else {
+		// Compute ResourceUpdatedDetails for updates
+		var updateDetails *domain.ResourceUpdatedDetails
+		if !created {
+			var (
+				oldTrustPolicy, newTrustPolicy *domain.TrustPolicy
+				ok                             bool
+			)
+			if oldTrustPolicy, newTrustPolicy, ok = castResources[domain.TrustPolicy](oldResource, newResource); ok && oldTrustPolicy != nil && newTrustPolicy != nil {
+				updateDetails = h.computeResourceUpdatedDetails(oldTrustPolicy.Metadata, newTrustPolicy.Metadata)
+			}
+		}
+		h.CreateEvent(ctx, orgId, common.GetResourceCreatedOrUpdatedSuccessEvent(ctx, created, resourceKind, name, updateDetails, h.log, nil))
+	}
+}
+
 func (h *EventHandler) emitResourceSyncConditionEvents(ctx context.Context, orgId uuid.UUID, name string, oldResourceSync, newResourceSync *domain.ResourceSync) {
 	if oldResourceSync == nil || newResourceSync == nil {
 		return
@@ -599,10 +741,12 @@ func (h *EventHandler) emitResourceSyncConditionEvents(ctx context.Context, orgI
 		if domain.IsStatusConditionTrue(newConditions, domain.ConditionTypeResourceSyncSynced) {
 			h.CreateEvent(ctx, orgId, common.GetResourceSyncSyncedEvent(ctx, name))
 		} else {
-			// Only emit failure event if it's an actual failure, not just "NewHashDetected"
+			// Only emit failure event if it's an actual failure, not just "NewHashDetected" or "DryRun"
 			// "NewHashDetected" is a normal state change, not a failure
 			// The commit detected event is already emitted when the hash changes
-			if newSynced != nil && newSynced.Reason != domain.ResourceSyncNewHashDetectedReason {
+			// "DryRun" means the changes were intentionally not applied
+			if newSynced != nil && newSynced.Reason != domain.ResourceSyncNewHashDetectedReason &&
+				newSynced.Reason != domain.ResourceSyncDryRunReason {
 				message := "Resource sync failed"
 				if newSynced.Message != "" {
 					message = newSynced.Message
@@ -653,6 +797,26 @@ func (h *EventHandler) computeResourceUpdatedDetails(oldMetadata, newMetadata do
 	return updateDetails
 }
 
+// deviceVariables returns the per-device variables of a device, or nil if it has none
+func deviceVariables(device *domain.Device) map[string]string {
+	if device == nil || device.Spec == nil {
+		return nil
+	}
+	return lo.FromPtr(device.Spec.Variables)
+}
+
+// systemInfoChanged returns true if the device reported different system info. Devices without a known
+// previous status are not considered changed.
+func systemInfoChanged(oldDevice, newDevice *domain.Device) bool {
+	if oldDevice == nil || newDevice == nil || oldDevice.Status == nil || newDevice.Status == nil {
+		return false
+	}
+	if oldDevice.Status.SystemInfo.IsEmpty() && newDevice.Status.SystemInfo.IsEmpty() {
+		return false
+	}
+	return !reflect.DeepEqual(oldDevice.Status.SystemInfo, newDevice.Status.SystemInfo)
+}
+
 // castResources safely casts both old and new interface{} resources to the specified type T
 // Returns ok=true only if both resources are either nil or successfully cast to *T
 func castResources[T any](oldResource, newResource interface{}) (oldTyped, newTyped *T, ok bool) {
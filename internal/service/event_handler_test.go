@@ -47,6 +47,12 @@ func (m *MockKVStore) Delete(ctx context.Context, key string) error
 func (m *MockKVStore) StreamAdd(ctx context.Context, key string, value []byte) (string, error) {
 	return "0-0", nil
 }
+func (m *MockKVStore) StreamAddWithMaxLen(ctx context.Context, key string, value []byte, maxLen int64) (string, error) {
+	return "0-0", nil
+}
+func (m *MockKVStore) StreamInfo(ctx context.Context, key string) (kvstore.StreamInfo, error) {
+	return kvstore.StreamInfo{}, nil
+}
 func (m *MockKVStore) StreamRange(ctx context.Context, key string, start, stop string) ([]kvstore.StreamEntry, error) {
 	return nil, nil
 }
@@ -260,6 +266,35 @@ func TestEventHandler_HandleDeviceUpdatedEmptyOldDevice(t *testing.T) {
 	assert.Equal(t, 1, len(events.Items))
 }
 
+func TestEventHandler_HandleDeviceSystemInfoUpdated(t *testing.T) {
+	require := require.New(t)
+	serviceHandler := serviceHandler()
+	ctx := context.Background()
+	testOrgId := uuid.New()
+
+	oldDevice := prepareDevice(testOrgId, "foo")
+	oldDevice.Metadata.Owner = lo.ToPtr("Fleet/myfleet")
+	oldDevice.Status.SystemInfo = domain.DeviceSystemInfo{Architecture: "amd64", OperatingSystem: "linux"}
+	newDevice := prepareDevice(testOrgId, "foo")
+	newDevice.Metadata.Owner = lo.ToPtr("Fleet/myfleet")
+	newDevice.Status.SystemInfo = domain.DeviceSystemInfo{Architecture: "amd64", OperatingSystem: "linux", CustomInfo: &domain.CustomDeviceInfo{"zone": "eu-1"}}
+
+	// unchanged system info does not update the device
+	serviceHandler.eventHandler.HandleDeviceUpdatedEvents(ctx, domain.DeviceKind, testOrgId, "foo", oldDevice, oldDevice, false, nil)
+	events, err := serviceHandler.store.Event().List(ctx, testOrgId, store.ListParams{})
+	require.NoError(err)
+	require.Empty(events.Items)
+
+	serviceHandler.eventHandler.HandleDeviceUpdatedEvents(ctx, domain.DeviceKind, testOrgId, "foo", oldDevice, newDevice, false, nil)
+	events, err = serviceHandler.store.Event().List(ctx, testOrgId, store.ListParams{})
+	require.NoError(err)
+	require.Len(events.Items, 1)
+	require.Equal(domain.EventReasonResourceUpdated, events.Items[0].Reason)
+	details, err := events.Items[0].Details.AsResourceUpdatedDetails()
+	require.NoError(err)
+	require.Equal([]domain.ResourceUpdatedDetailsUpdatedFields{domain.SystemInfo}, details.UpdatedFields)
+}
+
 func TestEventHandler_DeviceDisconnectedEventDeduplication(t *testing.T) {
 	serviceHandler := serviceHandler()
 
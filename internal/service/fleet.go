@@ -151,6 +151,11 @@ func (h *ServiceHandler) UpdateFleetAnnotations(ctx context.Context, orgId uuid.
 	return StoreErrorToApiStatus(err, false, domain.FleetKind, &name)
 }
 
+func (h *ServiceHandler) UpdateFleetRolloutStatus(ctx context.Context, orgId uuid.UUID, name string, rollout *domain.FleetRolloutStatus) domain.Status {
+	err := h.store.Fleet().UpdateRolloutStatus(ctx, orgId, name, rollout)
+	return StoreErrorToApiStatus(err, false, domain.FleetKind, &name)
+}
+
 func (h *ServiceHandler) OverwriteFleetRepositoryRefs(ctx context.Context, orgId uuid.UUID, name string, repositoryNames ...string) domain.Status {
 	err := h.store.Fleet().OverwriteRepositoryRefs(ctx, orgId, name, repositoryNames...)
 	return StoreErrorToApiStatus(err, false, domain.FleetKind, &name)
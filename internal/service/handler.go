@@ -23,8 +23,10 @@ type ServiceHandler struct {
 }
 
 func NewServiceHandler(store store.Store, workerClient worker_client.WorkerClient, kvStore kvstore.KVStore, ca *crypto.CAClient, log logrus.FieldLogger, agentEndpoint string, uiUrl string, tpmCAPaths []string) *ServiceHandler {
+	eventHandler := NewEventHandler(store, workerClient, log)
+	eventHandler.watch = newWatchPublisher(kvStore, log)
 	return &ServiceHandler{
-		eventHandler:  NewEventHandler(store, workerClient, log),
+		eventHandler:  eventHandler,
 		store:         store,
 		ca:            ca,
 		log:           log,
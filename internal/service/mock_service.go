@@ -164,6 +164,36 @@ func (mr *MockServiceMockRecorder) CreateDevice(ctx, orgId, device any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDevice", reflect.TypeOf((*MockService)(nil).CreateDevice), ctx, orgId, device)
 }
 
+// CreateDeviceAttestationChallenge mocks base method.
+func (m *MockService) CreateDeviceAttestationChallenge(ctx context.Context, orgId uuid.UUID, name string) (*domain.AttestationChallenge, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDeviceAttestationChallenge", ctx, orgId, name)
+	ret0, _ := ret[0].(*domain.AttestationChallenge)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// CreateDeviceAttestationChallenge indicates an expected call of CreateDeviceAttestationChallenge.
+func (mr *MockServiceMockRecorder) CreateDeviceAttestationChallenge(ctx, orgId, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDeviceAttestationChallenge", reflect.TypeOf((*MockService)(nil).CreateDeviceAttestationChallenge), ctx, orgId, name)
+}
+
+// CreateEnrollmentPolicy mocks base method.
+func (m *MockService) CreateEnrollmentPolicy(ctx context.Context, orgId uuid.UUID, ep domain.EnrollmentPolicy) (*domain.EnrollmentPolicy, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEnrollmentPolicy", ctx, orgId, ep)
+	ret0, _ := ret[0].(*domain.EnrollmentPolicy)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// CreateEnrollmentPolicy indicates an expected call of CreateEnrollmentPolicy.
+func (mr *MockServiceMockRecorder) CreateEnrollmentPolicy(ctx, orgId, ep any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEnrollmentPolicy", reflect.TypeOf((*MockService)(nil).CreateEnrollmentPolicy), ctx, orgId, ep)
+}
+
 // CreateEnrollmentRequest mocks base method.
 func (m *MockService) CreateEnrollmentRequest(ctx context.Context, orgId uuid.UUID, er domain.EnrollmentRequest) (*domain.EnrollmentRequest, domain.Status) {
 	m.ctrl.T.Helper()
@@ -206,6 +236,21 @@ func (mr *MockServiceMockRecorder) CreateFleet(ctx, orgId, fleet any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFleet", reflect.TypeOf((*MockService)(nil).CreateFleet), ctx, orgId, fleet)
 }
 
+// CreateReferenceMeasurement mocks base method.
+func (m *MockService) CreateReferenceMeasurement(ctx context.Context, orgId uuid.UUID, rm domain.ReferenceMeasurement) (*domain.ReferenceMeasurement, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReferenceMeasurement", ctx, orgId, rm)
+	ret0, _ := ret[0].(*domain.ReferenceMeasurement)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// CreateReferenceMeasurement indicates an expected call of CreateReferenceMeasurement.
+func (mr *MockServiceMockRecorder) CreateReferenceMeasurement(ctx, orgId, rm any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReferenceMeasurement", reflect.TypeOf((*MockService)(nil).CreateReferenceMeasurement), ctx, orgId, rm)
+}
+
 // CreateRepository mocks base method.
 func (m *MockService) CreateRepository(ctx context.Context, orgId uuid.UUID, repo domain.Repository) (*domain.Repository, domain.Status) {
 	m.ctrl.T.Helper()
@@ -236,6 +281,21 @@ func (mr *MockServiceMockRecorder) CreateResourceSync(ctx, orgId, rs any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateResourceSync", reflect.TypeOf((*MockService)(nil).CreateResourceSync), ctx, orgId, rs)
 }
 
+// CreateSecret mocks base method.
+func (m *MockService) CreateSecret(ctx context.Context, orgId uuid.UUID, secret domain.Secret) (*domain.Secret, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSecret", ctx, orgId, secret)
+	ret0, _ := ret[0].(*domain.Secret)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// CreateSecret indicates an expected call of CreateSecret.
+func (mr *MockServiceMockRecorder) CreateSecret(ctx, orgId, secret any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSecret", reflect.TypeOf((*MockService)(nil).CreateSecret), ctx, orgId, secret)
+}
+
 // CreateTemplateVersion mocks base method.
 func (m *MockService) CreateTemplateVersion(ctx context.Context, orgId uuid.UUID, tv domain.TemplateVersion, immediateRollout bool) (*domain.TemplateVersion, domain.Status) {
 	m.ctrl.T.Helper()
@@ -251,6 +311,21 @@ func (mr *MockServiceMockRecorder) CreateTemplateVersion(ctx, orgId, tv, immedia
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTemplateVersion", reflect.TypeOf((*MockService)(nil).CreateTemplateVersion), ctx, orgId, tv, immediateRollout)
 }
 
+// CreateTrustPolicy mocks base method.
+func (m *MockService) CreateTrustPolicy(ctx context.Context, orgId uuid.UUID, trustPolicy domain.TrustPolicy) (*domain.TrustPolicy, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTrustPolicy", ctx, orgId, trustPolicy)
+	ret0, _ := ret[0].(*domain.TrustPolicy)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// CreateTrustPolicy indicates an expected call of CreateTrustPolicy.
+func (mr *MockServiceMockRecorder) CreateTrustPolicy(ctx, orgId, trustPolicy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTrustPolicy", reflect.TypeOf((*MockService)(nil).CreateTrustPolicy), ctx, orgId, trustPolicy)
+}
+
 // DecommissionDevice mocks base method.
 func (m *MockService) DecommissionDevice(ctx context.Context, orgId uuid.UUID, name string, decom domain.DeviceDecommission) (*domain.Device, domain.Status) {
 	m.ctrl.T.Helper()
@@ -336,6 +411,20 @@ func (mr *MockServiceMockRecorder) DeleteDevice(ctx, orgId, name any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDevice", reflect.TypeOf((*MockService)(nil).DeleteDevice), ctx, orgId, name)
 }
 
+// DeleteEnrollmentPolicy mocks base method.
+func (m *MockService) DeleteEnrollmentPolicy(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEnrollmentPolicy", ctx, orgId, name)
+	ret0, _ := ret[0].(domain.Status)
+	return ret0
+}
+
+// DeleteEnrollmentPolicy indicates an expected call of DeleteEnrollmentPolicy.
+func (mr *MockServiceMockRecorder) DeleteEnrollmentPolicy(ctx, orgId, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEnrollmentPolicy", reflect.TypeOf((*MockService)(nil).DeleteEnrollmentPolicy), ctx, orgId, name)
+}
+
 // DeleteEnrollmentRequest mocks base method.
 func (m *MockService) DeleteEnrollmentRequest(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
 	m.ctrl.T.Helper()
@@ -379,6 +468,20 @@ func (mr *MockServiceMockRecorder) DeleteFleet(ctx, orgId, name any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFleet", reflect.TypeOf((*MockService)(nil).DeleteFleet), ctx, orgId, name)
 }
 
+// DeleteReferenceMeasurement mocks base method.
+func (m *MockService) DeleteReferenceMeasurement(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReferenceMeasurement", ctx, orgId, name)
+	ret0, _ := ret[0].(domain.Status)
+	return ret0
+}
+
+// DeleteReferenceMeasurement indicates an expected call of DeleteReferenceMeasurement.
+func (mr *MockServiceMockRecorder) DeleteReferenceMeasurement(ctx, orgId, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReferenceMeasurement", reflect.TypeOf((*MockService)(nil).DeleteReferenceMeasurement), ctx, orgId, name)
+}
+
 // DeleteRepository mocks base method.
 func (m *MockService) DeleteRepository(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
 	m.ctrl.T.Helper()
@@ -407,6 +510,20 @@ func (mr *MockServiceMockRecorder) DeleteResourceSync(ctx, orgId, name any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteResourceSync", reflect.TypeOf((*MockService)(nil).DeleteResourceSync), ctx, orgId, name)
 }
 
+// DeleteSecret mocks base method.
+func (m *MockService) DeleteSecret(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSecret", ctx, orgId, name)
+	ret0, _ := ret[0].(domain.Status)
+	return ret0
+}
+
+// DeleteSecret indicates an expected call of DeleteSecret.
+func (mr *MockServiceMockRecorder) DeleteSecret(ctx, orgId, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecret", reflect.TypeOf((*MockService)(nil).DeleteSecret), ctx, orgId, name)
+}
+
 // DeleteTemplateVersion mocks base method.
 func (m *MockService) DeleteTemplateVersion(ctx context.Context, orgId uuid.UUID, fleet, name string) domain.Status {
 	m.ctrl.T.Helper()
@@ -421,6 +538,20 @@ func (mr *MockServiceMockRecorder) DeleteTemplateVersion(ctx, orgId, fleet, name
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTemplateVersion", reflect.TypeOf((*MockService)(nil).DeleteTemplateVersion), ctx, orgId, fleet, name)
 }
 
+// DeleteTrustPolicy mocks base method.
+func (m *MockService) DeleteTrustPolicy(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTrustPolicy", ctx, orgId, name)
+	ret0, _ := ret[0].(domain.Status)
+	return ret0
+}
+
+// DeleteTrustPolicy indicates an expected call of DeleteTrustPolicy.
+func (mr *MockServiceMockRecorder) DeleteTrustPolicy(ctx, orgId, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTrustPolicy", reflect.TypeOf((*MockService)(nil).DeleteTrustPolicy), ctx, orgId, name)
+}
+
 // GetAuthConfig mocks base method.
 func (m *MockService) GetAuthConfig(ctx context.Context, authConfig *domain.AuthConfig) (*domain.AuthConfig, domain.Status) {
 	m.ctrl.T.Helper()
@@ -676,6 +807,21 @@ func (mr *MockServiceMockRecorder) GetEnrollmentConfig(ctx, orgId, params any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEnrollmentConfig", reflect.TypeOf((*MockService)(nil).GetEnrollmentConfig), ctx, orgId, params)
 }
 
+// GetEnrollmentPolicy mocks base method.
+func (m *MockService) GetEnrollmentPolicy(ctx context.Context, orgId uuid.UUID, name string) (*domain.EnrollmentPolicy, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEnrollmentPolicy", ctx, orgId, name)
+	ret0, _ := ret[0].(*domain.EnrollmentPolicy)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// GetEnrollmentPolicy indicates an expected call of GetEnrollmentPolicy.
+func (mr *MockServiceMockRecorder) GetEnrollmentPolicy(ctx, orgId, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEnrollmentPolicy", reflect.TypeOf((*MockService)(nil).GetEnrollmentPolicy), ctx, orgId, name)
+}
+
 // GetEnrollmentRequest mocks base method.
 func (m *MockService) GetEnrollmentRequest(ctx context.Context, orgId uuid.UUID, name string) (*domain.EnrollmentRequest, domain.Status) {
 	m.ctrl.T.Helper()
@@ -766,6 +912,21 @@ func (mr *MockServiceMockRecorder) GetLatestTemplateVersion(ctx, orgId, fleet an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestTemplateVersion", reflect.TypeOf((*MockService)(nil).GetLatestTemplateVersion), ctx, orgId, fleet)
 }
 
+// GetReferenceMeasurement mocks base method.
+func (m *MockService) GetReferenceMeasurement(ctx context.Context, orgId uuid.UUID, name string) (*domain.ReferenceMeasurement, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReferenceMeasurement", ctx, orgId, name)
+	ret0, _ := ret[0].(*domain.ReferenceMeasurement)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// GetReferenceMeasurement indicates an expected call of GetReferenceMeasurement.
+func (mr *MockServiceMockRecorder) GetReferenceMeasurement(ctx, orgId, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReferenceMeasurement", reflect.TypeOf((*MockService)(nil).GetReferenceMeasurement), ctx, orgId, name)
+}
+
 // GetRenderedDevice mocks base method.
 func (m *MockService) GetRenderedDevice(ctx context.Context, orgId uuid.UUID, name string, params domain.GetRenderedDeviceParams) (*domain.Device, domain.Status) {
 	m.ctrl.T.Helper()
@@ -841,6 +1002,36 @@ func (mr *MockServiceMockRecorder) GetResourceSync(ctx, orgId, name any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResourceSync", reflect.TypeOf((*MockService)(nil).GetResourceSync), ctx, orgId, name)
 }
 
+// GetResourceSyncPreview mocks base method.
+func (m *MockService) GetResourceSyncPreview(ctx context.Context, orgId uuid.UUID, name, requestId string) (*domain.ResourceSyncPreview, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetResourceSyncPreview", ctx, orgId, name, requestId)
+	ret0, _ := ret[0].(*domain.ResourceSyncPreview)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// GetResourceSyncPreview indicates an expected call of GetResourceSyncPreview.
+func (mr *MockServiceMockRecorder) GetResourceSyncPreview(ctx, orgId, name, requestId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResourceSyncPreview", reflect.TypeOf((*MockService)(nil).GetResourceSyncPreview), ctx, orgId, name, requestId)
+}
+
+// GetSecret mocks base method.
+func (m *MockService) GetSecret(ctx context.Context, orgId uuid.UUID, name string) (*domain.Secret, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecret", ctx, orgId, name)
+	ret0, _ := ret[0].(*domain.Secret)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// GetSecret indicates an expected call of GetSecret.
+func (mr *MockServiceMockRecorder) GetSecret(ctx, orgId, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecret", reflect.TypeOf((*MockService)(nil).GetSecret), ctx, orgId, name)
+}
+
 // GetTemplateVersion mocks base method.
 func (m *MockService) GetTemplateVersion(ctx context.Context, orgId uuid.UUID, fleet, name string) (*domain.TemplateVersion, domain.Status) {
 	m.ctrl.T.Helper()
@@ -856,6 +1047,21 @@ func (mr *MockServiceMockRecorder) GetTemplateVersion(ctx, orgId, fleet, name an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplateVersion", reflect.TypeOf((*MockService)(nil).GetTemplateVersion), ctx, orgId, fleet, name)
 }
 
+// GetTrustPolicy mocks base method.
+func (m *MockService) GetTrustPolicy(ctx context.Context, orgId uuid.UUID, name string) (*domain.TrustPolicy, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrustPolicy", ctx, orgId, name)
+	ret0, _ := ret[0].(*domain.TrustPolicy)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// GetTrustPolicy indicates an expected call of GetTrustPolicy.
+func (mr *MockServiceMockRecorder) GetTrustPolicy(ctx, orgId, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrustPolicy", reflect.TypeOf((*MockService)(nil).GetTrustPolicy), ctx, orgId, name)
+}
+
 // ListAllAuthProviders mocks base method.
 func (m *MockService) ListAllAuthProviders(ctx context.Context, params domain.ListAuthProvidersParams) (*domain.AuthProviderList, domain.Status) {
 	m.ctrl.T.Helper()
@@ -1006,6 +1212,21 @@ func (mr *MockServiceMockRecorder) ListDisruptionBudgetFleets(ctx, orgId any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDisruptionBudgetFleets", reflect.TypeOf((*MockService)(nil).ListDisruptionBudgetFleets), ctx, orgId)
 }
 
+// ListEnrollmentPolicies mocks base method.
+func (m *MockService) ListEnrollmentPolicies(ctx context.Context, orgId uuid.UUID, params domain.ListEnrollmentPoliciesParams) (*domain.EnrollmentPolicyList, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEnrollmentPolicies", ctx, orgId, params)
+	ret0, _ := ret[0].(*domain.EnrollmentPolicyList)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// ListEnrollmentPolicies indicates an expected call of ListEnrollmentPolicies.
+func (mr *MockServiceMockRecorder) ListEnrollmentPolicies(ctx, orgId, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEnrollmentPolicies", reflect.TypeOf((*MockService)(nil).ListEnrollmentPolicies), ctx, orgId, params)
+}
+
 // ListEnrollmentRequests mocks base method.
 func (m *MockService) ListEnrollmentRequests(ctx context.Context, orgId uuid.UUID, params domain.ListEnrollmentRequestsParams) (*domain.EnrollmentRequestList, domain.Status) {
 	m.ctrl.T.Helper()
@@ -1096,6 +1317,21 @@ func (mr *MockServiceMockRecorder) ListOrganizations(ctx, params any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrganizations", reflect.TypeOf((*MockService)(nil).ListOrganizations), ctx, params)
 }
 
+// ListReferenceMeasurements mocks base method.
+func (m *MockService) ListReferenceMeasurements(ctx context.Context, orgId uuid.UUID, params domain.ListReferenceMeasurementsParams) (*domain.ReferenceMeasurementList, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReferenceMeasurements", ctx, orgId, params)
+	ret0, _ := ret[0].(*domain.ReferenceMeasurementList)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// ListReferenceMeasurements indicates an expected call of ListReferenceMeasurements.
+func (mr *MockServiceMockRecorder) ListReferenceMeasurements(ctx, orgId, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReferenceMeasurements", reflect.TypeOf((*MockService)(nil).ListReferenceMeasurements), ctx, orgId, params)
+}
+
 // ListRepositories mocks base method.
 func (m *MockService) ListRepositories(ctx context.Context, orgId uuid.UUID, params domain.ListRepositoriesParams) (*domain.RepositoryList, domain.Status) {
 	m.ctrl.T.Helper()
@@ -1126,6 +1362,21 @@ func (mr *MockServiceMockRecorder) ListResourceSyncs(ctx, orgId, params any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResourceSyncs", reflect.TypeOf((*MockService)(nil).ListResourceSyncs), ctx, orgId, params)
 }
 
+// ListSecrets mocks base method.
+func (m *MockService) ListSecrets(ctx context.Context, orgId uuid.UUID, params domain.ListSecretsParams) (*domain.SecretList, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSecrets", ctx, orgId, params)
+	ret0, _ := ret[0].(*domain.SecretList)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// ListSecrets indicates an expected call of ListSecrets.
+func (mr *MockServiceMockRecorder) ListSecrets(ctx, orgId, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecrets", reflect.TypeOf((*MockService)(nil).ListSecrets), ctx, orgId, params)
+}
+
 // ListTemplateVersions mocks base method.
 func (m *MockService) ListTemplateVersions(ctx context.Context, orgId uuid.UUID, fleet string, params domain.ListTemplateVersionsParams) (*domain.TemplateVersionList, domain.Status) {
 	m.ctrl.T.Helper()
@@ -1141,6 +1392,21 @@ func (mr *MockServiceMockRecorder) ListTemplateVersions(ctx, orgId, fleet, param
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTemplateVersions", reflect.TypeOf((*MockService)(nil).ListTemplateVersions), ctx, orgId, fleet, params)
 }
 
+// ListTrustPolicies mocks base method.
+func (m *MockService) ListTrustPolicies(ctx context.Context, orgId uuid.UUID, params domain.ListTrustPoliciesParams) (*domain.TrustPolicyList, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTrustPolicies", ctx, orgId, params)
+	ret0, _ := ret[0].(*domain.TrustPolicyList)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// ListTrustPolicies indicates an expected call of ListTrustPolicies.
+func (mr *MockServiceMockRecorder) ListTrustPolicies(ctx, orgId, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrustPolicies", reflect.TypeOf((*MockService)(nil).ListTrustPolicies), ctx, orgId, params)
+}
+
 // MarkDevicesRolloutSelection mocks base method.
 func (m *MockService) MarkDevicesRolloutSelection(ctx context.Context, orgId uuid.UUID, params domain.ListDevicesParams, annotationSelector *selector.AnnotationSelector, limit *int) domain.Status {
 	m.ctrl.T.Helper()
@@ -1298,6 +1564,21 @@ func (mr *MockServiceMockRecorder) PatchDeviceStatus(ctx, orgId, name, patch any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchDeviceStatus", reflect.TypeOf((*MockService)(nil).PatchDeviceStatus), ctx, orgId, name, patch)
 }
 
+// PatchEnrollmentPolicy mocks base method.
+func (m *MockService) PatchEnrollmentPolicy(ctx context.Context, orgId uuid.UUID, name string, patch domain.PatchRequest) (*domain.EnrollmentPolicy, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PatchEnrollmentPolicy", ctx, orgId, name, patch)
+	ret0, _ := ret[0].(*domain.EnrollmentPolicy)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// PatchEnrollmentPolicy indicates an expected call of PatchEnrollmentPolicy.
+func (mr *MockServiceMockRecorder) PatchEnrollmentPolicy(ctx, orgId, name, patch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchEnrollmentPolicy", reflect.TypeOf((*MockService)(nil).PatchEnrollmentPolicy), ctx, orgId, name, patch)
+}
+
 // PatchEnrollmentRequest mocks base method.
 func (m *MockService) PatchEnrollmentRequest(ctx context.Context, orgId uuid.UUID, name string, patch domain.PatchRequest) (*domain.EnrollmentRequest, domain.Status) {
 	m.ctrl.T.Helper()
@@ -1328,6 +1609,21 @@ func (mr *MockServiceMockRecorder) PatchFleet(ctx, orgId, name, patch any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchFleet", reflect.TypeOf((*MockService)(nil).PatchFleet), ctx, orgId, name, patch)
 }
 
+// PatchReferenceMeasurement mocks base method.
+func (m *MockService) PatchReferenceMeasurement(ctx context.Context, orgId uuid.UUID, name string, patch domain.PatchRequest) (*domain.ReferenceMeasurement, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PatchReferenceMeasurement", ctx, orgId, name, patch)
+	ret0, _ := ret[0].(*domain.ReferenceMeasurement)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// PatchReferenceMeasurement indicates an expected call of PatchReferenceMeasurement.
+func (mr *MockServiceMockRecorder) PatchReferenceMeasurement(ctx, orgId, name, patch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchReferenceMeasurement", reflect.TypeOf((*MockService)(nil).PatchReferenceMeasurement), ctx, orgId, name, patch)
+}
+
 // PatchRepository mocks base method.
 func (m *MockService) PatchRepository(ctx context.Context, orgId uuid.UUID, name string, patch domain.PatchRequest) (*domain.Repository, domain.Status) {
 	m.ctrl.T.Helper()
@@ -1448,6 +1744,21 @@ func (mr *MockServiceMockRecorder) ReplaceDevice(ctx, orgId, name, device, field
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceDevice", reflect.TypeOf((*MockService)(nil).ReplaceDevice), ctx, orgId, name, device, fieldsToUnset)
 }
 
+// ReplaceDeviceAttestation mocks base method.
+func (m *MockService) ReplaceDeviceAttestation(ctx context.Context, orgId uuid.UUID, name string, report domain.AttestationReport) (*domain.DeviceIntegrityStatus, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceDeviceAttestation", ctx, orgId, name, report)
+	ret0, _ := ret[0].(*domain.DeviceIntegrityStatus)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// ReplaceDeviceAttestation indicates an expected call of ReplaceDeviceAttestation.
+func (mr *MockServiceMockRecorder) ReplaceDeviceAttestation(ctx, orgId, name, report any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceDeviceAttestation", reflect.TypeOf((*MockService)(nil).ReplaceDeviceAttestation), ctx, orgId, name, report)
+}
+
 // ReplaceDeviceStatus mocks base method.
 func (m *MockService) ReplaceDeviceStatus(ctx context.Context, orgId uuid.UUID, name string, device domain.Device) (*domain.Device, domain.Status) {
 	m.ctrl.T.Helper()
@@ -1463,6 +1774,21 @@ func (mr *MockServiceMockRecorder) ReplaceDeviceStatus(ctx, orgId, name, device
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceDeviceStatus", reflect.TypeOf((*MockService)(nil).ReplaceDeviceStatus), ctx, orgId, name, device)
 }
 
+// ReplaceEnrollmentPolicy mocks base method.
+func (m *MockService) ReplaceEnrollmentPolicy(ctx context.Context, orgId uuid.UUID, name string, ep domain.EnrollmentPolicy) (*domain.EnrollmentPolicy, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceEnrollmentPolicy", ctx, orgId, name, ep)
+	ret0, _ := ret[0].(*domain.EnrollmentPolicy)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// ReplaceEnrollmentPolicy indicates an expected call of ReplaceEnrollmentPolicy.
+func (mr *MockServiceMockRecorder) ReplaceEnrollmentPolicy(ctx, orgId, name, ep any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceEnrollmentPolicy", reflect.TypeOf((*MockService)(nil).ReplaceEnrollmentPolicy), ctx, orgId, name, ep)
+}
+
 // ReplaceEnrollmentRequest mocks base method.
 func (m *MockService) ReplaceEnrollmentRequest(ctx context.Context, orgId uuid.UUID, name string, er domain.EnrollmentRequest) (*domain.EnrollmentRequest, domain.Status) {
 	m.ctrl.T.Helper()
@@ -1523,6 +1849,21 @@ func (mr *MockServiceMockRecorder) ReplaceFleetStatus(ctx, orgId, name, fleet an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceFleetStatus", reflect.TypeOf((*MockService)(nil).ReplaceFleetStatus), ctx, orgId, name, fleet)
 }
 
+// ReplaceReferenceMeasurement mocks base method.
+func (m *MockService) ReplaceReferenceMeasurement(ctx context.Context, orgId uuid.UUID, name string, rm domain.ReferenceMeasurement) (*domain.ReferenceMeasurement, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceReferenceMeasurement", ctx, orgId, name, rm)
+	ret0, _ := ret[0].(*domain.ReferenceMeasurement)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// ReplaceReferenceMeasurement indicates an expected call of ReplaceReferenceMeasurement.
+func (mr *MockServiceMockRecorder) ReplaceReferenceMeasurement(ctx, orgId, name, rm any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceReferenceMeasurement", reflect.TypeOf((*MockService)(nil).ReplaceReferenceMeasurement), ctx, orgId, name, rm)
+}
+
 // ReplaceRepository mocks base method.
 func (m *MockService) ReplaceRepository(ctx context.Context, orgId uuid.UUID, name string, repo domain.Repository) (*domain.Repository, domain.Status) {
 	m.ctrl.T.Helper()
@@ -1583,6 +1924,51 @@ func (mr *MockServiceMockRecorder) ReplaceResourceSyncStatus(ctx, orgId, name, r
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceResourceSyncStatus", reflect.TypeOf((*MockService)(nil).ReplaceResourceSyncStatus), ctx, orgId, name, resourceSync)
 }
 
+// ReplaceSecret mocks base method.
+func (m *MockService) ReplaceSecret(ctx context.Context, orgId uuid.UUID, name string, secret domain.Secret) (*domain.Secret, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceSecret", ctx, orgId, name, secret)
+	ret0, _ := ret[0].(*domain.Secret)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// ReplaceSecret indicates an expected call of ReplaceSecret.
+func (mr *MockServiceMockRecorder) ReplaceSecret(ctx, orgId, name, secret any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceSecret", reflect.TypeOf((*MockService)(nil).ReplaceSecret), ctx, orgId, name, secret)
+}
+
+// ReplaceTrustPolicy mocks base method.
+func (m *MockService) ReplaceTrustPolicy(ctx context.Context, orgId uuid.UUID, name string, trustPolicy domain.TrustPolicy) (*domain.TrustPolicy, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceTrustPolicy", ctx, orgId, name, trustPolicy)
+	ret0, _ := ret[0].(*domain.TrustPolicy)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// ReplaceTrustPolicy indicates an expected call of ReplaceTrustPolicy.
+func (mr *MockServiceMockRecorder) ReplaceTrustPolicy(ctx, orgId, name, trustPolicy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceTrustPolicy", reflect.TypeOf((*MockService)(nil).ReplaceTrustPolicy), ctx, orgId, name, trustPolicy)
+}
+
+// RequestResourceSyncPreview mocks base method.
+func (m *MockService) RequestResourceSyncPreview(ctx context.Context, orgId uuid.UUID, name string, params domain.RequestResourceSyncPreviewParams) (*domain.ResourceSyncPreviewRequest, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestResourceSyncPreview", ctx, orgId, name, params)
+	ret0, _ := ret[0].(*domain.ResourceSyncPreviewRequest)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// RequestResourceSyncPreview indicates an expected call of RequestResourceSyncPreview.
+func (mr *MockServiceMockRecorder) RequestResourceSyncPreview(ctx, orgId, name, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestResourceSyncPreview", reflect.TypeOf((*MockService)(nil).RequestResourceSyncPreview), ctx, orgId, name, params)
+}
+
 // ResumeDevices mocks base method.
 func (m *MockService) ResumeDevices(ctx context.Context, orgId uuid.UUID, request domain.DeviceResumeRequest) (domain.DeviceResumeResponse, domain.Status) {
 	m.ctrl.T.Helper()
@@ -1598,6 +1984,21 @@ func (mr *MockServiceMockRecorder) ResumeDevices(ctx, orgId, request any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeDevices", reflect.TypeOf((*MockService)(nil).ResumeDevices), ctx, orgId, request)
 }
 
+// RevokeDeviceCertificates mocks base method.
+func (m *MockService) RevokeDeviceCertificates(ctx context.Context, orgId uuid.UUID, name string, revocation domain.DeviceRevocation) (*domain.DeviceRevocationResult, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeDeviceCertificates", ctx, orgId, name, revocation)
+	ret0, _ := ret[0].(*domain.DeviceRevocationResult)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// RevokeDeviceCertificates indicates an expected call of RevokeDeviceCertificates.
+func (mr *MockServiceMockRecorder) RevokeDeviceCertificates(ctx, orgId, name, revocation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeDeviceCertificates", reflect.TypeOf((*MockService)(nil).RevokeDeviceCertificates), ctx, orgId, name, revocation)
+}
+
 // SetCheckpoint mocks base method.
 func (m *MockService) SetCheckpoint(ctx context.Context, consumer, key string, value []byte) domain.Status {
 	m.ctrl.T.Helper()
@@ -1726,18 +2127,32 @@ func (mr *MockServiceMockRecorder) UpdateFleetConditions(ctx, orgId, name, condi
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFleetConditions", reflect.TypeOf((*MockService)(nil).UpdateFleetConditions), ctx, orgId, name, conditions)
 }
 
+// UpdateFleetRolloutStatus mocks base method.
+func (m *MockService) UpdateFleetRolloutStatus(ctx context.Context, orgId uuid.UUID, name string, rollout *domain.FleetRolloutStatus) domain.Status {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFleetRolloutStatus", ctx, orgId, name, rollout)
+	ret0, _ := ret[0].(domain.Status)
+	return ret0
+}
+
+// UpdateFleetRolloutStatus indicates an expected call of UpdateFleetRolloutStatus.
+func (mr *MockServiceMockRecorder) UpdateFleetRolloutStatus(ctx, orgId, name, rollout any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFleetRolloutStatus", reflect.TypeOf((*MockService)(nil).UpdateFleetRolloutStatus), ctx, orgId, name, rollout)
+}
+
 // UpdateRenderedDevice mocks base method.
-func (m *MockService) UpdateRenderedDevice(ctx context.Context, orgId uuid.UUID, name, renderedConfig, renderedApplications, specHash string) domain.Status {
+func (m *MockService) UpdateRenderedDevice(ctx context.Context, orgId uuid.UUID, name, renderedConfig, renderedApplications, renderedTrustPolicy, specHash string) domain.Status {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateRenderedDevice", ctx, orgId, name, renderedConfig, renderedApplications, specHash)
+	ret := m.ctrl.Call(m, "UpdateRenderedDevice", ctx, orgId, name, renderedConfig, renderedApplications, renderedTrustPolicy, specHash)
 	ret0, _ := ret[0].(domain.Status)
 	return ret0
 }
 
 // UpdateRenderedDevice indicates an expected call of UpdateRenderedDevice.
-func (mr *MockServiceMockRecorder) UpdateRenderedDevice(ctx, orgId, name, renderedConfig, renderedApplications, specHash any) *gomock.Call {
+func (mr *MockServiceMockRecorder) UpdateRenderedDevice(ctx, orgId, name, renderedConfig, renderedApplications, renderedTrustPolicy, specHash any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRenderedDevice", reflect.TypeOf((*MockService)(nil).UpdateRenderedDevice), ctx, orgId, name, renderedConfig, renderedApplications, specHash)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRenderedDevice", reflect.TypeOf((*MockService)(nil).UpdateRenderedDevice), ctx, orgId, name, renderedConfig, renderedApplications, renderedTrustPolicy, specHash)
 }
 
 // UpdateServerSideDeviceStatus mocks base method.
@@ -1767,3 +2182,48 @@ func (mr *MockServiceMockRecorder) UpdateServiceSideDeviceStatus(ctx, orgId, dev
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateServiceSideDeviceStatus", reflect.TypeOf((*MockService)(nil).UpdateServiceSideDeviceStatus), ctx, orgId, device)
 }
+
+// WatchDevices mocks base method.
+func (m *MockService) WatchDevices(ctx context.Context, orgId uuid.UUID, params domain.ListDevicesParams) (ResourceWatcher, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchDevices", ctx, orgId, params)
+	ret0, _ := ret[0].(ResourceWatcher)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// WatchDevices indicates an expected call of WatchDevices.
+func (mr *MockServiceMockRecorder) WatchDevices(ctx, orgId, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchDevices", reflect.TypeOf((*MockService)(nil).WatchDevices), ctx, orgId, params)
+}
+
+// WatchEvents mocks base method.
+func (m *MockService) WatchEvents(ctx context.Context, orgId uuid.UUID, params domain.ListEventsParams) (ResourceWatcher, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchEvents", ctx, orgId, params)
+	ret0, _ := ret[0].(ResourceWatcher)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// WatchEvents indicates an expected call of WatchEvents.
+func (mr *MockServiceMockRecorder) WatchEvents(ctx, orgId, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchEvents", reflect.TypeOf((*MockService)(nil).WatchEvents), ctx, orgId, params)
+}
+
+// WatchFleets mocks base method.
+func (m *MockService) WatchFleets(ctx context.Context, orgId uuid.UUID, params domain.ListFleetsParams) (ResourceWatcher, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchFleets", ctx, orgId, params)
+	ret0, _ := ret[0].(ResourceWatcher)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// WatchFleets indicates an expected call of WatchFleets.
+func (mr *MockServiceMockRecorder) WatchFleets(ctx, orgId, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchFleets", reflect.TypeOf((*MockService)(nil).WatchFleets), ctx, orgId, params)
+}
@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/store/selector"
+	"github.com/google/uuid"
+)
+
+func (h *ServiceHandler) CreateReferenceMeasurement(ctx context.Context, orgId uuid.UUID, rm domain.ReferenceMeasurement) (*domain.ReferenceMeasurement, domain.Status) {
+	// don't set fields that are managed by the service
+	NilOutManagedObjectMetaProperties(&rm.Metadata)
+
+	if errs := rm.Validate(); len(errs) > 0 {
+		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
+	}
+
+	result, err := h.store.ReferenceMeasurement().Create(ctx, orgId, &rm, h.callbackReferenceMeasurementUpdated)
+	return result, StoreErrorToApiStatus(err, true, domain.ReferenceMeasurementKind, rm.Metadata.Name)
+}
+
+func (h *ServiceHandler) ListReferenceMeasurements(ctx context.Context, orgId uuid.UUID, params domain.ListReferenceMeasurementsParams) (*domain.ReferenceMeasurementList, domain.Status) {
+	listParams, status := prepareListParams(params.Continue, params.LabelSelector, params.FieldSelector, params.Limit)
+	if status != domain.StatusOK() {
+		return nil, status
+	}
+
+	result, err := h.store.ReferenceMeasurement().List(ctx, orgId, *listParams)
+	if err == nil {
+		return result, domain.StatusOK()
+	}
+
+	var se *selector.SelectorError
+
+	switch {
+	case selector.AsSelectorError(err, &se):
+		return nil, domain.StatusBadRequest(se.Error())
+	default:
+		return nil, domain.StatusInternalServerError(err.Error())
+	}
+}
+
+func (h *ServiceHandler) GetReferenceMeasurement(ctx context.Context, orgId uuid.UUID, name string) (*domain.ReferenceMeasurement, domain.Status) {
+	result, err := h.store.ReferenceMeasurement().Get(ctx, orgId, name)
+	return result, StoreErrorToApiStatus(err, false, domain.ReferenceMeasurementKind, &name)
+}
+
+func (h *ServiceHandler) ReplaceReferenceMeasurement(ctx context.Context, orgId uuid.UUID, name string, rm domain.ReferenceMeasurement) (*domain.ReferenceMeasurement, domain.Status) {
+	// don't overwrite fields that are managed by the service
+	if !IsInternalRequest(ctx) {
+		NilOutManagedObjectMetaProperties(&rm.Metadata)
+	}
+	if errs := rm.Validate(); len(errs) > 0 {
+		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
+	}
+	if name != *rm.Metadata.Name {
+		return nil, domain.StatusBadRequest("resource name specified in metadata does not match name in path")
+	}
+
+	result, created, err := h.store.ReferenceMeasurement().CreateOrUpdate(ctx, orgId, &rm, h.callbackReferenceMeasurementUpdated)
+	return result, StoreErrorToApiStatus(err, created, domain.ReferenceMeasurementKind, &name)
+}
+
+func (h *ServiceHandler) DeleteReferenceMeasurement(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	err := h.store.ReferenceMeasurement().Delete(ctx, orgId, name, h.callbackReferenceMeasurementDeleted)
+	return StoreErrorToApiStatus(err, false, domain.ReferenceMeasurementKind, &name)
+}
+
+// Only metadata.labels and spec can be patched. If we try to patch other fields, HTTP 400 Bad Request is returned.
+func (h *ServiceHandler) PatchReferenceMeasurement(ctx context.Context, orgId uuid.UUID, name string, patch domain.PatchRequest) (*domain.ReferenceMeasurement, domain.Status) {
+	currentObj, err := h.store.ReferenceMeasurement().Get(ctx, orgId, name)
+	if err != nil {
+		return nil, StoreErrorToApiStatus(err, false, domain.ReferenceMeasurementKind, &name)
+	}
+
+	newObj := &domain.ReferenceMeasurement{}
+	err = ApplyJSONPatch(ctx, currentObj, newObj, patch, "/referencemeasurements/"+name)
+	if err != nil {
+		return nil, domain.StatusBadRequest(err.Error())
+	}
+
+	if errs := newObj.Validate(); len(errs) > 0 {
+		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
+	}
+	if errs := currentObj.ValidateUpdate(newObj); len(errs) > 0 {
+		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
+	}
+
+	NilOutManagedObjectMetaProperties(&newObj.Metadata)
+	newObj.Metadata.ResourceVersion = nil
+	result, err := h.store.ReferenceMeasurement().Update(ctx, orgId, newObj, h.callbackReferenceMeasurementUpdated)
+	return result, StoreErrorToApiStatus(err, false, domain.ReferenceMeasurementKind, &name)
+}
+
+// callbackReferenceMeasurementUpdated is the reference measurement-specific callback that handles reference measurement events
+func (h *ServiceHandler) callbackReferenceMeasurementUpdated(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	h.eventHandler.HandleReferenceMeasurementUpdatedEvents(ctx, resourceKind, orgId, name, oldResource, newResource, created, err)
+}
+
+// callbackReferenceMeasurementDeleted is the reference measurement-specific callback that handles reference measurement deletion events
+func (h *ServiceHandler) callbackReferenceMeasurementDeleted(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	h.eventHandler.HandleGenericResourceDeletedEvents(ctx, resourceKind, orgId, name, oldResource, newResource, created, err)
+}
@@ -2,10 +2,15 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 
 	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/kvstore"
+	"github.com/flightctl/flightctl/internal/service/common"
 	"github.com/flightctl/flightctl/internal/store/selector"
+	"github.com/flightctl/flightctl/internal/util/validation"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
@@ -120,6 +125,46 @@ func (h *ServiceHandler) ReplaceResourceSyncStatus(ctx context.Context, orgId uu
 	return result, StoreErrorToApiStatus(err, false, domain.ResourceSyncKind, &name)
 }
 
+// RequestResourceSyncPreview asks the workers to preview the ResourceSync at the requested revision. Previews clone
+// the repository, so they are computed by the resourcesync task, which stores them for GetResourceSyncPreview.
+func (h *ServiceHandler) RequestResourceSyncPreview(ctx context.Context, orgId uuid.UUID, name string, params domain.RequestResourceSyncPreviewParams) (*domain.ResourceSyncPreviewRequest, domain.Status) {
+	rs, err := h.store.ResourceSync().Get(ctx, orgId, name)
+	if err != nil {
+		return nil, StoreErrorToApiStatus(err, false, domain.ResourceSyncKind, &name)
+	}
+	revision := rs.Spec.TargetRevision
+	if params.Revision != nil {
+		if errs := validation.ValidateGitRevision(params.Revision, "revision"); len(errs) > 0 {
+			return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
+		}
+		revision = *params.Revision
+	}
+
+	request := &domain.ResourceSyncPreviewRequest{
+		RequestId: uuid.NewString(),
+		Revision:  revision,
+	}
+	h.CreateEvent(ctx, orgId, common.GetResourceSyncPreviewRequestedEvent(ctx, name, request.RequestId, request.Revision))
+	return request, domain.StatusCreated()
+}
+
+func (h *ServiceHandler) GetResourceSyncPreview(ctx context.Context, orgId uuid.UUID, name string, requestId string) (*domain.ResourceSyncPreview, domain.Status) {
+	key := kvstore.ResourceSyncPreviewKey{OrgID: orgId, ResourceSyncName: name, RequestID: requestId}
+	data, err := h.kvStore.Get(ctx, key.ComposeKey())
+	if err != nil {
+		return nil, domain.StatusInternalServerError(fmt.Sprintf("reading resourcesync preview: %v", err))
+	}
+	if data == nil {
+		return nil, domain.StatusResourceNotFound("ResourceSyncPreview", requestId)
+	}
+
+	var preview domain.ResourceSyncPreview
+	if err := json.Unmarshal(data, &preview); err != nil {
+		return nil, domain.StatusInternalServerError(fmt.Sprintf("parsing resourcesync preview: %v", err))
+	}
+	return &preview, domain.StatusOK()
+}
+
 // callbackResourceSyncUpdated is the resource sync-specific callback that handles resource sync events
 func (h *ServiceHandler) callbackResourceSyncUpdated(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
 	h.eventHandler.HandleResourceSyncUpdatedEvents(ctx, resourceKind, orgId, name, oldResource, newResource, created, err)
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/kvstore"
 	"github.com/flightctl/flightctl/internal/store"
 	"github.com/google/uuid"
 	"github.com/samber/lo"
@@ -268,3 +269,76 @@ func TestResourceSyncReplaceAllowsRepositoryUpdate(t *testing.T) {
 	require.Equal(statusSuccessCode, status.Code)
 	require.Equal("catalog-mixed-test-1774364233", replaced.Spec.Repository)
 }
+
+// previewKVStore returns the stored preview of a single request
+type previewKVStore struct {
+	MockKVStore
+	key  string
+	data []byte
+}
+
+func (m *previewKVStore) Get(ctx context.Context, key string) ([]byte, error) {
+	if key == m.key {
+		return m.data, nil
+	}
+	return nil, nil
+}
+
+func TestResourceSyncPreviewRequest(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	testOrgID := uuid.New()
+
+	testStore := &TestStore{}
+	kvStore := &previewKVStore{}
+	serviceHandler := ServiceHandler{
+		eventHandler: NewEventHandler(testStore, nil, logrus.New()),
+		store:        testStore,
+		workerClient: &DummyWorkerClient{},
+		kvStore:      kvStore,
+		log:          logrus.New(),
+	}
+
+	resourceSync := domain.ResourceSync{
+		ApiVersion: "v1beta1",
+		Kind:       "ResourceSync",
+		Metadata:   domain.ObjectMeta{Name: lo.ToPtr("foo")},
+		Spec: domain.ResourceSyncSpec{
+			Repository:     "repo",
+			TargetRevision: "main",
+			Path:           "/foo",
+		},
+	}
+	_, status := serviceHandler.CreateResourceSync(ctx, testOrgID, resourceSync)
+	require.Equal(statusCreatedCode, status.Code)
+
+	// the preview is requested from the workers rather than computed by the service
+	request, status := serviceHandler.RequestResourceSyncPreview(ctx, testOrgID, "foo", domain.RequestResourceSyncPreviewParams{Revision: lo.ToPtr("feature")})
+	require.Equal(statusCreatedCode, status.Code)
+	require.Equal("feature", request.Revision)
+	require.NotEmpty(request.RequestId)
+	events, err := serviceHandler.store.Event().List(ctx, testOrgID, store.ListParams{})
+	require.NoError(err)
+	requested := lo.Filter(events.Items, func(e domain.Event, _ int) bool {
+		return e.Reason == domain.EventReasonResourceSyncPreviewRequested
+	})
+	require.Len(requested, 1)
+	details, err := requested[0].Details.AsResourceSyncPreviewRequestedDetails()
+	require.NoError(err)
+	require.Equal(request.RequestId, details.RequestId)
+	require.Equal("feature", details.Revision)
+
+	// not found until a worker has stored the preview
+	_, status = serviceHandler.GetResourceSyncPreview(ctx, testOrgID, "foo", request.RequestId)
+	require.Equal(statusNotFoundCode, status.Code)
+
+	key := kvstore.ResourceSyncPreviewKey{OrgID: testOrgID, ResourceSyncName: "foo", RequestID: request.RequestId}
+	kvStore.key = key.ComposeKey()
+	kvStore.data = []byte(`{"revision":"feature","changes":[],"errors":[]}`)
+	preview, status := serviceHandler.GetResourceSyncPreview(ctx, testOrgID, "foo", request.RequestId)
+	require.Equal(statusSuccessCode, status.Code)
+	require.Equal("feature", preview.Revision)
+
+	_, status = serviceHandler.RequestResourceSyncPreview(ctx, testOrgID, "missing", domain.RequestResourceSyncPreviewParams{})
+	require.Equal(statusNotFoundCode, status.Code)
+}
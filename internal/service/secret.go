@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/store/selector"
+	"github.com/google/uuid"
+)
+
+func (h *ServiceHandler) CreateSecret(ctx context.Context, orgId uuid.UUID, secret domain.Secret) (*domain.Secret, domain.Status) {
+	// don't set fields that are managed by the service
+	NilOutManagedObjectMetaProperties(&secret.Metadata)
+
+	if errs := secret.Validate(); len(errs) > 0 {
+		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
+	}
+
+	result, err := h.store.Secret().Create(ctx, orgId, &secret, h.callbackSecretUpdated)
+	return result, StoreErrorToApiStatus(err, true, domain.SecretKind, secret.Metadata.Name)
+}
+
+func (h *ServiceHandler) ListSecrets(ctx context.Context, orgId uuid.UUID, params domain.ListSecretsParams) (*domain.SecretList, domain.Status) {
+	listParams, status := prepareListParams(params.Continue, params.LabelSelector, params.FieldSelector, params.Limit)
+	if status != domain.StatusOK() {
+		return nil, status
+	}
+
+	result, err := h.store.Secret().List(ctx, orgId, *listParams)
+	if err == nil {
+		return result, domain.StatusOK()
+	}
+
+	var se *selector.SelectorError
+
+	switch {
+	case selector.AsSelectorError(err, &se):
+		return nil, domain.StatusBadRequest(se.Error())
+	default:
+		return nil, domain.StatusInternalServerError(err.Error())
+	}
+}
+
+// GetSecret returns the secret with its data. The data is only redacted when the secret is marshalled, so callers
+// within the service can read it with SecureString.Value.
+func (h *ServiceHandler) GetSecret(ctx context.Context, orgId uuid.UUID, name string) (*domain.Secret, domain.Status) {
+	result, err := h.store.Secret().Get(ctx, orgId, name)
+	return result, StoreErrorToApiStatus(err, false, domain.SecretKind, &name)
+}
+
+func (h *ServiceHandler) ReplaceSecret(ctx context.Context, orgId uuid.UUID, name string, secret domain.Secret) (*domain.Secret, domain.Status) {
+	// don't overwrite fields that are managed by the service
+	if !IsInternalRequest(ctx) {
+		NilOutManagedObjectMetaProperties(&secret.Metadata)
+	}
+	if errs := secret.Validate(); len(errs) > 0 {
+		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
+	}
+	if name != *secret.Metadata.Name {
+		return nil, domain.StatusBadRequest("resource name specified in metadata does not match name in path")
+	}
+
+	result, created, err := h.store.Secret().CreateOrUpdate(ctx, orgId, &secret, h.callbackSecretUpdated)
+	return result, StoreErrorToApiStatus(err, created, domain.SecretKind, &name)
+}
+
+func (h *ServiceHandler) DeleteSecret(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	err := h.store.Secret().Delete(ctx, orgId, name, h.callbackSecretDeleted)
+	return StoreErrorToApiStatus(err, false, domain.SecretKind, &name)
+}
+
+// callbackSecretUpdated is the secret-specific callback that handles secret events
+func (h *ServiceHandler) callbackSecretUpdated(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	h.eventHandler.HandleSecretUpdatedEvents(ctx, resourceKind, orgId, name, oldResource, newResource, created, err)
+}
+
+// callbackSecretDeleted is the secret-specific callback that handles secret deletion events
+func (h *ServiceHandler) callbackSecretDeleted(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	h.eventHandler.HandleGenericResourceDeletedEvents(ctx, resourceKind, orgId, name, oldResource, newResource, created, err)
+}
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateSecret(t *testing.T) {
+	require := require.New(t)
+	testStore := &TestStore{}
+	serviceHandler, ctx := newTestServiceHandler(t, testStore, nil)
+	orgId := store.NullOrgId
+
+	secret := domain.Secret{
+		Metadata: domain.ObjectMeta{Name: lo.ToPtr("registry")},
+		Spec:     domain.SecretSpec{Data: map[string]domain.SecureString{"token": "s3cr3t"}},
+	}
+	_, status := serviceHandler.CreateSecret(ctx, orgId, secret)
+	require.Equal(domain.StatusCreated(), status)
+
+	result, status := serviceHandler.GetSecret(ctx, orgId, "registry")
+	require.Equal(domain.StatusOK(), status)
+	require.Equal("s3cr3t", result.Spec.Data["token"].Value())
+
+	// data that was redacted in an earlier response must not overwrite the stored data
+	secret.Spec.Data["token"] = domain.RedactedPlaceholder
+	_, status = serviceHandler.ReplaceSecret(ctx, orgId, "registry", secret)
+	require.Equal(int32(400), status.Code)
+}
+
+func TestRedactRenderedSecretResources(t *testing.T) {
+	require := require.New(t)
+
+	// files are redacted by the mark set when rendering, even if the Secret resource is no longer in the spec
+	var rendered domain.ConfigProviderSpec
+	require.NoError(rendered.FromInlineConfigProviderSpec(domain.InlineConfigProviderSpec{
+		Name: "rendered-config",
+		Inline: []domain.FileSpec{
+			{Path: "/etc/registry/token", Content: "czNjcjN0", ContentEncoding: lo.ToPtr(domain.EncodingBase64), Sensitive: lo.ToPtr(true)},
+			{Path: "/etc/k8s/token", Content: "k8s"},
+			{Path: "/etc/motd", Content: "hello"},
+		},
+	}))
+	device := &domain.Device{
+		Metadata: domain.ObjectMeta{Name: lo.ToPtr("device")},
+		Spec:     &domain.DeviceSpec{Config: &[]domain.ConfigProviderSpec{rendered}},
+	}
+	require.NoError(redactRenderedSecretResources(device))
+
+	inlineSpec, err := (*device.Spec.Config)[0].AsInlineConfigProviderSpec()
+	require.NoError(err)
+	require.Equal(domain.RedactedPlaceholder, inlineSpec.Inline[0].Content)
+	require.Equal(domain.EncodingPlain, lo.FromPtr(inlineSpec.Inline[0].ContentEncoding))
+	require.Equal("k8s", inlineSpec.Inline[1].Content)
+	require.Equal("hello", inlineSpec.Inline[2].Content)
+}
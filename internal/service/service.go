@@ -35,10 +35,11 @@ type Service interface {
 	GetRenderedDevice(ctx context.Context, orgId uuid.UUID, name string, params domain.GetRenderedDeviceParams) (*domain.Device, domain.Status)
 	PatchDevice(ctx context.Context, orgId uuid.UUID, name string, patch domain.PatchRequest) (*domain.Device, domain.Status)
 	DecommissionDevice(ctx context.Context, orgId uuid.UUID, name string, decom domain.DeviceDecommission) (*domain.Device, domain.Status)
+	RevokeDeviceCertificates(ctx context.Context, orgId uuid.UUID, name string, revocation domain.DeviceRevocation) (*domain.DeviceRevocationResult, domain.Status)
 
 	ResumeDevices(ctx context.Context, orgId uuid.UUID, request domain.DeviceResumeRequest) (domain.DeviceResumeResponse, domain.Status)
 	UpdateDeviceAnnotations(ctx context.Context, orgId uuid.UUID, name string, annotations map[string]string, deleteKeys []string) domain.Status
-	UpdateRenderedDevice(ctx context.Context, orgId uuid.UUID, name, renderedConfig, renderedApplications, specHash string) domain.Status
+	UpdateRenderedDevice(ctx context.Context, orgId uuid.UUID, name, renderedConfig, renderedApplications, renderedTrustPolicy, specHash string) domain.Status
 	SetDeviceServiceConditions(ctx context.Context, orgId uuid.UUID, name string, conditions []domain.Condition) domain.Status
 	OverwriteDeviceRepositoryRefs(ctx context.Context, orgId uuid.UUID, name string, repositoryNames ...string) domain.Status
 	GetDeviceRepositoryRefs(ctx context.Context, orgId uuid.UUID, name string) (*domain.RepositoryList, domain.Status)
@@ -52,6 +53,11 @@ type Service interface {
 	SetOutOfDate(ctx context.Context, orgId uuid.UUID, owner string) error
 	UpdateServerSideDeviceStatus(ctx context.Context, orgId uuid.UUID, name string) error
 	ListConnectivityChangedDevices(ctx context.Context, orgId uuid.UUID, params domain.ListDevicesParams, cutoffTime time.Time) (*domain.DeviceList, domain.Status)
+	WatchDevices(ctx context.Context, orgId uuid.UUID, params domain.ListDevicesParams) (ResourceWatcher, domain.Status)
+
+	// DeviceAttestation
+	CreateDeviceAttestationChallenge(ctx context.Context, orgId uuid.UUID, name string) (*domain.AttestationChallenge, domain.Status)
+	ReplaceDeviceAttestation(ctx context.Context, orgId uuid.UUID, name string, report domain.AttestationReport) (*domain.DeviceIntegrityStatus, domain.Status)
 
 	// EnrollmentConfig
 	GetEnrollmentConfig(ctx context.Context, orgId uuid.UUID, params domain.GetEnrollmentConfigParams) (*domain.EnrollmentConfig, domain.Status)
@@ -78,8 +84,10 @@ type Service interface {
 	PatchFleet(ctx context.Context, orgId uuid.UUID, name string, patch domain.PatchRequest) (*domain.Fleet, domain.Status)
 	ListFleetRolloutDeviceSelection(ctx context.Context, orgId uuid.UUID) (*domain.FleetList, domain.Status)
 	ListDisruptionBudgetFleets(ctx context.Context, orgId uuid.UUID) (*domain.FleetList, domain.Status)
+	WatchFleets(ctx context.Context, orgId uuid.UUID, params domain.ListFleetsParams) (ResourceWatcher, domain.Status)
 	UpdateFleetConditions(ctx context.Context, orgId uuid.UUID, name string, conditions []domain.Condition) domain.Status
 	UpdateFleetAnnotations(ctx context.Context, orgId uuid.UUID, name string, annotations map[string]string, deleteKeys []string) domain.Status
+	UpdateFleetRolloutStatus(ctx context.Context, orgId uuid.UUID, name string, rollout *domain.FleetRolloutStatus) domain.Status
 	OverwriteFleetRepositoryRefs(ctx context.Context, orgId uuid.UUID, name string, repositoryNames ...string) domain.Status
 	GetFleetRepositoryRefs(ctx context.Context, orgId uuid.UUID, name string) (*domain.RepositoryList, domain.Status)
 
@@ -119,6 +127,38 @@ type Service interface {
 	DeleteResourceSync(ctx context.Context, orgId uuid.UUID, name string) domain.Status
 	PatchResourceSync(ctx context.Context, orgId uuid.UUID, name string, patch domain.PatchRequest) (*domain.ResourceSync, domain.Status)
 	ReplaceResourceSyncStatus(ctx context.Context, orgId uuid.UUID, name string, resourceSync domain.ResourceSync) (*domain.ResourceSync, domain.Status)
+	RequestResourceSyncPreview(ctx context.Context, orgId uuid.UUID, name string, params domain.RequestResourceSyncPreviewParams) (*domain.ResourceSyncPreviewRequest, domain.Status)
+	GetResourceSyncPreview(ctx context.Context, orgId uuid.UUID, name string, requestId string) (*domain.ResourceSyncPreview, domain.Status)
+
+	// EnrollmentPolicy
+	CreateEnrollmentPolicy(ctx context.Context, orgId uuid.UUID, ep domain.EnrollmentPolicy) (*domain.EnrollmentPolicy, domain.Status)
+	ListEnrollmentPolicies(ctx context.Context, orgId uuid.UUID, params domain.ListEnrollmentPoliciesParams) (*domain.EnrollmentPolicyList, domain.Status)
+	GetEnrollmentPolicy(ctx context.Context, orgId uuid.UUID, name string) (*domain.EnrollmentPolicy, domain.Status)
+	ReplaceEnrollmentPolicy(ctx context.Context, orgId uuid.UUID, name string, ep domain.EnrollmentPolicy) (*domain.EnrollmentPolicy, domain.Status)
+	DeleteEnrollmentPolicy(ctx context.Context, orgId uuid.UUID, name string) domain.Status
+	PatchEnrollmentPolicy(ctx context.Context, orgId uuid.UUID, name string, patch domain.PatchRequest) (*domain.EnrollmentPolicy, domain.Status)
+
+	// Secret
+	CreateSecret(ctx context.Context, orgId uuid.UUID, secret domain.Secret) (*domain.Secret, domain.Status)
+	ListSecrets(ctx context.Context, orgId uuid.UUID, params domain.ListSecretsParams) (*domain.SecretList, domain.Status)
+	GetSecret(ctx context.Context, orgId uuid.UUID, name string) (*domain.Secret, domain.Status)
+	ReplaceSecret(ctx context.Context, orgId uuid.UUID, name string, secret domain.Secret) (*domain.Secret, domain.Status)
+	DeleteSecret(ctx context.Context, orgId uuid.UUID, name string) domain.Status
+
+	// TrustPolicy
+	CreateTrustPolicy(ctx context.Context, orgId uuid.UUID, trustPolicy domain.TrustPolicy) (*domain.TrustPolicy, domain.Status)
+	ListTrustPolicies(ctx context.Context, orgId uuid.UUID, params domain.ListTrustPoliciesParams) (*domain.TrustPolicyList, domain.Status)
+	GetTrustPolicy(ctx context.Context, orgId uuid.UUID, name string) (*domain.TrustPolicy, domain.Status)
+	ReplaceTrustPolicy(ctx context.Context, orgId uuid.UUID, name string, trustPolicy domain.TrustPolicy) (*domain.TrustPolicy, domain.Status)
+	DeleteTrustPolicy(ctx context.Context, orgId uuid.UUID, name string) domain.Status
+
+	// ReferenceMeasurement
+	CreateReferenceMeasurement(ctx context.Context, orgId uuid.UUID, rm domain.ReferenceMeasurement) (*domain.ReferenceMeasurement, domain.Status)
+	ListReferenceMeasurements(ctx context.Context, orgId uuid.UUID, params domain.ListReferenceMeasurementsParams) (*domain.ReferenceMeasurementList, domain.Status)
+	GetReferenceMeasurement(ctx context.Context, orgId uuid.UUID, name string) (*domain.ReferenceMeasurement, domain.Status)
+	ReplaceReferenceMeasurement(ctx context.Context, orgId uuid.UUID, name string, rm domain.ReferenceMeasurement) (*domain.ReferenceMeasurement, domain.Status)
+	DeleteReferenceMeasurement(ctx context.Context, orgId uuid.UUID, name string) domain.Status
+	PatchReferenceMeasurement(ctx context.Context, orgId uuid.UUID, name string, patch domain.PatchRequest) (*domain.ReferenceMeasurement, domain.Status)
 
 	// Catalog
 	CreateCatalog(ctx context.Context, orgId uuid.UUID, catalog domain.Catalog) (*domain.Catalog, domain.Status)
@@ -149,6 +189,7 @@ type Service interface {
 	CreateEvent(ctx context.Context, orgId uuid.UUID, event *domain.Event)
 	ListEvents(ctx context.Context, orgId uuid.UUID, params domain.ListEventsParams) (*domain.EventList, domain.Status)
 	DeleteEventsOlderThan(ctx context.Context, cutoffTime time.Time) (int64, domain.Status)
+	WatchEvents(ctx context.Context, orgId uuid.UUID, params domain.ListEventsParams) (ResourceWatcher, domain.Status)
 
 	// Checkpoint
 	GetCheckpoint(ctx context.Context, consumer string, key string) ([]byte, domain.Status)
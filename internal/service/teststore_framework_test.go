@@ -29,6 +29,9 @@ type TestStore struct {
 	repositories       *DummyRepository
 	resourceSyncVals   *DummyResourceSync
 	enrollmentRequests *DummyEnrollmentRequest
+	enrollmentPolicies *DummyEnrollmentPolicy
+	secrets            *DummySecret
+	trustPolicies      *DummyTrustPolicy
 	organizations      *DummyOrganization
 }
 
@@ -62,6 +65,21 @@ type DummyEnrollmentRequest struct {
 	enrollmentRequests *[]domain.EnrollmentRequest
 }
 
+type DummyEnrollmentPolicy struct {
+	store.EnrollmentPolicy
+	enrollmentPolicies *[]domain.EnrollmentPolicy
+}
+
+type DummySecret struct {
+	store.Secret
+	secrets *[]domain.Secret
+}
+
+type DummyTrustPolicy struct {
+	store.TrustPolicy
+	trustPolicies *[]domain.TrustPolicy
+}
+
 type DummyCatalog struct {
 	store.Catalog
 	catalogs *[]domain.Catalog
@@ -96,6 +114,15 @@ func (s *TestStore) init() {
 	if s.enrollmentRequests == nil {
 		s.enrollmentRequests = &DummyEnrollmentRequest{enrollmentRequests: &[]domain.EnrollmentRequest{}}
 	}
+	if s.enrollmentPolicies == nil {
+		s.enrollmentPolicies = &DummyEnrollmentPolicy{enrollmentPolicies: &[]domain.EnrollmentPolicy{}}
+	}
+	if s.secrets == nil {
+		s.secrets = &DummySecret{secrets: &[]domain.Secret{}}
+	}
+	if s.trustPolicies == nil {
+		s.trustPolicies = &DummyTrustPolicy{trustPolicies: &[]domain.TrustPolicy{}}
+	}
 	if s.organizations == nil {
 		s.organizations = &DummyOrganization{organizations: &[]*model.Organization{}}
 	}
@@ -136,6 +163,21 @@ func (s *TestStore) EnrollmentRequest() store.EnrollmentRequest {
 	return s.enrollmentRequests
 }
 
+func (s *TestStore) EnrollmentPolicy() store.EnrollmentPolicy {
+	s.init()
+	return s.enrollmentPolicies
+}
+
+func (s *TestStore) Secret() store.Secret {
+	s.init()
+	return s.secrets
+}
+
+func (s *TestStore) TrustPolicy() store.TrustPolicy {
+	s.init()
+	return s.trustPolicies
+}
+
 func (s *TestStore) Organization() store.Organization {
 	s.init()
 	return s.organizations
@@ -639,6 +681,67 @@ func (s *DummyEnrollmentRequest) UpdateStatus(ctx context.Context, orgId uuid.UU
 	return nil, flterrors.ErrResourceNotFound
 }
 
+// --------------------------------------> EnrollmentPolicy
+
+func (s *DummyEnrollmentPolicy) Create(ctx context.Context, orgId uuid.UUID, ep *domain.EnrollmentPolicy, callbackEvent store.EventCallback) (*domain.EnrollmentPolicy, error) {
+	var e domain.EnrollmentPolicy
+	deepCopy(ep, &e)
+	*s.enrollmentPolicies = append(*s.enrollmentPolicies, e)
+	return ep, nil
+}
+
+func (s *DummyEnrollmentPolicy) List(ctx context.Context, orgId uuid.UUID, listParams store.ListParams) (*domain.EnrollmentPolicyList, error) {
+	list := &domain.EnrollmentPolicyList{Items: []domain.EnrollmentPolicy{}}
+	for _, ep := range *s.enrollmentPolicies {
+		var e domain.EnrollmentPolicy
+		deepCopy(ep, &e)
+		list.Items = append(list.Items, e)
+	}
+	return list, nil
+}
+
+// --------------------------------------> Secret
+
+func (s *DummySecret) Create(ctx context.Context, orgId uuid.UUID, secret *domain.Secret, callbackEvent store.EventCallback) (*domain.Secret, error) {
+	for _, existing := range *s.secrets {
+		if *existing.Metadata.Name == *secret.Metadata.Name {
+			return nil, flterrors.ErrDuplicateName
+		}
+	}
+	*s.secrets = append(*s.secrets, *secret)
+	return secret, nil
+}
+
+func (s *DummySecret) Get(ctx context.Context, orgId uuid.UUID, name string) (*domain.Secret, error) {
+	for _, secret := range *s.secrets {
+		if *secret.Metadata.Name == name {
+			return &secret, nil
+		}
+	}
+	return nil, flterrors.ErrResourceNotFound
+}
+
+// --------------------------------------> TrustPolicy
+
+func (s *DummyTrustPolicy) Create(ctx context.Context, orgId uuid.UUID, trustPolicy *domain.TrustPolicy, callbackEvent store.EventCallback) (*domain.TrustPolicy, error) {
+	for _, existing := range *s.trustPolicies {
+		if *existing.Metadata.Name == *trustPolicy.Metadata.Name {
+			return nil, flterrors.ErrDuplicateName
+		}
+	}
+	*s.trustPolicies = append(*s.trustPolicies, *trustPolicy)
+	return trustPolicy, nil
+}
+
+func (s *DummyTrustPolicy) Get(ctx context.Context, orgId uuid.UUID, name string) (*domain.TrustPolicy, error) {
+	for _, trustPolicy := range *s.trustPolicies {
+		if *trustPolicy.Metadata.Name == name {
+			return &trustPolicy, nil
+		}
+	}
+	return nil, flterrors.ErrResourceNotFound
+}
+
 // --------------------------------------> Organization
 
 func (s *DummyOrganization) InitialMigration(ctx context.Context) error {
@@ -122,6 +122,13 @@ func (t *TracedService) ListConnectivityChangedDevices(ctx context.Context, orgI
 	return resp, st
 }
 
+func (t *TracedService) WatchDevices(ctx context.Context, orgId uuid.UUID, params domain.ListDevicesParams) (ResourceWatcher, domain.Status) {
+	ctx, span := startSpan(ctx, "WatchDevices")
+	resp, st := t.inner.WatchDevices(ctx, orgId, params)
+	endSpan(span, st)
+	return resp, st
+}
+
 func (t *TracedService) ListDevicesByServiceCondition(ctx context.Context, orgId uuid.UUID, conditionType string, conditionStatus string, listParams store.ListParams) (*domain.DeviceList, domain.Status) {
 	ctx, span := startSpan(ctx, "ListDevicesByServiceCondition")
 	resp, st := t.inner.ListDevicesByServiceCondition(ctx, orgId, conditionType, conditionStatus, listParams)
@@ -176,6 +183,18 @@ func (t *TracedService) PatchDeviceStatus(ctx context.Context, orgId uuid.UUID,
 	endSpan(span, st)
 	return resp, st
 }
+func (t *TracedService) CreateDeviceAttestationChallenge(ctx context.Context, orgId uuid.UUID, name string) (*domain.AttestationChallenge, domain.Status) {
+	ctx, span := startSpan(ctx, "CreateDeviceAttestationChallenge")
+	resp, st := t.inner.CreateDeviceAttestationChallenge(ctx, orgId, name)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) ReplaceDeviceAttestation(ctx context.Context, orgId uuid.UUID, name string, report domain.AttestationReport) (*domain.DeviceIntegrityStatus, domain.Status) {
+	ctx, span := startSpan(ctx, "ReplaceDeviceAttestation")
+	resp, st := t.inner.ReplaceDeviceAttestation(ctx, orgId, name, report)
+	endSpan(span, st)
+	return resp, st
+}
 func (t *TracedService) GetRenderedDevice(ctx context.Context, orgId uuid.UUID, name string, p domain.GetRenderedDeviceParams) (*domain.Device, domain.Status) {
 	ctx, span := startSpan(ctx, "GetRenderedDevice")
 	resp, st := t.inner.GetRenderedDevice(ctx, orgId, name, p)
@@ -194,6 +213,12 @@ func (t *TracedService) DecommissionDevice(ctx context.Context, orgId uuid.UUID,
 	endSpan(span, st)
 	return resp, st
 }
+func (t *TracedService) RevokeDeviceCertificates(ctx context.Context, orgId uuid.UUID, name string, revocation domain.DeviceRevocation) (*domain.DeviceRevocationResult, domain.Status) {
+	ctx, span := startSpan(ctx, "RevokeDeviceCertificates")
+	resp, st := t.inner.RevokeDeviceCertificates(ctx, orgId, name, revocation)
+	endSpan(span, st)
+	return resp, st
+}
 
 func (t *TracedService) ResumeDevices(ctx context.Context, orgId uuid.UUID, request domain.DeviceResumeRequest) (domain.DeviceResumeResponse, domain.Status) {
 	ctx, span := startSpan(ctx, "ResumeDevices")
@@ -207,9 +232,9 @@ func (t *TracedService) UpdateDeviceAnnotations(ctx context.Context, orgId uuid.
 	endSpan(span, st)
 	return st
 }
-func (t *TracedService) UpdateRenderedDevice(ctx context.Context, orgId uuid.UUID, name, renderedConfig, renderedApps, specHash string) domain.Status {
+func (t *TracedService) UpdateRenderedDevice(ctx context.Context, orgId uuid.UUID, name, renderedConfig, renderedApps, renderedTrustPolicy, specHash string) domain.Status {
 	ctx, span := startSpan(ctx, "UpdateRenderedDevice")
-	st := t.inner.UpdateRenderedDevice(ctx, orgId, name, renderedConfig, renderedApps, specHash)
+	st := t.inner.UpdateRenderedDevice(ctx, orgId, name, renderedConfig, renderedApps, renderedTrustPolicy, specHash)
 	endSpan(span, st)
 	return st
 }
@@ -399,6 +424,12 @@ func (t *TracedService) ListDisruptionBudgetFleets(ctx context.Context, orgId uu
 	endSpan(span, st)
 	return resp, st
 }
+func (t *TracedService) WatchFleets(ctx context.Context, orgId uuid.UUID, params domain.ListFleetsParams) (ResourceWatcher, domain.Status) {
+	ctx, span := startSpan(ctx, "WatchFleets")
+	resp, st := t.inner.WatchFleets(ctx, orgId, params)
+	endSpan(span, st)
+	return resp, st
+}
 func (t *TracedService) UpdateFleetConditions(ctx context.Context, orgId uuid.UUID, name string, conditions []domain.Condition) domain.Status {
 	ctx, span := startSpan(ctx, "UpdateFleetConditions")
 	st := t.inner.UpdateFleetConditions(ctx, orgId, name, conditions)
@@ -411,6 +442,12 @@ func (t *TracedService) UpdateFleetAnnotations(ctx context.Context, orgId uuid.U
 	endSpan(span, st)
 	return st
 }
+func (t *TracedService) UpdateFleetRolloutStatus(ctx context.Context, orgId uuid.UUID, name string, rollout *domain.FleetRolloutStatus) domain.Status {
+	ctx, span := startSpan(ctx, "UpdateFleetRolloutStatus")
+	st := t.inner.UpdateFleetRolloutStatus(ctx, orgId, name, rollout)
+	endSpan(span, st)
+	return st
+}
 func (t *TracedService) OverwriteFleetRepositoryRefs(ctx context.Context, orgId uuid.UUID, name string, repositoryNames ...string) domain.Status {
 	ctx, span := startSpan(ctx, "OverwriteFleetRepositoryRefs")
 	st := t.inner.OverwriteFleetRepositoryRefs(ctx, orgId, name, repositoryNames...)
@@ -533,6 +570,158 @@ func (t *TracedService) ReplaceResourceSyncStatus(ctx context.Context, orgId uui
 	endSpan(span, st)
 	return resp, st
 }
+func (t *TracedService) RequestResourceSyncPreview(ctx context.Context, orgId uuid.UUID, name string, params domain.RequestResourceSyncPreviewParams) (*domain.ResourceSyncPreviewRequest, domain.Status) {
+	ctx, span := startSpan(ctx, "RequestResourceSyncPreview")
+	resp, st := t.inner.RequestResourceSyncPreview(ctx, orgId, name, params)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) GetResourceSyncPreview(ctx context.Context, orgId uuid.UUID, name string, requestId string) (*domain.ResourceSyncPreview, domain.Status) {
+	ctx, span := startSpan(ctx, "GetResourceSyncPreview")
+	resp, st := t.inner.GetResourceSyncPreview(ctx, orgId, name, requestId)
+	endSpan(span, st)
+	return resp, st
+}
+
+// --- EnrollmentPolicy ---
+func (t *TracedService) CreateEnrollmentPolicy(ctx context.Context, orgId uuid.UUID, ep domain.EnrollmentPolicy) (*domain.EnrollmentPolicy, domain.Status) {
+	ctx, span := startSpan(ctx, "CreateEnrollmentPolicy")
+	resp, st := t.inner.CreateEnrollmentPolicy(ctx, orgId, ep)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) ListEnrollmentPolicies(ctx context.Context, orgId uuid.UUID, params domain.ListEnrollmentPoliciesParams) (*domain.EnrollmentPolicyList, domain.Status) {
+	ctx, span := startSpan(ctx, "ListEnrollmentPolicies")
+	resp, st := t.inner.ListEnrollmentPolicies(ctx, orgId, params)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) GetEnrollmentPolicy(ctx context.Context, orgId uuid.UUID, name string) (*domain.EnrollmentPolicy, domain.Status) {
+	ctx, span := startSpan(ctx, "GetEnrollmentPolicy")
+	resp, st := t.inner.GetEnrollmentPolicy(ctx, orgId, name)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) ReplaceEnrollmentPolicy(ctx context.Context, orgId uuid.UUID, name string, ep domain.EnrollmentPolicy) (*domain.EnrollmentPolicy, domain.Status) {
+	ctx, span := startSpan(ctx, "ReplaceEnrollmentPolicy")
+	resp, st := t.inner.ReplaceEnrollmentPolicy(ctx, orgId, name, ep)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) DeleteEnrollmentPolicy(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	ctx, span := startSpan(ctx, "DeleteEnrollmentPolicy")
+	st := t.inner.DeleteEnrollmentPolicy(ctx, orgId, name)
+	endSpan(span, st)
+	return st
+}
+func (t *TracedService) PatchEnrollmentPolicy(ctx context.Context, orgId uuid.UUID, name string, patch domain.PatchRequest) (*domain.EnrollmentPolicy, domain.Status) {
+	ctx, span := startSpan(ctx, "PatchEnrollmentPolicy")
+	resp, st := t.inner.PatchEnrollmentPolicy(ctx, orgId, name, patch)
+	endSpan(span, st)
+	return resp, st
+}
+
+// --- Secret ---
+func (t *TracedService) CreateSecret(ctx context.Context, orgId uuid.UUID, secret domain.Secret) (*domain.Secret, domain.Status) {
+	ctx, span := startSpan(ctx, "CreateSecret")
+	resp, st := t.inner.CreateSecret(ctx, orgId, secret)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) ListSecrets(ctx context.Context, orgId uuid.UUID, params domain.ListSecretsParams) (*domain.SecretList, domain.Status) {
+	ctx, span := startSpan(ctx, "ListSecrets")
+	resp, st := t.inner.ListSecrets(ctx, orgId, params)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) GetSecret(ctx context.Context, orgId uuid.UUID, name string) (*domain.Secret, domain.Status) {
+	ctx, span := startSpan(ctx, "GetSecret")
+	resp, st := t.inner.GetSecret(ctx, orgId, name)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) ReplaceSecret(ctx context.Context, orgId uuid.UUID, name string, secret domain.Secret) (*domain.Secret, domain.Status) {
+	ctx, span := startSpan(ctx, "ReplaceSecret")
+	resp, st := t.inner.ReplaceSecret(ctx, orgId, name, secret)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) DeleteSecret(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	ctx, span := startSpan(ctx, "DeleteSecret")
+	st := t.inner.DeleteSecret(ctx, orgId, name)
+	endSpan(span, st)
+	return st
+}
+
+// --- TrustPolicy ---
+func (t *TracedService) CreateTrustPolicy(ctx context.Context, orgId uuid.UUID, trustPolicy domain.TrustPolicy) (*domain.TrustPolicy, domain.Status) {
+	ctx, span := startSpan(ctx, "CreateTrustPolicy")
+	resp, st := t.inner.CreateTrustPolicy(ctx, orgId, trustPolicy)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) ListTrustPolicies(ctx context.Context, orgId uuid.UUID, params domain.ListTrustPoliciesParams) (*domain.TrustPolicyList, domain.Status) {
+	ctx, span := startSpan(ctx, "ListTrustPolicies")
+	resp, st := t.inner.ListTrustPolicies(ctx, orgId, params)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) GetTrustPolicy(ctx context.Context, orgId uuid.UUID, name string) (*domain.TrustPolicy, domain.Status) {
+	ctx, span := startSpan(ctx, "GetTrustPolicy")
+	resp, st := t.inner.GetTrustPolicy(ctx, orgId, name)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) ReplaceTrustPolicy(ctx context.Context, orgId uuid.UUID, name string, trustPolicy domain.TrustPolicy) (*domain.TrustPolicy, domain.Status) {
+	ctx, span := startSpan(ctx, "ReplaceTrustPolicy")
+	resp, st := t.inner.ReplaceTrustPolicy(ctx, orgId, name, trustPolicy)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) DeleteTrustPolicy(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	ctx, span := startSpan(ctx, "DeleteTrustPolicy")
+	st := t.inner.DeleteTrustPolicy(ctx, orgId, name)
+	endSpan(span, st)
+	return st
+}
+
+// --- ReferenceMeasurement ---
+func (t *TracedService) CreateReferenceMeasurement(ctx context.Context, orgId uuid.UUID, rm domain.ReferenceMeasurement) (*domain.ReferenceMeasurement, domain.Status) {
+	ctx, span := startSpan(ctx, "CreateReferenceMeasurement")
+	resp, st := t.inner.CreateReferenceMeasurement(ctx, orgId, rm)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) ListReferenceMeasurements(ctx context.Context, orgId uuid.UUID, params domain.ListReferenceMeasurementsParams) (*domain.ReferenceMeasurementList, domain.Status) {
+	ctx, span := startSpan(ctx, "ListReferenceMeasurements")
+	resp, st := t.inner.ListReferenceMeasurements(ctx, orgId, params)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) GetReferenceMeasurement(ctx context.Context, orgId uuid.UUID, name string) (*domain.ReferenceMeasurement, domain.Status) {
+	ctx, span := startSpan(ctx, "GetReferenceMeasurement")
+	resp, st := t.inner.GetReferenceMeasurement(ctx, orgId, name)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) ReplaceReferenceMeasurement(ctx context.Context, orgId uuid.UUID, name string, rm domain.ReferenceMeasurement) (*domain.ReferenceMeasurement, domain.Status) {
+	ctx, span := startSpan(ctx, "ReplaceReferenceMeasurement")
+	resp, st := t.inner.ReplaceReferenceMeasurement(ctx, orgId, name, rm)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) DeleteReferenceMeasurement(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	ctx, span := startSpan(ctx, "DeleteReferenceMeasurement")
+	st := t.inner.DeleteReferenceMeasurement(ctx, orgId, name)
+	endSpan(span, st)
+	return st
+}
+func (t *TracedService) PatchReferenceMeasurement(ctx context.Context, orgId uuid.UUID, name string, patch domain.PatchRequest) (*domain.ReferenceMeasurement, domain.Status) {
+	ctx, span := startSpan(ctx, "PatchReferenceMeasurement")
+	resp, st := t.inner.PatchReferenceMeasurement(ctx, orgId, name, patch)
+	endSpan(span, st)
+	return resp, st
+}
 
 // --- Catalog ---
 func (t *TracedService) CreateCatalog(ctx context.Context, orgId uuid.UUID, catalog domain.Catalog) (*domain.Catalog, domain.Status) {
@@ -682,6 +871,12 @@ func (t *TracedService) DeleteEventsOlderThan(ctx context.Context, cutoffTime ti
 	endSpan(span, st)
 	return resp, st
 }
+func (t *TracedService) WatchEvents(ctx context.Context, orgId uuid.UUID, params domain.ListEventsParams) (ResourceWatcher, domain.Status) {
+	ctx, span := startSpan(ctx, "WatchEvents")
+	resp, st := t.inner.WatchEvents(ctx, orgId, params)
+	endSpan(span, st)
+	return resp, st
+}
 
 // --- Checkpoint ---
 func (t *TracedService) GetCheckpoint(ctx context.Context, consumer string, key string) ([]byte, domain.Status) {
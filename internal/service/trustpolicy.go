@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/store/selector"
+	"github.com/google/uuid"
+)
+
+func (h *ServiceHandler) CreateTrustPolicy(ctx context.Context, orgId uuid.UUID, trustPolicy domain.TrustPolicy) (*domain.TrustPolicy, domain.Status) {
+	// don't set fields that are managed by the service
+	NilOutManagedObjectMetaProperties(&trustPolicy.Metadata)
+
+	if errs := trustPolicy.Validate(); len(errs) > 0 {
+		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
+	}
+
+	result, err := h.store.TrustPolicy().Create(ctx, orgId, &trustPolicy, h.callbackTrustPolicyUpdated)
+	return result, StoreErrorToApiStatus(err, true, domain.TrustPolicyKind, trustPolicy.Metadata.Name)
+}
+
+func (h *ServiceHandler) ListTrustPolicies(ctx context.Context, orgId uuid.UUID, params domain.ListTrustPoliciesParams) (*domain.TrustPolicyList, domain.Status) {
+	listParams, status := prepareListParams(params.Continue, params.LabelSelector, params.FieldSelector, params.Limit)
+	if status != domain.StatusOK() {
+		return nil, status
+	}
+
+	result, err := h.store.TrustPolicy().List(ctx, orgId, *listParams)
+	if err == nil {
+		return result, domain.StatusOK()
+	}
+
+	var se *selector.SelectorError
+
+	switch {
+	case selector.AsSelectorError(err, &se):
+		return nil, domain.StatusBadRequest(se.Error())
+	default:
+		return nil, domain.StatusInternalServerError(err.Error())
+	}
+}
+
+func (h *ServiceHandler) GetTrustPolicy(ctx context.Context, orgId uuid.UUID, name string) (*domain.TrustPolicy, domain.Status) {
+	result, err := h.store.TrustPolicy().Get(ctx, orgId, name)
+	return result, StoreErrorToApiStatus(err, false, domain.TrustPolicyKind, &name)
+}
+
+func (h *ServiceHandler) ReplaceTrustPolicy(ctx context.Context, orgId uuid.UUID, name string, trustPolicy domain.TrustPolicy) (*domain.TrustPolicy, domain.Status) {
+	// don't overwrite fields that are managed by the service
+	if !IsInternalRequest(ctx) {
+		NilOutManagedObjectMetaProperties(&trustPolicy.Metadata)
+	}
+	if errs := trustPolicy.Validate(); len(errs) > 0 {
+		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
+	}
+	if name != *trustPolicy.Metadata.Name {
+		return nil, domain.StatusBadRequest("resource name specified in metadata does not match name in path")
+	}
+
+	result, created, err := h.store.TrustPolicy().CreateOrUpdate(ctx, orgId, &trustPolicy, h.callbackTrustPolicyUpdated)
+	return result, StoreErrorToApiStatus(err, created, domain.TrustPolicyKind, &name)
+}
+
+func (h *ServiceHandler) DeleteTrustPolicy(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	err := h.store.TrustPolicy().Delete(ctx, orgId, name, h.callbackTrustPolicyDeleted)
+	return StoreErrorToApiStatus(err, false, domain.TrustPolicyKind, &name)
+}
+
+// callbackTrustPolicyUpdated is the trust-policy-specific callback that handles trust policy events
+func (h *ServiceHandler) callbackTrustPolicyUpdated(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	h.eventHandler.HandleTrustPolicyUpdatedEvents(ctx, resourceKind, orgId, name, oldResource, newResource, created, err)
+}
+
+// callbackTrustPolicyDeleted is the trust-policy-specific callback that handles trust policy deletion events
+func (h *ServiceHandler) callbackTrustPolicyDeleted(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	h.eventHandler.HandleGenericResourceDeletedEvents(ctx, resourceKind, orgId, name, oldResource, newResource, created, err)
+}
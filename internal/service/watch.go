@@ -0,0 +1,327 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/kvstore"
+	"github.com/flightctl/flightctl/internal/store/selector"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// watchStreamMaxLen bounds the number of notifications retained per organization and resource kind.
+	watchStreamMaxLen = 10000
+	// watchReadBlockTimeout is how long a watcher waits for new notifications before sending a bookmark.
+	watchReadBlockTimeout = 30 * time.Second
+	// watchReadBatchSize is the maximal number of notifications read from the stream at once.
+	watchReadBatchSize = 100
+	// watchStartSkew moves the start of a new watch slightly into the past to compensate for clock skew
+	// between the service and Redis. Clients may therefore receive a notification more than once.
+	watchStartSkew = time.Second
+)
+
+var watchResourceVersionRegex = regexp.MustCompile(`^\d+-\d+$`)
+
+// ErrWatchExpired is returned when notifications following the watcher's position have been trimmed
+// from the stream. The client must list the resources again and start a new watch.
+var ErrWatchExpired = errors.New("the requested resourceVersion is too old and notifications have been trimmed")
+
+// watchableKinds are the resource kinds for which change notifications are published.
+var watchableKinds = map[domain.ResourceKind]struct{}{
+	domain.DeviceKind: {},
+	domain.FleetKind:  {},
+	domain.EventKind:  {},
+}
+
+// ResourceWatcher streams notifications about changes to resources of a single kind.
+type ResourceWatcher interface {
+	// Next blocks until notifications are available and returns them. If no notification arrives
+	// within the read timeout, a single BOOKMARK notification with the current position is returned.
+	Next(ctx context.Context) ([]domain.WatchEvent, error)
+}
+
+// watchNotification is the representation of a notification in the watch stream.
+type watchNotification struct {
+	Type   domain.WatchEventType  `json:"type"`
+	Object map[string]interface{} `json:"object"`
+	// OldLabels are the labels of the resource before a MODIFIED or DELETED change, used to tell
+	// watchers with a label selector that the resource entered or left their selection.
+	OldLabels *map[string]string `json:"oldLabels,omitempty"`
+}
+
+// watchPublisher appends change notifications to the per-organization watch streams in the kvstore,
+// from which every API server replica serves its watchers.
+type watchPublisher struct {
+	kvStore kvstore.KVStore
+	log     logrus.FieldLogger
+}
+
+func newWatchPublisher(kvStore kvstore.KVStore, log logrus.FieldLogger) *watchPublisher {
+	if kvStore == nil {
+		return nil
+	}
+	return &watchPublisher{kvStore: kvStore, log: log}
+}
+
+// publish appends a notification for the given resource. oldLabels holds the labels of the resource
+// before a modification, or nil if there was no previous version. Failures are logged and otherwise
+// ignored, since the change itself has already been persisted.
+func (p *watchPublisher) publish(ctx context.Context, orgId uuid.UUID, kind domain.ResourceKind, eventType domain.WatchEventType, object any, oldLabels *map[string]string) {
+	if p == nil || object == nil {
+		return
+	}
+	if _, ok := watchableKinds[kind]; !ok {
+		return
+	}
+
+	objectBytes, err := json.Marshal(object)
+	if err != nil {
+		p.log.WithError(err).Errorf("failed marshalling %s for watch notification", kind)
+		return
+	}
+	notification := struct {
+		Type      domain.WatchEventType `json:"type"`
+		Object    json.RawMessage       `json:"object"`
+		OldLabels *map[string]string    `json:"oldLabels,omitempty"`
+	}{Type: eventType, Object: objectBytes, OldLabels: oldLabels}
+	b, err := json.Marshal(notification)
+	if err != nil {
+		p.log.WithError(err).Errorf("failed marshalling watch notification for %s", kind)
+		return
+	}
+
+	key := kvstore.WatchStreamKey{OrgID: orgId, Kind: string(kind)}
+	if _, err = p.kvStore.StreamAddWithMaxLen(ctx, key.ComposeKey(), b, watchStreamMaxLen); err != nil {
+		p.log.WithError(err).Errorf("failed publishing watch notification for %s", kind)
+	}
+}
+
+// publishDeleted appends a DELETED notification carrying the resource's identity and its last labels.
+func (p *watchPublisher) publishDeleted(ctx context.Context, orgId uuid.UUID, kind domain.ResourceKind, name string, labels *map[string]string) {
+	p.publish(ctx, orgId, kind, domain.WatchEventDeleted, map[string]any{
+		"kind":     string(kind),
+		"metadata": domain.ObjectMeta{Name: lo.ToPtr(name), Labels: labels},
+	}, labels)
+}
+
+// watchDeletedLabels returns the last labels of a deleted resource, or nil if they are unknown.
+func watchDeletedLabels(resource interface{}) *map[string]string {
+	switch r := resource.(type) {
+	case *domain.Device:
+		if r != nil {
+			return watchOldLabels(&r.Metadata)
+		}
+	case *domain.Fleet:
+		if r != nil {
+			return watchOldLabels(&r.Metadata)
+		}
+	}
+	return nil
+}
+
+// watchOldLabels returns the labels of the previous version of a resource for a MODIFIED notification.
+// A resource without labels yields an empty map, so that watchers can still tell it existed.
+func watchOldLabels(metadata *domain.ObjectMeta) *map[string]string {
+	if metadata == nil {
+		return nil
+	}
+	return lo.ToPtr(lo.FromPtr(metadata.Labels))
+}
+
+// streamWatcher implements ResourceWatcher on top of a kvstore stream.
+type streamWatcher struct {
+	kvStore       kvstore.KVStore
+	key           string
+	lastID        string
+	labelSelector *selector.LabelSelector
+	pending       []domain.WatchEvent
+}
+
+func (w *streamWatcher) bookmark() domain.WatchEvent {
+	return domain.WatchEvent{Type: domain.WatchEventBookmark, ResourceVersion: w.lastID}
+}
+
+func (w *streamWatcher) matches(object map[string]interface{}) bool {
+	if w.labelSelector == nil {
+		return true
+	}
+	metadata, _ := object["metadata"].(map[string]interface{})
+	rawLabels, _ := metadata["labels"].(map[string]interface{})
+	labels := make(map[string]string, len(rawLabels))
+	for k, v := range rawLabels {
+		if value, ok := v.(string); ok {
+			labels[k] = value
+		}
+	}
+	return w.labelSelector.Matches(labels)
+}
+
+// filter decides whether a notification is delivered to the watcher and with which type. Like in
+// Kubernetes, a modification that moves a resource into the watcher's label selection is delivered
+// as ADDED, and one that moves it out of the selection as DELETED.
+func (w *streamWatcher) filter(notification watchNotification) (domain.WatchEventType, bool) {
+	if w.labelSelector == nil {
+		return notification.Type, true
+	}
+	if notification.Type == domain.WatchEventDeleted {
+		// a deleted resource is reported only to watchers whose selection it was in
+		return notification.Type, w.labelSelector.Matches(lo.FromPtr(notification.OldLabels))
+	}
+	matches := w.matches(notification.Object)
+	if notification.Type != domain.WatchEventModified || notification.OldLabels == nil {
+		return notification.Type, matches
+	}
+	matched := w.labelSelector.Matches(*notification.OldLabels)
+	switch {
+	case matches && !matched:
+		return domain.WatchEventAdded, true
+	case !matches && matched:
+		return domain.WatchEventDeleted, true
+	default:
+		return notification.Type, matches
+	}
+}
+
+// expired returns true if notifications following lastID may have been trimmed from the stream.
+func expired(ctx context.Context, kvStore kvstore.KVStore, key string, lastID string) (bool, error) {
+	info, err := kvStore.StreamInfo(ctx, key)
+	if err != nil {
+		return false, err
+	}
+	if info.EntriesAdded <= info.Length || info.FirstID == "" {
+		// nothing has been trimmed yet
+		return false, nil
+	}
+	return compareWatchResourceVersions(lastID, info.FirstID) < 0, nil
+}
+
+// compareWatchResourceVersions compares two stream IDs of the form <milliseconds>-<sequence>.
+func compareWatchResourceVersions(a, b string) int {
+	var aMs, aSeq, bMs, bSeq uint64
+	_, _ = fmt.Sscanf(a, "%d-%d", &aMs, &aSeq)
+	_, _ = fmt.Sscanf(b, "%d-%d", &bMs, &bSeq)
+	switch {
+	case aMs < bMs, aMs == bMs && aSeq < bSeq:
+		return -1
+	case aMs == bMs && aSeq == bSeq:
+		return 0
+	default:
+		return 1
+	}
+}
+
+func (w *streamWatcher) Next(ctx context.Context) ([]domain.WatchEvent, error) {
+	if len(w.pending) > 0 {
+		events := w.pending
+		w.pending = nil
+		return events, nil
+	}
+
+	// a watcher that falls behind the trimming of the stream would silently miss notifications
+	isExpired, err := expired(ctx, w.kvStore, w.key, w.lastID)
+	if err != nil {
+		return nil, err
+	}
+	if isExpired {
+		return nil, ErrWatchExpired
+	}
+
+	entries, err := w.kvStore.StreamRead(ctx, w.key, w.lastID, watchReadBlockTimeout, watchReadBatchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []domain.WatchEvent
+	for _, entry := range entries {
+		w.lastID = entry.ID
+		var notification watchNotification
+		if err := json.Unmarshal(entry.Value, &notification); err != nil {
+			continue
+		}
+		eventType, ok := w.filter(notification)
+		if !ok {
+			continue
+		}
+		events = append(events, domain.WatchEvent{
+			Type:            eventType,
+			ResourceVersion: entry.ID,
+			Object:          lo.ToPtr(notification.Object),
+		})
+	}
+	if len(events) == 0 {
+		return []domain.WatchEvent{w.bookmark()}, nil
+	}
+	return events, nil
+}
+
+func (h *ServiceHandler) newResourceWatcher(ctx context.Context, orgId uuid.UUID, kind domain.ResourceKind, resourceVersion *string, labelSelector *string) (ResourceWatcher, domain.Status) {
+	if h.kvStore == nil {
+		return nil, domain.StatusNotImplemented("watching resources is not supported by this service")
+	}
+
+	startID := fmt.Sprintf("%d-0", time.Now().Add(-watchStartSkew).UnixMilli())
+	if resourceVersion != nil {
+		if !watchResourceVersionRegex.MatchString(*resourceVersion) {
+			return nil, domain.StatusBadRequest(fmt.Sprintf("invalid resourceVersion %q", *resourceVersion))
+		}
+		startID = *resourceVersion
+	}
+	key := kvstore.WatchStreamKey{OrgID: orgId, Kind: string(kind)}
+	if resourceVersion != nil {
+		isExpired, err := expired(ctx, h.kvStore, key.ComposeKey(), startID)
+		if err != nil {
+			return nil, domain.StatusInternalServerError(err.Error())
+		}
+		if isExpired {
+			return nil, domain.StatusGone(fmt.Sprintf("resourceVersion %q has expired, list the resources again and watch from the returned position", startID))
+		}
+	}
+
+	var ls *selector.LabelSelector
+	if labelSelector != nil && *labelSelector != "" {
+		var err error
+		if ls, err = selector.NewLabelSelector(*labelSelector); err != nil {
+			return nil, domain.StatusBadRequest(err.Error())
+		}
+	}
+
+	w := &streamWatcher{
+		kvStore:       h.kvStore,
+		key:           key.ComposeKey(),
+		lastID:        startID,
+		labelSelector: ls,
+	}
+	// The first notification tells the client where the stream starts, so that it can list the
+	// current state afterwards without missing any change.
+	w.pending = []domain.WatchEvent{w.bookmark()}
+	return w, domain.StatusOK()
+}
+
+func (h *ServiceHandler) WatchDevices(ctx context.Context, orgId uuid.UUID, params domain.ListDevicesParams) (ResourceWatcher, domain.Status) {
+	if params.SummaryOnly != nil || params.Limit != nil || params.Continue != nil || params.FieldSelector != nil {
+		return nil, domain.StatusBadRequest("parameters such as 'summaryOnly', 'fieldSelector', 'limit' and 'continue' are not supported when 'watch' is true")
+	}
+	return h.newResourceWatcher(ctx, orgId, domain.DeviceKind, params.ResourceVersion, params.LabelSelector)
+}
+
+func (h *ServiceHandler) WatchFleets(ctx context.Context, orgId uuid.UUID, params domain.ListFleetsParams) (ResourceWatcher, domain.Status) {
+	if params.AddDevicesSummary != nil || params.Limit != nil || params.Continue != nil || params.FieldSelector != nil {
+		return nil, domain.StatusBadRequest("parameters such as 'addDevicesSummary', 'fieldSelector', 'limit' and 'continue' are not supported when 'watch' is true")
+	}
+	return h.newResourceWatcher(ctx, orgId, domain.FleetKind, params.ResourceVersion, params.LabelSelector)
+}
+
+func (h *ServiceHandler) WatchEvents(ctx context.Context, orgId uuid.UUID, params domain.ListEventsParams) (ResourceWatcher, domain.Status) {
+	if params.Limit != nil || params.Continue != nil || params.FieldSelector != nil {
+		return nil, domain.StatusBadRequest("parameters such as 'fieldSelector', 'limit' and 'continue' are not supported when 'watch' is true")
+	}
+	return h.newResourceWatcher(ctx, orgId, domain.EventKind, params.ResourceVersion, nil)
+}
@@ -0,0 +1,300 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/kvstore"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+// streamKVStore keeps streams in memory so that watch notifications can be published and read back
+type streamKVStore struct {
+	MockKVStore
+	mu      sync.Mutex
+	seq     int64
+	streams map[string][]kvstore.StreamEntry
+	added   map[string]int64
+}
+
+func newStreamKVStore() *streamKVStore {
+	return &streamKVStore{streams: map[string][]kvstore.StreamEntry{}, added: map[string]int64{}}
+}
+
+func (s *streamKVStore) StreamAddWithMaxLen(ctx context.Context, key string, value []byte, maxLen int64) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.seq++
+	id := fmt.Sprintf("%d-%d", time.Now().UnixMilli(), s.seq)
+	s.streams[key] = append(s.streams[key], kvstore.StreamEntry{ID: id, Value: value})
+	s.added[key]++
+	if int64(len(s.streams[key])) > maxLen {
+		s.streams[key] = s.streams[key][1:]
+	}
+	return id, nil
+}
+
+func (s *streamKVStore) StreamRead(ctx context.Context, key string, lastID string, block time.Duration, count int64) ([]kvstore.StreamEntry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var ret []kvstore.StreamEntry
+	for _, entry := range s.streams[key] {
+		if compareStreamIDs(entry.ID, lastID) > 0 {
+			ret = append(ret, entry)
+		}
+	}
+	return ret, nil
+}
+
+func (s *streamKVStore) StreamInfo(ctx context.Context, key string) (kvstore.StreamInfo, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	info := kvstore.StreamInfo{Length: int64(len(s.streams[key])), EntriesAdded: s.added[key]}
+	if len(s.streams[key]) > 0 {
+		info.FirstID = s.streams[key][0].ID
+	}
+	return info, nil
+}
+
+func compareStreamIDs(a, b string) int {
+	var aMs, aSeq, bMs, bSeq int64
+	_, _ = fmt.Sscanf(a, "%d-%d", &aMs, &aSeq)
+	_, _ = fmt.Sscanf(b, "%d-%d", &bMs, &bSeq)
+	switch {
+	case aMs != bMs:
+		return int(aMs - bMs)
+	default:
+		return int(aSeq - bSeq)
+	}
+}
+
+func watchServiceHandler(kv kvstore.KVStore) *ServiceHandler {
+	testStore := &TestStore{}
+	eventHandler := NewEventHandler(testStore, nil, logrus.New())
+	eventHandler.watch = newWatchPublisher(kv, logrus.New())
+	return &ServiceHandler{
+		eventHandler: eventHandler,
+		store:        testStore,
+		kvStore:      kv,
+		log:          logrus.New(),
+	}
+}
+
+func TestWatchDevices(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	orgId := uuid.New()
+	kv := newStreamKVStore()
+	h := watchServiceHandler(kv)
+
+	watcher, status := h.WatchDevices(ctx, orgId, domain.ListDevicesParams{LabelSelector: lo.ToPtr("site=a")})
+	require.Equal(int32(http.StatusOK), status.Code)
+
+	// the first notification is a bookmark telling the client where the stream starts
+	events, err := watcher.Next(ctx)
+	require.NoError(err)
+	require.Len(events, 1)
+	require.Equal(domain.WatchEventBookmark, events[0].Type)
+	start := events[0].ResourceVersion
+
+	devA := prepareDevice(orgId, "dev-a")
+	devA.Metadata.Labels = &map[string]string{"site": "a"}
+	devB := prepareDevice(orgId, "dev-b")
+	devB.Metadata.Labels = &map[string]string{"site": "b"}
+
+	h.eventHandler.HandleDeviceUpdatedEvents(ctx, domain.DeviceKind, orgId, "dev-a", nil, devA, true, nil)
+	h.eventHandler.HandleDeviceUpdatedEvents(ctx, domain.DeviceKind, orgId, "dev-b", nil, devB, true, nil)
+	h.eventHandler.HandleDeviceUpdatedEvents(ctx, domain.DeviceKind, orgId, "dev-a", devA, devA, false, nil)
+	h.eventHandler.HandleGenericResourceDeletedEvents(ctx, domain.DeviceKind, orgId, "dev-a", devA, nil, false, nil)
+	// deletions outside of the selection are not reported
+	h.eventHandler.HandleGenericResourceDeletedEvents(ctx, domain.DeviceKind, orgId, "dev-b", devB, nil, false, nil)
+
+	events, err = watcher.Next(ctx)
+	require.NoError(err)
+	require.Len(events, 3)
+	require.Equal(domain.WatchEventAdded, events[0].Type)
+	require.Equal(domain.WatchEventModified, events[1].Type)
+	require.Equal(domain.WatchEventDeleted, events[2].Type)
+	for _, event := range events {
+		metadata := (*event.Object)["metadata"].(map[string]interface{})
+		require.Equal("dev-a", metadata["name"])
+		require.Equal(map[string]interface{}{"site": "a"}, metadata["labels"])
+	}
+
+	// no new notifications results in a bookmark with the latest position
+	events, err = watcher.Next(ctx)
+	require.NoError(err)
+	require.Len(events, 1)
+	require.Equal(domain.WatchEventBookmark, events[0].Type)
+	require.Equal(events[0].ResourceVersion, kv.streams[(&kvstore.WatchStreamKey{OrgID: orgId, Kind: domain.DeviceKind}).ComposeKey()][4].ID)
+
+	// resuming from the initial position without a selector replays all notifications
+	watcher, status = h.WatchDevices(ctx, orgId, domain.ListDevicesParams{ResourceVersion: lo.ToPtr(start)})
+	require.Equal(int32(http.StatusOK), status.Code)
+	_, err = watcher.Next(ctx)
+	require.NoError(err)
+	events, err = watcher.Next(ctx)
+	require.NoError(err)
+	require.Len(events, 5)
+}
+
+func TestWatchDevicesLeavingSelection(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	orgId := uuid.New()
+	h := watchServiceHandler(newStreamKVStore())
+
+	watcher, status := h.WatchDevices(ctx, orgId, domain.ListDevicesParams{LabelSelector: lo.ToPtr("site=a")})
+	require.Equal(int32(http.StatusOK), status.Code)
+	_, err := watcher.Next(ctx)
+	require.NoError(err)
+
+	inA := prepareDevice(orgId, "dev")
+	inA.Metadata.Labels = &map[string]string{"site": "a"}
+	inB := prepareDevice(orgId, "dev")
+	inB.Metadata.Labels = &map[string]string{"site": "b"}
+	unlabeled := prepareDevice(orgId, "dev")
+
+	h.eventHandler.HandleDeviceUpdatedEvents(ctx, domain.DeviceKind, orgId, "dev", nil, inA, true, nil)
+	// leaving the selection is reported as a deletion carrying the new state
+	h.eventHandler.HandleDeviceUpdatedEvents(ctx, domain.DeviceKind, orgId, "dev", inA, inB, false, nil)
+	// changes outside of the selection are not reported
+	h.eventHandler.HandleDeviceUpdatedEvents(ctx, domain.DeviceKind, orgId, "dev", inB, unlabeled, false, nil)
+	// entering the selection again is reported as an addition
+	h.eventHandler.HandleDeviceUpdatedEvents(ctx, domain.DeviceKind, orgId, "dev", unlabeled, inA, false, nil)
+
+	events, err := watcher.Next(ctx)
+	require.NoError(err)
+	require.Len(events, 3)
+	require.Equal(domain.WatchEventAdded, events[0].Type)
+	require.Equal(domain.WatchEventDeleted, events[1].Type)
+	metadata := (*events[1].Object)["metadata"].(map[string]interface{})
+	require.Equal("dev", metadata["name"])
+	require.Equal(map[string]interface{}{"site": "b"}, metadata["labels"])
+	require.Equal(domain.WatchEventAdded, events[2].Type)
+
+	// watchers without a selector see the plain modifications
+	watcher, status = h.WatchDevices(ctx, orgId, domain.ListDevicesParams{ResourceVersion: lo.ToPtr("0-0")})
+	require.Equal(int32(http.StatusOK), status.Code)
+	_, err = watcher.Next(ctx)
+	require.NoError(err)
+	events, err = watcher.Next(ctx)
+	require.NoError(err)
+	require.Len(events, 4)
+	for _, event := range events[1:] {
+		require.Equal(domain.WatchEventModified, event.Type)
+	}
+}
+
+func TestWatchEvents(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	orgId := uuid.New()
+	h := watchServiceHandler(newStreamKVStore())
+
+	watcher, status := h.WatchEvents(ctx, orgId, domain.ListEventsParams{})
+	require.Equal(int32(http.StatusOK), status.Code)
+	_, err := watcher.Next(ctx)
+	require.NoError(err)
+
+	h.CreateEvent(ctx, orgId, domain.GetBaseEvent(ctx, domain.DeviceKind, "dev", domain.EventReasonDeviceDisconnected, "disconnected", nil))
+
+	events, err := watcher.Next(ctx)
+	require.NoError(err)
+	require.Len(events, 1)
+	require.Equal(domain.WatchEventAdded, events[0].Type)
+	require.Equal(string(domain.EventReasonDeviceDisconnected), (*events[0].Object)["reason"])
+}
+
+func TestWatchInvalidParams(t *testing.T) {
+	ctx := context.Background()
+	orgId := uuid.New()
+	h := watchServiceHandler(newStreamKVStore())
+
+	tests := []struct {
+		name   string
+		status func() domain.Status
+	}{
+		{
+			name: "invalid resourceVersion",
+			status: func() domain.Status {
+				_, s := h.WatchDevices(ctx, orgId, domain.ListDevicesParams{ResourceVersion: lo.ToPtr("abc")})
+				return s
+			},
+		},
+		{
+			name: "invalid label selector",
+			status: func() domain.Status {
+				_, s := h.WatchFleets(ctx, orgId, domain.ListFleetsParams{LabelSelector: lo.ToPtr("ke@y")})
+				return s
+			},
+		},
+		{
+			name: "summaryOnly is not supported",
+			status: func() domain.Status {
+				_, s := h.WatchDevices(ctx, orgId, domain.ListDevicesParams{SummaryOnly: lo.ToPtr(true)})
+				return s
+			},
+		},
+		{
+			name: "limit is not supported",
+			status: func() domain.Status {
+				_, s := h.WatchEvents(ctx, orgId, domain.ListEventsParams{Limit: lo.ToPtr(int32(10))})
+				return s
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, int32(http.StatusBadRequest), tt.status().Code)
+		})
+	}
+}
+
+func TestWatchExpiredResourceVersion(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	orgId := uuid.New()
+	kv := newStreamKVStore()
+	h := watchServiceHandler(kv)
+
+	publish := func(name string) {
+		h.eventHandler.HandleDeviceUpdatedEvents(ctx, domain.DeviceKind, orgId, name, nil, prepareDevice(orgId, name), true, nil)
+	}
+	publish("dev-0")
+	key := (&kvstore.WatchStreamKey{OrgID: orgId, Kind: domain.DeviceKind}).ComposeKey()
+	first := kv.streams[key][0].ID
+
+	// resuming from a retained position is possible as long as nothing has been trimmed
+	_, status := h.WatchDevices(ctx, orgId, domain.ListDevicesParams{ResourceVersion: lo.ToPtr(first)})
+	require.Equal(int32(http.StatusOK), status.Code)
+
+	watcher, status := h.WatchDevices(ctx, orgId, domain.ListDevicesParams{ResourceVersion: lo.ToPtr(first)})
+	require.Equal(int32(http.StatusOK), status.Code)
+	_, err := watcher.Next(ctx)
+	require.NoError(err)
+
+	for i := 1; i <= watchStreamMaxLen; i++ {
+		publish(fmt.Sprintf("dev-%d", i))
+	}
+
+	// the notification following the requested position has been trimmed
+	_, status = h.WatchDevices(ctx, orgId, domain.ListDevicesParams{ResourceVersion: lo.ToPtr(first)})
+	require.Equal(int32(http.StatusGone), status.Code)
+
+	// a running watcher that fell behind is expired as well
+	_, err = watcher.Next(ctx)
+	require.ErrorIs(err, ErrWatchExpired)
+
+	// the oldest retained notification is still a valid position
+	_, status = h.WatchDevices(ctx, orgId, domain.ListDevicesParams{ResourceVersion: lo.ToPtr(kv.streams[key][0].ID)})
+	require.Equal(int32(http.StatusOK), status.Code)
+}
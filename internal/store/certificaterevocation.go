@@ -0,0 +1,71 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type CertificateRevocation interface {
+	InitialMigration(ctx context.Context) error
+
+	Create(ctx context.Context, orgId uuid.UUID, deviceName string, revoked []domain.RevokedCertificate) error
+	// List returns the unexpired revocations of all organizations, as the CA is shared between them.
+	List(ctx context.Context) ([]domain.RevokedCertificate, error)
+}
+
+type CertificateRevocationStore struct {
+	dbHandler *gorm.DB
+	log       logrus.FieldLogger
+}
+
+// Make sure we conform to CertificateRevocation interface
+var _ CertificateRevocation = (*CertificateRevocationStore)(nil)
+
+func NewCertificateRevocation(db *gorm.DB, log logrus.FieldLogger) CertificateRevocation {
+	return &CertificateRevocationStore{dbHandler: db, log: log}
+}
+
+func (s *CertificateRevocationStore) getDB(ctx context.Context) *gorm.DB {
+	return s.dbHandler.WithContext(ctx)
+}
+
+func (s *CertificateRevocationStore) InitialMigration(ctx context.Context) error {
+	db := s.getDB(ctx)
+	return db.AutoMigrate(&model.CertificateRevocation{})
+}
+
+func (s *CertificateRevocationStore) Create(ctx context.Context, orgId uuid.UUID, deviceName string, revoked []domain.RevokedCertificate) error {
+	if len(revoked) == 0 {
+		return nil
+	}
+	revocations := make([]*model.CertificateRevocation, 0, len(revoked))
+	for i := range revoked {
+		revocations = append(revocations, model.NewCertificateRevocationFromApiResource(orgId, deviceName, &revoked[i]))
+	}
+	// a certificate that is already revoked keeps its original revocation time and reason
+	err := s.getDB(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "serial_number"}},
+		DoNothing: true,
+	}).Create(&revocations).Error
+	return ErrorFromGormError(err)
+}
+
+func (s *CertificateRevocationStore) List(ctx context.Context) ([]domain.RevokedCertificate, error) {
+	var revocations []model.CertificateRevocation
+	err := s.getDB(ctx).Where("not_after > ?", time.Now().UTC()).Order("revoked_at").Find(&revocations).Error
+	if err != nil {
+		return nil, ErrorFromGormError(err)
+	}
+	result := make([]domain.RevokedCertificate, 0, len(revocations))
+	for i := range revocations {
+		result = append(result, revocations[i].ToApiResource())
+	}
+	return result, nil
+}
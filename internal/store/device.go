@@ -62,7 +62,7 @@ type Device interface {
 
 	// Used internally
 	UpdateAnnotations(ctx context.Context, orgId uuid.UUID, name string, annotations map[string]string, deleteKeys []string) error
-	UpdateRendered(ctx context.Context, orgId uuid.UUID, name, renderedConfig, renderedApplications, specHash string) (string, error)
+	UpdateRendered(ctx context.Context, orgId uuid.UUID, name, renderedConfig, renderedApplications, renderedTrustPolicy, specHash string) (string, error)
 	SetServiceConditions(ctx context.Context, orgId uuid.UUID, name string, conditions []domain.Condition, callback ServiceConditionsCallback) error
 	DecommissionDevice(ctx context.Context, orgId uuid.UUID, name string, decom domain.DeviceDecommission, eventCallback EventCallback) (*domain.Device, error)
 	OverwriteRepositoryRefs(ctx context.Context, orgId uuid.UUID, name string, repositoryNames ...string) error
@@ -505,14 +505,14 @@ func (s *DeviceStore) Labels(ctx context.Context, orgId uuid.UUID, listParams Li
 }
 
 func (s *DeviceStore) Delete(ctx context.Context, orgId uuid.UUID, name string, eventCallback EventCallback) (bool, error) {
-	deleted, err := s.genericStore.Delete(
+	device, err := s.genericStore.DeleteReturning(
 		ctx,
 		model.Device{Resource: model.Resource{OrgID: orgId, Name: name}},
 		Resource{Table: "enrollment_requests", OrgID: orgId.String(), Name: name})
-	if deleted && eventCallback != nil {
-		s.callEventCallback(ctx, eventCallback, orgId, name, nil, nil, false, err)
+	if device != nil && eventCallback != nil {
+		s.callEventCallback(ctx, eventCallback, orgId, name, device, nil, false, err)
 	}
-	return deleted, err
+	return device != nil, err
 }
 
 func (s *DeviceStore) Count(ctx context.Context, orgId uuid.UUID, listParams ListParams) (int64, error) {
@@ -959,7 +959,7 @@ func (s *DeviceStore) SetOutOfDate(ctx context.Context, orgId uuid.UUID, owner s
 	})
 }
 
-func (s *DeviceStore) updateRendered(ctx context.Context, orgId uuid.UUID, name, renderedConfig, renderedApplications, specHash string) (retry bool, renderedVersion string, err error) {
+func (s *DeviceStore) updateRendered(ctx context.Context, orgId uuid.UUID, name, renderedConfig, renderedApplications, renderedTrustPolicy, specHash string) (retry bool, renderedVersion string, err error) {
 	existingRecord := model.Device{Resource: model.Resource{OrgID: orgId, Name: name}}
 	result := s.getDB(ctx).Take(&existingRecord)
 	if result.Error != nil {
@@ -991,16 +991,27 @@ func (s *DeviceStore) updateRendered(ctx context.Context, orgId uuid.UUID, name,
 		}
 	}
 	existingAnnotations[domain.DeviceAnnotationRenderedSpecHash] = hash
+	// the rendered device is sent with the OS image of the spec, so record which image was sent
+	if existingRecord.Spec != nil && existingRecord.Spec.Data.Os != nil {
+		existingAnnotations[domain.DeviceAnnotationRenderedOsImage] = existingRecord.Spec.Data.Os.Image
+	} else {
+		delete(existingAnnotations, domain.DeviceAnnotationRenderedOsImage)
+	}
 
 	renderedApplicationsJSON := renderedApplications
 	if strings.TrimSpace(renderedApplications) == "" {
 		renderedApplicationsJSON = "[]"
 	}
+	renderedTrustPolicyJSON := renderedTrustPolicy
+	if strings.TrimSpace(renderedTrustPolicy) == "" {
+		renderedTrustPolicyJSON = "null"
+	}
 
 	result = s.getDB(ctx).Model(existingRecord).Where("resource_version = ?", lo.FromPtr(existingRecord.ResourceVersion)).Updates(map[string]interface{}{
 		"annotations":           model.MakeJSONMap(existingAnnotations),
 		"rendered_config":       &renderedConfig,
 		"rendered_applications": &renderedApplicationsJSON,
+		"rendered_trust_policy": &renderedTrustPolicyJSON,
 		"resource_version":      gorm.Expr("resource_version + 1"),
 		"render_timestamp":      time.Now(),
 	})
@@ -1015,13 +1026,13 @@ func (s *DeviceStore) updateRendered(ctx context.Context, orgId uuid.UUID, name,
 	return false, nextRenderedVersion, nil
 }
 
-func (s *DeviceStore) UpdateRendered(ctx context.Context, orgId uuid.UUID, name, renderedConfig, renderedApplications, specHash string) (string, error) {
+func (s *DeviceStore) UpdateRendered(ctx context.Context, orgId uuid.UUID, name, renderedConfig, renderedApplications, renderedTrustPolicy, specHash string) (string, error) {
 	var rv string
 
 	wrapper := func() (bool, error) {
 		var retry bool
 		var err error
-		retry, rv, err = s.updateRendered(ctx, orgId, name, renderedConfig, renderedApplications, specHash)
+		retry, rv, err = s.updateRendered(ctx, orgId, name, renderedConfig, renderedApplications, renderedTrustPolicy, specHash)
 		return retry, err
 	}
 
@@ -0,0 +1,131 @@
+package store
+
+import (
+	"context"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type EnrollmentPolicy interface {
+	InitialMigration(ctx context.Context) error
+
+	Create(ctx context.Context, orgId uuid.UUID, enrollmentPolicy *domain.EnrollmentPolicy, eventCallback EventCallback) (*domain.EnrollmentPolicy, error)
+	Update(ctx context.Context, orgId uuid.UUID, enrollmentPolicy *domain.EnrollmentPolicy, eventCallback EventCallback) (*domain.EnrollmentPolicy, error)
+	CreateOrUpdate(ctx context.Context, orgId uuid.UUID, enrollmentPolicy *domain.EnrollmentPolicy, eventCallback EventCallback) (*domain.EnrollmentPolicy, bool, error)
+	Get(ctx context.Context, orgId uuid.UUID, name string) (*domain.EnrollmentPolicy, error)
+	List(ctx context.Context, orgId uuid.UUID, listParams ListParams) (*domain.EnrollmentPolicyList, error)
+	Delete(ctx context.Context, orgId uuid.UUID, name string, eventCallback EventCallback) error
+	Count(ctx context.Context, orgId uuid.UUID, listParams ListParams) (int64, error)
+}
+
+type EnrollmentPolicyStore struct {
+	dbHandler           *gorm.DB
+	log                 logrus.FieldLogger
+	genericStore        *GenericStore[*model.EnrollmentPolicy, model.EnrollmentPolicy, domain.EnrollmentPolicy, domain.EnrollmentPolicyList]
+	eventCallbackCaller EventCallbackCaller
+}
+
+// Make sure we conform to EnrollmentPolicy interface
+var _ EnrollmentPolicy = (*EnrollmentPolicyStore)(nil)
+
+func NewEnrollmentPolicy(db *gorm.DB, log logrus.FieldLogger) EnrollmentPolicy {
+	genericStore := NewGenericStore[*model.EnrollmentPolicy, model.EnrollmentPolicy, domain.EnrollmentPolicy, domain.EnrollmentPolicyList](
+		db,
+		log,
+		model.NewEnrollmentPolicyFromApiResource,
+		(*model.EnrollmentPolicy).ToApiResource,
+		model.EnrollmentPoliciesToApiResource,
+	)
+	return &EnrollmentPolicyStore{dbHandler: db, log: log, genericStore: genericStore, eventCallbackCaller: CallEventCallback(domain.EnrollmentPolicyKind, log)}
+}
+
+func (s *EnrollmentPolicyStore) getDB(ctx context.Context) *gorm.DB {
+	return s.dbHandler.WithContext(ctx)
+}
+
+func (s *EnrollmentPolicyStore) InitialMigration(ctx context.Context) error {
+	db := s.getDB(ctx)
+
+	if err := db.AutoMigrate(&model.EnrollmentPolicy{}); err != nil {
+		return err
+	}
+
+	// Create GIN index for EnrollmentPolicy labels
+	if !db.Migrator().HasIndex(&model.EnrollmentPolicy{}, "idx_enrollment_policies_labels") {
+		if db.Dialector.Name() == "postgres" {
+			if err := db.Exec("CREATE INDEX idx_enrollment_policies_labels ON enrollment_policies USING GIN (labels)").Error; err != nil {
+				return err
+			}
+		} else {
+			if err := db.Migrator().CreateIndex(&model.EnrollmentPolicy{}, "Labels"); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Create GIN index for EnrollmentPolicy annotations
+	if !db.Migrator().HasIndex(&model.EnrollmentPolicy{}, "idx_enrollment_policies_annotations") {
+		if db.Dialector.Name() == "postgres" {
+			if err := db.Exec("CREATE INDEX idx_enrollment_policies_annotations ON enrollment_policies USING GIN (annotations)").Error; err != nil {
+				return err
+			}
+		} else {
+			if err := db.Migrator().CreateIndex(&model.EnrollmentPolicy{}, "Annotations"); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *EnrollmentPolicyStore) Create(ctx context.Context, orgId uuid.UUID, resource *domain.EnrollmentPolicy, eventCallback EventCallback) (*domain.EnrollmentPolicy, error) {
+	ep, err := s.genericStore.Create(ctx, orgId, resource)
+	s.eventCallbackCaller(ctx, eventCallback, orgId, lo.FromPtr(resource.Metadata.Name), nil, ep, true, err)
+	return ep, err
+}
+
+func (s *EnrollmentPolicyStore) Update(ctx context.Context, orgId uuid.UUID, resource *domain.EnrollmentPolicy, eventCallback EventCallback) (*domain.EnrollmentPolicy, error) {
+	newEp, oldEp, err := s.genericStore.Update(ctx, orgId, resource, nil, true, nil)
+	s.eventCallbackCaller(ctx, eventCallback, orgId, lo.FromPtr(resource.Metadata.Name), oldEp, newEp, false, err)
+	return newEp, err
+}
+
+func (s *EnrollmentPolicyStore) CreateOrUpdate(ctx context.Context, orgId uuid.UUID, resource *domain.EnrollmentPolicy, eventCallback EventCallback) (*domain.EnrollmentPolicy, bool, error) {
+	newEp, oldEp, created, err := s.genericStore.CreateOrUpdate(ctx, orgId, resource, nil, true, nil)
+	s.eventCallbackCaller(ctx, eventCallback, orgId, lo.FromPtr(resource.Metadata.Name), oldEp, newEp, created, err)
+	return newEp, created, err
+}
+
+func (s *EnrollmentPolicyStore) Get(ctx context.Context, orgId uuid.UUID, name string) (*domain.EnrollmentPolicy, error) {
+	return s.genericStore.Get(ctx, orgId, name)
+}
+
+func (s *EnrollmentPolicyStore) List(ctx context.Context, orgId uuid.UUID, listParams ListParams) (*domain.EnrollmentPolicyList, error) {
+	return s.genericStore.List(ctx, orgId, listParams)
+}
+
+func (s *EnrollmentPolicyStore) Delete(ctx context.Context, orgId uuid.UUID, name string, eventCallback EventCallback) error {
+	deleted, err := s.genericStore.Delete(ctx, model.EnrollmentPolicy{Resource: model.Resource{OrgID: orgId, Name: name}})
+	if deleted && eventCallback != nil {
+		s.eventCallbackCaller(ctx, eventCallback, orgId, name, nil, nil, false, nil)
+	}
+	return err
+}
+
+func (s *EnrollmentPolicyStore) Count(ctx context.Context, orgId uuid.UUID, listParams ListParams) (int64, error) {
+	query, err := ListQuery(&model.EnrollmentPolicy{}).Build(ctx, s.getDB(ctx), orgId, listParams)
+	if err != nil {
+		return 0, err
+	}
+	var enrollmentPoliciesCount int64
+	if err := query.Count(&enrollmentPoliciesCount).Error; err != nil {
+		return 0, ErrorFromGormError(err)
+	}
+	return enrollmentPoliciesCount, nil
+}
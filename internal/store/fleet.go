@@ -35,6 +35,7 @@ type Fleet interface {
 	UnsetOwnerByKind(ctx context.Context, tx *gorm.DB, orgId uuid.UUID, resourceKind string) error
 	UpdateConditions(ctx context.Context, orgId uuid.UUID, name string, conditions []domain.Condition, eventCallback EventCallback) error
 	UpdateAnnotations(ctx context.Context, orgId uuid.UUID, name string, annotations map[string]string, deleteKeys []string, eventCallback EventCallback) error
+	UpdateRolloutStatus(ctx context.Context, orgId uuid.UUID, name string, rollout *domain.FleetRolloutStatus) error
 	OverwriteRepositoryRefs(ctx context.Context, orgId uuid.UUID, name string, repositoryNames ...string) error
 	GetRepositoryRefs(ctx context.Context, orgId uuid.UUID, name string) (*domain.RepositoryList, error)
 
@@ -363,12 +364,12 @@ func (s *FleetStore) ListIgnoreOrg(ctx context.Context) ([]model.Fleet, error) {
 }
 
 func (s *FleetStore) Delete(ctx context.Context, orgId uuid.UUID, name string, eventCallback EventCallback) error {
-	deleted, err := s.genericStore.Delete(
+	fleet, err := s.genericStore.DeleteReturning(
 		ctx,
 		model.Fleet{Resource: model.Resource{OrgID: orgId, Name: name}},
 	)
-	if deleted && eventCallback != nil {
-		s.callEventCallback(ctx, eventCallback, orgId, name, nil, nil, false, err)
+	if fleet != nil && eventCallback != nil {
+		s.callEventCallback(ctx, eventCallback, orgId, name, fleet, nil, false, err)
 	}
 	return err
 }
@@ -459,6 +460,38 @@ func (s *FleetStore) UpdateConditions(ctx context.Context, orgId uuid.UUID, name
 	})
 }
 
+func (s *FleetStore) updateRolloutStatus(ctx context.Context, orgId uuid.UUID, name string, rollout *domain.FleetRolloutStatus) (bool, error) {
+	existingRecord := model.Fleet{Resource: model.Resource{OrgID: orgId, Name: name}}
+	result := s.getDB(ctx).Take(&existingRecord)
+	if result.Error != nil {
+		return false, ErrorFromGormError(result.Error)
+	}
+
+	if existingRecord.Status == nil {
+		existingRecord.Status = model.MakeJSONField(domain.FleetStatus{})
+	}
+	existingRecord.Status.Data.Rollout = rollout
+
+	result = s.getDB(ctx).Model(existingRecord).Where("resource_version = ?", lo.FromPtr(existingRecord.ResourceVersion)).Updates(map[string]interface{}{
+		"status":           existingRecord.Status,
+		"resource_version": gorm.Expr("resource_version + 1"),
+	})
+	if err := ErrorFromGormError(result.Error); err != nil {
+		return strings.Contains(err.Error(), "deadlock"), err
+	}
+	if result.RowsAffected == 0 {
+		return true, flterrors.ErrNoRowsUpdated
+	}
+	return false, nil
+}
+
+// UpdateRolloutStatus replaces the rollout status of the fleet, leaving the rest of its status unchanged
+func (s *FleetStore) UpdateRolloutStatus(ctx context.Context, orgId uuid.UUID, name string, rollout *domain.FleetRolloutStatus) error {
+	return retryUpdate(func() (bool, error) {
+		return s.updateRolloutStatus(ctx, orgId, name, rollout)
+	})
+}
+
 func (s *FleetStore) updateAnnotations(ctx context.Context, existingRecord model.Fleet, existingAnnotations map[string]string) (bool, error) {
 	result := s.getDB(ctx).Model(existingRecord).Where("resource_version = ?", lo.FromPtr(existingRecord.ResourceVersion)).Updates(map[string]interface{}{
 		"annotations":      model.MakeJSONMap(existingAnnotations),
@@ -25,7 +25,7 @@ type IntegrationTestCallback func()
 // A is the API resource, for example: domain.Device
 // AL is the API list, for example: domain.DeviceList
 type Model interface {
-	model.AuthProvider | model.Catalog | model.CertificateSigningRequest | model.Device | model.EnrollmentRequest | model.Fleet | model.Repository | model.ResourceSync | model.TemplateVersion | model.Event
+	model.AuthProvider | model.Catalog | model.CertificateSigningRequest | model.Device | model.EnrollmentPolicy | model.EnrollmentRequest | model.Fleet | model.Repository | model.ReferenceMeasurement | model.ResourceSync | model.Secret | model.TemplateVersion | model.TrustPolicy | model.Event
 }
 type extInt[M any] interface {
 	model.ResourceInterface
@@ -185,6 +185,18 @@ func (s *GenericStore[P, M, A, AL]) createResource(ctx context.Context, resource
 	return false, nil
 }
 
+// unownedSpecComparer is implemented by resources whose spec contains fields that are not set by the resource's
+// owner, such as a device's variables, and may therefore be updated even if the resource has an owner.
+type unownedSpecComparer interface {
+	HasSameOwnedSpecAs(otherResource any) bool
+}
+
+// onlyUnownedSpecChanged returns true if the specs of the resources differ only in fields not set by the owner.
+func onlyUnownedSpecChanged(existing, resource any) bool {
+	comparer, ok := resource.(unownedSpecComparer)
+	return ok && comparer.HasSameOwnedSpecAs(existing)
+}
+
 func (s *GenericStore[P, M, A, AL]) updateResource(ctx context.Context, fromAPI bool, existing, resource P, fieldsToUnset []string) (bool, error) {
 	hasOwner := len(lo.FromPtr(existing.GetOwner())) != 0
 
@@ -199,7 +211,7 @@ func (s *GenericStore[P, M, A, AL]) updateResource(ctx context.Context, fromAPI
 
 	sameSpec := resource.HasSameSpecAs(existing)
 	if !sameSpec {
-		if fromAPI && hasOwner && !allowResourceSyncUpdate {
+		if fromAPI && hasOwner && !allowResourceSyncUpdate && !onlyUnownedSpecChanged(existing, resource) {
 			// Don't let the user update the spec if it has an owner
 			return false, flterrors.ErrUpdatingResourceWithOwnerNotAllowed
 		}
@@ -282,9 +294,9 @@ func (s *GenericStore[P, M, A, AL]) Delete(ctx context.Context, resource M, asso
 	var err error
 
 	if len(associatedResources) == 0 {
-		deleted, err = s.delete(ctx, resource)
+		deleted, err = s.delete(ctx, &resource)
 	} else {
-		deleted, err = s.deleteWithAssociated(ctx, resource, associatedResources...)
+		deleted, err = s.deleteWithAssociated(ctx, &resource, associatedResources...)
 	}
 	if err != nil {
 		return false, err
@@ -293,8 +305,25 @@ func (s *GenericStore[P, M, A, AL]) Delete(ctx context.Context, resource M, asso
 	return deleted, nil
 }
 
-func (s *GenericStore[P, M, A, AL]) delete(ctx context.Context, resource M) (bool, error) {
-	result := s.getDB(ctx).Unscoped().Where("spec IS NOT NULL").Delete(&resource)
+// DeleteReturning deletes the resource like Delete and returns its last version, or nil if it did not exist.
+func (s *GenericStore[P, M, A, AL]) DeleteReturning(ctx context.Context, resource M, associatedResources ...Resource) (*A, error) {
+	var deleted bool
+	var err error
+
+	if len(associatedResources) == 0 {
+		deleted, err = s.delete(ctx, &resource)
+	} else {
+		deleted, err = s.deleteWithAssociated(ctx, &resource, associatedResources...)
+	}
+	if err != nil || !deleted {
+		return nil, err
+	}
+
+	return s.modelPtrToAPI(&resource)
+}
+
+func (s *GenericStore[P, M, A, AL]) delete(ctx context.Context, resource P) (bool, error) {
+	result := s.getDB(ctx).Unscoped().Clauses(clause.Returning{}).Where("spec IS NOT NULL").Delete(resource)
 	if result.Error != nil {
 		return false, ErrorFromGormError(result.Error)
 	}
@@ -305,10 +334,10 @@ func (s *GenericStore[P, M, A, AL]) delete(ctx context.Context, resource M) (boo
 	return true, nil
 }
 
-func (s *GenericStore[P, M, A, AL]) deleteWithAssociated(ctx context.Context, resource M, associatedResources ...Resource) (bool, error) {
+func (s *GenericStore[P, M, A, AL]) deleteWithAssociated(ctx context.Context, resource P, associatedResources ...Resource) (bool, error) {
 	deleted := false
 	err := s.getDB(ctx).Transaction(func(innerTx *gorm.DB) (err error) {
-		result := innerTx.Unscoped().Delete(&resource)
+		result := innerTx.Unscoped().Clauses(clause.Returning{}).Delete(resource)
 		if result.Error != nil {
 			return ErrorFromGormError(result.Error)
 		}
@@ -0,0 +1,49 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/google/uuid"
+)
+
+// CertificateRevocation is an entry of the revocation list of the service's CA.
+// Serial numbers are issued by a single CA and are therefore unique across organizations.
+type CertificateRevocation struct {
+	SerialNumber string    `gorm:"primaryKey"`
+	OrgID        uuid.UUID `gorm:"type:uuid;index"`
+	DeviceName   string    `gorm:"index"`
+	Reason       string
+	RevokedAt    time.Time
+	NotAfter     time.Time `gorm:"index"`
+}
+
+func (r CertificateRevocation) String() string {
+	val, err := json.Marshal(r)
+	if err != nil {
+		return fmt.Sprintf("CertificateRevocation<marshal-error:%v>", err)
+	}
+	return string(val)
+}
+
+func NewCertificateRevocationFromApiResource(orgId uuid.UUID, deviceName string, revoked *domain.RevokedCertificate) *CertificateRevocation {
+	return &CertificateRevocation{
+		SerialNumber: revoked.SerialNumber,
+		OrgID:        orgId,
+		DeviceName:   deviceName,
+		Reason:       string(revoked.Reason),
+		RevokedAt:    revoked.RevokedAt.UTC(),
+		NotAfter:     revoked.NotAfter.UTC(),
+	}
+}
+
+func (r *CertificateRevocation) ToApiResource() domain.RevokedCertificate {
+	return domain.RevokedCertificate{
+		SerialNumber: r.SerialNumber,
+		Reason:       domain.CertificateRevocationReason(r.Reason),
+		RevokedAt:    r.RevokedAt,
+		NotAfter:     r.NotAfter,
+	}
+}
@@ -37,6 +37,9 @@ type Device struct {
 	// The rendered application provided by the service.
 	RenderedApplications *JSONField[*[]domain.ApplicationProviderSpec] `gorm:"type:jsonb"`
 
+	// The trust policy the device was rendered with, including the spec of the referenced TrustPolicy.
+	RenderedTrustPolicy *JSONField[*domain.DeviceTrustPolicy] `gorm:"type:jsonb"`
+
 	// Join table with the relationship of devices to repositories (only maintained for standalone devices)
 	Repositories []Repository `gorm:"many2many:device_repos;constraint:OnDelete:CASCADE;"`
 
@@ -200,6 +203,10 @@ func (d *Device) ToApiResource(opts ...APIResourceOption) (*domain.Device, error
 		// TODO: handle multiple consoles, for now we just encapsulate our one console in a list
 		spec.Config = d.RenderedConfig.Data
 		spec.Applications = d.RenderedApplications.Data
+		spec.TrustPolicy = nil
+		if d.RenderedTrustPolicy != nil {
+			spec.TrustPolicy = d.RenderedTrustPolicy.Data
+		}
 		spec.Consoles = &consoles
 	}
 
@@ -299,6 +306,18 @@ func (d *Device) HasSameSpecAs(otherResource any) bool {
 	return domain.DeviceSpecsAreEqual(d.Spec.Data, other.Spec.Data)
 }
 
+// HasSameOwnedSpecAs compares the specs without the variables, which are set per device rather than by the
+// device's fleet.
+func (d *Device) HasSameOwnedSpecAs(otherResource any) bool {
+	other, ok := otherResource.(*Device)
+	if !ok || other == nil || d.Spec == nil || other.Spec == nil {
+		return false
+	}
+	spec, otherSpec := d.Spec.Data, other.Spec.Data
+	spec.Variables, otherSpec.Variables = nil, nil
+	return domain.DeviceSpecsAreEqual(spec, otherSpec)
+}
+
 func (d *Device) GetStatusAsJson() ([]byte, error) {
 	return d.Status.MarshalJSON()
 }
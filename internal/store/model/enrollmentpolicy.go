@@ -0,0 +1,126 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/samber/lo"
+)
+
+type EnrollmentPolicy struct {
+	Resource
+
+	// The approval rules, stored as opaque JSON object.
+	Spec *JSONField[domain.EnrollmentPolicySpec] `gorm:"type:jsonb"`
+}
+
+func (ep *EnrollmentPolicy) String() string {
+	val, _ := json.Marshal(ep)
+	return string(val)
+}
+
+func NewEnrollmentPolicyFromApiResource(resource *domain.EnrollmentPolicy) (*EnrollmentPolicy, error) {
+	if resource == nil || resource.Metadata.Name == nil {
+		return &EnrollmentPolicy{}, nil
+	}
+
+	var resourceVersion *int64
+	if resource.Metadata.ResourceVersion != nil {
+		i, err := strconv.ParseInt(lo.FromPtr(resource.Metadata.ResourceVersion), 10, 64)
+		if err != nil {
+			return nil, flterrors.ErrIllegalResourceVersionFormat
+		}
+		resourceVersion = &i
+	}
+	return &EnrollmentPolicy{
+		Resource: Resource{
+			Name:            *resource.Metadata.Name,
+			Labels:          lo.FromPtrOr(resource.Metadata.Labels, make(map[string]string)),
+			Annotations:     lo.FromPtrOr(resource.Metadata.Annotations, make(map[string]string)),
+			ResourceVersion: resourceVersion,
+		},
+		Spec: MakeJSONField(resource.Spec),
+	}, nil
+}
+
+func EnrollmentPolicyAPIVersion() string {
+	return fmt.Sprintf("%s/%s", domain.APIGroup, domain.EnrollmentPolicyAPIVersion)
+}
+
+func (ep *EnrollmentPolicy) ToApiResource(opts ...APIResourceOption) (*domain.EnrollmentPolicy, error) {
+	if ep == nil {
+		return &domain.EnrollmentPolicy{}, nil
+	}
+
+	var spec domain.EnrollmentPolicySpec
+	if ep.Spec != nil {
+		spec = ep.Spec.Data
+	}
+
+	return &domain.EnrollmentPolicy{
+		ApiVersion: EnrollmentPolicyAPIVersion(),
+		Kind:       domain.EnrollmentPolicyKind,
+		Metadata: domain.ObjectMeta{
+			Name:              lo.ToPtr(ep.Name),
+			CreationTimestamp: lo.ToPtr(ep.CreatedAt.UTC()),
+			Labels:            lo.ToPtr(util.EnsureMap(ep.Resource.Labels)),
+			Annotations:       lo.ToPtr(util.EnsureMap(ep.Resource.Annotations)),
+			Generation:        ep.Generation,
+			ResourceVersion:   lo.Ternary(ep.ResourceVersion != nil, lo.ToPtr(strconv.FormatInt(lo.FromPtr(ep.ResourceVersion), 10)), nil),
+		},
+		Spec: spec,
+	}, nil
+}
+
+func EnrollmentPoliciesToApiResource(eps []EnrollmentPolicy, cont *string, numRemaining *int64) (domain.EnrollmentPolicyList, error) {
+	enrollmentPolicyList := make([]domain.EnrollmentPolicy, len(eps))
+	for i, enrollmentPolicy := range eps {
+		apiResource, _ := enrollmentPolicy.ToApiResource()
+		enrollmentPolicyList[i] = *apiResource
+	}
+	ret := domain.EnrollmentPolicyList{
+		ApiVersion: EnrollmentPolicyAPIVersion(),
+		Kind:       domain.EnrollmentPolicyListKind,
+		Items:      enrollmentPolicyList,
+		Metadata:   domain.ListMeta{},
+	}
+	if cont != nil {
+		ret.Metadata.Continue = cont
+		ret.Metadata.RemainingItemCount = numRemaining
+	}
+	return ret, nil
+}
+
+func (ep *EnrollmentPolicy) GetKind() string {
+	return domain.EnrollmentPolicyKind
+}
+
+func (ep *EnrollmentPolicy) HasNilSpec() bool {
+	return ep.Spec == nil
+}
+
+func (ep *EnrollmentPolicy) HasSameSpecAs(otherResource any) bool {
+	other, ok := otherResource.(*EnrollmentPolicy) // Assert that the other resource is a *EnrollmentPolicy
+	if !ok {
+		return false // Not the same type, so specs cannot be the same
+	}
+	if other == nil {
+		return false
+	}
+	if ep.Spec == nil && other.Spec == nil {
+		return true
+	}
+	if (ep.Spec == nil && other.Spec != nil) || (ep.Spec != nil && other.Spec == nil) {
+		return false
+	}
+	return reflect.DeepEqual(ep.Spec.Data, other.Spec.Data)
+}
+
+func (ep *EnrollmentPolicy) GetStatusAsJson() ([]byte, error) {
+	return []byte("{}"), nil
+}
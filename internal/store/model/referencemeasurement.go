@@ -0,0 +1,126 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/samber/lo"
+)
+
+type ReferenceMeasurement struct {
+	Resource
+
+	// The expected measurements, stored as opaque JSON object.
+	Spec *JSONField[domain.ReferenceMeasurementSpec] `gorm:"type:jsonb"`
+}
+
+func (rm *ReferenceMeasurement) String() string {
+	val, _ := json.Marshal(rm)
+	return string(val)
+}
+
+func NewReferenceMeasurementFromApiResource(resource *domain.ReferenceMeasurement) (*ReferenceMeasurement, error) {
+	if resource == nil || resource.Metadata.Name == nil {
+		return &ReferenceMeasurement{}, nil
+	}
+
+	var resourceVersion *int64
+	if resource.Metadata.ResourceVersion != nil {
+		i, err := strconv.ParseInt(lo.FromPtr(resource.Metadata.ResourceVersion), 10, 64)
+		if err != nil {
+			return nil, flterrors.ErrIllegalResourceVersionFormat
+		}
+		resourceVersion = &i
+	}
+	return &ReferenceMeasurement{
+		Resource: Resource{
+			Name:            *resource.Metadata.Name,
+			Labels:          lo.FromPtrOr(resource.Metadata.Labels, make(map[string]string)),
+			Annotations:     lo.FromPtrOr(resource.Metadata.Annotations, make(map[string]string)),
+			ResourceVersion: resourceVersion,
+		},
+		Spec: MakeJSONField(resource.Spec),
+	}, nil
+}
+
+func ReferenceMeasurementAPIVersion() string {
+	return fmt.Sprintf("%s/%s", domain.APIGroup, domain.ReferenceMeasurementAPIVersion)
+}
+
+func (rm *ReferenceMeasurement) ToApiResource(opts ...APIResourceOption) (*domain.ReferenceMeasurement, error) {
+	if rm == nil {
+		return &domain.ReferenceMeasurement{}, nil
+	}
+
+	var spec domain.ReferenceMeasurementSpec
+	if rm.Spec != nil {
+		spec = rm.Spec.Data
+	}
+
+	return &domain.ReferenceMeasurement{
+		ApiVersion: ReferenceMeasurementAPIVersion(),
+		Kind:       domain.ReferenceMeasurementKind,
+		Metadata: domain.ObjectMeta{
+			Name:              lo.ToPtr(rm.Name),
+			CreationTimestamp: lo.ToPtr(rm.CreatedAt.UTC()),
+			Labels:            lo.ToPtr(util.EnsureMap(rm.Resource.Labels)),
+			Annotations:       lo.ToPtr(util.EnsureMap(rm.Resource.Annotations)),
+			Generation:        rm.Generation,
+			ResourceVersion:   lo.Ternary(rm.ResourceVersion != nil, lo.ToPtr(strconv.FormatInt(lo.FromPtr(rm.ResourceVersion), 10)), nil),
+		},
+		Spec: spec,
+	}, nil
+}
+
+func ReferenceMeasurementsToApiResource(rms []ReferenceMeasurement, cont *string, numRemaining *int64) (domain.ReferenceMeasurementList, error) {
+	referenceMeasurementList := make([]domain.ReferenceMeasurement, len(rms))
+	for i, referenceMeasurement := range rms {
+		apiResource, _ := referenceMeasurement.ToApiResource()
+		referenceMeasurementList[i] = *apiResource
+	}
+	ret := domain.ReferenceMeasurementList{
+		ApiVersion: ReferenceMeasurementAPIVersion(),
+		Kind:       domain.ReferenceMeasurementListKind,
+		Items:      referenceMeasurementList,
+		Metadata:   domain.ListMeta{},
+	}
+	if cont != nil {
+		ret.Metadata.Continue = cont
+		ret.Metadata.RemainingItemCount = numRemaining
+	}
+	return ret, nil
+}
+
+func (rm *ReferenceMeasurement) GetKind() string {
+	return domain.ReferenceMeasurementKind
+}
+
+func (rm *ReferenceMeasurement) HasNilSpec() bool {
+	return rm.Spec == nil
+}
+
+func (rm *ReferenceMeasurement) HasSameSpecAs(otherResource any) bool {
+	other, ok := otherResource.(*ReferenceMeasurement) // Assert that the other resource is a *ReferenceMeasurement
+	if !ok {
+		return false // Not the same type, so specs cannot be the same
+	}
+	if other == nil {
+		return false
+	}
+	if rm.Spec == nil && other.Spec == nil {
+		return true
+	}
+	if (rm.Spec == nil && other.Spec != nil) || (rm.Spec != nil && other.Spec == nil) {
+		return false
+	}
+	return reflect.DeepEqual(rm.Spec.Data, other.Spec.Data)
+}
+
+func (rm *ReferenceMeasurement) GetStatusAsJson() ([]byte, error) {
+	return []byte("{}"), nil
+}
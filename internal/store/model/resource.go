@@ -172,8 +172,12 @@ var _ ResourceInterface = (*Device)(nil)
 var _ ResourceInterface = (*Fleet)(nil)
 var _ ResourceInterface = (*Catalog)(nil)
 var _ ResourceInterface = (*CertificateSigningRequest)(nil)
+var _ ResourceInterface = (*EnrollmentPolicy)(nil)
 var _ ResourceInterface = (*EnrollmentRequest)(nil)
 var _ ResourceInterface = (*Repository)(nil)
+var _ ResourceInterface = (*ReferenceMeasurement)(nil)
 var _ ResourceInterface = (*ResourceSync)(nil)
+var _ ResourceInterface = (*Secret)(nil)
 var _ ResourceInterface = (*TemplateVersion)(nil)
+var _ ResourceInterface = (*TrustPolicy)(nil)
 var _ ResourceInterface = (*Event)(nil)
@@ -0,0 +1,183 @@
+package model
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/flightctl/flightctl/internal/crypto"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/samber/lo"
+)
+
+type Secret struct {
+	Resource
+
+	// The encrypted data, stored as opaque JSON object.
+	Spec *JSONField[SecretSpec] `gorm:"type:jsonb"`
+}
+
+// SecretSpec is the envelope-encrypted data of a secret.
+type SecretSpec struct {
+	// Identifies the key encryption key, so that data encrypted with another key is detected.
+	KeyID string `json:"keyId"`
+
+	// The data encryption key, encrypted with the key encryption key.
+	EncryptedKey []byte `json:"encryptedKey"`
+
+	// The secret's data, encrypted with a data encryption key that is unique to this version of the secret.
+	EncryptedData []byte `json:"encryptedData"`
+
+	// A keyed digest of the data, so that changes can be detected without decrypting it.
+	DataDigest []byte `json:"dataDigest"`
+}
+
+func (s *Secret) String() string {
+	val, _ := json.Marshal(s)
+	return string(val)
+}
+
+// NewSecretFromApiResource encrypts the data of the secret with the envelope. The name of the secret is bound to
+// the encrypted data, so that it cannot be decrypted as another secret.
+func NewSecretFromApiResource(resource *domain.Secret, envelope *crypto.Envelope) (*Secret, error) {
+	if resource == nil || resource.Metadata.Name == nil {
+		return &Secret{}, nil
+	}
+	if envelope == nil {
+		return nil, flterrors.ErrSecretEncryptionNotConfigured
+	}
+
+	var resourceVersion *int64
+	if resource.Metadata.ResourceVersion != nil {
+		i, err := strconv.ParseInt(lo.FromPtr(resource.Metadata.ResourceVersion), 10, 64)
+		if err != nil {
+			return nil, flterrors.ErrIllegalResourceVersionFormat
+		}
+		resourceVersion = &i
+	}
+
+	// SecureString values are redacted when marshalled, so the data is marshalled as plain strings
+	data, err := json.Marshal(lo.MapValues(resource.Spec.Data, func(v domain.SecureString, _ string) string { return v.Value() }))
+	if err != nil {
+		return nil, fmt.Errorf("marshalling secret data: %w", err)
+	}
+	sealed, err := envelope.Seal(data, []byte(*resource.Metadata.Name))
+	if err != nil {
+		return nil, fmt.Errorf("encrypting secret data: %w", err)
+	}
+
+	return &Secret{
+		Resource: Resource{
+			Name:            *resource.Metadata.Name,
+			Labels:          lo.FromPtrOr(resource.Metadata.Labels, make(map[string]string)),
+			Annotations:     lo.FromPtrOr(resource.Metadata.Annotations, make(map[string]string)),
+			ResourceVersion: resourceVersion,
+		},
+		Spec: MakeJSONField(SecretSpec{
+			KeyID:         sealed.KeyID,
+			EncryptedKey:  sealed.EncryptedKey,
+			EncryptedData: sealed.Ciphertext,
+			DataDigest:    envelope.Digest(data),
+		}),
+	}, nil
+}
+
+func SecretAPIVersion() string {
+	return fmt.Sprintf("%s/%s", domain.APIGroup, domain.SecretAPIVersion)
+}
+
+// ToApiResource decrypts the data of the secret with the envelope.
+func (s *Secret) ToApiResource(envelope *crypto.Envelope, opts ...APIResourceOption) (*domain.Secret, error) {
+	if s == nil {
+		return &domain.Secret{}, nil
+	}
+	if envelope == nil {
+		return nil, flterrors.ErrSecretEncryptionNotConfigured
+	}
+
+	var data map[string]string
+	if s.Spec != nil {
+		plaintext, err := envelope.Open(&crypto.SealedData{
+			KeyID:        s.Spec.Data.KeyID,
+			EncryptedKey: s.Spec.Data.EncryptedKey,
+			Ciphertext:   s.Spec.Data.EncryptedData,
+		}, []byte(s.Name))
+		if err != nil {
+			return nil, fmt.Errorf("decrypting data of secret %q: %w", s.Name, err)
+		}
+		if err := json.Unmarshal(plaintext, &data); err != nil {
+			return nil, fmt.Errorf("unmarshalling data of secret %q: %w", s.Name, err)
+		}
+	}
+
+	return &domain.Secret{
+		ApiVersion: SecretAPIVersion(),
+		Kind:       domain.SecretKind,
+		Metadata: domain.ObjectMeta{
+			Name:              lo.ToPtr(s.Name),
+			CreationTimestamp: lo.ToPtr(s.CreatedAt.UTC()),
+			Labels:            lo.ToPtr(util.EnsureMap(s.Resource.Labels)),
+			Annotations:       lo.ToPtr(util.EnsureMap(s.Resource.Annotations)),
+			Generation:        s.Generation,
+			ResourceVersion:   lo.Ternary(s.ResourceVersion != nil, lo.ToPtr(strconv.FormatInt(lo.FromPtr(s.ResourceVersion), 10)), nil),
+		},
+		Spec: domain.SecretSpec{
+			Data: lo.MapValues(data, func(v string, _ string) domain.SecureString { return domain.SecureString(v) }),
+		},
+	}, nil
+}
+
+func SecretsToApiResource(secrets []Secret, cont *string, numRemaining *int64, envelope *crypto.Envelope) (domain.SecretList, error) {
+	secretList := make([]domain.Secret, len(secrets))
+	for i := range secrets {
+		apiResource, err := secrets[i].ToApiResource(envelope)
+		if err != nil {
+			return domain.SecretList{}, err
+		}
+		secretList[i] = *apiResource
+	}
+	ret := domain.SecretList{
+		ApiVersion: SecretAPIVersion(),
+		Kind:       domain.SecretListKind,
+		Items:      secretList,
+		Metadata:   domain.ListMeta{},
+	}
+	if cont != nil {
+		ret.Metadata.Continue = cont
+		ret.Metadata.RemainingItemCount = numRemaining
+	}
+	return ret, nil
+}
+
+func (s *Secret) GetKind() string {
+	return domain.SecretKind
+}
+
+func (s *Secret) HasNilSpec() bool {
+	return s.Spec == nil
+}
+
+func (s *Secret) HasSameSpecAs(otherResource any) bool {
+	other, ok := otherResource.(*Secret) // Assert that the other resource is a *Secret
+	if !ok {
+		return false // Not the same type, so specs cannot be the same
+	}
+	if other == nil {
+		return false
+	}
+	if s.Spec == nil && other.Spec == nil {
+		return true
+	}
+	if (s.Spec == nil && other.Spec != nil) || (s.Spec != nil && other.Spec == nil) {
+		return false
+	}
+	// the data is encrypted with a fresh data encryption key every time, so only the digests can be compared
+	return s.Spec.Data.KeyID == other.Spec.Data.KeyID && bytes.Equal(s.Spec.Data.DataDigest, other.Spec.Data.DataDigest)
+}
+
+func (s *Secret) GetStatusAsJson() ([]byte, error) {
+	return []byte("{}"), nil
+}
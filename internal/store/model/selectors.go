@@ -27,10 +27,16 @@ var (
 		selector.NewSelectorName("status.approval.approved"): selector.Bool,
 		selector.NewSelectorName("status.certificate"):       selector.String,
 	}
+	enrollmentPolicySpecSelectors = selectorToTypeMap{
+		selector.NewSelectorName("spec.approval.fleet"): selector.String,
+	}
 	resourceSyncSpecSelectors = selectorToTypeMap{
 		selector.NewSelectorName("spec.repository"): selector.String,
 		selector.NewSelectorName("spec.type"):       selector.String,
 	}
+	referenceMeasurementSpecSelectors = selectorToTypeMap{
+		selector.NewSelectorName("spec.osImage"): selector.String,
+	}
 	repositorySpecSelectors = selectorToTypeMap{
 		selector.NewSelectorName("spec.type"): selector.String,
 		selector.NewSelectorName("spec.url"):  selector.String,
@@ -130,6 +136,36 @@ func (m *ResourceSync) ListSelectors() selector.SelectorNameSet {
 	return selector.NewSelectorFieldNameSet().Add(keys...)
 }
 
+func (m *EnrollmentPolicy) ResolveSelector(name selector.SelectorName) (*selector.SelectorField, error) {
+	if typ, exists := enrollmentPolicySpecSelectors[name]; exists {
+		return makeJSONBSelectorField(name, typ)
+	}
+	return nil, fmt.Errorf("unable to resolve selector for enrollment policy")
+}
+
+func (m *EnrollmentPolicy) ListSelectors() selector.SelectorNameSet {
+	keys := make([]selector.SelectorName, 0, len(enrollmentPolicySpecSelectors))
+	for sn := range enrollmentPolicySpecSelectors {
+		keys = append(keys, sn)
+	}
+	return selector.NewSelectorFieldNameSet().Add(keys...)
+}
+
+func (m *ReferenceMeasurement) ResolveSelector(name selector.SelectorName) (*selector.SelectorField, error) {
+	if typ, exists := referenceMeasurementSpecSelectors[name]; exists {
+		return makeJSONBSelectorField(name, typ)
+	}
+	return nil, fmt.Errorf("unable to resolve selector for reference measurement")
+}
+
+func (m *ReferenceMeasurement) ListSelectors() selector.SelectorNameSet {
+	keys := make([]selector.SelectorName, 0, len(referenceMeasurementSpecSelectors))
+	for sn := range referenceMeasurementSpecSelectors {
+		keys = append(keys, sn)
+	}
+	return selector.NewSelectorFieldNameSet().Add(keys...)
+}
+
 func (m *Repository) ResolveSelector(name selector.SelectorName) (*selector.SelectorField, error) {
 	if typ, exists := repositorySpecSelectors[name]; exists {
 		return makeJSONBSelectorField(name, typ)
@@ -0,0 +1,131 @@
+package store
+
+import (
+	"context"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type ReferenceMeasurement interface {
+	InitialMigration(ctx context.Context) error
+
+	Create(ctx context.Context, orgId uuid.UUID, referenceMeasurement *domain.ReferenceMeasurement, eventCallback EventCallback) (*domain.ReferenceMeasurement, error)
+	Update(ctx context.Context, orgId uuid.UUID, referenceMeasurement *domain.ReferenceMeasurement, eventCallback EventCallback) (*domain.ReferenceMeasurement, error)
+	CreateOrUpdate(ctx context.Context, orgId uuid.UUID, referenceMeasurement *domain.ReferenceMeasurement, eventCallback EventCallback) (*domain.ReferenceMeasurement, bool, error)
+	Get(ctx context.Context, orgId uuid.UUID, name string) (*domain.ReferenceMeasurement, error)
+	List(ctx context.Context, orgId uuid.UUID, listParams ListParams) (*domain.ReferenceMeasurementList, error)
+	Delete(ctx context.Context, orgId uuid.UUID, name string, eventCallback EventCallback) error
+	Count(ctx context.Context, orgId uuid.UUID, listParams ListParams) (int64, error)
+}
+
+type ReferenceMeasurementStore struct {
+	dbHandler           *gorm.DB
+	log                 logrus.FieldLogger
+	genericStore        *GenericStore[*model.ReferenceMeasurement, model.ReferenceMeasurement, domain.ReferenceMeasurement, domain.ReferenceMeasurementList]
+	eventCallbackCaller EventCallbackCaller
+}
+
+// Make sure we conform to ReferenceMeasurement interface
+var _ ReferenceMeasurement = (*ReferenceMeasurementStore)(nil)
+
+func NewReferenceMeasurement(db *gorm.DB, log logrus.FieldLogger) ReferenceMeasurement {
+	genericStore := NewGenericStore[*model.ReferenceMeasurement, model.ReferenceMeasurement, domain.ReferenceMeasurement, domain.ReferenceMeasurementList](
+		db,
+		log,
+		model.NewReferenceMeasurementFromApiResource,
+		(*model.ReferenceMeasurement).ToApiResource,
+		model.ReferenceMeasurementsToApiResource,
+	)
+	return &ReferenceMeasurementStore{dbHandler: db, log: log, genericStore: genericStore, eventCallbackCaller: CallEventCallback(domain.ReferenceMeasurementKind, log)}
+}
+
+func (s *ReferenceMeasurementStore) getDB(ctx context.Context) *gorm.DB {
+	return s.dbHandler.WithContext(ctx)
+}
+
+func (s *ReferenceMeasurementStore) InitialMigration(ctx context.Context) error {
+	db := s.getDB(ctx)
+
+	if err := db.AutoMigrate(&model.ReferenceMeasurement{}); err != nil {
+		return err
+	}
+
+	// Create GIN index for ReferenceMeasurement labels
+	if !db.Migrator().HasIndex(&model.ReferenceMeasurement{}, "idx_reference_measurements_labels") {
+		if db.Dialector.Name() == "postgres" {
+			if err := db.Exec("CREATE INDEX idx_reference_measurements_labels ON reference_measurements USING GIN (labels)").Error; err != nil {
+				return err
+			}
+		} else {
+			if err := db.Migrator().CreateIndex(&model.ReferenceMeasurement{}, "Labels"); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Create GIN index for ReferenceMeasurement annotations
+	if !db.Migrator().HasIndex(&model.ReferenceMeasurement{}, "idx_reference_measurements_annotations") {
+		if db.Dialector.Name() == "postgres" {
+			if err := db.Exec("CREATE INDEX idx_reference_measurements_annotations ON reference_measurements USING GIN (annotations)").Error; err != nil {
+				return err
+			}
+		} else {
+			if err := db.Migrator().CreateIndex(&model.ReferenceMeasurement{}, "Annotations"); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *ReferenceMeasurementStore) Create(ctx context.Context, orgId uuid.UUID, resource *domain.ReferenceMeasurement, eventCallback EventCallback) (*domain.ReferenceMeasurement, error) {
+	rm, err := s.genericStore.Create(ctx, orgId, resource)
+	s.eventCallbackCaller(ctx, eventCallback, orgId, lo.FromPtr(resource.Metadata.Name), nil, rm, true, err)
+	return rm, err
+}
+
+func (s *ReferenceMeasurementStore) Update(ctx context.Context, orgId uuid.UUID, resource *domain.ReferenceMeasurement, eventCallback EventCallback) (*domain.ReferenceMeasurement, error) {
+	newRm, oldRm, err := s.genericStore.Update(ctx, orgId, resource, nil, true, nil)
+	s.eventCallbackCaller(ctx, eventCallback, orgId, lo.FromPtr(resource.Metadata.Name), oldRm, newRm, false, err)
+	return newRm, err
+}
+
+func (s *ReferenceMeasurementStore) CreateOrUpdate(ctx context.Context, orgId uuid.UUID, resource *domain.ReferenceMeasurement, eventCallback EventCallback) (*domain.ReferenceMeasurement, bool, error) {
+	newRm, oldRm, created, err := s.genericStore.CreateOrUpdate(ctx, orgId, resource, nil, true, nil)
+	s.eventCallbackCaller(ctx, eventCallback, orgId, lo.FromPtr(resource.Metadata.Name), oldRm, newRm, created, err)
+	return newRm, created, err
+}
+
+func (s *ReferenceMeasurementStore) Get(ctx context.Context, orgId uuid.UUID, name string) (*domain.ReferenceMeasurement, error) {
+	return s.genericStore.Get(ctx, orgId, name)
+}
+
+func (s *ReferenceMeasurementStore) List(ctx context.Context, orgId uuid.UUID, listParams ListParams) (*domain.ReferenceMeasurementList, error) {
+	return s.genericStore.List(ctx, orgId, listParams)
+}
+
+func (s *ReferenceMeasurementStore) Delete(ctx context.Context, orgId uuid.UUID, name string, eventCallback EventCallback) error {
+	deleted, err := s.genericStore.Delete(ctx, model.ReferenceMeasurement{Resource: model.Resource{OrgID: orgId, Name: name}})
+	if deleted && eventCallback != nil {
+		s.eventCallbackCaller(ctx, eventCallback, orgId, name, nil, nil, false, nil)
+	}
+	return err
+}
+
+func (s *ReferenceMeasurementStore) Count(ctx context.Context, orgId uuid.UUID, listParams ListParams) (int64, error) {
+	query, err := ListQuery(&model.ReferenceMeasurement{}).Build(ctx, s.getDB(ctx), orgId, listParams)
+	if err != nil {
+		return 0, err
+	}
+	var referenceMeasurementsCount int64
+	if err := query.Count(&referenceMeasurementsCount).Error; err != nil {
+		return 0, ErrorFromGormError(err)
+	}
+	return referenceMeasurementsCount, nil
+}
@@ -0,0 +1,132 @@
+package store
+
+import (
+	"context"
+
+	"github.com/flightctl/flightctl/internal/crypto"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type Secret interface {
+	InitialMigration(ctx context.Context) error
+
+	Create(ctx context.Context, orgId uuid.UUID, secret *domain.Secret, eventCallback EventCallback) (*domain.Secret, error)
+	CreateOrUpdate(ctx context.Context, orgId uuid.UUID, secret *domain.Secret, eventCallback EventCallback) (*domain.Secret, bool, error)
+	Get(ctx context.Context, orgId uuid.UUID, name string) (*domain.Secret, error)
+	List(ctx context.Context, orgId uuid.UUID, listParams ListParams) (*domain.SecretList, error)
+	Delete(ctx context.Context, orgId uuid.UUID, name string, eventCallback EventCallback) error
+	Count(ctx context.Context, orgId uuid.UUID, listParams ListParams) (int64, error)
+}
+
+// SecretStore stores the data of secrets envelope-encrypted, and decrypts it when reading secrets.
+type SecretStore struct {
+	dbHandler           *gorm.DB
+	log                 logrus.FieldLogger
+	genericStore        *GenericStore[*model.Secret, model.Secret, domain.Secret, domain.SecretList]
+	eventCallbackCaller EventCallbackCaller
+}
+
+// Make sure we conform to Secret interface
+var _ Secret = (*SecretStore)(nil)
+
+func NewSecret(db *gorm.DB, log logrus.FieldLogger, envelope *crypto.Envelope) Secret {
+	genericStore := NewGenericStore[*model.Secret, model.Secret, domain.Secret, domain.SecretList](
+		db,
+		log,
+		func(resource *domain.Secret) (*model.Secret, error) {
+			return model.NewSecretFromApiResource(resource, envelope)
+		},
+		func(secret *model.Secret, opts ...model.APIResourceOption) (*domain.Secret, error) {
+			return secret.ToApiResource(envelope, opts...)
+		},
+		func(secrets []model.Secret, cont *string, numRemaining *int64) (domain.SecretList, error) {
+			return model.SecretsToApiResource(secrets, cont, numRemaining, envelope)
+		},
+	)
+	return &SecretStore{dbHandler: db, log: log, genericStore: genericStore, eventCallbackCaller: CallEventCallback(domain.SecretKind, log)}
+}
+
+func (s *SecretStore) getDB(ctx context.Context) *gorm.DB {
+	return s.dbHandler.WithContext(ctx)
+}
+
+func (s *SecretStore) InitialMigration(ctx context.Context) error {
+	db := s.getDB(ctx)
+
+	if err := db.AutoMigrate(&model.Secret{}); err != nil {
+		return err
+	}
+
+	// Create GIN index for Secret labels
+	if !db.Migrator().HasIndex(&model.Secret{}, "idx_secrets_labels") {
+		if db.Dialector.Name() == "postgres" {
+			if err := db.Exec("CREATE INDEX idx_secrets_labels ON secrets USING GIN (labels)").Error; err != nil {
+				return err
+			}
+		} else {
+			if err := db.Migrator().CreateIndex(&model.Secret{}, "Labels"); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Create GIN index for Secret annotations
+	if !db.Migrator().HasIndex(&model.Secret{}, "idx_secrets_annotations") {
+		if db.Dialector.Name() == "postgres" {
+			if err := db.Exec("CREATE INDEX idx_secrets_annotations ON secrets USING GIN (annotations)").Error; err != nil {
+				return err
+			}
+		} else {
+			if err := db.Migrator().CreateIndex(&model.Secret{}, "Annotations"); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *SecretStore) Create(ctx context.Context, orgId uuid.UUID, resource *domain.Secret, eventCallback EventCallback) (*domain.Secret, error) {
+	secret, err := s.genericStore.Create(ctx, orgId, resource)
+	s.eventCallbackCaller(ctx, eventCallback, orgId, lo.FromPtr(resource.Metadata.Name), nil, secret, true, err)
+	return secret, err
+}
+
+func (s *SecretStore) CreateOrUpdate(ctx context.Context, orgId uuid.UUID, resource *domain.Secret, eventCallback EventCallback) (*domain.Secret, bool, error) {
+	newSecret, oldSecret, created, err := s.genericStore.CreateOrUpdate(ctx, orgId, resource, nil, true, nil)
+	s.eventCallbackCaller(ctx, eventCallback, orgId, lo.FromPtr(resource.Metadata.Name), oldSecret, newSecret, created, err)
+	return newSecret, created, err
+}
+
+func (s *SecretStore) Get(ctx context.Context, orgId uuid.UUID, name string) (*domain.Secret, error) {
+	return s.genericStore.Get(ctx, orgId, name)
+}
+
+func (s *SecretStore) List(ctx context.Context, orgId uuid.UUID, listParams ListParams) (*domain.SecretList, error) {
+	return s.genericStore.List(ctx, orgId, listParams)
+}
+
+func (s *SecretStore) Delete(ctx context.Context, orgId uuid.UUID, name string, eventCallback EventCallback) error {
+	deleted, err := s.genericStore.Delete(ctx, model.Secret{Resource: model.Resource{OrgID: orgId, Name: name}})
+	if deleted && eventCallback != nil {
+		s.eventCallbackCaller(ctx, eventCallback, orgId, name, nil, nil, false, nil)
+	}
+	return err
+}
+
+func (s *SecretStore) Count(ctx context.Context, orgId uuid.UUID, listParams ListParams) (int64, error) {
+	query, err := ListQuery(&model.Secret{}).Build(ctx, s.getDB(ctx), orgId, listParams)
+	if err != nil {
+		return 0, err
+	}
+	var secretsCount int64
+	if err := query.Count(&secretsCount).Error; err != nil {
+		return 0, ErrorFromGormError(err)
+	}
+	return secretsCount, nil
+}
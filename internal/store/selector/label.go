@@ -11,6 +11,7 @@ import (
 	"github.com/flightctl/flightctl/pkg/k8s/selector/selection"
 	"github.com/flightctl/flightctl/pkg/queryparser"
 	"github.com/flightctl/flightctl/pkg/queryparser/sqljsonb"
+	k8sLabels "k8s.io/apimachinery/pkg/labels"
 )
 
 type LabelSelector struct {
@@ -96,6 +97,17 @@ func NewLabelSelector(input string) (*LabelSelector, error) {
 	}, nil
 }
 
+// Matches reports whether the given set of labels satisfies the LabelSelector.
+// It is used to filter resources in memory when a database query is not available.
+//
+// Example:
+//
+//	ls, _ := NewLabelSelector("env=prod")
+//	ls.Matches(map[string]string{"env": "prod"}) // true
+func (ls *LabelSelector) Matches(labels map[string]string) bool {
+	return ls.selector.Matches(k8sLabels.Set(labels))
+}
+
 // Parse converts the LabelSelector into a SQL query with parameters.
 // The method uses a provided resolver to determine the correct labels field.
 //
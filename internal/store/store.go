@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	"github.com/flightctl/flightctl/internal/config"
+	"github.com/flightctl/flightctl/internal/crypto"
 	"github.com/flightctl/flightctl/internal/instrumentation/tracing"
 	"github.com/flightctl/flightctl/internal/org"
 	"github.com/flightctl/flightctl/internal/store/selector"
@@ -23,10 +24,15 @@ type Store interface {
 	Device() Device
 	EnrollmentRequest() EnrollmentRequest
 	CertificateSigningRequest() CertificateSigningRequest
+	CertificateRevocation() CertificateRevocation
 	Fleet() Fleet
 	TemplateVersion() TemplateVersion
 	Repository() Repository
 	ResourceSync() ResourceSync
+	ReferenceMeasurement() ReferenceMeasurement
+	EnrollmentPolicy() EnrollmentPolicy
+	Secret() Secret
+	TrustPolicy() TrustPolicy
 	Catalog() Catalog
 	Event() Event
 	Checkpoint() Checkpoint
@@ -41,10 +47,15 @@ type DataStore struct {
 	device                    Device
 	enrollmentRequest         EnrollmentRequest
 	certificateSigningRequest CertificateSigningRequest
+	certificateRevocation     CertificateRevocation
 	fleet                     Fleet
 	templateVersion           TemplateVersion
 	repository                Repository
 	resourceSync              ResourceSync
+	referenceMeasurement      ReferenceMeasurement
+	enrollmentPolicy          EnrollmentPolicy
+	secret                    Secret
+	trustPolicy               TrustPolicy
 	catalog                   Catalog
 	event                     Event
 	checkpoint                Checkpoint
@@ -54,15 +65,39 @@ type DataStore struct {
 	db *gorm.DB
 }
 
-func NewStore(db *gorm.DB, log logrus.FieldLogger) Store {
+type storeOptions struct {
+	secretEnvelope *crypto.Envelope
+}
+
+// StoreOption configures optional capabilities of the store.
+type StoreOption func(*storeOptions)
+
+// WithSecretEnvelope sets the envelope that encrypts the data of Secret resources at rest. Without it, Secret
+// resources can be neither written nor read.
+func WithSecretEnvelope(envelope *crypto.Envelope) StoreOption {
+	return func(o *storeOptions) {
+		o.secretEnvelope = envelope
+	}
+}
+
+func NewStore(db *gorm.DB, log logrus.FieldLogger, opts ...StoreOption) Store {
+	var o storeOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
 	return &DataStore{
 		device:                    NewDevice(db, log),
 		enrollmentRequest:         NewEnrollmentRequest(db, log),
 		certificateSigningRequest: NewCertificateSigningRequest(db, log),
+		certificateRevocation:     NewCertificateRevocation(db, log),
 		fleet:                     NewFleet(db, log),
 		templateVersion:           NewTemplateVersion(db, log),
 		repository:                NewRepository(db, log),
 		resourceSync:              NewResourceSync(db, log),
+		referenceMeasurement:      NewReferenceMeasurement(db, log),
+		enrollmentPolicy:          NewEnrollmentPolicy(db, log),
+		secret:                    NewSecret(db, log, o.secretEnvelope),
+		trustPolicy:               NewTrustPolicy(db, log),
 		catalog:                   NewCatalog(db, log),
 		event:                     NewEvent(db, log),
 		checkpoint:                NewCheckpoint(db, log),
@@ -88,6 +123,10 @@ func (s *DataStore) CertificateSigningRequest() CertificateSigningRequest {
 	return s.certificateSigningRequest
 }
 
+func (s *DataStore) CertificateRevocation() CertificateRevocation {
+	return s.certificateRevocation
+}
+
 func (s *DataStore) Fleet() Fleet {
 	return s.fleet
 }
@@ -100,6 +139,22 @@ func (s *DataStore) ResourceSync() ResourceSync {
 	return s.resourceSync
 }
 
+func (s *DataStore) ReferenceMeasurement() ReferenceMeasurement {
+	return s.referenceMeasurement
+}
+
+func (s *DataStore) EnrollmentPolicy() EnrollmentPolicy {
+	return s.enrollmentPolicy
+}
+
+func (s *DataStore) Secret() Secret {
+	return s.secret
+}
+
+func (s *DataStore) TrustPolicy() TrustPolicy {
+	return s.trustPolicy
+}
+
 func (s *DataStore) Catalog() Catalog {
 	return s.catalog
 }
@@ -174,6 +229,9 @@ func (s *DataStore) RunMigrations(ctx context.Context) error {
 	if err := s.CertificateSigningRequest().InitialMigration(ctx); err != nil {
 		return err
 	}
+	if err := s.CertificateRevocation().InitialMigration(ctx); err != nil {
+		return err
+	}
 	if err := s.Fleet().InitialMigration(ctx); err != nil {
 		return err
 	}
@@ -186,6 +244,18 @@ func (s *DataStore) RunMigrations(ctx context.Context) error {
 	if err := s.ResourceSync().InitialMigration(ctx); err != nil {
 		return err
 	}
+	if err := s.ReferenceMeasurement().InitialMigration(ctx); err != nil {
+		return err
+	}
+	if err := s.EnrollmentPolicy().InitialMigration(ctx); err != nil {
+		return err
+	}
+	if err := s.Secret().InitialMigration(ctx); err != nil {
+		return err
+	}
+	if err := s.TrustPolicy().InitialMigration(ctx); err != nil {
+		return err
+	}
 	if err := s.Catalog().InitialMigration(ctx); err != nil {
 		return err
 	}
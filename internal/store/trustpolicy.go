@@ -0,0 +1,131 @@
+package store
+
+import (
+	"context"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type TrustPolicy interface {
+	InitialMigration(ctx context.Context) error
+
+	Create(ctx context.Context, orgId uuid.UUID, trustPolicy *domain.TrustPolicy, eventCallback EventCallback) (*domain.TrustPolicy, error)
+	Update(ctx context.Context, orgId uuid.UUID, trustPolicy *domain.TrustPolicy, eventCallback EventCallback) (*domain.TrustPolicy, error)
+	CreateOrUpdate(ctx context.Context, orgId uuid.UUID, trustPolicy *domain.TrustPolicy, eventCallback EventCallback) (*domain.TrustPolicy, bool, error)
+	Get(ctx context.Context, orgId uuid.UUID, name string) (*domain.TrustPolicy, error)
+	List(ctx context.Context, orgId uuid.UUID, listParams ListParams) (*domain.TrustPolicyList, error)
+	Delete(ctx context.Context, orgId uuid.UUID, name string, eventCallback EventCallback) error
+	Count(ctx context.Context, orgId uuid.UUID, listParams ListParams) (int64, error)
+}
+
+type TrustPolicyStore struct {
+	dbHandler           *gorm.DB
+	log                 logrus.FieldLogger
+	genericStore        *GenericStore[*model.TrustPolicy, model.TrustPolicy, domain.TrustPolicy, domain.TrustPolicyList]
+	eventCallbackCaller EventCallbackCaller
+}
+
+// Make sure we conform to TrustPolicy interface
+var _ TrustPolicy = (*TrustPolicyStore)(nil)
+
+func NewTrustPolicy(db *gorm.DB, log logrus.FieldLogger) TrustPolicy {
+	genericStore := NewGenericStore[*model.TrustPolicy, model.TrustPolicy, domain.TrustPolicy, domain.TrustPolicyList](
+		db,
+		log,
+		model.NewTrustPolicyFromApiResource,
+		(*model.TrustPolicy).ToApiResource,
+		model.TrustPoliciesToApiResource,
+	)
+	return &TrustPolicyStore{dbHandler: db, log: log, genericStore: genericStore, eventCallbackCaller: CallEventCallback(domain.TrustPolicyKind, log)}
+}
+
+func (s *TrustPolicyStore) getDB(ctx context.Context) *gorm.DB {
+	return s.dbHandler.WithContext(ctx)
+}
+
+func (s *TrustPolicyStore) InitialMigration(ctx context.Context) error {
+	db := s.getDB(ctx)
+
+	if err := db.AutoMigrate(&model.TrustPolicy{}); err != nil {
+		return err
+	}
+
+	// Create GIN index for TrustPolicy labels
+	if !db.Migrator().HasIndex(&model.TrustPolicy{}, "idx_trust_policies_labels") {
+		if db.Dialector.Name() == "postgres" {
+			if err := db.Exec("CREATE INDEX idx_trust_policies_labels ON trust_policies USING GIN (labels)").Error; err != nil {
+				return err
+			}
+		} else {
+			if err := db.Migrator().CreateIndex(&model.TrustPolicy{}, "Labels"); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Create GIN index for TrustPolicy annotations
+	if !db.Migrator().HasIndex(&model.TrustPolicy{}, "idx_trust_policies_annotations") {
+		if db.Dialector.Name() == "postgres" {
+			if err := db.Exec("CREATE INDEX idx_trust_policies_annotations ON trust_policies USING GIN (annotations)").Error; err != nil {
+				return err
+			}
+		} else {
+			if err := db.Migrator().CreateIndex(&model.TrustPolicy{}, "Annotations"); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *TrustPolicyStore) Create(ctx context.Context, orgId uuid.UUID, resource *domain.TrustPolicy, eventCallback EventCallback) (*domain.TrustPolicy, error) {
+	tp, err := s.genericStore.Create(ctx, orgId, resource)
+	s.eventCallbackCaller(ctx, eventCallback, orgId, lo.FromPtr(resource.Metadata.Name), nil, tp, true, err)
+	return tp, err
+}
+
+func (s *TrustPolicyStore) Update(ctx context.Context, orgId uuid.UUID, resource *domain.TrustPolicy, eventCallback EventCallback) (*domain.TrustPolicy, error) {
+	newTp, oldTp, err := s.genericStore.Update(ctx, orgId, resource, nil, true, nil)
+	s.eventCallbackCaller(ctx, eventCallback, orgId, lo.FromPtr(resource.Metadata.Name), oldTp, newTp, false, err)
+	return newTp, err
+}
+
+func (s *TrustPolicyStore) CreateOrUpdate(ctx context.Context, orgId uuid.UUID, resource *domain.TrustPolicy, eventCallback EventCallback) (*domain.TrustPolicy, bool, error) {
+	newTp, oldTp, created, err := s.genericStore.CreateOrUpdate(ctx, orgId, resource, nil, true, nil)
+	s.eventCallbackCaller(ctx, eventCallback, orgId, lo.FromPtr(resource.Metadata.Name), oldTp, newTp, created, err)
+	return newTp, created, err
+}
+
+func (s *TrustPolicyStore) Get(ctx context.Context, orgId uuid.UUID, name string) (*domain.TrustPolicy, error) {
+	return s.genericStore.Get(ctx, orgId, name)
+}
+
+func (s *TrustPolicyStore) List(ctx context.Context, orgId uuid.UUID, listParams ListParams) (*domain.TrustPolicyList, error) {
+	return s.genericStore.List(ctx, orgId, listParams)
+}
+
+func (s *TrustPolicyStore) Delete(ctx context.Context, orgId uuid.UUID, name string, eventCallback EventCallback) error {
+	deleted, err := s.genericStore.Delete(ctx, model.TrustPolicy{Resource: model.Resource{OrgID: orgId, Name: name}})
+	if deleted && eventCallback != nil {
+		s.eventCallbackCaller(ctx, eventCallback, orgId, name, nil, nil, false, nil)
+	}
+	return err
+}
+
+func (s *TrustPolicyStore) Count(ctx context.Context, orgId uuid.UUID, listParams ListParams) (int64, error) {
+	query, err := ListQuery(&model.TrustPolicy{}).Build(ctx, s.getDB(ctx), orgId, listParams)
+	if err != nil {
+		return 0, err
+	}
+	var trustPoliciesCount int64
+	if err := query.Count(&trustPoliciesCount).Error; err != nil {
+		return 0, ErrorFromGormError(err)
+	}
+	return trustPoliciesCount, nil
+}
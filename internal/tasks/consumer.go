@@ -104,6 +104,20 @@ func dispatchTasks(serviceHandler service.Service, k8sClient k8sclient.K8SClient
 			})
 			errorMessages = appendErrorMessage(errorMessages, taskName, err)
 		}
+		if shouldSyncRepositoryResources(ctx, eventWithOrgId.Event, log) {
+			taskName = "resourceSync"
+			err = runTaskWithMetrics(taskName, workerMetrics, func() error {
+				return resourceSyncOnPush(ctx, eventWithOrgId.OrgId, eventWithOrgId.Event, serviceHandler, cfg, log)
+			})
+			errorMessages = appendErrorMessage(errorMessages, taskName, err)
+		}
+		if shouldPreviewResourceSync(ctx, eventWithOrgId.Event, log) {
+			taskName = "resourceSyncPreview"
+			err = runTaskWithMetrics(taskName, workerMetrics, func() error {
+				return resourceSyncPreview(ctx, eventWithOrgId.OrgId, eventWithOrgId.Event, serviceHandler, kvStore, cfg, log)
+			})
+			errorMessages = appendErrorMessage(errorMessages, taskName, err)
+		}
 
 		// Emit InternalTaskFailedEvent for any unhandled task failures
 		// This serves as a safety net while preserving specific error handling within tasks
@@ -164,15 +178,20 @@ func runTaskWithMetrics(name string, workerMetrics *worker.WorkerCollector, fn f
 }
 
 func shouldRolloutFleet(ctx context.Context, event domain.Event, log logrus.FieldLogger) bool {
-	// If a devices's owner or labels were updated return true
+	// If a devices's owner, labels, variables or system info were updated return true
 	if event.Reason == domain.EventReasonResourceUpdated && event.InvolvedObject.Kind == domain.DeviceKind {
-		return hasUpdatedFields(event.Details, log, domain.Owner, domain.Labels)
+		return hasUpdatedFields(event.Details, log, domain.Owner, domain.Labels, domain.SpecVariables, domain.SystemInfo)
 	}
 
 	if event.Reason == domain.EventReasonFleetRolloutBatchDispatched && event.InvolvedObject.Kind == domain.FleetKind {
 		return true
 	}
 
+	// If a failed rollout was rolled back, the devices are reverted to the restored template version
+	if event.Reason == domain.EventReasonFleetRolloutRolledBack && event.InvolvedObject.Kind == domain.FleetKind {
+		return true
+	}
+
 	// If a device was created, return true
 	if event.Reason == domain.EventReasonResourceCreated && event.InvolvedObject.Kind == domain.DeviceKind {
 		return true
@@ -247,7 +266,8 @@ func shouldRenderDevice(ctx context.Context, event domain.Event, log logrus.Fiel
 	if lo.Contains([]domain.EventReason{domain.EventReasonReferencedRepositoryUpdated,
 		domain.EventReasonResourceCreated,
 		domain.EventReasonFleetRolloutDeviceSelected, domain.EventReasonDeviceConflictResolved,
-		domain.EventReasonDeviceDecommissioned}, event.Reason) {
+		domain.EventReasonDeviceDecommissioned, domain.EventReasonDeviceIntegrityVerified,
+		domain.EventReasonDeviceEncryptionKeyUpdated}, event.Reason) {
 		return true
 	}
 
@@ -281,9 +301,24 @@ func shouldUpdateRepositoryReferers(ctx context.Context, event domain.Event, log
 		return true
 	}
 
+	// If a repository received a push, return true
+	if event.Reason == domain.EventReasonRepositoryPushReceived && event.InvolvedObject.Kind == domain.RepositoryKind {
+		return true
+	}
+
 	return false
 }
 
+func shouldSyncRepositoryResources(ctx context.Context, event domain.Event, log logrus.FieldLogger) bool {
+	// If a repository received a push, return true
+	return event.Reason == domain.EventReasonRepositoryPushReceived && event.InvolvedObject.Kind == domain.RepositoryKind
+}
+
+func shouldPreviewResourceSync(ctx context.Context, event domain.Event, log logrus.FieldLogger) bool {
+	// If a preview of a resourcesync was requested, return true
+	return event.Reason == domain.EventReasonResourceSyncPreviewRequested && event.InvolvedObject.Kind == domain.ResourceSyncKind
+}
+
 func hasUpdatedFields(details *domain.EventDetails, log logrus.FieldLogger, fields ...domain.ResourceUpdatedDetailsUpdatedFields) bool {
 	if details == nil {
 		return false
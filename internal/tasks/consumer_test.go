@@ -72,6 +72,16 @@ func TestShouldRolloutFleet(t *testing.T) {
 			event:    createTestEventWithDetails(domain.DeviceKind, domain.EventReasonResourceUpdated, "device1", createResourceUpdatedDetails(t, domain.Labels)),
 			expected: true,
 		},
+		{
+			name:     "DeviceUpdatedWithVariables",
+			event:    createTestEventWithDetails(domain.DeviceKind, domain.EventReasonResourceUpdated, "device1", createResourceUpdatedDetails(t, domain.Spec, domain.SpecVariables)),
+			expected: true,
+		},
+		{
+			name:     "DeviceUpdatedWithSystemInfo",
+			event:    createTestEventWithDetails(domain.DeviceKind, domain.EventReasonResourceUpdated, "device1", createResourceUpdatedDetails(t, domain.SystemInfo)),
+			expected: true,
+		},
 		{
 			name:     "DeviceUpdatedWithOtherFields",
 			event:    createTestEventWithDetails(domain.DeviceKind, domain.EventReasonResourceUpdated, "device1", createResourceUpdatedDetails(t, domain.Spec)),
@@ -87,6 +97,11 @@ func TestShouldRolloutFleet(t *testing.T) {
 			event:    createTestEvent(domain.FleetKind, domain.EventReasonFleetRolloutBatchDispatched, "fleet1"),
 			expected: true,
 		},
+		{
+			name:     "FleetRolloutRolledBack",
+			event:    createTestEvent(domain.FleetKind, domain.EventReasonFleetRolloutRolledBack, "fleet1"),
+			expected: true,
+		},
 		{
 			name:     "DeviceCreated",
 			event:    createTestEvent(domain.DeviceKind, domain.EventReasonResourceCreated, "device1"),
@@ -1,6 +1,7 @@
 package tasks
 
 import (
+	"bytes"
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
@@ -15,11 +16,13 @@ import (
 	config_latest_types "github.com/coreos/ignition/v2/config/v3_4/types"
 	"github.com/flightctl/flightctl/api/core/v1beta1"
 	"github.com/flightctl/flightctl/internal/config"
+	"github.com/flightctl/flightctl/internal/crypto"
 	"github.com/flightctl/flightctl/internal/domain"
 	"github.com/flightctl/flightctl/internal/kvstore"
 	"github.com/flightctl/flightctl/internal/service"
 	"github.com/flightctl/flightctl/internal/util"
 	"github.com/flightctl/flightctl/internal/util/validation"
+	fccrypto "github.com/flightctl/flightctl/pkg/crypto"
 	"github.com/flightctl/flightctl/pkg/ignition"
 	"github.com/flightctl/flightctl/pkg/k8sclient"
 	"github.com/google/uuid"
@@ -72,6 +75,11 @@ type DeviceRenderLogic struct {
 	templateVersion *string
 	deviceConfig    *[]domain.ConfigProviderSpec
 	applications    *[]domain.ApplicationProviderSpec
+	// sensitivePaths holds the paths of rendered files whose content must be encrypted to the device
+	sensitivePaths map[string]struct{}
+	// secretResourcePaths holds the paths of rendered files written from Secret resources, which are marked as
+	// sensitive so that their content is redacted for users
+	secretResourcePaths map[string]struct{}
 }
 
 func NewDeviceRenderLogic(log logrus.FieldLogger, serviceHandler service.Service, k8sClient k8sclient.K8SClient, kvStore kvstore.KVStore, cfg *config.Config, orgId uuid.UUID, event domain.Event) DeviceRenderLogic {
@@ -84,8 +92,18 @@ func (t *DeviceRenderLogic) RenderDevice(ctx context.Context) error {
 		return fmt.Errorf("failed getting device %s/%s: %s", t.orgId, t.event.InvolvedObject.Name, status.Message)
 	}
 
-	// Calculate hash including device spec to detect changes
-	specHash := hashRenderedWithSpec(device.Spec)
+	var encryptionKey string
+	if device.Status != nil {
+		encryptionKey = lo.FromPtr(device.Status.Config.EncryptionKey)
+	}
+
+	trustPolicy, err := t.resolveTrustPolicy(ctx, device.Spec)
+	if err != nil {
+		return t.setStatus(ctx, err)
+	}
+
+	// Calculate hash including device spec, trust policy and encryption key to detect changes
+	specHash := hashRenderedWithSpec(device.Spec, trustPolicy, encryptionKey)
 
 	// If device.Spec or device.Spec.Config are nil, we still want to render an empty ignition config
 	if device.Spec != nil {
@@ -96,7 +114,7 @@ func (t *DeviceRenderLogic) RenderDevice(ctx context.Context) error {
 	if device.Metadata.Annotations != nil {
 		annotations := lo.FromPtr(device.Metadata.Annotations)
 
-		// Don't render if the device is awaiting reconnection or paused due to conflicts after restore
+		// Don't render if the device is awaiting reconnection, paused due to conflicts after restore, or quarantined
 		if val, ok := annotations[domain.DeviceAnnotationAwaitingReconnect]; ok {
 			if val == "true" {
 				t.log.Infof("Device %s is awaiting reconnection after restore", t.event.InvolvedObject.Name)
@@ -109,6 +127,12 @@ func (t *DeviceRenderLogic) RenderDevice(ctx context.Context) error {
 				return nil
 			}
 		}
+		if val, ok := annotations[domain.DeviceAnnotationQuarantined]; ok {
+			if val == "true" {
+				t.log.Infof("Device %s is quarantined after failing attestation", t.event.InvolvedObject.Name)
+				return nil
+			}
+		}
 
 		// Don't render if the device spec hash hasn't changed since the last render
 		if val, ok := annotations[domain.DeviceAnnotationRenderedSpecHash]; ok {
@@ -136,7 +160,11 @@ func (t *DeviceRenderLogic) RenderDevice(ctx context.Context) error {
 
 	// TODO: remove ignition
 	ignitionConfig, referencedRepos, renderErr := t.renderConfig(ctx)
-	renderedConfig, err := ignitionConfigToRenderedConfig(ignitionConfig)
+	encryptFile, err := t.sensitiveFileEncrypter(encryptionKey)
+	if err != nil {
+		return t.setStatus(ctx, err)
+	}
+	renderedConfig, err := ignitionConfigToRenderedConfig(ignitionConfig, t.renderedFileTransform(encryptFile))
 	if err != nil {
 		return fmt.Errorf("failed converting ignition config to rendered config: %w", err)
 	}
@@ -161,10 +189,36 @@ func (t *DeviceRenderLogic) RenderDevice(ctx context.Context) error {
 		return t.setStatus(ctx, err)
 	}
 
-	status = t.serviceHandler.UpdateRenderedDevice(ctx, t.orgId, t.event.InvolvedObject.Name, string(renderedConfig), string(renderedApplications), specHash)
+	renderedTrustPolicy, err := json.Marshal(trustPolicy)
+	if err != nil {
+		return fmt.Errorf("failed marshalling trust policy: %w", err)
+	}
+
+	status = t.serviceHandler.UpdateRenderedDevice(ctx, t.orgId, t.event.InvolvedObject.Name, string(renderedConfig), string(renderedApplications), string(renderedTrustPolicy), specHash)
 	return t.setStatus(ctx, service.ApiStatusToErr(status))
 }
 
+// resolveTrustPolicy returns the TrustPolicy the device is rendered with: the one referenced by its spec, or else the
+// organization's default TrustPolicy if there is one. A referenced TrustPolicy that does not exist is an error.
+func (t *DeviceRenderLogic) resolveTrustPolicy(ctx context.Context, deviceSpec *domain.DeviceSpec) (*domain.DeviceTrustPolicy, error) {
+	if deviceSpec != nil && deviceSpec.TrustPolicy != nil {
+		trustPolicy, status := t.serviceHandler.GetTrustPolicy(ctx, t.orgId, deviceSpec.TrustPolicy.Name)
+		if err := service.ApiStatusToErr(status); err != nil {
+			return nil, fmt.Errorf("failed getting trust policy %s: %w", deviceSpec.TrustPolicy.Name, err)
+		}
+		return &domain.DeviceTrustPolicy{Name: deviceSpec.TrustPolicy.Name, Spec: &trustPolicy.Spec}, nil
+	}
+
+	trustPolicy, status := t.serviceHandler.GetTrustPolicy(ctx, t.orgId, domain.DefaultTrustPolicyName)
+	if status.Code == http.StatusNotFound {
+		return nil, nil
+	}
+	if err := service.ApiStatusToErr(status); err != nil {
+		return nil, fmt.Errorf("failed getting trust policy %s: %w", domain.DefaultTrustPolicyName, err)
+	}
+	return &domain.DeviceTrustPolicy{Name: domain.DefaultTrustPolicyName, Spec: &trustPolicy.Spec}, nil
+}
+
 func (t *DeviceRenderLogic) setStatus(ctx context.Context, renderErr error) error {
 	condition := domain.Condition{Type: domain.ConditionTypeDeviceSpecValid}
 
@@ -186,6 +240,50 @@ func (t *DeviceRenderLogic) setStatus(ctx context.Context, renderErr error) erro
 	return renderErr
 }
 
+// sensitiveFileEncrypter returns a function that encrypts the content of secret-derived files to the device's
+// encryption key. Devices that do not report a key (e.g. older agents) receive the content unencrypted.
+func (t *DeviceRenderLogic) sensitiveFileEncrypter(encryptionKey string) (func(*domain.FileSpec) error, error) {
+	if encryptionKey == "" {
+		return nil, nil
+	}
+	publicKey, err := fccrypto.ParsePublicKeyPEM([]byte(encryptionKey))
+	if err != nil {
+		return nil, fmt.Errorf("invalid device encryption key: %w", err)
+	}
+
+	return func(file *domain.FileSpec) error {
+		if _, ok := t.sensitivePaths[file.Path]; !ok {
+			return nil
+		}
+		contents, err := file.ContentsDecoded()
+		if err != nil {
+			return fmt.Errorf("decoding contents of %q: %w", file.Path, err)
+		}
+		encrypted, err := fccrypto.EncryptJWE(publicKey, contents)
+		if err != nil {
+			return fmt.Errorf("encrypting contents of %q: %w", file.Path, err)
+		}
+		file.Content = encrypted
+		file.ContentEncoding = lo.ToPtr(domain.EncodingJWE)
+		return nil
+	}, nil
+}
+
+// renderedFileTransform marks the files written from Secret resources as sensitive and then applies encryptFile, if
+// the device receives encrypted content. The mark is stored with the rendered configuration, so that its files are
+// redacted for users even after the Secret resource was removed from the device spec.
+func (t *DeviceRenderLogic) renderedFileTransform(encryptFile func(*domain.FileSpec) error) func(*domain.FileSpec) error {
+	return func(file *domain.FileSpec) error {
+		if _, ok := t.secretResourcePaths[file.Path]; ok {
+			file.Sensitive = lo.ToPtr(true)
+		}
+		if encryptFile == nil {
+			return nil
+		}
+		return encryptFile(file)
+	}
+}
+
 func (t *DeviceRenderLogic) renderApplications(ctx context.Context) ([]byte, error) {
 	if t.applications == nil {
 		return nil, nil
@@ -289,6 +387,8 @@ func (t *DeviceRenderLogic) renderConfigItem(ctx context.Context, configItem *do
 		return t.renderGitConfig(ctx, configItem, ignitionConfig)
 	case domain.KubernetesSecretProviderType:
 		return t.renderK8sConfig(ctx, configItem, ignitionConfig)
+	case domain.SecretProviderType:
+		return t.renderSecretConfig(ctx, configItem, ignitionConfig)
 	case domain.InlineConfigProviderType:
 		return t.renderInlineConfig(configItem, ignitionConfig)
 	case domain.HttpConfigProviderType:
@@ -393,30 +493,132 @@ func (t *DeviceRenderLogic) renderK8sConfig(ctx context.Context, configItem *dom
 	if err != nil {
 		return nil, nil, fmt.Errorf("%w: failed getting config item as KubernetesSecretProviderSpec: %w", ErrUnknownConfigName, err)
 	}
-	if t.k8sClient == nil {
-		return &k8sSpec.Name, nil, fmt.Errorf("kubernetes API is not available")
+
+	secretData, err := t.getK8sSecretData(ctx, k8sSpec.SecretRef.Namespace, k8sSpec.SecretRef.Name)
+	if err != nil {
+		return &k8sSpec.Name, nil, err
+	}
+	if _, err := t.mountSecretData(secretData, k8sSpec.SecretRef.MountPath, k8sSpec.SecretRef.User, k8sSpec.SecretRef.Group, ignitionConfig); err != nil {
+		return &k8sSpec.Name, nil, err
 	}
+	return &k8sSpec.Name, nil, nil
+}
 
-	var secretData map[string][]byte
-	var key kvstore.K8sSecretKey
-	needToStoreData := false
+func (t *DeviceRenderLogic) renderSecretConfig(ctx context.Context, configItem *domain.ConfigProviderSpec, ignitionConfig **config_latest_types.Config) (*string, *string, error) {
+	secretSpec, err := configItem.AsSecretProviderSpec()
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: failed getting config item as SecretProviderSpec: %w", ErrUnknownConfigName, err)
+	}
+
+	secretData, err := t.getSecretResourceData(ctx, secretSpec.SecretResourceRef.Name)
+	if err != nil {
+		return &secretSpec.Name, nil, err
+	}
+	paths, err := t.mountSecretData(secretData, secretSpec.SecretResourceRef.MountPath, secretSpec.SecretResourceRef.User, secretSpec.SecretResourceRef.Group, ignitionConfig)
+	if err != nil {
+		return &secretSpec.Name, nil, err
+	}
+	if t.secretResourcePaths == nil {
+		t.secretResourcePaths = make(map[string]struct{})
+	}
+	for _, path := range paths {
+		t.secretResourcePaths[path] = struct{}{}
+	}
+	return &secretSpec.Name, nil, nil
+}
+
+// mountSecretData writes each entry of the secret data as a file named after its key under mountPath and returns the
+// paths of the files.
+func (t *DeviceRenderLogic) mountSecretData(secretData map[string][]byte, mountPath string, user v1beta1.Username, group string, ignitionConfig **config_latest_types.Config) ([]string, error) {
+	ignitionWrapper, err := ignition.NewWrapper()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create ignition wrapper: %w", err)
+	}
+	base := filepath.Clean(mountPath)
+	paths := make([]string, 0, len(secretData))
+	for name, contents := range secretData {
+		// enforce filename (no path segments)
+		if name == "." || name == ".." || strings.ContainsRune(name, '/') {
+			return nil, fmt.Errorf("invalid secret key %q: must be a single file name", name)
+		}
+		dest := filepath.Join(base, name)
+		if err := validation.DenyForbiddenDevicePath(dest); err != nil {
+			return nil, fmt.Errorf("invalid secret-derived path %q: %w", dest, err)
+		}
+		if t.sensitivePaths == nil {
+			t.sensitivePaths = make(map[string]struct{})
+		}
+		t.sensitivePaths[dest] = struct{}{}
+		ignitionWrapper.SetFile(dest, contents, 0o644, false, user.String(), group)
+		paths = append(paths, dest)
+	}
 
+	*ignitionConfig = lo.ToPtr(ignitionWrapper.Merge(**ignitionConfig))
+	return paths, nil
+}
+
+func (t *DeviceRenderLogic) getK8sSecretData(ctx context.Context, namespace, name string) (map[string][]byte, error) {
+	if t.k8sClient == nil {
+		return nil, fmt.Errorf("kubernetes API is not available")
+	}
+	var key string
 	if t.ownerFleet != nil {
-		key = kvstore.K8sSecretKey{
+		k8sSecretKey := kvstore.K8sSecretKey{
 			OrgID:           t.orgId,
 			Fleet:           *t.ownerFleet,
 			TemplateVersion: *t.templateVersion,
-			Namespace:       k8sSpec.SecretRef.Namespace,
-			Name:            k8sSpec.SecretRef.Name,
+			Namespace:       namespace,
+			Name:            name,
 		}
-		data, err := t.kvStore.Get(ctx, key.ComposeKey())
+		key = k8sSecretKey.ComposeKey()
+	}
+	return t.getFrozenSecretData(ctx, key, fmt.Sprintf("%s/%s", namespace, name), func() (map[string][]byte, error) {
+		secret, err := t.k8sClient.GetSecret(ctx, namespace, name)
 		if err != nil {
-			return &k8sSpec.Name, nil, fmt.Errorf("failed fetching cached secret data: %w", err)
+			return nil, err
+		}
+		return secret.Data, nil
+	})
+}
+
+func (t *DeviceRenderLogic) getSecretResourceData(ctx context.Context, name string) (map[string][]byte, error) {
+	var key string
+	if t.ownerFleet != nil {
+		secretKey := kvstore.SecretKey{
+			OrgID:           t.orgId,
+			Fleet:           *t.ownerFleet,
+			TemplateVersion: *t.templateVersion,
+			Name:            name,
+		}
+		key = secretKey.ComposeKey()
+	}
+	return t.getFrozenSecretData(ctx, key, name, func() (map[string][]byte, error) {
+		secret, status := t.serviceHandler.GetSecret(ctx, t.orgId, name)
+		if err := service.ApiStatusToErr(status); err != nil {
+			return nil, err
+		}
+		return lo.MapValues(secret.Spec.Data, func(v domain.SecureString, _ string) []byte { return []byte(v.Value()) }), nil
+	})
+}
+
+// getFrozenSecretData returns the secret data that was frozen for the fleet's template version, fetching and freezing
+// it first if necessary. Devices that are not owned by a fleet (empty key) always get the current data.
+func (t *DeviceRenderLogic) getFrozenSecretData(ctx context.Context, key string, secretName string, fetch func() (map[string][]byte, error)) (map[string][]byte, error) {
+	var secretData map[string][]byte
+	needToStoreData := false
+
+	if key != "" {
+		data, err := t.kvStore.Get(ctx, key)
+		if err != nil {
+			return nil, fmt.Errorf("failed fetching cached secret data: %w", err)
 		}
 		if data != nil {
+			if data, err = t.openFrozenSecretData(key, data); err != nil {
+				return nil, fmt.Errorf("failed decrypting cached secret data: %w", err)
+			}
 			err = json.Unmarshal(data, &secretData)
 			if err != nil {
-				return &k8sSpec.Name, nil, fmt.Errorf("failed parsing cached secret data: %w", err)
+				return nil, fmt.Errorf("failed parsing cached secret data: %w", err)
 			}
 		} else {
 			needToStoreData = true
@@ -424,46 +626,78 @@ func (t *DeviceRenderLogic) renderK8sConfig(ctx context.Context, configItem *dom
 	}
 
 	if secretData == nil {
-		secret, err := t.k8sClient.GetSecret(ctx, k8sSpec.SecretRef.Namespace, k8sSpec.SecretRef.Name)
+		var err error
+		secretData, err = fetch()
 		if err != nil {
-			return &k8sSpec.Name, nil, fmt.Errorf("failed getting secret %s/%s: %w", k8sSpec.SecretRef.Namespace, k8sSpec.SecretRef.Name, err)
+			return nil, fmt.Errorf("failed getting secret %s: %w", secretName, err)
 		}
-		secretData = secret.Data
 	}
 
 	if needToStoreData {
 		secretDataToStore, err := json.Marshal(secretData)
 		if err != nil {
-			return &k8sSpec.Name, nil, fmt.Errorf("failed marshalling secret data %s/%s: %w", k8sSpec.SecretRef.Namespace, k8sSpec.SecretRef.Name, err)
+			return nil, fmt.Errorf("failed marshalling secret data %s: %w", secretName, err)
+		}
+		if secretDataToStore, err = t.sealFrozenSecretData(key, secretDataToStore); err != nil {
+			return nil, fmt.Errorf("failed encrypting secret data %s: %w", secretName, err)
 		}
-		updated, err := t.kvStore.SetNX(ctx, key.ComposeKey(), secretDataToStore)
+		updated, err := t.kvStore.SetNX(ctx, key, secretDataToStore)
 		if err != nil {
-			return &k8sSpec.Name, nil, fmt.Errorf("failed storing secret %s/%s: %w", k8sSpec.SecretRef.Namespace, k8sSpec.SecretRef.Name, err)
+			return nil, fmt.Errorf("failed storing secret %s: %w", secretName, err)
 		}
 		if !updated {
-			return &k8sSpec.Name, nil, fmt.Errorf("failed freezing secret %s/%s: unexpectedly changed", k8sSpec.SecretRef.Namespace, k8sSpec.SecretRef.Name)
+			return nil, fmt.Errorf("failed freezing secret %s: unexpectedly changed", secretName)
 		}
 	}
+	return secretData, nil
+}
 
-	ignitionWrapper, err := ignition.NewWrapper()
+// sealedSecretDataPrefix marks frozen secret data that was sealed with the service's secrets envelope
+const sealedSecretDataPrefix = "sealed:"
+
+// sealFrozenSecretData encrypts frozen secret data before it is cached, so the KV store never holds secrets in
+// cleartext when a key-encryption key is configured. The cache key is bound as additional data.
+func (t *DeviceRenderLogic) sealFrozenSecretData(key string, data []byte) ([]byte, error) {
+	envelope, err := t.secretEnvelope()
+	if err != nil || envelope == nil {
+		return data, err
+	}
+	sealed, err := envelope.Seal(data, []byte(key))
 	if err != nil {
-		return &k8sSpec.Name, nil, fmt.Errorf("failed to create ignition wrapper: %w", err)
+		return nil, err
 	}
-	base := filepath.Clean(k8sSpec.SecretRef.MountPath)
-	for name, contents := range secretData {
-		// enforce filename (no path segments)
-		if name == "." || name == ".." || strings.ContainsRune(name, '/') {
-			return &k8sSpec.Name, nil, fmt.Errorf("invalid secret key %q: must be a single file name", name)
-		}
-		dest := filepath.Join(base, name)
-		if err := validation.DenyForbiddenDevicePath(dest); err != nil {
-			return &k8sSpec.Name, nil, fmt.Errorf("invalid secret-derived path %q: %w", dest, err)
-		}
-		ignitionWrapper.SetFile(dest, contents, 0o644, false, k8sSpec.SecretRef.User.String(), k8sSpec.SecretRef.Group)
+	sealedBytes, err := json.Marshal(sealed)
+	if err != nil {
+		return nil, err
 	}
+	return append([]byte(sealedSecretDataPrefix), sealedBytes...), nil
+}
 
-	*ignitionConfig = lo.ToPtr(ignitionWrapper.Merge(**ignitionConfig))
-	return &k8sSpec.Name, nil, nil
+// openFrozenSecretData reverses sealFrozenSecretData. Data cached without a key-encryption key is returned as is.
+func (t *DeviceRenderLogic) openFrozenSecretData(key string, data []byte) ([]byte, error) {
+	sealedBytes, ok := bytes.CutPrefix(data, []byte(sealedSecretDataPrefix))
+	if !ok {
+		return data, nil
+	}
+	envelope, err := t.secretEnvelope()
+	if err != nil {
+		return nil, err
+	}
+	if envelope == nil {
+		return nil, fmt.Errorf("secrets key-encryption key is not configured")
+	}
+	var sealed crypto.SealedData
+	if err := json.Unmarshal(sealedBytes, &sealed); err != nil {
+		return nil, err
+	}
+	return envelope.Open(&sealed, []byte(key))
+}
+
+func (t *DeviceRenderLogic) secretEnvelope() (*crypto.Envelope, error) {
+	if t.cfg == nil {
+		return nil, nil
+	}
+	return t.cfg.SecretEnvelope()
 }
 
 func (t *DeviceRenderLogic) renderInlineConfig(configItem *domain.ConfigProviderSpec, ignitionConfig **config_latest_types.Config) (*string, *string, error) {
@@ -682,8 +916,9 @@ func (t *DeviceRenderLogic) cloneCachedGitRepoToIgnition(ctx context.Context, re
 }
 
 // TODO: this is temporary, ignition will be removed in the future
-// ignitionConfigToRenderedConfig converts an ignition config to rendered config bytes
-func ignitionConfigToRenderedConfig(ignition *config_latest_types.Config) ([]byte, error) {
+// ignitionConfigToRenderedConfig converts an ignition config to rendered config bytes, applying the optional
+// transform to each file
+func ignitionConfigToRenderedConfig(ignition *config_latest_types.Config, transform func(*domain.FileSpec) error) ([]byte, error) {
 	emptyConfig := []byte("[]")
 
 	if ignition == nil || len(ignition.Storage.Files) == 0 {
@@ -721,6 +956,11 @@ func ignitionConfigToRenderedConfig(ignition *config_latest_types.Config) ([]byt
 			Group:           group,
 			Mode:            file.Mode,
 		}
+		if transform != nil {
+			if err := transform(&fileSpec); err != nil {
+				return nil, err
+			}
+		}
 
 		files = append(files, fileSpec)
 	}
@@ -742,12 +982,21 @@ func ignitionConfigToRenderedConfig(ignition *config_latest_types.Config) ([]byt
 	return renderedConfig, nil
 }
 
-// hashRenderedWithSpec creates a hash of the device spec to detect changes
-func hashRenderedWithSpec(deviceSpec *domain.DeviceSpec) string {
+// hashRenderedWithSpec creates a hash of the device spec, resolved trust policy and encryption key to detect changes
+func hashRenderedWithSpec(deviceSpec *domain.DeviceSpec, trustPolicy *domain.DeviceTrustPolicy, encryptionKey string) string {
 	if deviceSpec == nil {
 		return ""
 	}
 	specBytes, _ := json.Marshal(deviceSpec)
+	// the trust policy only contributes when set, so devices without one keep their existing hash
+	if trustPolicy != nil && trustPolicy.Spec != nil {
+		policyBytes, _ := json.Marshal(trustPolicy.Spec)
+		specBytes = append(specBytes, policyBytes...)
+	}
+	// the key only contributes when set, so devices without one keep their existing hash
+	if encryptionKey != "" {
+		specBytes = append(specBytes, encryptionKey...)
+	}
 	hash := sha256.Sum256(specBytes)
 	return hex.EncodeToString(hash[:])
 }
@@ -0,0 +1,54 @@
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/service"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRenderMarksSecretResourceFilesSensitive(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	mockSvc := service.NewMockService(ctrl)
+	orgId := uuid.New()
+
+	secretSpec := domain.SecretProviderSpec{Name: "registry"}
+	secretSpec.SecretResourceRef.Name = "registry"
+	secretSpec.SecretResourceRef.MountPath = "/etc/registry"
+	var secretProvider domain.ConfigProviderSpec
+	require.NoError(secretProvider.FromSecretProviderSpec(secretSpec))
+	var inlineProvider domain.ConfigProviderSpec
+	require.NoError(inlineProvider.FromInlineConfigProviderSpec(domain.InlineConfigProviderSpec{
+		Name:   "motd",
+		Inline: []domain.FileSpec{{Path: "/etc/motd", Content: "hello"}},
+	}))
+
+	mockSvc.EXPECT().GetSecret(gomock.Any(), orgId, "registry").Return(&domain.Secret{
+		Spec: domain.SecretSpec{Data: map[string]domain.SecureString{"token": "s3cr3t"}},
+	}, domain.StatusOK())
+
+	logic := NewDeviceRenderLogic(logrus.New(), mockSvc, nil, nil, nil, orgId, domain.Event{})
+	logic.deviceConfig = &[]domain.ConfigProviderSpec{secretProvider, inlineProvider}
+	ignitionConfig, _, err := logic.renderConfig(context.Background())
+	require.NoError(err)
+	renderedConfig, err := ignitionConfigToRenderedConfig(ignitionConfig, logic.renderedFileTransform(nil))
+	require.NoError(err)
+
+	var providers []domain.ConfigProviderSpec
+	require.NoError(json.Unmarshal(renderedConfig, &providers))
+	require.Len(providers, 1)
+	inlineSpec, err := providers[0].AsInlineConfigProviderSpec()
+	require.NoError(err)
+	sensitive := lo.SliceToMap(inlineSpec.Inline, func(file domain.FileSpec) (string, bool) {
+		return file.Path, lo.FromPtr(file.Sensitive)
+	})
+	require.Equal(map[string]bool{"/etc/registry/token": true, "/etc/motd": false}, sensitive)
+}
@@ -115,9 +115,9 @@ func (f FleetRolloutsLogic) RolloutFleet(ctx context.Context) error {
 	}
 	f.log.Infof("Rolling out fleet %s/%s", f.orgId, f.event.InvolvedObject.Name)
 
-	templateVersion, status := f.serviceHandler.GetLatestTemplateVersion(ctx, f.orgId, f.event.InvolvedObject.Name)
-	if status.Code != http.StatusOK {
-		return fmt.Errorf("failed to get templateVersion: %s", status.Message)
+	templateVersion, rolledBack, err := f.targetTemplateVersion(ctx, fleet)
+	if err != nil {
+		return err
 	}
 
 	owner := util.SetResourceOwner(domain.FleetKind, f.event.InvolvedObject.Name)
@@ -134,7 +134,8 @@ func (f FleetRolloutsLogic) RolloutFleet(ctx context.Context) error {
 			Values:   &[]string{lo.FromPtr(templateVersion.Metadata.Name)},
 		}.String(),
 	}
-	if fleet.Spec.RolloutPolicy != nil && fleet.Spec.RolloutPolicy.DeviceSelection != nil {
+	// After a rollback, all the devices of the fleet are reverted, regardless of the batch they were rolled out in
+	if fleet.Spec.RolloutPolicy != nil && fleet.Spec.RolloutPolicy.DeviceSelection != nil && !rolledBack {
 		annotationFilter = append(annotationFilter, domain.MatchExpression{
 			Key:      domain.DeviceAnnotationSelectedForRollout,
 			Operator: domain.Exists,
@@ -221,20 +222,19 @@ func (f FleetRolloutsLogic) RolloutDevice(ctx context.Context) error {
 	}
 	f.owner = *device.Metadata.Owner
 
-	templateVersion, status := f.serviceHandler.GetLatestTemplateVersion(ctx, f.orgId, ownerName)
-	if status.Code != http.StatusOK {
-		return fmt.Errorf("failed to get templateVersion: %s", status.Message)
-	}
-
 	fleet, status := f.serviceHandler.GetFleet(ctx, f.orgId, ownerName, domain.GetFleetParams{})
 	if status.Code != http.StatusOK {
 		return fmt.Errorf("failed to get fleet: %s", status.Message)
 	}
+	templateVersion, rolledBack, err := f.targetTemplateVersion(ctx, fleet)
+	if err != nil {
+		return err
+	}
 	rolloutProgressStage, err := rollout.ProgressStage(fleet)
 	if err != nil {
 		return fmt.Errorf("failed to find rollout progress stage for fleet: %w", err)
 	}
-	if rolloutProgressStage == rollout.ConfiguredBatch {
+	if rolloutProgressStage == rollout.ConfiguredBatch && !rolledBack {
 		// If a rollout is in progress, then the device will be rolled out by one of the next batches
 		f.log.Infof("Rollout is in progress for fleet %v/%s. Skipping device %s rollout", f.orgId, lo.FromPtr(fleet.Metadata.Name), f.event.InvolvedObject.Name)
 		return nil
@@ -243,6 +243,25 @@ func (f FleetRolloutsLogic) RolloutDevice(ctx context.Context) error {
 	return f.updateDeviceToFleetTemplate(ctx, device, templateVersion, delayDeviceRender)
 }
 
+// targetTemplateVersion returns the template version the fleet's devices should be at.  This is the latest template
+// version of the fleet, unless its rollout failed and was rolled back to a previous template version.
+func (f FleetRolloutsLogic) targetTemplateVersion(ctx context.Context, fleet *domain.Fleet) (*domain.TemplateVersion, bool, error) {
+	fleetName := lo.FromPtr(fleet.Metadata.Name)
+	templateVersion, status := f.serviceHandler.GetLatestTemplateVersion(ctx, f.orgId, fleetName)
+	if status.Code != http.StatusOK {
+		return nil, false, fmt.Errorf("failed to get templateVersion: %s", status.Message)
+	}
+	restored, rolledBack := rollout.RestoredTemplateVersion(fleet, lo.FromPtr(templateVersion.Metadata.Name))
+	if !rolledBack {
+		return templateVersion, false, nil
+	}
+	templateVersion, status = f.serviceHandler.GetTemplateVersion(ctx, f.orgId, fleetName, restored)
+	if status.Code != http.StatusOK {
+		return nil, false, fmt.Errorf("failed to get restored templateVersion %s: %s", restored, status.Message)
+	}
+	return templateVersion, true, nil
+}
+
 func (f FleetRolloutsLogic) updateDeviceToFleetTemplate(ctx context.Context, device *domain.Device, templateVersion *domain.TemplateVersion, delayDeviceRender bool) error {
 	currentVersion := ""
 	if device.Metadata.Annotations != nil {
@@ -287,6 +306,12 @@ func (f FleetRolloutsLogic) updateDeviceToFleetTemplate(ctx context.Context, dev
 		Resources:    templateVersion.Status.Resources,
 		Applications: deviceApps,
 		UpdatePolicy: templateVersion.Status.UpdatePolicy,
+		HealthChecks: templateVersion.Status.HealthChecks,
+		TrustPolicy:  templateVersion.Status.TrustPolicy,
+	}
+	// Variables are set per device rather than by the fleet template
+	if device.Spec != nil {
+		newDeviceSpec.Variables = device.Spec.Variables
 	}
 
 	errs = newDeviceSpec.Validate(false)
@@ -622,8 +647,9 @@ func (f FleetRolloutsLogic) replaceVolumeParameters(device *domain.Device, appNa
 		}
 
 		newVol := domain.ApplicationVolume{
-			Name:          vol.Name,
-			ReclaimPolicy: vol.ReclaimPolicy,
+			Name:                 vol.Name,
+			ReclaimPolicy:        vol.ReclaimPolicy,
+			SnapshotBeforeUpdate: vol.SnapshotBeforeUpdate,
 		}
 
 		switch volType {
@@ -708,6 +734,8 @@ func (f FleetRolloutsLogic) getDeviceConfig(device *domain.Device, templateVersi
 			newConfigItem, errs = f.replaceGitConfigParameters(device, configItem)
 		case domain.KubernetesSecretProviderType:
 			newConfigItem, errs = f.replaceKubeSecretConfigParameters(device, configItem)
+		case domain.SecretProviderType:
+			newConfigItem, errs = f.replaceSecretConfigParameters(device, configItem)
 		case domain.InlineConfigProviderType:
 			newConfigItem, errs = f.replaceInlineConfigParameters(device, configItem)
 		case domain.HttpConfigProviderType:
@@ -796,6 +824,37 @@ func (f FleetRolloutsLogic) replaceKubeSecretConfigParameters(device *domain.Dev
 	return &newConfigItem, nil
 }
 
+func (f FleetRolloutsLogic) replaceSecretConfigParameters(device *domain.Device, configItem domain.ConfigProviderSpec) (*domain.ConfigProviderSpec, []error) {
+	secretSpec, err := configItem.AsSecretProviderSpec()
+	if err != nil {
+		return nil, []error{fmt.Errorf("failed to convert config to secret config: %w", err)}
+	}
+
+	errs := []error{}
+
+	secretSpec.SecretResourceRef.Name, err = replaceParametersInString(secretSpec.SecretResourceRef.Name, device)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("failed replacing parameters in name in secret config %s: %w", secretSpec.Name, err))
+	}
+
+	secretSpec.SecretResourceRef.MountPath, err = replaceParametersInString(secretSpec.SecretResourceRef.MountPath, device)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("failed replacing parameters in mountPath in secret config %s: %w", secretSpec.Name, err))
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	newConfigItem := domain.ConfigProviderSpec{}
+	err = newConfigItem.FromSecretProviderSpec(secretSpec)
+	if err != nil {
+		return nil, []error{fmt.Errorf("failed converting secret config: %w", err)}
+	}
+
+	return &newConfigItem, nil
+}
+
 func (f FleetRolloutsLogic) replaceInlineConfigParameters(device *domain.Device, configItem domain.ConfigProviderSpec) (*domain.ConfigProviderSpec, []error) {
 	inlineSpec, err := configItem.AsInlineConfigProviderSpec()
 	if err != nil {
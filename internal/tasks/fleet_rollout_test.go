@@ -741,6 +741,27 @@ func TestFleetRolloutsLogic_ReplaceContainerApplicationParameters(t *testing.T)
 			expectedEnv:   map[string]string{"ENVIRONMENT": "staging"},
 			expectError:   false,
 		},
+		{
+			name: "replaces device variables and system info in envVars",
+			device: func() *domain.Device {
+				device := createTestDeviceWithLabels("mydevice", "fleet/test", map[string]string{})
+				device.Spec.Variables = &map[string]string{"gateway": "10.0.0.1"}
+				device.Status.SystemInfo = domain.DeviceSystemInfo{Architecture: "arm64"}
+				return device
+			}(),
+			image:         "quay.io/test/container:{{ .status.systemInfo.architecture }}",
+			envVars:       &map[string]string{"GATEWAY": "{{ .spec.variables.gateway }}"},
+			expectedImage: "quay.io/test/container:arm64",
+			expectedEnv:   map[string]string{"GATEWAY": "10.0.0.1"},
+			expectError:   false,
+		},
+		{
+			name:        "fails on missing device variable",
+			device:      createTestDeviceWithLabels("mydevice", "fleet/test", map[string]string{}),
+			image:       "quay.io/test/container:latest",
+			envVars:     &map[string]string{"GATEWAY": "{{ .spec.variables.gateway }}"},
+			expectError: true,
+		},
 		{
 			name:          "replaces multiple templates",
 			device:        createTestDeviceWithLabels("mydevice", "fleet/test", map[string]string{"version": "v3.0", "tier": "premium"}),
@@ -1001,3 +1022,53 @@ func TestFleetRolloutIterationContext(t *testing.T) {
 		assert.True(t, errors.Is(iterCtx.Err(), context.Canceled))
 	})
 }
+
+func TestFleetRolloutsLogic_TargetTemplateVersion(t *testing.T) {
+	tests := []struct {
+		name               string
+		rollback           *domain.FleetRolloutRollback
+		expectedVersion    string
+		expectedRolledBack bool
+	}{
+		{
+			name:            "NoRollback",
+			expectedVersion: "tv-2",
+		},
+		{
+			name:               "LatestVersionRolledBack",
+			rollback:           &domain.FleetRolloutRollback{TemplateVersion: "tv-2", RestoredTemplateVersion: "tv-1"},
+			expectedVersion:    "tv-1",
+			expectedRolledBack: true,
+		},
+		{
+			name:            "OlderVersionRolledBack",
+			rollback:        &domain.FleetRolloutRollback{TemplateVersion: "tv-0", RestoredTemplateVersion: "tv-1"},
+			expectedVersion: "tv-2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			fleetName := "test-fleet"
+			fleet := createTestFleetForRollout(fleetName, &domain.RolloutPolicy{
+				OnFailure: lo.ToPtr(domain.RolloutFailurePolicyRollback),
+			})
+			fleet.Status = &domain.FleetStatus{Rollout: &domain.FleetRolloutStatus{Rollback: tt.rollback}}
+
+			mockService := service.NewMockService(ctrl)
+			mockService.EXPECT().GetLatestTemplateVersion(gomock.Any(), gomock.Any(), fleetName).Return(createTestTemplateVersion("tv-2"), domain.Status{Code: http.StatusOK})
+			if tt.expectedRolledBack {
+				mockService.EXPECT().GetTemplateVersion(gomock.Any(), gomock.Any(), fleetName, tt.expectedVersion).Return(createTestTemplateVersion(tt.expectedVersion), domain.Status{Code: http.StatusOK})
+			}
+
+			logic := NewFleetRolloutsLogic(logrus.New(), mockService, uuid.New(), domain.Event{})
+			templateVersion, rolledBack, err := logic.targetTemplateVersion(context.Background(), fleet)
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedRolledBack, rolledBack)
+			require.Equal(t, tt.expectedVersion, lo.FromPtr(templateVersion.Metadata.Name))
+		})
+	}
+}
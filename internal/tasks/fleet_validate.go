@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -67,6 +68,12 @@ func (t *FleetValidateLogic) CreateNewTemplateVersionIfFleetValid(ctx context.Co
 	templateVersionName := generateTemplateVersionName(fleet)
 	t.templateConfig = fleet.Spec.Template.Spec.Config
 	referencedRepos, validationErr := t.validateConfig(ctx)
+	if validationErr == nil {
+		validationErr = t.validateTrustPolicy(ctx, fleet.Spec.Template.Spec.TrustPolicy)
+	}
+	if validationErr == nil {
+		validationErr = validateApplicationDependencies(fleet.Spec.Template.Spec.Applications)
+	}
 
 	// Set the many-to-many relationship with the repos (we do this even if the validation failed so that we will
 	// validate the fleet again if the repository is updated, and then it might be fixed).
@@ -92,6 +99,8 @@ func (t *FleetValidateLogic) CreateNewTemplateVersionIfFleetValid(ctx context.Co
 			Resources:    fleet.Spec.Template.Spec.Resources,
 			Systemd:      fleet.Spec.Template.Spec.Systemd,
 			UpdatePolicy: fleet.Spec.Template.Spec.UpdatePolicy,
+			HealthChecks: fleet.Spec.Template.Spec.HealthChecks,
+			TrustPolicy:  fleet.Spec.Template.Spec.TrustPolicy,
 		},
 	}
 
@@ -178,6 +187,80 @@ func (t *FleetValidateLogic) validateConfig(ctx context.Context) ([]string, erro
 	return referencedRepos, nil
 }
 
+// validateTrustPolicy checks that the TrustPolicy referenced by the fleet's template exists
+func (t *FleetValidateLogic) validateTrustPolicy(ctx context.Context, trustPolicy *domain.DeviceTrustPolicy) error {
+	if trustPolicy == nil {
+		return nil
+	}
+	_, status := t.serviceHandler.GetTrustPolicy(ctx, t.orgId, trustPolicy.Name)
+	if status.Code != http.StatusOK {
+		return fmt.Errorf("failed getting trust policy %s: %s", trustPolicy.Name, status.Message)
+	}
+	return nil
+}
+
+// validateApplicationDependencies checks that the dependencies between the applications of the fleet's template
+// do not form a cycle, which would leave the agent unable to order their start. The API validation already
+// ensures that each dependency refers to another application of the template.
+func validateApplicationDependencies(apps *[]domain.ApplicationProviderSpec) error {
+	if apps == nil {
+		return nil
+	}
+
+	var names []string
+	dependsOn := make(map[string][]string)
+	for _, app := range *apps {
+		// applications without a name cannot be referenced, so they cannot be part of a cycle
+		name, err := app.GetName()
+		if err != nil || name == nil {
+			continue
+		}
+		deps, err := app.GetDependsOn()
+		if err != nil {
+			return fmt.Errorf("failed getting dependencies of application %s: %w", *name, err)
+		}
+		names = append(names, *name)
+		for _, dep := range deps {
+			dependsOn[*name] = append(dependsOn[*name], dep.Name)
+		}
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int, len(names))
+	var path []string
+	var visit func(name string) error
+	visit = func(name string) error {
+		switch state[name] {
+		case visited:
+			return nil
+		case visiting:
+			start := slices.Index(path, name)
+			return fmt.Errorf("application dependency cycle: %s", strings.Join(append(path[start:], name), " -> "))
+		}
+		state[name] = visiting
+		path = append(path, name)
+		for _, dep := range dependsOn[name] {
+			if err := visit(dep); err != nil {
+				return err
+			}
+		}
+		path = path[:len(path)-1]
+		state[name] = visited
+		return nil
+	}
+
+	for _, name := range names {
+		if err := visit(name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (t *FleetValidateLogic) validateConfigItem(ctx context.Context, configItem *domain.ConfigProviderSpec) (*string, *string, error) {
 	configType, err := configItem.Type()
 	if err != nil {
@@ -189,6 +272,8 @@ func (t *FleetValidateLogic) validateConfigItem(ctx context.Context, configItem
 		return t.validateGitConfig(ctx, configItem)
 	case domain.KubernetesSecretProviderType:
 		return t.validateK8sConfig(ctx, configItem)
+	case domain.SecretProviderType:
+		return t.validateSecretConfig(ctx, configItem)
 	case domain.InlineConfigProviderType:
 		return t.validateInlineConfig(configItem)
 	case domain.HttpConfigProviderType:
@@ -232,6 +317,19 @@ func (t *FleetValidateLogic) validateK8sConfig(ctx context.Context, configItem *
 	return &k8sSpec.Name, nil, nil
 }
 
+func (t *FleetValidateLogic) validateSecretConfig(ctx context.Context, configItem *domain.ConfigProviderSpec) (*string, *string, error) {
+	secretSpec, err := configItem.AsSecretProviderSpec()
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: failed getting config item as SecretProviderSpec: %w", ErrUnknownConfigName, err)
+	}
+	_, status := t.serviceHandler.GetSecret(ctx, t.orgId, secretSpec.SecretResourceRef.Name)
+	if status.Code != http.StatusOK {
+		return &secretSpec.Name, nil, fmt.Errorf("failed getting secret %s: %s", secretSpec.SecretResourceRef.Name, status.Message)
+	}
+
+	return &secretSpec.Name, nil, nil
+}
+
 func (t *FleetValidateLogic) validateInlineConfig(configItem *domain.ConfigProviderSpec) (*string, *string, error) {
 	inlineSpec, err := configItem.AsInlineConfigProviderSpec()
 	if err != nil {
@@ -10,6 +10,7 @@ import (
 	"github.com/flightctl/flightctl/internal/service"
 	"github.com/flightctl/flightctl/pkg/k8sclient"
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -102,6 +103,71 @@ func TestFleetValidateLogic_CreateNewTemplateVersionIfFleetValid_ImmediateRollou
 	}
 }
 
+func TestValidateApplicationDependencies(t *testing.T) {
+	newApp := func(name string, dependsOn ...string) domain.ApplicationProviderSpec {
+		containerApp := domain.ContainerApplication{
+			Name:    lo.ToPtr(name),
+			AppType: domain.AppTypeContainer,
+			Image:   "quay.io/example/" + name + ":v1",
+		}
+		if len(dependsOn) > 0 {
+			containerApp.DependsOn = lo.ToPtr(lo.Map(dependsOn, func(dep string, _ int) domain.ApplicationDependency {
+				return domain.ApplicationDependency{Name: dep}
+			}))
+		}
+		var app domain.ApplicationProviderSpec
+		require.NoError(t, app.FromContainerApplication(containerApp))
+		return app
+	}
+
+	tests := []struct {
+		name    string
+		apps    *[]domain.ApplicationProviderSpec
+		wantErr string
+	}{
+		{
+			name: "no applications",
+		},
+		{
+			name: "chain of dependencies",
+			apps: &[]domain.ApplicationProviderSpec{
+				newApp("api", "broker", "db"),
+				newApp("broker", "db"),
+				newApp("db"),
+			},
+		},
+		{
+			name: "cycle of two applications",
+			apps: &[]domain.ApplicationProviderSpec{
+				newApp("api", "db"),
+				newApp("db", "api"),
+			},
+			wantErr: "application dependency cycle: api -> db -> api",
+		},
+		{
+			name: "cycle not including the first application",
+			apps: &[]domain.ApplicationProviderSpec{
+				newApp("ui", "api"),
+				newApp("api", "broker"),
+				newApp("broker", "db"),
+				newApp("db", "api"),
+			},
+			wantErr: "application dependency cycle: api -> broker -> db -> api",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateApplicationDependencies(tt.apps)
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
+
 func TestGenerateTemplateVersionName(t *testing.T) {
 	require := require.New(t)
 
@@ -4,11 +4,13 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/flightctl/flightctl/internal/domain"
 	"github.com/flightctl/flightctl/internal/service"
 	servicecommon "github.com/flightctl/flightctl/internal/service/common"
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
 )
 
@@ -47,12 +49,21 @@ func NewRepositoryUpdateLogic(log logrus.FieldLogger, serviceHandler service.Ser
 }
 
 func (t *RepositoryUpdateLogic) HandleRepositoryUpdate(ctx context.Context) error {
+	// after a push, only the resources that follow one of the pushed revisions need to be notified
+	revisions, err := pushedRevisions(t.event)
+	if err != nil {
+		return err
+	}
+
 	fleets, status := t.serviceHandler.GetRepositoryFleetReferences(ctx, t.orgId, t.event.InvolvedObject.Name)
 	if status.Code != http.StatusOK {
 		return fmt.Errorf("fetching fleets: %s", status.Message)
 	}
 
 	for _, fleet := range fleets.Items {
+		if !referencesPushedRevision(fleet.Spec.Template.Spec.Config, t.event.InvolvedObject.Name, revisions) {
+			continue
+		}
 		t.serviceHandler.CreateEvent(ctx, t.orgId, servicecommon.GetReferencedRepositoryUpdatedEvent(ctx, domain.FleetKind, *fleet.Metadata.Name, t.event.InvolvedObject.Name))
 	}
 
@@ -62,8 +73,54 @@ func (t *RepositoryUpdateLogic) HandleRepositoryUpdate(ctx context.Context) erro
 	}
 
 	for _, device := range devices.Items {
+		if device.Spec != nil && !referencesPushedRevision(device.Spec.Config, t.event.InvolvedObject.Name, revisions) {
+			continue
+		}
 		t.serviceHandler.CreateEvent(ctx, t.orgId, servicecommon.GetReferencedRepositoryUpdatedEvent(ctx, domain.DeviceKind, *device.Metadata.Name, t.event.InvolvedObject.Name))
 	}
 
 	return nil
 }
+
+// pushedRevisions returns the revisions of a RepositoryPushReceived event. It returns an empty list for other events
+// and for pushes that may have changed any revision.
+func pushedRevisions(event domain.Event) ([]string, error) {
+	if event.Reason != domain.EventReasonRepositoryPushReceived || event.Details == nil {
+		return nil, nil
+	}
+	details, err := event.Details.AsRepositoryPushReceivedDetails()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert event details to repository push received details: %w", err)
+	}
+	return details.Revisions, nil
+}
+
+// matchesPushedRevision reports whether the target revision may have changed by a push of the given revisions.
+// Revisions that are templated or resolved by the Git server cannot be compared, so they always match.
+func matchesPushedRevision(targetRevision string, revisions []string) bool {
+	if len(revisions) == 0 || targetRevision == "" || targetRevision == "HEAD" || strings.Contains(targetRevision, "{{") {
+		return true
+	}
+	return lo.Contains(revisions, targetRevision)
+}
+
+// referencesPushedRevision reports whether the config refers to a revision of the repository that may have changed
+// by a push of the given revisions.
+func referencesPushedRevision(config *[]domain.ConfigProviderSpec, repository string, revisions []string) bool {
+	if len(revisions) == 0 {
+		return true
+	}
+	for _, item := range lo.FromPtr(config) {
+		if providerType, err := item.Type(); err != nil || providerType != domain.GitConfigProviderType {
+			continue
+		}
+		gitSpec, err := item.AsGitConfigProviderSpec()
+		if err != nil || gitSpec.GitRef.Repository != repository {
+			continue
+		}
+		if matchesPushedRevision(gitSpec.GitRef.TargetRevision, revisions) {
+			return true
+		}
+	}
+	return false
+}
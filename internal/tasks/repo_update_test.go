@@ -0,0 +1,55 @@
+package tasks
+
+import (
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchesPushedRevision(t *testing.T) {
+	pushed := []string{"refs/heads/main", "main", "0123456789abcdef0123456789abcdef01234567"}
+
+	tests := []struct {
+		name           string
+		targetRevision string
+		revisions      []string
+		expected       bool
+	}{
+		{name: "pushed branch", targetRevision: "main", revisions: pushed, expected: true},
+		{name: "pushed reference", targetRevision: "refs/heads/main", revisions: pushed, expected: true},
+		{name: "other branch", targetRevision: "release-1.0", revisions: pushed, expected: false},
+		{name: "default branch", targetRevision: "HEAD", revisions: pushed, expected: true},
+		{name: "templated revision", targetRevision: "{{ .metadata.labels.branch }}", revisions: pushed, expected: true},
+		{name: "any revision", targetRevision: "release-1.0", revisions: []string{}, expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, matchesPushedRevision(tt.targetRevision, tt.revisions))
+		})
+	}
+}
+
+func TestReferencesPushedRevision(t *testing.T) {
+	require := require.New(t)
+
+	gitConfig := func(repository, targetRevision string) domain.ConfigProviderSpec {
+		var provider domain.ConfigProviderSpec
+		require.NoError(provider.FromGitConfigProviderSpec(domain.GitConfigProviderSpec{
+			Name: "config",
+			GitRef: struct {
+				Path           string `json:"path"`
+				Repository     string `json:"repository"`
+				TargetRevision string `json:"targetRevision"`
+			}{Path: "/etc", Repository: repository, TargetRevision: targetRevision},
+		}))
+		return provider
+	}
+	pushed := []string{"refs/heads/main", "main"}
+
+	require.True(referencesPushedRevision(&[]domain.ConfigProviderSpec{gitConfig("repo", "main")}, "repo", pushed))
+	require.False(referencesPushedRevision(&[]domain.ConfigProviderSpec{gitConfig("repo", "stable")}, "repo", pushed))
+	require.False(referencesPushedRevision(&[]domain.ConfigProviderSpec{gitConfig("other", "main")}, "repo", pushed))
+	require.True(referencesPushedRevision(&[]domain.ConfigProviderSpec{gitConfig("repo", "stable")}, "repo", nil))
+}
@@ -46,26 +46,66 @@ func NewResourceSync(serviceHandler service.Service, log logrus.FieldLogger, cfg
 	}
 }
 
+// The resourceSync task is triggered when the Git server of a repository notifies us of a push. It syncs the
+// ResourceSyncs that follow one of the pushed revisions right away, so that polling is only a fallback.
+func resourceSyncOnPush(ctx context.Context, orgId uuid.UUID, event domain.Event, serviceHandler service.Service, cfg *config.Config, log logrus.FieldLogger) error {
+	revisions, err := pushedRevisions(event)
+	if err != nil {
+		return err
+	}
+	return newResourceSyncFromConfig(serviceHandler, log, cfg).SyncRepository(ctx, orgId, event.InvolvedObject.Name, revisions)
+}
+
+func newResourceSyncFromConfig(serviceHandler service.Service, log logrus.FieldLogger, cfg *config.Config) *ResourceSync {
+	var ignoreResourceUpdates []string
+	if cfg != nil && cfg.GitOps != nil {
+		ignoreResourceUpdates = cfg.GitOps.IgnoreResourceUpdates
+	}
+	return NewResourceSync(serviceHandler, log, cfg, ignoreResourceUpdates)
+}
+
 func (r *ResourceSync) Poll(ctx context.Context, orgId uuid.UUID) {
 	log := log.WithReqIDFromCtx(ctx, r.log)
 
 	log.Info("Running ResourceSync Polling")
 
+	if err := r.syncMatching(ctx, log, orgId, nil, func(*domain.ResourceSync) bool { return true }); err != nil {
+		log.Error(err)
+	}
+}
+
+// SyncRepository syncs the ResourceSyncs of the repository whose target revision may have changed by a push of the
+// given revisions. An empty list of revisions syncs all ResourceSyncs of the repository.
+func (r *ResourceSync) SyncRepository(ctx context.Context, orgId uuid.UUID, repositoryName string, revisions []string) error {
+	log := log.WithReqIDFromCtx(ctx, r.log)
+
+	log.Infof("Running ResourceSync for push to repository %s", repositoryName)
+
+	fieldSelector := fmt.Sprintf("spec.repository=%s", repositoryName)
+	return r.syncMatching(ctx, log, orgId, &fieldSelector, func(rs *domain.ResourceSync) bool {
+		return matchesPushedRevision(rs.Spec.TargetRevision, revisions)
+	})
+}
+
+func (r *ResourceSync) syncMatching(ctx context.Context, log logrus.FieldLogger, orgId uuid.UUID, fieldSelector *string, matches func(*domain.ResourceSync) bool) error {
 	limit := int32(ItemsPerPage)
 	continueToken := (*string)(nil)
 
 	for {
 		resourcesyncs, status := r.serviceHandler.ListResourceSyncs(ctx, orgId, domain.ListResourceSyncsParams{
-			Limit:    &limit,
-			Continue: continueToken,
+			FieldSelector: fieldSelector,
+			Limit:         &limit,
+			Continue:      continueToken,
 		})
 		if status.Code != 200 {
-			log.Errorf("error fetching repositories: %s", status.Message)
-			return
+			return fmt.Errorf("error fetching resourcesyncs: %s", status.Message)
 		}
 
 		for i := range resourcesyncs.Items {
 			rs := &resourcesyncs.Items[i]
+			if !matches(rs) {
+				continue
+			}
 			err := r.run(ctx, log, orgId, rs)
 			if err != nil {
 				log.Errorf("resourcesync/%s: error during run: %v", *rs.Metadata.Name, err)
@@ -74,7 +114,7 @@ func (r *ResourceSync) Poll(ctx context.Context, orgId uuid.UUID) {
 
 		continueToken = resourcesyncs.Metadata.Continue
 		if continueToken == nil {
-			break
+			return nil
 		}
 	}
 }
@@ -89,6 +129,11 @@ func (r *ResourceSync) run(ctx context.Context, log logrus.FieldLogger, orgId uu
 		return err
 	}
 
+	if lo.FromPtr(rs.Spec.DryRun) {
+		return r.dryRun(ctx, log, orgId, rs)
+	}
+	rs.Status.Preview = nil
+
 	// Parse and validate resources
 	resources, err := r.parseAndValidateResources(rs, repo, CloneGitRepo)
 	if err != nil {
@@ -270,27 +315,12 @@ func (r *ResourceSync) SyncFleets(ctx context.Context, log logrus.FieldLogger, o
 		return validateErr
 	}
 
-	fleetsPreOwned := make([]domain.Fleet, 0)
-
-	listParams := domain.ListFleetsParams{
-		Limit:         lo.ToPtr(int32(100)),
-		FieldSelector: lo.ToPtr(fmt.Sprintf("metadata.owner=%s", *owner)),
-	}
-	for {
-		var listRes *domain.FleetList
-		var status domain.Status
-		listRes, status = r.serviceHandler.ListFleets(ctx, orgId, listParams)
-		if status.Code != http.StatusOK {
-			listErr := fmt.Errorf("resource %s: failed to list owned fleets. error: %s", resourceName, status.Message)
-			log.Error(listErr)
-			domain.SetStatusConditionByError(&rs.Status.Conditions, domain.ConditionTypeResourceSyncSynced, "success", "fail", listErr)
-			return listErr
-		}
-		fleetsPreOwned = append(fleetsPreOwned, listRes.Items...)
-		if listRes.Metadata.Continue == nil {
-			break
-		}
-		listParams.Continue = listRes.Metadata.Continue
+	fleetsPreOwned, err := r.listOwnedFleets(ctx, orgId, *owner)
+	if err != nil {
+		listErr := fmt.Errorf("resource %s: failed to list owned fleets. error: %w", resourceName, err)
+		log.Error(listErr)
+		domain.SetStatusConditionByError(&rs.Status.Conditions, domain.ConditionTypeResourceSyncSynced, "success", "fail", listErr)
+		return listErr
 	}
 
 	fleetsToRemove := fleetsDelta(fleetsPreOwned, fleets)
@@ -323,6 +353,25 @@ func (r *ResourceSync) SyncFleets(ctx context.Context, log logrus.FieldLogger, o
 	return nil
 }
 
+func (r *ResourceSync) listOwnedFleets(ctx context.Context, orgId uuid.UUID, owner string) ([]domain.Fleet, error) {
+	owned := make([]domain.Fleet, 0)
+	listParams := domain.ListFleetsParams{
+		Limit:         lo.ToPtr(int32(100)),
+		FieldSelector: lo.ToPtr(fmt.Sprintf("metadata.owner=%s", owner)),
+	}
+	for {
+		listRes, status := r.serviceHandler.ListFleets(ctx, orgId, listParams)
+		if status.Code != http.StatusOK {
+			return nil, errors.New(status.Message)
+		}
+		owned = append(owned, listRes.Items...)
+		if listRes.Metadata.Continue == nil {
+			return owned, nil
+		}
+		listParams.Continue = listRes.Metadata.Continue
+	}
+}
+
 func (r *ResourceSync) createOrUpdateMultiple(ctx context.Context, orgId uuid.UUID, owner *string, resources ...*domain.Fleet) error {
 	var errs []error
 	for _, resource := range resources {
@@ -724,24 +773,12 @@ func (r *ResourceSync) SyncCatalogs(ctx context.Context, log logrus.FieldLogger,
 	}
 
 	// List pre-owned catalogs
-	catalogsPreOwned := make([]domain.Catalog, 0)
-	listParams := domain.ListCatalogsParams{
-		Limit:         lo.ToPtr(int32(100)),
-		FieldSelector: lo.ToPtr(fmt.Sprintf("metadata.owner=%s", *owner)),
-	}
-	for {
-		listRes, status := r.serviceHandler.ListCatalogs(ctx, orgId, listParams)
-		if status.Code != http.StatusOK {
-			err := fmt.Errorf("resource %s: failed to list owned catalogs: %s", resourceName, status.Message)
-			log.Error(err)
-			domain.SetStatusConditionByError(&rs.Status.Conditions, domain.ConditionTypeResourceSyncSynced, "success", "fail", err)
-			return nil, err
-		}
-		catalogsPreOwned = append(catalogsPreOwned, listRes.Items...)
-		if listRes.Metadata.Continue == nil {
-			break
-		}
-		listParams.Continue = listRes.Metadata.Continue
+	catalogsPreOwned, err := r.listOwnedCatalogs(ctx, orgId, *owner)
+	if err != nil {
+		err = fmt.Errorf("resource %s: failed to list owned catalogs: %w", resourceName, err)
+		log.Error(err)
+		domain.SetStatusConditionByError(&rs.Status.Conditions, domain.ConditionTypeResourceSyncSynced, "success", "fail", err)
+		return nil, err
 	}
 
 	toRemove := catalogsDelta(catalogsPreOwned, catalogs)
@@ -763,6 +800,25 @@ func (r *ResourceSync) SyncCatalogs(ctx context.Context, log logrus.FieldLogger,
 	return toRemove, nil
 }
 
+func (r *ResourceSync) listOwnedCatalogs(ctx context.Context, orgId uuid.UUID, owner string) ([]domain.Catalog, error) {
+	owned := make([]domain.Catalog, 0)
+	listParams := domain.ListCatalogsParams{
+		Limit:         lo.ToPtr(int32(100)),
+		FieldSelector: lo.ToPtr(fmt.Sprintf("metadata.owner=%s", owner)),
+	}
+	for {
+		listRes, status := r.serviceHandler.ListCatalogs(ctx, orgId, listParams)
+		if status.Code != http.StatusOK {
+			return nil, errors.New(status.Message)
+		}
+		owned = append(owned, listRes.Items...)
+		if listRes.Metadata.Continue == nil {
+			return owned, nil
+		}
+		listParams.Continue = listRes.Metadata.Continue
+	}
+}
+
 func (r *ResourceSync) createOrUpdateCatalogs(ctx context.Context, orgId uuid.UUID, owner *string, resources ...*domain.Catalog) error {
 	var errs []error
 	for _, resource := range resources {
@@ -851,24 +907,12 @@ func (r *ResourceSync) SyncCatalogItems(ctx context.Context, log logrus.FieldLog
 	}
 
 	// List pre-owned items
-	itemsPreOwned := make([]domain.CatalogItem, 0)
-	listParams := domain.ListAllCatalogItemsParams{
-		Limit:         lo.ToPtr(int32(100)),
-		FieldSelector: lo.ToPtr(fmt.Sprintf("metadata.owner=%s", *owner)),
-	}
-	for {
-		listRes, status := r.serviceHandler.ListAllCatalogItems(ctx, orgId, listParams)
-		if status.Code != http.StatusOK {
-			err := fmt.Errorf("resource %s: failed to list owned catalog items: %s", resourceName, status.Message)
-			log.Error(err)
-			domain.SetStatusConditionByError(&rs.Status.Conditions, domain.ConditionTypeResourceSyncSynced, "success", "fail", err)
-			return nil, err
-		}
-		itemsPreOwned = append(itemsPreOwned, listRes.Items...)
-		if listRes.Metadata.Continue == nil {
-			break
-		}
-		listParams.Continue = listRes.Metadata.Continue
+	itemsPreOwned, err := r.listOwnedCatalogItems(ctx, orgId, *owner)
+	if err != nil {
+		err = fmt.Errorf("resource %s: failed to list owned catalog items: %w", resourceName, err)
+		log.Error(err)
+		domain.SetStatusConditionByError(&rs.Status.Conditions, domain.ConditionTypeResourceSyncSynced, "success", "fail", err)
+		return nil, err
 	}
 
 	toRemove := catalogItemsDelta(itemsPreOwned, items)
@@ -890,6 +934,25 @@ func (r *ResourceSync) SyncCatalogItems(ctx context.Context, log logrus.FieldLog
 	return toRemove, nil
 }
 
+func (r *ResourceSync) listOwnedCatalogItems(ctx context.Context, orgId uuid.UUID, owner string) ([]domain.CatalogItem, error) {
+	owned := make([]domain.CatalogItem, 0)
+	listParams := domain.ListAllCatalogItemsParams{
+		Limit:         lo.ToPtr(int32(100)),
+		FieldSelector: lo.ToPtr(fmt.Sprintf("metadata.owner=%s", owner)),
+	}
+	for {
+		listRes, status := r.serviceHandler.ListAllCatalogItems(ctx, orgId, listParams)
+		if status.Code != http.StatusOK {
+			return nil, errors.New(status.Message)
+		}
+		owned = append(owned, listRes.Items...)
+		if listRes.Metadata.Continue == nil {
+			return owned, nil
+		}
+		listParams.Continue = listRes.Metadata.Continue
+	}
+}
+
 func (r *ResourceSync) createOrUpdateCatalogItems(ctx context.Context, orgId uuid.UUID, owner *string, items ...*domain.CatalogItem) error {
 	var errs []error
 	for _, item := range items {
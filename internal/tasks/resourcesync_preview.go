@@ -0,0 +1,366 @@
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/config"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/kvstore"
+	"github.com/flightctl/flightctl/internal/service"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+// resourceSyncPreviewTTL is how long a requested preview is kept for the requester to read it.
+const resourceSyncPreviewTTL = time.Hour
+
+// The resourceSyncPreview task computes a preview of a ResourceSync that was requested through the API and stores it
+// for the requester to read. Previews clone the repository, so they are computed by the workers rather than the API.
+func resourceSyncPreview(ctx context.Context, orgId uuid.UUID, event domain.Event, serviceHandler service.Service, kvStore kvstore.KVStore, cfg *config.Config, log logrus.FieldLogger) error {
+	return newResourceSyncFromConfig(serviceHandler, log, cfg).storeRequestedPreview(ctx, orgId, event, kvStore, CloneGitRepo)
+}
+
+func (r *ResourceSync) storeRequestedPreview(ctx context.Context, orgId uuid.UUID, event domain.Event, kvStore kvstore.KVStore, gitCloneRepo cloneGitRepoFunc) error {
+	if event.Details == nil {
+		return fmt.Errorf("missing details of %s event", event.Reason)
+	}
+	details, err := event.Details.AsResourceSyncPreviewRequestedDetails()
+	if err != nil {
+		return fmt.Errorf("failed to parse details of %s event: %w", event.Reason, err)
+	}
+
+	name := event.InvolvedObject.Name
+	preview := &domain.ResourceSyncPreview{
+		Revision: details.Revision,
+		Changes:  []domain.ResourceSyncChange{},
+		Errors:   []string{},
+	}
+	// problems are reported in the preview, as the requester waits for it
+	rs, status := r.serviceHandler.GetResourceSync(ctx, orgId, name)
+	if status.Code != http.StatusOK {
+		preview.Errors = append(preview.Errors, fmt.Sprintf("resourcesync %s: %s", name, status.Message))
+	} else {
+		rs.Spec.TargetRevision = details.Revision
+		computed, err := r.previewResourceSync(ctx, orgId, rs, gitCloneRepo)
+		if err != nil {
+			preview.Errors = append(preview.Errors, err.Error())
+		} else {
+			preview = computed
+		}
+	}
+
+	data, err := json.Marshal(preview)
+	if err != nil {
+		return fmt.Errorf("failed to marshal preview of resourcesync %s: %w", name, err)
+	}
+	key := kvstore.ResourceSyncPreviewKey{OrgID: orgId, ResourceSyncName: name, RequestID: details.RequestId}
+	keyStr := key.ComposeKey()
+	if _, err := kvStore.SetNX(ctx, keyStr, data); err != nil {
+		return fmt.Errorf("failed to store preview of resourcesync %s: %w", name, err)
+	}
+	if err := kvStore.SetExpire(ctx, keyStr, resourceSyncPreviewTTL); err != nil {
+		return fmt.Errorf("failed to store preview of resourcesync %s: %w", name, err)
+	}
+	return nil
+}
+
+// PreviewResourceSync computes the changes that syncing the ResourceSync at its target revision would make to the
+// resources it manages. It only reads the repository and the live resources and never modifies anything. Problems
+// that would make the sync fail are reported as errors of the preview; the returned error is only set if the live
+// resources cannot be read.
+func (r *ResourceSync) PreviewResourceSync(ctx context.Context, orgId uuid.UUID, rs *domain.ResourceSync) (*domain.ResourceSyncPreview, error) {
+	return r.previewResourceSync(ctx, orgId, rs, CloneGitRepo)
+}
+
+func (r *ResourceSync) previewResourceSync(ctx context.Context, orgId uuid.UUID, rs *domain.ResourceSync, gitCloneRepo cloneGitRepoFunc) (*domain.ResourceSyncPreview, error) {
+	resourceName := lo.FromPtr(rs.Metadata.Name)
+	preview := &domain.ResourceSyncPreview{
+		Revision: rs.Spec.TargetRevision,
+		Changes:  []domain.ResourceSyncChange{},
+		Errors:   []string{},
+	}
+	addError := func(err error) {
+		preview.Errors = append(preview.Errors, err.Error())
+	}
+
+	repo, status := r.serviceHandler.GetRepository(ctx, orgId, rs.Spec.Repository)
+	if status.Code != http.StatusOK {
+		addError(fmt.Errorf("repository %s: %s", rs.Spec.Repository, status.Message))
+		return preview, nil
+	}
+
+	mfs, hash, err := gitCloneRepo(repo, &rs.Spec.TargetRevision, lo.ToPtr(1), r.cfg)
+	if err != nil {
+		addError(fmt.Errorf("failed to clone repository %s: %w", rs.Spec.Repository, err))
+		return preview, nil
+	}
+	preview.Commit = lo.ToPtr(hash)
+
+	fileInfo, err := mfs.Stat(rs.Spec.Path)
+	if err != nil {
+		addError(fmt.Errorf("path %s not found in repository: %w", rs.Spec.Path, err))
+		return preview, nil
+	}
+	var resources []GenericResourceMap
+	if fileInfo.IsDir() {
+		resources, err = r.extractResourcesFromDir(mfs, rs.Spec.Path)
+	} else {
+		resources, err = r.extractResourcesFromFile(mfs, rs.Spec.Path)
+	}
+	if err != nil {
+		addError(fmt.Errorf("resource %s: error: %w", resourceName, err))
+		return preview, nil
+	}
+
+	owner := *util.SetResourceOwner(domain.ResourceSyncKind, resourceName)
+	var changes []domain.ResourceSyncChange
+	switch syncType := lo.FromPtrOr(rs.Spec.Type, domain.ResourceSyncTypeFleet); syncType {
+	case domain.ResourceSyncTypeFleet:
+		changes, err = r.previewFleetResources(ctx, orgId, owner, resources, addError)
+	case domain.ResourceSyncTypeCatalog:
+		changes, err = r.previewCatalogResources(ctx, orgId, owner, resources, addError)
+	default:
+		addError(fmt.Errorf("resource %s: unsupported sync type %q", resourceName, syncType))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("resource %s: %w", resourceName, err)
+	}
+	preview.Changes = append(preview.Changes, changes...)
+	return preview, nil
+}
+
+func (r *ResourceSync) previewFleetResources(ctx context.Context, orgId uuid.UUID, owner string, resources []GenericResourceMap, addError func(error)) ([]domain.ResourceSyncChange, error) {
+	if unexpected := unexpectedKinds(resources, domain.FleetKind); len(unexpected) > 0 {
+		addError(fmt.Errorf("sync type is fleet but found unexpected kind(s): %v", unexpected))
+		return nil, nil
+	}
+	fleets, err := r.parseFleets(filterByKind(resources, domain.FleetKind))
+	if err != nil {
+		addError(err)
+		return nil, nil
+	}
+	if err := r.validateFleetNameConflicts(ctx, orgId, fleets, owner); err != nil {
+		addError(err)
+	}
+
+	changes := []domain.ResourceSyncChange{}
+	for _, fleet := range fleets {
+		live, status := r.serviceHandler.GetFleet(ctx, orgId, *fleet.Metadata.Name, domain.GetFleetParams{})
+		if status.Code != http.StatusOK && status.Code != http.StatusNotFound {
+			return nil, fmt.Errorf("failed to get fleet %s: %s", *fleet.Metadata.Name, status.Message)
+		}
+		var liveObj any
+		if status.Code == http.StatusOK {
+			liveObj = live
+		}
+		if err := r.appendChange(&changes, domain.FleetKind, *fleet.Metadata.Name, liveObj, fleet); err != nil {
+			return nil, err
+		}
+	}
+
+	owned, err := r.listOwnedFleets(ctx, orgId, owner)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list owned fleets. error: %w", err)
+	}
+	for _, name := range fleetsDelta(owned, fleets) {
+		changes = append(changes, deleteChange(domain.FleetKind, name))
+	}
+	return changes, nil
+}
+
+func (r *ResourceSync) previewCatalogResources(ctx context.Context, orgId uuid.UUID, owner string, resources []GenericResourceMap, addError func(error)) ([]domain.ResourceSyncChange, error) {
+	if unexpected := unexpectedKinds(resources, domain.CatalogKind, domain.CatalogItemKind); len(unexpected) > 0 {
+		addError(fmt.Errorf("sync type is catalog but found unexpected kind(s): %v", unexpected))
+		return nil, nil
+	}
+	catalogs, err := r.parseCatalogs(filterByKind(resources, domain.CatalogKind))
+	if err != nil {
+		addError(err)
+		return nil, nil
+	}
+	items, err := r.parseCatalogItems(filterByKind(resources, domain.CatalogItemKind))
+	if err != nil {
+		addError(err)
+		return nil, nil
+	}
+	if err := r.validateCatalogNameConflicts(ctx, orgId, catalogs, owner); err != nil {
+		addError(err)
+	}
+	if err := r.validateCatalogItemConflicts(ctx, orgId, items, owner); err != nil {
+		addError(err)
+	}
+
+	changes := []domain.ResourceSyncChange{}
+	for _, catalog := range catalogs {
+		live, status := r.serviceHandler.GetCatalog(ctx, orgId, *catalog.Metadata.Name)
+		if status.Code != http.StatusOK && status.Code != http.StatusNotFound {
+			return nil, fmt.Errorf("failed to get catalog %s: %s", *catalog.Metadata.Name, status.Message)
+		}
+		var liveObj any
+		if status.Code == http.StatusOK {
+			liveObj = live
+		}
+		if err := r.appendChange(&changes, domain.CatalogKind, *catalog.Metadata.Name, liveObj, catalog); err != nil {
+			return nil, err
+		}
+	}
+	for _, item := range items {
+		key := fmt.Sprintf("%s/%s", item.Metadata.Catalog, *item.Metadata.Name)
+		live, status := r.serviceHandler.GetCatalogItem(ctx, orgId, item.Metadata.Catalog, *item.Metadata.Name)
+		if status.Code != http.StatusOK && status.Code != http.StatusNotFound {
+			return nil, fmt.Errorf("failed to get catalog item %s: %s", key, status.Message)
+		}
+		var liveObj any
+		if status.Code == http.StatusOK {
+			liveObj = live
+		}
+		if err := r.appendChange(&changes, domain.CatalogItemKind, key, liveObj, item); err != nil {
+			return nil, err
+		}
+	}
+
+	// catalog items are deleted before their catalogs
+	ownedItems, err := r.listOwnedCatalogItems(ctx, orgId, owner)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list owned catalog items: %w", err)
+	}
+	for _, key := range catalogItemsDelta(ownedItems, items) {
+		changes = append(changes, deleteChange(domain.CatalogItemKind, key))
+	}
+	ownedCatalogs, err := r.listOwnedCatalogs(ctx, orgId, owner)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list owned catalogs: %w", err)
+	}
+	for _, name := range catalogsDelta(ownedCatalogs, catalogs) {
+		changes = append(changes, deleteChange(domain.CatalogKind, name))
+	}
+	return changes, nil
+}
+
+// appendChange appends the change that syncing the desired resource would make to the live resource, if any. A nil
+// live resource does not exist yet and would be created.
+func (r *ResourceSync) appendChange(changes *[]domain.ResourceSyncChange, kind, name string, live, desired any) error {
+	if live == nil {
+		*changes = append(*changes, domain.ResourceSyncChange{Kind: kind, Name: name, Action: domain.ResourceSyncChangeActionCreate})
+		return nil
+	}
+	liveFields, err := r.syncedFields(live)
+	if err != nil {
+		return fmt.Errorf("%s %s: %w", kind, name, err)
+	}
+	desiredFields, err := r.syncedFields(desired)
+	if err != nil {
+		return fmt.Errorf("%s %s: %w", kind, name, err)
+	}
+	diff := diffFields("", liveFields, desiredFields)
+	if len(diff) == 0 {
+		return nil
+	}
+	*changes = append(*changes, domain.ResourceSyncChange{Kind: kind, Name: name, Action: domain.ResourceSyncChangeActionUpdate, Diff: &diff})
+	return nil
+}
+
+func deleteChange(kind, name string) domain.ResourceSyncChange {
+	return domain.ResourceSyncChange{Kind: kind, Name: name, Action: domain.ResourceSyncChangeActionDelete}
+}
+
+// syncedFields returns the fields of a resource that syncing sets, that is its labels and its spec without the
+// fields that are configured to be ignored.
+func (r *ResourceSync) syncedFields(resource any) (map[string]any, error) {
+	buf, err := json.Marshal(resource)
+	if err != nil {
+		return nil, err
+	}
+	var fields GenericResourceMap
+	if err := json.Unmarshal(buf, &fields); err != nil {
+		return nil, err
+	}
+	fields = RemoveIgnoredFields(fields, r.ignoreResourceUpdates)
+
+	synced := map[string]any{}
+	if spec, ok := fields["spec"]; ok {
+		synced["spec"] = spec
+	}
+	if metadata, ok := fields["metadata"].(map[string]any); ok {
+		if labels, ok := metadata["labels"]; ok {
+			synced["metadata"] = map[string]any{"labels": labels}
+		}
+	}
+	return synced, nil
+}
+
+// diffFields returns the differences between two JSON values as a list of changed fields. Objects are compared
+// field by field and lists of the same length item by item, anything else is compared as a whole.
+func diffFields(path string, oldValue, newValue any) []domain.ResourceSyncFieldDiff {
+	diff := []domain.ResourceSyncFieldDiff{}
+	oldMap, oldIsMap := oldValue.(map[string]any)
+	newMap, newIsMap := newValue.(map[string]any)
+	oldList, oldIsList := oldValue.([]any)
+	newList, newIsList := newValue.([]any)
+
+	switch {
+	case oldIsMap && newIsMap:
+		keys := lo.Uniq(append(lo.Keys(oldMap), lo.Keys(newMap)...))
+		sort.Strings(keys)
+		for _, key := range keys {
+			fieldPath := key
+			if path != "" {
+				fieldPath = path + "." + key
+			}
+			diff = append(diff, diffFields(fieldPath, oldMap[key], newMap[key])...)
+		}
+	case oldIsList && newIsList && len(oldList) == len(newList):
+		for i := range oldList {
+			diff = append(diff, diffFields(fmt.Sprintf("%s[%d]", path, i), oldList[i], newList[i])...)
+		}
+	case !reflect.DeepEqual(oldValue, newValue):
+		diff = append(diff, domain.ResourceSyncFieldDiff{
+			Path: path,
+			Old:  encodeFieldValue(oldValue),
+			New:  encodeFieldValue(newValue),
+		})
+	}
+	return diff
+}
+
+func encodeFieldValue(value any) *string {
+	if value == nil {
+		return nil
+	}
+	buf, err := json.Marshal(value)
+	if err != nil {
+		return lo.ToPtr(fmt.Sprintf("%v", value))
+	}
+	return lo.ToPtr(string(buf))
+}
+
+// dryRun records the changes that syncing would make in the status of the ResourceSync instead of applying them.
+func (r *ResourceSync) dryRun(ctx context.Context, log logrus.FieldLogger, orgId uuid.UUID, rs *domain.ResourceSync) error {
+	preview, err := r.PreviewResourceSync(ctx, orgId, rs)
+	if err != nil {
+		domain.SetStatusConditionByError(&rs.Status.Conditions, domain.ConditionTypeResourceSyncSynced, "success", "fail", err)
+		return err
+	}
+	rs.Status.Preview = preview
+	if preview.Commit != nil {
+		rs.Status.ObservedCommit = preview.Commit
+	}
+
+	message := fmt.Sprintf("dry run: %d change(s) not applied", len(preview.Changes))
+	if len(preview.Errors) > 0 {
+		message = fmt.Sprintf("%s, %d error(s): %s", message, len(preview.Errors), strings.Join(preview.Errors, "; "))
+	}
+	domain.SetStatusConditionByError(&rs.Status.Conditions, domain.ConditionTypeResourceSyncSynced, "success", domain.ResourceSyncDryRunReason, errors.New(message))
+	log.Infof("resourcesync/%s: %s", lo.FromPtr(rs.Metadata.Name), message)
+	return nil
+}
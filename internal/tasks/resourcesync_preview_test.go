@@ -0,0 +1,245 @@
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/config"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/kvstore"
+	"github.com/flightctl/flightctl/internal/service"
+	"github.com/flightctl/flightctl/internal/service/common"
+	"github.com/flightctl/flightctl/internal/util"
+	billy "github.com/go-git/go-billy/v5"
+	"github.com/go-git/go-billy/v5/memfs"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDiffFields(t *testing.T) {
+	tests := []struct {
+		name     string
+		old      any
+		new      any
+		expected []domain.ResourceSyncFieldDiff
+	}{
+		{
+			name:     "equal",
+			old:      map[string]any{"spec": map[string]any{"a": "x", "b": []any{"1", "2"}}},
+			new:      map[string]any{"spec": map[string]any{"a": "x", "b": []any{"1", "2"}}},
+			expected: []domain.ResourceSyncFieldDiff{},
+		},
+		{
+			name: "changed nested field",
+			old:  map[string]any{"spec": map[string]any{"os": map[string]any{"image": "quay.io/os:v1"}}},
+			new:  map[string]any{"spec": map[string]any{"os": map[string]any{"image": "quay.io/os:v2"}}},
+			expected: []domain.ResourceSyncFieldDiff{
+				{Path: "spec.os.image", Old: lo.ToPtr(`"quay.io/os:v1"`), New: lo.ToPtr(`"quay.io/os:v2"`)},
+			},
+		},
+		{
+			name: "added and removed fields",
+			old:  map[string]any{"metadata": map[string]any{"labels": map[string]any{"env": "prod"}}},
+			new:  map[string]any{"metadata": map[string]any{"labels": map[string]any{"site": "a"}}},
+			expected: []domain.ResourceSyncFieldDiff{
+				{Path: "metadata.labels.env", Old: lo.ToPtr(`"prod"`)},
+				{Path: "metadata.labels.site", New: lo.ToPtr(`"a"`)},
+			},
+		},
+		{
+			name: "list item changed",
+			old:  map[string]any{"items": []any{map[string]any{"v": float64(1)}, "b"}},
+			new:  map[string]any{"items": []any{map[string]any{"v": float64(2)}, "b"}},
+			expected: []domain.ResourceSyncFieldDiff{
+				{Path: "items[0].v", Old: lo.ToPtr("1"), New: lo.ToPtr("2")},
+			},
+		},
+		{
+			name: "list length changed",
+			old:  map[string]any{"items": []any{"a"}},
+			new:  map[string]any{"items": []any{"a", "b"}},
+			expected: []domain.ResourceSyncFieldDiff{
+				{Path: "items", Old: lo.ToPtr(`["a"]`), New: lo.ToPtr(`["a","b"]`)},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, diffFields("", tt.old, tt.new))
+		})
+	}
+}
+
+func TestPreviewResourceSync_Catalog(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+
+	// the mock fails the test on any call that would modify resources
+	mockSvc := service.NewMockService(ctrl)
+	rs := NewResourceSync(mockSvc, logrus.New(), nil, nil)
+	orgId := uuid.New()
+	rsObj := newTestRS("test-rs")
+	rsObj.Spec.Type = lo.ToPtr(domain.ResourceSyncTypeCatalog)
+	owner := util.SetResourceOwner(domain.ResourceSyncKind, "test-rs")
+
+	mfs := memfs.New()
+	require.NoError(copyEmbedToMemfs(catalogUpdatedFS, "testdata/catalog_updated", mfs, "/catalog"))
+	cloneRepo := func(_ *domain.Repository, revision *string, _ *int, _ *config.Config) (billy.Filesystem, string, error) {
+		require.Equal("main", *revision)
+		return mfs, "abc123", nil
+	}
+
+	// the live catalog matches the repository and the live prometheus item is an older version
+	resources := loadFixtures(t, catalogUpdatedFS, "testdata/catalog_updated")
+	catalogs, err := rs.parseCatalogs(filterByKind(resources, domain.CatalogKind))
+	require.NoError(err)
+	liveCatalog := *catalogs[0]
+	liveCatalog.Metadata.Owner = owner
+	items, err := rs.parseCatalogItems(filterByKind(resources, domain.CatalogItemKind))
+	require.NoError(err)
+	livePrometheus := *items[0]
+	require.Equal("prometheus", *livePrometheus.Metadata.Name)
+	livePrometheus.Metadata.Owner = owner
+	livePrometheus.Spec.Versions = []domain.CatalogItemVersion{livePrometheus.Spec.Versions[0]}
+	livePrometheus.Spec.Versions[0].Version = "1.8.0"
+
+	mockSvc.EXPECT().GetRepository(gomock.Any(), orgId, "test-repo").
+		Return(&domain.Repository{Metadata: domain.ObjectMeta{Name: lo.ToPtr("test-repo")}}, okStatus())
+	mockSvc.EXPECT().GetCatalog(gomock.Any(), orgId, "platform-apps").
+		Return(&liveCatalog, okStatus()).AnyTimes()
+	mockSvc.EXPECT().GetCatalogItem(gomock.Any(), orgId, "platform-apps", "prometheus").
+		Return(&livePrometheus, okStatus()).AnyTimes()
+	mockSvc.EXPECT().GetCatalogItem(gomock.Any(), orgId, "platform-apps", "redis").
+		Return(nil, notFoundStatus()).AnyTimes()
+	mockSvc.EXPECT().ListAllCatalogItems(gomock.Any(), orgId, gomock.Any()).
+		Return(&domain.CatalogItemList{
+			Items: []domain.CatalogItem{
+				livePrometheus,
+				{Metadata: domain.CatalogItemMeta{Name: lo.ToPtr("nginx"), Catalog: "platform-apps", Owner: owner}},
+			},
+		}, okStatus())
+	mockSvc.EXPECT().ListCatalogs(gomock.Any(), orgId, gomock.Any()).
+		Return(&domain.CatalogList{Items: []domain.Catalog{liveCatalog}}, okStatus())
+
+	preview, err := rs.previewResourceSync(context.Background(), orgId, rsObj, cloneRepo)
+	require.NoError(err)
+	require.Empty(preview.Errors)
+	require.Equal("main", preview.Revision)
+	require.Equal("abc123", lo.FromPtr(preview.Commit))
+	require.Equal([]domain.ResourceSyncChange{
+		{
+			Kind:   domain.CatalogItemKind,
+			Name:   "platform-apps/prometheus",
+			Action: domain.ResourceSyncChangeActionUpdate,
+			Diff: &[]domain.ResourceSyncFieldDiff{
+				{Path: "spec.versions[0].version", Old: lo.ToPtr(`"1.8.0"`), New: lo.ToPtr(`"1.9.0"`)},
+			},
+		},
+		{Kind: domain.CatalogItemKind, Name: "platform-apps/redis", Action: domain.ResourceSyncChangeActionCreate},
+		{Kind: domain.CatalogItemKind, Name: "platform-apps/nginx", Action: domain.ResourceSyncChangeActionDelete},
+	}, preview.Changes)
+}
+
+func TestPreviewResourceSync_RepositoryNotFound(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+
+	mockSvc := service.NewMockService(ctrl)
+	rs := NewResourceSync(mockSvc, logrus.New(), nil, nil)
+	orgId := uuid.New()
+	cloneRepo := func(*domain.Repository, *string, *int, *config.Config) (billy.Filesystem, string, error) {
+		t.Fatal("repository must not be cloned")
+		return nil, "", nil
+	}
+
+	mockSvc.EXPECT().GetRepository(gomock.Any(), orgId, "test-repo").Return(nil, notFoundStatus())
+
+	preview, err := rs.previewResourceSync(context.Background(), orgId, newTestRS("test-rs"), cloneRepo)
+	require.NoError(err)
+	require.Empty(preview.Changes)
+	require.Equal([]string{"repository test-repo: not found"}, preview.Errors)
+	require.Nil(preview.Commit)
+}
+
+// previewKVStore keeps the values that the preview task stores
+type previewKVStore struct {
+	kvstore.KVStore
+	values  map[string][]byte
+	expires map[string]time.Duration
+}
+
+func (s *previewKVStore) SetNX(_ context.Context, key string, value []byte) (bool, error) {
+	if _, ok := s.values[key]; ok {
+		return false, nil
+	}
+	s.values[key] = value
+	return true, nil
+}
+
+func (s *previewKVStore) SetExpire(_ context.Context, key string, expiration time.Duration) error {
+	s.expires[key] = expiration
+	return nil
+}
+
+func TestStoreRequestedPreview(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+
+	mockSvc := service.NewMockService(ctrl)
+	rs := NewResourceSync(mockSvc, logrus.New(), nil, nil)
+	orgId := uuid.New()
+	kvStore := &previewKVStore{values: map[string][]byte{}, expires: map[string]time.Duration{}}
+	cloneRepo := func(_ *domain.Repository, revision *string, _ *int, _ *config.Config) (billy.Filesystem, string, error) {
+		require.Equal("v2", *revision)
+		return memfs.New(), "def456", nil
+	}
+	event := common.GetResourceSyncPreviewRequestedEvent(context.Background(), "test-rs", "req-1", "v2")
+
+	mockSvc.EXPECT().GetResourceSync(gomock.Any(), orgId, "test-rs").Return(newTestRS("test-rs"), okStatus())
+	mockSvc.EXPECT().GetRepository(gomock.Any(), orgId, "test-repo").
+		Return(&domain.Repository{Metadata: domain.ObjectMeta{Name: lo.ToPtr("test-repo")}}, okStatus())
+	mockSvc.EXPECT().ListFleets(gomock.Any(), orgId, gomock.Any()).Return(&domain.FleetList{}, okStatus()).AnyTimes()
+
+	require.NoError(rs.storeRequestedPreview(context.Background(), orgId, *event, kvStore, cloneRepo))
+
+	previewKey := kvstore.ResourceSyncPreviewKey{OrgID: orgId, ResourceSyncName: "test-rs", RequestID: "req-1"}
+	key := previewKey.ComposeKey()
+	require.Contains(kvStore.values, key)
+	require.Equal(resourceSyncPreviewTTL, kvStore.expires[key])
+	var preview domain.ResourceSyncPreview
+	require.NoError(json.Unmarshal(kvStore.values[key], &preview))
+	require.Equal("v2", preview.Revision)
+	require.Equal("def456", lo.FromPtr(preview.Commit))
+}
+
+func TestStoreRequestedPreview_ResourceSyncNotFound(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+
+	mockSvc := service.NewMockService(ctrl)
+	rs := NewResourceSync(mockSvc, logrus.New(), nil, nil)
+	orgId := uuid.New()
+	kvStore := &previewKVStore{values: map[string][]byte{}, expires: map[string]time.Duration{}}
+	cloneRepo := func(*domain.Repository, *string, *int, *config.Config) (billy.Filesystem, string, error) {
+		t.Fatal("repository must not be cloned")
+		return nil, "", nil
+	}
+	event := common.GetResourceSyncPreviewRequestedEvent(context.Background(), "test-rs", "req-1", "main")
+
+	mockSvc.EXPECT().GetResourceSync(gomock.Any(), orgId, "test-rs").Return(nil, notFoundStatus())
+
+	require.NoError(rs.storeRequestedPreview(context.Background(), orgId, *event, kvStore, cloneRepo))
+
+	previewKey := kvstore.ResourceSyncPreviewKey{OrgID: orgId, ResourceSyncName: "test-rs", RequestID: "req-1"}
+	key := previewKey.ComposeKey()
+	var preview domain.ResourceSyncPreview
+	require.NoError(json.Unmarshal(kvStore.values[key], &preview))
+	require.Empty(preview.Changes)
+	require.Equal([]string{"resourcesync test-rs: not found"}, preview.Errors)
+}
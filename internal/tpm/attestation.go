@@ -0,0 +1,91 @@
+package tpm
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"sort"
+
+	"github.com/google/go-tpm/tpm2"
+)
+
+// VerifyQuote verifies that a TPM2_Quote was signed by the supplied attestation key, that it
+// is bound to the expected nonce, and that the reported PCR values are the ones that were quoted.
+// attestPub is the marshaled TPM2B_PUBLIC of the attestation key, quoted is the marshaled
+// TPM2B_ATTEST and signature is the marshaled TPMT_SIGNATURE.
+func VerifyQuote(attestPub, quoted, signature, nonce []byte, pcrs map[int][]byte) error {
+	pub, err := tpm2.Unmarshal[tpm2.TPM2BPublic](attestPub)
+	if err != nil {
+		return fmt.Errorf("unmarshalling attestation key: %w", err)
+	}
+	key, err := convertTPM2BPublicToPublicKey(pub)
+	if err != nil {
+		return fmt.Errorf("converting attestation key: %w", err)
+	}
+
+	if err := verifyTPM2CertifySignature(quoted, signature, key); err != nil {
+		return fmt.Errorf("verifying quote signature: %w", err)
+	}
+
+	attest, err := tpm2.Unmarshal[tpm2.TPM2BAttest](quoted)
+	if err != nil {
+		return fmt.Errorf("unmarshalling quote: %w", err)
+	}
+	attestContents, err := attest.Contents()
+	if err != nil {
+		return fmt.Errorf("extracting quote contents: %w", err)
+	}
+	if attestContents.Type != tpm2.TPMSTAttestQuote {
+		return fmt.Errorf("attestation is not a quote: 0x%x", attestContents.Type)
+	}
+	if !bytes.Equal(attestContents.ExtraData.Buffer, nonce) {
+		return fmt.Errorf("quote nonce does not match the challenge")
+	}
+
+	info, err := attestContents.Attested.Quote()
+	if err != nil {
+		return fmt.Errorf("extracting quote info: %w", err)
+	}
+
+	selected, err := selectedSHA256PCRs(&info.PCRSelect)
+	if err != nil {
+		return err
+	}
+	if len(selected) != len(pcrs) {
+		return fmt.Errorf("quote covers %d PCRs but %d values were reported", len(selected), len(pcrs))
+	}
+
+	// the quote digest is the hash of the selected PCR values concatenated in ascending order
+	h := sha256.New()
+	for _, index := range selected {
+		value, ok := pcrs[index]
+		if !ok {
+			return fmt.Errorf("missing value for quoted PCR %d", index)
+		}
+		h.Write(value)
+	}
+	if !bytes.Equal(h.Sum(nil), info.PCRDigest.Buffer) {
+		return fmt.Errorf("reported PCR values do not match the quoted digest")
+	}
+
+	return nil
+}
+
+// selectedSHA256PCRs returns the sorted PCR indexes of a SHA-256 only PCR selection.
+func selectedSHA256PCRs(selection *tpm2.TPMLPCRSelection) ([]int, error) {
+	var pcrs []int
+	for _, sel := range selection.PCRSelections {
+		if sel.Hash != tpm2.TPMAlgSHA256 {
+			return nil, fmt.Errorf("unsupported PCR bank in quote: 0x%x", sel.Hash)
+		}
+		for byteIdx, b := range sel.PCRSelect {
+			for bitIdx := 0; bitIdx < 8; bitIdx++ {
+				if b&(1<<bitIdx) != 0 {
+					pcrs = append(pcrs, byteIdx*8+bitIdx)
+				}
+			}
+		}
+	}
+	sort.Ints(pcrs)
+	return pcrs, nil
+}
@@ -0,0 +1,121 @@
+package tpm
+
+import (
+	"crypto/sha256"
+	"io"
+	"testing"
+
+	agent_config "github.com/flightctl/flightctl/internal/agent/config"
+	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/google/go-tpm-tools/simulator"
+	"github.com/google/go-tpm/tpm2"
+	"github.com/google/go-tpm/tpm2/transport"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyQuote(t *testing.T) {
+	nonce := []byte("0123456789abcdef0123456789abcdef")
+	pcrs := []int{0, 7, 16}
+
+	tests := []struct {
+		name    string
+		mutate  func(q *PCRQuote, nonce []byte) []byte
+		wantErr string
+	}{
+		{
+			name: "valid quote",
+		},
+		{
+			name: "wrong nonce",
+			mutate: func(q *PCRQuote, nonce []byte) []byte {
+				return []byte("another-nonce-another-nonce-0000")
+			},
+			wantErr: "quote nonce does not match the challenge",
+		},
+		{
+			name: "tampered PCR value",
+			mutate: func(q *PCRQuote, nonce []byte) []byte {
+				tampered := sha256.Sum256([]byte("tampered"))
+				q.PCRs[7] = tampered[:]
+				return nonce
+			},
+			wantErr: "reported PCR values do not match the quoted digest",
+		},
+		{
+			name: "missing PCR value",
+			mutate: func(q *PCRQuote, nonce []byte) []byte {
+				delete(q.PCRs, 16)
+				return nonce
+			},
+			wantErr: "quote covers 3 PCRs but 2 values were reported",
+		},
+		{
+			name: "tampered signature",
+			mutate: func(q *PCRQuote, nonce []byte) []byte {
+				q.Quoted[len(q.Quoted)-1] ^= 0xff
+				return nonce
+			},
+			wantErr: "verifying quote signature",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+
+			sim, err := simulator.Get()
+			require.NoError(err)
+			defer sim.Close()
+
+			require.NoError(setupFakeRSAEKCertificate(sim))
+
+			tmpDir := t.TempDir()
+			rw := fileio.NewReadWriter(
+				fileio.NewReader(fileio.WithReaderRootDir(tmpDir)),
+				fileio.NewWriter(fileio.WithWriterRootDir(tmpDir)),
+			)
+			connFactory := func() (io.ReadWriteCloser, error) {
+				return simConn{sim: sim}, nil
+			}
+			c, err := newClientWithConnection(connFactory, log.NewPrefixLogger("test"), rw, &agent_config.Config{
+				TPM: agent_config.TPM{
+					Enabled:         true,
+					DevicePath:      agent_config.DefaultTPMDevicePath,
+					StorageFilePath: agent_config.DefaultTPMKeyFile,
+				},
+			}, "test-model", "test-serial")
+			require.NoError(err)
+			defer func() { require.NoError(safeCloseSession(c.session)) }()
+
+			// measure something into PCR 16 so that not every quoted value is zero
+			_, err = tpm2.PCRExtend{
+				PCRHandle: tpm2.AuthHandle{Handle: tpm2.TPMHandle(16), Auth: tpm2.PasswordAuth(nil)},
+				Digests: tpm2.TPMLDigestValues{Digests: []tpm2.TPMTHA{{
+					HashAlg: tpm2.TPMAlgSHA256,
+					Digest:  make([]byte, sha256.Size),
+				}}},
+			}.Execute(transport.FromReadWriter(sim))
+			require.NoError(err)
+
+			quote, err := c.Quote(nonce, pcrs)
+			require.NoError(err)
+			require.Len(quote.PCRs, len(pcrs))
+
+			lakPub, err := c.session.GetPublicKey(LAK)
+			require.NoError(err)
+
+			expectedNonce := nonce
+			if tt.mutate != nil {
+				expectedNonce = tt.mutate(quote, nonce)
+			}
+
+			err = VerifyQuote(tpm2.Marshal(*lakPub), quote.Quoted, quote.Signature, expectedNonce, quote.PCRs)
+			if tt.wantErr != "" {
+				require.ErrorContains(err, tt.wantErr)
+				return
+			}
+			require.NoError(err)
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"crypto"
+	"crypto/ecdh"
 	"crypto/ecdsa"
 	"crypto/rand"
 	"crypto/rsa"
@@ -297,6 +298,38 @@ func (c *client) CreateApplicationKey(name string) ([]byte, []byte, error) {
 	return tcgCSR, exported, nil
 }
 
+// EncryptionPublicKey returns the public key of the TPM-bound encryption key, creating the key if needed.
+func (c *client) EncryptionPublicKey() (crypto.PublicKey, error) {
+	pub, err := c.session.GetPublicKey(LDevEK)
+	if err != nil {
+		return nil, fmt.Errorf("getting encryption public key: %w", err)
+	}
+	return convertTPM2BPublicToPublicKey(pub)
+}
+
+// ECDH computes the shared secret between the TPM-bound encryption key and the given P-256 public key.
+func (c *client) ECDH(peer *ecdh.PublicKey) ([]byte, error) {
+	// the uncompressed point encoding is 0x04 || X || Y
+	point := peer.Bytes()
+	if peer.Curve() != ecdh.P256() || len(point) != 65 {
+		return nil, fmt.Errorf("unsupported ECDH public key")
+	}
+	shared, err := c.session.ECDHZGen(LDevEK, tpm2.TPMSECCPoint{
+		X: tpm2.TPM2BECCParameter{Buffer: point[1:33]},
+		Y: tpm2.TPM2BECCParameter{Buffer: point[33:]},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(shared.X.Buffer) > 32 {
+		return nil, fmt.Errorf("unexpected ECDH shared point size: %d", len(shared.X.Buffer))
+	}
+	// the shared secret is the X coordinate, left-padded to the size of the field
+	z := make([]byte, 32)
+	copy(z[32-len(shared.X.Buffer):], shared.X.Buffer)
+	return z, nil
+}
+
 // GetSigner returns the crypto.Signer interface for this client
 func (c *client) GetSigner() crypto.Signer {
 	return c
@@ -359,6 +392,24 @@ func (c *client) SolveChallenge(credentialBlob, encryptedSecret []byte) ([]byte,
 	return c.session.SolveChallenge(credentialBlob, encryptedSecret)
 }
 
+// Quote reads the specified PCRs and produces a LAK-signed quote over them
+// using the nonce as qualifying data.
+func (c *client) Quote(nonce []byte, pcrs []int) (*PCRQuote, error) {
+	quoted, signature, err := c.session.Quote(pcrs, nonce)
+	if err != nil {
+		return nil, fmt.Errorf("quoting PCRs: %w", err)
+	}
+	values, err := c.session.ReadPCRs(pcrs)
+	if err != nil {
+		return nil, err
+	}
+	return &PCRQuote{
+		Quoted:    quoted,
+		Signature: signature,
+		PCRs:      values,
+	}, nil
+}
+
 // convertTPM2BPublicToECDSA converts a TPM2BPublic to a public key.
 func convertTPM2BPublicToPublicKey(pub *tpm2.TPM2BPublic) (crypto.PublicKey, error) {
 	outpub, err := pub.Contents()
@@ -20,6 +20,7 @@ import (
 
 	agent_config "github.com/flightctl/flightctl/internal/agent/config"
 	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	fccrypto "github.com/flightctl/flightctl/pkg/crypto"
 	"github.com/flightctl/flightctl/pkg/log"
 	"github.com/google/go-tpm-tools/simulator"
 	legacy "github.com/google/go-tpm/legacy/tpm2"
@@ -1579,6 +1580,43 @@ func TestClient_CreateApplicationKeys(t *testing.T) {
 	}
 }
 
+func TestClient_ECDH(t *testing.T) {
+	require := require.New(t)
+
+	sim, err := simulator.Get()
+	require.NoError(err)
+	defer sim.Close()
+	require.NoError(setupFakeRSAEKCertificate(sim))
+
+	tmpDir := t.TempDir()
+	rw := fileio.NewReadWriter(
+		fileio.NewReader(fileio.WithReaderRootDir(tmpDir)),
+		fileio.NewWriter(fileio.WithWriterRootDir(tmpDir)),
+	)
+	c, err := newClientWithConnection(func() (io.ReadWriteCloser, error) { return sim, nil }, log.NewPrefixLogger("test"), rw, &agent_config.Config{
+		TPM: agent_config.TPM{
+			Enabled:         true,
+			DevicePath:      agent_config.DefaultTPMDevicePath,
+			StorageFilePath: agent_config.DefaultTPMKeyFile,
+		},
+	}, "test-model", "test-serial")
+	require.NoError(err)
+	defer func() { require.NoError(safeCloseSession(c.session)) }()
+	// the simulator has no resource manager, so make room for the encryption key
+	require.NoError(c.session.(*tpmSession).flushKey(LAK))
+
+	pub, err := c.EncryptionPublicKey()
+	require.NoError(err)
+	require.False(pub.(*ecdsa.PublicKey).Equal(c.Public()), "encryption key must differ from the LDevID")
+
+	plaintext := []byte("sensitive")
+	token, err := fccrypto.EncryptJWE(pub, plaintext)
+	require.NoError(err)
+	decrypted, err := fccrypto.DecryptJWE(token, c.ECDH)
+	require.NoError(err)
+	require.Equal(plaintext, decrypted)
+}
+
 // closes the session in a way that doesn't close the underlying connection so that it can be reused
 // for a testing purposes
 func safeCloseSession(session Session) error {
@@ -0,0 +1,172 @@
+package tpm
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+const (
+	// DefaultEventLogPath is where the kernel exposes the firmware measurement log
+	DefaultEventLogPath = "/sys/kernel/security/tpm0/binary_bios_measurements"
+
+	// TCG PC Client Platform Firmware Profile, Section 10.4.1 - EV_NO_ACTION events are not extended
+	eventTypeNoAction uint32 = 0x00000003
+
+	algSHA1   uint16 = 0x0004
+	algSHA256 uint16 = 0x000B
+
+	sha1DigestSize = 20
+	maxEventSize   = 1 << 20
+)
+
+var (
+	specIDEventSignature     = []byte("Spec ID Event03\x00")
+	startupLocalitySignature = []byte("StartupLocality\x00")
+)
+
+// ReplayEventLog parses a crypto-agile TCG event log and replays the SHA-256 bank,
+// returning the expected value of every PCR that has at least one measurement.
+func ReplayEventLog(eventLog []byte) (map[int][]byte, error) {
+	r := bytes.NewReader(eventLog)
+
+	// the first event uses the legacy TCG_PCR_EVENT format and carries the Spec ID event
+	digestSizes, err := readSpecIDEvent(r)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := digestSizes[algSHA256]; !ok {
+		return nil, fmt.Errorf("event log does not contain SHA-256 digests")
+	}
+
+	pcrs := make(map[int][]byte)
+	for r.Len() > 0 {
+		var header struct {
+			PCRIndex    uint32
+			EventType   uint32
+			DigestCount uint32
+		}
+		if err := binary.Read(r, binary.LittleEndian, &header); err != nil {
+			return nil, fmt.Errorf("reading event header: %w", err)
+		}
+		if header.PCRIndex > 23 {
+			return nil, fmt.Errorf("invalid PCR index in event log: %d", header.PCRIndex)
+		}
+
+		var sha256Digest []byte
+		for i := uint32(0); i < header.DigestCount; i++ {
+			var alg uint16
+			if err := binary.Read(r, binary.LittleEndian, &alg); err != nil {
+				return nil, fmt.Errorf("reading digest algorithm: %w", err)
+			}
+			size, ok := digestSizes[alg]
+			if !ok {
+				return nil, fmt.Errorf("unknown digest algorithm in event log: 0x%x", alg)
+			}
+			digest := make([]byte, size)
+			if _, err := io.ReadFull(r, digest); err != nil {
+				return nil, fmt.Errorf("reading digest: %w", err)
+			}
+			if alg == algSHA256 {
+				sha256Digest = digest
+			}
+		}
+
+		data, err := readEventData(r)
+		if err != nil {
+			return nil, err
+		}
+
+		index := int(header.PCRIndex)
+		if header.EventType == eventTypeNoAction {
+			// the startup locality event sets the initial value of PCR 0
+			if index == 0 && len(data) == len(startupLocalitySignature)+1 && bytes.HasPrefix(data, startupLocalitySignature) {
+				initial := make([]byte, sha256.Size)
+				initial[sha256.Size-1] = data[len(data)-1]
+				pcrs[0] = initial
+			}
+			continue
+		}
+		if sha256Digest == nil {
+			return nil, fmt.Errorf("event for PCR %d is missing a SHA-256 digest", index)
+		}
+
+		current, ok := pcrs[index]
+		if !ok {
+			current = make([]byte, sha256.Size)
+		}
+		extended := sha256.Sum256(append(current, sha256Digest...))
+		pcrs[index] = extended[:]
+	}
+
+	return pcrs, nil
+}
+
+// readSpecIDEvent reads the leading TCG_PCR_EVENT and returns the digest sizes it declares.
+func readSpecIDEvent(r *bytes.Reader) (map[uint16]uint16, error) {
+	var header struct {
+		PCRIndex  uint32
+		EventType uint32
+		Digest    [sha1DigestSize]byte
+	}
+	if err := binary.Read(r, binary.LittleEndian, &header); err != nil {
+		return nil, fmt.Errorf("reading event log header: %w", err)
+	}
+	data, err := readEventData(r)
+	if err != nil {
+		return nil, err
+	}
+	if header.EventType != eventTypeNoAction || !bytes.HasPrefix(data, specIDEventSignature) {
+		return nil, fmt.Errorf("event log is not in the crypto-agile format")
+	}
+
+	spec := bytes.NewReader(data[len(specIDEventSignature):])
+	var specHeader struct {
+		PlatformClass      uint32
+		SpecVersionMinor   uint8
+		SpecVersionMajor   uint8
+		SpecErrata         uint8
+		UintnSize          uint8
+		NumberOfAlgorithms uint32
+	}
+	if err := binary.Read(spec, binary.LittleEndian, &specHeader); err != nil {
+		return nil, fmt.Errorf("reading spec ID event: %w", err)
+	}
+
+	digestSizes := make(map[uint16]uint16, specHeader.NumberOfAlgorithms)
+	for i := uint32(0); i < specHeader.NumberOfAlgorithms; i++ {
+		var alg struct {
+			AlgorithmID uint16
+			DigestSize  uint16
+		}
+		if err := binary.Read(spec, binary.LittleEndian, &alg); err != nil {
+			return nil, fmt.Errorf("reading spec ID event algorithms: %w", err)
+		}
+		digestSizes[alg.AlgorithmID] = alg.DigestSize
+	}
+	if size, ok := digestSizes[algSHA256]; ok && size != sha256.Size {
+		return nil, fmt.Errorf("unexpected SHA-256 digest size in event log: %d", size)
+	}
+	if size, ok := digestSizes[algSHA1]; ok && size != sha1DigestSize {
+		return nil, fmt.Errorf("unexpected SHA-1 digest size in event log: %d", size)
+	}
+
+	return digestSizes, nil
+}
+
+func readEventData(r *bytes.Reader) ([]byte, error) {
+	var size uint32
+	if err := binary.Read(r, binary.LittleEndian, &size); err != nil {
+		return nil, fmt.Errorf("reading event size: %w", err)
+	}
+	if size > maxEventSize || int(size) > r.Len() {
+		return nil, fmt.Errorf("invalid event size: %d", size)
+	}
+	data := make([]byte, size)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, fmt.Errorf("reading event data: %w", err)
+	}
+	return data, nil
+}
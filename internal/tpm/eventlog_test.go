@@ -0,0 +1,146 @@
+package tpm
+
+import (
+	"bytes"
+	"crypto/sha1" //nolint:gosec
+	"crypto/sha256"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testEvent struct {
+	pcr       uint32
+	eventType uint32
+	data      []byte
+}
+
+func buildEventLog(t *testing.T, withSHA256 bool, events []testEvent) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	write := func(v any) {
+		require.NoError(t, binary.Write(&buf, binary.LittleEndian, v))
+	}
+
+	// Spec ID event in the legacy TCG_PCR_EVENT format
+	var spec bytes.Buffer
+	spec.Write(specIDEventSignature)
+	algs := [][2]uint16{{algSHA1, sha1DigestSize}}
+	if withSHA256 {
+		algs = append(algs, [2]uint16{algSHA256, sha256.Size})
+	}
+	require.NoError(t, binary.Write(&spec, binary.LittleEndian, struct {
+		PlatformClass uint32
+		Minor, Major  uint8
+		Errata, Uintn uint8
+		Count         uint32
+	}{0, 0, 2, 0, 2, uint32(len(algs))}))
+	for _, alg := range algs {
+		require.NoError(t, binary.Write(&spec, binary.LittleEndian, alg))
+	}
+	spec.WriteByte(0) // vendor info size
+
+	write(uint32(0))
+	write(eventTypeNoAction)
+	write([sha1DigestSize]byte{})
+	write(uint32(spec.Len()))
+	buf.Write(spec.Bytes())
+
+	for _, e := range events {
+		write(e.pcr)
+		write(e.eventType)
+		write(uint32(len(algs)))
+		sha1Digest := sha1.Sum(e.data) //nolint:gosec
+		write(algSHA1)
+		buf.Write(sha1Digest[:])
+		if withSHA256 {
+			sha256Digest := sha256.Sum256(e.data)
+			write(algSHA256)
+			buf.Write(sha256Digest[:])
+		}
+		write(uint32(len(e.data)))
+		buf.Write(e.data)
+	}
+	return buf.Bytes()
+}
+
+func extend(current []byte, data []byte) []byte {
+	digest := sha256.Sum256(data)
+	extended := sha256.Sum256(append(append([]byte{}, current...), digest[:]...))
+	return extended[:]
+}
+
+func TestReplayEventLog(t *testing.T) {
+	zero := make([]byte, sha256.Size)
+	locality3 := make([]byte, sha256.Size)
+	locality3[sha256.Size-1] = 3
+
+	tests := []struct {
+		name       string
+		withSHA256 bool
+		events     []testEvent
+		corrupt    func([]byte) []byte
+		want       map[int][]byte
+		wantErr    string
+	}{
+		{
+			name:       "replays measurements per PCR",
+			withSHA256: true,
+			events: []testEvent{
+				{pcr: 0, eventType: 0x8, data: []byte("firmware")},
+				{pcr: 7, eventType: 0x800000e0, data: []byte("secure boot")},
+				{pcr: 0, eventType: 0x4, data: []byte("separator")},
+			},
+			want: map[int][]byte{
+				0: extend(extend(zero, []byte("firmware")), []byte("separator")),
+				7: extend(zero, []byte("secure boot")),
+			},
+		},
+		{
+			name:       "startup locality sets the initial value of PCR 0",
+			withSHA256: true,
+			events: []testEvent{
+				{pcr: 0, eventType: eventTypeNoAction, data: append(append([]byte{}, startupLocalitySignature...), 3)},
+				{pcr: 0, eventType: 0x8, data: []byte("firmware")},
+			},
+			want: map[int][]byte{
+				0: extend(locality3, []byte("firmware")),
+			},
+		},
+		{
+			name:       "event log without SHA-256 bank",
+			withSHA256: false,
+			events:     []testEvent{{pcr: 0, eventType: 0x8, data: []byte("firmware")}},
+			wantErr:    "event log does not contain SHA-256 digests",
+		},
+		{
+			name:       "truncated event log",
+			withSHA256: true,
+			events:     []testEvent{{pcr: 0, eventType: 0x8, data: []byte("firmware")}},
+			corrupt:    func(b []byte) []byte { return b[:len(b)-4] },
+			wantErr:    "invalid event size",
+		},
+		{
+			name:    "legacy event log",
+			corrupt: func(b []byte) []byte { return make([]byte, 64) },
+			wantErr: "event log is not in the crypto-agile format",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			eventLog := buildEventLog(t, tt.withSHA256, tt.events)
+			if tt.corrupt != nil {
+				eventLog = tt.corrupt(eventLog)
+			}
+			got, err := ReplayEventLog(eventLog)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -12,6 +12,7 @@ package tpm
 import (
 	context "context"
 	crypto "crypto"
+	ecdh "crypto/ecdh"
 	io "io"
 	reflect "reflect"
 
@@ -86,6 +87,36 @@ func (mr *MockClientMockRecorder) CreateApplicationKey(name any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateApplicationKey", reflect.TypeOf((*MockClient)(nil).CreateApplicationKey), name)
 }
 
+// ECDH mocks base method.
+func (m *MockClient) ECDH(peer *ecdh.PublicKey) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ECDH", peer)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ECDH indicates an expected call of ECDH.
+func (mr *MockClientMockRecorder) ECDH(peer any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ECDH", reflect.TypeOf((*MockClient)(nil).ECDH), peer)
+}
+
+// EncryptionPublicKey mocks base method.
+func (m *MockClient) EncryptionPublicKey() (crypto.PublicKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EncryptionPublicKey")
+	ret0, _ := ret[0].(crypto.PublicKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EncryptionPublicKey indicates an expected call of EncryptionPublicKey.
+func (mr *MockClientMockRecorder) EncryptionPublicKey() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EncryptionPublicKey", reflect.TypeOf((*MockClient)(nil).EncryptionPublicKey))
+}
+
 // GetSigner mocks base method.
 func (m *MockClient) GetSigner() crypto.Signer {
 	m.ctrl.T.Helper()
@@ -129,6 +160,21 @@ func (mr *MockClientMockRecorder) Public() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Public", reflect.TypeOf((*MockClient)(nil).Public))
 }
 
+// Quote mocks base method.
+func (m *MockClient) Quote(nonce []byte, pcrs []int) (*PCRQuote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Quote", nonce, pcrs)
+	ret0, _ := ret[0].(*PCRQuote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Quote indicates an expected call of Quote.
+func (mr *MockClientMockRecorder) Quote(nonce, pcrs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Quote", reflect.TypeOf((*MockClient)(nil).Quote), nonce, pcrs)
+}
+
 // SolveChallenge mocks base method.
 func (m *MockClient) SolveChallenge(credentialBlob, encryptedSecret []byte) ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -691,6 +737,21 @@ func (mr *MockSessionMockRecorder) CreateKey(keyType any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateKey", reflect.TypeOf((*MockSession)(nil).CreateKey), keyType)
 }
 
+// ECDHZGen mocks base method.
+func (m *MockSession) ECDHZGen(keyType KeyType, point tpm2.TPMSECCPoint) (*tpm2.TPMSECCPoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ECDHZGen", keyType, point)
+	ret0, _ := ret[0].(*tpm2.TPMSECCPoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ECDHZGen indicates an expected call of ECDHZGen.
+func (mr *MockSessionMockRecorder) ECDHZGen(keyType, point any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ECDHZGen", reflect.TypeOf((*MockSession)(nil).ECDHZGen), keyType, point)
+}
+
 // GenerateChallenge mocks base method.
 func (m *MockSession) GenerateChallenge(secret []byte) ([]byte, []byte, error) {
 	m.ctrl.T.Helper()
@@ -767,6 +828,37 @@ func (mr *MockSessionMockRecorder) LoadKey(keyType any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadKey", reflect.TypeOf((*MockSession)(nil).LoadKey), keyType)
 }
 
+// Quote mocks base method.
+func (m *MockSession) Quote(pcrs []int, qualifyingData []byte) ([]byte, []byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Quote", pcrs, qualifyingData)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].([]byte)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Quote indicates an expected call of Quote.
+func (mr *MockSessionMockRecorder) Quote(pcrs, qualifyingData any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Quote", reflect.TypeOf((*MockSession)(nil).Quote), pcrs, qualifyingData)
+}
+
+// ReadPCRs mocks base method.
+func (m *MockSession) ReadPCRs(pcrs []int) (map[int][]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadPCRs", pcrs)
+	ret0, _ := ret[0].(map[int][]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadPCRs indicates an expected call of ReadPCRs.
+func (mr *MockSessionMockRecorder) ReadPCRs(pcrs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadPCRs", reflect.TypeOf((*MockSession)(nil).ReadPCRs), pcrs)
+}
+
 // RemoveApplicationKey mocks base method.
 func (m *MockSession) RemoveApplicationKey(appName string) error {
 	m.ctrl.T.Helper()
@@ -349,6 +349,62 @@ func (s *tpmSession) Sign(keyType KeyType, digest []byte) ([]byte, error) {
 	return signWithKey(transport.FromReadWriter(s.conn), authHandel, digest)
 }
 
+func (s *tpmSession) Quote(pcrs []int, qualifyingData []byte) ([]byte, []byte, error) {
+	selection, err := createPCRSelectionFromIndexes(pcrs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	lakHandle, err := s.LoadKey(LAK)
+	if err != nil {
+		return nil, nil, fmt.Errorf("loading LAK for quote: %w", err)
+	}
+
+	cmd := tpm2.Quote{
+		SignHandle: tpm2.AuthHandle{
+			Handle: lakHandle.Handle,
+			Name:   lakHandle.Name,
+			Auth:   tpm2.PasswordAuth(nil),
+		},
+		QualifyingData: tpm2.TPM2BData{Buffer: qualifyingData},
+		InScheme: tpm2.TPMTSigScheme{
+			Scheme: tpm2.TPMAlgECDSA,
+			Details: tpm2.NewTPMUSigScheme(
+				tpm2.TPMAlgECDSA, // TODO: do not assume
+				&tpm2.TPMSSchemeHash{HashAlg: tpm2.TPMAlgSHA256},
+			),
+		},
+		PCRSelect: *selection,
+	}
+
+	resp, err := cmd.Execute(transport.FromReadWriter(s.conn))
+	if err != nil {
+		return nil, nil, fmt.Errorf("TPM2_Quote failed: %w", err)
+	}
+
+	return tpm2.Marshal(resp.Quoted), tpm2.Marshal(resp.Signature), nil
+}
+
+func (s *tpmSession) ReadPCRs(pcrs []int) (map[int][]byte, error) {
+	values := make(map[int][]byte, len(pcrs))
+	// PCRs are read one at a time as TPM2_PCR_Read returns at most eight digests per call
+	for _, pcr := range pcrs {
+		selection, err := createPCRSelectionFromIndexes([]int{pcr})
+		if err != nil {
+			return nil, err
+		}
+		resp, err := tpm2.PCRRead{PCRSelectionIn: *selection}.Execute(transport.FromReadWriter(s.conn))
+		if err != nil {
+			return nil, fmt.Errorf("reading PCR %d: %w", pcr, err)
+		}
+		if len(resp.PCRValues.Digests) != 1 {
+			return nil, fmt.Errorf("reading PCR %d: unexpected number of digests: %d", pcr, len(resp.PCRValues.Digests))
+		}
+		values[pcr] = resp.PCRValues.Digests[0].Buffer
+	}
+	return values, nil
+}
+
 func signWithKey(conn transport.TPM, handle tpm2.AuthHandle, digest []byte) ([]byte, error) {
 	cmd := tpm2.Sign{
 		KeyHandle: handle,
@@ -409,6 +465,27 @@ func (s *tpmSession) GetPublicKey(keyType KeyType) (*tpm2.TPM2B[tpm2.TPMTPublic,
 	return &pub.OutPublic, nil
 }
 
+func (s *tpmSession) ECDHZGen(keyType KeyType, point tpm2.TPMSECCPoint) (*tpm2.TPMSECCPoint, error) {
+	handle, err := s.LoadKey(keyType)
+	if err != nil {
+		return nil, fmt.Errorf("loading key: %w", err)
+	}
+
+	resp, err := tpm2.ECDHZGen{
+		KeyHandle: tpm2.AuthHandle{
+			Handle: handle.Handle,
+			Name:   handle.Name,
+			Auth:   tpm2.PasswordAuth(nil),
+		},
+		InPoint: tpm2.New2B(point),
+	}.Execute(transport.FromReadWriter(s.conn))
+	if err != nil {
+		return nil, fmt.Errorf("TPM2_ECDH_ZGen failed: %w", err)
+	}
+
+	return resp.OutPoint.Contents()
+}
+
 func (s *tpmSession) Clear() error {
 	// only the lockout and platform hierarchies can invoke tpm2.Clear
 	// it is possible to block the lockout hierarchy from performing a clear operation
@@ -520,7 +597,7 @@ func (s *tpmSession) flushKeys() []error {
 func (s *tpmSession) clearStoredKeys() error {
 	// Clear stored keys by removing them from storage
 	// This makes them unrecoverable even if TPM Clear failed
-	keyTypes := []KeyType{LDevID, LAK}
+	keyTypes := []KeyType{LDevID, LAK, LDevEK}
 
 	for _, kt := range keyTypes {
 		_ = s.storage.ClearKey(kt)
@@ -928,6 +1005,8 @@ func (s *tpmSession) getKeyTemplate(keyType KeyType) (tpm2.TPMTPublic, error) {
 		return AttestationKeyTemplate(s.keyAlgo)
 	case SRK:
 		return StorageKeyTemplate(s.keyAlgo)
+	case LDevEK:
+		return EncryptionKeyTemplate(), nil
 	default:
 		return tpm2.TPMTPublic{}, fmt.Errorf("unsupported key type: %s", keyType)
 	}
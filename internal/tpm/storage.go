@@ -21,6 +21,7 @@ var (
 type storageData struct {
 	LDevID          *keyData                       `json:"ldevid,omitempty"`
 	LAK             *keyData                       `json:"lak,omitempty"`
+	LDevEK          *keyData                       `json:"ldevek,omitempty"`
 	SealedPassword  *passwordData                  `json:"sealed_password,omitempty"`
 	ApplicationKeys map[string]*applicationKeyData `json:"app_keys,omitempty"`
 }
@@ -111,6 +112,8 @@ func (s *storageData) Handle(keyType KeyType) *keyData {
 		return s.LDevID
 	case LAK:
 		return s.LAK
+	case LDevEK:
+		return s.LDevEK
 	default:
 		return nil
 	}
@@ -122,6 +125,8 @@ func (s *storageData) ClearHandle(keyType KeyType) error {
 		s.LDevID = nil
 	case LAK:
 		s.LAK = nil
+	case LDevEK:
+		s.LDevEK = nil
 	default:
 		return fmt.Errorf("invalid key type: %s", keyType)
 	}
@@ -478,6 +483,10 @@ func (s *fileStorage) ensureKey(data *storageData, keyType KeyType) error {
 		if data.LAK == nil {
 			data.LAK = &keyData{}
 		}
+	case LDevEK:
+		if data.LDevEK == nil {
+			data.LDevEK = &keyData{}
+		}
 	default:
 		return fmt.Errorf("unsupported key type: %s", keyType)
 	}
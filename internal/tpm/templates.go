@@ -163,6 +163,44 @@ func AttestationKeyTemplate(keyAlgo KeyAlgorithm) (tpm2.TPMTPublic, error) {
 	}
 }
 
+// EncryptionKeyTemplate generates a template for an ECDH key on the NIST P-256 curve that can only be used to
+// decrypt. The key is created under the Storage Root Key and never leaves the TPM.
+func EncryptionKeyTemplate() tpm2.TPMTPublic {
+	return tpm2.TPMTPublic{
+		Type:    tpm2.TPMAlgECC,
+		NameAlg: tpm2.TPMAlgSHA256,
+		ObjectAttributes: tpm2.TPMAObject{
+			FixedTPM:            true, // true = must stay in TPM
+			FixedParent:         true, // true = can't be re-parented
+			SensitiveDataOrigin: true, // true = TPM generates all sensitive data during creation
+			UserWithAuth:        true, // true = pw or hmac can be used in addition to authpolicy
+			Decrypt:             true, // true = can be used for key agreement
+		},
+		Parameters: tpm2.NewTPMUPublicParms(
+			tpm2.TPMAlgECC,
+			&tpm2.TPMSECCParms{
+				Scheme: tpm2.TPMTECCScheme{
+					Scheme: tpm2.TPMAlgECDH,
+					Details: tpm2.NewTPMUAsymScheme(
+						tpm2.TPMAlgECDH,
+						&tpm2.TPMSKeySchemeECDH{
+							HashAlg: tpm2.TPMAlgSHA256,
+						},
+					),
+				},
+				CurveID: tpm2.TPMECCNistP256,
+			},
+		),
+		Unique: tpm2.NewTPMUPublicID(
+			tpm2.TPMAlgECC,
+			&tpm2.TPMSECCPoint{
+				X: tpm2.TPM2BECCParameter{Buffer: make([]byte, 32)},
+				Y: tpm2.TPM2BECCParameter{Buffer: make([]byte, 32)},
+			},
+		),
+	}
+}
+
 func StorageKeyTemplate(keyAlgo KeyAlgorithm) (tpm2.TPMTPublic, error) {
 	switch keyAlgo {
 	case ECDSA:
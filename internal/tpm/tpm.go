@@ -3,6 +3,8 @@ package tpm
 import (
 	"context"
 	"crypto"
+	"crypto/ecdh"
+	"fmt"
 	"regexp"
 
 	legacy "github.com/google/go-tpm/legacy/tpm2"
@@ -29,6 +31,22 @@ type Client interface {
 	VendorInfoCollector(ctx context.Context) string
 	// CreateApplicationKey generates a TCG CSR IDEVID bundle and a TSS2 PEM encoded file for the specified application
 	CreateApplicationKey(name string) ([]byte, []byte, error)
+	// Quote produces a LAK-signed quote over the specified SHA-256 PCRs and returns it with the quoted PCR values
+	Quote(nonce []byte, pcrs []int) (*PCRQuote, error)
+	// EncryptionPublicKey returns the public key of the TPM-bound key that content is encrypted to
+	EncryptionPublicKey() (crypto.PublicKey, error)
+	// ECDH computes the shared secret between the TPM-bound encryption key and the given public key
+	ECDH(peer *ecdh.PublicKey) ([]byte, error)
+}
+
+// PCRQuote is a TPM2_Quote over a set of SHA-256 PCRs
+type PCRQuote struct {
+	// Quoted is the marshaled TPM2B_ATTEST structure
+	Quoted []byte
+	// Signature is the marshaled TPMT_SIGNATURE over Quoted
+	Signature []byte
+	// PCRs maps each quoted PCR index to its value at the time of the quote
+	PCRs map[int][]byte
 }
 
 const (
@@ -54,6 +72,10 @@ const (
 
 	// SRK (Storage Root Key) is a well-known, persistent primary key in the TPM's storage hierarchy.
 	SRK KeyType = "srk"
+
+	// LDevEK (Local Device Encryption Key) is an ECDH key used to decrypt content the service encrypted
+	// to the device. The LDevID cannot be used for this as it is a signing-only key.
+	LDevEK KeyType = "ldevek"
 )
 
 // KeyAlgorithm represents the cryptographic algorithm used for keys
@@ -138,8 +160,14 @@ type Session interface {
 	RemoveApplicationKey(appName string) error
 	// Sign signs data with the specified key
 	Sign(keyType KeyType, digest []byte) ([]byte, error)
+	// Quote signs the specified SHA-256 PCRs with the LAK
+	Quote(pcrs []int, qualifyingData []byte) (quoted, signature []byte, err error)
+	// ReadPCRs reads the SHA-256 bank values of the specified PCRs
+	ReadPCRs(pcrs []int) (map[int][]byte, error)
 	// GetPublicKey gets the public key for a key type
 	GetPublicKey(keyType KeyType) (*tpm2.TPM2BPublic, error)
+	// ECDHZGen computes the shared point between the specified ECC decryption key and the given public point
+	ECDHZGen(keyType KeyType, point tpm2.TPMSECCPoint) (*tpm2.TPMSECCPoint, error)
 	// GetEndorsementKeyCert returns the endorsement key certificate
 	GetEndorsementKeyCert() ([]byte, error)
 	// GenerateChallenge creates a credential challenge used to prove ownership
@@ -166,6 +194,18 @@ func createPCRSelection(selection [3]byte) *tpm2.TPMLPCRSelection {
 	}
 }
 
+// createPCRSelectionFromIndexes creates a SHA-256 PCR selection for the given PCR indexes
+func createPCRSelectionFromIndexes(pcrs []int) (*tpm2.TPMLPCRSelection, error) {
+	var selection [3]byte
+	for _, pcr := range pcrs {
+		if pcr < 0 || pcr > 23 {
+			return nil, fmt.Errorf("invalid PCR index %d", pcr)
+		}
+		selection[pcr/8] |= 1 << (pcr % 8)
+	}
+	return createPCRSelection(selection), nil
+}
+
 // createFullPCRSelection creates a PCR selection that includes all PCRs (0-23)
 func createFullPCRSelection() *tpm2.TPMLPCRSelection {
 	// PCRs 0-7 (all bits set)
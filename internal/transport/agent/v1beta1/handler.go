@@ -149,6 +149,81 @@ func (s *AgentTransportHandler) PatchDeviceStatus(w http.ResponseWriter, r *http
 	s.SetResponse(w, apiResult, status)
 }
 
+// (POST /api/v1/devices/{name}/attestation/challenge)
+func (s *AgentTransportHandler) CreateDeviceAttestationChallenge(w http.ResponseWriter, r *http.Request, name string) {
+	ctx := r.Context()
+
+	// Extract device fingerprint from context (set by middleware)
+	val := ctx.Value(consts.IdentityCtxKey)
+	if val == nil {
+		s.log.Error("agent identity is missing from context")
+		status := api.StatusUnauthorized(http.StatusText(http.StatusUnauthorized))
+		s.SetResponse(w, status, status)
+		return
+	}
+	identity, ok := val.(*middleware.AgentIdentity)
+	if !ok {
+		s.log.Error("invalid agent identity type in context")
+		status := api.StatusInternalServerError(http.StatusText(http.StatusInternalServerError))
+		s.SetResponse(w, status, status)
+		return
+	}
+	fingerprint := identity.GetUsername() // This is the device fingerprint for agents
+
+	// Validate that the authenticated device matches the requested device name
+	if fingerprint != name {
+		s.log.Errorf("attempt to access device %q with certificate fingerprint %q has been detected", name, fingerprint)
+		status := api.StatusUnauthorized(http.StatusText(http.StatusUnauthorized))
+		s.SetResponse(w, status, status)
+		return
+	}
+
+	body, status := s.serviceHandler.CreateDeviceAttestationChallenge(ctx, transport.OrgIDFromContext(ctx), fingerprint)
+	apiResult := s.converter.Device().AttestationChallengeFromDomain(body)
+	s.SetResponse(w, apiResult, status)
+}
+
+// (PUT /api/v1/devices/{name}/attestation)
+func (s *AgentTransportHandler) ReplaceDeviceAttestation(w http.ResponseWriter, r *http.Request, name string) {
+	ctx := r.Context()
+
+	// Extract device fingerprint from context (set by middleware)
+	val := ctx.Value(consts.IdentityCtxKey)
+	if val == nil {
+		s.log.Error("agent identity is missing from context")
+		status := api.StatusUnauthorized(http.StatusText(http.StatusUnauthorized))
+		s.SetResponse(w, status, status)
+		return
+	}
+	identity, ok := val.(*middleware.AgentIdentity)
+	if !ok {
+		s.log.Error("invalid agent identity type in context")
+		status := api.StatusInternalServerError(http.StatusText(http.StatusInternalServerError))
+		s.SetResponse(w, status, status)
+		return
+	}
+	fingerprint := identity.GetUsername() // This is the device fingerprint for agents
+
+	// Validate that the authenticated device matches the requested device name
+	if fingerprint != name {
+		s.log.Errorf("attempt to access device %q with certificate fingerprint %q has been detected", name, fingerprint)
+		status := api.StatusUnauthorized(http.StatusText(http.StatusUnauthorized))
+		s.SetResponse(w, status, status)
+		return
+	}
+
+	var report api.AttestationReport
+	if err := json.NewDecoder(r.Body).Decode(&report); err != nil {
+		s.SetParseFailureResponse(w, err)
+		return
+	}
+
+	domainReport := s.converter.Device().AttestationReportToDomain(report)
+	body, status := s.serviceHandler.ReplaceDeviceAttestation(ctx, transport.OrgIDFromContext(ctx), fingerprint, domainReport)
+	apiResult := s.converter.Device().IntegrityStatusFromDomain(body)
+	s.SetResponse(w, apiResult, status)
+}
+
 // (POST /api/v1/enrollmentrequests)
 func (s *AgentTransportHandler) CreateEnrollmentRequest(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -8,9 +8,13 @@ import (
 	"time"
 
 	api "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/console/filetransfer"
+	"github.com/flightctl/flightctl/internal/console/logstream"
+	"github.com/flightctl/flightctl/internal/console/portforward"
 	"github.com/flightctl/flightctl/internal/transport"
 	"github.com/go-chi/chi/v5"
 	"github.com/gorilla/websocket"
+	"github.com/samber/lo"
 )
 
 func (h *WebsocketHandler) injectProtocolsToMetadata(metadataStr string, protocols []string) (string, error) {
@@ -27,16 +31,46 @@ func (h *WebsocketHandler) injectProtocolsToMetadata(metadataStr string, protoco
 }
 
 func (h *WebsocketHandler) HandleDeviceConsole(w http.ResponseWriter, r *http.Request) {
+	// The port forwarding, file transfer and log protocols are only available through their own endpoints, so
+	// that they can be authorized separately
+	protocols := lo.Without(websocket.Subprotocols(r), portforward.ProtocolV1Name, filetransfer.ProtocolV1Name, logstream.ProtocolV1Name)
+	h.handleDeviceSession(w, r, "console", protocols)
+}
+
+func (h *WebsocketHandler) HandleDevicePortForward(w http.ResponseWriter, r *http.Request) {
+	h.handleDedicatedDeviceSession(w, r, "port forward", portforward.ProtocolV1Name)
+}
+
+func (h *WebsocketHandler) HandleDeviceCopy(w http.ResponseWriter, r *http.Request) {
+	h.handleDedicatedDeviceSession(w, r, "file transfer", filetransfer.ProtocolV1Name)
+}
+
+func (h *WebsocketHandler) HandleDeviceLogs(w http.ResponseWriter, r *http.Request) {
+	h.handleDedicatedDeviceSession(w, r, "logs", logstream.ProtocolV1Name)
+}
+
+// handleDedicatedDeviceSession starts a session that must use the given protocol.
+func (h *WebsocketHandler) handleDedicatedDeviceSession(w http.ResponseWriter, r *http.Request, sessionType string, protocol string) {
+	protocols := lo.Intersect(websocket.Subprotocols(r), []string{protocol})
+	if len(protocols) == 0 {
+		http.Error(w, fmt.Sprintf("%s requires the %s protocol", sessionType, protocol), http.StatusBadRequest)
+		return
+	}
+	h.handleDeviceSession(w, r, sessionType, protocols)
+}
+
+// handleDeviceSession starts a console session with the device using one of the given protocols and relays
+// messages between the websocket connection and the device.
+func (h *WebsocketHandler) handleDeviceSession(w http.ResponseWriter, r *http.Request, sessionType string, protocols []string) {
 	deviceName := chi.URLParam(r, "name")
 
-	h.log.Infof("websocket console connection requested for device: %s", deviceName)
+	h.log.Infof("websocket %s connection requested for device: %s", sessionType, deviceName)
 
 	// Extract organization ID from context
 	orgId := transport.OrgIDFromContext(r.Context())
 
 	// Extract metadata
-	metadata, err := h.injectProtocolsToMetadata(r.URL.Query().Get(api.DeviceQueryConsoleSessionMetadata),
-		websocket.Subprotocols(r))
+	metadata, err := h.injectProtocolsToMetadata(r.URL.Query().Get(api.DeviceQueryConsoleSessionMetadata), protocols)
 	if err != nil {
 		h.log.Errorf("failed injecting protocols to metadata for device %s: %v", deviceName, err)
 		http.Error(w, "protocols injection error", http.StatusInternalServerError)
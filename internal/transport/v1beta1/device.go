@@ -25,6 +25,11 @@ func (h *TransportHandler) CreateDevice(w http.ResponseWriter, r *http.Request)
 // (GET /api/v1/devices)
 func (h *TransportHandler) ListDevices(w http.ResponseWriter, r *http.Request, params apiv1beta1.ListDevicesParams) {
 	domainParams := h.converter.Device().ListParamsToDomain(params)
+	if params.Watch != nil && *params.Watch {
+		watcher, status := h.serviceHandler.WatchDevices(r.Context(), transport.OrgIDFromContext(r.Context()), domainParams)
+		h.writeWatchStream(w, r, watcher, status)
+		return
+	}
 	body, status := h.serviceHandler.ListDevices(r.Context(), transport.OrgIDFromContext(r.Context()), domainParams, nil)
 	apiResult := h.converter.Device().ListFromDomain(body)
 	h.SetResponse(w, apiResult, status)
@@ -135,6 +140,20 @@ func (h *TransportHandler) DecommissionDevice(w http.ResponseWriter, r *http.Req
 	h.SetResponse(w, apiResult, status)
 }
 
+// (PUT /api/v1/devices/{name}/revocation)
+func (h *TransportHandler) RevokeDeviceCertificates(w http.ResponseWriter, r *http.Request, name string) {
+	var revocation apiv1beta1.DeviceRevocation
+	if err := json.NewDecoder(r.Body).Decode(&revocation); err != nil {
+		h.SetParseFailureResponse(w, err)
+		return
+	}
+
+	domainRevocation := h.converter.Device().RevocationToDomain(revocation)
+	body, status := h.serviceHandler.RevokeDeviceCertificates(r.Context(), transport.OrgIDFromContext(r.Context()), name, domainRevocation)
+	apiResult := h.converter.Device().RevocationResultFromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
 // (POST /api/v1/deviceactions/resume)
 func (h *TransportHandler) ResumeDevices(w http.ResponseWriter, r *http.Request) {
 	var request apiv1beta1.DeviceResumeRequest
@@ -0,0 +1,72 @@
+package transportv1beta1
+
+import (
+	"encoding/json"
+	"net/http"
+
+	apiv1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/transport"
+)
+
+// (POST /api/v1/enrollmentpolicies)
+func (h *TransportHandler) CreateEnrollmentPolicy(w http.ResponseWriter, r *http.Request) {
+	var ep apiv1beta1.EnrollmentPolicy
+	if err := json.NewDecoder(r.Body).Decode(&ep); err != nil {
+		h.SetParseFailureResponse(w, err)
+		return
+	}
+
+	domainEP := h.converter.EnrollmentPolicy().ToDomain(ep)
+	body, status := h.serviceHandler.CreateEnrollmentPolicy(r.Context(), transport.OrgIDFromContext(r.Context()), domainEP)
+	apiResult := h.converter.EnrollmentPolicy().FromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (GET /api/v1/enrollmentpolicies)
+func (h *TransportHandler) ListEnrollmentPolicies(w http.ResponseWriter, r *http.Request, params apiv1beta1.ListEnrollmentPoliciesParams) {
+	domainParams := h.converter.EnrollmentPolicy().ListParamsToDomain(params)
+	body, status := h.serviceHandler.ListEnrollmentPolicies(r.Context(), transport.OrgIDFromContext(r.Context()), domainParams)
+	apiResult := h.converter.EnrollmentPolicy().ListFromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (GET /api/v1/enrollmentpolicies/{name})
+func (h *TransportHandler) GetEnrollmentPolicy(w http.ResponseWriter, r *http.Request, name string) {
+	body, status := h.serviceHandler.GetEnrollmentPolicy(r.Context(), transport.OrgIDFromContext(r.Context()), name)
+	apiResult := h.converter.EnrollmentPolicy().FromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (PUT /api/v1/enrollmentpolicies/{name})
+func (h *TransportHandler) ReplaceEnrollmentPolicy(w http.ResponseWriter, r *http.Request, name string) {
+	var ep apiv1beta1.EnrollmentPolicy
+	if err := json.NewDecoder(r.Body).Decode(&ep); err != nil {
+		h.SetParseFailureResponse(w, err)
+		return
+	}
+
+	domainEP := h.converter.EnrollmentPolicy().ToDomain(ep)
+	body, status := h.serviceHandler.ReplaceEnrollmentPolicy(r.Context(), transport.OrgIDFromContext(r.Context()), name, domainEP)
+	apiResult := h.converter.EnrollmentPolicy().FromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (DELETE /api/v1/enrollmentpolicies/{name})
+func (h *TransportHandler) DeleteEnrollmentPolicy(w http.ResponseWriter, r *http.Request, name string) {
+	status := h.serviceHandler.DeleteEnrollmentPolicy(r.Context(), transport.OrgIDFromContext(r.Context()), name)
+	h.SetResponse(w, nil, status)
+}
+
+// (PATCH /api/v1/enrollmentpolicies/{name})
+func (h *TransportHandler) PatchEnrollmentPolicy(w http.ResponseWriter, r *http.Request, name string) {
+	var patch apiv1beta1.PatchRequest
+	if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
+		h.SetParseFailureResponse(w, err)
+		return
+	}
+
+	domainPatch := h.converter.Common().PatchRequestToDomain(patch)
+	body, status := h.serviceHandler.PatchEnrollmentPolicy(r.Context(), transport.OrgIDFromContext(r.Context()), name, domainPatch)
+	apiResult := h.converter.EnrollmentPolicy().FromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
@@ -25,6 +25,11 @@ func (h *TransportHandler) CreateFleet(w http.ResponseWriter, r *http.Request) {
 // (GET /api/v1/fleets)
 func (h *TransportHandler) ListFleets(w http.ResponseWriter, r *http.Request, params apiv1beta1.ListFleetsParams) {
 	domainParams := h.converter.Fleet().ListParamsToDomain(params)
+	if params.Watch != nil && *params.Watch {
+		watcher, status := h.serviceHandler.WatchFleets(r.Context(), transport.OrgIDFromContext(r.Context()), domainParams)
+		h.writeWatchStream(w, r, watcher, status)
+		return
+	}
 	body, status := h.serviceHandler.ListFleets(r.Context(), transport.OrgIDFromContext(r.Context()), domainParams)
 	apiResult := h.converter.Fleet().ListFromDomain(body)
 	h.SetResponse(w, apiResult, status)
@@ -19,6 +19,7 @@ type TransportHandler struct {
 	authTokenProxy    *service.AuthTokenProxy
 	authUserInfoProxy *service.AuthUserInfoProxy
 	authZ             auth.AuthZMiddleware
+	log               logrus.FieldLogger
 }
 
 type WebsocketHandler struct {
@@ -30,7 +31,7 @@ type WebsocketHandler struct {
 // Make sure we conform to servers Transport interface
 var _ server.Transport = (*TransportHandler)(nil)
 
-func NewTransportHandler(serviceHandler service.Service, converter convertv1beta1.Converter, authN common.AuthNMiddleware, authTokenProxy *service.AuthTokenProxy, authUserInfoProxy *service.AuthUserInfoProxy, authZ auth.AuthZMiddleware) *TransportHandler {
+func NewTransportHandler(serviceHandler service.Service, converter convertv1beta1.Converter, authN common.AuthNMiddleware, authTokenProxy *service.AuthTokenProxy, authUserInfoProxy *service.AuthUserInfoProxy, authZ auth.AuthZMiddleware, log logrus.FieldLogger) *TransportHandler {
 	return &TransportHandler{
 		serviceHandler:    serviceHandler,
 		converter:         converter,
@@ -38,6 +39,7 @@ func NewTransportHandler(serviceHandler service.Service, converter convertv1beta
 		authTokenProxy:    authTokenProxy,
 		authUserInfoProxy: authUserInfoProxy,
 		authZ:             authZ,
+		log:               log,
 	}
 }
 
@@ -52,4 +54,10 @@ func NewWebsocketHandler(ca *crypto.CAClient, log logrus.FieldLogger, consoleSes
 func (h *WebsocketHandler) RegisterRoutes(r chi.Router) {
 	// Websocket handler for console
 	r.Get("/ws/v1/devices/{name}/console", h.HandleDeviceConsole)
+	// Websocket handler for port forwarding
+	r.Get("/ws/v1/devices/{name}/portforward", h.HandleDevicePortForward)
+	// Websocket handler for file transfer
+	r.Get("/ws/v1/devices/{name}/copy", h.HandleDeviceCopy)
+	// Websocket handler for device logs
+	r.Get("/ws/v1/devices/{name}/logs", h.HandleDeviceLogs)
 }
@@ -0,0 +1,72 @@
+package transportv1beta1
+
+import (
+	"encoding/json"
+	"net/http"
+
+	apiv1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/transport"
+)
+
+// (POST /api/v1/referencemeasurements)
+func (h *TransportHandler) CreateReferenceMeasurement(w http.ResponseWriter, r *http.Request) {
+	var rm apiv1beta1.ReferenceMeasurement
+	if err := json.NewDecoder(r.Body).Decode(&rm); err != nil {
+		h.SetParseFailureResponse(w, err)
+		return
+	}
+
+	domainRM := h.converter.ReferenceMeasurement().ToDomain(rm)
+	body, status := h.serviceHandler.CreateReferenceMeasurement(r.Context(), transport.OrgIDFromContext(r.Context()), domainRM)
+	apiResult := h.converter.ReferenceMeasurement().FromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (GET /api/v1/referencemeasurements)
+func (h *TransportHandler) ListReferenceMeasurements(w http.ResponseWriter, r *http.Request, params apiv1beta1.ListReferenceMeasurementsParams) {
+	domainParams := h.converter.ReferenceMeasurement().ListParamsToDomain(params)
+	body, status := h.serviceHandler.ListReferenceMeasurements(r.Context(), transport.OrgIDFromContext(r.Context()), domainParams)
+	apiResult := h.converter.ReferenceMeasurement().ListFromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (GET /api/v1/referencemeasurements/{name})
+func (h *TransportHandler) GetReferenceMeasurement(w http.ResponseWriter, r *http.Request, name string) {
+	body, status := h.serviceHandler.GetReferenceMeasurement(r.Context(), transport.OrgIDFromContext(r.Context()), name)
+	apiResult := h.converter.ReferenceMeasurement().FromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (PUT /api/v1/referencemeasurements/{name})
+func (h *TransportHandler) ReplaceReferenceMeasurement(w http.ResponseWriter, r *http.Request, name string) {
+	var rm apiv1beta1.ReferenceMeasurement
+	if err := json.NewDecoder(r.Body).Decode(&rm); err != nil {
+		h.SetParseFailureResponse(w, err)
+		return
+	}
+
+	domainRM := h.converter.ReferenceMeasurement().ToDomain(rm)
+	body, status := h.serviceHandler.ReplaceReferenceMeasurement(r.Context(), transport.OrgIDFromContext(r.Context()), name, domainRM)
+	apiResult := h.converter.ReferenceMeasurement().FromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (DELETE /api/v1/referencemeasurements/{name})
+func (h *TransportHandler) DeleteReferenceMeasurement(w http.ResponseWriter, r *http.Request, name string) {
+	status := h.serviceHandler.DeleteReferenceMeasurement(r.Context(), transport.OrgIDFromContext(r.Context()), name)
+	h.SetResponse(w, nil, status)
+}
+
+// (PATCH /api/v1/referencemeasurements/{name})
+func (h *TransportHandler) PatchReferenceMeasurement(w http.ResponseWriter, r *http.Request, name string) {
+	var patch apiv1beta1.PatchRequest
+	if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
+		h.SetParseFailureResponse(w, err)
+		return
+	}
+
+	domainPatch := h.converter.Common().PatchRequestToDomain(patch)
+	body, status := h.serviceHandler.PatchReferenceMeasurement(r.Context(), transport.OrgIDFromContext(r.Context()), name, domainPatch)
+	apiResult := h.converter.ReferenceMeasurement().FromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
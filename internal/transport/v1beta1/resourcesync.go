@@ -70,3 +70,18 @@ func (h *TransportHandler) PatchResourceSync(w http.ResponseWriter, r *http.Requ
 	apiResult := h.converter.ResourceSync().FromDomain(body)
 	h.SetResponse(w, apiResult, status)
 }
+
+// (POST /api/v1/resourcesyncs/{name}/preview)
+func (h *TransportHandler) RequestResourceSyncPreview(w http.ResponseWriter, r *http.Request, name string, params apiv1beta1.RequestResourceSyncPreviewParams) {
+	domainParams := h.converter.ResourceSync().PreviewParamsToDomain(params)
+	body, status := h.serviceHandler.RequestResourceSyncPreview(r.Context(), transport.OrgIDFromContext(r.Context()), name, domainParams)
+	apiResult := h.converter.ResourceSync().PreviewRequestFromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (GET /api/v1/resourcesyncs/{name}/preview/{requestId})
+func (h *TransportHandler) GetResourceSyncPreview(w http.ResponseWriter, r *http.Request, name string, requestId string) {
+	body, status := h.serviceHandler.GetResourceSyncPreview(r.Context(), transport.OrgIDFromContext(r.Context()), name, requestId)
+	apiResult := h.converter.ResourceSync().PreviewFromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
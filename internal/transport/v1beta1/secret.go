@@ -0,0 +1,58 @@
+package transportv1beta1
+
+import (
+	"encoding/json"
+	"net/http"
+
+	apiv1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/transport"
+)
+
+// (POST /api/v1/secrets)
+func (h *TransportHandler) CreateSecret(w http.ResponseWriter, r *http.Request) {
+	var secret apiv1beta1.Secret
+	if err := json.NewDecoder(r.Body).Decode(&secret); err != nil {
+		h.SetParseFailureResponse(w, err)
+		return
+	}
+
+	domainSecret := h.converter.Secret().ToDomain(secret)
+	body, status := h.serviceHandler.CreateSecret(r.Context(), transport.OrgIDFromContext(r.Context()), domainSecret)
+	apiResult := h.converter.Secret().FromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (GET /api/v1/secrets)
+func (h *TransportHandler) ListSecrets(w http.ResponseWriter, r *http.Request, params apiv1beta1.ListSecretsParams) {
+	domainParams := h.converter.Secret().ListParamsToDomain(params)
+	body, status := h.serviceHandler.ListSecrets(r.Context(), transport.OrgIDFromContext(r.Context()), domainParams)
+	apiResult := h.converter.Secret().ListFromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (GET /api/v1/secrets/{name})
+func (h *TransportHandler) GetSecret(w http.ResponseWriter, r *http.Request, name string) {
+	body, status := h.serviceHandler.GetSecret(r.Context(), transport.OrgIDFromContext(r.Context()), name)
+	apiResult := h.converter.Secret().FromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (PUT /api/v1/secrets/{name})
+func (h *TransportHandler) ReplaceSecret(w http.ResponseWriter, r *http.Request, name string) {
+	var secret apiv1beta1.Secret
+	if err := json.NewDecoder(r.Body).Decode(&secret); err != nil {
+		h.SetParseFailureResponse(w, err)
+		return
+	}
+
+	domainSecret := h.converter.Secret().ToDomain(secret)
+	body, status := h.serviceHandler.ReplaceSecret(r.Context(), transport.OrgIDFromContext(r.Context()), name, domainSecret)
+	apiResult := h.converter.Secret().FromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (DELETE /api/v1/secrets/{name})
+func (h *TransportHandler) DeleteSecret(w http.ResponseWriter, r *http.Request, name string) {
+	status := h.serviceHandler.DeleteSecret(r.Context(), transport.OrgIDFromContext(r.Context()), name)
+	h.SetResponse(w, nil, status)
+}
@@ -0,0 +1,58 @@
+package transportv1beta1
+
+import (
+	"encoding/json"
+	"net/http"
+
+	apiv1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/transport"
+)
+
+// (POST /api/v1/trustpolicies)
+func (h *TransportHandler) CreateTrustPolicy(w http.ResponseWriter, r *http.Request) {
+	var trustPolicy apiv1beta1.TrustPolicy
+	if err := json.NewDecoder(r.Body).Decode(&trustPolicy); err != nil {
+		h.SetParseFailureResponse(w, err)
+		return
+	}
+
+	domainTrustPolicy := h.converter.TrustPolicy().ToDomain(trustPolicy)
+	body, status := h.serviceHandler.CreateTrustPolicy(r.Context(), transport.OrgIDFromContext(r.Context()), domainTrustPolicy)
+	apiResult := h.converter.TrustPolicy().FromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (GET /api/v1/trustpolicies)
+func (h *TransportHandler) ListTrustPolicies(w http.ResponseWriter, r *http.Request, params apiv1beta1.ListTrustPoliciesParams) {
+	domainParams := h.converter.TrustPolicy().ListParamsToDomain(params)
+	body, status := h.serviceHandler.ListTrustPolicies(r.Context(), transport.OrgIDFromContext(r.Context()), domainParams)
+	apiResult := h.converter.TrustPolicy().ListFromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (GET /api/v1/trustpolicies/{name})
+func (h *TransportHandler) GetTrustPolicy(w http.ResponseWriter, r *http.Request, name string) {
+	body, status := h.serviceHandler.GetTrustPolicy(r.Context(), transport.OrgIDFromContext(r.Context()), name)
+	apiResult := h.converter.TrustPolicy().FromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (PUT /api/v1/trustpolicies/{name})
+func (h *TransportHandler) ReplaceTrustPolicy(w http.ResponseWriter, r *http.Request, name string) {
+	var trustPolicy apiv1beta1.TrustPolicy
+	if err := json.NewDecoder(r.Body).Decode(&trustPolicy); err != nil {
+		h.SetParseFailureResponse(w, err)
+		return
+	}
+
+	domainTrustPolicy := h.converter.TrustPolicy().ToDomain(trustPolicy)
+	body, status := h.serviceHandler.ReplaceTrustPolicy(r.Context(), transport.OrgIDFromContext(r.Context()), name, domainTrustPolicy)
+	apiResult := h.converter.TrustPolicy().FromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (DELETE /api/v1/trustpolicies/{name})
+func (h *TransportHandler) DeleteTrustPolicy(w http.ResponseWriter, r *http.Request, name string) {
+	status := h.serviceHandler.DeleteTrustPolicy(r.Context(), transport.OrgIDFromContext(r.Context()), name)
+	h.SetResponse(w, nil, status)
+}
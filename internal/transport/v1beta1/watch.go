@@ -0,0 +1,73 @@
+package transportv1beta1
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/service"
+)
+
+// writeWatchStream streams the notifications produced by watcher until the client disconnects.
+// Notifications are written as newline-delimited JSON, or as server-sent events if the client
+// accepts 'text/event-stream'.
+func (h *TransportHandler) writeWatchStream(w http.ResponseWriter, r *http.Request, watcher service.ResourceWatcher, status domain.Status) {
+	if status.Code != http.StatusOK {
+		h.SetResponse(w, nil, status)
+		return
+	}
+
+	ctx := r.Context()
+	sse := strings.Contains(r.Header.Get("Accept"), "text/event-stream")
+
+	// A watch outlives the server's write timeout, so disable the deadline for this response
+	rc := http.NewResponseController(w)
+	_ = rc.SetWriteDeadline(time.Time{})
+
+	if sse {
+		w.Header().Set("Content-Type", "text/event-stream")
+	} else {
+		w.Header().Set("Content-Type", "application/json")
+	}
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	_ = rc.Flush()
+
+	for {
+		events, err := watcher.Next(ctx)
+		if err != nil {
+			if errors.Is(err, service.ErrWatchExpired) {
+				// the client resumes from its last resourceVersion and receives 410 Gone
+				h.log.Debug("closing expired watch")
+				return
+			}
+			if ctx.Err() == nil {
+				h.log.WithError(err).Error("failed reading watch notifications")
+			}
+			return
+		}
+		for _, event := range events {
+			b, err := json.Marshal(h.converter.Common().WatchEventFromDomain(event))
+			if err != nil {
+				h.log.WithError(err).Error("failed marshalling watch notification")
+				return
+			}
+			if sse {
+				_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event.Type, b)
+			} else {
+				_, err = fmt.Fprintf(w, "%s\n", b)
+			}
+			if err != nil {
+				return
+			}
+		}
+		if err := rc.Flush(); err != nil {
+			return
+		}
+	}
+}
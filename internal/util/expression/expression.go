@@ -0,0 +1,704 @@
+// Package expression implements the expression language of hook conditions, which the service uses to
+// validate conditions when a spec is submitted and the agent to evaluate them during an update.
+package expression
+
+import (
+	"fmt"
+	"maps"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Expressions support:
+//   - literals: true, false, numbers, 'single' or "double" quoted strings, and lists such as ['a', 'b']
+//   - variables, e.g. rebooted, and member access on maps, e.g. labels.region or labels["region"]
+//   - the boolean operators !, && and ||, and parentheses for grouping
+//   - the comparisons ==, !=, <, <=, > and >=, and the membership test 'in' for lists, maps and strings
+//   - the functions contains, startsWith, endsWith, matches, lower, upper and len
+//
+// Looking up a key that does not exist in a map yields an empty string.
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenNumber
+	tokenString
+	tokenOperator
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+// twoCharOperators must be checked before the single character operators they start with.
+var twoCharOperators = []string{"&&", "||", "==", "!=", "<=", ">="}
+
+const singleCharOperators = "!<>()[],."
+
+func tokenize(expression string) ([]token, error) {
+	var tokens []token
+	runes := []rune(expression)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case unicode.IsLetter(r) || r == '_':
+			start := i
+			for i < len(runes) && (unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i]) || runes[i] == '_') {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenIdent, text: string(runes[start:i]), pos: start})
+		case unicode.IsDigit(r) || (r == '-' && i+1 < len(runes) && unicode.IsDigit(runes[i+1])):
+			start := i
+			i++
+			for i < len(runes) && (unicode.IsDigit(runes[i]) || runes[i] == '.') {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenNumber, text: string(runes[start:i]), pos: start})
+		case r == '\'' || r == '"':
+			start := i
+			var sb strings.Builder
+			i++
+			for ; i < len(runes) && runes[i] != r; i++ {
+				if runes[i] == '\\' && i+1 < len(runes) {
+					i++
+				}
+				sb.WriteRune(runes[i])
+			}
+			if i >= len(runes) {
+				return nil, fmt.Errorf("unterminated string at position %d", start)
+			}
+			i++
+			tokens = append(tokens, token{kind: tokenString, text: sb.String(), pos: start})
+		default:
+			matched := false
+			for _, op := range twoCharOperators {
+				if strings.HasPrefix(string(runes[i:]), op) {
+					tokens = append(tokens, token{kind: tokenOperator, text: op, pos: i})
+					i += len(op)
+					matched = true
+					break
+				}
+			}
+			if matched {
+				continue
+			}
+			if !strings.ContainsRune(singleCharOperators, r) {
+				return nil, fmt.Errorf("unexpected character %q at position %d", r, i)
+			}
+			tokens = append(tokens, token{kind: tokenOperator, text: string(r), pos: i})
+			i++
+		}
+	}
+	return append(tokens, token{kind: tokenEOF, pos: len(runes)}), nil
+}
+
+// exprNode is a node of a parsed expression.
+type exprNode interface {
+	eval(vars map[string]any) (any, error)
+	// variables adds the names of the variables referenced by the node to names.
+	variables(names map[string]struct{})
+}
+
+// Expression is a parsed expression.
+type Expression struct {
+	source string
+	root   exprNode
+}
+
+type literalNode struct {
+	value any
+}
+
+type variableNode struct {
+	name string
+}
+
+type listNode struct {
+	items []exprNode
+}
+
+type indexNode struct {
+	target exprNode
+	key    exprNode
+}
+
+type unaryNode struct {
+	op      string
+	operand exprNode
+}
+
+type binaryNode struct {
+	op  string
+	lhs exprNode
+	rhs exprNode
+}
+
+type callNode struct {
+	name string
+	args []exprNode
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+// Parse parses an expression without evaluating it.
+func Parse(expression string) (*Expression, error) {
+	root, err := parseExpression(expression)
+	if err != nil {
+		return nil, err
+	}
+	return &Expression{source: expression, root: root}, nil
+}
+
+// Variables returns the sorted names of the variables the expression refers to.
+func (e *Expression) Variables() []string {
+	names := map[string]struct{}{}
+	e.root.variables(names)
+	return slices.Sorted(maps.Keys(names))
+}
+
+// Evaluate evaluates the expression, which must yield a boolean, using the given variables.
+func (e *Expression) Evaluate(vars map[string]any) (bool, error) {
+	result, err := evalBool(e.root, vars)
+	if err != nil {
+		return false, fmt.Errorf("evaluating expression %q: %w", e.source, err)
+	}
+	return result, nil
+}
+
+func parseExpression(expression string) (exprNode, error) {
+	tokens, err := tokenize(expression)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	node, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != tokenEOF {
+		return nil, fmt.Errorf("unexpected %q at position %d", t.text, t.pos)
+	}
+	return node, nil
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+// accept consumes the next token if it is the given operator or keyword.
+func (p *parser) accept(text string) bool {
+	t := p.peek()
+	if (t.kind == tokenOperator || t.kind == tokenIdent) && t.text == text {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *parser) expect(text string) error {
+	if !p.accept(text) {
+		t := p.peek()
+		if t.kind == tokenEOF {
+			return fmt.Errorf("expected %q at end of expression", text)
+		}
+		return fmt.Errorf("expected %q at position %d, got %q", text, t.pos, t.text)
+	}
+	return nil
+}
+
+func (p *parser) parseOr() (exprNode, error) {
+	lhs, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.accept("||") {
+		rhs, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		lhs = &binaryNode{op: "||", lhs: lhs, rhs: rhs}
+	}
+	return lhs, nil
+}
+
+func (p *parser) parseAnd() (exprNode, error) {
+	lhs, err := p.parseComparison()
+	if err != nil {
+		return nil, err
+	}
+	for p.accept("&&") {
+		rhs, err := p.parseComparison()
+		if err != nil {
+			return nil, err
+		}
+		lhs = &binaryNode{op: "&&", lhs: lhs, rhs: rhs}
+	}
+	return lhs, nil
+}
+
+func (p *parser) parseComparison() (exprNode, error) {
+	lhs, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for _, op := range []string{"==", "!=", "<=", ">=", "<", ">", "in"} {
+		if p.accept(op) {
+			rhs, err := p.parseUnary()
+			if err != nil {
+				return nil, err
+			}
+			return &binaryNode{op: op, lhs: lhs, rhs: rhs}, nil
+		}
+	}
+	return lhs, nil
+}
+
+func (p *parser) parseUnary() (exprNode, error) {
+	if p.accept("!") {
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &unaryNode{op: "!", operand: operand}, nil
+	}
+	return p.parsePostfix()
+}
+
+func (p *parser) parsePostfix() (exprNode, error) {
+	node, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		switch {
+		case p.accept("."):
+			t := p.next()
+			if t.kind != tokenIdent {
+				return nil, fmt.Errorf("expected a key after '.' at position %d", t.pos)
+			}
+			node = &indexNode{target: node, key: &literalNode{value: t.text}}
+		case p.accept("["):
+			key, err := p.parseOr()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect("]"); err != nil {
+				return nil, err
+			}
+			node = &indexNode{target: node, key: key}
+		default:
+			return node, nil
+		}
+	}
+}
+
+func (p *parser) parsePrimary() (exprNode, error) {
+	t := p.next()
+	switch t.kind {
+	case tokenNumber:
+		f, err := strconv.ParseFloat(t.text, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q at position %d", t.text, t.pos)
+		}
+		return &literalNode{value: f}, nil
+	case tokenString:
+		return &literalNode{value: t.text}, nil
+	case tokenIdent:
+		switch t.text {
+		case "true":
+			return &literalNode{value: true}, nil
+		case "false":
+			return &literalNode{value: false}, nil
+		case "in":
+			return nil, fmt.Errorf("unexpected %q at position %d", t.text, t.pos)
+		}
+		if p.accept("(") {
+			fn, ok := expressionFunctions[t.text]
+			if !ok {
+				return nil, fmt.Errorf("unknown function %q", t.text)
+			}
+			args, err := p.parseList(")")
+			if err != nil {
+				return nil, err
+			}
+			if len(args) != fn.arity {
+				return nil, fmt.Errorf("%s() takes %d argument(s), got %d", t.text, fn.arity, len(args))
+			}
+			return &callNode{name: t.text, args: args}, nil
+		}
+		return &variableNode{name: t.text}, nil
+	case tokenOperator:
+		switch t.text {
+		case "(":
+			node, err := p.parseOr()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect(")"); err != nil {
+				return nil, err
+			}
+			return node, nil
+		case "[":
+			items, err := p.parseList("]")
+			if err != nil {
+				return nil, err
+			}
+			return &listNode{items: items}, nil
+		}
+	case tokenEOF:
+		return nil, fmt.Errorf("unexpected end of expression")
+	}
+	return nil, fmt.Errorf("unexpected %q at position %d", t.text, t.pos)
+}
+
+// parseList parses comma separated expressions up to and including the closing token.
+func (p *parser) parseList(closing string) ([]exprNode, error) {
+	items := []exprNode{}
+	if p.accept(closing) {
+		return items, nil
+	}
+	for {
+		item, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+		if p.accept(closing) {
+			return items, nil
+		}
+		if err := p.expect(","); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (n *literalNode) eval(map[string]any) (any, error) {
+	return n.value, nil
+}
+
+func (n *variableNode) eval(vars map[string]any) (any, error) {
+	v, ok := vars[n.name]
+	if !ok {
+		return nil, fmt.Errorf("unknown variable %q", n.name)
+	}
+	return v, nil
+}
+
+func (n *listNode) eval(vars map[string]any) (any, error) {
+	items := make([]any, 0, len(n.items))
+	for _, item := range n.items {
+		v, err := item.eval(vars)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, v)
+	}
+	return items, nil
+}
+
+func (n *indexNode) eval(vars map[string]any) (any, error) {
+	target, err := n.target.eval(vars)
+	if err != nil {
+		return nil, err
+	}
+	key, err := n.key.eval(vars)
+	if err != nil {
+		return nil, err
+	}
+	switch t := target.(type) {
+	case map[string]any:
+		k, ok := key.(string)
+		if !ok {
+			return nil, fmt.Errorf("map keys must be strings, got %s", typeName(key))
+		}
+		if v, ok := t[k]; ok {
+			return v, nil
+		}
+		return "", nil
+	case []any:
+		f, ok := key.(float64)
+		if !ok || f != float64(int(f)) {
+			return nil, fmt.Errorf("list indexes must be integers, got %s", typeName(key))
+		}
+		if int(f) < 0 || int(f) >= len(t) {
+			return nil, fmt.Errorf("list index %d out of range", int(f))
+		}
+		return t[int(f)], nil
+	default:
+		return nil, fmt.Errorf("cannot index %s", typeName(target))
+	}
+}
+
+func (n *unaryNode) eval(vars map[string]any) (any, error) {
+	b, err := evalBool(n.operand, vars)
+	if err != nil {
+		return nil, err
+	}
+	return !b, nil
+}
+
+func (n *binaryNode) eval(vars map[string]any) (any, error) {
+	switch n.op {
+	case "&&", "||":
+		lhs, err := evalBool(n.lhs, vars)
+		if err != nil {
+			return nil, err
+		}
+		if lhs == (n.op == "||") {
+			return lhs, nil
+		}
+		return evalBool(n.rhs, vars)
+	}
+
+	lhs, err := n.lhs.eval(vars)
+	if err != nil {
+		return nil, err
+	}
+	rhs, err := n.rhs.eval(vars)
+	if err != nil {
+		return nil, err
+	}
+	switch n.op {
+	case "==", "!=":
+		if typeName(lhs) != typeName(rhs) {
+			return nil, fmt.Errorf("cannot compare %s with %s", typeName(lhs), typeName(rhs))
+		}
+		return reflect.DeepEqual(lhs, rhs) == (n.op == "=="), nil
+	case "in":
+		return contains(rhs, lhs)
+	default:
+		return compareOrdered(n.op, lhs, rhs)
+	}
+}
+
+func (n *callNode) eval(vars map[string]any) (any, error) {
+	fn := expressionFunctions[n.name]
+	args := make([]any, 0, len(n.args))
+	for _, arg := range n.args {
+		v, err := arg.eval(vars)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, v)
+	}
+	return fn.call(args)
+}
+
+func (n *literalNode) variables(map[string]struct{}) {}
+
+func (n *variableNode) variables(names map[string]struct{}) {
+	names[n.name] = struct{}{}
+}
+
+func (n *listNode) variables(names map[string]struct{}) {
+	for _, item := range n.items {
+		item.variables(names)
+	}
+}
+
+func (n *indexNode) variables(names map[string]struct{}) {
+	n.target.variables(names)
+	n.key.variables(names)
+}
+
+func (n *unaryNode) variables(names map[string]struct{}) {
+	n.operand.variables(names)
+}
+
+func (n *binaryNode) variables(names map[string]struct{}) {
+	n.lhs.variables(names)
+	n.rhs.variables(names)
+}
+
+func (n *callNode) variables(names map[string]struct{}) {
+	for _, arg := range n.args {
+		arg.variables(names)
+	}
+}
+
+func evalBool(node exprNode, vars map[string]any) (bool, error) {
+	v, err := node.eval(vars)
+	if err != nil {
+		return false, err
+	}
+	b, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("expected a boolean, got %s", typeName(v))
+	}
+	return b, nil
+}
+
+func compareOrdered(op string, lhs, rhs any) (bool, error) {
+	var cmp int
+	switch l := lhs.(type) {
+	case float64:
+		r, ok := rhs.(float64)
+		if !ok {
+			return false, fmt.Errorf("cannot compare %s with %s", typeName(lhs), typeName(rhs))
+		}
+		switch {
+		case l < r:
+			cmp = -1
+		case l > r:
+			cmp = 1
+		}
+	case string:
+		r, ok := rhs.(string)
+		if !ok {
+			return false, fmt.Errorf("cannot compare %s with %s", typeName(lhs), typeName(rhs))
+		}
+		cmp = strings.Compare(l, r)
+	default:
+		return false, fmt.Errorf("operator %q is not supported for %s", op, typeName(lhs))
+	}
+	switch op {
+	case "<":
+		return cmp < 0, nil
+	case "<=":
+		return cmp <= 0, nil
+	case ">":
+		return cmp > 0, nil
+	default:
+		return cmp >= 0, nil
+	}
+}
+
+// contains reports whether the list contains the element, the map contains the key, or the string contains
+// the substring.
+func contains(collection, element any) (bool, error) {
+	switch c := collection.(type) {
+	case []any:
+		for _, item := range c {
+			if reflect.DeepEqual(item, element) {
+				return true, nil
+			}
+		}
+		return false, nil
+	case map[string]any:
+		k, ok := element.(string)
+		if !ok {
+			return false, fmt.Errorf("map keys must be strings, got %s", typeName(element))
+		}
+		_, found := c[k]
+		return found, nil
+	case string:
+		s, ok := element.(string)
+		if !ok {
+			return false, fmt.Errorf("cannot search a string for %s", typeName(element))
+		}
+		return strings.Contains(c, s), nil
+	default:
+		return false, fmt.Errorf("cannot search %s", typeName(collection))
+	}
+}
+
+type expressionFunction struct {
+	arity int
+	call  func(args []any) (any, error)
+}
+
+var expressionFunctions = map[string]expressionFunction{
+	"contains": {arity: 2, call: func(args []any) (any, error) {
+		return contains(args[0], args[1])
+	}},
+	"startsWith": {arity: 2, call: stringPredicate(strings.HasPrefix)},
+	"endsWith":   {arity: 2, call: stringPredicate(strings.HasSuffix)},
+	"matches": {arity: 2, call: stringsFunction(func(s, pattern string) (any, error) {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid regular expression %q: %w", pattern, err)
+		}
+		return re.MatchString(s), nil
+	})},
+	"lower": {arity: 1, call: stringFunction(strings.ToLower)},
+	"upper": {arity: 1, call: stringFunction(strings.ToUpper)},
+	"len": {arity: 1, call: func(args []any) (any, error) {
+		switch v := args[0].(type) {
+		case string:
+			return float64(len(v)), nil
+		case []any:
+			return float64(len(v)), nil
+		case map[string]any:
+			return float64(len(v)), nil
+		default:
+			return nil, fmt.Errorf("len() is not supported for %s", typeName(v))
+		}
+	}},
+}
+
+func stringFunction(fn func(string) string) func(args []any) (any, error) {
+	return func(args []any) (any, error) {
+		s, ok := args[0].(string)
+		if !ok {
+			return nil, fmt.Errorf("expected a string, got %s", typeName(args[0]))
+		}
+		return fn(s), nil
+	}
+}
+
+func stringsFunction(fn func(a, b string) (any, error)) func(args []any) (any, error) {
+	return func(args []any) (any, error) {
+		a, ok := args[0].(string)
+		if !ok {
+			return nil, fmt.Errorf("expected a string, got %s", typeName(args[0]))
+		}
+		b, ok := args[1].(string)
+		if !ok {
+			return nil, fmt.Errorf("expected a string, got %s", typeName(args[1]))
+		}
+		return fn(a, b)
+	}
+}
+
+func stringPredicate(fn func(a, b string) bool) func(args []any) (any, error) {
+	return stringsFunction(func(a, b string) (any, error) {
+		return fn(a, b), nil
+	})
+}
+
+func typeName(v any) string {
+	switch v.(type) {
+	case bool:
+		return "boolean"
+	case float64:
+		return "number"
+	case string:
+		return "string"
+	case []any:
+		return "list"
+	case map[string]any:
+		return "map"
+	default:
+		return fmt.Sprintf("%T", v)
+	}
+}
+
+// Evaluate parses and evaluates an expression, which must yield a boolean.
+func Evaluate(expression string, vars map[string]any) (bool, error) {
+	e, err := Parse(expression)
+	if err != nil {
+		return false, fmt.Errorf("parsing expression %q: %w", expression, err)
+	}
+	return e.Evaluate(vars)
+}
@@ -0,0 +1,319 @@
+package expression
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvaluateExpression(t *testing.T) {
+	vars := map[string]any{
+		"rebooted":            true,
+		"osImageChanged":      false,
+		"osImage":             "quay.io/example/os:v2",
+		"applicationsChanged": []any{"app-a", "app-b"},
+		"labels":              map[string]any{"region": "eu", "site": "berlin"},
+		"systemInfo":          map[string]any{"architecture": "amd64"},
+		"filesChanged":        []any{"/etc/app/app.conf"},
+	}
+
+	tests := []struct {
+		name          string
+		expression    string
+		expected      bool
+		errorContains string
+	}{
+		{name: "boolean variable", expression: "rebooted == true", expected: true},
+		{name: "negation", expression: "!osImageChanged", expected: true},
+		{name: "and", expression: "rebooted && osImageChanged", expected: false},
+		{name: "or", expression: "osImageChanged || rebooted", expected: true},
+		{name: "precedence", expression: "osImageChanged && rebooted || true", expected: true},
+		{name: "parentheses", expression: "osImageChanged && (rebooted || true)", expected: false},
+		{name: "member access", expression: "labels.region == 'eu'", expected: true},
+		{name: "index access", expression: `labels["site"] != "munich"`, expected: true},
+		{name: "missing key is empty", expression: "labels.zone == ''", expected: true},
+		{name: "in list", expression: "'app-a' in applicationsChanged", expected: true},
+		{name: "not in list", expression: "!('app-c' in applicationsChanged)", expected: true},
+		{name: "in list literal", expression: "labels.region in ['eu', 'us']", expected: true},
+		{name: "key in map", expression: "'architecture' in systemInfo", expected: true},
+		{name: "substring", expression: "'example' in osImage", expected: true},
+		{name: "number comparison", expression: "len(applicationsChanged) >= 2", expected: true},
+		{name: "string comparison", expression: "labels.region < 'fr'", expected: true},
+		{name: "startsWith", expression: "startsWith(osImage, 'quay.io/')", expected: true},
+		{name: "endsWith", expression: "endsWith(osImage, ':v1')", expected: false},
+		{name: "matches", expression: "matches(osImage, ':v[0-9]+$')", expected: true},
+		{name: "contains", expression: "contains(filesChanged, '/etc/app/app.conf')", expected: true},
+		{name: "lower and upper", expression: "upper(labels.region) == 'EU' && lower('EU') == labels.region", expected: true},
+		{name: "combined", expression: "'app-a' in applicationsChanged && labels.region == 'eu'", expected: true},
+		{name: "unknown variable", expression: "unknown == true", errorContains: "unknown variable"},
+		{name: "unknown function", expression: "foo(rebooted)", errorContains: "unknown function"},
+		{name: "wrong arity", expression: "lower('a', 'b')", errorContains: "takes 1 argument"},
+		{name: "type mismatch", expression: "rebooted == 'true'", errorContains: "cannot compare"},
+		{name: "non boolean result", expression: "osImage", errorContains: "expected a boolean"},
+		{name: "syntax error", expression: "rebooted ==", errorContains: "unexpected end"},
+		{name: "trailing tokens", expression: "rebooted rebooted", errorContains: "unexpected"},
+		{name: "unterminated string", expression: "osImage == 'quay", errorContains: "unterminated string"},
+		{name: "invalid regex", expression: "matches(osImage, '[')", errorContains: "invalid regular expression"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Evaluate(tt.expression, vars)
+			if tt.errorContains != "" {
+				require.ErrorContains(t, err, tt.errorContains)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestEvaluateOperators(t *testing.T) {
+	vars := map[string]any{
+		"rebooted":            true,
+		"osImageChanged":      false,
+		"osImage":             "quay.io/example/os:v2",
+		"applicationsChanged": []any{"app-a", "app-b"},
+		"labels":              map[string]any{"region": "eu"},
+	}
+
+	tests := []struct {
+		expression string
+		expected   bool
+	}{
+		// equality
+		{expression: "1 == 1", expected: true},
+		{expression: "1 == 2", expected: false},
+		{expression: "1 != 2", expected: true},
+		{expression: "'a' == 'a'", expected: true},
+		{expression: `'a' != "a"`, expected: false},
+		{expression: "true == rebooted", expected: true},
+		{expression: "['app-a', 'app-b'] == applicationsChanged", expected: true},
+		{expression: "['app-b', 'app-a'] == applicationsChanged", expected: false},
+		// ordering of numbers
+		{expression: "1 < 2", expected: true},
+		{expression: "2 < 2", expected: false},
+		{expression: "2 <= 2", expected: true},
+		{expression: "3 <= 2", expected: false},
+		{expression: "2 > 1", expected: true},
+		{expression: "2 > 2", expected: false},
+		{expression: "2 >= 2", expected: true},
+		{expression: "1 >= 2", expected: false},
+		{expression: "-1 < 0", expected: true},
+		{expression: "1.5 < 2", expected: true},
+		// ordering of strings
+		{expression: "'a' < 'b'", expected: true},
+		{expression: "'b' <= 'a'", expected: false},
+		{expression: "'b' > 'a'", expected: true},
+		{expression: "'a' >= 'a'", expected: true},
+		// membership
+		{expression: "'app-b' in applicationsChanged", expected: true},
+		{expression: "'app-c' in applicationsChanged", expected: false},
+		{expression: "1 in [1, 2]", expected: true},
+		{expression: "'x' in []", expected: false},
+		{expression: "'region' in labels", expected: true},
+		{expression: "'site' in labels", expected: false},
+		{expression: "'os:v2' in osImage", expected: true},
+		{expression: "'os:v1' in osImage", expected: false},
+		// boolean operators
+		{expression: "!rebooted", expected: false},
+		{expression: "!!rebooted", expected: true},
+		{expression: "true && true", expected: true},
+		{expression: "true && false", expected: false},
+		{expression: "false || true", expected: true},
+		{expression: "false || false", expected: false},
+		// member and index access
+		{expression: "labels.region == 'eu'", expected: true},
+		{expression: "labels['region'] == 'eu'", expected: true},
+		{expression: "labels[lower('REGION')] == 'eu'", expected: true},
+		{expression: "applicationsChanged[1] == 'app-b'", expected: true},
+		{expression: "labels.missing == ''", expected: true},
+		// literals
+		{expression: `'it\'s' == "it's"`, expected: true},
+		{expression: "len('') == 0", expected: true},
+		{expression: "len(labels) == 1", expected: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			result, err := Evaluate(tt.expression, vars)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestEvaluatePrecedence(t *testing.T) {
+	vars := map[string]any{
+		"rebooted": true,
+		"osImage":  "quay.io/example/os:v2",
+	}
+
+	tests := []struct {
+		name          string
+		expression    string
+		expected      bool
+		errorContains string
+	}{
+		{name: "and binds tighter than or", expression: "true || false && false", expected: true},
+		{name: "parentheses override precedence", expression: "(true || false) && false", expected: false},
+		{name: "and is left associative", expression: "true && true && false", expected: false},
+		{name: "or is left associative", expression: "false || false || true", expected: true},
+		{name: "not binds tighter than or", expression: "!true || true", expected: true},
+		{name: "not applies to parentheses", expression: "!(true || true)", expected: false},
+		{name: "not binds tighter than comparison", expression: "!osImage == 'x'", errorContains: "expected a boolean"},
+		{name: "comparison binds tighter than and", expression: "1 < 2 && 'a' in ['a']", expected: true},
+		{name: "comparison binds tighter than or", expression: "1 > 2 || osImage != ''", expected: true},
+		{name: "member access binds tighter than not", expression: "!['a'][0] == 'a'", errorContains: "expected a boolean"},
+		{name: "nested parentheses", expression: "((rebooted))", expected: true},
+		{name: "comparisons do not chain", expression: "1 < 2 < 3", errorContains: "unexpected"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Evaluate(tt.expression, vars)
+			if tt.errorContains != "" {
+				require.ErrorContains(t, err, tt.errorContains)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestEvaluateShortCircuit(t *testing.T) {
+	vars := map[string]any{
+		"rebooted":       true,
+		"osImageChanged": false,
+		"osImage":        "quay.io/example/os:v2",
+	}
+
+	tests := []struct {
+		name          string
+		expression    string
+		expected      bool
+		errorContains string
+	}{
+		{name: "and skips the right side if the left side is false", expression: "osImageChanged && unknown", expected: false},
+		{name: "or skips the right side if the left side is true", expression: "rebooted || unknown", expected: true},
+		{name: "and skips a non boolean right side", expression: "osImageChanged && osImage", expected: false},
+		{name: "or skips a non boolean right side", expression: "rebooted || osImage", expected: true},
+		{name: "and evaluates the right side if the left side is true", expression: "rebooted && unknown", errorContains: "unknown variable"},
+		{name: "or evaluates the right side if the left side is false", expression: "osImageChanged || osImage", errorContains: "expected a boolean"},
+		{name: "errors on the left side are not skipped", expression: "unknown && osImageChanged", errorContains: "unknown variable"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Evaluate(tt.expression, vars)
+			if tt.errorContains != "" {
+				require.ErrorContains(t, err, tt.errorContains)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestEvaluateTypeMismatch(t *testing.T) {
+	vars := map[string]any{
+		"rebooted":            true,
+		"osImage":             "quay.io/example/os:v2",
+		"applicationsChanged": []any{"app-a"},
+		"labels":              map[string]any{"region": "eu"},
+	}
+
+	tests := []struct {
+		expression    string
+		errorContains string
+	}{
+		{expression: "1 == '1'", errorContains: "cannot compare number with string"},
+		{expression: "rebooted != 'true'", errorContains: "cannot compare boolean with string"},
+		{expression: "labels == applicationsChanged", errorContains: "cannot compare map with list"},
+		{expression: "1 < 'a'", errorContains: "cannot compare number with string"},
+		{expression: "'a' >= 1", errorContains: "cannot compare string with number"},
+		{expression: "rebooted < true", errorContains: `operator "<" is not supported for boolean`},
+		{expression: "applicationsChanged > []", errorContains: `operator ">" is not supported for list`},
+		{expression: "!osImage", errorContains: "expected a boolean, got string"},
+		{expression: "osImage && rebooted", errorContains: "expected a boolean, got string"},
+		{expression: "rebooted && 1", errorContains: "expected a boolean, got number"},
+		{expression: "len(osImage)", errorContains: "expected a boolean, got number"},
+		{expression: "1 in osImage", errorContains: "cannot search a string for number"},
+		{expression: "'a' in 1", errorContains: "cannot search number"},
+		{expression: "1 in labels", errorContains: "map keys must be strings"},
+		{expression: "labels[1] == ''", errorContains: "map keys must be strings"},
+		{expression: "applicationsChanged['a'] == ''", errorContains: "list indexes must be integers"},
+		{expression: "applicationsChanged[0.5] == ''", errorContains: "list indexes must be integers"},
+		{expression: "applicationsChanged[1] == ''", errorContains: "out of range"},
+		{expression: "rebooted.x", errorContains: "cannot index boolean"},
+		{expression: "lower(1) == ''", errorContains: "expected a string, got number"},
+		{expression: "startsWith(osImage, 1)", errorContains: "expected a string, got number"},
+		{expression: "len(rebooted) == 0", errorContains: "len() is not supported for boolean"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			_, err := Evaluate(tt.expression, vars)
+			require.ErrorContains(t, err, tt.errorContains)
+		})
+	}
+}
+
+func TestParseMalformed(t *testing.T) {
+	tests := []struct {
+		expression    string
+		errorContains string
+	}{
+		{expression: "", errorContains: "unexpected end of expression"},
+		{expression: "   ", errorContains: "unexpected end of expression"},
+		{expression: "rebooted &&", errorContains: "unexpected end of expression"},
+		{expression: "|| rebooted", errorContains: `unexpected "||" at position 0`},
+		{expression: "rebooted & true", errorContains: "unexpected character '&' at position 9"},
+		{expression: "rebooted = true", errorContains: "unexpected character '='"},
+		{expression: "(rebooted", errorContains: `expected ")" at end of expression`},
+		{expression: "rebooted)", errorContains: `unexpected ")" at position 8`},
+		{expression: "[1, 2", errorContains: `expected "," at end of expression`},
+		{expression: "[1 2]", errorContains: `expected "," at position 3, got "2"`},
+		{expression: "[1,]", errorContains: `unexpected "]"`},
+		{expression: "labels[", errorContains: "unexpected end of expression"},
+		{expression: "labels['a'", errorContains: `expected "]" at end of expression`},
+		{expression: "labels.", errorContains: "expected a key after '.'"},
+		{expression: "labels.1", errorContains: "expected a key after '.'"},
+		{expression: "1.2.3 == 1", errorContains: `invalid number "1.2.3"`},
+		{expression: "'abc", errorContains: "unterminated string at position 0"},
+		{expression: `"abc\"`, errorContains: "unterminated string"},
+		{expression: "in", errorContains: `unexpected "in"`},
+		{expression: "'a' in", errorContains: "unexpected end of expression"},
+		{expression: "rebooted rebooted", errorContains: `unexpected "rebooted" at position 9`},
+		{expression: "exists(rebooted)", errorContains: `unknown function "exists"`},
+		{expression: "len()", errorContains: "len() takes 1 argument(s), got 0"},
+		{expression: "startsWith(osImage)", errorContains: "startsWith() takes 2 argument(s), got 1"},
+		{expression: "lower('a', 'b')", errorContains: "lower() takes 1 argument(s), got 2"},
+		{expression: "lower('a'", errorContains: `expected "," at end of expression`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			_, err := Parse(tt.expression)
+			require.ErrorContains(t, err, tt.errorContains)
+		})
+	}
+}
+
+func TestVariables(t *testing.T) {
+	tests := []struct {
+		expression string
+		expected   []string
+	}{
+		{expression: "true", expected: nil},
+		{expression: "rebooted", expected: []string{"rebooted"}},
+		{expression: "rebooted && !rebooted", expected: []string{"rebooted"}},
+		{expression: "labels.region == region", expected: []string{"labels", "region"}},
+		{expression: "labels[osImage] in [site, 'a']", expected: []string{"labels", "osImage", "site"}},
+		{expression: "len(filesChanged) > 0 || startsWith(osImage, prefix)", expected: []string{"filesChanged", "osImage", "prefix"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			e, err := Parse(tt.expression)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, e.Variables())
+		})
+	}
+}
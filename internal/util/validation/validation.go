@@ -136,6 +136,15 @@ func ValidateFilePath(s *string, path string) []error {
 	return asErrors(errs)
 }
 
+// ValidateSecretDataKey validates that a key of a secret's data is a valid file name, as for the keys of Kubernetes secrets.
+func ValidateSecretDataKey(key string, path string) []error {
+	errs := field.ErrorList{}
+	for _, msg := range k8sutilvalidation.IsConfigMapKey(key) {
+		errs = append(errs, field.Invalid(fieldPathFor(path), key, msg))
+	}
+	return asErrors(errs)
+}
+
 func ValidateRelativePath(s *string, path string, maxLength int) []error {
 	if s == nil {
 		return []error{}
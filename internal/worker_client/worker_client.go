@@ -73,15 +73,20 @@ func (t *workerClient) EmitEvent(ctx context.Context, orgId uuid.UUID, event *do
 
 // eventReasons contains all event reasons that should be sent to the workers
 var eventReasons = map[domain.EventReason]struct{}{
-	domain.EventReasonResourceCreated:             {},
-	domain.EventReasonResourceUpdated:             {},
-	domain.EventReasonResourceDeleted:             {},
-	domain.EventReasonFleetRolloutStarted:         {},
-	domain.EventReasonReferencedRepositoryUpdated: {},
-	domain.EventReasonFleetRolloutDeviceSelected:  {},
-	domain.EventReasonFleetRolloutBatchDispatched: {},
-	domain.EventReasonDeviceConflictResolved:      {},
-	domain.EventReasonDeviceDecommissioned:        {},
+	domain.EventReasonResourceCreated:              {},
+	domain.EventReasonResourceUpdated:              {},
+	domain.EventReasonResourceDeleted:              {},
+	domain.EventReasonFleetRolloutStarted:          {},
+	domain.EventReasonReferencedRepositoryUpdated:  {},
+	domain.EventReasonRepositoryPushReceived:       {},
+	domain.EventReasonResourceSyncPreviewRequested: {},
+	domain.EventReasonFleetRolloutDeviceSelected:   {},
+	domain.EventReasonFleetRolloutBatchDispatched:  {},
+	domain.EventReasonFleetRolloutRolledBack:       {},
+	domain.EventReasonDeviceConflictResolved:       {},
+	domain.EventReasonDeviceDecommissioned:         {},
+	domain.EventReasonDeviceIntegrityVerified:      {},
+	domain.EventReasonDeviceEncryptionKeyUpdated:   {},
 }
 
 func shouldEmitEvent(reason domain.EventReason) bool {
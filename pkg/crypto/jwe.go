@@ -0,0 +1,246 @@
+package crypto
+
+import (
+	"crypto"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Sensitive content is encrypted as a JWE in compact serialization (RFC 7516) using direct ECDH-ES key
+// agreement on P-256 and A256GCM content encryption (RFC 7518, section 4.6). Only the holder of the
+// recipient's private key can decrypt it, which also allows the key agreement to happen inside a TPM.
+const (
+	jweAlgorithm         = "ECDH-ES"
+	jweContentEncryption = "A256GCM"
+	jweCurve             = "P-256"
+	jweKeyType           = "EC"
+	jweKeyBits           = 256
+)
+
+// ECDHFunc computes the shared secret between the recipient's private key and the given ephemeral public key.
+type ECDHFunc func(ephemeral *ecdh.PublicKey) ([]byte, error)
+
+type jweHeader struct {
+	Alg string          `json:"alg"`
+	Enc string          `json:"enc"`
+	Epk jweEphemeralKey `json:"epk"`
+	Apu string          `json:"apu,omitempty"`
+	Apv string          `json:"apv,omitempty"`
+}
+
+type jweEphemeralKey struct {
+	Kty string `json:"kty"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// EncryptJWE encrypts the plaintext to the recipient's P-256 public key and returns the JWE compact serialization.
+func EncryptJWE(recipient crypto.PublicKey, plaintext []byte) (string, error) {
+	recipientKey, err := ECDHPublicKey(recipient)
+	if err != nil {
+		return "", err
+	}
+	ephemeral, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		return "", fmt.Errorf("generating ephemeral key: %w", err)
+	}
+	z, err := ephemeral.ECDH(recipientKey)
+	if err != nil {
+		return "", fmt.Errorf("computing shared secret: %w", err)
+	}
+
+	// the uncompressed point encoding is 0x04 || X || Y
+	point := ephemeral.PublicKey().Bytes()
+	header, err := json.Marshal(jweHeader{
+		Alg: jweAlgorithm,
+		Enc: jweContentEncryption,
+		Epk: jweEphemeralKey{
+			Kty: jweKeyType,
+			Crv: jweCurve,
+			X:   base64.RawURLEncoding.EncodeToString(point[1:33]),
+			Y:   base64.RawURLEncoding.EncodeToString(point[33:]),
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("marshalling JWE header: %w", err)
+	}
+	encodedHeader := base64.RawURLEncoding.EncodeToString(header)
+
+	aead, err := newJWEContentCipher(z, nil, nil)
+	if err != nil {
+		return "", err
+	}
+	iv := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(iv); err != nil {
+		return "", fmt.Errorf("generating IV: %w", err)
+	}
+	sealed := aead.Seal(nil, iv, plaintext, []byte(encodedHeader))
+	ciphertext, tag := sealed[:len(sealed)-aead.Overhead()], sealed[len(sealed)-aead.Overhead():]
+
+	return strings.Join([]string{
+		encodedHeader,
+		"",
+		base64.RawURLEncoding.EncodeToString(iv),
+		base64.RawURLEncoding.EncodeToString(ciphertext),
+		base64.RawURLEncoding.EncodeToString(tag),
+	}, "."), nil
+}
+
+// DecryptJWE decrypts a JWE in compact serialization that was encrypted to the key that ecdhFn computes shared
+// secrets with.
+func DecryptJWE(token string, ecdhFn ECDHFunc) ([]byte, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 5 {
+		return nil, errors.New("invalid JWE: expected compact serialization with 5 parts")
+	}
+	if parts[1] != "" {
+		return nil, errors.New("invalid JWE: unexpected encrypted key for direct key agreement")
+	}
+
+	headerBytes, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid JWE header: %w", err)
+	}
+	var header jweHeader
+	if err := json.Unmarshal(headerBytes, &header); err != nil {
+		return nil, fmt.Errorf("invalid JWE header: %w", err)
+	}
+	if header.Alg != jweAlgorithm || header.Enc != jweContentEncryption {
+		return nil, fmt.Errorf("unsupported JWE algorithm %q with content encryption %q", header.Alg, header.Enc)
+	}
+	ephemeral, err := header.Epk.publicKey()
+	if err != nil {
+		return nil, err
+	}
+
+	var decoded [3][]byte
+	for i, part := range parts[2:] {
+		if decoded[i], err = base64.RawURLEncoding.DecodeString(part); err != nil {
+			return nil, fmt.Errorf("invalid JWE: %w", err)
+		}
+	}
+	iv, ciphertext, tag := decoded[0], decoded[1], decoded[2]
+
+	apu, err := base64.RawURLEncoding.DecodeString(header.Apu)
+	if err != nil {
+		return nil, fmt.Errorf("invalid JWE apu: %w", err)
+	}
+	apv, err := base64.RawURLEncoding.DecodeString(header.Apv)
+	if err != nil {
+		return nil, fmt.Errorf("invalid JWE apv: %w", err)
+	}
+
+	z, err := ecdhFn(ephemeral)
+	if err != nil {
+		return nil, fmt.Errorf("computing shared secret: %w", err)
+	}
+	aead, err := newJWEContentCipher(z, apu, apv)
+	if err != nil {
+		return nil, err
+	}
+	if len(iv) != aead.NonceSize() {
+		return nil, fmt.Errorf("invalid JWE: IV must be %d bytes", aead.NonceSize())
+	}
+	plaintext, err := aead.Open(nil, iv, append(ciphertext, tag...), []byte(parts[0]))
+	if err != nil {
+		return nil, fmt.Errorf("decrypting JWE: %w", err)
+	}
+	return plaintext, nil
+}
+
+func (k jweEphemeralKey) publicKey() (*ecdh.PublicKey, error) {
+	if k.Kty != jweKeyType || k.Crv != jweCurve {
+		return nil, fmt.Errorf("unsupported JWE ephemeral key %s/%s", k.Kty, k.Crv)
+	}
+	x, err := base64.RawURLEncoding.DecodeString(k.X)
+	if err != nil {
+		return nil, fmt.Errorf("invalid JWE ephemeral key: %w", err)
+	}
+	y, err := base64.RawURLEncoding.DecodeString(k.Y)
+	if err != nil {
+		return nil, fmt.Errorf("invalid JWE ephemeral key: %w", err)
+	}
+	if len(x) != 32 || len(y) != 32 {
+		return nil, errors.New("invalid JWE ephemeral key: unexpected coordinate length")
+	}
+	point := append(append([]byte{4}, x...), y...)
+	// NewPublicKey rejects points that are not on the curve
+	key, err := ecdh.P256().NewPublicKey(point)
+	if err != nil {
+		return nil, fmt.Errorf("invalid JWE ephemeral key: %w", err)
+	}
+	return key, nil
+}
+
+// newJWEContentCipher derives the content encryption key from the shared secret with the Concat KDF
+// (NIST SP 800-56A, section 5.8.1) as specified for direct ECDH-ES key agreement.
+func newJWEContentCipher(z, apu, apv []byte) (cipher.AEAD, error) {
+	var otherInfo []byte
+	for _, field := range [][]byte{[]byte(jweContentEncryption), apu, apv} {
+		otherInfo = binary.BigEndian.AppendUint32(otherInfo, uint32(len(field))) //nolint:gosec
+		otherInfo = append(otherInfo, field...)
+	}
+	otherInfo = binary.BigEndian.AppendUint32(otherInfo, jweKeyBits)
+
+	// a single round of SHA-256 yields the 256 bits needed for A256GCM
+	hash := sha256.New()
+	hash.Write([]byte{0, 0, 0, 1})
+	hash.Write(z)
+	hash.Write(otherInfo)
+
+	block, err := aes.NewCipher(hash.Sum(nil))
+	if err != nil {
+		return nil, fmt.Errorf("creating content cipher: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
+
+// ECDHPublicKey converts a P-256 public key to its ECDH form.
+func ECDHPublicKey(key crypto.PublicKey) (*ecdh.PublicKey, error) {
+	switch key := key.(type) {
+	case *ecdh.PublicKey:
+		if key.Curve() != ecdh.P256() {
+			return nil, errors.New("unsupported ECDH curve: only P-256 is supported")
+		}
+		return key, nil
+	case *ecdsa.PublicKey:
+		ecdhKey, err := key.ECDH()
+		if err != nil {
+			return nil, fmt.Errorf("converting ECDSA key: %w", err)
+		}
+		return ECDHPublicKey(ecdhKey)
+	default:
+		return nil, fmt.Errorf("unsupported public key type %T", key)
+	}
+}
+
+// EncodePublicKeyPEM encodes a public key as a PEM PUBLIC KEY block.
+func EncodePublicKeyPEM(key crypto.PublicKey) ([]byte, error) {
+	der, err := x509.MarshalPKIXPublicKey(key)
+	if err != nil {
+		return nil, fmt.Errorf("marshalling public key: %w", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), nil
+}
+
+// ParsePublicKeyPEM parses a PEM PUBLIC KEY block.
+func ParsePublicKeyPEM(pemKey []byte) (crypto.PublicKey, error) {
+	block, _ := pem.Decode(pemKey)
+	if block == nil || block.Type != "PUBLIC KEY" {
+		return nil, errors.New("failed to decode PEM public key")
+	}
+	return x509.ParsePKIXPublicKey(block.Bytes)
+}
@@ -0,0 +1,71 @@
+package crypto
+
+import (
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"testing"
+
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwe"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJWE(t *testing.T) {
+	require := require.New(t)
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(err)
+	recipient, err := privateKey.ECDH()
+	require.NoError(err)
+	ecdhFn := func(ephemeral *ecdh.PublicKey) ([]byte, error) { return recipient.ECDH(ephemeral) }
+	plaintext := []byte("password=hunter2\n")
+
+	t.Run("round trip", func(t *testing.T) {
+		token, err := EncryptJWE(&privateKey.PublicKey, plaintext)
+		require.NoError(err)
+		require.NotContains(token, "hunter2")
+
+		decrypted, err := DecryptJWE(token, ecdhFn)
+		require.NoError(err)
+		require.Equal(plaintext, decrypted)
+	})
+
+	t.Run("interoperates with other implementations", func(t *testing.T) {
+		token, err := jwe.Encrypt(plaintext, jwe.WithKey(jwa.ECDH_ES, &privateKey.PublicKey), jwe.WithContentEncryption(jwa.A256GCM))
+		require.NoError(err)
+		decrypted, err := DecryptJWE(string(token), ecdhFn)
+		require.NoError(err)
+		require.Equal(plaintext, decrypted)
+
+		ours, err := EncryptJWE(&privateKey.PublicKey, plaintext)
+		require.NoError(err)
+		decrypted, err = jwe.Decrypt([]byte(ours), jwe.WithKey(jwa.ECDH_ES, privateKey))
+		require.NoError(err)
+		require.Equal(plaintext, decrypted)
+	})
+
+	t.Run("other key cannot decrypt", func(t *testing.T) {
+		otherKey, err := ecdh.P256().GenerateKey(rand.Reader)
+		require.NoError(err)
+		token, err := EncryptJWE(&privateKey.PublicKey, plaintext)
+		require.NoError(err)
+		_, err = DecryptJWE(token, func(ephemeral *ecdh.PublicKey) ([]byte, error) { return otherKey.ECDH(ephemeral) })
+		require.Error(err)
+	})
+
+	t.Run("tampered header is rejected", func(t *testing.T) {
+		token, err := EncryptJWE(&privateKey.PublicKey, plaintext)
+		require.NoError(err)
+		_, err = DecryptJWE("e"+token, ecdhFn)
+		require.Error(err)
+	})
+
+	t.Run("public key PEM round trip", func(t *testing.T) {
+		pemKey, err := EncodePublicKeyPEM(&privateKey.PublicKey)
+		require.NoError(err)
+		parsed, err := ParsePublicKeyPEM(pemKey)
+		require.NoError(err)
+		require.True(privateKey.PublicKey.Equal(parsed))
+	})
+}
@@ -375,6 +375,27 @@ var _ = Describe("DeviceStore create", func() {
 			Expect(err).Should(MatchError(flterrors.ErrUpdatingResourceWithOwnerNotAllowed))
 		})
 
+		It("CreateOrUpdateDevice update variables owned from API", func() {
+			dev, err := devStore.Get(ctx, orgId, "mydevice-1")
+			Expect(err).ToNot(HaveOccurred())
+			dev.Metadata.Owner = lo.ToPtr("Fleet/myfleet")
+			dev, _, err = devStore.CreateOrUpdate(ctx, orgId, dev, nil, false, nil, callback)
+			Expect(err).ToNot(HaveOccurred())
+
+			// variables are set per device, so they may be updated although the fleet owns the device
+			dev.Spec.Variables = &map[string]string{"zone": "eu-1"}
+			dev, _, err = devStore.CreateOrUpdate(ctx, orgId, dev, nil, true, nil, callback)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(dev.Spec.Variables).ToNot(BeNil())
+			Expect(*dev.Spec.Variables).To(HaveKeyWithValue("zone", "eu-1"))
+
+			// the rest of the spec is still managed by the fleet
+			dev.Spec.Variables = &map[string]string{"zone": "eu-2"}
+			dev.Spec.Os.Image = "newos"
+			_, _, err = devStore.CreateOrUpdate(ctx, orgId, dev, nil, true, nil, callback)
+			Expect(err).Should(MatchError(flterrors.ErrUpdatingResourceWithOwnerNotAllowed))
+		})
+
 		It("CreateOrUpdateDevice update labels owned from API", func() {
 			// Create a comprehensive DeviceSpec with all possible fields to test our comparison logic
 			createComprehensiveTestDevice := func(orgId uuid.UUID, name string, owner *string, labels *map[string]string) api.Device {
@@ -894,7 +915,7 @@ var _ = Describe("DeviceStore create", func() {
 			Expect(err).ToNot(HaveOccurred())
 
 			// Set first rendered config
-			_, err = devStore.UpdateRendered(ctx, orgId, "dev", firstConfig, "", "hash1")
+			_, err = devStore.UpdateRendered(ctx, orgId, "dev", firstConfig, "", "", "hash1")
 			Expect(err).ToNot(HaveOccurred())
 
 			// Getting first rendered config
@@ -917,7 +938,7 @@ var _ = Describe("DeviceStore create", func() {
 			// Set second rendered config
 			secondConfig, err := createTestConfigProvider("this is the second config")
 			Expect(err).ToNot(HaveOccurred())
-			_, err = devStore.UpdateRendered(ctx, orgId, "dev", secondConfig, "", "hash2")
+			_, err = devStore.UpdateRendered(ctx, orgId, "dev", secondConfig, "", "", "hash2")
 			Expect(err).ToNot(HaveOccurred())
 
 			// Passing previous renderedVersion